@@ -71,16 +71,45 @@ func ensureBlockchain(db *evmi_database.EvmiDatabase, cfg types.ConfigBlockchain
 		return 0, err
 	}
 
+	headMode := evmi_database.ChainHeadMode(strings.ToUpper(cfg.HeadMode))
+	if !headMode.Valid() {
+		return 0, fmt.Errorf("unknown blockchain headMode %q", cfg.HeadMode)
+	}
+	if cfg.RpcRateLimit < 0 {
+		return 0, fmt.Errorf("invalid blockchain rpcRateLimit %v", cfg.RpcRateLimit)
+	}
+
 	row := evmi_database.EvmBlockchain{
-		ChainId:             cfg.ChainId,
-		Name:                cfg.Name,
-		RpcUrl:              cfg.RpcUrl,
-		BlockRange:          cfg.BlockRange,
-		BlockSlice:          cfg.BlockSlice,
-		PullInterval:        cfg.PullInterval,
-		RpcMaxBatchSize:     cfg.RpcMaxBatchSize,
-		SqdGatewayAvailable: cfg.SqdGatewayAvailable,
-		SqdGatewayUrl:       cfg.SqdGatewayUrl,
+		ChainId:              cfg.ChainId,
+		Name:                 cfg.Name,
+		RpcUrl:               cfg.RpcUrl,
+		BlockRange:           cfg.BlockRange,
+		BlockSlice:           cfg.BlockSlice,
+		PullInterval:         cfg.PullInterval,
+		RpcMaxBatchSize:      cfg.RpcMaxBatchSize,
+		SqdGatewayAvailable:  cfg.SqdGatewayAvailable,
+		SqdGatewayUrl:        cfg.SqdGatewayUrl,
+		HeadMode:             string(headMode),
+		HeadConfirmations:    cfg.HeadConfirmations,
+		WsUrl:                cfg.WsUrl,
+		WsSubscribeLogs:      cfg.WsSubscribeLogs,
+		LogsMaxAddresses:     cfg.LogsMaxAddresses,
+		TraceFilterAvailable: cfg.TraceFilterAvailable,
+		RpcRateLimit:         cfg.RpcRateLimit,
+	}
+	row.SetMethodCosts(cfg.RpcMethodCosts)
+	for _, endpoint := range cfg.RpcEndpoints {
+		if endpoint.Url == "" {
+			return 0, errors.New("blockchain rpcEndpoints url is required")
+		}
+		weight := endpoint.Weight
+		if weight == 0 {
+			weight = 1
+		}
+		row.RpcEndpoints = append(row.RpcEndpoints, evmi_database.EvmRpcEndpoint{Url: endpoint.Url, Weight: weight})
+	}
+	if row.RpcUrl == "" && len(row.RpcEndpoints) > 0 {
+		row.RpcUrl = row.RpcEndpoints[0].Url
 	}
 	if err := db.Conn.Create(&row).Error; err != nil {
 		return 0, err
@@ -190,12 +219,26 @@ func ensureSource(db *evmi_database.EvmiDatabase, instanceID uint, cfg types.Con
 		chainID = pipeline.EvmBlockchainID
 	}
 
-	// ABI is optional (a FULL source doesn't decode).
-	var abiID uint
-	if cfg.Abi != "" {
-		if abiID, err = abiIDByName(db, cfg.Abi); err != nil {
+	// ABI is optional (FULL, BLOCKS and TRACES sources don't decode).
+	abiNames := cfg.Abis
+	if len(abiNames) == 0 && cfg.Abi != "" {
+		abiNames = []string{cfg.Abi}
+	}
+	var abiIDs []uint
+	for _, name := range abiNames {
+		abiID, err := abiIDByName(db, name)
+		if err != nil {
 			return err
 		}
+		abiIDs = append(abiIDs, abiID)
+	}
+	topic0s := cfg.Topic0s
+	if len(topic0s) == 0 && cfg.Topic0 != "" {
+		topic0s = []string{cfg.Topic0}
+	}
+	addresses := cfg.Addresses
+	if len(addresses) == 0 && cfg.Address != "" {
+		addresses = []string{cfg.Address}
 	}
 
 	// Existence check by the source's natural key within its pipeline.
@@ -204,8 +247,18 @@ func ensureSource(db *evmi_database.EvmiDatabase, instanceID uint, cfg types.Con
 	switch sourceType {
 	case string(evmi_database.ContractLogSourceType), string(evmi_database.FactoryLogSourceType):
 		query = query.Where("address = ?", cfg.Address)
+	case string(evmi_database.TransactionsLogSourceType), string(evmi_database.TracesLogSourceType):
+		var address string
+		if len(addresses) > 0 {
+			address = addresses[0]
+		}
+		query = query.Where("address = ?", address)
 	case string(evmi_database.TopicLogSourceType):
-		query = query.Where("topic0 = ?", cfg.Topic0)
+		var topic0 string
+		if len(topic0s) > 0 {
+			topic0 = topic0s[0]
+		}
+		query = query.Where("topic0 = ?", topic0)
 	}
 	var count int64
 	if err := query.Count(&count).Error; err != nil {
@@ -221,16 +274,20 @@ func ensureSource(db *evmi_database.EvmiDatabase, instanceID uint, cfg types.Con
 		Type:             sourceType,
 		StartBlock:       cfg.StartBlock,
 		SyncBlock:        cfg.StartBlock,
+		EndBlock:         cfg.EndBlock,
+		CatchUpWorkers:   cfg.CatchUpWorkers,
 		EvmLogPipelineID: pipelineID,
-		EvmJsonAbiID:     abiID,
 		EvmBlockchainID:  chainID,
 	}
+	row.SetAbiIDs(abiIDs)
+	row.SetTopic0s(topic0s)
 	if cfg.Address != "" {
 		row.Address = sql.NullString{String: cfg.Address, Valid: true}
 	}
-	if cfg.Topic0 != "" {
-		row.Topic0 = sql.NullString{String: cfg.Topic0, Valid: true}
+	if len(cfg.Addresses) > 0 {
+		row.SetAddresses(cfg.Addresses)
 	}
+	row.FunctionSelector = strings.ToLower(cfg.FunctionSelector)
 	if len(cfg.TopicFilters) > 0 {
 		row.TopicFilters = pq.StringArray(cfg.TopicFilters)
 	}
@@ -267,6 +324,7 @@ func createFactoryRules(db *evmi_database.EvmiDatabase, sourceID uint, parentRul
 		}
 		rule := evmi_database.EvmFactoryRule{
 			ParentRuleID:          parentRuleID,
+			Trigger:               r.Trigger,
 			CreationFunctionName:  r.CreationFunctionName,
 			CreationAddressLogArg: r.CreationAddressLogArg,
 			ChildType:             childType,
@@ -25,6 +25,7 @@ func newDBForTest(t *testing.T) *evmi_database.EvmiDatabase {
 	if err := db.AutoMigrate(
 		&evmi_database.EvmiInstance{},
 		&evmi_database.EvmBlockchain{},
+		&evmi_database.EvmRpcEndpoint{},
 		&evmi_database.EvmJsonAbi{},
 		&evmi_database.EvmLogStore{},
 		&evmi_database.EvmLogPipeline{},
@@ -41,7 +42,8 @@ func newDBForTest(t *testing.T) *evmi_database.EvmiDatabase {
 func fullConfig() types.AutoloadResources {
 	return types.AutoloadResources{
 		Blockchains: []types.ConfigBlockchain{
-			{Name: "ethereum", ChainId: 1, RpcUrl: "http://rpc", BlockRange: 100},
+			{Name: "ethereum", ChainId: 1, RpcUrl: "http://rpc", BlockRange: 100, HeadMode: "finalized",
+				RpcEndpoints: []types.ConfigRpcEndpoint{{Url: "http://rpc", Weight: 2}, {Url: "http://rpc-backup"}}},
 		},
 		Abis: []types.ConfigAbi{
 			{ContractName: "ERC20", Content: "[]"},
@@ -77,9 +79,15 @@ func TestLoadCreatesResourcesAndResolvesRefs(t *testing.T) {
 
 	// Blockchain / ABI / store created.
 	var chain evmi_database.EvmBlockchain
-	if err := db.Conn.Where("name = ?", "ethereum").First(&chain).Error; err != nil {
+	if err := db.Conn.Preload("RpcEndpoints").Where("name = ?", "ethereum").First(&chain).Error; err != nil {
 		t.Fatalf("blockchain not created: %v", err)
 	}
+	if chain.HeadMode != string(evmi_database.FinalizedChainHeadMode) {
+		t.Errorf("blockchain head mode = %q, want FINALIZED", chain.HeadMode)
+	}
+	if len(chain.RpcEndpoints) != 2 || chain.RpcEndpoints[0].Weight != 2 || chain.RpcEndpoints[1].Weight != 1 {
+		t.Errorf("blockchain rpc endpoints = %+v, want weights 2 and 1 (default)", chain.RpcEndpoints)
+	}
 	var store evmi_database.EvmLogStore
 	if err := db.Conn.Where("identifier = ?", "main").First(&store).Error; err != nil {
 		t.Fatalf("store not created: %v", err)
@@ -11,6 +11,8 @@ const (
 	SourceUpdateTopic    string = "source.update"
 	EnableSourceTopic    string = "source.enable"
 	DisableSourceTopic   string = "source.disable"
+	SourceRollbackTopic  string = "source.rollback"
+	RedecodeSourceTopic  string = "source.redecode"
 	ExporterUpdateTopic  string = "exporter.update"
 	EnableExporterTopic  string = "exporter.enable"
 	DisableExporterTopic string = "exporter.disable"
@@ -40,6 +42,8 @@ func InitializeBus() *bus.Bus {
 	b.RegisterTopics(SourceUpdateTopic)
 	b.RegisterTopics(EnableSourceTopic)
 	b.RegisterTopics(DisableSourceTopic)
+	b.RegisterTopics(SourceRollbackTopic)
+	b.RegisterTopics(RedecodeSourceTopic)
 	b.RegisterTopics(ExporterUpdateTopic)
 	b.RegisterTopics(EnableExporterTopic)
 	b.RegisterTopics(DisableExporterTopic)
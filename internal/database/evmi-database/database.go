@@ -80,12 +80,12 @@ func LoadDatabase(dbType DatabaseType, config map[string]string, logger zerolog.
 		return nil, err
 	}
 
-	err = db.AutoMigrate(&EvmBlockchain{})
+	err = db.AutoMigrate(&EvmBlockchain{}, &EvmRpcEndpoint{})
 	if err != nil {
 		return nil, err
 	}
 
-	err = db.AutoMigrate(&EvmJsonAbi{})
+	err = db.AutoMigrate(&EvmJsonAbi{}, &EvmImplementationAbi{}, &EvmEventSignature{})
 	if err != nil {
 		return nil, err
 	}
@@ -105,6 +105,11 @@ func LoadDatabase(dbType DatabaseType, config map[string]string, logger zerolog.
 		return nil, err
 	}
 
+	err = db.AutoMigrate(&EvmProxyImplementation{}, &EvmLogSourceRedecode{})
+	if err != nil {
+		return nil, err
+	}
+
 	err = db.AutoMigrate(&EvmFactoryRule{}, &EvmFactoryRuleCondition{})
 	if err != nil {
 		return nil, err
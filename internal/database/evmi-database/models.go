@@ -2,6 +2,7 @@ package evmi_database
 
 import (
 	"database/sql"
+	"encoding/json"
 	"time"
 
 	"github.com/lib/pq"
@@ -79,6 +80,25 @@ const (
 	ContractLogSourceType LogSourceType = "CONTRACT"
 	TopicLogSourceType    LogSourceType = "TOPIC"
 	FactoryLogSourceType  LogSourceType = "FACTORY"
+	// TransactionsLogSourceType sources index the transactions from or to
+	// their addresses, calls emitting no event included, instead of logs.
+	TransactionsLogSourceType LogSourceType = "TRANSACTIONS"
+	// BlocksLogSourceType sources index every block header of their chain.
+	BlocksLogSourceType LogSourceType = "BLOCKS"
+	// TracesLogSourceType sources index the call frames (internal calls, value
+	// transfers, contract creations) from or to their addresses, read from the
+	// node's traces.
+	TracesLogSourceType LogSourceType = "TRACES"
+)
+
+// FactoryRuleTrigger is what a factory rule matches: a decoded creation event
+// (EVENT, the default when empty) or a contract creation found in the
+// factory's traces (TRACE).
+type FactoryRuleTrigger string
+
+const (
+	EventFactoryRuleTrigger FactoryRuleTrigger = "EVENT"
+	TraceFactoryRuleTrigger FactoryRuleTrigger = "TRACE"
 )
 
 type LogSourceStatus string
@@ -87,14 +107,43 @@ const (
 	RunningLogSourceStatus     LogSourceStatus = "RUNNING"
 	LoopbackOffLogSourceStatus LogSourceStatus = "LOOPBACKOFF"
 	StoppedLogSourceStatus     LogSourceStatus = "STOPPED"
+	// CompletedLogSourceStatus is a bounded source that indexed its last block.
+	CompletedLogSourceStatus LogSourceStatus = "COMPLETED"
+)
+
+// ChainHeadMode selects the block a chain's sources index up to. An empty mode
+// is LATEST.
+type ChainHeadMode string
+
+const (
+	// LatestChainHeadMode follows eth_blockNumber.
+	LatestChainHeadMode ChainHeadMode = "LATEST"
+	// ConfirmationsChainHeadMode follows the latest block minus HeadConfirmations.
+	ConfirmationsChainHeadMode ChainHeadMode = "CONFIRMATIONS"
+	// SafeChainHeadMode follows the "safe" block tag.
+	SafeChainHeadMode ChainHeadMode = "SAFE"
+	// FinalizedChainHeadMode follows the "finalized" block tag.
+	FinalizedChainHeadMode ChainHeadMode = "FINALIZED"
 )
 
+// Valid reports whether m is a known head mode (or empty, meaning LATEST).
+func (m ChainHeadMode) Valid() bool {
+	switch m {
+	case "", LatestChainHeadMode, ConfirmationsChainHeadMode, SafeChainHeadMode, FinalizedChainHeadMode:
+		return true
+	}
+	return false
+}
+
 type ExporterStatus string
 
 const (
 	RunningExporterStatus ExporterStatus = "RUNNING"
 	StoppedExporterStatus ExporterStatus = "STOPPED"
 	FailedExporterStatus  ExporterStatus = "FAILED"
+	// CompletedExporterStatus is an exporter that delivered every log of its
+	// pipeline's sources, all of them completed.
+	CompletedExporterStatus ExporterStatus = "COMPLETED"
 )
 
 type PluginStatus string
@@ -154,9 +203,76 @@ type EvmBlockchain struct {
 	BlockSlice      uint64
 	PullInterval    uint64
 	RpcMaxBatchSize uint64
+	// LogsMaxAddresses is the most addresses per eth_getLogs call when the
+	// ranges of several sources are batched; 0 uses the indexer default.
+	LogsMaxAddresses uint64
+
+	// HeadMode is one of ChainHeadMode; HeadConfirmations is the depth used by
+	// CONFIRMATIONS.
+	HeadMode          string
+	HeadConfirmations uint64
 
 	SqdGatewayAvailable bool
 	SqdGatewayUrl       string
+
+	// WsUrl, when set, is a WebSocket endpoint whose newHeads subscription (or,
+	// with WsSubscribeLogs, each source's logs subscription) triggers indexing
+	// instead of polling every PullInterval.
+	WsUrl           string
+	WsSubscribeLogs bool
+
+	// TraceFilterAvailable selects trace_filter (Erigon, Nethermind, Reth) to
+	// read the traces of TRACES sources and trace factory rules; otherwise
+	// every block is traced with debug_traceBlockByNumber and the callTracer.
+	TraceFilterAvailable bool
+
+	// RpcRateLimit caps the chain's JSON-RPC calls, over all of its endpoints
+	// and the sources of an instance, to this many requests per second, or
+	// compute units when RpcMethodCosts weighs the methods. 0 is unlimited.
+	// RpcMethodCosts is a JSON object of method -> compute units; methods not
+	// listed cost 1. See MethodCosts.
+	RpcRateLimit   float64
+	RpcMethodCosts datatypes.JSON
+
+	// RpcEndpoints are the chain's JSON-RPC nodes; calls are spread over the
+	// healthy ones by weight. A chain without any falls back to RpcUrl.
+	RpcEndpoints []EvmRpcEndpoint `gorm:"foreignKey:EvmBlockchainID"`
+}
+
+// MethodCosts returns the compute-unit cost of the methods RpcMethodCosts
+// lists; an unset or invalid value lists none.
+func (b EvmBlockchain) MethodCosts() map[string]uint64 {
+	var costs map[string]uint64
+	if len(b.RpcMethodCosts) > 0 {
+		_ = json.Unmarshal(b.RpcMethodCosts, &costs)
+	}
+	return costs
+}
+
+// SetMethodCosts sets the compute-unit cost of methods.
+func (b *EvmBlockchain) SetMethodCosts(costs map[string]uint64) {
+	b.RpcMethodCosts = nil
+	if len(costs) > 0 {
+		b.RpcMethodCosts, _ = json.Marshal(costs)
+	}
+}
+
+// EvmRpcEndpoint is one JSON-RPC node of a blockchain. Url and Weight are
+// configuration; the remaining columns are the health last observed by the
+// indexers (not written by the API).
+type EvmRpcEndpoint struct {
+	gorm.Model
+
+	EvmBlockchainID uint `gorm:"index"`
+	Url             string
+	Weight          uint64
+
+	Healthy   bool
+	HeadBlock uint64
+	LatencyMs uint64
+	ErrorRate float64
+	LastError string
+	CheckedAt *time.Time
 }
 
 type EvmJsonAbi struct {
@@ -166,6 +282,86 @@ type EvmJsonAbi struct {
 	Content      string
 }
 
+// ProxyType is the upgradeability pattern of a proxy source, as detected by
+// the indexer.
+type ProxyType string
+
+const (
+	// Eip1967ProxyType stores its implementation in the EIP-1967 slot.
+	Eip1967ProxyType ProxyType = "EIP1967"
+	// Eip1822ProxyType (UUPS) stores it in the EIP-1822 PROXIABLE slot.
+	Eip1822ProxyType ProxyType = "EIP1822"
+	// BeaconProxyType reads it from the beacon in the EIP-1967 beacon slot.
+	BeaconProxyType ProxyType = "BEACON"
+)
+
+// EvmImplementationAbi maps an implementation contract of a blockchain to its
+// ABI: the logs and calldata of the proxies delegating to it are decoded with
+// that ABI combined with the proxy source's own. Address is checksummed.
+type EvmImplementationAbi struct {
+	gorm.Model
+
+	EvmBlockchainID uint   `gorm:"index"`
+	Address         string `gorm:"index"`
+	EvmJsonAbiID    uint
+}
+
+// EvmProxyImplementation is one entry of a proxy source's implementation
+// history: from the log at (FromBlock, FromLogIndex) on, the source delegates
+// to Implementation, through Beacon for BEACON proxies. Entries are recorded
+// by the indexer (from block 0 for the storage slots read when the source
+// starts, from Upgraded and BeaconUpgraded events while indexing) and dropped
+// with the blocks they were read from on reorgs.
+type EvmProxyImplementation struct {
+	gorm.Model
+
+	EvmLogSourceID uint `gorm:"index"`
+	ProxyType      string
+	Beacon         string
+	Implementation string
+	FromBlock      uint64
+	FromLogIndex   uint64
+}
+
+// EvmEventSignature is an event imported into the signature registry, along
+// with the events of every EvmJsonAbi. FULL and TOPIC sources decode the logs
+// their ABIs do not declare with the registry event of the log's topic0 and
+// topic count. Signature is human-readable with its indexed arguments, as
+// written by types.FormatEventSignature; IndexedCount is their number.
+type EvmEventSignature struct {
+	gorm.Model
+
+	Topic0       string `gorm:"index"`
+	IndexedCount uint
+	Signature    string
+}
+
+type RedecodeStatus string
+
+const (
+	RunningRedecodeStatus RedecodeStatus = "RUNNING"
+	DoneRedecodeStatus    RedecodeStatus = "DONE"
+	FailedRedecodeStatus  RedecodeStatus = "FAILED"
+)
+
+// EvmLogSourceRedecode is the re-decode job of a source: the indexer owning the
+// source streams its stored logs from FromBlock to ToBlock (the source's
+// SyncBlock when the job started), decodes them again with the current ABIs
+// and rewrites their metadata in the store. NextBlock and Logs are the
+// progress; a RUNNING job resumes from NextBlock when its indexer restarts.
+// A source has at most one job, the last one started.
+type EvmLogSourceRedecode struct {
+	gorm.Model
+
+	EvmLogSourceID uint `gorm:"uniqueIndex"`
+	Status         string
+	FromBlock      uint64
+	NextBlock      uint64
+	ToBlock        uint64
+	Logs           uint64
+	Error          string
+}
+
 type EvmLogStore struct {
 	gorm.Model
 
@@ -198,12 +394,36 @@ type EvmLogSource struct {
 
 	StartBlock uint64
 	SyncBlock  uint64
-
-	// Contract type data
-	Address sql.NullString
-
-	// Topic type data
+	// EndBlock bounds the source to [StartBlock, EndBlock]: once it is indexed
+	// the source is COMPLETED. 0 indexes forever.
+	EndBlock uint64
+	// CatchUpWorkers is the number of ranges fetched concurrently while the
+	// source is far behind the head. 0 and 1 index one range at a time.
+	CatchUpWorkers uint
+
+	// LastError is the last error the source's indexer failed with, at
+	// LastErrorAt, while indexing [LastErrorFromBlock, LastErrorToBlock] (both
+	// 0 when it failed outside a range). ConsecutiveFailures counts the
+	// failures since the last range indexed: while it is not 0 the source is
+	// in LOOPBACKOFF, restarted after an exponential backoff.
+	LastError           string
+	LastErrorAt         *time.Time
+	LastErrorFromBlock  uint64
+	LastErrorToBlock    uint64
+	ConsecutiveFailures uint
+
+	// Contract type data. TRANSACTIONS sources match any of Addresses,
+	// Address holding its first one; see AddressValues.
+	Address   sql.NullString
+	Addresses pq.StringArray `gorm:"type:text[]"`
+	// FunctionSelector optionally restricts a TRANSACTIONS source to the
+	// calls of one function (0x-prefixed 4-byte selector).
+	FunctionSelector string
+
+	// Topic type data. Topic0s is the OR-list of topic0 values the source
+	// matches, Topic0 holding its first one; see Topic0Values.
 	Topic0       sql.NullString
+	Topic0s      pq.StringArray `gorm:"type:text[]"`
 	TopicFilters pq.StringArray `gorm:"type:text[]"`
 
 	// Factory rules (Type == FACTORY). A FACTORY source has N creation rules
@@ -220,8 +440,81 @@ type EvmLogSource struct {
 	ParentSourceID uint `gorm:"index"`
 
 	EvmLogPipelineID uint
-	EvmJsonAbiID     uint
-	EvmBlockchainID  uint
+	// EvmJsonAbiIDs is the set of ABIs the source's logs and calldata are
+	// decoded against, EvmJsonAbiID holding its first one; see AbiIDs.
+	EvmJsonAbiID    uint
+	EvmJsonAbiIDs   pq.Int64Array `gorm:"type:bigint[]"`
+	EvmBlockchainID uint
+}
+
+// Topic0Values returns the topic0 values the source matches, any of them.
+// Sources created before Topic0s only have Topic0.
+func (s EvmLogSource) Topic0Values() []string {
+	if len(s.Topic0s) > 0 {
+		return s.Topic0s
+	}
+	if s.Topic0.Valid && s.Topic0.String != "" {
+		return []string{s.Topic0.String}
+	}
+	return nil
+}
+
+// SetTopic0s sets the topic0 OR-list of the source.
+func (s *EvmLogSource) SetTopic0s(values []string) {
+	s.Topic0, s.Topic0s = sql.NullString{}, nil
+	if len(values) > 0 {
+		s.Topic0 = sql.NullString{String: values[0], Valid: true}
+		s.Topic0s = pq.StringArray(values)
+	}
+}
+
+// AddressValues returns the addresses the source matches, any of them.
+// Sources created before Addresses only have Address.
+func (s EvmLogSource) AddressValues() []string {
+	if len(s.Addresses) > 0 {
+		return s.Addresses
+	}
+	if s.Address.Valid && s.Address.String != "" {
+		return []string{s.Address.String}
+	}
+	return nil
+}
+
+// SetAddresses sets the addresses the source matches.
+func (s *EvmLogSource) SetAddresses(values []string) {
+	s.Address, s.Addresses = sql.NullString{}, nil
+	if len(values) > 0 {
+		s.Address = sql.NullString{String: values[0], Valid: true}
+		s.Addresses = pq.StringArray(values)
+	}
+}
+
+// AbiIDs returns the ABIs the source decodes with, the first one first.
+// Sources created before EvmJsonAbiIDs only have EvmJsonAbiID.
+func (s EvmLogSource) AbiIDs() []uint {
+	if len(s.EvmJsonAbiIDs) > 0 {
+		ids := make([]uint, len(s.EvmJsonAbiIDs))
+		for i, id := range s.EvmJsonAbiIDs {
+			ids[i] = uint(id)
+		}
+		return ids
+	}
+	if s.EvmJsonAbiID != 0 {
+		return []uint{s.EvmJsonAbiID}
+	}
+	return nil
+}
+
+// SetAbiIDs sets the ABIs the source decodes with.
+func (s *EvmLogSource) SetAbiIDs(ids []uint) {
+	s.EvmJsonAbiID, s.EvmJsonAbiIDs = 0, nil
+	if len(ids) > 0 {
+		s.EvmJsonAbiID = ids[0]
+		s.EvmJsonAbiIDs = make(pq.Int64Array, len(ids))
+		for i, id := range ids {
+			s.EvmJsonAbiIDs[i] = int64(id)
+		}
+	}
 }
 
 // EvmFactoryRule is one creation rule of a FACTORY source: "when CreationFunctionName
@@ -240,6 +533,11 @@ type EvmFactoryRule struct {
 	EvmLogSourceID *uint `gorm:"index"`
 	ParentRuleID   *uint `gorm:"index"`
 
+	// Trigger is one of FactoryRuleTrigger. A TRACE rule ignores
+	// CreationFunctionName and CreationAddressLogArg: it matches the contracts
+	// the factory creates (CREATE or CREATE2 from its address) and its
+	// conditions apply to the creation's from, address, value and type.
+	Trigger               string
 	CreationFunctionName  string
 	CreationAddressLogArg string
 	// ChildType is the spawned source's type: CONTRACT or FACTORY.
@@ -301,3 +599,17 @@ type EvmiExporter struct {
 
 	ChainSyncStatus datatypes.JSON
 }
+
+// RollbackCursor returns the SyncBlock the exporter resumes from once the logs
+// of fromBlock and above are rolled back, never below StartBlock-1, and
+// whether its cursor is past it.
+func (e EvmiExporter) RollbackCursor(fromBlock uint64) (uint64, bool) {
+	rewind := uint64(0)
+	if fromBlock > 0 {
+		rewind = fromBlock - 1
+	}
+	if e.StartBlock > 0 && rewind < e.StartBlock-1 {
+		rewind = e.StartBlock - 1
+	}
+	return rewind, e.SyncBlock > rewind || (e.SyncBlock == rewind && e.SyncLogIndex >= 0)
+}
@@ -0,0 +1,240 @@
+package clickhouse_store
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/evmi-cloud/go-evm-indexer/internal/database/log-stores/eventtables"
+	"github.com/evmi-cloud/go-evm-indexer/internal/types"
+)
+
+// eventTableColumns are the standard columns of an event table, in
+// createEventTableTemplate order.
+var eventTableColumns = []string{
+	"id", "source_id", "chain_id", "address", "block_number", "block_timestamp", "block_hash",
+	"transaction_hash", "transaction_index", "transaction_from", "log_index",
+}
+
+func (db *ClickHouseStore) registryTableName() string {
+	return db.logTableName + "_event_tables"
+}
+
+// EnsureEventTables creates or migrates the tables of contractName's events
+// and routes their logs there from then on.
+func (db *ClickHouseStore) EnsureEventTables(contractName string, events []types.EvmEntrySchema) error {
+	if !db.eventTables {
+		return nil
+	}
+	for _, table := range eventtables.Tables(contractName, events) {
+		if err := db.ensureEventTable(&table); err != nil {
+			return fmt.Errorf("clickhouse store: event table %s: %w", table.Name, err)
+		}
+		db.tables[table.Key()] = table
+	}
+	return nil
+}
+
+func (db *ClickHouseStore) ensureEventTable(table *eventtables.Table) error {
+	ctx := context.Background()
+
+	var entries []struct {
+		Columns map[string]string `ch:"columns"`
+	}
+	if err := db.store.Select(ctx, &entries, fmt.Sprintf("SELECT columns FROM %s FINAL WHERE name = ?", db.registryTableName()), table.Name); err != nil {
+		return err
+	}
+	known := map[string]string{}
+	if len(entries) > 0 {
+		known = entries[0].Columns
+	}
+
+	if err := db.store.Exec(ctx, fmt.Sprintf(createEventTableTemplate, table.Name)); err != nil {
+		return err
+	}
+	for _, column := range eventtables.Evolve(table, known) {
+		if err := db.store.Exec(ctx, fmt.Sprintf(addEventColumnTemplate, table.Name, column.Name, eventColumnType(column))); err != nil {
+			return err
+		}
+	}
+
+	batch, err := db.store.PrepareBatch(ctx, fmt.Sprintf("INSERT INTO %s (name, contract_name, event_name, columns)", db.registryTableName()))
+	if err != nil {
+		return err
+	}
+	if err := batch.Append(table.Name, table.ContractName, table.EventName, known); err != nil {
+		return err
+	}
+	return batch.Send()
+}
+
+// eventColumnType is the column type of an argument: (U)Int64 for integers
+// up to 64 bits, (U)Int256 for wider ones, arrays of those, and JSON strings
+// for tuples and nested arrays.
+func eventColumnType(column eventtables.Column) string {
+	switch column.Kind {
+	case eventtables.Uint:
+		return integerType("UInt", column.Bits)
+	case eventtables.Int:
+		return integerType("Int", column.Bits)
+	case eventtables.Bool:
+		return "Bool"
+	case eventtables.UintArray:
+		return "Array(" + integerType("UInt", column.Bits) + ")"
+	case eventtables.IntArray:
+		return "Array(" + integerType("Int", column.Bits) + ")"
+	case eventtables.BoolArray:
+		return "Array(Bool)"
+	case eventtables.StringArray:
+		return "Array(String)"
+	}
+	return "String"
+}
+
+func integerType(prefix string, bits int) string {
+	if bits <= 64 {
+		return prefix + "64"
+	}
+	return prefix + "256"
+}
+
+// eventColumnValue is the argument in the Go type the driver takes for
+// eventColumnType. Columns are not nullable: missing arguments are zero.
+func eventColumnValue(column eventtables.Column, data map[string]string) any {
+	value, ok := column.Value(data)
+	small := column.Bits <= 64
+
+	switch column.Kind {
+	case eventtables.Uint, eventtables.Int:
+		n, _ := value.(*big.Int)
+		if n == nil {
+			n = new(big.Int)
+		}
+		switch {
+		case !small:
+			return n
+		case column.Kind == eventtables.Uint:
+			return n.Uint64()
+		default:
+			return n.Int64()
+		}
+	case eventtables.UintArray, eventtables.IntArray:
+		numbers, _ := value.([]*big.Int)
+		if !small {
+			if numbers == nil {
+				numbers = []*big.Int{}
+			}
+			return numbers
+		}
+		if column.Kind == eventtables.UintArray {
+			out := make([]uint64, len(numbers))
+			for i, n := range numbers {
+				out[i] = n.Uint64()
+			}
+			return out
+		}
+		out := make([]int64, len(numbers))
+		for i, n := range numbers {
+			out[i] = n.Int64()
+		}
+		return out
+	case eventtables.Bool:
+		b, _ := value.(bool)
+		return b
+	case eventtables.BoolArray:
+		items, _ := value.([]bool)
+		if items == nil {
+			items = []bool{}
+		}
+		return items
+	case eventtables.StringArray:
+		items, _ := value.([]string)
+		if items == nil {
+			items = []string{}
+		}
+		return items
+	}
+	if !ok {
+		return ""
+	}
+	return value
+}
+
+// insertEventLogs writes the logs of events with a table to it.
+func (db *ClickHouseStore) insertEventLogs(logs []types.EvmLog) error {
+	tables := map[string]eventtables.Table{}
+	byTable := map[string][]types.EvmLog{}
+	for _, l := range logs {
+		key, ok := eventtables.KeyOf(l.Metadata)
+		if !ok {
+			continue
+		}
+		if table, ok := db.tables[key]; ok {
+			tables[table.Name] = table
+			byTable[table.Name] = append(byTable[table.Name], l)
+		}
+	}
+
+	for name, tableLogs := range byTable {
+		if err := db.insertEventTable(tables[name], tableLogs); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (db *ClickHouseStore) insertEventTable(table eventtables.Table, logs []types.EvmLog) error {
+	columns := append([]string{}, eventTableColumns...)
+	for _, column := range table.Columns {
+		columns = append(columns, "`"+column.Name+"`")
+	}
+	batch, err := db.store.PrepareBatch(context.Background(), fmt.Sprintf("INSERT INTO %s (%s)", table.Name, strings.Join(columns, ", ")))
+	if err != nil {
+		return err
+	}
+
+	for _, l := range logs {
+		row := []any{
+			l.Id, uint32(l.SourceId), uint32(l.ChainId), l.Address, l.BlockNumber, l.BlockTimestamp, l.BlockHash,
+			l.TransactionHash, uint32(l.TransactionIndex), l.TransactionFrom, uint32(l.LogIndex),
+		}
+		for _, column := range table.Columns {
+			row = append(row, eventColumnValue(column, l.Metadata.Data))
+		}
+		if err := batch.Append(row...); err != nil {
+			return err
+		}
+	}
+	return batch.Send()
+}
+
+// deleteEventLogs deletes the rows matching where from the registered event
+// tables of contractNames, or of every contract when contractNames is empty.
+// It does nothing when event tables are disabled in this store. Deletes are
+// lightweight (DELETE FROM): the rows are masked at once and purged by later
+// merges, instead of the parts being rewritten.
+func (db *ClickHouseStore) deleteEventLogs(contractNames []string, where string) error {
+	if !db.eventTables {
+		return nil
+	}
+	ctx := context.Background()
+	query := fmt.Sprintf("SELECT name FROM %s FINAL", db.registryTableName())
+	var args []any
+	if len(contractNames) > 0 {
+		query += " WHERE contract_name IN (?)"
+		args = append(args, contractNames)
+	}
+	var entries []struct {
+		Name string `ch:"name"`
+	}
+	if err := db.store.Select(ctx, &entries, query, args...); err != nil {
+		return err
+	}
+	for _, entry := range entries {
+		if err := db.store.Exec(ctx, fmt.Sprintf("DELETE FROM %s WHERE %s", entry.Name, where)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
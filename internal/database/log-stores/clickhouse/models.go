@@ -2,11 +2,15 @@ package clickhouse_store
 
 import (
 	"math/big"
+
+	"github.com/evmi-cloud/go-evm-indexer/internal/types"
 )
 
 const (
 	LogCollectionName         string = "logs"
 	TransactionCollectionName string = "transactions"
+	BlockCollectionName       string = "blocks"
+	TraceCollectionName       string = "traces"
 )
 
 type ClickHouseEvmMetadata struct {
@@ -16,6 +20,32 @@ type ClickHouseEvmMetadata struct {
 	Data         map[string]string `ch:"data"`
 }
 
+// ClickHouseEvmArgs are the decoded arguments typed after their ABI schema
+// (see types.EvmMetadata.TypedArgs), by argument name. They are written for
+// queries and not read back.
+type ClickHouseEvmArgs struct {
+	ArgsUint         map[string]*big.Int   `ch:"args_uint"`
+	ArgsInt          map[string]*big.Int   `ch:"args_int"`
+	ArgsBool         map[string]bool       `ch:"args_bool"`
+	ArgsAddress      map[string]string     `ch:"args_address"`
+	ArgsUintArray    map[string][]*big.Int `ch:"args_uint_array"`
+	ArgsIntArray     map[string][]*big.Int `ch:"args_int_array"`
+	ArgsAddressArray map[string][]string   `ch:"args_address_array"`
+}
+
+func toClickHouseArgs(m types.EvmMetadata) ClickHouseEvmArgs {
+	typed := m.TypedArgs()
+	return ClickHouseEvmArgs{
+		ArgsUint:         typed.Uint,
+		ArgsInt:          typed.Int,
+		ArgsBool:         typed.Bool,
+		ArgsAddress:      typed.Address,
+		ArgsUintArray:    typed.UintArray,
+		ArgsIntArray:     typed.IntArray,
+		ArgsAddressArray: typed.AddressArray,
+	}
+}
+
 // Field widths must match the DDL in templates.go exactly: the driver refuses
 // to scan a UInt32 column into a wider Go integer.
 type ClickHouseEvmLog struct {
@@ -35,6 +65,7 @@ type ClickHouseEvmLog struct {
 	Removed          bool     `ch:"removed"`
 
 	Metadata ClickHouseEvmMetadata `ch:"metadata"`
+	ClickHouseEvmArgs
 }
 
 type ClickHouseEvmTransaction struct {
@@ -51,5 +82,53 @@ type ClickHouseEvmTransaction struct {
 	To               string   `ch:"to"`
 	Hash             string   `ch:"hash"`
 
+	Type                uint8    `ch:"type"`
+	Status              uint8    `ch:"status"`
+	GasUsed             uint64   `ch:"gas_used"`
+	EffectiveGasPrice   *big.Int `ch:"effective_gas_price"`
+	ContractAddress     string   `ch:"contract_address"`
+	BlobGasUsed         uint64   `ch:"blob_gas_used"`
+	BlobGasPrice        *big.Int `ch:"blob_gas_price"`
+	BlobVersionedHashes []string `ch:"blob_versioned_hashes"`
+
 	Metadata ClickHouseEvmMetadata `ch:"metadata"`
+	ClickHouseEvmArgs
+}
+
+type ClickHouseEvmBlock struct {
+	Id               string   `ch:"id"`
+	SourceId         uint32   `ch:"source_id"`
+	ChainId          uint32   `ch:"chain_id"`
+	BlockNumber      uint64   `ch:"block_number"`
+	BlockTimestamp   uint64   `ch:"block_timestamp"`
+	Hash             string   `ch:"hash"`
+	ParentHash       string   `ch:"parent_hash"`
+	Miner            string   `ch:"miner"`
+	GasUsed          uint64   `ch:"gas_used"`
+	GasLimit         uint64   `ch:"gas_limit"`
+	BaseFeePerGas    *big.Int `ch:"base_fee_per_gas"`
+	BlobGasUsed      uint64   `ch:"blob_gas_used"`
+	ExcessBlobGas    uint64   `ch:"excess_blob_gas"`
+	TransactionCount uint32   `ch:"transaction_count"`
+	LogsBloom        string   `ch:"logs_bloom"`
+}
+
+type ClickHouseEvmTrace struct {
+	Id               string   `ch:"id"`
+	SourceId         uint32   `ch:"source_id"`
+	ChainId          uint32   `ch:"chain_id"`
+	BlockNumber      uint64   `ch:"block_number"`
+	BlockTimestamp   uint64   `ch:"block_timestamp"`
+	TransactionHash  string   `ch:"transaction_hash"`
+	TransactionIndex uint64   `ch:"transaction_index"`
+	TraceAddress     string   `ch:"trace_address"`
+	Type             string   `ch:"type"`
+	From             string   `ch:"from"`
+	To               string   `ch:"to"`
+	Value            *big.Int `ch:"value"`
+	Input            string   `ch:"input"`
+	Output           string   `ch:"output"`
+	Gas              uint64   `ch:"gas"`
+	GasUsed          uint64   `ch:"gas_used"`
+	Error            string   `ch:"error"`
 }
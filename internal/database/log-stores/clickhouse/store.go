@@ -9,6 +9,7 @@ import (
 
 	"github.com/ClickHouse/clickhouse-go/v2"
 	"github.com/ClickHouse/clickhouse-go/v2/lib/driver"
+	"github.com/evmi-cloud/go-evm-indexer/internal/database/log-stores/eventtables"
 	"github.com/evmi-cloud/go-evm-indexer/internal/types"
 	"github.com/rs/zerolog"
 )
@@ -19,6 +20,14 @@ type ClickHouseStore struct {
 
 	logTableName string
 	txTableName  string
+	// blockTableName defaults to "<logsTableName>_blocks" for configurations
+	// predating the blocks table.
+	blockTableName string
+	// traceTableName defaults to "<logsTableName>_traces" likewise.
+	traceTableName string
+
+	eventTables bool
+	tables      map[eventtables.Key]eventtables.Table
 }
 
 func (db *ClickHouseStore) Init(config map[string]string) error {
@@ -29,6 +38,16 @@ func (db *ClickHouseStore) Init(config map[string]string) error {
 	password := config["password"]
 	db.logTableName = config["logsTableName"]
 	db.txTableName = config["transactionsTableName"]
+	db.blockTableName = config["blocksTableName"]
+	if db.blockTableName == "" {
+		db.blockTableName = db.logTableName + "_blocks"
+	}
+	db.traceTableName = config["tracesTableName"]
+	if db.traceTableName == "" {
+		db.traceTableName = db.logTableName + "_traces"
+	}
+	db.eventTables = eventtables.Enabled(config)
+	db.tables = map[eventtables.Key]eventtables.Table{}
 
 	ctx := context.Background()
 	conn, err := clickhouse.Open(&clickhouse.Options{
@@ -80,6 +99,33 @@ func (db *ClickHouseStore) Init(config map[string]string) error {
 		return err
 	}
 
+	err = db.store.Exec(ctx, fmt.Sprintf(addTransactionsReceiptColumnsTemplate, db.txTableName))
+	if err != nil {
+		return err
+	}
+
+	err = db.store.Exec(ctx, fmt.Sprintf(createBlocksTableTemplate, db.blockTableName))
+	if err != nil {
+		return err
+	}
+
+	err = db.store.Exec(ctx, fmt.Sprintf(createTracesTableTemplate, db.traceTableName))
+	if err != nil {
+		return err
+	}
+
+	for _, table := range []string{db.logTableName, db.txTableName} {
+		err = db.store.Exec(ctx, fmt.Sprintf(addTypedArgsColumnsTemplate, table))
+		if err != nil {
+			return err
+		}
+	}
+
+	err = db.store.Exec(ctx, fmt.Sprintf(createEventTablesRegistryTemplate, db.registryTableName()))
+	if err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -116,6 +162,7 @@ func (db *ClickHouseStore) InsertLogs(logs []types.EvmLog) error {
 				FunctionName: log.Metadata.FunctionName,
 				Data:         log.Metadata.Data,
 			},
+			ClickHouseEvmArgs: toClickHouseArgs(log.Metadata),
 		}
 
 		err = batch.AppendStruct(l)
@@ -124,7 +171,10 @@ func (db *ClickHouseStore) InsertLogs(logs []types.EvmLog) error {
 		}
 	}
 
-	return batch.Send()
+	if err := batch.Send(); err != nil {
+		return err
+	}
+	return db.insertEventLogs(logs)
 }
 
 func (db *ClickHouseStore) InsertTransactions(txs []types.EvmTransaction) error {
@@ -139,6 +189,14 @@ func (db *ClickHouseStore) InsertTransactions(txs []types.EvmTransaction) error
 		if !ok {
 			return errors.New("bad tx value")
 		}
+		effectiveGasPrice, err := parseWei(tx.EffectiveGasPrice)
+		if err != nil {
+			return err
+		}
+		blobGasPrice, err := parseWei(tx.BlobGasPrice)
+		if err != nil {
+			return err
+		}
 
 		transaction := &ClickHouseEvmTransaction{
 			Id:               tx.Id,
@@ -154,12 +212,22 @@ func (db *ClickHouseStore) InsertTransactions(txs []types.EvmTransaction) error
 			To:               tx.To,
 			Hash:             tx.Hash,
 
+			Type:                uint8(tx.Type),
+			Status:              uint8(tx.Status),
+			GasUsed:             tx.GasUsed,
+			EffectiveGasPrice:   effectiveGasPrice,
+			ContractAddress:     tx.ContractAddress,
+			BlobGasUsed:         tx.BlobGasUsed,
+			BlobGasPrice:        blobGasPrice,
+			BlobVersionedHashes: tx.BlobVersionedHashes,
+
 			Metadata: ClickHouseEvmMetadata{
 				ContractName: tx.Metadata.ContractName,
 				EventName:    tx.Metadata.EventName,
 				FunctionName: tx.Metadata.FunctionName,
 				Data:         tx.Metadata.Data,
 			},
+			ClickHouseEvmArgs: toClickHouseArgs(tx.Metadata),
 		}
 
 		err = batch.AppendStruct(transaction)
@@ -171,15 +239,179 @@ func (db *ClickHouseStore) InsertTransactions(txs []types.EvmTransaction) error
 	return batch.Send()
 }
 
-// DeleteSourceData removes every log and transaction for the source via mutations
-// (ALTER TABLE ... DELETE), which apply across all parts including as-yet-unmerged
-// ReplacingMergeTree duplicates.
+func (db *ClickHouseStore) InsertBlocks(blocks []types.EvmBlock) error {
+
+	batch, err := db.store.PrepareBatch(context.Background(), fmt.Sprintf("INSERT INTO %s", db.blockTableName))
+	if err != nil {
+		return err
+	}
+
+	for _, block := range blocks {
+		baseFee, err := parseWei(block.BaseFeePerGas)
+		if err != nil {
+			return err
+		}
+
+		err = batch.AppendStruct(&ClickHouseEvmBlock{
+			Id:               block.Id,
+			SourceId:         uint32(block.SourceId),
+			ChainId:          uint32(block.ChainId),
+			BlockNumber:      block.BlockNumber,
+			BlockTimestamp:   block.BlockTimestamp,
+			Hash:             block.Hash,
+			ParentHash:       block.ParentHash,
+			Miner:            block.Miner,
+			GasUsed:          block.GasUsed,
+			GasLimit:         block.GasLimit,
+			BaseFeePerGas:    baseFee,
+			BlobGasUsed:      block.BlobGasUsed,
+			ExcessBlobGas:    block.ExcessBlobGas,
+			TransactionCount: uint32(block.TransactionCount),
+			LogsBloom:        block.LogsBloom,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return batch.Send()
+}
+
+func (db *ClickHouseStore) InsertTraces(traces []types.EvmTrace) error {
+
+	batch, err := db.store.PrepareBatch(context.Background(), fmt.Sprintf("INSERT INTO %s", db.traceTableName))
+	if err != nil {
+		return err
+	}
+
+	for _, trace := range traces {
+		value, err := parseWei(trace.Value)
+		if err != nil {
+			return err
+		}
+
+		err = batch.AppendStruct(&ClickHouseEvmTrace{
+			Id:               trace.Id,
+			SourceId:         uint32(trace.SourceId),
+			ChainId:          uint32(trace.ChainId),
+			BlockNumber:      trace.BlockNumber,
+			BlockTimestamp:   trace.BlockTimestamp,
+			TransactionHash:  trace.TransactionHash,
+			TransactionIndex: trace.TransactionIndex,
+			TraceAddress:     trace.TraceAddress,
+			Type:             trace.Type,
+			From:             trace.From,
+			To:               trace.To,
+			Value:            value,
+			Input:            trace.Input,
+			Output:           trace.Output,
+			Gas:              trace.Gas,
+			GasUsed:          trace.GasUsed,
+			Error:            trace.Error,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return batch.Send()
+}
+
+// UpdateLogsMetadata re-inserts the stored logs with their new metadata: the
+// ReplacingMergeTree keeps the last inserted row of each (block_number,
+// log_index) of a source. When event tables are enabled, their rows in the
+// tables of the logs' contracts are deleted first, as the event they decode to
+// may have changed.
+func (db *ClickHouseStore) UpdateLogsMetadata(logs []types.EvmLog) error {
+	if len(logs) == 0 {
+		return nil
+	}
+	ctx := context.Background()
+
+	quoted := make([]string, len(logs))
+	for i, l := range logs {
+		quoted[i] = "'" + strings.ReplaceAll(l.Id, "'", "\\'") + "'"
+	}
+	inClause := strings.Join(quoted, ",")
+
+	var results []struct {
+		Id string `ch:"id"`
+	}
+	if err := db.store.Select(ctx, &results, fmt.Sprintf("SELECT id FROM %s FINAL WHERE id IN (%s)", db.logTableName, inClause)); err != nil {
+		return err
+	}
+	isStored := make(map[string]bool, len(results))
+	for _, r := range results {
+		isStored[r.Id] = true
+	}
+	var stored []types.EvmLog
+	for _, l := range logs {
+		if isStored[l.Id] {
+			stored = append(stored, l)
+		}
+	}
+	if len(stored) == 0 {
+		return nil
+	}
+
+	if db.eventTables {
+		var contractNames []string
+		seen := map[string]bool{}
+		fromBlock, toBlock := stored[0].BlockNumber, stored[0].BlockNumber
+		for _, l := range stored {
+			if name := l.Metadata.ContractName; name != "" && !seen[name] {
+				seen[name] = true
+				contractNames = append(contractNames, name)
+			}
+			fromBlock, toBlock = min(fromBlock, l.BlockNumber), max(toBlock, l.BlockNumber)
+		}
+		if len(contractNames) > 0 {
+			where := fmt.Sprintf("block_number BETWEEN %d AND %d AND id IN (%s)", fromBlock, toBlock, inClause)
+			if err := db.deleteEventLogs(contractNames, where); err != nil {
+				return err
+			}
+		}
+	}
+	return db.InsertLogs(stored)
+}
+
+// DeleteSourceData removes every log, transaction, block and trace for the
+// source via mutations (ALTER TABLE ... DELETE), which apply across all parts
+// including as-yet-unmerged ReplacingMergeTree duplicates. Event tables, when
+// enabled, are cleaned with lightweight deletes.
 func (db *ClickHouseStore) DeleteSourceData(sourceId uint64) error {
 	ctx := context.Background()
-	if err := db.store.Exec(ctx, fmt.Sprintf("ALTER TABLE %s DELETE WHERE source_id = %d", db.logTableName, sourceId)); err != nil {
+	if err := db.deleteEventLogs(nil, fmt.Sprintf("source_id = %d", sourceId)); err != nil {
 		return err
 	}
-	return db.store.Exec(ctx, fmt.Sprintf("ALTER TABLE %s DELETE WHERE source_id = %d", db.txTableName, sourceId))
+	for _, table := range []string{db.logTableName, db.txTableName, db.blockTableName, db.traceTableName} {
+		if err := db.store.Exec(ctx, fmt.Sprintf("ALTER TABLE %s DELETE WHERE source_id = %d", table, sourceId)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// RollbackSourceData removes the source's logs, transactions, blocks and traces
+// at or above fromBlock with the same mutations as DeleteSourceData, narrowed
+// to the block range. The mutations run synchronously (mutations_sync) so the
+// orphaned rows are gone before the range is re-indexed and re-exported.
+func (db *ClickHouseStore) RollbackSourceData(sourceId uint64, fromBlock uint64) error {
+	ctx := context.Background()
+	if err := db.deleteEventLogs(nil, fmt.Sprintf("source_id = %d AND block_number >= %d", sourceId, fromBlock)); err != nil {
+		return err
+	}
+	for _, table := range []string{db.logTableName, db.txTableName, db.blockTableName, db.traceTableName} {
+		if err := db.store.Exec(ctx, fmt.Sprintf("ALTER TABLE %s DELETE WHERE source_id = %d AND block_number >= %d SETTINGS mutations_sync = 1", table, sourceId, fromBlock)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Close closes the store's connections.
+func (db *ClickHouseStore) Close() error {
+	return db.store.Close()
 }
 
 func (db *ClickHouseStore) GetLogsCount() (uint64, error) {
@@ -312,17 +544,27 @@ func (db *ClickHouseStore) GetTransactions(sourceId uint64, fromBlock uint64, to
 	for _, tx := range results {
 
 		txs = append(txs, types.EvmTransaction{
-			Id:             tx.Id,
-			SourceId:       uint(tx.SourceId),
-			BlockNumber:    tx.BlockNumber,
-			BlockTimestamp: tx.BlockTimestamp,
-			ChainId:        uint64(tx.ChainId),
-			From:           tx.From,
-			Data:           tx.Data,
-			Value:          tx.Value.String(),
-			Nonce:          tx.Nonce,
-			To:             tx.To,
-			Hash:           tx.Hash,
+			Id:               tx.Id,
+			SourceId:         uint(tx.SourceId),
+			BlockNumber:      tx.BlockNumber,
+			BlockTimestamp:   tx.BlockTimestamp,
+			TransactionIndex: tx.TransactionIndex,
+			ChainId:          uint64(tx.ChainId),
+			From:             tx.From,
+			Data:             tx.Data,
+			Value:            tx.Value.String(),
+			Nonce:            tx.Nonce,
+			To:               tx.To,
+			Hash:             tx.Hash,
+
+			Type:                uint64(tx.Type),
+			Status:              uint64(tx.Status),
+			GasUsed:             tx.GasUsed,
+			EffectiveGasPrice:   tx.EffectiveGasPrice.String(),
+			ContractAddress:     tx.ContractAddress,
+			BlobGasUsed:         tx.BlobGasUsed,
+			BlobGasPrice:        tx.BlobGasPrice.String(),
+			BlobVersionedHashes: tx.BlobVersionedHashes,
 
 			Metadata: types.EvmMetadata{
 				ContractName: tx.Metadata.ContractName,
@@ -336,6 +578,89 @@ func (db *ClickHouseStore) GetTransactions(sourceId uint64, fromBlock uint64, to
 	return txs, nil
 }
 
+func (db *ClickHouseStore) GetBlocks(sourceId uint64, fromBlock uint64, toBlock uint64) ([]types.EvmBlock, error) {
+
+	var results []ClickHouseEvmBlock
+	if err := db.store.Select(context.Background(), &results, fmt.Sprintf("SELECT * FROM %s FINAL WHERE source_id = %d AND block_number >= %d AND block_number <= %d ORDER BY block_number ASC", db.blockTableName, sourceId, fromBlock, toBlock)); err != nil {
+		return []types.EvmBlock{}, err
+	}
+
+	blocks := []types.EvmBlock{}
+	for _, block := range results {
+		// The base fee is left empty before London, as on insert.
+		baseFee := ""
+		if block.BaseFeePerGas != nil && block.BaseFeePerGas.Sign() > 0 {
+			baseFee = block.BaseFeePerGas.String()
+		}
+		blocks = append(blocks, types.EvmBlock{
+			Id:               block.Id,
+			SourceId:         uint(block.SourceId),
+			ChainId:          uint64(block.ChainId),
+			BlockNumber:      block.BlockNumber,
+			BlockTimestamp:   block.BlockTimestamp,
+			Hash:             block.Hash,
+			ParentHash:       block.ParentHash,
+			Miner:            block.Miner,
+			GasUsed:          block.GasUsed,
+			GasLimit:         block.GasLimit,
+			BaseFeePerGas:    baseFee,
+			BlobGasUsed:      block.BlobGasUsed,
+			ExcessBlobGas:    block.ExcessBlobGas,
+			TransactionCount: uint64(block.TransactionCount),
+			LogsBloom:        block.LogsBloom,
+		})
+	}
+
+	return blocks, nil
+}
+
+func (db *ClickHouseStore) GetTraces(sourceId uint64, fromBlock uint64, toBlock uint64) ([]types.EvmTrace, error) {
+
+	var results []ClickHouseEvmTrace
+	if err := db.store.Select(context.Background(), &results, fmt.Sprintf("SELECT * FROM %s FINAL WHERE source_id = %d AND block_number >= %d AND block_number <= %d ORDER BY block_number ASC, transaction_index ASC, trace_address ASC", db.traceTableName, sourceId, fromBlock, toBlock)); err != nil {
+		return []types.EvmTrace{}, err
+	}
+
+	traces := []types.EvmTrace{}
+	for _, trace := range results {
+		traces = append(traces, types.EvmTrace{
+			Id:               trace.Id,
+			SourceId:         uint(trace.SourceId),
+			ChainId:          uint64(trace.ChainId),
+			BlockNumber:      trace.BlockNumber,
+			BlockTimestamp:   trace.BlockTimestamp,
+			TransactionHash:  trace.TransactionHash,
+			TransactionIndex: trace.TransactionIndex,
+			TraceAddress:     trace.TraceAddress,
+			Type:             trace.Type,
+			From:             trace.From,
+			To:               trace.To,
+			Value:            trace.Value.String(),
+			Input:            trace.Input,
+			Output:           trace.Output,
+			Gas:              trace.Gas,
+			GasUsed:          trace.GasUsed,
+			Error:            trace.Error,
+		})
+	}
+
+	return traces, nil
+}
+
+// parseWei parses a decimal wei amount of a transaction, block or trace. An
+// empty amount, for a transaction built without its receipt or a block before
+// London, is stored as 0.
+func parseWei(v string) (*big.Int, error) {
+	if v == "" {
+		return new(big.Int), nil
+	}
+	wei, ok := new(big.Int).SetString(v, 10)
+	if !ok {
+		return nil, fmt.Errorf("bad wei amount %q", v)
+	}
+	return wei, nil
+}
+
 func NewClickHouseStore(logger zerolog.Logger) (*ClickHouseStore, error) {
 	return &ClickHouseStore{
 		logger: logger,
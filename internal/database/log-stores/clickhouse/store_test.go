@@ -27,6 +27,7 @@ func TestClickHouseStore(t *testing.T) {
 		"password":              os.Getenv("CLICKHOUSE_PASSWORD"),
 		"logsTableName":         "evmi_test_logs",
 		"transactionsTableName": "evmi_test_transactions",
+		"eventTables":           "true",
 	}
 	s, _ := NewClickHouseStore(zerolog.Nop())
 	if err := s.Init(cfg); err != nil {
@@ -35,6 +36,8 @@ func TestClickHouseStore(t *testing.T) {
 	defer func() {
 		_ = s.store.Exec(ctx, "DROP TABLE IF EXISTS evmi_test_logs")
 		_ = s.store.Exec(ctx, "DROP TABLE IF EXISTS evmi_test_transactions")
+		_ = s.store.Exec(ctx, "DROP TABLE IF EXISTS evmi_test_logs_event_tables")
+		_ = s.store.Exec(ctx, "DROP TABLE IF EXISTS c_e")
 	}()
 
 	mk := func(sourceId uint, block, idx uint64) types.EvmLog {
@@ -46,7 +49,20 @@ func TestClickHouseStore(t *testing.T) {
 		}
 	}
 
+	event := types.EvmEntrySchema{Name: "E", Signature: "E(address,uint256,uint8[])", Args: []types.EvmArgSchema{
+		{Name: "to", Type: "address", Indexed: true},
+		{Name: "value", Type: "uint256"},
+		{Name: "ids", Type: "uint8[]"},
+	}}
+	if err := s.EnsureEventTables("C", []types.EvmEntrySchema{event}); err != nil {
+		t.Fatalf("ensure event tables: %v", err)
+	}
+
 	batch := []types.EvmLog{mk(1, 10, 0), mk(1, 10, 1), mk(1, 12, 0), mk(2, 11, 0)}
+	for i := range batch {
+		batch[i].Metadata.Args = event.Args
+		batch[i].Metadata.Data = map[string]string{"k": "v", "to": "0xbb", "value": "7", "ids": `["1","2"]`}
+	}
 	if err := s.InsertLogs(batch); err != nil {
 		t.Fatalf("insert: %v", err)
 	}
@@ -59,6 +75,13 @@ func TestClickHouseStore(t *testing.T) {
 		t.Fatalf("count = %d, err %v (want 4)", c, err)
 	}
 
+	var typed struct {
+		Total uint64 `ch:"total"`
+	}
+	if err := s.store.QueryRow(ctx, "SELECT toUInt64(sum(arg_value) + sum(arraySum(arg_ids))) AS total FROM c_e FINAL").ScanStruct(&typed); err != nil || typed.Total != 40 {
+		t.Errorf("event table total = %d, err %v (want 4 logs of 7+1+2)", typed.Total, err)
+	}
+
 	got, err := s.GetLogs(1, 10, 11)
 	if err != nil {
 		t.Fatal(err)
@@ -114,6 +137,23 @@ func TestClickHouseStore(t *testing.T) {
 	if err != nil || len(txs) != 1 || txs[0].Value != "5" {
 		t.Fatalf("GetTransactions = %+v, err %v (want 1 tx)", txs, err)
 	}
+
+	// Reorg rollback: source 1 keeps only the rows below block 11.
+	if err := s.RollbackSourceData(1, 11); err != nil {
+		t.Fatalf("rollback: %v", err)
+	}
+	if logs, err := s.GetLogs(1, 0, 100); err != nil || len(logs) != 2 || logs[1].BlockNumber != 10 {
+		t.Errorf("GetLogs after rollback = %+v, err %v (want the 2 logs of block 10)", logs, err)
+	}
+	if logs, _ := s.GetLogs(2, 0, 100); len(logs) != 1 {
+		t.Errorf("source 2 logs should remain, got %d", len(logs))
+	}
+	var remaining struct {
+		Count uint64 `ch:"count"`
+	}
+	if err := s.store.QueryRow(ctx, "SELECT count() AS count FROM c_e FINAL").ScanStruct(&remaining); err != nil || remaining.Count != 3 {
+		t.Errorf("event table rows after rollback = %d, err %v (want 3)", remaining.Count, err)
+	}
 }
 
 func orEnv(key, def string) string {
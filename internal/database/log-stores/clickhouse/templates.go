@@ -17,6 +17,13 @@ CREATE TABLE IF NOT EXISTS %s (
     data String CODEC(ZSTD),
     topics Array(String) CODEC(ZSTD),
     metadata JSON CODEC(ZSTD),
+    args_uint Map(String, UInt256) CODEC(ZSTD),
+    args_int Map(String, Int256) CODEC(ZSTD),
+    args_bool Map(String, Bool) CODEC(ZSTD),
+    args_address Map(String, String) CODEC(ZSTD),
+    args_uint_array Map(String, Array(UInt256)) CODEC(ZSTD),
+    args_int_array Map(String, Array(Int256)) CODEC(ZSTD),
+    args_address_array Map(String, Array(String)) CODEC(ZSTD),
 
     index idx_id id type bloom_filter granularity 1,
     index idx_source_id source_id type bloom_filter granularity 1,
@@ -24,7 +31,8 @@ CREATE TABLE IF NOT EXISTS %s (
     index idx_transaction_from transaction_from type bloom_filter granularity 4,
     index idx_transaction_hash transaction_hash type bloom_filter granularity 4,
     index idx_address address type bloom_filter granularity 1,
-    index idx_topics_1 topics[1] type bloom_filter granularity 4
+    index idx_topics_1 topics[1] type bloom_filter granularity 4,
+    index idx_args_address mapValues(args_address) type bloom_filter granularity 4
 )
 engine = ReplacingMergeTree
 partition by source_id
@@ -45,16 +53,159 @@ CREATE TABLE IF NOT EXISTS %s (
     nonce UInt64 CODEC(ZSTD),
     to String CODEC(ZSTD),
     value UInt256 CODEC(ZSTD),
+    type UInt8 CODEC(ZSTD),
+    status UInt8 CODEC(ZSTD),
+    gas_used UInt64 CODEC(ZSTD),
+    effective_gas_price UInt256 CODEC(ZSTD),
+    contract_address String CODEC(ZSTD),
+    blob_gas_used UInt64 CODEC(ZSTD),
+    blob_gas_price UInt256 CODEC(ZSTD),
+    blob_versioned_hashes Array(String) CODEC(ZSTD),
 
     metadata JSON CODEC(ZSTD),
+    args_uint Map(String, UInt256) CODEC(ZSTD),
+    args_int Map(String, Int256) CODEC(ZSTD),
+    args_bool Map(String, Bool) CODEC(ZSTD),
+    args_address Map(String, String) CODEC(ZSTD),
+    args_uint_array Map(String, Array(UInt256)) CODEC(ZSTD),
+    args_int_array Map(String, Array(Int256)) CODEC(ZSTD),
+    args_address_array Map(String, Array(String)) CODEC(ZSTD),
 
     index idx_source_id source_id type bloom_filter granularity 1,
     index idx_block_number block_number type minmax granularity 4,
     index idx_transaction_index transaction_index type minmax granularity 4,
     index idx_from from type bloom_filter granularity 4,
     index idx_hash hash type bloom_filter granularity 4,
+    index idx_args_address mapValues(args_address) type bloom_filter granularity 4,
 )
 engine = ReplacingMergeTree
 partition by source_id
 order by (block_number, transaction_index)
 `
+
+var createBlocksTableTemplate = `
+CREATE TABLE IF NOT EXISTS %s (
+    id String CODEC(ZSTD),
+    source_id UInt32 CODEC(ZSTD),
+    chain_id UInt32 CODEC(ZSTD),
+    block_number UInt64 CODEC(ZSTD),
+    block_timestamp UInt64 CODEC(ZSTD),
+    hash String CODEC(ZSTD),
+    parent_hash String CODEC(ZSTD),
+    miner String CODEC(ZSTD),
+    gas_used UInt64 CODEC(ZSTD),
+    gas_limit UInt64 CODEC(ZSTD),
+    base_fee_per_gas UInt256 CODEC(ZSTD),
+    blob_gas_used UInt64 CODEC(ZSTD),
+    excess_blob_gas UInt64 CODEC(ZSTD),
+    transaction_count UInt32 CODEC(ZSTD),
+    logs_bloom String CODEC(ZSTD),
+
+    index idx_source_id source_id type bloom_filter granularity 1,
+    index idx_hash hash type bloom_filter granularity 4,
+    index idx_miner miner type bloom_filter granularity 4
+)
+engine = ReplacingMergeTree
+partition by source_id
+order by block_number
+`
+
+var createTracesTableTemplate = `
+CREATE TABLE IF NOT EXISTS %s (
+    id String CODEC(ZSTD),
+    source_id UInt32 CODEC(ZSTD),
+    chain_id UInt32 CODEC(ZSTD),
+    block_number UInt64 CODEC(ZSTD),
+    block_timestamp UInt64 CODEC(ZSTD),
+    transaction_hash String CODEC(ZSTD),
+    transaction_index UInt64 CODEC(ZSTD),
+    trace_address String CODEC(ZSTD),
+    type LowCardinality(String),
+    from String CODEC(ZSTD),
+    to String CODEC(ZSTD),
+    value UInt256 CODEC(ZSTD),
+    input String CODEC(ZSTD),
+    output String CODEC(ZSTD),
+    gas UInt64 CODEC(ZSTD),
+    gas_used UInt64 CODEC(ZSTD),
+    error String CODEC(ZSTD),
+
+    index idx_source_id source_id type bloom_filter granularity 1,
+    index idx_transaction_hash transaction_hash type bloom_filter granularity 4,
+    index idx_from from type bloom_filter granularity 4,
+    index idx_to to type bloom_filter granularity 4
+)
+engine = ReplacingMergeTree
+partition by source_id
+order by (block_number, transaction_index, trace_address)
+`
+
+// addTransactionsReceiptColumnsTemplate brings a transactions table created
+// before the receipt fields up to date with createTransactionsTableTemplate.
+var addTransactionsReceiptColumnsTemplate = `
+ALTER TABLE %s
+    ADD COLUMN IF NOT EXISTS type UInt8 CODEC(ZSTD) AFTER value,
+    ADD COLUMN IF NOT EXISTS status UInt8 CODEC(ZSTD) AFTER type,
+    ADD COLUMN IF NOT EXISTS gas_used UInt64 CODEC(ZSTD) AFTER status,
+    ADD COLUMN IF NOT EXISTS effective_gas_price UInt256 CODEC(ZSTD) AFTER gas_used,
+    ADD COLUMN IF NOT EXISTS contract_address String CODEC(ZSTD) AFTER effective_gas_price,
+    ADD COLUMN IF NOT EXISTS blob_gas_used UInt64 CODEC(ZSTD) AFTER contract_address,
+    ADD COLUMN IF NOT EXISTS blob_gas_price UInt256 CODEC(ZSTD) AFTER blob_gas_used,
+    ADD COLUMN IF NOT EXISTS blob_versioned_hashes Array(String) CODEC(ZSTD) AFTER blob_gas_price
+`
+
+// addTypedArgsColumnsTemplate brings a logs or transactions table created
+// before the typed argument columns up to date.
+var addTypedArgsColumnsTemplate = `
+ALTER TABLE %s
+    ADD COLUMN IF NOT EXISTS args_uint Map(String, UInt256) CODEC(ZSTD) AFTER metadata,
+    ADD COLUMN IF NOT EXISTS args_int Map(String, Int256) CODEC(ZSTD) AFTER args_uint,
+    ADD COLUMN IF NOT EXISTS args_bool Map(String, Bool) CODEC(ZSTD) AFTER args_int,
+    ADD COLUMN IF NOT EXISTS args_address Map(String, String) CODEC(ZSTD) AFTER args_bool,
+    ADD COLUMN IF NOT EXISTS args_uint_array Map(String, Array(UInt256)) CODEC(ZSTD) AFTER args_address,
+    ADD COLUMN IF NOT EXISTS args_int_array Map(String, Array(Int256)) CODEC(ZSTD) AFTER args_uint_array,
+    ADD COLUMN IF NOT EXISTS args_address_array Map(String, Array(String)) CODEC(ZSTD) AFTER args_int_array,
+    ADD INDEX IF NOT EXISTS idx_args_address mapValues(args_address) type bloom_filter granularity 4
+`
+
+// createEventTablesRegistryTemplate registers the event tables, so deletes and
+// rollbacks reach them and later runs know the types of their argument
+// columns (column name to eventtables.Column.Type).
+var createEventTablesRegistryTemplate = `
+CREATE TABLE IF NOT EXISTS %s (
+    name String,
+    contract_name String,
+    event_name String,
+    columns Map(String, String),
+    updated_at DateTime64(3) DEFAULT now64(3)
+)
+engine = ReplacingMergeTree(updated_at)
+order by name
+`
+
+// createEventTableTemplate is the table of one event with the standard log
+// columns; its arguments are added with addEventColumnTemplate.
+var createEventTableTemplate = `
+CREATE TABLE IF NOT EXISTS %s (
+    id String CODEC(ZSTD),
+    source_id UInt32 CODEC(ZSTD),
+    chain_id UInt32 CODEC(ZSTD),
+    address String CODEC(ZSTD),
+    block_number UInt64 CODEC(ZSTD),
+    block_timestamp UInt64 CODEC(ZSTD),
+    block_hash String CODEC(ZSTD),
+    transaction_hash String CODEC(ZSTD),
+    transaction_index UInt32 CODEC(ZSTD),
+    transaction_from String CODEC(ZSTD),
+    log_index UInt32 CODEC(ZSTD),
+
+    index idx_source_id source_id type bloom_filter granularity 1,
+    index idx_address address type bloom_filter granularity 1,
+    index idx_transaction_hash transaction_hash type bloom_filter granularity 4
+)
+engine = ReplacingMergeTree
+partition by source_id
+order by (block_number, log_index)
+`
+
+var addEventColumnTemplate = "ALTER TABLE %s ADD COLUMN IF NOT EXISTS `%s` %s CODEC(ZSTD)"
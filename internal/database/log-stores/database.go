@@ -2,6 +2,7 @@ package log_stores
 
 import (
 	"errors"
+	"io"
 
 	clickhouse_store "github.com/evmi-cloud/go-evm-indexer/internal/database/log-stores/clickhouse"
 	elasticsearch_store "github.com/evmi-cloud/go-evm-indexer/internal/database/log-stores/elasticsearch"
@@ -19,6 +20,16 @@ func (store *IndexerStore) GetStorage() EvmIndexerStorage {
 	return store.storage
 }
 
+// Close releases the connections of the storage backends holding any (those
+// implementing io.Closer). Stores loaded for a one-off job are closed when it
+// ends.
+func (store *IndexerStore) Close() error {
+	if closer, ok := store.storage.(io.Closer); ok {
+		return closer.Close()
+	}
+	return nil
+}
+
 // NewIndexerStore wraps an existing storage backend. Useful for tests that inject
 // a fake EvmIndexerStorage without going through LoadStore.
 func NewIndexerStore(storage EvmIndexerStorage) *IndexerStore {
@@ -1,7 +1,7 @@
-// Package elasticsearch_store implements the EvmIndexerStorage backend on top of
-// Elasticsearch: logs and transactions are bulk-indexed as documents (keyed by
-// their stable id) and queries are run as bool/range searches sorted by
-// (block_number, log_index).
+// Package elasticsearch_store implements the EvmIndexerStorage backend on top
+// of Elasticsearch: logs, transactions, blocks and traces are bulk-indexed as
+// documents (keyed by their stable id) and queries are run as bool/range
+// searches sorted by (block_number, log_index).
 package elasticsearch_store
 
 import (
@@ -10,6 +10,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"math/big"
+	"net/http"
 	"strings"
 
 	"github.com/elastic/go-elasticsearch/v8"
@@ -21,10 +23,12 @@ import (
 const maxHits = 10000
 
 type ElasticsearchStore struct {
-	logger  zerolog.Logger
-	client  *elasticsearch.Client
-	logsIdx string
-	txIdx   string
+	logger    zerolog.Logger
+	client    *elasticsearch.Client
+	logsIdx   string
+	txIdx     string
+	blocksIdx string
+	tracesIdx string
 }
 
 func NewElasticsearchStore(logger zerolog.Logger) (*ElasticsearchStore, error) {
@@ -49,21 +53,47 @@ func (s *ElasticsearchStore) Init(config map[string]string) error {
 
 	s.logsIdx = orDefault(config["logsIndex"], "evmi_logs")
 	s.txIdx = orDefault(config["transactionsIndex"], "evmi_transactions")
+	s.blocksIdx = orDefault(config["blocksIndex"], "evmi_blocks")
+	s.tracesIdx = orDefault(config["tracesIndex"], "evmi_traces")
 
-	if err := s.ensureIndex(s.logsIdx); err != nil {
-		return err
+	for _, index := range []string{s.logsIdx, s.txIdx, s.blocksIdx, s.tracesIdx} {
+		if err := s.ensureIndex(index); err != nil {
+			return err
+		}
 	}
-	return s.ensureIndex(s.txIdx)
+	return nil
 }
 
 // numericMapping keeps the queried/sorted fields as longs so range, term and
-// sort behave correctly.
+// sort behave correctly. A trace's type is stored as trace_type: type is the
+// numeric transaction type.
 const numericMapping = `{"mappings":{"properties":{
   "source_id":{"type":"long"},"chain_id":{"type":"long"},"block_number":{"type":"long"},"block_timestamp":{"type":"long"},
   "log_index":{"type":"long"},"transaction_index":{"type":"long"},"nonce":{"type":"long"},
+  "type":{"type":"long"},"status":{"type":"long"},"gas_used":{"type":"long"},"blob_gas_used":{"type":"long"},
+  "gas_limit":{"type":"long"},"excess_blob_gas":{"type":"long"},"transaction_count":{"type":"long"},
+  "parent_hash":{"type":"keyword"},"miner":{"type":"keyword"},
+  "gas":{"type":"long"},"trace_type":{"type":"keyword"},"trace_address":{"type":"keyword"},
   "address":{"type":"keyword"},"transaction_hash":{"type":"keyword"},"block_hash":{"type":"keyword"},
-  "hash":{"type":"keyword"},"id":{"type":"keyword"},"topics":{"type":"keyword"}
-}}}`
+  "hash":{"type":"keyword"},"id":{"type":"keyword"},"topics":{"type":"keyword"},
+  "contract_address":{"type":"keyword"},"blob_versioned_hashes":{"type":"keyword"},
+  "metadata":{"properties":{"decoded":{"type":"flattened"}}}
+},` + typedArgsTemplates + `}}`
+
+// typedArgsTemplates map the typed arguments (see esMetadata): integers as
+// doubles, which can be summed and ranged at the cost of precision beyond 2^53
+// (the exact value stays in metadata.data), addresses as keywords.
+const typedArgsTemplates = `"dynamic_templates":[
+  {"metadata_numeric":{"path_match":"metadata.numeric.*","mapping":{"type":"double"}}},
+  {"metadata_address":{"path_match":"metadata.address.*","mapping":{"type":"keyword"}}},
+  {"metadata_bool":{"path_match":"metadata.bool.*","mapping":{"type":"boolean"}}}
+]`
+
+// metadataMapping adds the metadata mappings to an index created before them:
+// left to dynamic mapping, the first document would fix each argument's object
+// layout in metadata.decoded and reject the next event with another one, and
+// typed arguments would be mapped after whatever JSON type they arrive as.
+const metadataMapping = `{"properties":{"metadata":{"properties":{"decoded":{"type":"flattened"}}}},` + typedArgsTemplates + `}`
 
 func (s *ElasticsearchStore) ensureIndex(index string) error {
 	res, err := s.client.Indices.Exists([]string{index})
@@ -72,6 +102,15 @@ func (s *ElasticsearchStore) ensureIndex(index string) error {
 	}
 	res.Body.Close()
 	if res.StatusCode == 200 {
+		put, err := s.client.Indices.PutMapping([]string{index}, strings.NewReader(metadataMapping))
+		if err != nil {
+			return err
+		}
+		defer put.Body.Close()
+		if put.IsError() {
+			body, _ := io.ReadAll(put.Body)
+			return fmt.Errorf("elasticsearch: update mapping of index %s failed: %s", index, string(body))
+		}
 		return nil
 	}
 
@@ -94,6 +133,15 @@ type esMetadata struct {
 	EventName    string            `json:"event_name"`
 	FunctionName string            `json:"function_name"`
 	Data         map[string]string `json:"data"`
+	// Decoded is Data with its tuples and arrays as objects, indexed as a
+	// flattened field (metadata.decoded.<arg>.<field>). It is not read back.
+	Decoded map[string]any `json:"decoded,omitempty"`
+	// Numeric, Address and Bool are the arguments typed after their ABI
+	// schema, integers and integer arrays as decimal strings coerced by the
+	// index mapping. They are not read back either.
+	Numeric map[string]any  `json:"numeric,omitempty"`
+	Address map[string]any  `json:"address,omitempty"`
+	Bool    map[string]bool `json:"bool,omitempty"`
 }
 
 type esLog struct {
@@ -115,23 +163,99 @@ type esLog struct {
 }
 
 type esTx struct {
-	Id               string     `json:"id"`
-	SourceId         uint       `json:"source_id"`
-	BlockNumber      uint64     `json:"block_number"`
-	BlockTimestamp   uint64     `json:"block_timestamp"`
-	TransactionIndex uint64     `json:"transaction_index"`
-	ChainId          uint64     `json:"chain_id"`
-	From             string     `json:"from"`
-	Data             string     `json:"data"`
-	Value            string     `json:"value"`
-	Nonce            uint64     `json:"nonce"`
-	To               string     `json:"to"`
-	Hash             string     `json:"hash"`
-	Metadata         esMetadata `json:"metadata"`
+	Id               string `json:"id"`
+	SourceId         uint   `json:"source_id"`
+	BlockNumber      uint64 `json:"block_number"`
+	BlockTimestamp   uint64 `json:"block_timestamp"`
+	TransactionIndex uint64 `json:"transaction_index"`
+	ChainId          uint64 `json:"chain_id"`
+	From             string `json:"from"`
+	Data             string `json:"data"`
+	Value            string `json:"value"`
+	Nonce            uint64 `json:"nonce"`
+	To               string `json:"to"`
+	Hash             string `json:"hash"`
+	Type             uint64 `json:"type"`
+
+	Status            uint64 `json:"status"`
+	GasUsed           uint64 `json:"gas_used"`
+	EffectiveGasPrice string `json:"effective_gas_price"`
+	ContractAddress   string `json:"contract_address"`
+
+	BlobGasUsed         uint64   `json:"blob_gas_used"`
+	BlobGasPrice        string   `json:"blob_gas_price"`
+	BlobVersionedHashes []string `json:"blob_versioned_hashes"`
+
+	Metadata esMetadata `json:"metadata"`
+}
+
+type esBlock struct {
+	Id               string `json:"id"`
+	SourceId         uint   `json:"source_id"`
+	ChainId          uint64 `json:"chain_id"`
+	BlockNumber      uint64 `json:"block_number"`
+	BlockTimestamp   uint64 `json:"block_timestamp"`
+	Hash             string `json:"hash"`
+	ParentHash       string `json:"parent_hash"`
+	Miner            string `json:"miner"`
+	GasUsed          uint64 `json:"gas_used"`
+	GasLimit         uint64 `json:"gas_limit"`
+	BaseFeePerGas    string `json:"base_fee_per_gas"`
+	BlobGasUsed      uint64 `json:"blob_gas_used"`
+	ExcessBlobGas    uint64 `json:"excess_blob_gas"`
+	TransactionCount uint64 `json:"transaction_count"`
+	LogsBloom        string `json:"logs_bloom"`
+}
+
+type esTrace struct {
+	Id               string `json:"id"`
+	SourceId         uint   `json:"source_id"`
+	ChainId          uint64 `json:"chain_id"`
+	BlockNumber      uint64 `json:"block_number"`
+	BlockTimestamp   uint64 `json:"block_timestamp"`
+	TransactionHash  string `json:"transaction_hash"`
+	TransactionIndex uint64 `json:"transaction_index"`
+	TraceAddress     string `json:"trace_address"`
+	Type             string `json:"trace_type"`
+	From             string `json:"from"`
+	To               string `json:"to"`
+	Value            string `json:"value"`
+	Input            string `json:"input"`
+	Output           string `json:"output"`
+	Gas              uint64 `json:"gas"`
+	GasUsed          uint64 `json:"gas_used"`
+	Error            string `json:"error"`
 }
 
 func toEsMetadata(m types.EvmMetadata) esMetadata {
-	return esMetadata{ContractName: m.ContractName, EventName: m.EventName, FunctionName: m.FunctionName, Data: m.Data}
+	typed := m.TypedArgs()
+	numeric := map[string]any{}
+	for _, integers := range []map[string]*big.Int{typed.Uint, typed.Int} {
+		for name, n := range integers {
+			numeric[name] = n.String()
+		}
+	}
+	for _, arrays := range []map[string][]*big.Int{typed.UintArray, typed.IntArray} {
+		for name, items := range arrays {
+			values := make([]string, len(items))
+			for i, n := range items {
+				values[i] = n.String()
+			}
+			numeric[name] = values
+		}
+	}
+	address := map[string]any{}
+	for name, a := range typed.Address {
+		address[name] = a
+	}
+	for name, a := range typed.AddressArray {
+		address[name] = a
+	}
+
+	return esMetadata{
+		ContractName: m.ContractName, EventName: m.EventName, FunctionName: m.FunctionName, Data: m.Data, Decoded: m.DecodedData(),
+		Numeric: numeric, Address: address, Bool: typed.Bool,
+	}
 }
 
 func (d esLog) toType() types.EvmLog {
@@ -146,11 +270,29 @@ func (d esLog) toType() types.EvmLog {
 func (d esTx) toType() types.EvmTransaction {
 	return types.EvmTransaction{
 		Id: d.Id, SourceId: d.SourceId, BlockNumber: d.BlockNumber, BlockTimestamp: d.BlockTimestamp, TransactionIndex: d.TransactionIndex, ChainId: d.ChainId,
-		From: d.From, Data: d.Data, Value: d.Value, Nonce: d.Nonce, To: d.To, Hash: d.Hash,
+		From: d.From, Data: d.Data, Value: d.Value, Nonce: d.Nonce, To: d.To, Hash: d.Hash, Type: d.Type,
+		Status: d.Status, GasUsed: d.GasUsed, EffectiveGasPrice: d.EffectiveGasPrice, ContractAddress: d.ContractAddress,
+		BlobGasUsed: d.BlobGasUsed, BlobGasPrice: d.BlobGasPrice, BlobVersionedHashes: d.BlobVersionedHashes,
 		Metadata: types.EvmMetadata{ContractName: d.Metadata.ContractName, EventName: d.Metadata.EventName, FunctionName: d.Metadata.FunctionName, Data: d.Metadata.Data},
 	}
 }
 
+func (d esBlock) toType() types.EvmBlock {
+	return types.EvmBlock{
+		Id: d.Id, SourceId: d.SourceId, ChainId: d.ChainId, BlockNumber: d.BlockNumber, BlockTimestamp: d.BlockTimestamp,
+		Hash: d.Hash, ParentHash: d.ParentHash, Miner: d.Miner, GasUsed: d.GasUsed, GasLimit: d.GasLimit, BaseFeePerGas: d.BaseFeePerGas,
+		BlobGasUsed: d.BlobGasUsed, ExcessBlobGas: d.ExcessBlobGas, TransactionCount: d.TransactionCount, LogsBloom: d.LogsBloom,
+	}
+}
+
+func (d esTrace) toType() types.EvmTrace {
+	return types.EvmTrace{
+		Id: d.Id, SourceId: d.SourceId, ChainId: d.ChainId, BlockNumber: d.BlockNumber, BlockTimestamp: d.BlockTimestamp,
+		TransactionHash: d.TransactionHash, TransactionIndex: d.TransactionIndex, TraceAddress: d.TraceAddress,
+		Type: d.Type, From: d.From, To: d.To, Value: d.Value, Input: d.Input, Output: d.Output, Gas: d.Gas, GasUsed: d.GasUsed, Error: d.Error,
+	}
+}
+
 // --- writes ---------------------------------------------------------------
 
 func (s *ElasticsearchStore) InsertLogs(logs []types.EvmLog) error {
@@ -172,13 +314,62 @@ func (s *ElasticsearchStore) InsertTransactions(txs []types.EvmTransaction) erro
 	for _, t := range txs {
 		doc := esTx{
 			Id: t.Id, SourceId: t.SourceId, BlockNumber: t.BlockNumber, BlockTimestamp: t.BlockTimestamp, TransactionIndex: t.TransactionIndex, ChainId: t.ChainId,
-			From: t.From, Data: t.Data, Value: t.Value, Nonce: t.Nonce, To: t.To, Hash: t.Hash, Metadata: toEsMetadata(t.Metadata),
+			From: t.From, Data: t.Data, Value: t.Value, Nonce: t.Nonce, To: t.To, Hash: t.Hash, Type: t.Type,
+			Status: t.Status, GasUsed: t.GasUsed, EffectiveGasPrice: t.EffectiveGasPrice, ContractAddress: t.ContractAddress,
+			BlobGasUsed: t.BlobGasUsed, BlobGasPrice: t.BlobGasPrice, BlobVersionedHashes: t.BlobVersionedHashes,
+			Metadata: toEsMetadata(t.Metadata),
 		}
 		writeBulkEntry(&body, s.txIdx, t.Id, doc)
 	}
 	return s.bulk(&body)
 }
 
+func (s *ElasticsearchStore) InsertBlocks(blocks []types.EvmBlock) error {
+	var body bytes.Buffer
+	for _, b := range blocks {
+		doc := esBlock{
+			Id: b.Id, SourceId: b.SourceId, ChainId: b.ChainId, BlockNumber: b.BlockNumber, BlockTimestamp: b.BlockTimestamp,
+			Hash: b.Hash, ParentHash: b.ParentHash, Miner: b.Miner, GasUsed: b.GasUsed, GasLimit: b.GasLimit, BaseFeePerGas: b.BaseFeePerGas,
+			BlobGasUsed: b.BlobGasUsed, ExcessBlobGas: b.ExcessBlobGas, TransactionCount: b.TransactionCount, LogsBloom: b.LogsBloom,
+		}
+		writeBulkEntry(&body, s.blocksIdx, b.Id, doc)
+	}
+	return s.bulk(&body)
+}
+
+func (s *ElasticsearchStore) InsertTraces(traces []types.EvmTrace) error {
+	var body bytes.Buffer
+	for _, t := range traces {
+		doc := esTrace{
+			Id: t.Id, SourceId: t.SourceId, ChainId: t.ChainId, BlockNumber: t.BlockNumber, BlockTimestamp: t.BlockTimestamp,
+			TransactionHash: t.TransactionHash, TransactionIndex: t.TransactionIndex, TraceAddress: t.TraceAddress,
+			Type: t.Type, From: t.From, To: t.To, Value: t.Value, Input: t.Input, Output: t.Output, Gas: t.Gas, GasUsed: t.GasUsed, Error: t.Error,
+		}
+		writeBulkEntry(&body, s.tracesIdx, t.Id, doc)
+	}
+	return s.bulk(&body)
+}
+
+// UpdateLogsMetadata replaces the metadata object of the stored logs with a
+// scripted update: a partial document update would merge the new decoded
+// arguments into the old ones. Logs no longer stored fail with a 404 in the
+// bulk response, which is ignored.
+func (s *ElasticsearchStore) UpdateLogsMetadata(logs []types.EvmLog) error {
+	var body bytes.Buffer
+	for _, l := range logs {
+		action, _ := json.Marshal(map[string]any{"update": map[string]any{"_index": s.logsIdx, "_id": l.Id}})
+		line, _ := json.Marshal(map[string]any{"script": map[string]any{
+			"source": "ctx._source.metadata = params.metadata",
+			"params": map[string]any{"metadata": toEsMetadata(l.Metadata)},
+		}})
+		body.Write(action)
+		body.WriteByte('\n')
+		body.Write(line)
+		body.WriteByte('\n')
+	}
+	return s.bulkIgnoringMissing(&body, true)
+}
+
 func writeBulkEntry(body *bytes.Buffer, index, id string, doc any) {
 	action, _ := json.Marshal(map[string]any{"index": map[string]any{"_index": index, "_id": id}})
 	line, _ := json.Marshal(doc)
@@ -189,6 +380,12 @@ func writeBulkEntry(body *bytes.Buffer, index, id string, doc any) {
 }
 
 func (s *ElasticsearchStore) bulk(body *bytes.Buffer) error {
+	return s.bulkIgnoringMissing(body, false)
+}
+
+// bulkIgnoringMissing runs a bulk request; with ignoreMissing, items failing
+// because their document does not exist are not errors.
+func (s *ElasticsearchStore) bulkIgnoringMissing(body *bytes.Buffer, ignoreMissing bool) error {
 	if body.Len() == 0 {
 		return nil
 	}
@@ -202,28 +399,58 @@ func (s *ElasticsearchStore) bulk(body *bytes.Buffer) error {
 		return fmt.Errorf("elasticsearch bulk failed: %s", string(b))
 	}
 	var parsed struct {
-		Errors bool `json:"errors"`
+		Errors bool                              `json:"errors"`
+		Items  []map[string]struct{ Status int } `json:"items"`
 	}
 	if err := json.NewDecoder(res.Body).Decode(&parsed); err != nil {
 		return err
 	}
-	if parsed.Errors {
-		return fmt.Errorf("elasticsearch bulk: one or more documents failed to index")
+	if !parsed.Errors {
+		return nil
+	}
+	for _, item := range parsed.Items {
+		for _, result := range item {
+			if result.Status >= 300 && !(ignoreMissing && result.Status == http.StatusNotFound) {
+				return fmt.Errorf("elasticsearch bulk: one or more documents failed to index")
+			}
+		}
 	}
 	return nil
 }
 
-// DeleteSourceData removes every log and transaction document for the source via
-// delete_by_query (term on source_id), refreshing so the deletes are visible.
+// DeleteSourceData removes every log, transaction, block and trace document
+// for the source via delete_by_query (term on source_id), refreshing so the
+// deletes are visible.
 func (s *ElasticsearchStore) DeleteSourceData(sourceId uint64) error {
-	if err := s.deleteBySource(s.logsIdx, sourceId); err != nil {
-		return err
+	for _, index := range []string{s.logsIdx, s.txIdx, s.blocksIdx, s.tracesIdx} {
+		if err := s.deleteBySource(index, sourceId); err != nil {
+			return err
+		}
 	}
-	return s.deleteBySource(s.txIdx, sourceId)
+	return nil
+}
+
+// RollbackSourceData removes the source's log, transaction, block and trace
+// documents at or above fromBlock via delete_by_query.
+func (s *ElasticsearchStore) RollbackSourceData(sourceId uint64, fromBlock uint64) error {
+	query := boolFilter(
+		term("source_id", sourceId),
+		map[string]any{"range": map[string]any{"block_number": map[string]any{"gte": fromBlock}}},
+	)
+	for _, index := range []string{s.logsIdx, s.txIdx, s.blocksIdx, s.tracesIdx} {
+		if err := s.deleteByQuery(index, query); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 func (s *ElasticsearchStore) deleteBySource(index string, sourceId uint64) error {
-	body, err := json.Marshal(map[string]any{"query": term("source_id", sourceId)})
+	return s.deleteByQuery(index, term("source_id", sourceId))
+}
+
+func (s *ElasticsearchStore) deleteByQuery(index string, query map[string]any) error {
+	body, err := json.Marshal(map[string]any{"query": query})
 	if err != nil {
 		return err
 	}
@@ -340,6 +567,57 @@ func (s *ElasticsearchStore) GetTransactions(sourceId uint64, fromBlock uint64,
 	return out, nil
 }
 
+func (s *ElasticsearchStore) GetBlocks(sourceId uint64, fromBlock uint64, toBlock uint64) ([]types.EvmBlock, error) {
+	query := map[string]any{
+		"size": maxHits,
+		// A source stores one block per number: the sort is unique.
+		"sort": []any{map[string]any{"block_number": "asc"}},
+		"query": boolFilter(
+			term("source_id", sourceId),
+			rangeGteLte("block_number", fromBlock, toBlock),
+		),
+	}
+	sources, err := s.searchPaged(s.blocksIdx, query)
+	if err != nil {
+		return nil, err
+	}
+	out := []types.EvmBlock{}
+	for _, src := range sources {
+		var doc esBlock
+		if err := json.Unmarshal(src, &doc); err != nil {
+			return nil, err
+		}
+		out = append(out, doc.toType())
+	}
+	return out, nil
+}
+
+func (s *ElasticsearchStore) GetTraces(sourceId uint64, fromBlock uint64, toBlock uint64) ([]types.EvmTrace, error) {
+	query := map[string]any{
+		"size": maxHits,
+		// id tie-breaks the frames of a transaction so search_after pagination is
+		// stable.
+		"sort": []any{map[string]any{"block_number": "asc"}, map[string]any{"transaction_index": "asc"}, map[string]any{"id": "asc"}},
+		"query": boolFilter(
+			term("source_id", sourceId),
+			rangeGteLte("block_number", fromBlock, toBlock),
+		),
+	}
+	sources, err := s.searchPaged(s.tracesIdx, query)
+	if err != nil {
+		return nil, err
+	}
+	out := []types.EvmTrace{}
+	for _, src := range sources {
+		var doc esTrace
+		if err := json.Unmarshal(src, &doc); err != nil {
+			return nil, err
+		}
+		out = append(out, doc.toType())
+	}
+	return out, nil
+}
+
 // --- search plumbing ------------------------------------------------------
 
 type searchResponse struct {
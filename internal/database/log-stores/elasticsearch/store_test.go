@@ -89,5 +89,15 @@ func TestElasticsearchStore(t *testing.T) {
 		t.Fatalf("GetTransactions = %+v, err %v", txs, err)
 	}
 
+	// Reorg rollback: source 1 keeps only the rows below block 11.
+	if err := s.RollbackSourceData(1, 11); err != nil {
+		t.Fatalf("rollback: %v", err)
+	}
+	logs, err = s.GetLogs(1, 0, 100)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertIds("GetLogs after rollback", logs, "1:10:0", "1:10:1")
+
 	s.client.Indices.Delete([]string{"evmi_test_logs", "evmi_test_txs"})
 }
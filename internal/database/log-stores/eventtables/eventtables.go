@@ -0,0 +1,293 @@
+// Package eventtables holds what the SQL and ClickHouse stores share to write
+// decoded logs to one typed table per event, when their "eventTables" config is
+// set: table and column naming, the column kind of each Solidity type and the
+// parsing of the decoded values (see types.EvmMetadata.Data).
+package eventtables
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/evmi-cloud/go-evm-indexer/internal/types"
+)
+
+// ConfigKey is the store config flag enabling event tables ("true").
+const ConfigKey = "eventTables"
+
+// Enabled reports whether config enables event tables.
+func Enabled(config map[string]string) bool {
+	enabled, _ := strconv.ParseBool(config[ConfigKey])
+	return enabled
+}
+
+// Kind is how a column stores its argument.
+type Kind int
+
+const (
+	// Uint and Int are integers; Bits is their Solidity width.
+	Uint Kind = iota
+	Int
+	Bool
+	// String holds addresses, bytes, strings and the topic hashes of indexed
+	// dynamic arguments, as decoded.
+	String
+	UintArray
+	IntArray
+	BoolArray
+	StringArray
+	// JSON holds tuples and nested arrays as their canonical JSON.
+	JSON
+)
+
+// Column is the column of one event argument, named arg_<snake_case name> so
+// it never collides with the standard log columns.
+type Column struct {
+	Name string
+	Arg  types.EvmArgSchema
+	Kind Kind
+	Bits int
+	// hash is set for the topic hash of an indexed dynamic argument.
+	hash bool
+}
+
+// Table is the table of one event of a contract.
+type Table struct {
+	Name         string
+	ContractName string
+	EventName    string
+	Signature    string
+	Columns      []Column
+}
+
+// Key identifies the table of a decoded log.
+type Key struct {
+	ContractName string
+	Signature    string
+}
+
+func (t Table) Key() Key {
+	return Key{ContractName: t.ContractName, Signature: t.Signature}
+}
+
+// KeyOf is the key of the table a decoded log belongs to, derived from its
+// event name and argument schema. ok is false for logs without a schema
+// (unknown events, FULL sources).
+func KeyOf(m types.EvmMetadata) (Key, bool) {
+	if m.EventName == "" || m.Args == nil {
+		return Key{}, false
+	}
+	argTypes := make([]string, len(m.Args))
+	for i, arg := range m.Args {
+		argTypes[i] = arg.Type
+	}
+	return Key{ContractName: m.ContractName, Signature: m.EventName + "(" + strings.Join(argTypes, ",") + ")"}, true
+}
+
+// Tables are the tables of a contract's events, named
+// <contract>_<event> in snake case. Overloaded events add the first bytes of
+// their topic0 to tell their tables apart.
+func Tables(contractName string, events []types.EvmEntrySchema) []Table {
+	overloads := map[string]int{}
+	for _, event := range events {
+		overloads[event.Name]++
+	}
+
+	tables := make([]Table, 0, len(events))
+	for _, event := range events {
+		name := snakeCase(contractName) + "_" + snakeCase(event.Name)
+		if overloads[event.Name] > 1 {
+			name += "_" + strings.TrimPrefix(event.Selector, "0x")[:8]
+		}
+		if name != "" && unicode.IsDigit(rune(name[0])) {
+			name = "t_" + name
+		}
+
+		table := Table{Name: name, ContractName: contractName, EventName: event.Name, Signature: event.Signature}
+		taken := map[string]bool{}
+		for i, arg := range event.Args {
+			column := columnOf(arg)
+			if taken[column.Name] {
+				column.Name = fmt.Sprintf("%s_%d", column.Name, i)
+			}
+			taken[column.Name] = true
+			table.Columns = append(table.Columns, column)
+		}
+		tables = append(tables, table)
+	}
+	return tables
+}
+
+func columnOf(arg types.EvmArgSchema) Column {
+	column := Column{Name: "arg_" + snakeCase(arg.Name), Arg: arg, Kind: JSON}
+
+	elemType, isArray := arrayElem(arg.Type)
+	// Indexed dynamic arguments are stored as the hash of their value.
+	if arg.Indexed && (isArray || arg.Type == "string" || arg.Type == "bytes" || strings.HasPrefix(arg.Type, "(")) {
+		column.Kind, column.hash = String, true
+		return column
+	}
+	if isArray {
+		if _, nested := arrayElem(elemType); nested || strings.HasPrefix(elemType, "(") {
+			return column
+		}
+		elem := columnOf(types.EvmArgSchema{Type: elemType})
+		column.Bits = elem.Bits
+		switch elem.Kind {
+		case Uint:
+			column.Kind = UintArray
+		case Int:
+			column.Kind = IntArray
+		case Bool:
+			column.Kind = BoolArray
+		case String:
+			column.Kind = StringArray
+		}
+		return column
+	}
+
+	switch {
+	case strings.HasPrefix(arg.Type, "uint"):
+		column.Kind, column.Bits = Uint, integerBits(arg.Type, "uint")
+	case strings.HasPrefix(arg.Type, "int"):
+		column.Kind, column.Bits = Int, integerBits(arg.Type, "int")
+	case arg.Type == "bool":
+		column.Kind = Bool
+	case arg.Type == "address", arg.Type == "string", strings.HasPrefix(arg.Type, "bytes"):
+		column.Kind = String
+	}
+	return column
+}
+
+// arrayElem splits T[] and T[N] into T.
+func arrayElem(t string) (string, bool) {
+	if !strings.HasSuffix(t, "]") {
+		return t, false
+	}
+	i := strings.LastIndex(t, "[")
+	if i <= 0 {
+		return t, false
+	}
+	return t[:i], true
+}
+
+func integerBits(t, prefix string) int {
+	bits, err := strconv.Atoi(strings.TrimPrefix(t, prefix))
+	if err != nil {
+		return 256
+	}
+	return bits
+}
+
+// snakeCase lowers name to [a-z0-9_], splitting camel case words.
+func snakeCase(name string) string {
+	var b strings.Builder
+	runes := []rune(name)
+	for i, r := range runes {
+		switch {
+		case unicode.IsUpper(r):
+			// A new word starts at an upper case letter after a lower case one or
+			// a digit, or before a lower case one ending an acronym (ERC20Token).
+			if i > 0 && (unicode.IsLower(runes[i-1]) || unicode.IsDigit(runes[i-1]) ||
+				(unicode.IsUpper(runes[i-1]) && i+1 < len(runes) && unicode.IsLower(runes[i+1]))) {
+				b.WriteByte('_')
+			}
+			b.WriteRune(unicode.ToLower(r))
+		case r < unicode.MaxASCII && (unicode.IsLower(r) || unicode.IsDigit(r)):
+			b.WriteRune(r)
+		default:
+			b.WriteByte('_')
+		}
+	}
+	return strings.Trim(b.String(), "_")
+}
+
+// Value parses the column's decoded argument out of data: a *big.Int for
+// integers, bool, string, or a slice of those for arrays. ok is false when the
+// argument is missing or did not decode to the expected shape.
+func (c Column) Value(data map[string]string) (any, bool) {
+	raw, ok := data[c.Arg.Name]
+	if !ok {
+		return nil, false
+	}
+
+	switch c.Kind {
+	case Uint, Int:
+		n, ok := new(big.Int).SetString(raw, 10)
+		return n, ok
+	case Bool:
+		return raw == "true", true
+	case String, JSON:
+		return raw, true
+	case BoolArray:
+		var items []bool
+		if err := json.Unmarshal([]byte(raw), &items); err != nil {
+			return nil, false
+		}
+		return items, true
+	}
+
+	var items []string
+	if err := json.Unmarshal([]byte(raw), &items); err != nil {
+		return nil, false
+	}
+	if c.Kind == StringArray {
+		return items, true
+	}
+	numbers := make([]*big.Int, len(items))
+	for i, item := range items {
+		n, ok := new(big.Int).SetString(item, 10)
+		if !ok {
+			return nil, false
+		}
+		numbers[i] = n
+	}
+	return numbers, true
+}
+
+// Type is what the column holds: the argument's Solidity type, prefixed with
+// "indexed " for the topic hash of an indexed dynamic argument.
+func (c Column) Type() string {
+	if c.hash {
+		return "indexed " + c.Arg.Type
+	}
+	return c.Arg.Type
+}
+
+// Fixed reports whether the column's values have a bounded length:
+// addresses, bytesN and topic hashes.
+func (c Column) Fixed() bool {
+	return c.Kind == String && (c.hash || c.Arg.Type == "address" || (strings.HasPrefix(c.Arg.Type, "bytes") && c.Arg.Type != "bytes"))
+}
+
+// Evolve matches table against the columns already created, column name to
+// Type, as stored by an earlier run: columns are never dropped nor retyped, so
+// an argument whose type changed (e.g. after an ABI update) moves to a new
+// column suffixed with its type. It returns the columns to add, which it
+// records in known.
+func Evolve(table *Table, known map[string]string) []Column {
+	var added []Column
+	for i := range table.Columns {
+		column := &table.Columns[i]
+		base := column.Name
+		for n := 1; ; n++ {
+			existing, ok := known[column.Name]
+			if !ok {
+				known[column.Name] = column.Type()
+				added = append(added, *column)
+				break
+			}
+			if existing == column.Type() {
+				break
+			}
+			column.Name = base + "_" + snakeCase(column.Type())
+			if n > 1 {
+				column.Name = fmt.Sprintf("%s_%d", column.Name, n)
+			}
+		}
+	}
+	return added
+}
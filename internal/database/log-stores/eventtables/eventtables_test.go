@@ -0,0 +1,92 @@
+package eventtables
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/evmi-cloud/go-evm-indexer/internal/types"
+)
+
+func TestTablesNamesAndKinds(t *testing.T) {
+	events := []types.EvmEntrySchema{
+		{Name: "Transfer", Signature: "Transfer(address,uint256)", Selector: "0x1111111100", Args: []types.EvmArgSchema{
+			{Name: "to", Type: "address", Indexed: true},
+			{Name: "value", Type: "uint256"},
+		}},
+		{Name: "Transfer", Signature: "Transfer(string,int8[],(address,uint24))", Selector: "0x2222222200", Args: []types.EvmArgSchema{
+			{Name: "memo", Type: "string", Indexed: true},
+			{Name: "deltas", Type: "int8[]"},
+			{Name: "poolKey", Type: "(address,uint24)"},
+		}},
+	}
+	tables := Tables("ERC20Token", events)
+	if tables[0].Name != "erc20_token_transfer_11111111" || tables[1].Name != "erc20_token_transfer_22222222" {
+		t.Errorf("overloaded tables = %s, %s", tables[0].Name, tables[1].Name)
+	}
+
+	want := []struct {
+		name string
+		kind Kind
+		typ  string
+	}{
+		{"arg_memo", String, "indexed string"},
+		{"arg_deltas", IntArray, "int8[]"},
+		{"arg_pool_key", JSON, "(address,uint24)"},
+	}
+	for i, w := range want {
+		c := tables[1].Columns[i]
+		if c.Name != w.name || c.Kind != w.kind || c.Type() != w.typ {
+			t.Errorf("column %d = %s kind %d type %q, want %+v", i, c.Name, c.Kind, c.Type(), w)
+		}
+	}
+	if !tables[0].Columns[0].Fixed() || !tables[1].Columns[0].Fixed() || tables[1].Columns[2].Fixed() {
+		t.Error("addresses and topic hashes should be fixed-length, tuples not")
+	}
+
+	key, ok := KeyOf(types.EvmMetadata{ContractName: "ERC20Token", EventName: "Transfer", Args: events[0].Args})
+	if !ok || key != tables[0].Key() {
+		t.Errorf("KeyOf = %+v, want %+v", key, tables[0].Key())
+	}
+}
+
+func TestEvolveKeepsRetypedColumns(t *testing.T) {
+	known := map[string]string{"arg_to": "address", "arg_value": "uint256"}
+	table := Tables("Token", []types.EvmEntrySchema{{Name: "Transfer", Args: []types.EvmArgSchema{
+		{Name: "to", Type: "address"},
+		{Name: "value", Type: "int128"},
+		{Name: "memo", Type: "string"},
+	}}})[0]
+
+	added := Evolve(&table, known)
+	if len(added) != 2 || added[0].Name != "arg_value_int128" || added[1].Name != "arg_memo" {
+		t.Errorf("added = %+v", added)
+	}
+	if table.Columns[1].Name != "arg_value_int128" || known["arg_value"] != "uint256" || known["arg_value_int128"] != "int128" {
+		t.Errorf("columns = %+v, known = %v", table.Columns, known)
+	}
+	if again := Evolve(&table, known); len(again) != 0 {
+		t.Errorf("second evolve added %+v", again)
+	}
+}
+
+func TestColumnValue(t *testing.T) {
+	data := map[string]string{"value": "-3", "ids": `["1","2"]`, "flags": "[true,false]", "bad": "x"}
+	value, ok := Column{Arg: types.EvmArgSchema{Name: "value"}, Kind: Int}.Value(data)
+	if !ok || value.(*big.Int).Int64() != -3 {
+		t.Errorf("int value = %v, %v", value, ok)
+	}
+	value, ok = Column{Arg: types.EvmArgSchema{Name: "ids"}, Kind: UintArray}.Value(data)
+	if ids, _ := value.([]*big.Int); !ok || len(ids) != 2 || ids[1].Int64() != 2 {
+		t.Errorf("uint array value = %v, %v", value, ok)
+	}
+	value, ok = Column{Arg: types.EvmArgSchema{Name: "flags"}, Kind: BoolArray}.Value(data)
+	if flags, _ := value.([]bool); !ok || len(flags) != 2 || !flags[0] {
+		t.Errorf("bool array value = %v, %v", value, ok)
+	}
+	if _, ok := (Column{Arg: types.EvmArgSchema{Name: "bad"}, Kind: Uint}).Value(data); ok {
+		t.Error("a partial decode should not parse")
+	}
+	if _, ok := (Column{Arg: types.EvmArgSchema{Name: "missing"}, Kind: String}).Value(data); ok {
+		t.Error("a missing argument should not parse")
+	}
+}
@@ -6,11 +6,29 @@ type EvmIndexerStorage interface {
 	Init(config map[string]string) error
 	InsertLogs(logs []types.EvmLog) error
 	InsertTransactions(txs []types.EvmTransaction) error
+	// InsertBlocks stores the block headers of a BLOCKS source, keyed by their
+	// id like logs and transactions: a replayed range is not duplicated.
+	InsertBlocks(blocks []types.EvmBlock) error
+	// InsertTraces stores the call frames of a TRACES source, keyed by their id.
+	InsertTraces(traces []types.EvmTrace) error
 	GetLogsCount() (uint64, error)
-	// DeleteSourceData removes all stored logs and transactions for the given
-	// source. Used when a source (or a factory-spawned child) is deleted. Deleting
-	// data for a source with nothing stored is a no-op (not an error).
+	// DeleteSourceData removes all stored logs, transactions, blocks and traces
+	// for the given source. Used when a source (or a factory-spawned child) is
+	// deleted. Deleting data for a source with nothing stored is a no-op (not an
+	// error).
 	DeleteSourceData(sourceId uint64) error
+	// RollbackSourceData removes the source's stored logs, transactions, blocks
+	// and traces at or above fromBlock. Used when a chain reorganization orphans
+	// blocks the source already indexed: they are dropped here and re-indexed
+	// from the canonical chain, and when a source is resynced from a block.
+	// Rolling back a range with nothing stored is a no-op (not an error).
+	RollbackSourceData(sourceId uint64, fromBlock uint64) error
+	// UpdateLogsMetadata rewrites the decoded metadata (contract, event and
+	// function names, arguments) of stored logs, keyed by their id, with the
+	// metadata of logs. Used to re-decode a source's logs after its ABIs
+	// changed; the other fields of logs are the stored ones. Logs no longer
+	// stored are skipped.
+	UpdateLogsMetadata(logs []types.EvmLog) error
 	GetLogs(sourceId uint64, fromBlock uint64, toBlock uint64) ([]types.EvmLog, error)
 	// GetLogsAfter returns logs for the given sources up to and including toBlock,
 	// strictly after the (afterBlock, afterLogIndex) cursor, ordered by
@@ -20,4 +38,19 @@ type EvmIndexerStorage interface {
 	GetLogStream(sourceId uint64, fromBlock uint64, toBlock uint64, stream chan types.EvmLog) error
 	GetLatestLogs(sourceId uint64, limit uint64) ([]types.EvmLog, error)
 	GetTransactions(sourceId uint64, fromBlock uint64, toBlock uint64) ([]types.EvmTransaction, error)
+	// GetBlocks returns the blocks a source stored in [fromBlock, toBlock],
+	// ordered by block number.
+	GetBlocks(sourceId uint64, fromBlock uint64, toBlock uint64) ([]types.EvmBlock, error)
+	// GetTraces returns the call frames a source stored in [fromBlock, toBlock],
+	// ordered by block number and transaction index.
+	GetTraces(sourceId uint64, fromBlock uint64, toBlock uint64) ([]types.EvmTrace, error)
+}
+
+// EventTablesStorage is implemented by the stores that can also write decoded
+// logs to one typed table per event, when their "eventTables" config is set.
+type EventTablesStorage interface {
+	// EnsureEventTables creates the tables of contractName's events, or adds
+	// the columns of the arguments they are missing. Sources call it at start
+	// with their ABI's events; it is a no-op when event tables are disabled.
+	EnsureEventTables(contractName string, events []types.EvmEntrySchema) error
 }
@@ -1,6 +1,6 @@
 // Package mongodb_store implements the EvmIndexerStorage backend on MongoDB.
-// Logs and transactions are upserted as documents keyed by their stable id
-// (_id); queries are finds with bool/range filters sorted by
+// Logs, transactions, blocks and traces are upserted as documents keyed by their
+// stable id (_id); queries are finds with bool/range filters sorted by
 // (block_number, log_index).
 package mongodb_store
 
@@ -19,6 +19,8 @@ type MongoStore struct {
 	client *mongo.Client
 	logs   *mongo.Collection
 	txs    *mongo.Collection
+	blocks *mongo.Collection
+	traces *mongo.Collection
 }
 
 func NewMongoStore(logger zerolog.Logger) (*MongoStore, error) {
@@ -41,16 +43,20 @@ func (s *MongoStore) Init(config map[string]string) error {
 	db := client.Database(orDefault(config["database"], "evmi"))
 	s.logs = db.Collection(orDefault(config["logsCollection"], "logs"))
 	s.txs = db.Collection(orDefault(config["transactionsCollection"], "transactions"))
+	s.blocks = db.Collection(orDefault(config["blocksCollection"], "blocks"))
+	s.traces = db.Collection(orDefault(config["tracesCollection"], "traces"))
 
 	if _, err := s.logs.Indexes().CreateOne(ctx, mongo.IndexModel{
 		Keys: bson.D{{Key: "source_id", Value: 1}, {Key: "block_number", Value: 1}, {Key: "log_index", Value: 1}},
 	}); err != nil {
 		return err
 	}
-	if _, err := s.txs.Indexes().CreateOne(ctx, mongo.IndexModel{
-		Keys: bson.D{{Key: "source_id", Value: 1}, {Key: "block_number", Value: 1}},
-	}); err != nil {
-		return err
+	for _, collection := range []*mongo.Collection{s.txs, s.blocks, s.traces} {
+		if _, err := collection.Indexes().CreateOne(ctx, mongo.IndexModel{
+			Keys: bson.D{{Key: "source_id", Value: 1}, {Key: "block_number", Value: 1}},
+		}); err != nil {
+			return err
+		}
 	}
 	return nil
 }
@@ -62,6 +68,9 @@ type mongoMetadata struct {
 	EventName    string            `bson:"event_name"`
 	FunctionName string            `bson:"function_name"`
 	Data         map[string]string `bson:"data"`
+	// Decoded is Data with its tuples and arrays as sub-documents, for
+	// queries into them (metadata.decoded.<arg>.<field>). It is not read back.
+	Decoded map[string]any `bson:"decoded,omitempty"`
 }
 
 type mongoLog struct {
@@ -83,23 +92,72 @@ type mongoLog struct {
 }
 
 type mongoTx struct {
-	Id               string        `bson:"_id"`
-	SourceId         uint          `bson:"source_id"`
-	BlockNumber      uint64        `bson:"block_number"`
-	BlockTimestamp   uint64        `bson:"block_timestamp"`
-	TransactionIndex uint64        `bson:"transaction_index"`
-	ChainId          uint64        `bson:"chain_id"`
-	From             string        `bson:"from"`
-	Data             string        `bson:"data"`
-	Value            string        `bson:"value"`
-	Nonce            uint64        `bson:"nonce"`
-	To               string        `bson:"to"`
-	Hash             string        `bson:"hash"`
-	Metadata         mongoMetadata `bson:"metadata"`
+	Id               string `bson:"_id"`
+	SourceId         uint   `bson:"source_id"`
+	BlockNumber      uint64 `bson:"block_number"`
+	BlockTimestamp   uint64 `bson:"block_timestamp"`
+	TransactionIndex uint64 `bson:"transaction_index"`
+	ChainId          uint64 `bson:"chain_id"`
+	From             string `bson:"from"`
+	Data             string `bson:"data"`
+	Value            string `bson:"value"`
+	Nonce            uint64 `bson:"nonce"`
+	To               string `bson:"to"`
+	Hash             string `bson:"hash"`
+	Type             uint64 `bson:"type"`
+
+	Status            uint64 `bson:"status"`
+	GasUsed           uint64 `bson:"gas_used"`
+	EffectiveGasPrice string `bson:"effective_gas_price"`
+	ContractAddress   string `bson:"contract_address"`
+
+	BlobGasUsed         uint64   `bson:"blob_gas_used"`
+	BlobGasPrice        string   `bson:"blob_gas_price"`
+	BlobVersionedHashes []string `bson:"blob_versioned_hashes"`
+
+	Metadata mongoMetadata `bson:"metadata"`
+}
+
+type mongoBlock struct {
+	Id               string `bson:"_id"`
+	SourceId         uint   `bson:"source_id"`
+	ChainId          uint64 `bson:"chain_id"`
+	BlockNumber      uint64 `bson:"block_number"`
+	BlockTimestamp   uint64 `bson:"block_timestamp"`
+	Hash             string `bson:"hash"`
+	ParentHash       string `bson:"parent_hash"`
+	Miner            string `bson:"miner"`
+	GasUsed          uint64 `bson:"gas_used"`
+	GasLimit         uint64 `bson:"gas_limit"`
+	BaseFeePerGas    string `bson:"base_fee_per_gas"`
+	BlobGasUsed      uint64 `bson:"blob_gas_used"`
+	ExcessBlobGas    uint64 `bson:"excess_blob_gas"`
+	TransactionCount uint64 `bson:"transaction_count"`
+	LogsBloom        string `bson:"logs_bloom"`
+}
+
+type mongoTrace struct {
+	Id               string `bson:"_id"`
+	SourceId         uint   `bson:"source_id"`
+	ChainId          uint64 `bson:"chain_id"`
+	BlockNumber      uint64 `bson:"block_number"`
+	BlockTimestamp   uint64 `bson:"block_timestamp"`
+	TransactionHash  string `bson:"transaction_hash"`
+	TransactionIndex uint64 `bson:"transaction_index"`
+	TraceAddress     string `bson:"trace_address"`
+	Type             string `bson:"type"`
+	From             string `bson:"from"`
+	To               string `bson:"to"`
+	Value            string `bson:"value"`
+	Input            string `bson:"input"`
+	Output           string `bson:"output"`
+	Gas              uint64 `bson:"gas"`
+	GasUsed          uint64 `bson:"gas_used"`
+	Error            string `bson:"error"`
 }
 
 func toMongoMetadata(m types.EvmMetadata) mongoMetadata {
-	return mongoMetadata{ContractName: m.ContractName, EventName: m.EventName, FunctionName: m.FunctionName, Data: m.Data}
+	return mongoMetadata{ContractName: m.ContractName, EventName: m.EventName, FunctionName: m.FunctionName, Data: m.Data, Decoded: m.DecodedData()}
 }
 
 func (d mongoLog) toType() types.EvmLog {
@@ -114,11 +172,29 @@ func (d mongoLog) toType() types.EvmLog {
 func (d mongoTx) toType() types.EvmTransaction {
 	return types.EvmTransaction{
 		Id: d.Id, SourceId: d.SourceId, BlockNumber: d.BlockNumber, BlockTimestamp: d.BlockTimestamp, TransactionIndex: d.TransactionIndex, ChainId: d.ChainId,
-		From: d.From, Data: d.Data, Value: d.Value, Nonce: d.Nonce, To: d.To, Hash: d.Hash,
+		From: d.From, Data: d.Data, Value: d.Value, Nonce: d.Nonce, To: d.To, Hash: d.Hash, Type: d.Type,
+		Status: d.Status, GasUsed: d.GasUsed, EffectiveGasPrice: d.EffectiveGasPrice, ContractAddress: d.ContractAddress,
+		BlobGasUsed: d.BlobGasUsed, BlobGasPrice: d.BlobGasPrice, BlobVersionedHashes: d.BlobVersionedHashes,
 		Metadata: types.EvmMetadata{ContractName: d.Metadata.ContractName, EventName: d.Metadata.EventName, FunctionName: d.Metadata.FunctionName, Data: d.Metadata.Data},
 	}
 }
 
+func (d mongoBlock) toType() types.EvmBlock {
+	return types.EvmBlock{
+		Id: d.Id, SourceId: d.SourceId, ChainId: d.ChainId, BlockNumber: d.BlockNumber, BlockTimestamp: d.BlockTimestamp,
+		Hash: d.Hash, ParentHash: d.ParentHash, Miner: d.Miner, GasUsed: d.GasUsed, GasLimit: d.GasLimit, BaseFeePerGas: d.BaseFeePerGas,
+		BlobGasUsed: d.BlobGasUsed, ExcessBlobGas: d.ExcessBlobGas, TransactionCount: d.TransactionCount, LogsBloom: d.LogsBloom,
+	}
+}
+
+func (d mongoTrace) toType() types.EvmTrace {
+	return types.EvmTrace{
+		Id: d.Id, SourceId: d.SourceId, ChainId: d.ChainId, BlockNumber: d.BlockNumber, BlockTimestamp: d.BlockTimestamp,
+		TransactionHash: d.TransactionHash, TransactionIndex: d.TransactionIndex, TraceAddress: d.TraceAddress,
+		Type: d.Type, From: d.From, To: d.To, Value: d.Value, Input: d.Input, Output: d.Output, Gas: d.Gas, GasUsed: d.GasUsed, Error: d.Error,
+	}
+}
+
 // --- writes ---------------------------------------------------------------
 
 func (s *MongoStore) InsertLogs(logs []types.EvmLog) error {
@@ -147,7 +223,10 @@ func (s *MongoStore) InsertTransactions(txs []types.EvmTransaction) error {
 	for i, t := range txs {
 		doc := mongoTx{
 			Id: t.Id, SourceId: t.SourceId, BlockNumber: t.BlockNumber, BlockTimestamp: t.BlockTimestamp, TransactionIndex: t.TransactionIndex, ChainId: t.ChainId,
-			From: t.From, Data: t.Data, Value: t.Value, Nonce: t.Nonce, To: t.To, Hash: t.Hash, Metadata: toMongoMetadata(t.Metadata),
+			From: t.From, Data: t.Data, Value: t.Value, Nonce: t.Nonce, To: t.To, Hash: t.Hash, Type: t.Type,
+			Status: t.Status, GasUsed: t.GasUsed, EffectiveGasPrice: t.EffectiveGasPrice, ContractAddress: t.ContractAddress,
+			BlobGasUsed: t.BlobGasUsed, BlobGasPrice: t.BlobGasPrice, BlobVersionedHashes: t.BlobVersionedHashes,
+			Metadata: toMongoMetadata(t.Metadata),
 		}
 		models[i] = mongo.NewReplaceOneModel().SetFilter(bson.M{"_id": t.Id}).SetReplacement(doc).SetUpsert(true)
 	}
@@ -155,20 +234,84 @@ func (s *MongoStore) InsertTransactions(txs []types.EvmTransaction) error {
 	return err
 }
 
-// DeleteSourceData removes every log and transaction document for the source.
+func (s *MongoStore) InsertBlocks(blocks []types.EvmBlock) error {
+	if len(blocks) == 0 {
+		return nil
+	}
+	models := make([]mongo.WriteModel, len(blocks))
+	for i, b := range blocks {
+		doc := mongoBlock{
+			Id: b.Id, SourceId: b.SourceId, ChainId: b.ChainId, BlockNumber: b.BlockNumber, BlockTimestamp: b.BlockTimestamp,
+			Hash: b.Hash, ParentHash: b.ParentHash, Miner: b.Miner, GasUsed: b.GasUsed, GasLimit: b.GasLimit, BaseFeePerGas: b.BaseFeePerGas,
+			BlobGasUsed: b.BlobGasUsed, ExcessBlobGas: b.ExcessBlobGas, TransactionCount: b.TransactionCount, LogsBloom: b.LogsBloom,
+		}
+		models[i] = mongo.NewReplaceOneModel().SetFilter(bson.M{"_id": b.Id}).SetReplacement(doc).SetUpsert(true)
+	}
+	_, err := s.blocks.BulkWrite(context.Background(), models, options.BulkWrite().SetOrdered(false))
+	return err
+}
+
+func (s *MongoStore) InsertTraces(traces []types.EvmTrace) error {
+	if len(traces) == 0 {
+		return nil
+	}
+	models := make([]mongo.WriteModel, len(traces))
+	for i, t := range traces {
+		doc := mongoTrace{
+			Id: t.Id, SourceId: t.SourceId, ChainId: t.ChainId, BlockNumber: t.BlockNumber, BlockTimestamp: t.BlockTimestamp,
+			TransactionHash: t.TransactionHash, TransactionIndex: t.TransactionIndex, TraceAddress: t.TraceAddress,
+			Type: t.Type, From: t.From, To: t.To, Value: t.Value, Input: t.Input, Output: t.Output, Gas: t.Gas, GasUsed: t.GasUsed, Error: t.Error,
+		}
+		models[i] = mongo.NewReplaceOneModel().SetFilter(bson.M{"_id": t.Id}).SetReplacement(doc).SetUpsert(true)
+	}
+	_, err := s.traces.BulkWrite(context.Background(), models, options.BulkWrite().SetOrdered(false))
+	return err
+}
+
+// UpdateLogsMetadata replaces the metadata sub-document of the stored logs.
+func (s *MongoStore) UpdateLogsMetadata(logs []types.EvmLog) error {
+	if len(logs) == 0 {
+		return nil
+	}
+	models := make([]mongo.WriteModel, len(logs))
+	for i, l := range logs {
+		models[i] = mongo.NewUpdateOneModel().SetFilter(bson.M{"_id": l.Id}).SetUpdate(bson.M{"$set": bson.M{"metadata": toMongoMetadata(l.Metadata)}})
+	}
+	_, err := s.logs.BulkWrite(context.Background(), models, options.BulkWrite().SetOrdered(false))
+	return err
+}
+
+// DeleteSourceData removes every log, transaction, block and trace document
+// for the source.
 func (s *MongoStore) DeleteSourceData(sourceId uint64) error {
+	return s.deleteMany(bson.M{"source_id": sourceId})
+}
+
+// RollbackSourceData removes the source's log, transaction, block and trace
+// documents at or above fromBlock.
+func (s *MongoStore) RollbackSourceData(sourceId uint64, fromBlock uint64) error {
+	return s.deleteMany(bson.M{"source_id": sourceId, "block_number": bson.M{"$gte": fromBlock}})
+}
+
+func (s *MongoStore) deleteMany(filter bson.M) error {
 	ctx := context.Background()
-	if _, err := s.logs.DeleteMany(ctx, bson.M{"source_id": sourceId}); err != nil {
-		return err
+	for _, collection := range []*mongo.Collection{s.logs, s.txs, s.blocks, s.traces} {
+		if _, err := collection.DeleteMany(ctx, filter); err != nil {
+			return err
+		}
 	}
-	_, err := s.txs.DeleteMany(ctx, bson.M{"source_id": sourceId})
-	return err
+	return nil
 }
 
 // --- reads ----------------------------------------------------------------
 
 var sortAsc = options.Find().SetSort(bson.D{{Key: "block_number", Value: 1}, {Key: "log_index", Value: 1}})
 
+// Close disconnects the store's client.
+func (s *MongoStore) Close() error {
+	return s.client.Disconnect(context.Background())
+}
+
 func (s *MongoStore) GetLogsCount() (uint64, error) {
 	count, err := s.logs.CountDocuments(context.Background(), bson.M{})
 	return uint64(count), err
@@ -233,6 +376,44 @@ func (s *MongoStore) GetTransactions(sourceId uint64, fromBlock uint64, toBlock
 	return out, nil
 }
 
+func (s *MongoStore) GetBlocks(sourceId uint64, fromBlock uint64, toBlock uint64) ([]types.EvmBlock, error) {
+	filter := bson.M{"source_id": sourceId, "block_number": bson.M{"$gte": fromBlock, "$lte": toBlock}}
+	opts := options.Find().SetSort(bson.D{{Key: "block_number", Value: 1}})
+
+	cursor, err := s.blocks.Find(context.Background(), filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	var docs []mongoBlock
+	if err := cursor.All(context.Background(), &docs); err != nil {
+		return nil, err
+	}
+	out := make([]types.EvmBlock, 0, len(docs))
+	for _, d := range docs {
+		out = append(out, d.toType())
+	}
+	return out, nil
+}
+
+func (s *MongoStore) GetTraces(sourceId uint64, fromBlock uint64, toBlock uint64) ([]types.EvmTrace, error) {
+	filter := bson.M{"source_id": sourceId, "block_number": bson.M{"$gte": fromBlock, "$lte": toBlock}}
+	opts := options.Find().SetSort(bson.D{{Key: "block_number", Value: 1}, {Key: "transaction_index", Value: 1}, {Key: "trace_address", Value: 1}})
+
+	cursor, err := s.traces.Find(context.Background(), filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	var docs []mongoTrace
+	if err := cursor.All(context.Background(), &docs); err != nil {
+		return nil, err
+	}
+	out := make([]types.EvmTrace, 0, len(docs))
+	for _, d := range docs {
+		out = append(out, d.toType())
+	}
+	return out, nil
+}
+
 func (s *MongoStore) findLogs(filter bson.M, opts *options.FindOptions) ([]types.EvmLog, error) {
 	cursor, err := s.logs.Find(context.Background(), filter, opts)
 	if err != nil {
@@ -8,6 +8,7 @@ import (
 
 	"github.com/evmi-cloud/go-evm-indexer/internal/types"
 	"github.com/rs/zerolog"
+	"go.mongodb.org/mongo-driver/bson"
 )
 
 // TestMongoStore runs against a real MongoDB. Set MONGODB_URI (e.g.
@@ -27,6 +28,8 @@ func TestMongoStore(t *testing.T) {
 	// Clean slate, then re-init to recreate indexes.
 	_ = s.logs.Drop(ctx)
 	_ = s.txs.Drop(ctx)
+	_ = s.blocks.Drop(ctx)
+	_ = s.traces.Drop(ctx)
 	if err := s.Init(cfg); err != nil {
 		t.Fatalf("re-init: %v", err)
 	}
@@ -36,7 +39,7 @@ func TestMongoStore(t *testing.T) {
 		return types.EvmLog{
 			Id: fmt.Sprintf("1:%d:%d", block, idx), SourceId: sourceId, ChainId: 1, Address: "0xabc",
 			Topics: []string{"0xt0", "0xt1"}, Data: "beef", BlockNumber: block, LogIndex: idx,
-			Metadata: types.EvmMetadata{ContractName: "C", Data: map[string]string{"k": "v"}},
+			Metadata: types.EvmMetadata{ContractName: "C", Data: map[string]string{"k": "v", "key": `{"fee":"3000","hooks":["0xh"]}`}},
 		}
 	}
 	logs := []types.EvmLog{mk(1, 10, 0), mk(1, 10, 1), mk(1, 12, 0), mk(2, 11, 0)}
@@ -51,6 +54,10 @@ func TestMongoStore(t *testing.T) {
 	if c, err := s.GetLogsCount(); err != nil || c != 4 {
 		t.Fatalf("count = %d, err %v (want 4)", c, err)
 	}
+	// Tuples are queryable as sub-documents.
+	if c, err := s.logs.CountDocuments(ctx, bson.M{"metadata.decoded.key.fee": "3000", "metadata.decoded.key.hooks": "0xh"}); err != nil || c != 4 {
+		t.Errorf("decoded tuple query = %d, err %v (want 4)", c, err)
+	}
 
 	ids := func(got []types.EvmLog) string {
 		out := make([]string, len(got))
@@ -96,4 +103,55 @@ func TestMongoStore(t *testing.T) {
 	if err != nil || len(txs) != 1 || txs[0].Value != "5" {
 		t.Fatalf("GetTransactions = %+v, err %v", txs, err)
 	}
+
+	if err := s.InsertBlocks([]types.EvmBlock{
+		{Id: "1:12", SourceId: 1, ChainId: 1, BlockNumber: 12, Hash: "0xh12", BaseFeePerGas: "7"},
+		{Id: "1:10", SourceId: 1, ChainId: 1, BlockNumber: 10, Hash: "0xh10", BaseFeePerGas: "7"},
+	}); err != nil {
+		t.Fatalf("insert blocks: %v", err)
+	}
+	blocks, err := s.GetBlocks(1, 0, 100)
+	if err != nil || len(blocks) != 2 || blocks[0].Hash != "0xh10" || blocks[1].BaseFeePerGas != "7" {
+		t.Fatalf("GetBlocks = %+v, err %v", blocks, err)
+	}
+
+	if err := s.InsertTraces([]types.EvmTrace{
+		{Id: "1:1:0xt:0", SourceId: 1, ChainId: 1, BlockNumber: 12, TransactionHash: "0xt", TraceAddress: "0", Type: "CALL", Value: "5"},
+		{Id: "1:1:0xt:", SourceId: 1, ChainId: 1, BlockNumber: 12, TransactionHash: "0xt", Type: "CALL"},
+	}); err != nil {
+		t.Fatalf("insert traces: %v", err)
+	}
+	traces, err := s.GetTraces(1, 0, 100)
+	if err != nil || len(traces) != 2 || traces[0].TraceAddress != "" || traces[1].Value != "5" {
+		t.Fatalf("GetTraces = %+v, err %v", traces, err)
+	}
+
+	redecoded := got[0]
+	redecoded.Metadata = types.EvmMetadata{ContractName: "C", EventName: "Swap", Data: map[string]string{"k": "w"}}
+	if err := s.UpdateLogsMetadata([]types.EvmLog{redecoded, mk(1, 99, 0)}); err != nil {
+		t.Fatalf("update metadata: %v", err)
+	}
+	if got, _ := s.GetLogs(1, 10, 10); len(got) != 2 || got[0].Metadata.EventName != "Swap" || got[0].Metadata.Data["k"] != "w" {
+		t.Errorf("metadata not updated: %+v", got)
+	}
+	if c, _ := s.GetLogsCount(); c != 4 {
+		t.Errorf("update must not insert missing logs, count = %d", c)
+	}
+
+	// Reorg rollback: source 1 keeps only the rows below block 11.
+	if err := s.RollbackSourceData(1, 11); err != nil {
+		t.Fatalf("rollback: %v", err)
+	}
+	if got, _ := s.GetLogs(1, 0, 100); ids(got) != fmt.Sprint([]string{"1:10:0", "1:10:1"}) {
+		t.Errorf("GetLogs after rollback = %s", ids(got))
+	}
+	if got, _ := s.GetLogs(2, 0, 100); len(got) != 1 {
+		t.Errorf("source 2 logs should remain, got %d", len(got))
+	}
+	if blocks, _ := s.GetBlocks(1, 0, 100); len(blocks) != 1 || blocks[0].BlockNumber != 10 {
+		t.Errorf("GetBlocks after rollback = %+v", blocks)
+	}
+	if traces, _ := s.GetTraces(1, 0, 100); len(traces) != 0 {
+		t.Errorf("GetTraces after rollback = %+v", traces)
+	}
 }
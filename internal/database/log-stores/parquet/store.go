@@ -1,8 +1,8 @@
 // Package parquet_store implements the EvmIndexerStorage backend as Parquet
-// files on disk. Logs and transactions are written as immutable Parquet files
-// partitioned per source; queries read the relevant source's files back and
-// filter/sort in memory. It is an analytics-friendly archival sink — writes are
-// cheap and columnar, reads scan files.
+// files on disk. Logs, transactions, blocks and traces are written as immutable
+// Parquet files partitioned per source; queries read the relevant source's
+// files back and filter/sort in memory. It is an analytics-friendly archival
+// sink — writes are cheap and columnar, reads scan files.
 package parquet_store
 
 import (
@@ -18,9 +18,11 @@ import (
 )
 
 type ParquetStore struct {
-	logger  zerolog.Logger
-	logsDir string
-	txDir   string
+	logger    zerolog.Logger
+	logsDir   string
+	txDir     string
+	blocksDir string
+	tracesDir string
 }
 
 func NewParquetStore(logger zerolog.Logger) (*ParquetStore, error) {
@@ -34,10 +36,14 @@ func (s *ParquetStore) Init(config map[string]string) error {
 	}
 	s.logsDir = filepath.Join(base, "logs")
 	s.txDir = filepath.Join(base, "transactions")
-	if err := os.MkdirAll(s.logsDir, 0o755); err != nil {
-		return err
+	s.blocksDir = filepath.Join(base, "blocks")
+	s.tracesDir = filepath.Join(base, "traces")
+	for _, dir := range []string{s.logsDir, s.txDir, s.blocksDir, s.tracesDir} {
+		if err := os.MkdirAll(dir, 0o755); err != nil {
+			return err
+		}
 	}
-	return os.MkdirAll(s.txDir, 0o755)
+	return nil
 }
 
 // --- parquet row models (complex fields JSON-encoded to keep a flat schema) ---
@@ -76,10 +82,58 @@ type parquetTx struct {
 	Nonce            uint64 `parquet:"nonce"`
 	To               string `parquet:"to"`
 	Hash             string `parquet:"hash"`
-	ContractName     string `parquet:"metadata_contract_name"`
-	EventName        string `parquet:"metadata_event_name"`
-	FunctionName     string `parquet:"metadata_function_name"`
-	MetadataData     string `parquet:"metadata_data"`
+	Type             uint64 `parquet:"type"`
+
+	Status              uint64 `parquet:"status"`
+	GasUsed             uint64 `parquet:"gas_used"`
+	EffectiveGasPrice   string `parquet:"effective_gas_price"`
+	ContractAddress     string `parquet:"contract_address"`
+	BlobGasUsed         uint64 `parquet:"blob_gas_used"`
+	BlobGasPrice        string `parquet:"blob_gas_price"`
+	BlobVersionedHashes string `parquet:"blob_versioned_hashes"`
+
+	ContractName string `parquet:"metadata_contract_name"`
+	EventName    string `parquet:"metadata_event_name"`
+	FunctionName string `parquet:"metadata_function_name"`
+	MetadataData string `parquet:"metadata_data"`
+}
+
+type parquetBlock struct {
+	Id               string `parquet:"id"`
+	SourceId         uint64 `parquet:"source_id"`
+	ChainId          uint64 `parquet:"chain_id"`
+	BlockNumber      uint64 `parquet:"block_number"`
+	BlockTimestamp   uint64 `parquet:"block_timestamp"`
+	Hash             string `parquet:"hash"`
+	ParentHash       string `parquet:"parent_hash"`
+	Miner            string `parquet:"miner"`
+	GasUsed          uint64 `parquet:"gas_used"`
+	GasLimit         uint64 `parquet:"gas_limit"`
+	BaseFeePerGas    string `parquet:"base_fee_per_gas"`
+	BlobGasUsed      uint64 `parquet:"blob_gas_used"`
+	ExcessBlobGas    uint64 `parquet:"excess_blob_gas"`
+	TransactionCount uint64 `parquet:"transaction_count"`
+	LogsBloom        string `parquet:"logs_bloom"`
+}
+
+type parquetTrace struct {
+	Id               string `parquet:"id"`
+	SourceId         uint64 `parquet:"source_id"`
+	ChainId          uint64 `parquet:"chain_id"`
+	BlockNumber      uint64 `parquet:"block_number"`
+	BlockTimestamp   uint64 `parquet:"block_timestamp"`
+	TransactionHash  string `parquet:"transaction_hash"`
+	TransactionIndex uint64 `parquet:"transaction_index"`
+	TraceAddress     string `parquet:"trace_address"`
+	Type             string `parquet:"type"`
+	From             string `parquet:"from"`
+	To               string `parquet:"to"`
+	Value            string `parquet:"value"`
+	Input            string `parquet:"input"`
+	Output           string `parquet:"output"`
+	Gas              uint64 `parquet:"gas"`
+	GasUsed          uint64 `parquet:"gas_used"`
+	Error            string `parquet:"error"`
 }
 
 func toParquetLog(l types.EvmLog) parquetLog {
@@ -111,9 +165,12 @@ func fromParquetLog(p parquetLog) types.EvmLog {
 
 func toParquetTx(t types.EvmTransaction) parquetTx {
 	data, _ := json.Marshal(t.Metadata.Data)
+	blobHashes, _ := json.Marshal(t.BlobVersionedHashes)
 	return parquetTx{
 		Id: t.Id, SourceId: uint64(t.SourceId), BlockNumber: t.BlockNumber, BlockTimestamp: t.BlockTimestamp, TransactionIndex: t.TransactionIndex,
-		ChainId: t.ChainId, From: t.From, Data: t.Data, Value: t.Value, Nonce: t.Nonce, To: t.To, Hash: t.Hash,
+		ChainId: t.ChainId, From: t.From, Data: t.Data, Value: t.Value, Nonce: t.Nonce, To: t.To, Hash: t.Hash, Type: t.Type,
+		Status: t.Status, GasUsed: t.GasUsed, EffectiveGasPrice: t.EffectiveGasPrice, ContractAddress: t.ContractAddress,
+		BlobGasUsed: t.BlobGasUsed, BlobGasPrice: t.BlobGasPrice, BlobVersionedHashes: string(blobHashes),
 		ContractName: t.Metadata.ContractName, EventName: t.Metadata.EventName, FunctionName: t.Metadata.FunctionName,
 		MetadataData: string(data),
 	}
@@ -122,13 +179,49 @@ func toParquetTx(t types.EvmTransaction) parquetTx {
 func fromParquetTx(p parquetTx) types.EvmTransaction {
 	data := map[string]string{}
 	_ = json.Unmarshal([]byte(p.MetadataData), &data)
+	var blobHashes []string
+	_ = json.Unmarshal([]byte(p.BlobVersionedHashes), &blobHashes)
 	return types.EvmTransaction{
 		Id: p.Id, SourceId: uint(p.SourceId), BlockNumber: p.BlockNumber, BlockTimestamp: p.BlockTimestamp, TransactionIndex: p.TransactionIndex,
-		ChainId: p.ChainId, From: p.From, Data: p.Data, Value: p.Value, Nonce: p.Nonce, To: p.To, Hash: p.Hash,
+		ChainId: p.ChainId, From: p.From, Data: p.Data, Value: p.Value, Nonce: p.Nonce, To: p.To, Hash: p.Hash, Type: p.Type,
+		Status: p.Status, GasUsed: p.GasUsed, EffectiveGasPrice: p.EffectiveGasPrice, ContractAddress: p.ContractAddress,
+		BlobGasUsed: p.BlobGasUsed, BlobGasPrice: p.BlobGasPrice, BlobVersionedHashes: blobHashes,
 		Metadata: types.EvmMetadata{ContractName: p.ContractName, EventName: p.EventName, FunctionName: p.FunctionName, Data: data},
 	}
 }
 
+func toParquetBlock(b types.EvmBlock) parquetBlock {
+	return parquetBlock{
+		Id: b.Id, SourceId: uint64(b.SourceId), ChainId: b.ChainId, BlockNumber: b.BlockNumber, BlockTimestamp: b.BlockTimestamp,
+		Hash: b.Hash, ParentHash: b.ParentHash, Miner: b.Miner, GasUsed: b.GasUsed, GasLimit: b.GasLimit, BaseFeePerGas: b.BaseFeePerGas,
+		BlobGasUsed: b.BlobGasUsed, ExcessBlobGas: b.ExcessBlobGas, TransactionCount: b.TransactionCount, LogsBloom: b.LogsBloom,
+	}
+}
+
+func fromParquetBlock(p parquetBlock) types.EvmBlock {
+	return types.EvmBlock{
+		Id: p.Id, SourceId: uint(p.SourceId), ChainId: p.ChainId, BlockNumber: p.BlockNumber, BlockTimestamp: p.BlockTimestamp,
+		Hash: p.Hash, ParentHash: p.ParentHash, Miner: p.Miner, GasUsed: p.GasUsed, GasLimit: p.GasLimit, BaseFeePerGas: p.BaseFeePerGas,
+		BlobGasUsed: p.BlobGasUsed, ExcessBlobGas: p.ExcessBlobGas, TransactionCount: p.TransactionCount, LogsBloom: p.LogsBloom,
+	}
+}
+
+func toParquetTrace(t types.EvmTrace) parquetTrace {
+	return parquetTrace{
+		Id: t.Id, SourceId: uint64(t.SourceId), ChainId: t.ChainId, BlockNumber: t.BlockNumber, BlockTimestamp: t.BlockTimestamp,
+		TransactionHash: t.TransactionHash, TransactionIndex: t.TransactionIndex, TraceAddress: t.TraceAddress,
+		Type: t.Type, From: t.From, To: t.To, Value: t.Value, Input: t.Input, Output: t.Output, Gas: t.Gas, GasUsed: t.GasUsed, Error: t.Error,
+	}
+}
+
+func fromParquetTrace(p parquetTrace) types.EvmTrace {
+	return types.EvmTrace{
+		Id: p.Id, SourceId: uint(p.SourceId), ChainId: p.ChainId, BlockNumber: p.BlockNumber, BlockTimestamp: p.BlockTimestamp,
+		TransactionHash: p.TransactionHash, TransactionIndex: p.TransactionIndex, TraceAddress: p.TraceAddress,
+		Type: p.Type, From: p.From, To: p.To, Value: p.Value, Input: p.Input, Output: p.Output, Gas: p.Gas, GasUsed: p.GasUsed, Error: p.Error,
+	}
+}
+
 // --- writes ---------------------------------------------------------------
 
 func (s *ParquetStore) InsertLogs(logs []types.EvmLog) error {
@@ -175,6 +268,50 @@ func (s *ParquetStore) InsertTransactions(txs []types.EvmTransaction) error {
 	return nil
 }
 
+func (s *ParquetStore) InsertBlocks(blocks []types.EvmBlock) error {
+	bySource := map[uint]([]parquetBlock){}
+	for _, b := range blocks {
+		bySource[b.SourceId] = append(bySource[b.SourceId], toParquetBlock(b))
+	}
+	for sourceId, rows := range bySource {
+		var minBlock, maxBlock uint64
+		for i, r := range rows {
+			if i == 0 || r.BlockNumber < minBlock {
+				minBlock = r.BlockNumber
+			}
+			if r.BlockNumber > maxBlock {
+				maxBlock = r.BlockNumber
+			}
+		}
+		if err := writeBatchFile(s.sourceDir(s.blocksDir, uint64(sourceId)), minBlock, maxBlock, rows); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *ParquetStore) InsertTraces(traces []types.EvmTrace) error {
+	bySource := map[uint]([]parquetTrace){}
+	for _, t := range traces {
+		bySource[t.SourceId] = append(bySource[t.SourceId], toParquetTrace(t))
+	}
+	for sourceId, rows := range bySource {
+		var minBlock, maxBlock uint64
+		for i, r := range rows {
+			if i == 0 || r.BlockNumber < minBlock {
+				minBlock = r.BlockNumber
+			}
+			if r.BlockNumber > maxBlock {
+				maxBlock = r.BlockNumber
+			}
+		}
+		if err := writeBatchFile(s.sourceDir(s.tracesDir, uint64(sourceId)), minBlock, maxBlock, rows); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // writeBatchFile writes one batch as a parquet file named after its block
 // range. Inserts are replayed after a crash (the sync cursor only advances
 // once the write succeeded), so the name must be deterministic: replaying the
@@ -194,14 +331,131 @@ func writeBatchFile[T any](dir string, minBlock, maxBlock uint64, rows []T) erro
 	return os.Rename(tmp, final)
 }
 
-// DeleteSourceData removes the source's log and transaction partition directories
-// (and every parquet file in them). Removing a directory that was never written is
-// a no-op.
+// UpdateLogsMetadata rewrites the batch files holding the logs, under the same
+// names, with the logs' new metadata. Only the files whose block range
+// overlaps the logs' are read.
+func (s *ParquetStore) UpdateLogsMetadata(logs []types.EvmLog) error {
+	bySource := map[uint64]map[string]types.EvmLog{}
+	minBlocks, maxBlocks := map[uint64]uint64{}, map[uint64]uint64{}
+	for _, l := range logs {
+		sourceId := uint64(l.SourceId)
+		if bySource[sourceId] == nil {
+			bySource[sourceId] = map[string]types.EvmLog{}
+			minBlocks[sourceId], maxBlocks[sourceId] = l.BlockNumber, l.BlockNumber
+		}
+		bySource[sourceId][l.Id] = l
+		minBlocks[sourceId] = min(minBlocks[sourceId], l.BlockNumber)
+		maxBlocks[sourceId] = max(maxBlocks[sourceId], l.BlockNumber)
+	}
+
+	for sourceId, updates := range bySource {
+		dir := s.sourceDir(s.logsDir, sourceId)
+		files, err := parquetFiles(dir)
+		if err != nil {
+			return err
+		}
+		for _, f := range files {
+			var minBlock, maxBlock uint64
+			if _, err := fmt.Sscanf(filepath.Base(f), "%d-%d.parquet", &minBlock, &maxBlock); err != nil {
+				return fmt.Errorf("parquet store: unexpected batch file name %s", f)
+			}
+			if maxBlock < minBlocks[sourceId] || minBlock > maxBlocks[sourceId] {
+				continue
+			}
+
+			rows, err := parquet.ReadFile[parquetLog](f)
+			if err != nil {
+				return err
+			}
+			changed := false
+			for i, r := range rows {
+				if l, ok := updates[r.Id]; ok {
+					rows[i] = toParquetLog(l)
+					changed = true
+				}
+			}
+			if !changed {
+				continue
+			}
+			if err := writeBatchFile(dir, minBlock, maxBlock, rows); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// DeleteSourceData removes the source's log, transaction, block and trace
+// partition directories (and every parquet file in them). Removing a directory
+// that was never written is a no-op.
 func (s *ParquetStore) DeleteSourceData(sourceId uint64) error {
-	if err := os.RemoveAll(s.sourceDir(s.logsDir, sourceId)); err != nil {
+	for _, base := range []string{s.logsDir, s.txDir, s.blocksDir, s.tracesDir} {
+		if err := os.RemoveAll(s.sourceDir(base, sourceId)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// RollbackSourceData drops the source's rows at or above fromBlock. Batch files
+// lying entirely in the rolled-back range are removed; a file straddling
+// fromBlock is rewritten with only its earlier rows, under the name matching its
+// new block range (so a later replay of that range still overwrites it).
+func (s *ParquetStore) RollbackSourceData(sourceId uint64, fromBlock uint64) error {
+	if err := rollbackFiles(s.sourceDir(s.logsDir, sourceId), fromBlock, func(r parquetLog) uint64 { return r.BlockNumber }); err != nil {
 		return err
 	}
-	return os.RemoveAll(s.sourceDir(s.txDir, sourceId))
+	if err := rollbackFiles(s.sourceDir(s.txDir, sourceId), fromBlock, func(r parquetTx) uint64 { return r.BlockNumber }); err != nil {
+		return err
+	}
+	if err := rollbackFiles(s.sourceDir(s.blocksDir, sourceId), fromBlock, func(r parquetBlock) uint64 { return r.BlockNumber }); err != nil {
+		return err
+	}
+	return rollbackFiles(s.sourceDir(s.tracesDir, sourceId), fromBlock, func(r parquetTrace) uint64 { return r.BlockNumber })
+}
+
+func rollbackFiles[T any](dir string, fromBlock uint64, blockOf func(T) uint64) error {
+	files, err := parquetFiles(dir)
+	if err != nil {
+		return err
+	}
+	for _, f := range files {
+		var minBlock, maxBlock uint64
+		if _, err := fmt.Sscanf(filepath.Base(f), "%d-%d.parquet", &minBlock, &maxBlock); err != nil {
+			return fmt.Errorf("parquet store: unexpected batch file name %s", f)
+		}
+		if maxBlock < fromBlock {
+			continue
+		}
+		if minBlock >= fromBlock {
+			if err := os.Remove(f); err != nil {
+				return err
+			}
+			continue
+		}
+
+		rows, err := parquet.ReadFile[T](f)
+		if err != nil {
+			return err
+		}
+		kept := make([]T, 0, len(rows))
+		var keptMax uint64
+		for _, r := range rows {
+			if b := blockOf(r); b < fromBlock {
+				kept = append(kept, r)
+				if b > keptMax {
+					keptMax = b
+				}
+			}
+		}
+		if err := writeBatchFile(dir, minBlock, keptMax, kept); err != nil {
+			return err
+		}
+		if err := os.Remove(f); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 // --- reads ----------------------------------------------------------------
@@ -310,6 +564,62 @@ func (s *ParquetStore) GetTransactions(sourceId uint64, fromBlock uint64, toBloc
 	return out, nil
 }
 
+func (s *ParquetStore) GetBlocks(sourceId uint64, fromBlock uint64, toBlock uint64) ([]types.EvmBlock, error) {
+	files, err := parquetFiles(s.sourceDir(s.blocksDir, sourceId))
+	if err != nil {
+		return nil, err
+	}
+	out := []types.EvmBlock{}
+	seen := map[string]struct{}{}
+	for _, f := range files {
+		rows, err := parquet.ReadFile[parquetBlock](f)
+		if err != nil {
+			return nil, err
+		}
+		for _, r := range rows {
+			if _, dup := seen[r.Id]; dup || r.BlockNumber < fromBlock || r.BlockNumber > toBlock {
+				continue
+			}
+			seen[r.Id] = struct{}{}
+			out = append(out, fromParquetBlock(r))
+		}
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].BlockNumber < out[j].BlockNumber })
+	return out, nil
+}
+
+func (s *ParquetStore) GetTraces(sourceId uint64, fromBlock uint64, toBlock uint64) ([]types.EvmTrace, error) {
+	files, err := parquetFiles(s.sourceDir(s.tracesDir, sourceId))
+	if err != nil {
+		return nil, err
+	}
+	out := []types.EvmTrace{}
+	seen := map[string]struct{}{}
+	for _, f := range files {
+		rows, err := parquet.ReadFile[parquetTrace](f)
+		if err != nil {
+			return nil, err
+		}
+		for _, r := range rows {
+			if _, dup := seen[r.Id]; dup || r.BlockNumber < fromBlock || r.BlockNumber > toBlock {
+				continue
+			}
+			seen[r.Id] = struct{}{}
+			out = append(out, fromParquetTrace(r))
+		}
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].BlockNumber != out[j].BlockNumber {
+			return out[i].BlockNumber < out[j].BlockNumber
+		}
+		if out[i].TransactionIndex != out[j].TransactionIndex {
+			return out[i].TransactionIndex < out[j].TransactionIndex
+		}
+		return out[i].TraceAddress < out[j].TraceAddress
+	})
+	return out, nil
+}
+
 // --- helpers --------------------------------------------------------------
 
 func (s *ParquetStore) sourceDir(base string, sourceId uint64) string {
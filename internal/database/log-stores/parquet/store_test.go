@@ -95,7 +95,8 @@ func TestParquetLogsRoundTrip(t *testing.T) {
 func TestParquetTransactionsRoundTrip(t *testing.T) {
 	s := newStore(t)
 	if err := s.InsertTransactions([]types.EvmTransaction{
-		{Id: "1:10:tx", SourceId: 1, BlockNumber: 10, ChainId: 1, From: "0xf", To: "0xt", Value: "5", Hash: "0xh"},
+		{Id: "1:10:tx", SourceId: 1, BlockNumber: 10, ChainId: 1, From: "0xf", To: "0xt", Value: "5", Hash: "0xh",
+			Type: 2, Status: 1, GasUsed: 21000, EffectiveGasPrice: "7", ContractAddress: "0xc", BlobVersionedHashes: []string{"0x01aa"}},
 	}); err != nil {
 		t.Fatalf("insert txs: %v", err)
 	}
@@ -103,6 +104,10 @@ func TestParquetTransactionsRoundTrip(t *testing.T) {
 	if err != nil || len(txs) != 1 || txs[0].Value != "5" || txs[0].From != "0xf" {
 		t.Fatalf("GetTransactions = %+v, err %v", txs, err)
 	}
+	if tx := txs[0]; tx.Type != 2 || tx.Status != 1 || tx.GasUsed != 21000 || tx.EffectiveGasPrice != "7" ||
+		tx.ContractAddress != "0xc" || len(tx.BlobVersionedHashes) != 1 {
+		t.Errorf("receipt fields not round-tripped: %+v", tx)
+	}
 }
 
 func TestParquetInsertReplayDoesNotDuplicate(t *testing.T) {
@@ -179,3 +184,167 @@ func TestParquetDeleteSourceData(t *testing.T) {
 		t.Errorf("delete of empty source should be no-op, got %v", err)
 	}
 }
+
+func TestParquetRollbackSourceData(t *testing.T) {
+	s := newStore(t)
+	// Two batch files: [10..12] straddles the rollback point, [14..15] is above it.
+	if err := s.InsertLogs([]types.EvmLog{mkLog(1, 10, 0), mkLog(1, 11, 0), mkLog(1, 12, 0)}); err != nil {
+		t.Fatalf("insert: %v", err)
+	}
+	if err := s.InsertLogs([]types.EvmLog{mkLog(1, 14, 0), mkLog(1, 15, 0)}); err != nil {
+		t.Fatalf("insert: %v", err)
+	}
+	if err := s.InsertLogs([]types.EvmLog{mkLog(2, 11, 1)}); err != nil {
+		t.Fatalf("insert: %v", err)
+	}
+	if err := s.InsertTransactions([]types.EvmTransaction{
+		{Id: "1:tx10", SourceId: 1, BlockNumber: 10, ChainId: 1, Hash: "0xh10"},
+		{Id: "1:tx14", SourceId: 1, BlockNumber: 14, ChainId: 1, Hash: "0xh14"},
+	}); err != nil {
+		t.Fatalf("insert txs: %v", err)
+	}
+
+	if err := s.RollbackSourceData(1, 11); err != nil {
+		t.Fatalf("rollback: %v", err)
+	}
+
+	got, err := s.GetLogs(1, 0, 100)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fmt.Sprint(ids(got)) != fmt.Sprint([]string{"1:10:0"}) {
+		t.Errorf("source 1 logs after rollback = %v", ids(got))
+	}
+	if txs, _ := s.GetTransactions(1, 0, 100); len(txs) != 1 || txs[0].BlockNumber != 10 {
+		t.Errorf("source 1 txs after rollback = %+v", txs)
+	}
+	if logs, _ := s.GetLogs(2, 0, 100); len(logs) != 1 {
+		t.Errorf("source 2 logs should remain, got %d", len(logs))
+	}
+
+	// Re-indexing the rolled-back range does not duplicate the surviving block.
+	if err := s.InsertLogs([]types.EvmLog{mkLog(1, 10, 0), mkLog(1, 11, 0), mkLog(1, 12, 0)}); err != nil {
+		t.Fatalf("replay insert: %v", err)
+	}
+	if c, err := s.GetLogsCount(); err != nil || c != 4 {
+		t.Fatalf("count = %d, err %v (want 4 after replay)", c, err)
+	}
+
+	// Rolling back a source with nothing stored is a no-op.
+	if err := s.RollbackSourceData(999, 0); err != nil {
+		t.Errorf("rollback of empty source should be no-op, got %v", err)
+	}
+}
+
+func TestParquetUpdateLogsMetadata(t *testing.T) {
+	s := newStore(t)
+	if err := s.InsertLogs([]types.EvmLog{mkLog(1, 10, 0), mkLog(1, 11, 0)}); err != nil {
+		t.Fatalf("insert: %v", err)
+	}
+	if err := s.InsertLogs([]types.EvmLog{mkLog(1, 14, 0)}); err != nil {
+		t.Fatalf("insert: %v", err)
+	}
+
+	decoded := mkLog(1, 11, 0)
+	decoded.Metadata = types.EvmMetadata{ContractName: "ERC20", EventName: "Transfer", Data: map[string]string{"value": "5"}}
+	gone := mkLog(1, 12, 0)
+	gone.Metadata = decoded.Metadata
+	if err := s.UpdateLogsMetadata([]types.EvmLog{decoded, gone}); err != nil {
+		t.Fatalf("update: %v", err)
+	}
+
+	got, err := s.GetLogs(1, 0, 100)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fmt.Sprint(ids(got)) != fmt.Sprint([]string{"1:10:0", "1:11:0", "1:14:0"}) {
+		t.Fatalf("logs after update = %v", ids(got))
+	}
+	if m := got[1].Metadata; m.EventName != "Transfer" || m.Data["value"] != "5" {
+		t.Errorf("updated metadata = %+v", m)
+	}
+	if m := got[0].Metadata; m.EventName != "E" {
+		t.Errorf("untouched metadata = %+v", m)
+	}
+}
+
+func TestParquetBlocksRoundTrip(t *testing.T) {
+	s := newStore(t)
+	mkBlock := func(sourceId uint, number uint64) types.EvmBlock {
+		return types.EvmBlock{Id: fmt.Sprintf("1:%d", number), SourceId: sourceId, ChainId: 1, BlockNumber: number, BlockTimestamp: number * 12,
+			Hash: fmt.Sprintf("0xh%d", number), ParentHash: fmt.Sprintf("0xh%d", number-1), Miner: "0xm", GasUsed: 100, GasLimit: 200,
+			BaseFeePerGas: "7", BlobGasUsed: 131072, ExcessBlobGas: 3, TransactionCount: 4, LogsBloom: "0x00"}
+	}
+	blocks := []types.EvmBlock{mkBlock(1, 12), mkBlock(1, 10), mkBlock(1, 11), mkBlock(2, 20)}
+	if err := s.InsertBlocks(blocks); err != nil {
+		t.Fatalf("insert: %v", err)
+	}
+	if err := s.InsertBlocks(blocks); err != nil {
+		t.Fatalf("re-insert: %v", err)
+	}
+
+	got, err := s.GetBlocks(1, 10, 11)
+	if err != nil || len(got) != 2 || got[0].BlockNumber != 10 || got[1].BlockNumber != 11 {
+		t.Fatalf("GetBlocks = %+v, err %v", got, err)
+	}
+	if got[0] != mkBlock(1, 10) {
+		t.Errorf("block not round-tripped: %+v", got[0])
+	}
+
+	if err := s.RollbackSourceData(1, 11); err != nil {
+		t.Fatalf("rollback: %v", err)
+	}
+	if got, _ := s.GetBlocks(1, 0, 100); len(got) != 1 || got[0].BlockNumber != 10 {
+		t.Errorf("source 1 blocks after rollback = %+v", got)
+	}
+	if err := s.DeleteSourceData(1); err != nil {
+		t.Fatalf("delete: %v", err)
+	}
+	if got, _ := s.GetBlocks(1, 0, 100); len(got) != 0 {
+		t.Errorf("source 1 blocks not deleted: %d", len(got))
+	}
+	if got, _ := s.GetBlocks(2, 0, 100); len(got) != 1 {
+		t.Errorf("source 2 blocks should remain, got %d", len(got))
+	}
+}
+
+func TestParquetTracesRoundTrip(t *testing.T) {
+	s := newStore(t)
+	mkTrace := func(sourceId uint, number uint64, traceAddress string) types.EvmTrace {
+		return types.EvmTrace{Id: fmt.Sprintf("1:%d:0xt%d:%s", sourceId, number, traceAddress), SourceId: sourceId, ChainId: 1, BlockNumber: number,
+			BlockTimestamp: number * 12, TransactionHash: fmt.Sprintf("0xt%d", number), TransactionIndex: 2, TraceAddress: traceAddress,
+			Type: "CALL", From: "0xa", To: "0xb", Value: "5", Input: "0x", Output: "0x", Gas: 100, GasUsed: 50, Error: "Reverted"}
+	}
+	traces := []types.EvmTrace{mkTrace(1, 11, ""), mkTrace(1, 10, "0"), mkTrace(1, 10, ""), mkTrace(2, 20, "")}
+	if err := s.InsertTraces(traces); err != nil {
+		t.Fatalf("insert: %v", err)
+	}
+	// Replaying the range does not duplicate.
+	if err := s.InsertTraces(traces); err != nil {
+		t.Fatalf("re-insert: %v", err)
+	}
+
+	got, err := s.GetTraces(1, 10, 11)
+	if err != nil || len(got) != 3 || got[0].TraceAddress != "" || got[1].TraceAddress != "0" || got[2].BlockNumber != 11 {
+		t.Fatalf("GetTraces = %+v, err %v", got, err)
+	}
+	if got[1] != mkTrace(1, 10, "0") {
+		t.Errorf("trace not round-tripped: %+v", got[1])
+	}
+
+	if err := s.RollbackSourceData(1, 11); err != nil {
+		t.Fatalf("rollback: %v", err)
+	}
+	if got, _ := s.GetTraces(1, 0, 100); len(got) != 2 {
+		t.Errorf("source 1 traces after rollback = %+v", got)
+	}
+	if err := s.DeleteSourceData(1); err != nil {
+		t.Fatalf("delete: %v", err)
+	}
+	if got, _ := s.GetTraces(1, 0, 100); len(got) != 0 {
+		t.Errorf("source 1 traces not deleted: %d", len(got))
+	}
+	if got, _ := s.GetTraces(2, 0, 100); len(got) != 1 {
+		t.Errorf("source 2 traces should remain, got %d", len(got))
+	}
+}
@@ -0,0 +1,177 @@
+package sql_store
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/evmi-cloud/go-evm-indexer/internal/database/log-stores/eventtables"
+	"github.com/evmi-cloud/go-evm-indexer/internal/types"
+	"gorm.io/gorm/clause"
+)
+
+// sqlEventTable registers an event table, so deletes and rollbacks reach it
+// and later runs know the types of its argument columns (Columns, JSON of
+// column name to eventtables.Column.Type).
+type sqlEventTable struct {
+	Name         string `gorm:"column:name;type:varchar(255);primaryKey"`
+	ContractName string `gorm:"column:contract_name;type:varchar(255)"`
+	EventName    string `gorm:"column:event_name;type:varchar(255)"`
+	Columns      string `gorm:"column:columns;type:text"`
+}
+
+func (sqlEventTable) TableName() string { return "evm_event_tables" }
+
+// sqlEventLog holds the standard columns of an event table; the arguments are
+// added as arg_* columns by EnsureEventTables.
+type sqlEventLog struct {
+	Id               string `gorm:"column:id;type:varchar(255);primaryKey"`
+	SourceId         uint   `gorm:"column:source_id;index"`
+	ChainId          uint64 `gorm:"column:chain_id"`
+	Address          string `gorm:"column:address;type:varchar(255)"`
+	BlockNumber      uint64 `gorm:"column:block_number;index"`
+	BlockTimestamp   uint64 `gorm:"column:block_timestamp"`
+	BlockHash        string `gorm:"column:block_hash;type:varchar(255)"`
+	TransactionHash  string `gorm:"column:transaction_hash;type:varchar(255)"`
+	TransactionIndex uint64 `gorm:"column:transaction_index"`
+	TransactionFrom  string `gorm:"column:transaction_from;type:varchar(255)"`
+	LogIndex         uint64 `gorm:"column:log_index"`
+}
+
+// EnsureEventTables creates or migrates the tables of contractName's events
+// and routes their logs there from then on.
+func (s *SQLStore) EnsureEventTables(contractName string, events []types.EvmEntrySchema) error {
+	if !s.eventTables {
+		return nil
+	}
+	for _, table := range eventtables.Tables(contractName, events) {
+		if err := s.ensureEventTable(&table); err != nil {
+			return fmt.Errorf("sql store: event table %s: %w", table.Name, err)
+		}
+		s.tables[table.Key()] = table
+	}
+	return nil
+}
+
+func (s *SQLStore) ensureEventTable(table *eventtables.Table) error {
+	var entries []sqlEventTable
+	if err := s.db.Where("name = ?", table.Name).Limit(1).Find(&entries).Error; err != nil {
+		return err
+	}
+	known := map[string]string{}
+	if len(entries) > 0 {
+		_ = json.Unmarshal([]byte(entries[0].Columns), &known)
+	}
+
+	if err := s.db.Table(table.Name).AutoMigrate(&sqlEventLog{}); err != nil {
+		return err
+	}
+	for _, column := range eventtables.Evolve(table, known) {
+		// Another source of the same ABI may be adding the column too.
+		if s.db.Migrator().HasColumn(table.Name, column.Name) {
+			continue
+		}
+		err := s.db.Exec("ALTER TABLE ? ADD COLUMN ? "+s.eventColumnType(column), clause.Table{Name: table.Name}, clause.Column{Name: column.Name}).Error
+		if err != nil && !s.db.Migrator().HasColumn(table.Name, column.Name) {
+			return err
+		}
+	}
+
+	columns, _ := json.Marshal(known)
+	entry := sqlEventTable{Name: table.Name, ContractName: table.ContractName, EventName: table.EventName, Columns: string(columns)}
+	return s.db.Clauses(clause.OnConflict{UpdateAll: true}).Create(&entry).Error
+}
+
+// eventColumnType is the column type of an argument: bigint for integers it
+// fits, the numeric type of sqlNumeric for wider ones, and JSON text for
+// arrays and tuples.
+func (s *SQLStore) eventColumnType(column eventtables.Column) string {
+	switch column.Kind {
+	case eventtables.Uint, eventtables.Int:
+		if fitsBigint(column) {
+			return "bigint"
+		}
+		return sqlNumeric{}.GormDBDataType(s.db, nil)
+	case eventtables.Bool:
+		return "boolean"
+	}
+	if column.Fixed() {
+		return "varchar(255)"
+	}
+	return "text"
+}
+
+func fitsBigint(column eventtables.Column) bool {
+	return column.Bits < 64 || (column.Kind == eventtables.Int && column.Bits == 64)
+}
+
+func (s *SQLStore) eventColumnValue(column eventtables.Column, data map[string]string) any {
+	value, ok := column.Value(data)
+	if !ok {
+		return nil
+	}
+	switch v := value.(type) {
+	case *big.Int:
+		if fitsBigint(column) {
+			return v.Int64()
+		}
+		digits := v.String()
+		if s.dialect == "mysql" && len(strings.TrimPrefix(digits, "-")) > mysqlMaxDigits {
+			return nil
+		}
+		return digits
+	case bool, string:
+		return v
+	}
+	// Arrays, as their canonical JSON.
+	return data[column.Arg.Name]
+}
+
+// insertEventLogs writes the logs of events with a table to it.
+func (s *SQLStore) insertEventLogs(logs []types.EvmLog) error {
+	rows := map[string][]map[string]any{}
+	for _, l := range logs {
+		key, ok := eventtables.KeyOf(l.Metadata)
+		if !ok {
+			continue
+		}
+		table, ok := s.tables[key]
+		if !ok {
+			continue
+		}
+		row := map[string]any{
+			"id": l.Id, "source_id": l.SourceId, "chain_id": l.ChainId, "address": l.Address,
+			"block_number": l.BlockNumber, "block_timestamp": l.BlockTimestamp, "block_hash": l.BlockHash,
+			"transaction_hash": l.TransactionHash, "transaction_index": l.TransactionIndex,
+			"transaction_from": l.TransactionFrom, "log_index": l.LogIndex,
+		}
+		for _, column := range table.Columns {
+			row[column.Name] = s.eventColumnValue(column, l.Metadata.Data)
+		}
+		rows[table.Name] = append(rows[table.Name], row)
+	}
+
+	for name, tableRows := range rows {
+		if err := s.db.Table(name).Clauses(clause.OnConflict{DoNothing: true}).CreateInBatches(tableRows, 200).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// deleteEventLogs deletes the rows matching where from every registered event
+// table, enabled or not in this store.
+func (s *SQLStore) deleteEventLogs(where string, args ...any) error {
+	var names []string
+	if err := s.db.Model(&sqlEventTable{}).Pluck("name", &names).Error; err != nil {
+		return err
+	}
+	for _, name := range names {
+		err := s.db.Exec("DELETE FROM ? WHERE "+where, append([]any{clause.Table{Name: name}}, args...)...).Error
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -1,14 +1,21 @@
 // Package sql_store implements the EvmIndexerStorage backend on a relational
 // database via GORM. The same implementation serves MySQL and PostgreSQL (and
-// SQLite, used in tests) — only the dialect differs. Logs and transactions live
-// in flat tables keyed by their stable id (inserts dedupe on conflict); complex
-// fields (topics, metadata map) are JSON-encoded into text columns.
+// SQLite, used in tests) — only the dialect differs. Logs, transactions,
+// blocks and traces live in flat tables keyed by their stable id (inserts
+// dedupe on conflict); complex fields (topics, metadata map) are JSON-encoded
+// into text columns. Decoded arguments are also written one row each, typed
+// after their ABI schema, to evm_log_args and evm_transaction_args. With the
+// "eventTables" config, decoded logs are also written to one table per event,
+// with a typed column per argument (see EnsureEventTables).
 package sql_store
 
 import (
+	"database/sql"
 	"encoding/json"
 	"fmt"
+	"strings"
 
+	"github.com/evmi-cloud/go-evm-indexer/internal/database/log-stores/eventtables"
 	"github.com/evmi-cloud/go-evm-indexer/internal/types"
 	"github.com/rs/zerolog"
 	"gorm.io/driver/mysql"
@@ -17,12 +24,16 @@ import (
 	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
 	gormlogger "gorm.io/gorm/logger"
+	"gorm.io/gorm/schema"
 )
 
 type SQLStore struct {
 	logger  zerolog.Logger
 	dialect string
 	db      *gorm.DB
+
+	eventTables bool
+	tables      map[eventtables.Key]eventtables.Table
 }
 
 // NewSQLStore creates a store for the given dialect: "mysql", "postgres" or
@@ -53,10 +64,17 @@ func (s *SQLStore) Init(config map[string]string) error {
 	if err != nil {
 		return err
 	}
-	if err := db.AutoMigrate(&sqlLog{}, &sqlTx{}); err != nil {
+	if err := db.AutoMigrate(&sqlLog{}, &sqlTx{}, &sqlBlock{}, &sqlTrace{}, &sqlEventTable{}); err != nil {
 		return err
 	}
+	for _, table := range []string{logArgsTable, txArgsTable} {
+		if err := db.Table(table).AutoMigrate(&sqlArg{}); err != nil {
+			return err
+		}
+	}
 	s.db = db
+	s.eventTables = eventtables.Enabled(config)
+	s.tables = map[eventtables.Key]eventtables.Table{}
 	return nil
 }
 
@@ -98,6 +116,14 @@ type sqlTx struct {
 	Nonce                uint64 `gorm:"column:nonce"`
 	To                   string `gorm:"column:to_address;type:varchar(255)"`
 	Hash                 string `gorm:"column:hash;type:varchar(255)"`
+	Type                 uint64 `gorm:"column:type"`
+	Status               uint64 `gorm:"column:status"`
+	GasUsed              uint64 `gorm:"column:gas_used"`
+	EffectiveGasPrice    string `gorm:"column:effective_gas_price;type:varchar(255)"`
+	ContractAddress      string `gorm:"column:contract_address;type:varchar(255)"`
+	BlobGasUsed          uint64 `gorm:"column:blob_gas_used"`
+	BlobGasPrice         string `gorm:"column:blob_gas_price;type:varchar(255)"`
+	BlobVersionedHashes  string `gorm:"column:blob_versioned_hashes;type:text"`
 	MetadataContractName string `gorm:"column:metadata_contract_name;type:varchar(255)"`
 	MetadataEventName    string `gorm:"column:metadata_event_name;type:varchar(255)"`
 	MetadataFunctionName string `gorm:"column:metadata_function_name;type:varchar(255)"`
@@ -106,6 +132,108 @@ type sqlTx struct {
 
 func (sqlTx) TableName() string { return "evm_transactions" }
 
+type sqlBlock struct {
+	Id               string `gorm:"column:id;type:varchar(255);primaryKey"`
+	SourceId         uint   `gorm:"column:source_id;index"`
+	ChainId          uint64 `gorm:"column:chain_id"`
+	BlockNumber      uint64 `gorm:"column:block_number;index"`
+	BlockTimestamp   uint64 `gorm:"column:block_timestamp"`
+	Hash             string `gorm:"column:hash;type:varchar(255)"`
+	ParentHash       string `gorm:"column:parent_hash;type:varchar(255)"`
+	Miner            string `gorm:"column:miner;type:varchar(255)"`
+	GasUsed          uint64 `gorm:"column:gas_used"`
+	GasLimit         uint64 `gorm:"column:gas_limit"`
+	BaseFeePerGas    string `gorm:"column:base_fee_per_gas;type:varchar(255)"`
+	BlobGasUsed      uint64 `gorm:"column:blob_gas_used"`
+	ExcessBlobGas    uint64 `gorm:"column:excess_blob_gas"`
+	TransactionCount uint64 `gorm:"column:transaction_count"`
+	LogsBloom        string `gorm:"column:logs_bloom;type:text"`
+}
+
+func (sqlBlock) TableName() string { return "evm_blocks" }
+
+type sqlTrace struct {
+	Id               string `gorm:"column:id;type:varchar(255);primaryKey"`
+	SourceId         uint   `gorm:"column:source_id;index"`
+	ChainId          uint64 `gorm:"column:chain_id"`
+	BlockNumber      uint64 `gorm:"column:block_number;index"`
+	BlockTimestamp   uint64 `gorm:"column:block_timestamp"`
+	TransactionHash  string `gorm:"column:transaction_hash;type:varchar(255)"`
+	TransactionIndex uint64 `gorm:"column:transaction_index"`
+	TraceAddress     string `gorm:"column:trace_address;type:varchar(255)"`
+	Type             string `gorm:"column:type;type:varchar(255)"`
+	From             string `gorm:"column:from_address;type:varchar(255);index"`
+	To               string `gorm:"column:to_address;type:varchar(255);index"`
+	Value            string `gorm:"column:value;type:varchar(255)"`
+	Input            string `gorm:"column:input;type:text"`
+	Output           string `gorm:"column:output;type:text"`
+	Gas              uint64 `gorm:"column:gas"`
+	GasUsed          uint64 `gorm:"column:gas_used"`
+	Error            string `gorm:"column:error;type:text"`
+}
+
+func (sqlTrace) TableName() string { return "evm_traces" }
+
+const (
+	logArgsTable = "evm_log_args"
+	txArgsTable  = "evm_transaction_args"
+)
+
+// sqlArg is one decoded argument of a log (evm_log_args) or transaction
+// (evm_transaction_args), keyed by the row id and argument name. Value is the
+// argument as in metadata_data; integers are also in Numeric so they can be
+// summed and compared.
+type sqlArg struct {
+	RowId       string     `gorm:"column:row_id;type:varchar(255);primaryKey"`
+	Name        string     `gorm:"column:name;type:varchar(255);primaryKey"`
+	SourceId    uint       `gorm:"column:source_id;index"`
+	BlockNumber uint64     `gorm:"column:block_number;index"`
+	Type        string     `gorm:"column:type;type:varchar(255)"`
+	Value       string     `gorm:"column:value;type:text"`
+	Numeric     sqlNumeric `gorm:"column:numeric_value"`
+}
+
+// sqlNumeric is an integer argument in the dialect's arbitrary-precision
+// column: numeric on PostgreSQL and SQLite, decimal(65,0), MySQL's widest, on
+// MySQL, where larger values are left NULL.
+type sqlNumeric struct {
+	sql.NullString
+}
+
+func (sqlNumeric) GormDBDataType(db *gorm.DB, field *schema.Field) string {
+	if db.Dialector.Name() == "mysql" {
+		return "decimal(65,0)"
+	}
+	return "numeric"
+}
+
+// mysqlMaxDigits is the precision of MySQL's decimal(65,0).
+const mysqlMaxDigits = 65
+
+func (s *SQLStore) toSqlArgs(rowId string, sourceId uint, blockNumber uint64, m types.EvmMetadata) []sqlArg {
+	typed := m.TypedArgs()
+	args := make([]sqlArg, 0, len(m.Args))
+	for _, a := range m.Args {
+		value, ok := m.Data[a.Name]
+		if !ok {
+			continue
+		}
+		arg := sqlArg{RowId: rowId, Name: a.Name, SourceId: sourceId, BlockNumber: blockNumber, Type: a.Type, Value: value}
+		n := typed.Uint[a.Name]
+		if n == nil {
+			n = typed.Int[a.Name]
+		}
+		if n != nil {
+			digits := n.String()
+			if s.dialect != "mysql" || len(strings.TrimPrefix(digits, "-")) <= mysqlMaxDigits {
+				arg.Numeric = sqlNumeric{sql.NullString{String: digits, Valid: true}}
+			}
+		}
+		args = append(args, arg)
+	}
+	return args
+}
+
 func toSqlLog(l types.EvmLog) sqlLog {
 	topics, _ := json.Marshal(l.Topics)
 	data, _ := json.Marshal(l.Metadata.Data)
@@ -133,9 +261,12 @@ func fromSqlLog(r sqlLog) types.EvmLog {
 
 func toSqlTx(t types.EvmTransaction) sqlTx {
 	data, _ := json.Marshal(t.Metadata.Data)
+	blobHashes, _ := json.Marshal(t.BlobVersionedHashes)
 	return sqlTx{
 		Id: t.Id, SourceId: t.SourceId, BlockNumber: t.BlockNumber, BlockTimestamp: t.BlockTimestamp, TransactionIndex: t.TransactionIndex, ChainId: t.ChainId,
-		From: t.From, Data: t.Data, Value: t.Value, Nonce: t.Nonce, To: t.To, Hash: t.Hash,
+		From: t.From, Data: t.Data, Value: t.Value, Nonce: t.Nonce, To: t.To, Hash: t.Hash, Type: t.Type,
+		Status: t.Status, GasUsed: t.GasUsed, EffectiveGasPrice: t.EffectiveGasPrice, ContractAddress: t.ContractAddress,
+		BlobGasUsed: t.BlobGasUsed, BlobGasPrice: t.BlobGasPrice, BlobVersionedHashes: string(blobHashes),
 		MetadataContractName: t.Metadata.ContractName, MetadataEventName: t.Metadata.EventName,
 		MetadataFunctionName: t.Metadata.FunctionName, MetadataData: string(data),
 	}
@@ -144,13 +275,49 @@ func toSqlTx(t types.EvmTransaction) sqlTx {
 func fromSqlTx(r sqlTx) types.EvmTransaction {
 	data := map[string]string{}
 	_ = json.Unmarshal([]byte(r.MetadataData), &data)
+	var blobHashes []string
+	_ = json.Unmarshal([]byte(r.BlobVersionedHashes), &blobHashes)
 	return types.EvmTransaction{
 		Id: r.Id, SourceId: r.SourceId, BlockNumber: r.BlockNumber, BlockTimestamp: r.BlockTimestamp, TransactionIndex: r.TransactionIndex, ChainId: r.ChainId,
-		From: r.From, Data: r.Data, Value: r.Value, Nonce: r.Nonce, To: r.To, Hash: r.Hash,
+		From: r.From, Data: r.Data, Value: r.Value, Nonce: r.Nonce, To: r.To, Hash: r.Hash, Type: r.Type,
+		Status: r.Status, GasUsed: r.GasUsed, EffectiveGasPrice: r.EffectiveGasPrice, ContractAddress: r.ContractAddress,
+		BlobGasUsed: r.BlobGasUsed, BlobGasPrice: r.BlobGasPrice, BlobVersionedHashes: blobHashes,
 		Metadata: types.EvmMetadata{ContractName: r.MetadataContractName, EventName: r.MetadataEventName, FunctionName: r.MetadataFunctionName, Data: data},
 	}
 }
 
+func toSqlBlock(b types.EvmBlock) sqlBlock {
+	return sqlBlock{
+		Id: b.Id, SourceId: b.SourceId, ChainId: b.ChainId, BlockNumber: b.BlockNumber, BlockTimestamp: b.BlockTimestamp,
+		Hash: b.Hash, ParentHash: b.ParentHash, Miner: b.Miner, GasUsed: b.GasUsed, GasLimit: b.GasLimit, BaseFeePerGas: b.BaseFeePerGas,
+		BlobGasUsed: b.BlobGasUsed, ExcessBlobGas: b.ExcessBlobGas, TransactionCount: b.TransactionCount, LogsBloom: b.LogsBloom,
+	}
+}
+
+func fromSqlBlock(r sqlBlock) types.EvmBlock {
+	return types.EvmBlock{
+		Id: r.Id, SourceId: r.SourceId, ChainId: r.ChainId, BlockNumber: r.BlockNumber, BlockTimestamp: r.BlockTimestamp,
+		Hash: r.Hash, ParentHash: r.ParentHash, Miner: r.Miner, GasUsed: r.GasUsed, GasLimit: r.GasLimit, BaseFeePerGas: r.BaseFeePerGas,
+		BlobGasUsed: r.BlobGasUsed, ExcessBlobGas: r.ExcessBlobGas, TransactionCount: r.TransactionCount, LogsBloom: r.LogsBloom,
+	}
+}
+
+func toSqlTrace(t types.EvmTrace) sqlTrace {
+	return sqlTrace{
+		Id: t.Id, SourceId: t.SourceId, ChainId: t.ChainId, BlockNumber: t.BlockNumber, BlockTimestamp: t.BlockTimestamp,
+		TransactionHash: t.TransactionHash, TransactionIndex: t.TransactionIndex, TraceAddress: t.TraceAddress,
+		Type: t.Type, From: t.From, To: t.To, Value: t.Value, Input: t.Input, Output: t.Output, Gas: t.Gas, GasUsed: t.GasUsed, Error: t.Error,
+	}
+}
+
+func fromSqlTrace(r sqlTrace) types.EvmTrace {
+	return types.EvmTrace{
+		Id: r.Id, SourceId: r.SourceId, ChainId: r.ChainId, BlockNumber: r.BlockNumber, BlockTimestamp: r.BlockTimestamp,
+		TransactionHash: r.TransactionHash, TransactionIndex: r.TransactionIndex, TraceAddress: r.TraceAddress,
+		Type: r.Type, From: r.From, To: r.To, Value: r.Value, Input: r.Input, Output: r.Output, Gas: r.Gas, GasUsed: r.GasUsed, Error: r.Error,
+	}
+}
+
 // --- writes ---------------------------------------------------------------
 
 func (s *SQLStore) InsertLogs(logs []types.EvmLog) error {
@@ -158,10 +325,18 @@ func (s *SQLStore) InsertLogs(logs []types.EvmLog) error {
 		return nil
 	}
 	rows := make([]sqlLog, len(logs))
+	var args []sqlArg
 	for i, l := range logs {
 		rows[i] = toSqlLog(l)
+		args = append(args, s.toSqlArgs(l.Id, l.SourceId, l.BlockNumber, l.Metadata)...)
 	}
-	return s.db.Clauses(clause.OnConflict{DoNothing: true}).CreateInBatches(rows, 200).Error
+	if err := s.db.Clauses(clause.OnConflict{DoNothing: true}).CreateInBatches(rows, 200).Error; err != nil {
+		return err
+	}
+	if err := s.insertArgs(logArgsTable, args); err != nil {
+		return err
+	}
+	return s.insertEventLogs(logs)
 }
 
 func (s *SQLStore) InsertTransactions(txs []types.EvmTransaction) error {
@@ -169,22 +344,154 @@ func (s *SQLStore) InsertTransactions(txs []types.EvmTransaction) error {
 		return nil
 	}
 	rows := make([]sqlTx, len(txs))
+	var args []sqlArg
 	for i, t := range txs {
 		rows[i] = toSqlTx(t)
+		args = append(args, s.toSqlArgs(t.Id, t.SourceId, t.BlockNumber, t.Metadata)...)
+	}
+	if err := s.db.Clauses(clause.OnConflict{DoNothing: true}).CreateInBatches(rows, 200).Error; err != nil {
+		return err
+	}
+	return s.insertArgs(txArgsTable, args)
+}
+
+func (s *SQLStore) InsertBlocks(blocks []types.EvmBlock) error {
+	if len(blocks) == 0 {
+		return nil
+	}
+	rows := make([]sqlBlock, len(blocks))
+	for i, b := range blocks {
+		rows[i] = toSqlBlock(b)
+	}
+	return s.db.Clauses(clause.OnConflict{DoNothing: true}).CreateInBatches(rows, 200).Error
+}
+
+func (s *SQLStore) InsertTraces(traces []types.EvmTrace) error {
+	if len(traces) == 0 {
+		return nil
+	}
+	rows := make([]sqlTrace, len(traces))
+	for i, t := range traces {
+		rows[i] = toSqlTrace(t)
 	}
 	return s.db.Clauses(clause.OnConflict{DoNothing: true}).CreateInBatches(rows, 200).Error
 }
 
-// DeleteSourceData removes every log and transaction row for the source.
+func (s *SQLStore) insertArgs(table string, args []sqlArg) error {
+	if len(args) == 0 {
+		return nil
+	}
+	return s.db.Table(table).Clauses(clause.OnConflict{DoNothing: true}).CreateInBatches(args, 200).Error
+}
+
+// UpdateLogsMetadata rewrites the metadata columns of the stored logs, and
+// replaces their argument and event table rows.
+func (s *SQLStore) UpdateLogsMetadata(logs []types.EvmLog) error {
+	if len(logs) == 0 {
+		return nil
+	}
+	ids := make([]string, len(logs))
+	for i, l := range logs {
+		ids[i] = l.Id
+	}
+	var stored []string
+	if err := s.db.Model(&sqlLog{}).Where("id IN ?", ids).Pluck("id", &stored).Error; err != nil {
+		return err
+	}
+	isStored := make(map[string]bool, len(stored))
+	for _, id := range stored {
+		isStored[id] = true
+	}
+
+	var updated []types.EvmLog
+	var args []sqlArg
+	for _, l := range logs {
+		if !isStored[l.Id] {
+			continue
+		}
+		row := toSqlLog(l)
+		err := s.db.Model(&sqlLog{}).Where("id = ?", l.Id).Updates(map[string]any{
+			"metadata_contract_name": row.MetadataContractName,
+			"metadata_event_name":    row.MetadataEventName,
+			"metadata_function_name": row.MetadataFunctionName,
+			"metadata_data":          row.MetadataData,
+		}).Error
+		if err != nil {
+			return err
+		}
+		updated = append(updated, l)
+		args = append(args, s.toSqlArgs(l.Id, l.SourceId, l.BlockNumber, l.Metadata)...)
+	}
+
+	if err := s.db.Table(logArgsTable).Where("row_id IN ?", stored).Delete(&sqlArg{}).Error; err != nil {
+		return err
+	}
+	if err := s.insertArgs(logArgsTable, args); err != nil {
+		return err
+	}
+	if err := s.deleteEventLogs("id IN ?", stored); err != nil {
+		return err
+	}
+	return s.insertEventLogs(updated)
+}
+
+// DeleteSourceData removes every log, transaction, block and trace row for
+// the source, with their arguments and event table rows.
 func (s *SQLStore) DeleteSourceData(sourceId uint64) error {
+	if err := s.deleteEventLogs("source_id = ?", sourceId); err != nil {
+		return err
+	}
+	for _, table := range []string{logArgsTable, txArgsTable} {
+		if err := s.db.Table(table).Where("source_id = ?", sourceId).Delete(&sqlArg{}).Error; err != nil {
+			return err
+		}
+	}
 	if err := s.db.Where("source_id = ?", sourceId).Delete(&sqlLog{}).Error; err != nil {
 		return err
 	}
-	return s.db.Where("source_id = ?", sourceId).Delete(&sqlTx{}).Error
+	if err := s.db.Where("source_id = ?", sourceId).Delete(&sqlTx{}).Error; err != nil {
+		return err
+	}
+	if err := s.db.Where("source_id = ?", sourceId).Delete(&sqlBlock{}).Error; err != nil {
+		return err
+	}
+	return s.db.Where("source_id = ?", sourceId).Delete(&sqlTrace{}).Error
+}
+
+// RollbackSourceData removes the source's log, transaction, block and trace
+// rows at or above fromBlock, with their arguments and event table rows.
+func (s *SQLStore) RollbackSourceData(sourceId uint64, fromBlock uint64) error {
+	if err := s.deleteEventLogs("source_id = ? AND block_number >= ?", sourceId, fromBlock); err != nil {
+		return err
+	}
+	for _, table := range []string{logArgsTable, txArgsTable} {
+		if err := s.db.Table(table).Where("source_id = ? AND block_number >= ?", sourceId, fromBlock).Delete(&sqlArg{}).Error; err != nil {
+			return err
+		}
+	}
+	if err := s.db.Where("source_id = ? AND block_number >= ?", sourceId, fromBlock).Delete(&sqlLog{}).Error; err != nil {
+		return err
+	}
+	if err := s.db.Where("source_id = ? AND block_number >= ?", sourceId, fromBlock).Delete(&sqlTx{}).Error; err != nil {
+		return err
+	}
+	if err := s.db.Where("source_id = ? AND block_number >= ?", sourceId, fromBlock).Delete(&sqlBlock{}).Error; err != nil {
+		return err
+	}
+	return s.db.Where("source_id = ? AND block_number >= ?", sourceId, fromBlock).Delete(&sqlTrace{}).Error
 }
 
 // --- reads ----------------------------------------------------------------
 
+// Close closes the store's connection pool.
+func (s *SQLStore) Close() error {
+	sqlDB, err := s.db.DB()
+	if err != nil {
+		return err
+	}
+	return sqlDB.Close()
+}
+
 func (s *SQLStore) GetLogsCount() (uint64, error) {
 	var count int64
 	if err := s.db.Model(&sqlLog{}).Count(&count).Error; err != nil {
@@ -251,6 +558,32 @@ func (s *SQLStore) GetTransactions(sourceId uint64, fromBlock uint64, toBlock ui
 	return out, err
 }
 
+func (s *SQLStore) GetBlocks(sourceId uint64, fromBlock uint64, toBlock uint64) ([]types.EvmBlock, error) {
+	var rows []sqlBlock
+	err := s.db.
+		Where("source_id = ? AND block_number >= ? AND block_number <= ?", sourceId, fromBlock, toBlock).
+		Order("block_number asc").
+		Find(&rows).Error
+	out := make([]types.EvmBlock, 0, len(rows))
+	for _, r := range rows {
+		out = append(out, fromSqlBlock(r))
+	}
+	return out, err
+}
+
+func (s *SQLStore) GetTraces(sourceId uint64, fromBlock uint64, toBlock uint64) ([]types.EvmTrace, error) {
+	var rows []sqlTrace
+	err := s.db.
+		Where("source_id = ? AND block_number >= ? AND block_number <= ?", sourceId, fromBlock, toBlock).
+		Order("block_number asc, transaction_index asc, trace_address asc").
+		Find(&rows).Error
+	out := make([]types.EvmTrace, 0, len(rows))
+	for _, r := range rows {
+		out = append(out, fromSqlTrace(r))
+	}
+	return out, err
+}
+
 func mapLogs(rows []sqlLog) []types.EvmLog {
 	out := make([]types.EvmLog, 0, len(rows))
 	for _, r := range rows {
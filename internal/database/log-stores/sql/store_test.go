@@ -84,7 +84,8 @@ func TestSQLLogsRoundTrip(t *testing.T) {
 func TestSQLTransactionsRoundTrip(t *testing.T) {
 	s := newStore(t)
 	if err := s.InsertTransactions([]types.EvmTransaction{
-		{Id: "1:tx", SourceId: 1, BlockNumber: 10, BlockTimestamp: 1700000000, ChainId: 1, From: "0xf", To: "0xt", Value: "5", Hash: "0xh"},
+		{Id: "1:tx", SourceId: 1, BlockNumber: 10, BlockTimestamp: 1700000000, ChainId: 1, From: "0xf", To: "0xt", Value: "5", Hash: "0xh",
+			Type: 3, Status: 1, GasUsed: 21000, EffectiveGasPrice: "7", BlobGasUsed: 131072, BlobGasPrice: "1", BlobVersionedHashes: []string{"0x01aa"}},
 	}); err != nil {
 		t.Fatalf("insert txs: %v", err)
 	}
@@ -95,6 +96,10 @@ func TestSQLTransactionsRoundTrip(t *testing.T) {
 	if txs[0].BlockTimestamp != 1700000000 {
 		t.Errorf("block_timestamp not round-tripped: got %d", txs[0].BlockTimestamp)
 	}
+	if tx := txs[0]; tx.Type != 3 || tx.Status != 1 || tx.GasUsed != 21000 || tx.EffectiveGasPrice != "7" ||
+		tx.BlobGasUsed != 131072 || tx.BlobGasPrice != "1" || len(tx.BlobVersionedHashes) != 1 || tx.BlobVersionedHashes[0] != "0x01aa" {
+		t.Errorf("receipt fields not round-tripped: %+v", tx)
+	}
 }
 
 func TestSQLDeleteSourceData(t *testing.T) {
@@ -130,3 +135,320 @@ func TestSQLDeleteSourceData(t *testing.T) {
 		t.Errorf("source 2 txs should remain, got %d", len(txs))
 	}
 }
+
+func TestSQLRollbackSourceData(t *testing.T) {
+	s := newStore(t)
+	if err := s.InsertLogs([]types.EvmLog{mkLog(1, 10, 0), mkLog(1, 11, 0), mkLog(1, 12, 0), mkLog(2, 20, 0)}); err != nil {
+		t.Fatalf("insert logs: %v", err)
+	}
+	if err := s.InsertTransactions([]types.EvmTransaction{
+		{Id: "1:tx10", SourceId: 1, BlockNumber: 10, ChainId: 1, Hash: "0xh10"},
+		{Id: "1:tx12", SourceId: 1, BlockNumber: 12, ChainId: 1, Hash: "0xh12"},
+		{Id: "2:tx20", SourceId: 2, BlockNumber: 20, ChainId: 1, Hash: "0xh20"},
+	}); err != nil {
+		t.Fatalf("insert txs: %v", err)
+	}
+
+	if err := s.RollbackSourceData(1, 11); err != nil {
+		t.Fatalf("rollback: %v", err)
+	}
+
+	// Only source 1's rows at or above block 11 are gone.
+	logs, err := s.GetLogs(1, 0, 100)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ids(logs) != fmt.Sprint([]string{"1:10:0"}) {
+		t.Errorf("source 1 logs after rollback = %s", ids(logs))
+	}
+	if txs, _ := s.GetTransactions(1, 0, 100); len(txs) != 1 || txs[0].BlockNumber != 10 {
+		t.Errorf("source 1 txs after rollback = %+v", txs)
+	}
+	if logs, _ := s.GetLogs(2, 0, 100); len(logs) != 1 {
+		t.Errorf("source 2 logs should remain, got %d", len(logs))
+	}
+
+	// Rolled-back blocks can be re-indexed (no stale row shadows the new one).
+	replacement := mkLog(1, 11, 0)
+	replacement.BlockHash = "0xcanonical"
+	if err := s.InsertLogs([]types.EvmLog{replacement}); err != nil {
+		t.Fatalf("re-insert: %v", err)
+	}
+	if logs, _ := s.GetLogs(1, 11, 11); len(logs) != 1 || logs[0].BlockHash != "0xcanonical" {
+		t.Errorf("re-indexed log = %+v", logs)
+	}
+}
+
+func TestSQLTypedArgs(t *testing.T) {
+	s := newStore(t)
+	mk := func(block uint64, value string) types.EvmLog {
+		l := mkLog(1, block, 0)
+		l.Metadata.Data = map[string]string{"to": "0xbb", "value": value, "note": "x"}
+		l.Metadata.Args = []types.EvmArgSchema{
+			{Name: "to", Type: "address", Indexed: true},
+			{Name: "value", Type: "uint256"},
+			{Name: "note", Type: "string"},
+		}
+		return l
+	}
+	if err := s.InsertLogs([]types.EvmLog{mk(10, "115792089237316195423570985008687907853269984665640564039457584007913129639935"), mk(11, "5")}); err != nil {
+		t.Fatalf("insert logs: %v", err)
+	}
+
+	var count int64
+	s.db.Table(logArgsTable).Count(&count)
+	if count != 6 {
+		t.Errorf("args = %d, want 3 per log", count)
+	}
+	var total struct{ Total string }
+	s.db.Table(logArgsTable).Select("CAST(SUM(numeric_value) AS TEXT) AS total").Where("name = ? AND block_number = ?", "value", 11).Scan(&total)
+	if total.Total != "5" {
+		t.Errorf("sum = %q, want 5", total.Total)
+	}
+	var address sqlArg
+	s.db.Table(logArgsTable).Where("row_id = ? AND name = ?", "1:10:0", "to").First(&address)
+	if address.Type != "address" || address.Value != "0xbb" || address.Numeric.Valid {
+		t.Errorf("address arg = %+v", address)
+	}
+
+	// Rolled-back logs take their arguments along.
+	if err := s.RollbackSourceData(1, 11); err != nil {
+		t.Fatalf("rollback: %v", err)
+	}
+	s.db.Table(logArgsTable).Count(&count)
+	if count != 3 {
+		t.Errorf("args after rollback = %d, want 3", count)
+	}
+}
+
+func TestSQLEventTables(t *testing.T) {
+	dsn := filepath.Join(t.TempDir(), "test.db")
+	open := func() *SQLStore {
+		s, _ := NewSQLStore("sqlite", zerolog.Nop())
+		if err := s.Init(map[string]string{"dsn": dsn, "eventTables": "true"}); err != nil {
+			t.Fatalf("init: %v", err)
+		}
+		return s
+	}
+	transfer := types.EvmEntrySchema{Name: "Transfer", Signature: "Transfer(address,address,uint256)", Args: []types.EvmArgSchema{
+		{Name: "from", Type: "address", Indexed: true},
+		{Name: "to", Type: "address", Indexed: true},
+		{Name: "value", Type: "uint256"},
+	}}
+	mk := func(block uint64, event types.EvmEntrySchema, data map[string]string) types.EvmLog {
+		l := mkLog(1, block, 0)
+		l.Metadata = types.EvmMetadata{ContractName: "ERC20", EventName: event.Name, Data: data, Args: event.Args}
+		return l
+	}
+
+	s := open()
+	if err := s.EnsureEventTables("ERC20", []types.EvmEntrySchema{transfer}); err != nil {
+		t.Fatalf("ensure: %v", err)
+	}
+	logs := []types.EvmLog{
+		mk(10, transfer, map[string]string{"from": "0xaa", "to": "0xbb", "value": "115792089237316195423570985008687907853269984665640564039457584007913129639935"}),
+		mk(11, transfer, map[string]string{"from": "0xaa", "to": "0xcc", "value": "5"}),
+		mkLog(1, 12, 0), // not decoded: generic table only
+	}
+	if err := s.InsertLogs(logs); err != nil {
+		t.Fatalf("insert: %v", err)
+	}
+	var rows []struct {
+		BlockNumber uint64
+		ArgTo       string
+		Total       string
+	}
+	s.db.Table("erc20_transfer").Select("block_number, arg_to, CAST(arg_value AS TEXT) AS total").Order("block_number").Scan(&rows)
+	if len(rows) != 2 || rows[0].ArgTo != "0xbb" || rows[1].Total != "5" {
+		t.Errorf("erc20_transfer rows = %+v", rows)
+	}
+	if c, _ := s.GetLogsCount(); c != 3 {
+		t.Errorf("generic count = %d, want all 3 logs", c)
+	}
+
+	// An ABI update adds an argument and retypes another: a new column each,
+	// old columns kept.
+	updated := types.EvmEntrySchema{Name: "Transfer", Signature: "Transfer(address,address,int64,bool)", Args: []types.EvmArgSchema{
+		{Name: "from", Type: "address", Indexed: true},
+		{Name: "to", Type: "address", Indexed: true},
+		{Name: "value", Type: "int64"},
+		{Name: "flagged", Type: "bool"},
+	}}
+	s = open()
+	if err := s.EnsureEventTables("ERC20", []types.EvmEntrySchema{updated}); err != nil {
+		t.Fatalf("migrate: %v", err)
+	}
+	for _, column := range []string{"arg_value", "arg_value_int64", "arg_flagged"} {
+		if !s.db.Migrator().HasColumn("erc20_transfer", column) {
+			t.Errorf("column %s missing after migration", column)
+		}
+	}
+	if err := s.InsertLogs([]types.EvmLog{mk(13, updated, map[string]string{"from": "0xaa", "to": "0xdd", "value": "-7", "flagged": "true"})}); err != nil {
+		t.Fatalf("insert after migration: %v", err)
+	}
+	var migrated struct {
+		ArgValueInt64 int64
+		ArgFlagged    bool
+	}
+	s.db.Table("erc20_transfer").Where("block_number = ?", 13).Scan(&migrated)
+	if migrated.ArgValueInt64 != -7 || !migrated.ArgFlagged {
+		t.Errorf("migrated row = %+v", migrated)
+	}
+
+	// Rollbacks and deletes reach event tables, even from a store without
+	// the flag.
+	if err := s.RollbackSourceData(1, 11); err != nil {
+		t.Fatalf("rollback: %v", err)
+	}
+	var count int64
+	s.db.Table("erc20_transfer").Count(&count)
+	if count != 1 {
+		t.Errorf("rows after rollback = %d, want 1", count)
+	}
+	plain := newStore(t)
+	plain.db = s.db
+	if err := plain.DeleteSourceData(1); err != nil {
+		t.Fatalf("delete: %v", err)
+	}
+	s.db.Table("erc20_transfer").Count(&count)
+	if count != 0 {
+		t.Errorf("rows after delete = %d, want 0", count)
+	}
+}
+
+func TestSQLUpdateLogsMetadata(t *testing.T) {
+	s, _ := NewSQLStore("sqlite", zerolog.Nop())
+	if err := s.Init(map[string]string{"dsn": filepath.Join(t.TempDir(), "test.db"), "eventTables": "true"}); err != nil {
+		t.Fatalf("init: %v", err)
+	}
+	transfer := types.EvmEntrySchema{Name: "Transfer", Signature: "Transfer(address,uint256)", Args: []types.EvmArgSchema{
+		{Name: "to", Type: "address", Indexed: true},
+		{Name: "value", Type: "uint256"},
+	}}
+	if err := s.EnsureEventTables("ERC20", []types.EvmEntrySchema{transfer}); err != nil {
+		t.Fatalf("ensure: %v", err)
+	}
+	if err := s.InsertLogs([]types.EvmLog{mkLog(1, 10, 0), mkLog(1, 11, 0)}); err != nil {
+		t.Fatalf("insert: %v", err)
+	}
+
+	// The first log is now decoded; the second one is no longer stored.
+	decoded := mkLog(1, 10, 0)
+	decoded.Metadata = types.EvmMetadata{ContractName: "ERC20", EventName: "Transfer", Data: map[string]string{"to": "0xbb", "value": "5"}, Args: transfer.Args}
+	gone := mkLog(1, 12, 0)
+	gone.Metadata = decoded.Metadata
+	if err := s.UpdateLogsMetadata([]types.EvmLog{decoded, gone}); err != nil {
+		t.Fatalf("update: %v", err)
+	}
+
+	got, _ := s.GetLogs(1, 10, 12)
+	if ids(got) != fmt.Sprint([]string{"1:10:0", "1:11:0"}) {
+		t.Fatalf("logs = %s, want the stored ones only", ids(got))
+	}
+	if got[0].Metadata.EventName != "Transfer" || got[0].Metadata.Data["value"] != "5" {
+		t.Errorf("updated metadata = %+v", got[0].Metadata)
+	}
+	if got[1].Metadata.ContractName != "C" {
+		t.Errorf("untouched metadata = %+v", got[1].Metadata)
+	}
+	var args, rows int64
+	s.db.Table(logArgsTable).Count(&args)
+	s.db.Table("erc20_transfer").Count(&rows)
+	if args != 2 || rows != 1 {
+		t.Errorf("args = %d, event rows = %d, want 2 and 1", args, rows)
+	}
+
+	// Updating again replaces the rows instead of adding to them.
+	decoded.Metadata.Data = map[string]string{"to": "0xcc", "value": "6"}
+	if err := s.UpdateLogsMetadata([]types.EvmLog{decoded}); err != nil {
+		t.Fatalf("update again: %v", err)
+	}
+	var to string
+	s.db.Table("erc20_transfer").Select("arg_to").Where("id = ?", "1:10:0").Scan(&to)
+	s.db.Table(logArgsTable).Count(&args)
+	if to != "0xcc" || args != 2 {
+		t.Errorf("after second update: arg_to = %q, args = %d", to, args)
+	}
+}
+
+func TestSQLBlocksRoundTrip(t *testing.T) {
+	s := newStore(t)
+	mkBlock := func(sourceId uint, number uint64) types.EvmBlock {
+		return types.EvmBlock{Id: fmt.Sprintf("1:%d", number), SourceId: sourceId, ChainId: 1, BlockNumber: number, BlockTimestamp: number * 12,
+			Hash: fmt.Sprintf("0xh%d", number), ParentHash: fmt.Sprintf("0xh%d", number-1), Miner: "0xm", GasUsed: 100, GasLimit: 200,
+			BaseFeePerGas: "7", BlobGasUsed: 131072, ExcessBlobGas: 3, TransactionCount: 4, LogsBloom: "0x00"}
+	}
+	blocks := []types.EvmBlock{mkBlock(1, 12), mkBlock(1, 10), mkBlock(1, 11), mkBlock(2, 20)}
+	if err := s.InsertBlocks(blocks); err != nil {
+		t.Fatalf("insert: %v", err)
+	}
+	// Replaying the range does not duplicate.
+	if err := s.InsertBlocks(blocks); err != nil {
+		t.Fatalf("re-insert: %v", err)
+	}
+
+	got, err := s.GetBlocks(1, 10, 11)
+	if err != nil || len(got) != 2 || got[0].BlockNumber != 10 || got[1].BlockNumber != 11 {
+		t.Fatalf("GetBlocks = %+v, err %v", got, err)
+	}
+	if got[0] != mkBlock(1, 10) {
+		t.Errorf("block not round-tripped: %+v", got[0])
+	}
+
+	if err := s.RollbackSourceData(1, 11); err != nil {
+		t.Fatalf("rollback: %v", err)
+	}
+	if got, _ := s.GetBlocks(1, 0, 100); len(got) != 1 || got[0].BlockNumber != 10 {
+		t.Errorf("source 1 blocks after rollback = %+v", got)
+	}
+	if err := s.DeleteSourceData(1); err != nil {
+		t.Fatalf("delete: %v", err)
+	}
+	if got, _ := s.GetBlocks(1, 0, 100); len(got) != 0 {
+		t.Errorf("source 1 blocks not deleted: %d", len(got))
+	}
+	if got, _ := s.GetBlocks(2, 0, 100); len(got) != 1 {
+		t.Errorf("source 2 blocks should remain, got %d", len(got))
+	}
+}
+
+func TestSQLTracesRoundTrip(t *testing.T) {
+	s := newStore(t)
+	mkTrace := func(sourceId uint, number uint64, traceAddress string) types.EvmTrace {
+		return types.EvmTrace{Id: fmt.Sprintf("1:%d:0xt%d:%s", sourceId, number, traceAddress), SourceId: sourceId, ChainId: 1, BlockNumber: number,
+			BlockTimestamp: number * 12, TransactionHash: fmt.Sprintf("0xt%d", number), TransactionIndex: 2, TraceAddress: traceAddress,
+			Type: "CALL", From: "0xa", To: "0xb", Value: "5", Input: "0x", Output: "0x", Gas: 100, GasUsed: 50, Error: "Reverted"}
+	}
+	traces := []types.EvmTrace{mkTrace(1, 11, ""), mkTrace(1, 10, "0"), mkTrace(1, 10, ""), mkTrace(2, 20, "")}
+	if err := s.InsertTraces(traces); err != nil {
+		t.Fatalf("insert: %v", err)
+	}
+	// Replaying the range does not duplicate.
+	if err := s.InsertTraces(traces); err != nil {
+		t.Fatalf("re-insert: %v", err)
+	}
+
+	got, err := s.GetTraces(1, 10, 11)
+	if err != nil || len(got) != 3 || got[0].TraceAddress != "" || got[1].TraceAddress != "0" || got[2].BlockNumber != 11 {
+		t.Fatalf("GetTraces = %+v, err %v", got, err)
+	}
+	if got[1] != mkTrace(1, 10, "0") {
+		t.Errorf("trace not round-tripped: %+v", got[1])
+	}
+
+	if err := s.RollbackSourceData(1, 11); err != nil {
+		t.Fatalf("rollback: %v", err)
+	}
+	if got, _ := s.GetTraces(1, 0, 100); len(got) != 2 {
+		t.Errorf("source 1 traces after rollback = %+v", got)
+	}
+	if err := s.DeleteSourceData(1); err != nil {
+		t.Fatalf("delete: %v", err)
+	}
+	if got, _ := s.GetTraces(1, 0, 100); len(got) != 0 {
+		t.Errorf("source 1 traces not deleted: %d", len(got))
+	}
+	if got, _ := s.GetTraces(2, 0, 100); len(got) != 1 {
+		t.Errorf("source 2 traces should remain, got %d", len(got))
+	}
+}
@@ -3,7 +3,11 @@ package exporter
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"os"
+	"sort"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	internal_bus "github.com/evmi-cloud/go-evm-indexer/internal/bus"
@@ -14,6 +18,7 @@ import (
 	pluginsdk "github.com/evmi-cloud/go-evm-indexer/pkg/exporter"
 	"github.com/mustafaturan/bus/v3"
 	"github.com/rs/zerolog"
+	"github.com/thejerf/suture/v4"
 )
 
 // defaultBlockBatch bounds how many blocks of logs are pulled from the store per
@@ -37,6 +42,19 @@ type ExporterService struct {
 	chain     evmi_database.EvmBlockchain
 	storeInfo evmi_database.EvmLogStore
 
+	// pendingRollbacks holds the reorg rollbacks received on the bus and not yet
+	// applied to the cursor, keyed by source with the lowest FromBlock kept.
+	rollbackMu       sync.Mutex
+	pendingRollbacks map[uint]types.SourceRollback
+	// delivered is the highest block the plugin was given logs of. It is kept
+	// across restarts of Serve, which reload a cursor the indexer may already
+	// have rewound, so a pending rollback still reaches those logs.
+	delivered uint64
+
+	// completed is set once the exporter finished with its pipeline's sources.
+	// See complete.
+	completed atomic.Bool
+
 	logger zerolog.Logger
 }
 
@@ -125,6 +143,19 @@ func (p *ExporterService) Serve(ctx context.Context) error {
 	p.setStatus(string(evmi_database.RunningExporterStatus))
 	p.emitUpdate()
 
+	if p.bus != nil {
+		key := fmt.Sprintf("exporter-rollback-%d", p.exporter.ID)
+		p.bus.RegisterHandler(key, bus.Handler{
+			Matcher: internal_bus.SourceRollbackTopic,
+			Handle: func(_ context.Context, event bus.Event) {
+				if rollback, ok := event.Data.(types.SourceRollback); ok && rollback.PipelineId == p.pipeline.ID {
+					p.queueRollback(rollback)
+				}
+			},
+		})
+		defer p.bus.DeregisterHandler(key)
+	}
+
 	// Mark the exporter up for the lifetime of its loop.
 	p.metrics.SetExporterUp(p.exporterLabels(), true)
 	defer p.metrics.SetExporterUp(p.exporterLabels(), false)
@@ -146,8 +177,9 @@ func (p *ExporterService) setStatus(status string) {
 }
 
 // run is the main export loop. It returns nil on a clean stop (context
-// cancelled by the supervisor) and an error if the plugin or store fails
-// (letting the supervisor restart it).
+// cancelled by the supervisor), suture.ErrDoNotRestart once the exporter
+// completed, and an error if the plugin or store fails (letting the supervisor
+// restart it).
 //
 // The cursor is a (completedBlock, lastLogIndex) pair: completedBlock is the last
 // fully-processed block, lastLogIndex is the last log_index delivered within the
@@ -178,12 +210,22 @@ func (p *ExporterService) run(ctx context.Context, logParams map[string]interfac
 			return nil
 		}
 
-		sourceIds, head, err := p.sourcesAndHead()
+		var err error
+		completedBlock, lastLogIndex, err = p.applyRollbacks(completedBlock, lastLogIndex)
+		if err != nil {
+			return err
+		}
+
+		sourceIds, head, completed, err := p.sourcesAndHead()
 		if err != nil {
 			p.fail(err)
 			return err
 		}
 
+		if completed && completedBlock >= head {
+			return p.complete()
+		}
+
 		if len(sourceIds) == 0 || head <= completedBlock {
 			// Interruptible sleep so a disable/shutdown doesn't wait a full
 			// pull interval.
@@ -243,6 +285,7 @@ func (p *ExporterService) exportRange(sourceIds []uint64, completedBlock uint64,
 			return completedBlock, lastLogIndex, err
 		}
 		delivered++
+		p.delivered = max(p.delivered, l.BlockNumber)
 		// A delivered log at (B, I) means every block < B is complete and block B
 		// is in progress at index I.
 		completedBlock = blockBefore(l.BlockNumber)
@@ -262,6 +305,93 @@ func (p *ExporterService) exportRange(sourceIds []uint64, completedBlock uint64,
 	return completedBlock, lastLogIndex, nil
 }
 
+// queueRollback records a source rollback for the run loop to apply, keeping
+// the lowest FromBlock when a source reorgs again before it was applied.
+func (p *ExporterService) queueRollback(rollback types.SourceRollback) {
+	p.rollbackMu.Lock()
+	defer p.rollbackMu.Unlock()
+	if p.pendingRollbacks == nil {
+		p.pendingRollbacks = make(map[uint]types.SourceRollback)
+	}
+	if pending, ok := p.pendingRollbacks[rollback.SourceId]; ok && pending.FromBlock <= rollback.FromBlock {
+		return
+	}
+	p.pendingRollbacks[rollback.SourceId] = rollback
+}
+
+// applyRollbacks rewinds the cursor below the lowest pending rollback that
+// reaches logs already delivered, after telling the plugin (when it implements
+// Rollbacker) so it can undo them. Rollbacks above the delivered frontier only
+// drop logs the exporter has not seen yet and are discarded. A rollback stays
+// pending until the plugin accepted it and the cursor is persisted, so a failure
+// retries it when the supervisor restarts the exporter; the failure itself is
+// reported by p.fail, directly or through persistCursor. Pending rollbacks are
+// held in memory only: the indexer also rewinds the persisted cursor, so a
+// rollback missed while the exporter was not running, or lost to a process
+// restart, still redelivers the blocks but is not passed to the plugin.
+func (p *ExporterService) applyRollbacks(completedBlock uint64, lastLogIndex int64) (uint64, int64, error) {
+	p.rollbackMu.Lock()
+	pending := make([]types.SourceRollback, 0, len(p.pendingRollbacks))
+	for _, rollback := range p.pendingRollbacks {
+		pending = append(pending, rollback)
+	}
+	p.rollbackMu.Unlock()
+	if len(pending) == 0 {
+		return completedBlock, lastLogIndex, nil
+	}
+	sort.Slice(pending, func(i, j int) bool { return pending[i].SourceId < pending[j].SourceId })
+
+	// Highest block the plugin has received logs of.
+	frontier := completedBlock
+	if lastLogIndex >= 0 {
+		frontier = completedBlock + 1
+	}
+	frontier = max(frontier, p.delivered)
+
+	rollbacker, _ := p.plugin.(pluginsdk.Rollbacker)
+	for _, rollback := range pending {
+		if rollback.FromBlock <= frontier {
+			if rollbacker != nil {
+				if err := rollbacker.Rollback(pluginsdk.RollbackEvent{
+					SourceId:  rollback.SourceId,
+					ChainId:   rollback.ChainId,
+					FromBlock: rollback.FromBlock,
+				}); err != nil {
+					p.fail(err)
+					return completedBlock, lastLogIndex, err
+				}
+			}
+
+			rewind := blockBefore(rollback.FromBlock)
+			if p.exporter.StartBlock > 0 && rewind < p.exporter.StartBlock-1 {
+				rewind = p.exporter.StartBlock - 1
+			}
+			if rewind < completedBlock || (rewind == completedBlock && lastLogIndex >= 0) {
+				completedBlock, lastLogIndex = rewind, -1
+				if err := p.persistCursor(completedBlock, lastLogIndex); err != nil {
+					return completedBlock, lastLogIndex, err
+				}
+				p.emitUpdate()
+			}
+
+			if p.delivered >= rollback.FromBlock {
+				p.delivered = blockBefore(rollback.FromBlock)
+			}
+
+			p.logger.Warn().Fields(map[string]interface{}{
+				"exporter": p.exporter.Name, "source": rollback.SourceId, "fromBlock": rollback.FromBlock,
+			}).Msg("rolled back after chain reorganization")
+		}
+
+		p.rollbackMu.Lock()
+		if current, ok := p.pendingRollbacks[rollback.SourceId]; ok && current == rollback {
+			delete(p.pendingRollbacks, rollback.SourceId)
+		}
+		p.rollbackMu.Unlock()
+	}
+	return completedBlock, lastLogIndex, nil
+}
+
 // blockBefore returns b-1, guarding the genesis edge (block 0 with logs is not
 // resumable mid-block; such logs are effectively never present on EVM chains).
 func blockBefore(b uint64) uint64 {
@@ -284,29 +414,50 @@ func cursorBound(completedBlock uint64, lastLogIndex int64) (uint64, uint64) {
 
 // sourcesAndHead returns the enabled source ids of the pipeline and the highest
 // block that is safe to export: the minimum SyncBlock across those sources, so no
-// source is left behind within an exported range.
-func (p *ExporterService) sourcesAndHead() ([]uint64, uint64, error) {
+// source is left behind within an exported range. completed reports whether
+// every one of them is a bounded source that indexed its end block.
+func (p *ExporterService) sourcesAndHead() (sourceIds []uint64, head uint64, completed bool, err error) {
 	var sources []evmi_database.EvmLogSource
 	result := p.db.Conn.Model(&evmi_database.EvmLogSource{}).
 		Where("evm_log_pipeline_id = ? AND enabled = ?", p.pipeline.ID, true).
 		Find(&sources)
 	if result.Error != nil {
-		return nil, 0, result.Error
+		return nil, 0, false, result.Error
 	}
 
 	if len(sources) == 0 {
-		return nil, 0, nil
+		return nil, 0, false, nil
 	}
 
-	sourceIds := make([]uint64, 0, len(sources))
-	var head uint64
+	sourceIds = make([]uint64, 0, len(sources))
+	completed = true
 	for i, s := range sources {
 		sourceIds = append(sourceIds, uint64(s.ID))
 		if i == 0 || s.SyncBlock < head {
 			head = s.SyncBlock
 		}
+		if s.Status != string(evmi_database.CompletedLogSourceStatus) {
+			completed = false
+		}
+	}
+	return sourceIds, head, completed, nil
+}
+
+// complete finishes the exporter once every source of its pipeline completed
+// and their logs were all delivered: the plugin is told when it implements
+// Completer, and the supervisor does not restart the exporter.
+func (p *ExporterService) complete() error {
+	if completer, ok := p.plugin.(pluginsdk.Completer); ok {
+		if err := completer.Complete(); err != nil {
+			p.fail(err)
+			return err
+		}
 	}
-	return sourceIds, head, nil
+	p.completed.Store(true)
+	p.setStatus(string(evmi_database.CompletedExporterStatus))
+	p.emitUpdate()
+	p.logger.Info().Str("exporter", p.exporter.Name).Msg("exporter completed")
+	return suture.ErrDoNotRestart
 }
 
 // persistCursor writes the (block, logIndex) cursor to the exporter row.
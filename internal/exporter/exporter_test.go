@@ -1,15 +1,18 @@
 package exporter
 
 import (
+	"context"
 	"errors"
 	"sync/atomic"
 	"testing"
+	"time"
 
 	evmi_database "github.com/evmi-cloud/go-evm-indexer/internal/database/evmi-database"
 	log_stores "github.com/evmi-cloud/go-evm-indexer/internal/database/log-stores"
 	"github.com/evmi-cloud/go-evm-indexer/internal/types"
 	pluginsdk "github.com/evmi-cloud/go-evm-indexer/pkg/exporter"
 	"github.com/rs/zerolog"
+	"github.com/thejerf/suture/v4"
 	"gorm.io/driver/sqlite"
 	"gorm.io/gorm"
 )
@@ -84,8 +87,12 @@ type fakeStore struct {
 func (f *fakeStore) Init(map[string]string) error                    { return nil }
 func (f *fakeStore) InsertLogs([]types.EvmLog) error                 { return nil }
 func (f *fakeStore) InsertTransactions([]types.EvmTransaction) error { return nil }
+func (f *fakeStore) InsertBlocks([]types.EvmBlock) error             { return nil }
+func (f *fakeStore) InsertTraces([]types.EvmTrace) error             { return nil }
 func (f *fakeStore) GetLogsCount() (uint64, error)                   { return uint64(len(f.logs)), nil }
 func (f *fakeStore) DeleteSourceData(uint64) error                   { return nil }
+func (f *fakeStore) RollbackSourceData(uint64, uint64) error         { return nil }
+func (f *fakeStore) UpdateLogsMetadata([]types.EvmLog) error         { return nil }
 func (f *fakeStore) GetLogs(uint64, uint64, uint64) ([]types.EvmLog, error) {
 	return nil, nil
 }
@@ -110,6 +117,12 @@ func (f *fakeStore) GetLatestLogs(uint64, uint64) ([]types.EvmLog, error)
 func (f *fakeStore) GetTransactions(uint64, uint64, uint64) ([]types.EvmTransaction, error) {
 	return nil, nil
 }
+func (f *fakeStore) GetBlocks(uint64, uint64, uint64) ([]types.EvmBlock, error) {
+	return nil, nil
+}
+func (f *fakeStore) GetTraces(uint64, uint64, uint64) ([]types.EvmTrace, error) {
+	return nil, nil
+}
 
 // recordPlugin records the order of delivered logs and detects any concurrent
 // (re-entrant) NewLogEvent call.
@@ -295,3 +308,203 @@ func assertPersisted(t *testing.T, svc *ExporterService, wantBlock uint64, wantI
 		t.Errorf("persisted cursor = (%d,%d), want (%d,%d)", got.SyncBlock, got.SyncLogIndex, wantBlock, wantIdx)
 	}
 }
+
+// --- reorg rollback --------------------------------------------------------
+
+// rollbackPlugin is a recordPlugin that also implements pluginsdk.Rollbacker.
+type rollbackPlugin struct {
+	recordPlugin
+	rollbacks []pluginsdk.RollbackEvent
+	err       error
+}
+
+func (r *rollbackPlugin) Rollback(event pluginsdk.RollbackEvent) error {
+	r.rollbacks = append(r.rollbacks, event)
+	return r.err
+}
+
+func TestApplyRollbacksNotifiesPluginAndRewindsCursor(t *testing.T) {
+	plug := &rollbackPlugin{}
+	svc := newTestService(t, &fakeStore{}, plug)
+
+	svc.queueRollback(types.SourceRollback{SourceId: 2, ChainId: 1, FromBlock: 14})
+	svc.queueRollback(types.SourceRollback{SourceId: 1, ChainId: 1, FromBlock: 12})
+	// A later, shallower rollback of the same source keeps the lowest FromBlock.
+	svc.queueRollback(types.SourceRollback{SourceId: 1, ChainId: 1, FromBlock: 13})
+
+	completed, lastIdx, err := svc.applyRollbacks(15, 2)
+	if err != nil {
+		t.Fatalf("applyRollbacks: %v", err)
+	}
+	want := []pluginsdk.RollbackEvent{
+		{SourceId: 1, ChainId: 1, FromBlock: 12},
+		{SourceId: 2, ChainId: 1, FromBlock: 14},
+	}
+	if len(plug.rollbacks) != len(want) || plug.rollbacks[0] != want[0] || plug.rollbacks[1] != want[1] {
+		t.Errorf("rollbacks = %+v, want %+v", plug.rollbacks, want)
+	}
+	if completed != 11 || lastIdx != -1 {
+		t.Errorf("returned cursor = (%d,%d), want (11,-1)", completed, lastIdx)
+	}
+	assertPersisted(t, svc, 11, -1)
+	if len(svc.pendingRollbacks) != 0 {
+		t.Errorf("pending rollbacks = %+v, want none", svc.pendingRollbacks)
+	}
+}
+
+func TestApplyRollbacksKeepsPendingOnPluginError(t *testing.T) {
+	plug := &rollbackPlugin{err: errors.New("boom")}
+	svc := newTestService(t, &fakeStore{}, plug)
+	svc.queueRollback(types.SourceRollback{SourceId: 1, ChainId: 1, FromBlock: 12})
+
+	if _, _, err := svc.applyRollbacks(15, -1); err == nil {
+		t.Fatal("expected error from failing plugin, got nil")
+	}
+	if len(svc.pendingRollbacks) != 1 {
+		t.Errorf("pending rollbacks = %+v, want the failed one kept for retry", svc.pendingRollbacks)
+	}
+}
+
+func TestApplyRollbacksRetriesAfterTheCursorWasRewound(t *testing.T) {
+	plug := &rollbackPlugin{err: errors.New("boom")}
+	svc := newTestService(t, &fakeStore{}, plug)
+	svc.delivered = 15
+	svc.queueRollback(types.SourceRollback{SourceId: 1, ChainId: 1, FromBlock: 12})
+	if _, _, err := svc.applyRollbacks(15, -1); err == nil {
+		t.Fatal("expected error from failing plugin, got nil")
+	}
+
+	// The restarted exporter reloads the cursor the indexer already rewound.
+	plug.err = nil
+	if _, _, err := svc.applyRollbacks(11, -1); err != nil {
+		t.Fatalf("applyRollbacks: %v", err)
+	}
+	if len(plug.rollbacks) != 2 || len(svc.pendingRollbacks) != 0 {
+		t.Errorf("rollbacks = %+v, pending = %+v, want the retry passed to the plugin", plug.rollbacks, svc.pendingRollbacks)
+	}
+}
+
+func TestApplyRollbacksFailsWhenCursorIsNotPersisted(t *testing.T) {
+	svc := newTestService(t, &fakeStore{}, &rollbackPlugin{})
+	// A private database without the exporter table: every write fails.
+	db, err := gorm.Open(sqlite.Open("file::memory:"), &gorm.Config{})
+	if err != nil {
+		t.Fatalf("open sqlite: %v", err)
+	}
+	svc.db = &evmi_database.EvmiDatabase{Conn: db}
+	svc.queueRollback(types.SourceRollback{SourceId: 1, ChainId: 1, FromBlock: 12})
+
+	if _, _, err := svc.applyRollbacks(15, -1); err == nil {
+		t.Fatal("expected error from the cursor write, got nil")
+	}
+	if svc.exporter.Status != string(evmi_database.FailedExporterStatus) {
+		t.Errorf("status = %q, want FAILED", svc.exporter.Status)
+	}
+	if len(svc.pendingRollbacks) != 1 {
+		t.Errorf("pending rollbacks = %+v, want the failed one kept for retry", svc.pendingRollbacks)
+	}
+}
+
+func TestApplyRollbacksWithoutRollbackerRewindsOnly(t *testing.T) {
+	svc := newTestService(t, &fakeStore{}, &recordPlugin{})
+	svc.queueRollback(types.SourceRollback{SourceId: 1, ChainId: 1, FromBlock: 12})
+
+	completed, lastIdx, err := svc.applyRollbacks(15, -1)
+	if err != nil {
+		t.Fatalf("applyRollbacks: %v", err)
+	}
+	if completed != 11 || lastIdx != -1 {
+		t.Errorf("returned cursor = (%d,%d), want (11,-1)", completed, lastIdx)
+	}
+}
+
+func TestApplyRollbacksAboveFrontierIsNoop(t *testing.T) {
+	plug := &rollbackPlugin{}
+	svc := newTestService(t, &fakeStore{}, plug)
+	// Delivered up to (10, 3) in block 10; block 11 was never exported.
+	svc.queueRollback(types.SourceRollback{SourceId: 1, ChainId: 1, FromBlock: 11})
+
+	completed, lastIdx, err := svc.applyRollbacks(9, 3)
+	if err != nil {
+		t.Fatalf("applyRollbacks: %v", err)
+	}
+	if len(plug.rollbacks) != 0 {
+		t.Errorf("rollbacks = %+v, want none", plug.rollbacks)
+	}
+	if completed != 9 || lastIdx != 3 {
+		t.Errorf("returned cursor = (%d,%d), want unchanged (9,3)", completed, lastIdx)
+	}
+	if len(svc.pendingRollbacks) != 0 {
+		t.Errorf("pending rollbacks = %+v, want discarded", svc.pendingRollbacks)
+	}
+}
+
+// --- bounded pipelines -----------------------------------------------------
+
+// completerPlugin is a recordPlugin that also implements pluginsdk.Completer.
+type completerPlugin struct {
+	recordPlugin
+	completions int
+}
+
+func (c *completerPlugin) Complete() error {
+	c.completions++
+	return nil
+}
+
+// seedSources stores the given sources in a pipeline of their own, the test
+// database being shared by the package's tests.
+func seedSources(t *testing.T, svc *ExporterService, pipelineID uint, sources ...evmi_database.EvmLogSource) {
+	t.Helper()
+	if err := svc.db.Conn.AutoMigrate(&evmi_database.EvmLogSource{}); err != nil {
+		t.Fatalf("migrate: %v", err)
+	}
+	svc.pipeline.ID = pipelineID
+	for _, source := range sources {
+		source.Enabled = true
+		source.EvmLogPipelineID = pipelineID
+		if err := svc.db.Conn.Create(&source).Error; err != nil {
+			t.Fatalf("seed source: %v", err)
+		}
+	}
+}
+
+func TestRunCompletesOnceCompletedSourcesAreExported(t *testing.T) {
+	store := &fakeStore{logs: []types.EvmLog{logAt(10, 0), logAt(12, 1)}}
+	plug := &completerPlugin{}
+	svc := newTestService(t, store, plug)
+	seedSources(t, svc, 71,
+		evmi_database.EvmLogSource{Status: string(evmi_database.CompletedLogSourceStatus), SyncBlock: 12, EndBlock: 12},
+		evmi_database.EvmLogSource{Status: string(evmi_database.CompletedLogSourceStatus), SyncBlock: 20, EndBlock: 20},
+	)
+
+	err := svc.run(context.Background(), nil)
+	if !errors.Is(err, suture.ErrDoNotRestart) {
+		t.Fatalf("run = %v, want ErrDoNotRestart", err)
+	}
+	if len(plug.received) != 2 || plug.completions != 1 {
+		t.Errorf("received %d logs and %d completions, want 2 and 1", len(plug.received), plug.completions)
+	}
+	if svc.exporter.Status != string(evmi_database.CompletedExporterStatus) || !svc.completed.Load() {
+		t.Errorf("status = %q, want COMPLETED", svc.exporter.Status)
+	}
+	assertPersisted(t, svc, 12, -1)
+}
+
+func TestRunWaitsForRunningSources(t *testing.T) {
+	plug := &completerPlugin{}
+	svc := newTestService(t, &fakeStore{}, plug)
+	seedSources(t, svc, 72,
+		evmi_database.EvmLogSource{Status: string(evmi_database.CompletedLogSourceStatus), SyncBlock: 12, EndBlock: 12},
+		evmi_database.EvmLogSource{Status: string(evmi_database.RunningLogSourceStatus), SyncBlock: 30},
+	)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if err := svc.run(ctx, nil); err != nil {
+		t.Fatalf("run = %v, want a clean stop", err)
+	}
+	if plug.completions != 0 || svc.exporter.Status != string(evmi_database.StoppedExporterStatus) {
+		t.Errorf("completions = %d, status = %q; want none and STOPPED", plug.completions, svc.exporter.Status)
+	}
+}
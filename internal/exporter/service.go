@@ -120,9 +120,10 @@ func (s *ExporterServiceManager) Start() error {
 }
 
 // startExporter registers and supervises a service for exp. The caller MUST hold
-// s.mu; this keeps the "exactly one service per exporter" invariant atomic.
+// s.mu; this keeps the "exactly one service per exporter" invariant atomic. The
+// service of a completed exporter has left the supervisor and is replaced.
 func (s *ExporterServiceManager) startExporter(exp evmi_database.EvmiExporter) {
-	if _, ok := s.exporterServices[exp.ID]; ok {
+	if existing, ok := s.exporterServices[exp.ID]; ok && !existing.completed.Load() {
 		return
 	}
 	s.logger.Info().Msg("starting exporter id " + fmt.Sprint(exp.ID))
@@ -140,7 +141,7 @@ func (s *ExporterServiceManager) EnableExporter(exporterId uint) error {
 		return result.Error
 	}
 
-	if _, ok := s.exporterServices[exporterId]; ok {
+	if existing, ok := s.exporterServices[exporterId]; ok && !existing.completed.Load() {
 		return errors.New("exporter already running")
 	}
 
@@ -176,12 +177,15 @@ func (s *ExporterServiceManager) DisableExporter(exporterId uint) error {
 		return result.Error
 	}
 
-	s.logger.Info().Msg("disabling exporter id " + fmt.Sprint(exp.ID))
-	s.supervisor.RemoveAndWait(token, time.Minute)
+	// A completed exporter already left the supervisor and keeps its status.
+	if !s.exporterServices[exp.ID].completed.Load() {
+		s.logger.Info().Msg("disabling exporter id " + fmt.Sprint(exp.ID))
+		s.supervisor.RemoveAndWait(token, time.Minute)
 
-	exp.Status = string(evmi_database.StoppedExporterStatus)
-	if result := s.db.Conn.Model(&exp).Update("status", exp.Status); result.Error != nil {
-		return result.Error
+		exp.Status = string(evmi_database.StoppedExporterStatus)
+		if result := s.db.Conn.Model(&exp).Update("status", exp.Status); result.Error != nil {
+			return result.Error
+		}
 	}
 
 	delete(s.exporterIdToServiceId, exp.ID)
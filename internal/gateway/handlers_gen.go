@@ -123,6 +123,78 @@ func (g *Gateway) DeleteEvmJsonAbi(ctx context.Context, req *connect.Request[v1.
 	return forward(ctx, req, c.DeleteEvmJsonAbi)
 }
 
+// GetEvmJsonAbiSchema — shared metadata DB; any instance
+func (g *Gateway) GetEvmJsonAbiSchema(ctx context.Context, req *connect.Request[v1.GetEvmJsonAbiSchemaRequest]) (*connect.Response[v1.GetEvmJsonAbiSchemaResponse], error) {
+	c, err := g.anyClient()
+	if err != nil {
+		return nil, err
+	}
+	return forward(ctx, req, c.GetEvmJsonAbiSchema)
+}
+
+// SetEvmImplementationAbi — shared metadata DB; any instance
+func (g *Gateway) SetEvmImplementationAbi(ctx context.Context, req *connect.Request[v1.SetEvmImplementationAbiRequest]) (*connect.Response[v1.SetEvmImplementationAbiResponse], error) {
+	c, err := g.anyClient()
+	if err != nil {
+		return nil, err
+	}
+	return forward(ctx, req, c.SetEvmImplementationAbi)
+}
+
+// ListEvmImplementationAbis — shared metadata DB; any instance
+func (g *Gateway) ListEvmImplementationAbis(ctx context.Context, req *connect.Request[v1.ListEvmImplementationAbisRequest]) (*connect.Response[v1.ListEvmImplementationAbisResponse], error) {
+	c, err := g.anyClient()
+	if err != nil {
+		return nil, err
+	}
+	return forward(ctx, req, c.ListEvmImplementationAbis)
+}
+
+// DeleteEvmImplementationAbi — shared metadata DB; any instance
+func (g *Gateway) DeleteEvmImplementationAbi(ctx context.Context, req *connect.Request[v1.DeleteEvmImplementationAbiRequest]) (*connect.Response[v1.DeleteEvmImplementationAbiResponse], error) {
+	c, err := g.anyClient()
+	if err != nil {
+		return nil, err
+	}
+	return forward(ctx, req, c.DeleteEvmImplementationAbi)
+}
+
+// ListEvmProxyImplementations — shared metadata DB; any instance
+func (g *Gateway) ListEvmProxyImplementations(ctx context.Context, req *connect.Request[v1.ListEvmProxyImplementationsRequest]) (*connect.Response[v1.ListEvmProxyImplementationsResponse], error) {
+	c, err := g.anyClient()
+	if err != nil {
+		return nil, err
+	}
+	return forward(ctx, req, c.ListEvmProxyImplementations)
+}
+
+// ImportEvmEventSignatures — shared metadata DB; any instance
+func (g *Gateway) ImportEvmEventSignatures(ctx context.Context, req *connect.Request[v1.ImportEvmEventSignaturesRequest]) (*connect.Response[v1.ImportEvmEventSignaturesResponse], error) {
+	c, err := g.anyClient()
+	if err != nil {
+		return nil, err
+	}
+	return forward(ctx, req, c.ImportEvmEventSignatures)
+}
+
+// ListEvmEventSignatures — shared metadata DB; any instance
+func (g *Gateway) ListEvmEventSignatures(ctx context.Context, req *connect.Request[v1.ListEvmEventSignaturesRequest]) (*connect.Response[v1.ListEvmEventSignaturesResponse], error) {
+	c, err := g.anyClient()
+	if err != nil {
+		return nil, err
+	}
+	return forward(ctx, req, c.ListEvmEventSignatures)
+}
+
+// DeleteEvmEventSignature — shared metadata DB; any instance
+func (g *Gateway) DeleteEvmEventSignature(ctx context.Context, req *connect.Request[v1.DeleteEvmEventSignatureRequest]) (*connect.Response[v1.DeleteEvmEventSignatureResponse], error) {
+	c, err := g.anyClient()
+	if err != nil {
+		return nil, err
+	}
+	return forward(ctx, req, c.DeleteEvmEventSignature)
+}
+
 // CreateEvmLogStore — shared metadata DB; any instance
 func (g *Gateway) CreateEvmLogStore(ctx context.Context, req *connect.Request[v1.CreateEvmLogStoreRequest]) (*connect.Response[v1.CreateEvmLogStoreResponse], error) {
 	c, err := g.anyClient()
@@ -458,6 +530,24 @@ func (g *Gateway) DeleteEvmLogSource(ctx context.Context, req *connect.Request[v
 	return forward(ctx, req, c.DeleteEvmLogSource)
 }
 
+// RedecodeEvmLogSource — owning instance
+func (g *Gateway) RedecodeEvmLogSource(ctx context.Context, req *connect.Request[v1.RedecodeEvmLogSourceRequest]) (*connect.Response[v1.RedecodeEvmLogSourceResponse], error) {
+	c, err := g.clientForSource(uint(req.Msg.GetId()))
+	if err != nil {
+		return nil, err
+	}
+	return forward(ctx, req, c.RedecodeEvmLogSource)
+}
+
+// ResyncEvmLogSource — owning instance
+func (g *Gateway) ResyncEvmLogSource(ctx context.Context, req *connect.Request[v1.ResyncEvmLogSourceRequest]) (*connect.Response[v1.ResyncEvmLogSourceResponse], error) {
+	c, err := g.clientForSource(uint(req.Msg.GetId()))
+	if err != nil {
+		return nil, err
+	}
+	return forward(ctx, req, c.ResyncEvmLogSource)
+}
+
 // ListEvmLogs — owning instance
 func (g *Gateway) ListEvmLogs(ctx context.Context, req *connect.Request[v1.ListEvmLogsRequest]) (*connect.Response[v1.ListEvmLogsResponse], error) {
 	c, err := g.clientForSource(uint(req.Msg.GetSourceId()))
@@ -485,6 +575,24 @@ func (g *Gateway) ListEvmTransactions(ctx context.Context, req *connect.Request[
 	return forward(ctx, req, c.ListEvmTransactions)
 }
 
+// ListEvmBlocks — owning instance
+func (g *Gateway) ListEvmBlocks(ctx context.Context, req *connect.Request[v1.ListEvmBlocksRequest]) (*connect.Response[v1.ListEvmBlocksResponse], error) {
+	c, err := g.clientForSource(uint(req.Msg.GetSourceId()))
+	if err != nil {
+		return nil, err
+	}
+	return forward(ctx, req, c.ListEvmBlocks)
+}
+
+// ListEvmTraces — owning instance
+func (g *Gateway) ListEvmTraces(ctx context.Context, req *connect.Request[v1.ListEvmTracesRequest]) (*connect.Response[v1.ListEvmTracesResponse], error) {
+	c, err := g.clientForSource(uint(req.Msg.GetSourceId()))
+	if err != nil {
+		return nil, err
+	}
+	return forward(ctx, req, c.ListEvmTraces)
+}
+
 // CreateEvmiExporter — owning instance
 func (g *Gateway) CreateEvmiExporter(ctx context.Context, req *connect.Request[v1.CreateEvmiExporterRequest]) (*connect.Response[v1.CreateEvmiExporterResponse], error) {
 	c, err := g.clientForPipeline(uint(req.Msg.GetExporter().GetEvmLogPipelineId()))
@@ -2,10 +2,14 @@ package grpc
 
 import (
 	"context"
+	"fmt"
+	"strings"
 
 	"connectrpc.com/connect"
+	"github.com/ethereum/go-ethereum/accounts/abi"
 	evmi_database "github.com/evmi-cloud/go-evm-indexer/internal/database/evmi-database"
 	evm_indexerv1 "github.com/evmi-cloud/go-evm-indexer/internal/grpc/generated/evm_indexer/v1"
+	"github.com/evmi-cloud/go-evm-indexer/internal/types"
 )
 
 // CreateEvmJsonAbi implements evm_indexerv1connect.EvmIndexerServiceHandler.
@@ -99,6 +103,55 @@ func (e *EvmIndexerServer) UpdateEvmJsonAbi(ctx context.Context, req *connect.Re
 	}, nil
 }
 
+// GetEvmJsonAbiSchema implements evm_indexerv1connect.EvmIndexerServiceHandler.
+func (e *EvmIndexerServer) GetEvmJsonAbiSchema(ctx context.Context, req *connect.Request[evm_indexerv1.GetEvmJsonAbiSchemaRequest]) (*connect.Response[evm_indexerv1.GetEvmJsonAbiSchemaResponse], error) {
+	var jsonAbi evmi_database.EvmJsonAbi
+
+	result := e.db.Conn.First(&jsonAbi, req.Msg.Id)
+	if result.Error != nil {
+		return nil, dbError(result.Error)
+	}
+
+	parsed, err := abi.JSON(strings.NewReader(jsonAbi.Content))
+	if err != nil {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("abi %d does not parse: %w", jsonAbi.ID, err))
+	}
+	schema := types.NewEvmAbiSchema(parsed)
+
+	return &connect.Response[evm_indexerv1.GetEvmJsonAbiSchemaResponse]{
+		Msg: &evm_indexerv1.GetEvmJsonAbiSchemaResponse{
+			Events:    toGrpcEntrySchemas(schema.Events),
+			Functions: toGrpcEntrySchemas(schema.Functions),
+		},
+	}, nil
+}
+
+func toGrpcEntrySchemas(entries []types.EvmEntrySchema) []*evm_indexerv1.EvmEntrySchema {
+	var result []*evm_indexerv1.EvmEntrySchema
+	for _, entry := range entries {
+		result = append(result, &evm_indexerv1.EvmEntrySchema{
+			Name:      entry.Name,
+			Signature: entry.Signature,
+			Selector:  entry.Selector,
+			Args:      toGrpcArgSchemas(entry.Args),
+		})
+	}
+	return result
+}
+
+func toGrpcArgSchemas(args []types.EvmArgSchema) []*evm_indexerv1.EvmArgSchema {
+	var result []*evm_indexerv1.EvmArgSchema
+	for _, arg := range args {
+		result = append(result, &evm_indexerv1.EvmArgSchema{
+			Name:       arg.Name,
+			Type:       arg.Type,
+			Indexed:    arg.Indexed,
+			Components: toGrpcArgSchemas(arg.Components),
+		})
+	}
+	return result
+}
+
 func toGrpcAbi(blockchain evmi_database.EvmJsonAbi) *evm_indexerv1.EvmJsonAbi {
 	id := uint32(blockchain.ID)
 	createdAt := uint32(blockchain.CreatedAt.Unix())
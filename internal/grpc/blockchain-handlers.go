@@ -2,29 +2,100 @@ package grpc
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
 
 	"connectrpc.com/connect"
 	evmi_database "github.com/evmi-cloud/go-evm-indexer/internal/database/evmi-database"
 	evm_indexerv1 "github.com/evmi-cloud/go-evm-indexer/internal/grpc/generated/evm_indexer/v1"
 )
 
+// parseHeadMode normalizes a requested head mode (case-insensitive, empty means
+// LATEST), rejecting unknown ones.
+func parseHeadMode(mode string) (string, error) {
+	headMode := evmi_database.ChainHeadMode(strings.ToUpper(mode))
+	if !headMode.Valid() {
+		return "", connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("unknown head mode %q", mode))
+	}
+	return string(headMode), nil
+}
+
+// validateRpcEndpoints rejects endpoints without a URL and duplicated URLs.
+func validateRpcEndpoints(endpoints []*evm_indexerv1.EvmRpcEndpoint) error {
+	seen := make(map[string]bool, len(endpoints))
+	for _, endpoint := range endpoints {
+		if endpoint.Url == "" {
+			return connect.NewError(connect.CodeInvalidArgument, errors.New("rpc endpoint url is required"))
+		}
+		if seen[endpoint.Url] {
+			return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("rpc endpoint %q listed twice", endpoint.Url))
+		}
+		seen[endpoint.Url] = true
+	}
+	return nil
+}
+
+// validateRpcRateLimit rejects negative and non-finite rate limits.
+func validateRpcRateLimit(rate float64) error {
+	if rate < 0 || math.IsNaN(rate) || math.IsInf(rate, 0) {
+		return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid rpc rate limit %v", rate))
+	}
+	return nil
+}
+
+// rpcUrlOrFirstEndpoint keeps RpcUrl set for clients that only read it: an
+// empty one defaults to the first endpoint.
+func rpcUrlOrFirstEndpoint(rpcUrl string, endpoints []*evm_indexerv1.EvmRpcEndpoint) string {
+	if rpcUrl == "" && len(endpoints) > 0 {
+		return endpoints[0].Url
+	}
+	return rpcUrl
+}
+
 // CreateEvmBlockchain implements evm_indexerv1connect.EvmIndexerServiceHandler.
 func (e *EvmIndexerServer) CreateEvmBlockchain(ctx context.Context, req *connect.Request[evm_indexerv1.CreateEvmBlockchainRequest]) (*connect.Response[evm_indexerv1.CreateEvmBlockchainResponse], error) {
+	headMode, err := parseHeadMode(req.Msg.Blockchain.HeadMode)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateRpcEndpoints(req.Msg.Blockchain.RpcEndpoints); err != nil {
+		return nil, err
+	}
+	if err := validateRpcRateLimit(req.Msg.Blockchain.RpcRateLimit); err != nil {
+		return nil, err
+	}
+
 	newBlockchain := evmi_database.EvmBlockchain{
-		ChainId:         req.Msg.Blockchain.ChainId,
-		Name:            req.Msg.Blockchain.Name,
-		RpcUrl:          req.Msg.Blockchain.RpcUrl,
-		BlockRange:      req.Msg.Blockchain.BlockRange,
-		BlockSlice:      req.Msg.Blockchain.BlockSlice,
-		PullInterval:    req.Msg.Blockchain.PullInterval,
-		RpcMaxBatchSize: req.Msg.Blockchain.RpcMaxBatchSize,
+		ChainId:              req.Msg.Blockchain.ChainId,
+		Name:                 req.Msg.Blockchain.Name,
+		RpcUrl:               rpcUrlOrFirstEndpoint(req.Msg.Blockchain.RpcUrl, req.Msg.Blockchain.RpcEndpoints),
+		BlockRange:           req.Msg.Blockchain.BlockRange,
+		BlockSlice:           req.Msg.Blockchain.BlockSlice,
+		PullInterval:         req.Msg.Blockchain.PullInterval,
+		RpcMaxBatchSize:      req.Msg.Blockchain.RpcMaxBatchSize,
+		HeadMode:             headMode,
+		HeadConfirmations:    req.Msg.Blockchain.HeadConfirmations,
+		SqdGatewayAvailable:  req.Msg.Blockchain.SqdGatewayAvailable,
+		SqdGatewayUrl:        req.Msg.Blockchain.SqdGatewayUrl,
+		WsUrl:                req.Msg.Blockchain.WsUrl,
+		WsSubscribeLogs:      req.Msg.Blockchain.WsSubscribeLogs,
+		LogsMaxAddresses:     req.Msg.Blockchain.LogsMaxAddresses,
+		TraceFilterAvailable: req.Msg.Blockchain.TraceFilterAvailable,
+		RpcRateLimit:         req.Msg.Blockchain.RpcRateLimit,
 	}
+	newBlockchain.SetMethodCosts(req.Msg.Blockchain.RpcMethodCosts)
 
 	result := e.db.Conn.Create(&newBlockchain)
 	if result.Error != nil {
 		return nil, dbError(result.Error)
 	}
 
+	if err := e.saveRpcEndpoints(newBlockchain.ID, req.Msg.Blockchain.RpcEndpoints); err != nil {
+		return nil, dbError(err)
+	}
+
 	return &connect.Response[evm_indexerv1.CreateEvmBlockchainResponse]{
 		Msg: &evm_indexerv1.CreateEvmBlockchainResponse{
 			Id: uint32(newBlockchain.ID),
@@ -37,7 +108,7 @@ func (e *EvmIndexerServer) GetEvmBlockchain(ctx context.Context, req *connect.Re
 
 	var blockchain evmi_database.EvmBlockchain
 
-	result := e.db.Conn.First(&blockchain, req.Msg.Id)
+	result := e.db.Conn.Preload("RpcEndpoints").First(&blockchain, req.Msg.Id)
 	if result.Error != nil {
 		return nil, dbError(result.Error)
 	}
@@ -53,7 +124,7 @@ func (e *EvmIndexerServer) GetEvmBlockchain(ctx context.Context, req *connect.Re
 func (e *EvmIndexerServer) ListEvmBlockchains(ctx context.Context, req *connect.Request[evm_indexerv1.ListEvmBlockchainsRequest]) (*connect.Response[evm_indexerv1.ListEvmBlockchainsResponse], error) {
 	var blockchains []evmi_database.EvmBlockchain
 
-	query := e.db.Conn.Model(&evmi_database.EvmBlockchain{})
+	query := e.db.Conn.Model(&evmi_database.EvmBlockchain{}).Preload("RpcEndpoints")
 	if req.Msg.Pagination != nil && req.Msg.Pagination.Limit > 0 {
 		query = query.Offset(int(req.Msg.Pagination.Offset)).Limit(int(req.Msg.Pagination.Limit))
 	}
@@ -71,6 +142,17 @@ func (e *EvmIndexerServer) ListEvmBlockchains(ctx context.Context, req *connect.
 
 // UpdateEvmBlockchain implements evm_indexerv1connect.EvmIndexerServiceHandler.
 func (e *EvmIndexerServer) UpdateEvmBlockchain(ctx context.Context, req *connect.Request[evm_indexerv1.UpdateEvmBlockchainRequest]) (*connect.Response[evm_indexerv1.UpdateEvmBlockchainResponse], error) {
+	headMode, err := parseHeadMode(req.Msg.Blockchain.HeadMode)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateRpcEndpoints(req.Msg.Blockchain.RpcEndpoints); err != nil {
+		return nil, err
+	}
+	if err := validateRpcRateLimit(req.Msg.Blockchain.RpcRateLimit); err != nil {
+		return nil, err
+	}
+
 	var blockchain evmi_database.EvmBlockchain
 
 	result := e.db.Conn.First(&blockchain, req.Msg.Blockchain.Id)
@@ -80,17 +162,31 @@ func (e *EvmIndexerServer) UpdateEvmBlockchain(ctx context.Context, req *connect
 
 	blockchain.ChainId = req.Msg.Blockchain.ChainId
 	blockchain.Name = req.Msg.Blockchain.Name
-	blockchain.RpcUrl = req.Msg.Blockchain.RpcUrl
+	blockchain.RpcUrl = rpcUrlOrFirstEndpoint(req.Msg.Blockchain.RpcUrl, req.Msg.Blockchain.RpcEndpoints)
 	blockchain.BlockRange = req.Msg.Blockchain.BlockRange
 	blockchain.BlockSlice = req.Msg.Blockchain.BlockSlice
 	blockchain.PullInterval = req.Msg.Blockchain.PullInterval
 	blockchain.RpcMaxBatchSize = req.Msg.Blockchain.RpcMaxBatchSize
+	blockchain.HeadMode = headMode
+	blockchain.HeadConfirmations = req.Msg.Blockchain.HeadConfirmations
+	blockchain.SqdGatewayAvailable = req.Msg.Blockchain.SqdGatewayAvailable
+	blockchain.SqdGatewayUrl = req.Msg.Blockchain.SqdGatewayUrl
+	blockchain.WsUrl = req.Msg.Blockchain.WsUrl
+	blockchain.WsSubscribeLogs = req.Msg.Blockchain.WsSubscribeLogs
+	blockchain.LogsMaxAddresses = req.Msg.Blockchain.LogsMaxAddresses
+	blockchain.TraceFilterAvailable = req.Msg.Blockchain.TraceFilterAvailable
+	blockchain.RpcRateLimit = req.Msg.Blockchain.RpcRateLimit
+	blockchain.SetMethodCosts(req.Msg.Blockchain.RpcMethodCosts)
 
 	result = e.db.Conn.Save(&blockchain)
 	if result.Error != nil {
 		return nil, dbError(result.Error)
 	}
 
+	if err := e.saveRpcEndpoints(blockchain.ID, req.Msg.Blockchain.RpcEndpoints); err != nil {
+		return nil, dbError(err)
+	}
+
 	return &connect.Response[evm_indexerv1.UpdateEvmBlockchainResponse]{
 		Msg: &evm_indexerv1.UpdateEvmBlockchainResponse{},
 	}, nil
@@ -106,6 +202,11 @@ func (e *EvmIndexerServer) DeleteEvmBlockchain(ctx context.Context, req *connect
 		return nil, dbError(result.Error)
 	}
 
+	result = e.db.Conn.Where("evm_blockchain_id = ?", req.Msg.Id).Delete(&evmi_database.EvmRpcEndpoint{})
+	if result.Error != nil {
+		return nil, dbError(result.Error)
+	}
+
 	return &connect.Response[evm_indexerv1.DeleteEvmBlockchainResponse]{
 		Msg: &evm_indexerv1.DeleteEvmBlockchainResponse{},
 	}, nil
@@ -117,18 +218,53 @@ func toGrpcBlockchain(blockchain evmi_database.EvmBlockchain) *evm_indexerv1.Evm
 	updatedAt := uint32(blockchain.UpdatedAt.Unix())
 	deletedAt := uint32(blockchain.DeletedAt.Time.Unix())
 	return &evm_indexerv1.EvmBlockchain{
-		Id:              &id,
-		ChainId:         blockchain.ChainId,
-		Name:            blockchain.Name,
-		RpcUrl:          blockchain.RpcUrl,
-		BlockRange:      blockchain.BlockRange,
-		BlockSlice:      blockchain.BlockSlice,
-		PullInterval:    blockchain.PullInterval,
-		RpcMaxBatchSize: blockchain.RpcMaxBatchSize,
-		CreatedAt:       &createdAt,
-		UpdatedAt:       &updatedAt,
-		DeletedAt:       &deletedAt,
+		Id:                   &id,
+		ChainId:              blockchain.ChainId,
+		Name:                 blockchain.Name,
+		RpcUrl:               blockchain.RpcUrl,
+		BlockRange:           blockchain.BlockRange,
+		BlockSlice:           blockchain.BlockSlice,
+		PullInterval:         blockchain.PullInterval,
+		RpcMaxBatchSize:      blockchain.RpcMaxBatchSize,
+		CreatedAt:            &createdAt,
+		UpdatedAt:            &updatedAt,
+		DeletedAt:            &deletedAt,
+		HeadMode:             blockchain.HeadMode,
+		HeadConfirmations:    blockchain.HeadConfirmations,
+		SqdGatewayAvailable:  blockchain.SqdGatewayAvailable,
+		SqdGatewayUrl:        blockchain.SqdGatewayUrl,
+		RpcEndpoints:         toGrpcRpcEndpoints(blockchain.RpcEndpoints),
+		WsUrl:                blockchain.WsUrl,
+		WsSubscribeLogs:      blockchain.WsSubscribeLogs,
+		LogsMaxAddresses:     blockchain.LogsMaxAddresses,
+		TraceFilterAvailable: blockchain.TraceFilterAvailable,
+		RpcRateLimit:         blockchain.RpcRateLimit,
+		RpcMethodCosts:       blockchain.MethodCosts(),
+	}
+}
+
+func toGrpcRpcEndpoints(endpoints []evmi_database.EvmRpcEndpoint) []*evm_indexerv1.EvmRpcEndpoint {
+	result := make([]*evm_indexerv1.EvmRpcEndpoint, 0, len(endpoints))
+	for _, endpoint := range endpoints {
+		id := uint32(endpoint.ID)
+		var checkedAt *uint32
+		if endpoint.CheckedAt != nil {
+			at := uint32(endpoint.CheckedAt.Unix())
+			checkedAt = &at
+		}
+		result = append(result, &evm_indexerv1.EvmRpcEndpoint{
+			Id:        &id,
+			Url:       endpoint.Url,
+			Weight:    endpoint.Weight,
+			Healthy:   endpoint.Healthy,
+			HeadBlock: endpoint.HeadBlock,
+			LatencyMs: endpoint.LatencyMs,
+			ErrorRate: endpoint.ErrorRate,
+			LastError: endpoint.LastError,
+			CheckedAt: checkedAt,
+		})
 	}
+	return result
 }
 
 func toGrpcBlockchains(blockchains []evmi_database.EvmBlockchain) []*evm_indexerv1.EvmBlockchain {
@@ -140,3 +276,42 @@ func toGrpcBlockchains(blockchains []evmi_database.EvmBlockchain) []*evm_indexer
 
 	return result
 }
+
+// saveRpcEndpoints replaces a blockchain's endpoint list. Endpoints are matched
+// by URL so the ones kept keep their row, and the health the indexers reported
+// for them.
+func (e *EvmIndexerServer) saveRpcEndpoints(blockchainID uint, endpoints []*evm_indexerv1.EvmRpcEndpoint) error {
+	var existing []evmi_database.EvmRpcEndpoint
+	if err := e.db.Conn.Where("evm_blockchain_id = ?", blockchainID).Find(&existing).Error; err != nil {
+		return err
+	}
+	byUrl := make(map[string]evmi_database.EvmRpcEndpoint, len(existing))
+	for _, row := range existing {
+		byUrl[row.Url] = row
+	}
+
+	for _, endpoint := range endpoints {
+		weight := endpoint.Weight
+		if weight == 0 {
+			weight = 1
+		}
+		if row, ok := byUrl[endpoint.Url]; ok {
+			delete(byUrl, endpoint.Url)
+			if err := e.db.Conn.Model(&row).Update("weight", weight).Error; err != nil {
+				return err
+			}
+			continue
+		}
+		row := evmi_database.EvmRpcEndpoint{EvmBlockchainID: blockchainID, Url: endpoint.Url, Weight: weight}
+		if err := e.db.Conn.Create(&row).Error; err != nil {
+			return err
+		}
+	}
+
+	for _, row := range byUrl {
+		if err := e.db.Conn.Unscoped().Delete(&row).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
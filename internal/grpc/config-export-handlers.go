@@ -44,7 +44,7 @@ func (e *EvmIndexerServer) buildExportedConfig() (types.Config, error) {
 	db := e.db.Conn
 
 	var blockchains []evmi_database.EvmBlockchain
-	if err := db.Find(&blockchains).Error; err != nil {
+	if err := db.Preload("RpcEndpoints").Find(&blockchains).Error; err != nil {
 		return out, err
 	}
 	var abis []evmi_database.EvmJsonAbi
@@ -96,16 +96,29 @@ func (e *EvmIndexerServer) buildExportedConfig() (types.Config, error) {
 	}
 
 	for _, b := range blockchains {
+		var endpoints []types.ConfigRpcEndpoint
+		for _, endpoint := range b.RpcEndpoints {
+			endpoints = append(endpoints, types.ConfigRpcEndpoint{Url: endpoint.Url, Weight: endpoint.Weight})
+		}
 		out.Resources.Blockchains = append(out.Resources.Blockchains, types.ConfigBlockchain{
-			Name:                b.Name,
-			ChainId:             b.ChainId,
-			RpcUrl:              b.RpcUrl,
-			BlockRange:          b.BlockRange,
-			BlockSlice:          b.BlockSlice,
-			PullInterval:        b.PullInterval,
-			RpcMaxBatchSize:     b.RpcMaxBatchSize,
-			SqdGatewayAvailable: b.SqdGatewayAvailable,
-			SqdGatewayUrl:       b.SqdGatewayUrl,
+			Name:                 b.Name,
+			ChainId:              b.ChainId,
+			RpcUrl:               b.RpcUrl,
+			BlockRange:           b.BlockRange,
+			BlockSlice:           b.BlockSlice,
+			PullInterval:         b.PullInterval,
+			RpcMaxBatchSize:      b.RpcMaxBatchSize,
+			SqdGatewayAvailable:  b.SqdGatewayAvailable,
+			SqdGatewayUrl:        b.SqdGatewayUrl,
+			HeadMode:             b.HeadMode,
+			HeadConfirmations:    b.HeadConfirmations,
+			RpcEndpoints:         endpoints,
+			WsUrl:                b.WsUrl,
+			WsSubscribeLogs:      b.WsSubscribeLogs,
+			LogsMaxAddresses:     b.LogsMaxAddresses,
+			TraceFilterAvailable: b.TraceFilterAvailable,
+			RpcRateLimit:         b.RpcRateLimit,
+			RpcMethodCosts:       b.MethodCosts(),
 		})
 	}
 	for _, a := range abis {
@@ -131,12 +144,14 @@ func (e *EvmIndexerServer) buildExportedConfig() (types.Config, error) {
 	}
 	for _, s := range sources {
 		src := types.ConfigSource{
-			Pipeline:   pipelineName[s.EvmLogPipelineID],
-			Blockchain: blockchainName[s.EvmBlockchainID],
-			Abi:        abiName[s.EvmJsonAbiID],
-			Type:       s.Type,
-			Enabled:    s.Enabled,
-			StartBlock: s.StartBlock,
+			Pipeline:       pipelineName[s.EvmLogPipelineID],
+			Blockchain:     blockchainName[s.EvmBlockchainID],
+			Abi:            abiName[s.EvmJsonAbiID],
+			Type:           s.Type,
+			Enabled:        s.Enabled,
+			StartBlock:     s.StartBlock,
+			EndBlock:       s.EndBlock,
+			CatchUpWorkers: s.CatchUpWorkers,
 		}
 		if s.Address.Valid {
 			src.Address = s.Address.String
@@ -144,6 +159,20 @@ func (e *EvmIndexerServer) buildExportedConfig() (types.Config, error) {
 		if s.Topic0.Valid {
 			src.Topic0 = s.Topic0.String
 		}
+		// Lists of one value stay in the single fields.
+		if topic0s := s.Topic0Values(); len(topic0s) > 1 {
+			src.Topic0, src.Topic0s = "", topic0s
+		}
+		if abiIDs := s.AbiIDs(); len(abiIDs) > 1 {
+			src.Abi = ""
+			for _, abiID := range abiIDs {
+				src.Abis = append(src.Abis, abiName[abiID])
+			}
+		}
+		if addresses := s.AddressValues(); len(addresses) > 1 {
+			src.Address, src.Addresses = "", addresses
+		}
+		src.FunctionSelector = s.FunctionSelector
 		src.TopicFilters = []string(s.TopicFilters)
 		if s.Type == string(evmi_database.FactoryLogSourceType) {
 			rules, err := e.exportFactoryRules(s.ID, abiName)
@@ -210,6 +239,7 @@ func (e *EvmIndexerServer) exportRulesAt(sourceID uint, parentRuleID *uint, abiN
 			})
 		}
 		out = append(out, types.ConfigFactoryRule{
+			Trigger:               r.Trigger,
 			CreationFunctionName:  r.CreationFunctionName,
 			CreationAddressLogArg: r.CreationAddressLogArg,
 			ChildAbi:              abiName[r.EvmJsonAbiID],
@@ -26,13 +26,14 @@ func TestExportConfiguration(t *testing.T) {
 	e.config.Database.Config = map[string]string{"filename": "evmi.db"}
 	e.config.Metrics.Enabled = true
 	e.config.Metrics.Port = 9999
-	if err := e.db.Conn.AutoMigrate(&evmi_database.EvmBlockchain{}, &evmi_database.EvmJsonAbi{},
+	if err := e.db.Conn.AutoMigrate(&evmi_database.EvmBlockchain{}, &evmi_database.EvmRpcEndpoint{}, &evmi_database.EvmJsonAbi{},
 		&evmi_database.EvmiExporter{}, &evmi_database.Plugin{}); err != nil {
 		t.Fatalf("migrate: %v", err)
 	}
 	db := e.db.Conn
 
-	chain := evmi_database.EvmBlockchain{Name: "ethereum", ChainId: 1, RpcUrl: "http://rpc", BlockRange: 500}
+	chain := evmi_database.EvmBlockchain{Name: "ethereum", ChainId: 1, RpcUrl: "http://rpc", BlockRange: 500,
+		RpcEndpoints: []evmi_database.EvmRpcEndpoint{{Url: "http://rpc", Weight: 3}, {Url: "http://rpc-backup", Weight: 1}}}
 	db.Create(&chain)
 	abi := evmi_database.EvmJsonAbi{ContractName: "ERC20", Content: "[]"}
 	db.Create(&abi)
@@ -56,6 +57,12 @@ func TestExportConfiguration(t *testing.T) {
 		Conditions: []evmi_database.EvmFactoryRuleCondition{{Arg: "x", Operator: "gte", Value: "1"}}}
 	db.Create(&rule)
 
+	// A TOPIC source matching two topic0s, decoded against both ABIs.
+	topicSrc := evmi_database.EvmLogSource{Type: "TOPIC", Enabled: true, EvmLogPipelineID: pipeline.ID, EvmBlockchainID: chain.ID}
+	topicSrc.SetTopic0s([]string{"0xaa", "0xbb"})
+	topicSrc.SetAbiIDs([]uint{abi.ID, factoryAbi.ID})
+	db.Create(&topicSrc)
+
 	// Factory-created child: must be excluded from the export.
 	child := evmi_database.EvmLogSource{Type: "CONTRACT", Address: sql.NullString{String: "0xchild", Valid: true},
 		ParentSourceID: factorySrc.ID, EvmLogPipelineID: pipeline.ID, EvmBlockchainID: chain.ID, EvmJsonAbiID: abi.ID}
@@ -87,6 +94,8 @@ func TestExportConfiguration(t *testing.T) {
 
 	if len(cfg.Resources.Blockchains) != 1 || cfg.Resources.Blockchains[0].Name != "ethereum" || cfg.Resources.Blockchains[0].ChainId != 1 {
 		t.Errorf("blockchains: %+v", cfg.Resources.Blockchains)
+	} else if endpoints := cfg.Resources.Blockchains[0].RpcEndpoints; len(endpoints) != 2 || endpoints[0].Weight != 3 || endpoints[1].Url != "http://rpc-backup" {
+		t.Errorf("blockchain rpc endpoints: %+v", endpoints)
 	}
 	if len(cfg.Resources.Abis) != 2 {
 		t.Errorf("expected 2 abis, got %d", len(cfg.Resources.Abis))
@@ -104,11 +113,11 @@ func TestExportConfiguration(t *testing.T) {
 		t.Errorf("exporters: %+v", cfg.Resources.Exporters)
 	}
 
-	// The factory-created child is excluded; the two declared sources remain.
-	if len(cfg.Resources.Sources) != 2 {
-		t.Fatalf("expected 2 sources (factory child excluded), got %d: %+v", len(cfg.Resources.Sources), cfg.Resources.Sources)
+	// The factory-created child is excluded; the three declared sources remain.
+	if len(cfg.Resources.Sources) != 3 {
+		t.Fatalf("expected 3 sources (factory child excluded), got %d: %+v", len(cfg.Resources.Sources), cfg.Resources.Sources)
 	}
-	var fac *types.ConfigSource
+	var fac, topic *types.ConfigSource
 	for i := range cfg.Resources.Sources {
 		s := &cfg.Resources.Sources[i]
 		if s.Pipeline != "main" || s.Blockchain != "ethereum" {
@@ -120,6 +129,12 @@ func TestExportConfiguration(t *testing.T) {
 		if s.Type == "FACTORY" {
 			fac = s
 		}
+		if s.Type == "TOPIC" {
+			topic = s
+		}
+	}
+	if topic == nil || topic.Topic0 != "" || len(topic.Topic0s) != 2 || topic.Abi != "" || len(topic.Abis) != 2 || topic.Abis[1] != "UniswapV2Factory" {
+		t.Errorf("topic source lists not exported: %+v", topic)
 	}
 	if fac == nil {
 		t.Fatal("factory source missing from export")
@@ -142,7 +157,7 @@ func TestExportConfiguration(t *testing.T) {
 	if err != nil {
 		t.Fatalf("open fresh db: %v", err)
 	}
-	if err := fresh.AutoMigrate(&evmi_database.EvmBlockchain{}, &evmi_database.EvmJsonAbi{}, &evmi_database.EvmLogStore{},
+	if err := fresh.AutoMigrate(&evmi_database.EvmBlockchain{}, &evmi_database.EvmRpcEndpoint{}, &evmi_database.EvmJsonAbi{}, &evmi_database.EvmLogStore{},
 		&evmi_database.EvmLogPipeline{}, &evmi_database.EvmLogSource{}, &evmi_database.EvmFactoryRule{},
 		&evmi_database.EvmFactoryRuleCondition{}, &evmi_database.EvmiExporter{}, &evmi_database.Plugin{}); err != nil {
 		t.Fatalf("migrate fresh: %v", err)
@@ -159,6 +174,10 @@ func TestExportConfiguration(t *testing.T) {
 	if count != 1 {
 		t.Errorf("reload blockchains = %d, want 1", count)
 	}
+	fresh.Model(&evmi_database.EvmRpcEndpoint{}).Count(&count)
+	if count != 2 {
+		t.Errorf("reload rpc endpoints = %d, want 2", count)
+	}
 	fresh.Model(&evmi_database.EvmJsonAbi{}).Count(&count)
 	if count != 2 {
 		t.Errorf("reload abis = %d, want 2", count)
@@ -167,10 +186,20 @@ func TestExportConfiguration(t *testing.T) {
 	if count != 1 {
 		t.Errorf("reload pipelines = %d, want 1", count)
 	}
-	// Only the two declared sources are recreated (factory child is not declared).
+	// Only the three declared sources are recreated (factory child is not declared).
 	fresh.Model(&evmi_database.EvmLogSource{}).Count(&count)
-	if count != 2 {
-		t.Errorf("reload sources = %d, want 2 (factory child must not reload)", count)
+	if count != 3 {
+		t.Errorf("reload sources = %d, want 3 (factory child must not reload)", count)
+	}
+	var reloadedTopic evmi_database.EvmLogSource
+	if err := fresh.Where("type = ?", "TOPIC").First(&reloadedTopic).Error; err != nil {
+		t.Fatalf("reloaded topic source missing: %v", err)
+	}
+	if topic0s := reloadedTopic.Topic0Values(); len(topic0s) != 2 || topic0s[1] != "0xbb" || reloadedTopic.Topic0.String != "0xaa" {
+		t.Errorf("reloaded topic0s = %v", topic0s)
+	}
+	if len(reloadedTopic.AbiIDs()) != 2 {
+		t.Errorf("reloaded abis = %v, want 2", reloadedTopic.AbiIDs())
 	}
 	fresh.Model(&evmi_database.EvmiExporter{}).Count(&count)
 	if count != 1 {
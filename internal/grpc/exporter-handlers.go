@@ -143,7 +143,8 @@ func (e *EvmIndexerServer) StartExporter(ctx context.Context, req *connect.Reque
 		if result := e.db.Conn.First(&exporter, exporterId); result.Error != nil {
 			return connect.NewResponse(&evm_indexerv1.StartExporterResponse{Success: false, Error: result.Error.Error()}), nil
 		}
-		if exporter.Status == string(evmi_database.RunningExporterStatus) {
+		// An exporter whose sources all completed finishes right away.
+		if exporter.Status == string(evmi_database.RunningExporterStatus) || exporter.Status == string(evmi_database.CompletedExporterStatus) {
 			return connect.NewResponse(&evm_indexerv1.StartExporterResponse{Success: true}), nil
 		}
 	}
@@ -165,7 +166,8 @@ func (e *EvmIndexerServer) StopExporter(ctx context.Context, req *connect.Reques
 		if result := e.db.Conn.First(&exporter, exporterId); result.Error != nil {
 			return connect.NewResponse(&evm_indexerv1.StopExporterResponse{Success: false, Error: result.Error.Error()}), nil
 		}
-		if exporter.Status == string(evmi_database.StoppedExporterStatus) {
+		// A completed exporter was not running and keeps its status.
+		if exporter.Status == string(evmi_database.StoppedExporterStatus) || exporter.Status == string(evmi_database.CompletedExporterStatus) {
 			return connect.NewResponse(&evm_indexerv1.StopExporterResponse{Success: true}), nil
 		}
 	}
@@ -129,6 +129,33 @@ type EvmBlockchain struct {
 	CreatedAt       *uint32 `protobuf:"varint,9,opt,name=created_at,json=createdAt,proto3,oneof" json:"created_at,omitempty"`
 	UpdatedAt       *uint32 `protobuf:"varint,10,opt,name=updated_at,json=updatedAt,proto3,oneof" json:"updated_at,omitempty"`
 	DeletedAt       *uint32 `protobuf:"varint,11,opt,name=deleted_at,json=deletedAt,proto3,oneof" json:"deleted_at,omitempty"`
+	// Block the chain's sources index up to: LATEST (default when empty),
+	// CONFIRMATIONS (latest minus head_confirmations), SAFE or FINALIZED.
+	HeadMode          string `protobuf:"bytes,12,opt,name=head_mode,json=headMode,proto3" json:"head_mode,omitempty"`
+	HeadConfirmations uint64 `protobuf:"varint,13,opt,name=head_confirmations,json=headConfirmations,proto3" json:"head_confirmations,omitempty"`
+	// SQD (Subsquid) archive gateway used to backfill ranges it holds.
+	SqdGatewayAvailable bool   `protobuf:"varint,14,opt,name=sqd_gateway_available,json=sqdGatewayAvailable,proto3" json:"sqd_gateway_available,omitempty"`
+	SqdGatewayUrl       string `protobuf:"bytes,15,opt,name=sqd_gateway_url,json=sqdGatewayUrl,proto3" json:"sqd_gateway_url,omitempty"`
+	// JSON-RPC endpoints calls are spread over by weight; rpc_url is used alone
+	// when the list is empty.
+	RpcEndpoints []*EvmRpcEndpoint `protobuf:"bytes,16,rep,name=rpc_endpoints,json=rpcEndpoints,proto3" json:"rpc_endpoints,omitempty"`
+	// WebSocket endpoint whose newHeads subscription (or, with
+	// ws_subscribe_logs, each source's logs subscription) triggers indexing
+	// instead of polling every pull_interval.
+	WsUrl           string `protobuf:"bytes,17,opt,name=ws_url,json=wsUrl,proto3" json:"ws_url,omitempty"`
+	WsSubscribeLogs bool   `protobuf:"varint,18,opt,name=ws_subscribe_logs,json=wsSubscribeLogs,proto3" json:"ws_subscribe_logs,omitempty"`
+	// Most addresses per eth_getLogs call when the ranges of several sources
+	// are batched; 0 uses the indexer default.
+	LogsMaxAddresses uint64 `protobuf:"varint,19,opt,name=logs_max_addresses,json=logsMaxAddresses,proto3" json:"logs_max_addresses,omitempty"`
+	// Read the traces of TRACES sources and TRACE factory rules with
+	// trace_filter (Erigon, Nethermind, Reth) instead of tracing every block
+	// with debug_traceBlockByNumber.
+	TraceFilterAvailable bool `protobuf:"varint,20,opt,name=trace_filter_available,json=traceFilterAvailable,proto3" json:"trace_filter_available,omitempty"`
+	// JSON-RPC calls per second the chain's sources make together on an
+	// indexer instance, 0 for no limit. rpc_method_costs weighs the methods
+	// against it (compute units), 1 for a method left out.
+	RpcRateLimit   float64           `protobuf:"fixed64,21,opt,name=rpc_rate_limit,json=rpcRateLimit,proto3" json:"rpc_rate_limit,omitempty"`
+	RpcMethodCosts map[string]uint64 `protobuf:"bytes,22,rep,name=rpc_method_costs,json=rpcMethodCosts,proto3" json:"rpc_method_costs,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
 }
 
 func (x *EvmBlockchain) Reset() {
@@ -238,6 +265,195 @@ func (x *EvmBlockchain) GetDeletedAt() uint32 {
 	return 0
 }
 
+func (x *EvmBlockchain) GetHeadMode() string {
+	if x != nil {
+		return x.HeadMode
+	}
+	return ""
+}
+
+func (x *EvmBlockchain) GetHeadConfirmations() uint64 {
+	if x != nil {
+		return x.HeadConfirmations
+	}
+	return 0
+}
+
+func (x *EvmBlockchain) GetSqdGatewayAvailable() bool {
+	if x != nil {
+		return x.SqdGatewayAvailable
+	}
+	return false
+}
+
+func (x *EvmBlockchain) GetSqdGatewayUrl() string {
+	if x != nil {
+		return x.SqdGatewayUrl
+	}
+	return ""
+}
+
+func (x *EvmBlockchain) GetRpcEndpoints() []*EvmRpcEndpoint {
+	if x != nil {
+		return x.RpcEndpoints
+	}
+	return nil
+}
+
+func (x *EvmBlockchain) GetWsUrl() string {
+	if x != nil {
+		return x.WsUrl
+	}
+	return ""
+}
+
+func (x *EvmBlockchain) GetWsSubscribeLogs() bool {
+	if x != nil {
+		return x.WsSubscribeLogs
+	}
+	return false
+}
+
+func (x *EvmBlockchain) GetLogsMaxAddresses() uint64 {
+	if x != nil {
+		return x.LogsMaxAddresses
+	}
+	return 0
+}
+
+func (x *EvmBlockchain) GetTraceFilterAvailable() bool {
+	if x != nil {
+		return x.TraceFilterAvailable
+	}
+	return false
+}
+
+func (x *EvmBlockchain) GetRpcRateLimit() float64 {
+	if x != nil {
+		return x.RpcRateLimit
+	}
+	return 0
+}
+
+func (x *EvmBlockchain) GetRpcMethodCosts() map[string]uint64 {
+	if x != nil {
+		return x.RpcMethodCosts
+	}
+	return nil
+}
+
+// EvmRpcEndpoint is one JSON-RPC node of a blockchain. url and weight are set
+// through the API; the other fields are the health last reported by the
+// indexers and are ignored on create/update.
+type EvmRpcEndpoint struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        *uint32 `protobuf:"varint,1,opt,name=id,proto3,oneof" json:"id,omitempty"`
+	Url       string  `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	Weight    uint64  `protobuf:"varint,3,opt,name=weight,proto3" json:"weight,omitempty"`
+	Healthy   bool    `protobuf:"varint,4,opt,name=healthy,proto3" json:"healthy,omitempty"`
+	HeadBlock uint64  `protobuf:"varint,5,opt,name=head_block,json=headBlock,proto3" json:"head_block,omitempty"`
+	LatencyMs uint64  `protobuf:"varint,6,opt,name=latency_ms,json=latencyMs,proto3" json:"latency_ms,omitempty"`
+	ErrorRate float64 `protobuf:"fixed64,7,opt,name=error_rate,json=errorRate,proto3" json:"error_rate,omitempty"`
+	LastError string  `protobuf:"bytes,8,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	CheckedAt *uint32 `protobuf:"varint,9,opt,name=checked_at,json=checkedAt,proto3,oneof" json:"checked_at,omitempty"`
+}
+
+func (x *EvmRpcEndpoint) Reset() {
+	*x = EvmRpcEndpoint{}
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EvmRpcEndpoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EvmRpcEndpoint) ProtoMessage() {}
+
+func (x *EvmRpcEndpoint) ProtoReflect() protoreflect.Message {
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EvmRpcEndpoint.ProtoReflect.Descriptor instead.
+func (*EvmRpcEndpoint) Descriptor() ([]byte, []int) {
+	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *EvmRpcEndpoint) GetId() uint32 {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return 0
+}
+
+func (x *EvmRpcEndpoint) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *EvmRpcEndpoint) GetWeight() uint64 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *EvmRpcEndpoint) GetHealthy() bool {
+	if x != nil {
+		return x.Healthy
+	}
+	return false
+}
+
+func (x *EvmRpcEndpoint) GetHeadBlock() uint64 {
+	if x != nil {
+		return x.HeadBlock
+	}
+	return 0
+}
+
+func (x *EvmRpcEndpoint) GetLatencyMs() uint64 {
+	if x != nil {
+		return x.LatencyMs
+	}
+	return 0
+}
+
+func (x *EvmRpcEndpoint) GetErrorRate() float64 {
+	if x != nil {
+		return x.ErrorRate
+	}
+	return 0
+}
+
+func (x *EvmRpcEndpoint) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *EvmRpcEndpoint) GetCheckedAt() uint32 {
+	if x != nil && x.CheckedAt != nil {
+		return *x.CheckedAt
+	}
+	return 0
+}
+
 type EvmJsonAbi struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -253,7 +469,7 @@ type EvmJsonAbi struct {
 
 func (x *EvmJsonAbi) Reset() {
 	*x = EvmJsonAbi{}
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[2]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -265,7 +481,7 @@ func (x *EvmJsonAbi) String() string {
 func (*EvmJsonAbi) ProtoMessage() {}
 
 func (x *EvmJsonAbi) ProtoReflect() protoreflect.Message {
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[2]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -278,7 +494,7 @@ func (x *EvmJsonAbi) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EvmJsonAbi.ProtoReflect.Descriptor instead.
 func (*EvmJsonAbi) Descriptor() ([]byte, []int) {
-	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{2}
+	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *EvmJsonAbi) GetId() uint32 {
@@ -340,7 +556,7 @@ type EvmLogStore struct {
 
 func (x *EvmLogStore) Reset() {
 	*x = EvmLogStore{}
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[3]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -352,7 +568,7 @@ func (x *EvmLogStore) String() string {
 func (*EvmLogStore) ProtoMessage() {}
 
 func (x *EvmLogStore) ProtoReflect() protoreflect.Message {
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[3]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -365,7 +581,7 @@ func (x *EvmLogStore) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EvmLogStore.ProtoReflect.Descriptor instead.
 func (*EvmLogStore) Descriptor() ([]byte, []int) {
-	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{3}
+	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *EvmLogStore) GetId() uint32 {
@@ -440,7 +656,7 @@ type EvmLogPipeline struct {
 
 func (x *EvmLogPipeline) Reset() {
 	*x = EvmLogPipeline{}
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[4]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -452,7 +668,7 @@ func (x *EvmLogPipeline) String() string {
 func (*EvmLogPipeline) ProtoMessage() {}
 
 func (x *EvmLogPipeline) ProtoReflect() protoreflect.Message {
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[4]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -465,7 +681,7 @@ func (x *EvmLogPipeline) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EvmLogPipeline.ProtoReflect.Descriptor instead.
 func (*EvmLogPipeline) Descriptor() ([]byte, []int) {
-	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{4}
+	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *EvmLogPipeline) GetId() uint32 {
@@ -520,6 +736,8 @@ func (x *EvmLogPipeline) GetDeletedAt() uint32 {
 // FactoryRule is one creation rule of a FACTORY source: match creation_function_name,
 // read the new address from creation_address_log_arg, and create a child of
 // child_type using evm_json_abi_id. A FACTORY child runs child_rules (recursive).
+// A rule whose trigger is TRACE matches the contracts the factory creates in
+// its traces instead; its conditions apply to from, address, value and type.
 // FactoryRuleCondition gates a rule on a decoded event arg (all conditions must
 // hold for the child to be created). operator: eq|neq|gt|gte|lt|lte|contains.
 type FactoryRuleCondition struct {
@@ -534,7 +752,7 @@ type FactoryRuleCondition struct {
 
 func (x *FactoryRuleCondition) Reset() {
 	*x = FactoryRuleCondition{}
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[5]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -546,7 +764,7 @@ func (x *FactoryRuleCondition) String() string {
 func (*FactoryRuleCondition) ProtoMessage() {}
 
 func (x *FactoryRuleCondition) ProtoReflect() protoreflect.Message {
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[5]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -559,7 +777,7 @@ func (x *FactoryRuleCondition) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FactoryRuleCondition.ProtoReflect.Descriptor instead.
 func (*FactoryRuleCondition) Descriptor() ([]byte, []int) {
-	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{5}
+	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *FactoryRuleCondition) GetArg() string {
@@ -595,11 +813,12 @@ type FactoryRule struct {
 	EvmJsonAbiId          uint32                  `protobuf:"varint,5,opt,name=evm_json_abi_id,json=evmJsonAbiId,proto3" json:"evm_json_abi_id,omitempty"`
 	ChildRules            []*FactoryRule          `protobuf:"bytes,6,rep,name=child_rules,json=childRules,proto3" json:"child_rules,omitempty"` // used when child_type == FACTORY
 	Conditions            []*FactoryRuleCondition `protobuf:"bytes,7,rep,name=conditions,proto3" json:"conditions,omitempty"`
+	Trigger               string                  `protobuf:"bytes,8,opt,name=trigger,proto3" json:"trigger,omitempty"` // EVENT (default when empty) | TRACE
 }
 
 func (x *FactoryRule) Reset() {
 	*x = FactoryRule{}
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[6]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -611,7 +830,7 @@ func (x *FactoryRule) String() string {
 func (*FactoryRule) ProtoMessage() {}
 
 func (x *FactoryRule) ProtoReflect() protoreflect.Message {
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[6]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -624,7 +843,7 @@ func (x *FactoryRule) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FactoryRule.ProtoReflect.Descriptor instead.
 func (*FactoryRule) Descriptor() ([]byte, []int) {
-	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{6}
+	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *FactoryRule) GetId() uint32 {
@@ -676,17 +895,42 @@ func (x *FactoryRule) GetConditions() []*FactoryRuleCondition {
 	return nil
 }
 
+func (x *FactoryRule) GetTrigger() string {
+	if x != nil {
+		return x.Trigger
+	}
+	return ""
+}
+
 type EvmLogSource struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id         *uint32 `protobuf:"varint,1,opt,name=id,proto3,oneof" json:"id,omitempty"`
-	Type       string  `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
-	Enabled    bool    `protobuf:"varint,3,opt,name=enabled,proto3" json:"enabled,omitempty"`
-	Status     string  `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
-	StartBlock uint64  `protobuf:"varint,5,opt,name=start_block,json=startBlock,proto3" json:"start_block,omitempty"`
-	SyncBlock  uint64  `protobuf:"varint,6,opt,name=sync_block,json=syncBlock,proto3" json:"sync_block,omitempty"`
+	Id      *uint32 `protobuf:"varint,1,opt,name=id,proto3,oneof" json:"id,omitempty"`
+	Type    string  `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Enabled bool    `protobuf:"varint,3,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// RUNNING, LOOPBACKOFF, STOPPED or COMPLETED (a bounded source that indexed
+	// its end_block).
+	Status     string `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	StartBlock uint64 `protobuf:"varint,5,opt,name=start_block,json=startBlock,proto3" json:"start_block,omitempty"`
+	SyncBlock  uint64 `protobuf:"varint,6,opt,name=sync_block,json=syncBlock,proto3" json:"sync_block,omitempty"`
+	// end_block bounds the source to [start_block, end_block]; 0 indexes
+	// forever.
+	EndBlock uint64 `protobuf:"varint,29,opt,name=end_block,json=endBlock,proto3" json:"end_block,omitempty"`
+	// catch_up_workers is the number of block ranges fetched concurrently while
+	// the source is far behind the head; 0 and 1 index one range at a time.
+	CatchUpWorkers uint32 `protobuf:"varint,30,opt,name=catch_up_workers,json=catchUpWorkers,proto3" json:"catch_up_workers,omitempty"`
+	// The last error the source's indexer failed with, at last_error_at, while
+	// indexing [last_error_from_block, last_error_to_block] (both 0 when it
+	// failed outside a range). consecutive_failures counts the failures since
+	// the last range indexed: while it is not 0 the status is LOOPBACKOFF, the
+	// indexer being restarted after an exponential backoff.
+	LastError           string  `protobuf:"bytes,31,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	LastErrorAt         *uint32 `protobuf:"varint,32,opt,name=last_error_at,json=lastErrorAt,proto3,oneof" json:"last_error_at,omitempty"`
+	LastErrorFromBlock  uint64  `protobuf:"varint,33,opt,name=last_error_from_block,json=lastErrorFromBlock,proto3" json:"last_error_from_block,omitempty"`
+	LastErrorToBlock    uint64  `protobuf:"varint,34,opt,name=last_error_to_block,json=lastErrorToBlock,proto3" json:"last_error_to_block,omitempty"`
+	ConsecutiveFailures uint32  `protobuf:"varint,35,opt,name=consecutive_failures,json=consecutiveFailures,proto3" json:"consecutive_failures,omitempty"`
 	// Nullable fields
 	Address      *string  `protobuf:"bytes,7,opt,name=address,proto3,oneof" json:"address,omitempty"`
 	Topic0       *string  `protobuf:"bytes,8,opt,name=topic0,proto3,oneof" json:"topic0,omitempty"`
@@ -699,14 +943,27 @@ type EvmLogSource struct {
 	EvmLogPipelineId uint32         `protobuf:"varint,13,opt,name=evm_log_pipeline_id,json=evmLogPipelineId,proto3" json:"evm_log_pipeline_id,omitempty"`
 	EvmJsonAbiId     uint32         `protobuf:"varint,14,opt,name=evm_json_abi_id,json=evmJsonAbiId,proto3" json:"evm_json_abi_id,omitempty"`
 	EvmBlockchainId  uint32         `protobuf:"varint,15,opt,name=evm_blockchain_id,json=evmBlockchainId,proto3" json:"evm_blockchain_id,omitempty"`
-	CreatedAt        *uint32        `protobuf:"varint,16,opt,name=created_at,json=createdAt,proto3,oneof" json:"created_at,omitempty"`
-	UpdatedAt        *uint32        `protobuf:"varint,17,opt,name=updated_at,json=updatedAt,proto3,oneof" json:"updated_at,omitempty"`
-	DeletedAt        *uint32        `protobuf:"varint,18,opt,name=deleted_at,json=deletedAt,proto3,oneof" json:"deleted_at,omitempty"`
+	// TOPIC sources match any of topic0s; logs are decoded against whichever of
+	// evm_json_abi_ids declares their event. topic0 and evm_json_abi_id hold the
+	// first value of each; on writes, a single value may be set there instead.
+	Topic0S       []string `protobuf:"bytes,24,rep,name=topic0s,proto3" json:"topic0s,omitempty"`
+	EvmJsonAbiIds []uint32 `protobuf:"varint,25,rep,packed,name=evm_json_abi_ids,json=evmJsonAbiIds,proto3" json:"evm_json_abi_ids,omitempty"`
+	// The last re-decode job of the source, if any.
+	Redecode *EvmRedecodeProgress `protobuf:"bytes,26,opt,name=redecode,proto3,oneof" json:"redecode,omitempty"`
+	// TRANSACTIONS sources index the transactions from or to any of addresses,
+	// address holding the first one (on writes, a single value may be set there
+	// instead), optionally only the calls of function_selector (0x + 4 bytes).
+	// TRACES sources index the call frames from or to any of addresses.
+	Addresses        []string `protobuf:"bytes,27,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	FunctionSelector string   `protobuf:"bytes,28,opt,name=function_selector,json=functionSelector,proto3" json:"function_selector,omitempty"`
+	CreatedAt        *uint32  `protobuf:"varint,16,opt,name=created_at,json=createdAt,proto3,oneof" json:"created_at,omitempty"`
+	UpdatedAt        *uint32  `protobuf:"varint,17,opt,name=updated_at,json=updatedAt,proto3,oneof" json:"updated_at,omitempty"`
+	DeletedAt        *uint32  `protobuf:"varint,18,opt,name=deleted_at,json=deletedAt,proto3,oneof" json:"deleted_at,omitempty"`
 }
 
 func (x *EvmLogSource) Reset() {
 	*x = EvmLogSource{}
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[7]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -718,7 +975,7 @@ func (x *EvmLogSource) String() string {
 func (*EvmLogSource) ProtoMessage() {}
 
 func (x *EvmLogSource) ProtoReflect() protoreflect.Message {
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[7]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -731,7 +988,7 @@ func (x *EvmLogSource) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EvmLogSource.ProtoReflect.Descriptor instead.
 func (*EvmLogSource) Descriptor() ([]byte, []int) {
-	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{7}
+	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *EvmLogSource) GetId() uint32 {
@@ -776,6 +1033,55 @@ func (x *EvmLogSource) GetSyncBlock() uint64 {
 	return 0
 }
 
+func (x *EvmLogSource) GetEndBlock() uint64 {
+	if x != nil {
+		return x.EndBlock
+	}
+	return 0
+}
+
+func (x *EvmLogSource) GetCatchUpWorkers() uint32 {
+	if x != nil {
+		return x.CatchUpWorkers
+	}
+	return 0
+}
+
+func (x *EvmLogSource) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *EvmLogSource) GetLastErrorAt() uint32 {
+	if x != nil && x.LastErrorAt != nil {
+		return *x.LastErrorAt
+	}
+	return 0
+}
+
+func (x *EvmLogSource) GetLastErrorFromBlock() uint64 {
+	if x != nil {
+		return x.LastErrorFromBlock
+	}
+	return 0
+}
+
+func (x *EvmLogSource) GetLastErrorToBlock() uint64 {
+	if x != nil {
+		return x.LastErrorToBlock
+	}
+	return 0
+}
+
+func (x *EvmLogSource) GetConsecutiveFailures() uint32 {
+	if x != nil {
+		return x.ConsecutiveFailures
+	}
+	return 0
+}
+
 func (x *EvmLogSource) GetAddress() string {
 	if x != nil && x.Address != nil {
 		return *x.Address
@@ -832,6 +1138,41 @@ func (x *EvmLogSource) GetEvmBlockchainId() uint32 {
 	return 0
 }
 
+func (x *EvmLogSource) GetTopic0S() []string {
+	if x != nil {
+		return x.Topic0S
+	}
+	return nil
+}
+
+func (x *EvmLogSource) GetEvmJsonAbiIds() []uint32 {
+	if x != nil {
+		return x.EvmJsonAbiIds
+	}
+	return nil
+}
+
+func (x *EvmLogSource) GetRedecode() *EvmRedecodeProgress {
+	if x != nil {
+		return x.Redecode
+	}
+	return nil
+}
+
+func (x *EvmLogSource) GetAddresses() []string {
+	if x != nil {
+		return x.Addresses
+	}
+	return nil
+}
+
+func (x *EvmLogSource) GetFunctionSelector() string {
+	if x != nil {
+		return x.FunctionSelector
+	}
+	return ""
+}
+
 func (x *EvmLogSource) GetCreatedAt() uint32 {
 	if x != nil && x.CreatedAt != nil {
 		return *x.CreatedAt
@@ -853,32 +1194,39 @@ func (x *EvmLogSource) GetDeletedAt() uint32 {
 	return 0
 }
 
-type EvmMetadata struct {
+// EvmRedecodeProgress is the progress of a source's re-decode job: its
+// stored logs of [from_block, to_block] are decoded again with the current
+// ABIs, next_block being the first block not rewritten yet and logs the
+// number of logs rewritten so far. status is RUNNING, DONE or FAILED, with
+// error set on FAILED.
+type EvmRedecodeProgress struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ContractName *string           `protobuf:"bytes,1,opt,name=contract_name,json=contractName,proto3,oneof" json:"contract_name,omitempty"`
-	EventName    *string           `protobuf:"bytes,2,opt,name=event_name,json=eventName,proto3,oneof" json:"event_name,omitempty"`
-	FunctionName *string           `protobuf:"bytes,3,opt,name=function_name,json=functionName,proto3,oneof" json:"function_name,omitempty"`
-	Data         map[string]string `protobuf:"bytes,4,rep,name=data,proto3" json:"data,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Status    string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	FromBlock uint64 `protobuf:"varint,2,opt,name=from_block,json=fromBlock,proto3" json:"from_block,omitempty"`
+	NextBlock uint64 `protobuf:"varint,3,opt,name=next_block,json=nextBlock,proto3" json:"next_block,omitempty"`
+	ToBlock   uint64 `protobuf:"varint,4,opt,name=to_block,json=toBlock,proto3" json:"to_block,omitempty"`
+	Logs      uint64 `protobuf:"varint,5,opt,name=logs,proto3" json:"logs,omitempty"`
+	Error     string `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`
 }
 
-func (x *EvmMetadata) Reset() {
-	*x = EvmMetadata{}
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[8]
+func (x *EvmRedecodeProgress) Reset() {
+	*x = EvmRedecodeProgress{}
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *EvmMetadata) String() string {
+func (x *EvmRedecodeProgress) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EvmMetadata) ProtoMessage() {}
+func (*EvmRedecodeProgress) ProtoMessage() {}
 
-func (x *EvmMetadata) ProtoReflect() protoreflect.Message {
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[8]
+func (x *EvmRedecodeProgress) ProtoReflect() protoreflect.Message {
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -889,14 +1237,97 @@ func (x *EvmMetadata) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EvmMetadata.ProtoReflect.Descriptor instead.
-func (*EvmMetadata) Descriptor() ([]byte, []int) {
-	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use EvmRedecodeProgress.ProtoReflect.Descriptor instead.
+func (*EvmRedecodeProgress) Descriptor() ([]byte, []int) {
+	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *EvmMetadata) GetContractName() string {
-	if x != nil && x.ContractName != nil {
-		return *x.ContractName
+func (x *EvmRedecodeProgress) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *EvmRedecodeProgress) GetFromBlock() uint64 {
+	if x != nil {
+		return x.FromBlock
+	}
+	return 0
+}
+
+func (x *EvmRedecodeProgress) GetNextBlock() uint64 {
+	if x != nil {
+		return x.NextBlock
+	}
+	return 0
+}
+
+func (x *EvmRedecodeProgress) GetToBlock() uint64 {
+	if x != nil {
+		return x.ToBlock
+	}
+	return 0
+}
+
+func (x *EvmRedecodeProgress) GetLogs() uint64 {
+	if x != nil {
+		return x.Logs
+	}
+	return 0
+}
+
+func (x *EvmRedecodeProgress) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type EvmMetadata struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ContractName *string           `protobuf:"bytes,1,opt,name=contract_name,json=contractName,proto3,oneof" json:"contract_name,omitempty"`
+	EventName    *string           `protobuf:"bytes,2,opt,name=event_name,json=eventName,proto3,oneof" json:"event_name,omitempty"`
+	FunctionName *string           `protobuf:"bytes,3,opt,name=function_name,json=functionName,proto3,oneof" json:"function_name,omitempty"`
+	Data         map[string]string `protobuf:"bytes,4,rep,name=data,proto3" json:"data,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *EvmMetadata) Reset() {
+	*x = EvmMetadata{}
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EvmMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EvmMetadata) ProtoMessage() {}
+
+func (x *EvmMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EvmMetadata.ProtoReflect.Descriptor instead.
+func (*EvmMetadata) Descriptor() ([]byte, []int) {
+	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *EvmMetadata) GetContractName() string {
+	if x != nil && x.ContractName != nil {
+		return *x.ContractName
 	}
 	return ""
 }
@@ -946,7 +1377,7 @@ type EvmLog struct {
 
 func (x *EvmLog) Reset() {
 	*x = EvmLog{}
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[9]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -958,7 +1389,7 @@ func (x *EvmLog) String() string {
 func (*EvmLog) ProtoMessage() {}
 
 func (x *EvmLog) ProtoReflect() protoreflect.Message {
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[9]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -971,7 +1402,7 @@ func (x *EvmLog) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EvmLog.ProtoReflect.Descriptor instead.
 func (*EvmLog) Descriptor() ([]byte, []int) {
-	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{9}
+	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *EvmLog) GetId() string {
@@ -1097,11 +1528,20 @@ type EvmTransaction struct {
 	Hash             string       `protobuf:"bytes,11,opt,name=hash,proto3" json:"hash,omitempty"`
 	Metadata         *EvmMetadata `protobuf:"bytes,12,opt,name=metadata,proto3" json:"metadata,omitempty"`
 	BlockTimestamp   uint64       `protobuf:"varint,13,opt,name=block_timestamp,json=blockTimestamp,proto3" json:"block_timestamp,omitempty"` // unix seconds, from the block header
+	// Execution results, from the transaction receipt.
+	Type                uint64   `protobuf:"varint,14,opt,name=type,proto3" json:"type,omitempty"`     // EIP-2718 transaction type
+	Status              uint64   `protobuf:"varint,15,opt,name=status,proto3" json:"status,omitempty"` // 1 success, 0 reverted
+	GasUsed             uint64   `protobuf:"varint,16,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+	EffectiveGasPrice   string   `protobuf:"bytes,17,opt,name=effective_gas_price,json=effectiveGasPrice,proto3" json:"effective_gas_price,omitempty"` // wei
+	ContractAddress     string   `protobuf:"bytes,18,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`         // set on contract creations
+	BlobGasUsed         uint64   `protobuf:"varint,19,opt,name=blob_gas_used,json=blobGasUsed,proto3" json:"blob_gas_used,omitempty"`
+	BlobGasPrice        string   `protobuf:"bytes,20,opt,name=blob_gas_price,json=blobGasPrice,proto3" json:"blob_gas_price,omitempty"` // wei
+	BlobVersionedHashes []string `protobuf:"bytes,21,rep,name=blob_versioned_hashes,json=blobVersionedHashes,proto3" json:"blob_versioned_hashes,omitempty"`
 }
 
 func (x *EvmTransaction) Reset() {
 	*x = EvmTransaction{}
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[10]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1113,7 +1553,7 @@ func (x *EvmTransaction) String() string {
 func (*EvmTransaction) ProtoMessage() {}
 
 func (x *EvmTransaction) ProtoReflect() protoreflect.Message {
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[10]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1126,7 +1566,7 @@ func (x *EvmTransaction) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EvmTransaction.ProtoReflect.Descriptor instead.
 func (*EvmTransaction) Descriptor() ([]byte, []int) {
-	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{10}
+	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *EvmTransaction) GetId() string {
@@ -1220,84 +1660,100 @@ func (x *EvmTransaction) GetBlockTimestamp() uint64 {
 	return 0
 }
 
-// Pagination
-type Pagination struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Limit  uint32 `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
-	Offset uint32 `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+func (x *EvmTransaction) GetType() uint64 {
+	if x != nil {
+		return x.Type
+	}
+	return 0
 }
 
-func (x *Pagination) Reset() {
-	*x = Pagination{}
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[11]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *EvmTransaction) GetStatus() uint64 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
 }
 
-func (x *Pagination) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *EvmTransaction) GetGasUsed() uint64 {
+	if x != nil {
+		return x.GasUsed
+	}
+	return 0
 }
 
-func (*Pagination) ProtoMessage() {}
-
-func (x *Pagination) ProtoReflect() protoreflect.Message {
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[11]
+func (x *EvmTransaction) GetEffectiveGasPrice() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.EffectiveGasPrice
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use Pagination.ProtoReflect.Descriptor instead.
-func (*Pagination) Descriptor() ([]byte, []int) {
-	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{11}
+func (x *EvmTransaction) GetContractAddress() string {
+	if x != nil {
+		return x.ContractAddress
+	}
+	return ""
 }
 
-func (x *Pagination) GetLimit() uint32 {
+func (x *EvmTransaction) GetBlobGasUsed() uint64 {
 	if x != nil {
-		return x.Limit
+		return x.BlobGasUsed
 	}
 	return 0
 }
 
-func (x *Pagination) GetOffset() uint32 {
+func (x *EvmTransaction) GetBlobGasPrice() string {
 	if x != nil {
-		return x.Offset
+		return x.BlobGasPrice
 	}
-	return 0
+	return ""
 }
 
-// EvmiInstance
-type GetEvmiInstanceRequest struct {
+func (x *EvmTransaction) GetBlobVersionedHashes() []string {
+	if x != nil {
+		return x.BlobVersionedHashes
+	}
+	return nil
+}
+
+// EvmBlock is a block header indexed by a BLOCKS source.
+type EvmBlock struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id uint32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Id               string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	SourceId         uint32 `protobuf:"varint,2,opt,name=source_id,json=sourceId,proto3" json:"source_id,omitempty"`
+	ChainId          uint64 `protobuf:"varint,3,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	BlockNumber      uint64 `protobuf:"varint,4,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+	BlockTimestamp   uint64 `protobuf:"varint,5,opt,name=block_timestamp,json=blockTimestamp,proto3" json:"block_timestamp,omitempty"` // unix seconds
+	Hash             string `protobuf:"bytes,6,opt,name=hash,proto3" json:"hash,omitempty"`
+	ParentHash       string `protobuf:"bytes,7,opt,name=parent_hash,json=parentHash,proto3" json:"parent_hash,omitempty"`
+	Miner            string `protobuf:"bytes,8,opt,name=miner,proto3" json:"miner,omitempty"` // fee recipient
+	GasUsed          uint64 `protobuf:"varint,9,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+	GasLimit         uint64 `protobuf:"varint,10,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
+	BaseFeePerGas    string `protobuf:"bytes,11,opt,name=base_fee_per_gas,json=baseFeePerGas,proto3" json:"base_fee_per_gas,omitempty"` // wei, empty before London
+	BlobGasUsed      uint64 `protobuf:"varint,12,opt,name=blob_gas_used,json=blobGasUsed,proto3" json:"blob_gas_used,omitempty"`
+	ExcessBlobGas    uint64 `protobuf:"varint,13,opt,name=excess_blob_gas,json=excessBlobGas,proto3" json:"excess_blob_gas,omitempty"`
+	TransactionCount uint64 `protobuf:"varint,14,opt,name=transaction_count,json=transactionCount,proto3" json:"transaction_count,omitempty"`
+	LogsBloom        string `protobuf:"bytes,15,opt,name=logs_bloom,json=logsBloom,proto3" json:"logs_bloom,omitempty"`
 }
 
-func (x *GetEvmiInstanceRequest) Reset() {
-	*x = GetEvmiInstanceRequest{}
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[12]
+func (x *EvmBlock) Reset() {
+	*x = EvmBlock{}
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetEvmiInstanceRequest) String() string {
+func (x *EvmBlock) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetEvmiInstanceRequest) ProtoMessage() {}
+func (*EvmBlock) ProtoMessage() {}
 
-func (x *GetEvmiInstanceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[12]
+func (x *EvmBlock) ProtoReflect() protoreflect.Message {
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1308,85 +1764,155 @@ func (x *GetEvmiInstanceRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetEvmiInstanceRequest.ProtoReflect.Descriptor instead.
-func (*GetEvmiInstanceRequest) Descriptor() ([]byte, []int) {
-	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use EvmBlock.ProtoReflect.Descriptor instead.
+func (*EvmBlock) Descriptor() ([]byte, []int) {
+	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *GetEvmiInstanceRequest) GetId() uint32 {
+func (x *EvmBlock) GetId() string {
 	if x != nil {
 		return x.Id
 	}
+	return ""
+}
+
+func (x *EvmBlock) GetSourceId() uint32 {
+	if x != nil {
+		return x.SourceId
+	}
 	return 0
 }
 
-type GetEvmiInstanceResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *EvmBlock) GetChainId() uint64 {
+	if x != nil {
+		return x.ChainId
+	}
+	return 0
+}
 
-	Instance *EvmiInstance `protobuf:"bytes,1,opt,name=instance,proto3" json:"instance,omitempty"`
+func (x *EvmBlock) GetBlockNumber() uint64 {
+	if x != nil {
+		return x.BlockNumber
+	}
+	return 0
 }
 
-func (x *GetEvmiInstanceResponse) Reset() {
-	*x = GetEvmiInstanceResponse{}
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[13]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *EvmBlock) GetBlockTimestamp() uint64 {
+	if x != nil {
+		return x.BlockTimestamp
+	}
+	return 0
 }
 
-func (x *GetEvmiInstanceResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *EvmBlock) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
 }
 
-func (*GetEvmiInstanceResponse) ProtoMessage() {}
+func (x *EvmBlock) GetParentHash() string {
+	if x != nil {
+		return x.ParentHash
+	}
+	return ""
+}
 
-func (x *GetEvmiInstanceResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[13]
+func (x *EvmBlock) GetMiner() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Miner
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use GetEvmiInstanceResponse.ProtoReflect.Descriptor instead.
-func (*GetEvmiInstanceResponse) Descriptor() ([]byte, []int) {
-	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{13}
+func (x *EvmBlock) GetGasUsed() uint64 {
+	if x != nil {
+		return x.GasUsed
+	}
+	return 0
 }
 
-func (x *GetEvmiInstanceResponse) GetInstance() *EvmiInstance {
+func (x *EvmBlock) GetGasLimit() uint64 {
 	if x != nil {
-		return x.Instance
+		return x.GasLimit
 	}
-	return nil
+	return 0
 }
 
-type ListEvmiInstancesRequest struct {
+func (x *EvmBlock) GetBaseFeePerGas() string {
+	if x != nil {
+		return x.BaseFeePerGas
+	}
+	return ""
+}
+
+func (x *EvmBlock) GetBlobGasUsed() uint64 {
+	if x != nil {
+		return x.BlobGasUsed
+	}
+	return 0
+}
+
+func (x *EvmBlock) GetExcessBlobGas() uint64 {
+	if x != nil {
+		return x.ExcessBlobGas
+	}
+	return 0
+}
+
+func (x *EvmBlock) GetTransactionCount() uint64 {
+	if x != nil {
+		return x.TransactionCount
+	}
+	return 0
+}
+
+func (x *EvmBlock) GetLogsBloom() string {
+	if x != nil {
+		return x.LogsBloom
+	}
+	return ""
+}
+
+// EvmTrace is a call frame indexed by a TRACES source.
+type EvmTrace struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Pagination *Pagination `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Id               string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	SourceId         uint32 `protobuf:"varint,2,opt,name=source_id,json=sourceId,proto3" json:"source_id,omitempty"`
+	ChainId          uint64 `protobuf:"varint,3,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	BlockNumber      uint64 `protobuf:"varint,4,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+	BlockTimestamp   uint64 `protobuf:"varint,5,opt,name=block_timestamp,json=blockTimestamp,proto3" json:"block_timestamp,omitempty"` // unix seconds
+	TransactionHash  string `protobuf:"bytes,6,opt,name=transaction_hash,json=transactionHash,proto3" json:"transaction_hash,omitempty"`
+	TransactionIndex uint64 `protobuf:"varint,7,opt,name=transaction_index,json=transactionIndex,proto3" json:"transaction_index,omitempty"`
+	TraceAddress     string `protobuf:"bytes,8,opt,name=trace_address,json=traceAddress,proto3" json:"trace_address,omitempty"` // e.g. "0.1", empty for the top call
+	Type             string `protobuf:"bytes,9,opt,name=type,proto3" json:"type,omitempty"`                                     // CALL | STATICCALL | DELEGATECALL | CALLCODE | CREATE | CREATE2 | SELFDESTRUCT
+	From             string `protobuf:"bytes,10,opt,name=from,proto3" json:"from,omitempty"`
+	To               string `protobuf:"bytes,11,opt,name=to,proto3" json:"to,omitempty"`       // the created contract of a creation
+	Value            string `protobuf:"bytes,12,opt,name=value,proto3" json:"value,omitempty"` // wei
+	Input            string `protobuf:"bytes,13,opt,name=input,proto3" json:"input,omitempty"`
+	Output           string `protobuf:"bytes,14,opt,name=output,proto3" json:"output,omitempty"`
+	Gas              uint64 `protobuf:"varint,15,opt,name=gas,proto3" json:"gas,omitempty"`
+	GasUsed          uint64 `protobuf:"varint,16,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+	Error            string `protobuf:"bytes,17,opt,name=error,proto3" json:"error,omitempty"` // empty when the frame succeeded
 }
 
-func (x *ListEvmiInstancesRequest) Reset() {
-	*x = ListEvmiInstancesRequest{}
+func (x *EvmTrace) Reset() {
+	*x = EvmTrace{}
 	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListEvmiInstancesRequest) String() string {
+func (x *EvmTrace) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListEvmiInstancesRequest) ProtoMessage() {}
+func (*EvmTrace) ProtoMessage() {}
 
-func (x *ListEvmiInstancesRequest) ProtoReflect() protoreflect.Message {
+func (x *EvmTrace) ProtoReflect() protoreflect.Message {
 	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1398,40 +1924,154 @@ func (x *ListEvmiInstancesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListEvmiInstancesRequest.ProtoReflect.Descriptor instead.
-func (*ListEvmiInstancesRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use EvmTrace.ProtoReflect.Descriptor instead.
+func (*EvmTrace) Descriptor() ([]byte, []int) {
 	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *ListEvmiInstancesRequest) GetPagination() *Pagination {
+func (x *EvmTrace) GetId() string {
 	if x != nil {
-		return x.Pagination
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-type ListEvmiInstancesResponse struct {
+func (x *EvmTrace) GetSourceId() uint32 {
+	if x != nil {
+		return x.SourceId
+	}
+	return 0
+}
+
+func (x *EvmTrace) GetChainId() uint64 {
+	if x != nil {
+		return x.ChainId
+	}
+	return 0
+}
+
+func (x *EvmTrace) GetBlockNumber() uint64 {
+	if x != nil {
+		return x.BlockNumber
+	}
+	return 0
+}
+
+func (x *EvmTrace) GetBlockTimestamp() uint64 {
+	if x != nil {
+		return x.BlockTimestamp
+	}
+	return 0
+}
+
+func (x *EvmTrace) GetTransactionHash() string {
+	if x != nil {
+		return x.TransactionHash
+	}
+	return ""
+}
+
+func (x *EvmTrace) GetTransactionIndex() uint64 {
+	if x != nil {
+		return x.TransactionIndex
+	}
+	return 0
+}
+
+func (x *EvmTrace) GetTraceAddress() string {
+	if x != nil {
+		return x.TraceAddress
+	}
+	return ""
+}
+
+func (x *EvmTrace) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *EvmTrace) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *EvmTrace) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *EvmTrace) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *EvmTrace) GetInput() string {
+	if x != nil {
+		return x.Input
+	}
+	return ""
+}
+
+func (x *EvmTrace) GetOutput() string {
+	if x != nil {
+		return x.Output
+	}
+	return ""
+}
+
+func (x *EvmTrace) GetGas() uint64 {
+	if x != nil {
+		return x.Gas
+	}
+	return 0
+}
+
+func (x *EvmTrace) GetGasUsed() uint64 {
+	if x != nil {
+		return x.GasUsed
+	}
+	return 0
+}
+
+func (x *EvmTrace) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// Pagination
+type Pagination struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Instances []*EvmiInstance `protobuf:"bytes,1,rep,name=instances,proto3" json:"instances,omitempty"`
+	Limit  uint32 `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset uint32 `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
 }
 
-func (x *ListEvmiInstancesResponse) Reset() {
-	*x = ListEvmiInstancesResponse{}
+func (x *Pagination) Reset() {
+	*x = Pagination{}
 	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListEvmiInstancesResponse) String() string {
+func (x *Pagination) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListEvmiInstancesResponse) ProtoMessage() {}
+func (*Pagination) ProtoMessage() {}
 
-func (x *ListEvmiInstancesResponse) ProtoReflect() protoreflect.Message {
+func (x *Pagination) ProtoReflect() protoreflect.Message {
 	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1443,41 +2083,48 @@ func (x *ListEvmiInstancesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListEvmiInstancesResponse.ProtoReflect.Descriptor instead.
-func (*ListEvmiInstancesResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use Pagination.ProtoReflect.Descriptor instead.
+func (*Pagination) Descriptor() ([]byte, []int) {
 	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *ListEvmiInstancesResponse) GetInstances() []*EvmiInstance {
+func (x *Pagination) GetLimit() uint32 {
 	if x != nil {
-		return x.Instances
+		return x.Limit
 	}
-	return nil
+	return 0
 }
 
-// EvmBlockchain
-type CreateEvmBlockchainRequest struct {
+func (x *Pagination) GetOffset() uint32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+// EvmiInstance
+type GetEvmiInstanceRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Blockchain *EvmBlockchain `protobuf:"bytes,1,opt,name=blockchain,proto3" json:"blockchain,omitempty"`
+	Id uint32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *CreateEvmBlockchainRequest) Reset() {
-	*x = CreateEvmBlockchainRequest{}
+func (x *GetEvmiInstanceRequest) Reset() {
+	*x = GetEvmiInstanceRequest{}
 	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateEvmBlockchainRequest) String() string {
+func (x *GetEvmiInstanceRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateEvmBlockchainRequest) ProtoMessage() {}
+func (*GetEvmiInstanceRequest) ProtoMessage() {}
 
-func (x *CreateEvmBlockchainRequest) ProtoReflect() protoreflect.Message {
+func (x *GetEvmiInstanceRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1489,19 +2136,1160 @@ func (x *CreateEvmBlockchainRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateEvmBlockchainRequest.ProtoReflect.Descriptor instead.
-func (*CreateEvmBlockchainRequest) Descriptor() ([]byte, []int) {
-	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use GetEvmiInstanceRequest.ProtoReflect.Descriptor instead.
+func (*GetEvmiInstanceRequest) Descriptor() ([]byte, []int) {
+	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GetEvmiInstanceRequest) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type GetEvmiInstanceResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Instance *EvmiInstance `protobuf:"bytes,1,opt,name=instance,proto3" json:"instance,omitempty"`
+}
+
+func (x *GetEvmiInstanceResponse) Reset() {
+	*x = GetEvmiInstanceResponse{}
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEvmiInstanceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEvmiInstanceResponse) ProtoMessage() {}
+
+func (x *GetEvmiInstanceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEvmiInstanceResponse.ProtoReflect.Descriptor instead.
+func (*GetEvmiInstanceResponse) Descriptor() ([]byte, []int) {
+	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetEvmiInstanceResponse) GetInstance() *EvmiInstance {
+	if x != nil {
+		return x.Instance
+	}
+	return nil
+}
+
+type ListEvmiInstancesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pagination *Pagination `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (x *ListEvmiInstancesRequest) Reset() {
+	*x = ListEvmiInstancesRequest{}
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListEvmiInstancesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEvmiInstancesRequest) ProtoMessage() {}
+
+func (x *ListEvmiInstancesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEvmiInstancesRequest.ProtoReflect.Descriptor instead.
+func (*ListEvmiInstancesRequest) Descriptor() ([]byte, []int) {
+	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ListEvmiInstancesRequest) GetPagination() *Pagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type ListEvmiInstancesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Instances []*EvmiInstance `protobuf:"bytes,1,rep,name=instances,proto3" json:"instances,omitempty"`
+}
+
+func (x *ListEvmiInstancesResponse) Reset() {
+	*x = ListEvmiInstancesResponse{}
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListEvmiInstancesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEvmiInstancesResponse) ProtoMessage() {}
+
+func (x *ListEvmiInstancesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEvmiInstancesResponse.ProtoReflect.Descriptor instead.
+func (*ListEvmiInstancesResponse) Descriptor() ([]byte, []int) {
+	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ListEvmiInstancesResponse) GetInstances() []*EvmiInstance {
+	if x != nil {
+		return x.Instances
+	}
+	return nil
+}
+
+// EvmBlockchain
+type CreateEvmBlockchainRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Blockchain *EvmBlockchain `protobuf:"bytes,1,opt,name=blockchain,proto3" json:"blockchain,omitempty"`
+}
+
+func (x *CreateEvmBlockchainRequest) Reset() {
+	*x = CreateEvmBlockchainRequest{}
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateEvmBlockchainRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateEvmBlockchainRequest) ProtoMessage() {}
+
+func (x *CreateEvmBlockchainRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateEvmBlockchainRequest.ProtoReflect.Descriptor instead.
+func (*CreateEvmBlockchainRequest) Descriptor() ([]byte, []int) {
+	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *CreateEvmBlockchainRequest) GetBlockchain() *EvmBlockchain {
+	if x != nil {
+		return x.Blockchain
+	}
+	return nil
+}
+
+type CreateEvmBlockchainResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id uint32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *CreateEvmBlockchainResponse) Reset() {
+	*x = CreateEvmBlockchainResponse{}
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateEvmBlockchainResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateEvmBlockchainResponse) ProtoMessage() {}
+
+func (x *CreateEvmBlockchainResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateEvmBlockchainResponse.ProtoReflect.Descriptor instead.
+func (*CreateEvmBlockchainResponse) Descriptor() ([]byte, []int) {
+	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *CreateEvmBlockchainResponse) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type GetEvmBlockchainRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id uint32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *GetEvmBlockchainRequest) Reset() {
+	*x = GetEvmBlockchainRequest{}
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEvmBlockchainRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEvmBlockchainRequest) ProtoMessage() {}
+
+func (x *GetEvmBlockchainRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEvmBlockchainRequest.ProtoReflect.Descriptor instead.
+func (*GetEvmBlockchainRequest) Descriptor() ([]byte, []int) {
+	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GetEvmBlockchainRequest) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type GetEvmBlockchainResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Blockchain *EvmBlockchain `protobuf:"bytes,1,opt,name=blockchain,proto3" json:"blockchain,omitempty"`
+}
+
+func (x *GetEvmBlockchainResponse) Reset() {
+	*x = GetEvmBlockchainResponse{}
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEvmBlockchainResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEvmBlockchainResponse) ProtoMessage() {}
+
+func (x *GetEvmBlockchainResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEvmBlockchainResponse.ProtoReflect.Descriptor instead.
+func (*GetEvmBlockchainResponse) Descriptor() ([]byte, []int) {
+	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *GetEvmBlockchainResponse) GetBlockchain() *EvmBlockchain {
+	if x != nil {
+		return x.Blockchain
+	}
+	return nil
+}
+
+type UpdateEvmBlockchainRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Blockchain *EvmBlockchain `protobuf:"bytes,1,opt,name=blockchain,proto3" json:"blockchain,omitempty"`
+}
+
+func (x *UpdateEvmBlockchainRequest) Reset() {
+	*x = UpdateEvmBlockchainRequest{}
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateEvmBlockchainRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateEvmBlockchainRequest) ProtoMessage() {}
+
+func (x *UpdateEvmBlockchainRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateEvmBlockchainRequest.ProtoReflect.Descriptor instead.
+func (*UpdateEvmBlockchainRequest) Descriptor() ([]byte, []int) {
+	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *UpdateEvmBlockchainRequest) GetBlockchain() *EvmBlockchain {
+	if x != nil {
+		return x.Blockchain
+	}
+	return nil
+}
+
+type UpdateEvmBlockchainResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *UpdateEvmBlockchainResponse) Reset() {
+	*x = UpdateEvmBlockchainResponse{}
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateEvmBlockchainResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateEvmBlockchainResponse) ProtoMessage() {}
+
+func (x *UpdateEvmBlockchainResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateEvmBlockchainResponse.ProtoReflect.Descriptor instead.
+func (*UpdateEvmBlockchainResponse) Descriptor() ([]byte, []int) {
+	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{25}
+}
+
+type ListEvmBlockchainsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pagination *Pagination `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (x *ListEvmBlockchainsRequest) Reset() {
+	*x = ListEvmBlockchainsRequest{}
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListEvmBlockchainsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEvmBlockchainsRequest) ProtoMessage() {}
+
+func (x *ListEvmBlockchainsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEvmBlockchainsRequest.ProtoReflect.Descriptor instead.
+func (*ListEvmBlockchainsRequest) Descriptor() ([]byte, []int) {
+	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ListEvmBlockchainsRequest) GetPagination() *Pagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type ListEvmBlockchainsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Blockchains []*EvmBlockchain `protobuf:"bytes,1,rep,name=blockchains,proto3" json:"blockchains,omitempty"`
+}
+
+func (x *ListEvmBlockchainsResponse) Reset() {
+	*x = ListEvmBlockchainsResponse{}
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListEvmBlockchainsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEvmBlockchainsResponse) ProtoMessage() {}
+
+func (x *ListEvmBlockchainsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEvmBlockchainsResponse.ProtoReflect.Descriptor instead.
+func (*ListEvmBlockchainsResponse) Descriptor() ([]byte, []int) {
+	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ListEvmBlockchainsResponse) GetBlockchains() []*EvmBlockchain {
+	if x != nil {
+		return x.Blockchains
+	}
+	return nil
+}
+
+type DeleteEvmBlockchainRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id uint32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *DeleteEvmBlockchainRequest) Reset() {
+	*x = DeleteEvmBlockchainRequest{}
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteEvmBlockchainRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteEvmBlockchainRequest) ProtoMessage() {}
+
+func (x *DeleteEvmBlockchainRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteEvmBlockchainRequest.ProtoReflect.Descriptor instead.
+func (*DeleteEvmBlockchainRequest) Descriptor() ([]byte, []int) {
+	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *DeleteEvmBlockchainRequest) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DeleteEvmBlockchainResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DeleteEvmBlockchainResponse) Reset() {
+	*x = DeleteEvmBlockchainResponse{}
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteEvmBlockchainResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteEvmBlockchainResponse) ProtoMessage() {}
+
+func (x *DeleteEvmBlockchainResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteEvmBlockchainResponse.ProtoReflect.Descriptor instead.
+func (*DeleteEvmBlockchainResponse) Descriptor() ([]byte, []int) {
+	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{29}
+}
+
+// EvmJsonAbi
+type CreateEvmJsonAbiRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Abi *EvmJsonAbi `protobuf:"bytes,1,opt,name=abi,proto3" json:"abi,omitempty"`
+}
+
+func (x *CreateEvmJsonAbiRequest) Reset() {
+	*x = CreateEvmJsonAbiRequest{}
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateEvmJsonAbiRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateEvmJsonAbiRequest) ProtoMessage() {}
+
+func (x *CreateEvmJsonAbiRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateEvmJsonAbiRequest.ProtoReflect.Descriptor instead.
+func (*CreateEvmJsonAbiRequest) Descriptor() ([]byte, []int) {
+	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *CreateEvmJsonAbiRequest) GetAbi() *EvmJsonAbi {
+	if x != nil {
+		return x.Abi
+	}
+	return nil
+}
+
+type CreateEvmJsonAbiResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id uint32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *CreateEvmJsonAbiResponse) Reset() {
+	*x = CreateEvmJsonAbiResponse{}
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateEvmJsonAbiResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateEvmJsonAbiResponse) ProtoMessage() {}
+
+func (x *CreateEvmJsonAbiResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateEvmJsonAbiResponse.ProtoReflect.Descriptor instead.
+func (*CreateEvmJsonAbiResponse) Descriptor() ([]byte, []int) {
+	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *CreateEvmJsonAbiResponse) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type GetEvmJsonAbiRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id uint32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *GetEvmJsonAbiRequest) Reset() {
+	*x = GetEvmJsonAbiRequest{}
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEvmJsonAbiRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEvmJsonAbiRequest) ProtoMessage() {}
+
+func (x *GetEvmJsonAbiRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEvmJsonAbiRequest.ProtoReflect.Descriptor instead.
+func (*GetEvmJsonAbiRequest) Descriptor() ([]byte, []int) {
+	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *GetEvmJsonAbiRequest) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type GetEvmJsonAbiResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Abi *EvmJsonAbi `protobuf:"bytes,1,opt,name=abi,proto3" json:"abi,omitempty"`
+}
+
+func (x *GetEvmJsonAbiResponse) Reset() {
+	*x = GetEvmJsonAbiResponse{}
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEvmJsonAbiResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEvmJsonAbiResponse) ProtoMessage() {}
+
+func (x *GetEvmJsonAbiResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEvmJsonAbiResponse.ProtoReflect.Descriptor instead.
+func (*GetEvmJsonAbiResponse) Descriptor() ([]byte, []int) {
+	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *GetEvmJsonAbiResponse) GetAbi() *EvmJsonAbi {
+	if x != nil {
+		return x.Abi
+	}
+	return nil
+}
+
+type UpdateEvmJsonAbiRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Abi *EvmJsonAbi `protobuf:"bytes,1,opt,name=abi,proto3" json:"abi,omitempty"`
+}
+
+func (x *UpdateEvmJsonAbiRequest) Reset() {
+	*x = UpdateEvmJsonAbiRequest{}
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateEvmJsonAbiRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateEvmJsonAbiRequest) ProtoMessage() {}
+
+func (x *UpdateEvmJsonAbiRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateEvmJsonAbiRequest.ProtoReflect.Descriptor instead.
+func (*UpdateEvmJsonAbiRequest) Descriptor() ([]byte, []int) {
+	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *UpdateEvmJsonAbiRequest) GetAbi() *EvmJsonAbi {
+	if x != nil {
+		return x.Abi
+	}
+	return nil
+}
+
+type UpdateEvmJsonAbiResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *UpdateEvmJsonAbiResponse) Reset() {
+	*x = UpdateEvmJsonAbiResponse{}
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateEvmJsonAbiResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateEvmJsonAbiResponse) ProtoMessage() {}
+
+func (x *UpdateEvmJsonAbiResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateEvmJsonAbiResponse.ProtoReflect.Descriptor instead.
+func (*UpdateEvmJsonAbiResponse) Descriptor() ([]byte, []int) {
+	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{35}
+}
+
+type ListEvmJsonAbisRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pagination *Pagination `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (x *ListEvmJsonAbisRequest) Reset() {
+	*x = ListEvmJsonAbisRequest{}
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListEvmJsonAbisRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEvmJsonAbisRequest) ProtoMessage() {}
+
+func (x *ListEvmJsonAbisRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEvmJsonAbisRequest.ProtoReflect.Descriptor instead.
+func (*ListEvmJsonAbisRequest) Descriptor() ([]byte, []int) {
+	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ListEvmJsonAbisRequest) GetPagination() *Pagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type ListEvmJsonAbisResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Abis []*EvmJsonAbi `protobuf:"bytes,1,rep,name=abis,proto3" json:"abis,omitempty"`
+}
+
+func (x *ListEvmJsonAbisResponse) Reset() {
+	*x = ListEvmJsonAbisResponse{}
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListEvmJsonAbisResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEvmJsonAbisResponse) ProtoMessage() {}
+
+func (x *ListEvmJsonAbisResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEvmJsonAbisResponse.ProtoReflect.Descriptor instead.
+func (*ListEvmJsonAbisResponse) Descriptor() ([]byte, []int) {
+	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ListEvmJsonAbisResponse) GetAbis() []*EvmJsonAbi {
+	if x != nil {
+		return x.Abis
+	}
+	return nil
+}
+
+type DeleteEvmJsonAbiRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id uint32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *DeleteEvmJsonAbiRequest) Reset() {
+	*x = DeleteEvmJsonAbiRequest{}
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteEvmJsonAbiRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteEvmJsonAbiRequest) ProtoMessage() {}
+
+func (x *DeleteEvmJsonAbiRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteEvmJsonAbiRequest.ProtoReflect.Descriptor instead.
+func (*DeleteEvmJsonAbiRequest) Descriptor() ([]byte, []int) {
+	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *DeleteEvmJsonAbiRequest) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DeleteEvmJsonAbiResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DeleteEvmJsonAbiResponse) Reset() {
+	*x = DeleteEvmJsonAbiResponse{}
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteEvmJsonAbiResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteEvmJsonAbiResponse) ProtoMessage() {}
+
+func (x *DeleteEvmJsonAbiResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteEvmJsonAbiResponse.ProtoReflect.Descriptor instead.
+func (*DeleteEvmJsonAbiResponse) Descriptor() ([]byte, []int) {
+	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{39}
+}
+
+// Schema of the events and functions of an ABI, as attached to decoded logs
+// and transactions.
+type EvmArgSchema struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name       string          `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"` // unnamed arguments are arg0, arg1, ...
+	Type       string          `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"` // canonical Solidity type, e.g. uint256, address[], (address,uint24)
+	Indexed    bool            `protobuf:"varint,3,opt,name=indexed,proto3" json:"indexed,omitempty"`
+	Components []*EvmArgSchema `protobuf:"bytes,4,rep,name=components,proto3" json:"components,omitempty"` // tuple (or tuple array) components
+}
+
+func (x *EvmArgSchema) Reset() {
+	*x = EvmArgSchema{}
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EvmArgSchema) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EvmArgSchema) ProtoMessage() {}
+
+func (x *EvmArgSchema) ProtoReflect() protoreflect.Message {
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EvmArgSchema.ProtoReflect.Descriptor instead.
+func (*EvmArgSchema) Descriptor() ([]byte, []int) {
+	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *EvmArgSchema) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *EvmArgSchema) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *EvmArgSchema) GetIndexed() bool {
+	if x != nil {
+		return x.Indexed
+	}
+	return false
+}
+
+func (x *EvmArgSchema) GetComponents() []*EvmArgSchema {
+	if x != nil {
+		return x.Components
+	}
+	return nil
+}
+
+type EvmEntrySchema struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name      string          `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Signature string          `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	Selector  string          `protobuf:"bytes,3,opt,name=selector,proto3" json:"selector,omitempty"` // event topic0 or 4-byte function selector
+	Args      []*EvmArgSchema `protobuf:"bytes,4,rep,name=args,proto3" json:"args,omitempty"`
+}
+
+func (x *EvmEntrySchema) Reset() {
+	*x = EvmEntrySchema{}
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EvmEntrySchema) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EvmEntrySchema) ProtoMessage() {}
+
+func (x *EvmEntrySchema) ProtoReflect() protoreflect.Message {
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EvmEntrySchema.ProtoReflect.Descriptor instead.
+func (*EvmEntrySchema) Descriptor() ([]byte, []int) {
+	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *EvmEntrySchema) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *EvmEntrySchema) GetSignature() string {
+	if x != nil {
+		return x.Signature
+	}
+	return ""
+}
+
+func (x *EvmEntrySchema) GetSelector() string {
+	if x != nil {
+		return x.Selector
+	}
+	return ""
 }
 
-func (x *CreateEvmBlockchainRequest) GetBlockchain() *EvmBlockchain {
+func (x *EvmEntrySchema) GetArgs() []*EvmArgSchema {
 	if x != nil {
-		return x.Blockchain
+		return x.Args
 	}
 	return nil
 }
 
-type CreateEvmBlockchainResponse struct {
+type GetEvmJsonAbiSchemaRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -1509,21 +3297,21 @@ type CreateEvmBlockchainResponse struct {
 	Id uint32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *CreateEvmBlockchainResponse) Reset() {
-	*x = CreateEvmBlockchainResponse{}
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[17]
+func (x *GetEvmJsonAbiSchemaRequest) Reset() {
+	*x = GetEvmJsonAbiSchemaRequest{}
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateEvmBlockchainResponse) String() string {
+func (x *GetEvmJsonAbiSchemaRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateEvmBlockchainResponse) ProtoMessage() {}
+func (*GetEvmJsonAbiSchemaRequest) ProtoMessage() {}
 
-func (x *CreateEvmBlockchainResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[17]
+func (x *GetEvmJsonAbiSchemaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1534,41 +3322,42 @@ func (x *CreateEvmBlockchainResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateEvmBlockchainResponse.ProtoReflect.Descriptor instead.
-func (*CreateEvmBlockchainResponse) Descriptor() ([]byte, []int) {
-	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use GetEvmJsonAbiSchemaRequest.ProtoReflect.Descriptor instead.
+func (*GetEvmJsonAbiSchemaRequest) Descriptor() ([]byte, []int) {
+	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *CreateEvmBlockchainResponse) GetId() uint32 {
+func (x *GetEvmJsonAbiSchemaRequest) GetId() uint32 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-type GetEvmBlockchainRequest struct {
+type GetEvmJsonAbiSchemaResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id uint32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Events    []*EvmEntrySchema `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	Functions []*EvmEntrySchema `protobuf:"bytes,2,rep,name=functions,proto3" json:"functions,omitempty"`
 }
 
-func (x *GetEvmBlockchainRequest) Reset() {
-	*x = GetEvmBlockchainRequest{}
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[18]
+func (x *GetEvmJsonAbiSchemaResponse) Reset() {
+	*x = GetEvmJsonAbiSchemaResponse{}
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetEvmBlockchainRequest) String() string {
+func (x *GetEvmJsonAbiSchemaResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetEvmBlockchainRequest) ProtoMessage() {}
+func (*GetEvmJsonAbiSchemaResponse) ProtoMessage() {}
 
-func (x *GetEvmBlockchainRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[18]
+func (x *GetEvmJsonAbiSchemaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1579,41 +3368,54 @@ func (x *GetEvmBlockchainRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetEvmBlockchainRequest.ProtoReflect.Descriptor instead.
-func (*GetEvmBlockchainRequest) Descriptor() ([]byte, []int) {
-	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use GetEvmJsonAbiSchemaResponse.ProtoReflect.Descriptor instead.
+func (*GetEvmJsonAbiSchemaResponse) Descriptor() ([]byte, []int) {
+	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{43}
 }
 
-func (x *GetEvmBlockchainRequest) GetId() uint32 {
+func (x *GetEvmJsonAbiSchemaResponse) GetEvents() []*EvmEntrySchema {
 	if x != nil {
-		return x.Id
+		return x.Events
 	}
-	return 0
+	return nil
 }
 
-type GetEvmBlockchainResponse struct {
+func (x *GetEvmJsonAbiSchemaResponse) GetFunctions() []*EvmEntrySchema {
+	if x != nil {
+		return x.Functions
+	}
+	return nil
+}
+
+// EvmImplementationAbi maps an implementation contract to the ABI the logs and
+// calldata of the proxies delegating to it are decoded with (combined with the
+// proxy source's own ABI).
+type EvmImplementationAbi struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Blockchain *EvmBlockchain `protobuf:"bytes,1,opt,name=blockchain,proto3" json:"blockchain,omitempty"`
+	Id              *uint32 `protobuf:"varint,1,opt,name=id,proto3,oneof" json:"id,omitempty"`
+	EvmBlockchainId uint32  `protobuf:"varint,2,opt,name=evm_blockchain_id,json=evmBlockchainId,proto3" json:"evm_blockchain_id,omitempty"`
+	Address         string  `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	EvmJsonAbiId    uint32  `protobuf:"varint,4,opt,name=evm_json_abi_id,json=evmJsonAbiId,proto3" json:"evm_json_abi_id,omitempty"`
 }
 
-func (x *GetEvmBlockchainResponse) Reset() {
-	*x = GetEvmBlockchainResponse{}
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[19]
+func (x *EvmImplementationAbi) Reset() {
+	*x = EvmImplementationAbi{}
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetEvmBlockchainResponse) String() string {
+func (x *EvmImplementationAbi) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetEvmBlockchainResponse) ProtoMessage() {}
+func (*EvmImplementationAbi) ProtoMessage() {}
 
-func (x *GetEvmBlockchainResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[19]
+func (x *EvmImplementationAbi) ProtoReflect() protoreflect.Message {
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1624,41 +3426,64 @@ func (x *GetEvmBlockchainResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetEvmBlockchainResponse.ProtoReflect.Descriptor instead.
-func (*GetEvmBlockchainResponse) Descriptor() ([]byte, []int) {
-	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use EvmImplementationAbi.ProtoReflect.Descriptor instead.
+func (*EvmImplementationAbi) Descriptor() ([]byte, []int) {
+	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{44}
 }
 
-func (x *GetEvmBlockchainResponse) GetBlockchain() *EvmBlockchain {
+func (x *EvmImplementationAbi) GetId() uint32 {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return 0
+}
+
+func (x *EvmImplementationAbi) GetEvmBlockchainId() uint32 {
 	if x != nil {
-		return x.Blockchain
+		return x.EvmBlockchainId
 	}
-	return nil
+	return 0
 }
 
-type UpdateEvmBlockchainRequest struct {
+func (x *EvmImplementationAbi) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *EvmImplementationAbi) GetEvmJsonAbiId() uint32 {
+	if x != nil {
+		return x.EvmJsonAbiId
+	}
+	return 0
+}
+
+// SetEvmImplementationAbi creates the mapping of (evm_blockchain_id, address),
+// or replaces its ABI.
+type SetEvmImplementationAbiRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Blockchain *EvmBlockchain `protobuf:"bytes,1,opt,name=blockchain,proto3" json:"blockchain,omitempty"`
+	ImplementationAbi *EvmImplementationAbi `protobuf:"bytes,1,opt,name=implementation_abi,json=implementationAbi,proto3" json:"implementation_abi,omitempty"`
 }
 
-func (x *UpdateEvmBlockchainRequest) Reset() {
-	*x = UpdateEvmBlockchainRequest{}
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[20]
+func (x *SetEvmImplementationAbiRequest) Reset() {
+	*x = SetEvmImplementationAbiRequest{}
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateEvmBlockchainRequest) String() string {
+func (x *SetEvmImplementationAbiRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateEvmBlockchainRequest) ProtoMessage() {}
+func (*SetEvmImplementationAbiRequest) ProtoMessage() {}
 
-func (x *UpdateEvmBlockchainRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[20]
+func (x *SetEvmImplementationAbiRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1669,39 +3494,41 @@ func (x *UpdateEvmBlockchainRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateEvmBlockchainRequest.ProtoReflect.Descriptor instead.
-func (*UpdateEvmBlockchainRequest) Descriptor() ([]byte, []int) {
-	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use SetEvmImplementationAbiRequest.ProtoReflect.Descriptor instead.
+func (*SetEvmImplementationAbiRequest) Descriptor() ([]byte, []int) {
+	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{45}
 }
 
-func (x *UpdateEvmBlockchainRequest) GetBlockchain() *EvmBlockchain {
+func (x *SetEvmImplementationAbiRequest) GetImplementationAbi() *EvmImplementationAbi {
 	if x != nil {
-		return x.Blockchain
+		return x.ImplementationAbi
 	}
 	return nil
 }
 
-type UpdateEvmBlockchainResponse struct {
+type SetEvmImplementationAbiResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Id uint32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *UpdateEvmBlockchainResponse) Reset() {
-	*x = UpdateEvmBlockchainResponse{}
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[21]
+func (x *SetEvmImplementationAbiResponse) Reset() {
+	*x = SetEvmImplementationAbiResponse{}
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[46]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateEvmBlockchainResponse) String() string {
+func (x *SetEvmImplementationAbiResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateEvmBlockchainResponse) ProtoMessage() {}
+func (*SetEvmImplementationAbiResponse) ProtoMessage() {}
 
-func (x *UpdateEvmBlockchainResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[21]
+func (x *SetEvmImplementationAbiResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[46]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1712,34 +3539,42 @@ func (x *UpdateEvmBlockchainResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateEvmBlockchainResponse.ProtoReflect.Descriptor instead.
-func (*UpdateEvmBlockchainResponse) Descriptor() ([]byte, []int) {
-	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use SetEvmImplementationAbiResponse.ProtoReflect.Descriptor instead.
+func (*SetEvmImplementationAbiResponse) Descriptor() ([]byte, []int) {
+	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{46}
 }
 
-type ListEvmBlockchainsRequest struct {
+func (x *SetEvmImplementationAbiResponse) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type ListEvmImplementationAbisRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Pagination *Pagination `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	EvmBlockchainId uint32      `protobuf:"varint,1,opt,name=evm_blockchain_id,json=evmBlockchainId,proto3" json:"evm_blockchain_id,omitempty"` // 0 = every blockchain
+	Pagination      *Pagination `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (x *ListEvmBlockchainsRequest) Reset() {
-	*x = ListEvmBlockchainsRequest{}
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[22]
+func (x *ListEvmImplementationAbisRequest) Reset() {
+	*x = ListEvmImplementationAbisRequest{}
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[47]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListEvmBlockchainsRequest) String() string {
+func (x *ListEvmImplementationAbisRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListEvmBlockchainsRequest) ProtoMessage() {}
+func (*ListEvmImplementationAbisRequest) ProtoMessage() {}
 
-func (x *ListEvmBlockchainsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[22]
+func (x *ListEvmImplementationAbisRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1750,41 +3585,48 @@ func (x *ListEvmBlockchainsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListEvmBlockchainsRequest.ProtoReflect.Descriptor instead.
-func (*ListEvmBlockchainsRequest) Descriptor() ([]byte, []int) {
-	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use ListEvmImplementationAbisRequest.ProtoReflect.Descriptor instead.
+func (*ListEvmImplementationAbisRequest) Descriptor() ([]byte, []int) {
+	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{47}
 }
 
-func (x *ListEvmBlockchainsRequest) GetPagination() *Pagination {
+func (x *ListEvmImplementationAbisRequest) GetEvmBlockchainId() uint32 {
+	if x != nil {
+		return x.EvmBlockchainId
+	}
+	return 0
+}
+
+func (x *ListEvmImplementationAbisRequest) GetPagination() *Pagination {
 	if x != nil {
 		return x.Pagination
 	}
 	return nil
 }
 
-type ListEvmBlockchainsResponse struct {
+type ListEvmImplementationAbisResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Blockchains []*EvmBlockchain `protobuf:"bytes,1,rep,name=blockchains,proto3" json:"blockchains,omitempty"`
+	ImplementationAbis []*EvmImplementationAbi `protobuf:"bytes,1,rep,name=implementation_abis,json=implementationAbis,proto3" json:"implementation_abis,omitempty"`
 }
 
-func (x *ListEvmBlockchainsResponse) Reset() {
-	*x = ListEvmBlockchainsResponse{}
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[23]
+func (x *ListEvmImplementationAbisResponse) Reset() {
+	*x = ListEvmImplementationAbisResponse{}
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[48]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListEvmBlockchainsResponse) String() string {
+func (x *ListEvmImplementationAbisResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListEvmBlockchainsResponse) ProtoMessage() {}
+func (*ListEvmImplementationAbisResponse) ProtoMessage() {}
 
-func (x *ListEvmBlockchainsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[23]
+func (x *ListEvmImplementationAbisResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[48]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1795,19 +3637,19 @@ func (x *ListEvmBlockchainsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListEvmBlockchainsResponse.ProtoReflect.Descriptor instead.
-func (*ListEvmBlockchainsResponse) Descriptor() ([]byte, []int) {
-	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use ListEvmImplementationAbisResponse.ProtoReflect.Descriptor instead.
+func (*ListEvmImplementationAbisResponse) Descriptor() ([]byte, []int) {
+	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{48}
 }
 
-func (x *ListEvmBlockchainsResponse) GetBlockchains() []*EvmBlockchain {
+func (x *ListEvmImplementationAbisResponse) GetImplementationAbis() []*EvmImplementationAbi {
 	if x != nil {
-		return x.Blockchains
+		return x.ImplementationAbis
 	}
 	return nil
 }
 
-type DeleteEvmBlockchainRequest struct {
+type DeleteEvmImplementationAbiRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -1815,21 +3657,21 @@ type DeleteEvmBlockchainRequest struct {
 	Id uint32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *DeleteEvmBlockchainRequest) Reset() {
-	*x = DeleteEvmBlockchainRequest{}
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[24]
+func (x *DeleteEvmImplementationAbiRequest) Reset() {
+	*x = DeleteEvmImplementationAbiRequest{}
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[49]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteEvmBlockchainRequest) String() string {
+func (x *DeleteEvmImplementationAbiRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteEvmBlockchainRequest) ProtoMessage() {}
+func (*DeleteEvmImplementationAbiRequest) ProtoMessage() {}
 
-func (x *DeleteEvmBlockchainRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[24]
+func (x *DeleteEvmImplementationAbiRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[49]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1840,39 +3682,39 @@ func (x *DeleteEvmBlockchainRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteEvmBlockchainRequest.ProtoReflect.Descriptor instead.
-func (*DeleteEvmBlockchainRequest) Descriptor() ([]byte, []int) {
-	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use DeleteEvmImplementationAbiRequest.ProtoReflect.Descriptor instead.
+func (*DeleteEvmImplementationAbiRequest) Descriptor() ([]byte, []int) {
+	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{49}
 }
 
-func (x *DeleteEvmBlockchainRequest) GetId() uint32 {
+func (x *DeleteEvmImplementationAbiRequest) GetId() uint32 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-type DeleteEvmBlockchainResponse struct {
+type DeleteEvmImplementationAbiResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *DeleteEvmBlockchainResponse) Reset() {
-	*x = DeleteEvmBlockchainResponse{}
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[25]
+func (x *DeleteEvmImplementationAbiResponse) Reset() {
+	*x = DeleteEvmImplementationAbiResponse{}
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[50]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteEvmBlockchainResponse) String() string {
+func (x *DeleteEvmImplementationAbiResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteEvmBlockchainResponse) ProtoMessage() {}
+func (*DeleteEvmImplementationAbiResponse) ProtoMessage() {}
 
-func (x *DeleteEvmBlockchainResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[25]
+func (x *DeleteEvmImplementationAbiResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[50]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1883,35 +3725,42 @@ func (x *DeleteEvmBlockchainResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteEvmBlockchainResponse.ProtoReflect.Descriptor instead.
-func (*DeleteEvmBlockchainResponse) Descriptor() ([]byte, []int) {
-	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{25}
+// Deprecated: Use DeleteEvmImplementationAbiResponse.ProtoReflect.Descriptor instead.
+func (*DeleteEvmImplementationAbiResponse) Descriptor() ([]byte, []int) {
+	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{50}
 }
 
-// EvmJsonAbi
-type CreateEvmJsonAbiRequest struct {
+// EvmProxyImplementation is one entry of a proxy source's implementation
+// history, active from the log at (from_block, from_log_index).
+// proxy_type: EIP1967 | EIP1822 | BEACON.
+type EvmProxyImplementation struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Abi *EvmJsonAbi `protobuf:"bytes,1,opt,name=abi,proto3" json:"abi,omitempty"`
+	ProxyType      string  `protobuf:"bytes,1,opt,name=proxy_type,json=proxyType,proto3" json:"proxy_type,omitempty"`
+	Beacon         string  `protobuf:"bytes,2,opt,name=beacon,proto3" json:"beacon,omitempty"`
+	Implementation string  `protobuf:"bytes,3,opt,name=implementation,proto3" json:"implementation,omitempty"`
+	FromBlock      uint64  `protobuf:"varint,4,opt,name=from_block,json=fromBlock,proto3" json:"from_block,omitempty"`
+	FromLogIndex   uint64  `protobuf:"varint,5,opt,name=from_log_index,json=fromLogIndex,proto3" json:"from_log_index,omitempty"`
+	EvmJsonAbiId   *uint32 `protobuf:"varint,6,opt,name=evm_json_abi_id,json=evmJsonAbiId,proto3,oneof" json:"evm_json_abi_id,omitempty"` // unset when no ABI is mapped
 }
 
-func (x *CreateEvmJsonAbiRequest) Reset() {
-	*x = CreateEvmJsonAbiRequest{}
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[26]
+func (x *EvmProxyImplementation) Reset() {
+	*x = EvmProxyImplementation{}
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[51]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateEvmJsonAbiRequest) String() string {
+func (x *EvmProxyImplementation) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateEvmJsonAbiRequest) ProtoMessage() {}
+func (*EvmProxyImplementation) ProtoMessage() {}
 
-func (x *CreateEvmJsonAbiRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[26]
+func (x *EvmProxyImplementation) ProtoReflect() protoreflect.Message {
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[51]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1922,41 +3771,76 @@ func (x *CreateEvmJsonAbiRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateEvmJsonAbiRequest.ProtoReflect.Descriptor instead.
-func (*CreateEvmJsonAbiRequest) Descriptor() ([]byte, []int) {
-	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{26}
+// Deprecated: Use EvmProxyImplementation.ProtoReflect.Descriptor instead.
+func (*EvmProxyImplementation) Descriptor() ([]byte, []int) {
+	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{51}
 }
 
-func (x *CreateEvmJsonAbiRequest) GetAbi() *EvmJsonAbi {
+func (x *EvmProxyImplementation) GetProxyType() string {
 	if x != nil {
-		return x.Abi
+		return x.ProxyType
 	}
-	return nil
+	return ""
 }
 
-type CreateEvmJsonAbiResponse struct {
+func (x *EvmProxyImplementation) GetBeacon() string {
+	if x != nil {
+		return x.Beacon
+	}
+	return ""
+}
+
+func (x *EvmProxyImplementation) GetImplementation() string {
+	if x != nil {
+		return x.Implementation
+	}
+	return ""
+}
+
+func (x *EvmProxyImplementation) GetFromBlock() uint64 {
+	if x != nil {
+		return x.FromBlock
+	}
+	return 0
+}
+
+func (x *EvmProxyImplementation) GetFromLogIndex() uint64 {
+	if x != nil {
+		return x.FromLogIndex
+	}
+	return 0
+}
+
+func (x *EvmProxyImplementation) GetEvmJsonAbiId() uint32 {
+	if x != nil && x.EvmJsonAbiId != nil {
+		return *x.EvmJsonAbiId
+	}
+	return 0
+}
+
+type ListEvmProxyImplementationsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id uint32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	SourceId uint32 `protobuf:"varint,1,opt,name=source_id,json=sourceId,proto3" json:"source_id,omitempty"`
 }
 
-func (x *CreateEvmJsonAbiResponse) Reset() {
-	*x = CreateEvmJsonAbiResponse{}
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[27]
+func (x *ListEvmProxyImplementationsRequest) Reset() {
+	*x = ListEvmProxyImplementationsRequest{}
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[52]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateEvmJsonAbiResponse) String() string {
+func (x *ListEvmProxyImplementationsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateEvmJsonAbiResponse) ProtoMessage() {}
+func (*ListEvmProxyImplementationsRequest) ProtoMessage() {}
 
-func (x *CreateEvmJsonAbiResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[27]
+func (x *ListEvmProxyImplementationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[52]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1967,41 +3851,41 @@ func (x *CreateEvmJsonAbiResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateEvmJsonAbiResponse.ProtoReflect.Descriptor instead.
-func (*CreateEvmJsonAbiResponse) Descriptor() ([]byte, []int) {
-	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{27}
-}
-
-func (x *CreateEvmJsonAbiResponse) GetId() uint32 {
+// Deprecated: Use ListEvmProxyImplementationsRequest.ProtoReflect.Descriptor instead.
+func (*ListEvmProxyImplementationsRequest) Descriptor() ([]byte, []int) {
+	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *ListEvmProxyImplementationsRequest) GetSourceId() uint32 {
 	if x != nil {
-		return x.Id
+		return x.SourceId
 	}
 	return 0
 }
 
-type GetEvmJsonAbiRequest struct {
+type ListEvmProxyImplementationsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id uint32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Implementations []*EvmProxyImplementation `protobuf:"bytes,1,rep,name=implementations,proto3" json:"implementations,omitempty"`
 }
 
-func (x *GetEvmJsonAbiRequest) Reset() {
-	*x = GetEvmJsonAbiRequest{}
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[28]
+func (x *ListEvmProxyImplementationsResponse) Reset() {
+	*x = ListEvmProxyImplementationsResponse{}
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[53]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetEvmJsonAbiRequest) String() string {
+func (x *ListEvmProxyImplementationsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetEvmJsonAbiRequest) ProtoMessage() {}
+func (*ListEvmProxyImplementationsResponse) ProtoMessage() {}
 
-func (x *GetEvmJsonAbiRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[28]
+func (x *ListEvmProxyImplementationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[53]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2012,41 +3896,50 @@ func (x *GetEvmJsonAbiRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetEvmJsonAbiRequest.ProtoReflect.Descriptor instead.
-func (*GetEvmJsonAbiRequest) Descriptor() ([]byte, []int) {
-	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{28}
+// Deprecated: Use ListEvmProxyImplementationsResponse.ProtoReflect.Descriptor instead.
+func (*ListEvmProxyImplementationsResponse) Descriptor() ([]byte, []int) {
+	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{53}
 }
 
-func (x *GetEvmJsonAbiRequest) GetId() uint32 {
+func (x *ListEvmProxyImplementationsResponse) GetImplementations() []*EvmProxyImplementation {
 	if x != nil {
-		return x.Id
+		return x.Implementations
 	}
-	return 0
+	return nil
 }
 
-type GetEvmJsonAbiResponse struct {
+// EvmEventSignature is one event of the signature registry FULL and TOPIC
+// sources decode logs with when their ABIs do not declare the event. The
+// events of every ABI are in the registry (evm_json_abi_id set, id unset),
+// along with the imported ones (id set). signature is human-readable, with its
+// indexed arguments: Transfer(address indexed from, address indexed to, uint256 value).
+type EvmEventSignature struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Abi *EvmJsonAbi `protobuf:"bytes,1,opt,name=abi,proto3" json:"abi,omitempty"`
+	Id           *uint32 `protobuf:"varint,1,opt,name=id,proto3,oneof" json:"id,omitempty"`
+	Topic0       string  `protobuf:"bytes,2,opt,name=topic0,proto3" json:"topic0,omitempty"`
+	IndexedCount uint32  `protobuf:"varint,3,opt,name=indexed_count,json=indexedCount,proto3" json:"indexed_count,omitempty"`
+	Signature    string  `protobuf:"bytes,4,opt,name=signature,proto3" json:"signature,omitempty"`
+	EvmJsonAbiId *uint32 `protobuf:"varint,5,opt,name=evm_json_abi_id,json=evmJsonAbiId,proto3,oneof" json:"evm_json_abi_id,omitempty"`
 }
 
-func (x *GetEvmJsonAbiResponse) Reset() {
-	*x = GetEvmJsonAbiResponse{}
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[29]
+func (x *EvmEventSignature) Reset() {
+	*x = EvmEventSignature{}
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[54]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetEvmJsonAbiResponse) String() string {
+func (x *EvmEventSignature) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetEvmJsonAbiResponse) ProtoMessage() {}
+func (*EvmEventSignature) ProtoMessage() {}
 
-func (x *GetEvmJsonAbiResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[29]
+func (x *EvmEventSignature) ProtoReflect() protoreflect.Message {
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[54]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2057,41 +3950,72 @@ func (x *GetEvmJsonAbiResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetEvmJsonAbiResponse.ProtoReflect.Descriptor instead.
-func (*GetEvmJsonAbiResponse) Descriptor() ([]byte, []int) {
-	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{29}
+// Deprecated: Use EvmEventSignature.ProtoReflect.Descriptor instead.
+func (*EvmEventSignature) Descriptor() ([]byte, []int) {
+	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{54}
 }
 
-func (x *GetEvmJsonAbiResponse) GetAbi() *EvmJsonAbi {
+func (x *EvmEventSignature) GetId() uint32 {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return 0
+}
+
+func (x *EvmEventSignature) GetTopic0() string {
 	if x != nil {
-		return x.Abi
+		return x.Topic0
 	}
-	return nil
+	return ""
 }
 
-type UpdateEvmJsonAbiRequest struct {
+func (x *EvmEventSignature) GetIndexedCount() uint32 {
+	if x != nil {
+		return x.IndexedCount
+	}
+	return 0
+}
+
+func (x *EvmEventSignature) GetSignature() string {
+	if x != nil {
+		return x.Signature
+	}
+	return ""
+}
+
+func (x *EvmEventSignature) GetEvmJsonAbiId() uint32 {
+	if x != nil && x.EvmJsonAbiId != nil {
+		return *x.EvmJsonAbiId
+	}
+	return 0
+}
+
+// ImportEvmEventSignatures imports the events of a signature file: a JSON ABI,
+// or one human-readable event signature per line. Signatures already imported
+// are skipped.
+type ImportEvmEventSignaturesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Abi *EvmJsonAbi `protobuf:"bytes,1,opt,name=abi,proto3" json:"abi,omitempty"`
+	Content string `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
 }
 
-func (x *UpdateEvmJsonAbiRequest) Reset() {
-	*x = UpdateEvmJsonAbiRequest{}
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[30]
+func (x *ImportEvmEventSignaturesRequest) Reset() {
+	*x = ImportEvmEventSignaturesRequest{}
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[55]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateEvmJsonAbiRequest) String() string {
+func (x *ImportEvmEventSignaturesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateEvmJsonAbiRequest) ProtoMessage() {}
+func (*ImportEvmEventSignaturesRequest) ProtoMessage() {}
 
-func (x *UpdateEvmJsonAbiRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[30]
+func (x *ImportEvmEventSignaturesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[55]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2102,39 +4026,42 @@ func (x *UpdateEvmJsonAbiRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateEvmJsonAbiRequest.ProtoReflect.Descriptor instead.
-func (*UpdateEvmJsonAbiRequest) Descriptor() ([]byte, []int) {
-	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{30}
+// Deprecated: Use ImportEvmEventSignaturesRequest.ProtoReflect.Descriptor instead.
+func (*ImportEvmEventSignaturesRequest) Descriptor() ([]byte, []int) {
+	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{55}
 }
 
-func (x *UpdateEvmJsonAbiRequest) GetAbi() *EvmJsonAbi {
+func (x *ImportEvmEventSignaturesRequest) GetContent() string {
 	if x != nil {
-		return x.Abi
+		return x.Content
 	}
-	return nil
+	return ""
 }
 
-type UpdateEvmJsonAbiResponse struct {
+type ImportEvmEventSignaturesResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Imported uint32 `protobuf:"varint,1,opt,name=imported,proto3" json:"imported,omitempty"`
+	Skipped  uint32 `protobuf:"varint,2,opt,name=skipped,proto3" json:"skipped,omitempty"`
 }
 
-func (x *UpdateEvmJsonAbiResponse) Reset() {
-	*x = UpdateEvmJsonAbiResponse{}
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[31]
+func (x *ImportEvmEventSignaturesResponse) Reset() {
+	*x = ImportEvmEventSignaturesResponse{}
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[56]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateEvmJsonAbiResponse) String() string {
+func (x *ImportEvmEventSignaturesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateEvmJsonAbiResponse) ProtoMessage() {}
+func (*ImportEvmEventSignaturesResponse) ProtoMessage() {}
 
-func (x *UpdateEvmJsonAbiResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[31]
+func (x *ImportEvmEventSignaturesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[56]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2145,34 +4072,49 @@ func (x *UpdateEvmJsonAbiResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateEvmJsonAbiResponse.ProtoReflect.Descriptor instead.
-func (*UpdateEvmJsonAbiResponse) Descriptor() ([]byte, []int) {
-	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{31}
+// Deprecated: Use ImportEvmEventSignaturesResponse.ProtoReflect.Descriptor instead.
+func (*ImportEvmEventSignaturesResponse) Descriptor() ([]byte, []int) {
+	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{56}
 }
 
-type ListEvmJsonAbisRequest struct {
+func (x *ImportEvmEventSignaturesResponse) GetImported() uint32 {
+	if x != nil {
+		return x.Imported
+	}
+	return 0
+}
+
+func (x *ImportEvmEventSignaturesResponse) GetSkipped() uint32 {
+	if x != nil {
+		return x.Skipped
+	}
+	return 0
+}
+
+type ListEvmEventSignaturesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Pagination *Pagination `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Topic0     string      `protobuf:"bytes,1,opt,name=topic0,proto3" json:"topic0,omitempty"` // empty = every topic0
+	Pagination *Pagination `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (x *ListEvmJsonAbisRequest) Reset() {
-	*x = ListEvmJsonAbisRequest{}
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[32]
+func (x *ListEvmEventSignaturesRequest) Reset() {
+	*x = ListEvmEventSignaturesRequest{}
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[57]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListEvmJsonAbisRequest) String() string {
+func (x *ListEvmEventSignaturesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListEvmJsonAbisRequest) ProtoMessage() {}
+func (*ListEvmEventSignaturesRequest) ProtoMessage() {}
 
-func (x *ListEvmJsonAbisRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[32]
+func (x *ListEvmEventSignaturesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[57]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2183,41 +4125,48 @@ func (x *ListEvmJsonAbisRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListEvmJsonAbisRequest.ProtoReflect.Descriptor instead.
-func (*ListEvmJsonAbisRequest) Descriptor() ([]byte, []int) {
-	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{32}
+// Deprecated: Use ListEvmEventSignaturesRequest.ProtoReflect.Descriptor instead.
+func (*ListEvmEventSignaturesRequest) Descriptor() ([]byte, []int) {
+	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{57}
 }
 
-func (x *ListEvmJsonAbisRequest) GetPagination() *Pagination {
+func (x *ListEvmEventSignaturesRequest) GetTopic0() string {
+	if x != nil {
+		return x.Topic0
+	}
+	return ""
+}
+
+func (x *ListEvmEventSignaturesRequest) GetPagination() *Pagination {
 	if x != nil {
 		return x.Pagination
 	}
 	return nil
 }
 
-type ListEvmJsonAbisResponse struct {
+type ListEvmEventSignaturesResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Abis []*EvmJsonAbi `protobuf:"bytes,1,rep,name=abis,proto3" json:"abis,omitempty"`
+	Signatures []*EvmEventSignature `protobuf:"bytes,1,rep,name=signatures,proto3" json:"signatures,omitempty"`
 }
 
-func (x *ListEvmJsonAbisResponse) Reset() {
-	*x = ListEvmJsonAbisResponse{}
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[33]
+func (x *ListEvmEventSignaturesResponse) Reset() {
+	*x = ListEvmEventSignaturesResponse{}
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[58]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListEvmJsonAbisResponse) String() string {
+func (x *ListEvmEventSignaturesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListEvmJsonAbisResponse) ProtoMessage() {}
+func (*ListEvmEventSignaturesResponse) ProtoMessage() {}
 
-func (x *ListEvmJsonAbisResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[33]
+func (x *ListEvmEventSignaturesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[58]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2228,19 +4177,19 @@ func (x *ListEvmJsonAbisResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListEvmJsonAbisResponse.ProtoReflect.Descriptor instead.
-func (*ListEvmJsonAbisResponse) Descriptor() ([]byte, []int) {
-	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{33}
+// Deprecated: Use ListEvmEventSignaturesResponse.ProtoReflect.Descriptor instead.
+func (*ListEvmEventSignaturesResponse) Descriptor() ([]byte, []int) {
+	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{58}
 }
 
-func (x *ListEvmJsonAbisResponse) GetAbis() []*EvmJsonAbi {
+func (x *ListEvmEventSignaturesResponse) GetSignatures() []*EvmEventSignature {
 	if x != nil {
-		return x.Abis
+		return x.Signatures
 	}
 	return nil
 }
 
-type DeleteEvmJsonAbiRequest struct {
+type DeleteEvmEventSignatureRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -2248,21 +4197,21 @@ type DeleteEvmJsonAbiRequest struct {
 	Id uint32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *DeleteEvmJsonAbiRequest) Reset() {
-	*x = DeleteEvmJsonAbiRequest{}
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[34]
+func (x *DeleteEvmEventSignatureRequest) Reset() {
+	*x = DeleteEvmEventSignatureRequest{}
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[59]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteEvmJsonAbiRequest) String() string {
+func (x *DeleteEvmEventSignatureRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteEvmJsonAbiRequest) ProtoMessage() {}
+func (*DeleteEvmEventSignatureRequest) ProtoMessage() {}
 
-func (x *DeleteEvmJsonAbiRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[34]
+func (x *DeleteEvmEventSignatureRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[59]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2273,39 +4222,39 @@ func (x *DeleteEvmJsonAbiRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteEvmJsonAbiRequest.ProtoReflect.Descriptor instead.
-func (*DeleteEvmJsonAbiRequest) Descriptor() ([]byte, []int) {
-	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{34}
+// Deprecated: Use DeleteEvmEventSignatureRequest.ProtoReflect.Descriptor instead.
+func (*DeleteEvmEventSignatureRequest) Descriptor() ([]byte, []int) {
+	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{59}
 }
 
-func (x *DeleteEvmJsonAbiRequest) GetId() uint32 {
+func (x *DeleteEvmEventSignatureRequest) GetId() uint32 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-type DeleteEvmJsonAbiResponse struct {
+type DeleteEvmEventSignatureResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *DeleteEvmJsonAbiResponse) Reset() {
-	*x = DeleteEvmJsonAbiResponse{}
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[35]
+func (x *DeleteEvmEventSignatureResponse) Reset() {
+	*x = DeleteEvmEventSignatureResponse{}
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[60]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteEvmJsonAbiResponse) String() string {
+func (x *DeleteEvmEventSignatureResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteEvmJsonAbiResponse) ProtoMessage() {}
+func (*DeleteEvmEventSignatureResponse) ProtoMessage() {}
 
-func (x *DeleteEvmJsonAbiResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[35]
+func (x *DeleteEvmEventSignatureResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[60]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2316,9 +4265,9 @@ func (x *DeleteEvmJsonAbiResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteEvmJsonAbiResponse.ProtoReflect.Descriptor instead.
-func (*DeleteEvmJsonAbiResponse) Descriptor() ([]byte, []int) {
-	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{35}
+// Deprecated: Use DeleteEvmEventSignatureResponse.ProtoReflect.Descriptor instead.
+func (*DeleteEvmEventSignatureResponse) Descriptor() ([]byte, []int) {
+	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{60}
 }
 
 // EvmLogStore
@@ -2332,7 +4281,7 @@ type CreateEvmLogStoreRequest struct {
 
 func (x *CreateEvmLogStoreRequest) Reset() {
 	*x = CreateEvmLogStoreRequest{}
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[36]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[61]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2344,7 +4293,7 @@ func (x *CreateEvmLogStoreRequest) String() string {
 func (*CreateEvmLogStoreRequest) ProtoMessage() {}
 
 func (x *CreateEvmLogStoreRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[36]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[61]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2357,7 +4306,7 @@ func (x *CreateEvmLogStoreRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateEvmLogStoreRequest.ProtoReflect.Descriptor instead.
 func (*CreateEvmLogStoreRequest) Descriptor() ([]byte, []int) {
-	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{36}
+	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{61}
 }
 
 func (x *CreateEvmLogStoreRequest) GetStore() *EvmLogStore {
@@ -2377,7 +4326,7 @@ type CreateEvmLogStoreResponse struct {
 
 func (x *CreateEvmLogStoreResponse) Reset() {
 	*x = CreateEvmLogStoreResponse{}
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[37]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[62]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2389,7 +4338,7 @@ func (x *CreateEvmLogStoreResponse) String() string {
 func (*CreateEvmLogStoreResponse) ProtoMessage() {}
 
 func (x *CreateEvmLogStoreResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[37]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[62]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2402,7 +4351,7 @@ func (x *CreateEvmLogStoreResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateEvmLogStoreResponse.ProtoReflect.Descriptor instead.
 func (*CreateEvmLogStoreResponse) Descriptor() ([]byte, []int) {
-	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{37}
+	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{62}
 }
 
 func (x *CreateEvmLogStoreResponse) GetId() uint32 {
@@ -2422,7 +4371,7 @@ type GetEvmLogStoreRequest struct {
 
 func (x *GetEvmLogStoreRequest) Reset() {
 	*x = GetEvmLogStoreRequest{}
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[38]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[63]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2434,7 +4383,7 @@ func (x *GetEvmLogStoreRequest) String() string {
 func (*GetEvmLogStoreRequest) ProtoMessage() {}
 
 func (x *GetEvmLogStoreRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[38]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[63]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2447,7 +4396,7 @@ func (x *GetEvmLogStoreRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetEvmLogStoreRequest.ProtoReflect.Descriptor instead.
 func (*GetEvmLogStoreRequest) Descriptor() ([]byte, []int) {
-	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{38}
+	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{63}
 }
 
 func (x *GetEvmLogStoreRequest) GetId() uint32 {
@@ -2467,7 +4416,7 @@ type GetEvmLogStoreResponse struct {
 
 func (x *GetEvmLogStoreResponse) Reset() {
 	*x = GetEvmLogStoreResponse{}
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[39]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[64]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2479,7 +4428,7 @@ func (x *GetEvmLogStoreResponse) String() string {
 func (*GetEvmLogStoreResponse) ProtoMessage() {}
 
 func (x *GetEvmLogStoreResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[39]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[64]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2492,7 +4441,7 @@ func (x *GetEvmLogStoreResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetEvmLogStoreResponse.ProtoReflect.Descriptor instead.
 func (*GetEvmLogStoreResponse) Descriptor() ([]byte, []int) {
-	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{39}
+	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{64}
 }
 
 func (x *GetEvmLogStoreResponse) GetStore() *EvmLogStore {
@@ -2512,7 +4461,7 @@ type UpdateEvmLogStoreRequest struct {
 
 func (x *UpdateEvmLogStoreRequest) Reset() {
 	*x = UpdateEvmLogStoreRequest{}
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[40]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[65]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2524,7 +4473,7 @@ func (x *UpdateEvmLogStoreRequest) String() string {
 func (*UpdateEvmLogStoreRequest) ProtoMessage() {}
 
 func (x *UpdateEvmLogStoreRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[40]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[65]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2537,7 +4486,7 @@ func (x *UpdateEvmLogStoreRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateEvmLogStoreRequest.ProtoReflect.Descriptor instead.
 func (*UpdateEvmLogStoreRequest) Descriptor() ([]byte, []int) {
-	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{40}
+	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{65}
 }
 
 func (x *UpdateEvmLogStoreRequest) GetStore() *EvmLogStore {
@@ -2555,7 +4504,7 @@ type UpdateEvmLogStoreResponse struct {
 
 func (x *UpdateEvmLogStoreResponse) Reset() {
 	*x = UpdateEvmLogStoreResponse{}
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[41]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[66]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2567,7 +4516,7 @@ func (x *UpdateEvmLogStoreResponse) String() string {
 func (*UpdateEvmLogStoreResponse) ProtoMessage() {}
 
 func (x *UpdateEvmLogStoreResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[41]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[66]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2580,7 +4529,7 @@ func (x *UpdateEvmLogStoreResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateEvmLogStoreResponse.ProtoReflect.Descriptor instead.
 func (*UpdateEvmLogStoreResponse) Descriptor() ([]byte, []int) {
-	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{41}
+	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{66}
 }
 
 type ListEvmLogStoresRequest struct {
@@ -2593,7 +4542,7 @@ type ListEvmLogStoresRequest struct {
 
 func (x *ListEvmLogStoresRequest) Reset() {
 	*x = ListEvmLogStoresRequest{}
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[42]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[67]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2605,7 +4554,7 @@ func (x *ListEvmLogStoresRequest) String() string {
 func (*ListEvmLogStoresRequest) ProtoMessage() {}
 
 func (x *ListEvmLogStoresRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[42]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[67]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2618,7 +4567,7 @@ func (x *ListEvmLogStoresRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListEvmLogStoresRequest.ProtoReflect.Descriptor instead.
 func (*ListEvmLogStoresRequest) Descriptor() ([]byte, []int) {
-	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{42}
+	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{67}
 }
 
 func (x *ListEvmLogStoresRequest) GetPagination() *Pagination {
@@ -2638,7 +4587,7 @@ type ListEvmLogStoresResponse struct {
 
 func (x *ListEvmLogStoresResponse) Reset() {
 	*x = ListEvmLogStoresResponse{}
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[43]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[68]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2650,7 +4599,7 @@ func (x *ListEvmLogStoresResponse) String() string {
 func (*ListEvmLogStoresResponse) ProtoMessage() {}
 
 func (x *ListEvmLogStoresResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[43]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[68]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2663,7 +4612,7 @@ func (x *ListEvmLogStoresResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListEvmLogStoresResponse.ProtoReflect.Descriptor instead.
 func (*ListEvmLogStoresResponse) Descriptor() ([]byte, []int) {
-	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{43}
+	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{68}
 }
 
 func (x *ListEvmLogStoresResponse) GetStores() []*EvmLogStore {
@@ -2683,7 +4632,7 @@ type DeleteEvmLogStoreRequest struct {
 
 func (x *DeleteEvmLogStoreRequest) Reset() {
 	*x = DeleteEvmLogStoreRequest{}
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[44]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[69]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2695,7 +4644,7 @@ func (x *DeleteEvmLogStoreRequest) String() string {
 func (*DeleteEvmLogStoreRequest) ProtoMessage() {}
 
 func (x *DeleteEvmLogStoreRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[44]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[69]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2708,7 +4657,7 @@ func (x *DeleteEvmLogStoreRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteEvmLogStoreRequest.ProtoReflect.Descriptor instead.
 func (*DeleteEvmLogStoreRequest) Descriptor() ([]byte, []int) {
-	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{44}
+	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{69}
 }
 
 func (x *DeleteEvmLogStoreRequest) GetId() uint32 {
@@ -2726,7 +4675,7 @@ type DeleteEvmLogStoreResponse struct {
 
 func (x *DeleteEvmLogStoreResponse) Reset() {
 	*x = DeleteEvmLogStoreResponse{}
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[45]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[70]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2738,7 +4687,7 @@ func (x *DeleteEvmLogStoreResponse) String() string {
 func (*DeleteEvmLogStoreResponse) ProtoMessage() {}
 
 func (x *DeleteEvmLogStoreResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[45]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[70]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2751,7 +4700,7 @@ func (x *DeleteEvmLogStoreResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteEvmLogStoreResponse.ProtoReflect.Descriptor instead.
 func (*DeleteEvmLogStoreResponse) Descriptor() ([]byte, []int) {
-	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{45}
+	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{70}
 }
 
 // EvmLogPipeline
@@ -2765,7 +4714,7 @@ type CreateEvmLogPipelineRequest struct {
 
 func (x *CreateEvmLogPipelineRequest) Reset() {
 	*x = CreateEvmLogPipelineRequest{}
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[46]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[71]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2777,7 +4726,7 @@ func (x *CreateEvmLogPipelineRequest) String() string {
 func (*CreateEvmLogPipelineRequest) ProtoMessage() {}
 
 func (x *CreateEvmLogPipelineRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[46]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[71]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2790,7 +4739,7 @@ func (x *CreateEvmLogPipelineRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateEvmLogPipelineRequest.ProtoReflect.Descriptor instead.
 func (*CreateEvmLogPipelineRequest) Descriptor() ([]byte, []int) {
-	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{46}
+	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{71}
 }
 
 func (x *CreateEvmLogPipelineRequest) GetPipeline() *EvmLogPipeline {
@@ -2810,7 +4759,7 @@ type CreateEvmLogPipelineResponse struct {
 
 func (x *CreateEvmLogPipelineResponse) Reset() {
 	*x = CreateEvmLogPipelineResponse{}
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[47]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[72]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2822,7 +4771,7 @@ func (x *CreateEvmLogPipelineResponse) String() string {
 func (*CreateEvmLogPipelineResponse) ProtoMessage() {}
 
 func (x *CreateEvmLogPipelineResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[47]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[72]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2835,7 +4784,7 @@ func (x *CreateEvmLogPipelineResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateEvmLogPipelineResponse.ProtoReflect.Descriptor instead.
 func (*CreateEvmLogPipelineResponse) Descriptor() ([]byte, []int) {
-	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{47}
+	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{72}
 }
 
 func (x *CreateEvmLogPipelineResponse) GetId() uint32 {
@@ -2855,7 +4804,7 @@ type GetEvmLogPipelineRequest struct {
 
 func (x *GetEvmLogPipelineRequest) Reset() {
 	*x = GetEvmLogPipelineRequest{}
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[48]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[73]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2867,7 +4816,7 @@ func (x *GetEvmLogPipelineRequest) String() string {
 func (*GetEvmLogPipelineRequest) ProtoMessage() {}
 
 func (x *GetEvmLogPipelineRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[48]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[73]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2880,7 +4829,7 @@ func (x *GetEvmLogPipelineRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetEvmLogPipelineRequest.ProtoReflect.Descriptor instead.
 func (*GetEvmLogPipelineRequest) Descriptor() ([]byte, []int) {
-	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{48}
+	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{73}
 }
 
 func (x *GetEvmLogPipelineRequest) GetId() uint32 {
@@ -2900,7 +4849,7 @@ type GetEvmLogPipelineResponse struct {
 
 func (x *GetEvmLogPipelineResponse) Reset() {
 	*x = GetEvmLogPipelineResponse{}
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[49]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[74]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2912,7 +4861,7 @@ func (x *GetEvmLogPipelineResponse) String() string {
 func (*GetEvmLogPipelineResponse) ProtoMessage() {}
 
 func (x *GetEvmLogPipelineResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[49]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[74]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2925,7 +4874,7 @@ func (x *GetEvmLogPipelineResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetEvmLogPipelineResponse.ProtoReflect.Descriptor instead.
 func (*GetEvmLogPipelineResponse) Descriptor() ([]byte, []int) {
-	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{49}
+	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{74}
 }
 
 func (x *GetEvmLogPipelineResponse) GetPipeline() *EvmLogPipeline {
@@ -2945,7 +4894,7 @@ type UpdateEvmLogPipelineRequest struct {
 
 func (x *UpdateEvmLogPipelineRequest) Reset() {
 	*x = UpdateEvmLogPipelineRequest{}
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[50]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[75]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2957,7 +4906,7 @@ func (x *UpdateEvmLogPipelineRequest) String() string {
 func (*UpdateEvmLogPipelineRequest) ProtoMessage() {}
 
 func (x *UpdateEvmLogPipelineRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[50]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[75]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2970,7 +4919,7 @@ func (x *UpdateEvmLogPipelineRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateEvmLogPipelineRequest.ProtoReflect.Descriptor instead.
 func (*UpdateEvmLogPipelineRequest) Descriptor() ([]byte, []int) {
-	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{50}
+	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{75}
 }
 
 func (x *UpdateEvmLogPipelineRequest) GetPipeline() *EvmLogPipeline {
@@ -2988,7 +4937,7 @@ type UpdateEvmLogPipelineResponse struct {
 
 func (x *UpdateEvmLogPipelineResponse) Reset() {
 	*x = UpdateEvmLogPipelineResponse{}
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[51]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[76]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3000,7 +4949,7 @@ func (x *UpdateEvmLogPipelineResponse) String() string {
 func (*UpdateEvmLogPipelineResponse) ProtoMessage() {}
 
 func (x *UpdateEvmLogPipelineResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[51]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[76]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3013,7 +4962,7 @@ func (x *UpdateEvmLogPipelineResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateEvmLogPipelineResponse.ProtoReflect.Descriptor instead.
 func (*UpdateEvmLogPipelineResponse) Descriptor() ([]byte, []int) {
-	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{51}
+	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{76}
 }
 
 type ListEvmLogPipelinesRequest struct {
@@ -3026,7 +4975,7 @@ type ListEvmLogPipelinesRequest struct {
 
 func (x *ListEvmLogPipelinesRequest) Reset() {
 	*x = ListEvmLogPipelinesRequest{}
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[52]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[77]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3038,7 +4987,7 @@ func (x *ListEvmLogPipelinesRequest) String() string {
 func (*ListEvmLogPipelinesRequest) ProtoMessage() {}
 
 func (x *ListEvmLogPipelinesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[52]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[77]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3051,7 +5000,7 @@ func (x *ListEvmLogPipelinesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListEvmLogPipelinesRequest.ProtoReflect.Descriptor instead.
 func (*ListEvmLogPipelinesRequest) Descriptor() ([]byte, []int) {
-	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{52}
+	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{77}
 }
 
 func (x *ListEvmLogPipelinesRequest) GetPagination() *Pagination {
@@ -3071,7 +5020,7 @@ type ListEvmLogPipelinesResponse struct {
 
 func (x *ListEvmLogPipelinesResponse) Reset() {
 	*x = ListEvmLogPipelinesResponse{}
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[53]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[78]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3083,7 +5032,7 @@ func (x *ListEvmLogPipelinesResponse) String() string {
 func (*ListEvmLogPipelinesResponse) ProtoMessage() {}
 
 func (x *ListEvmLogPipelinesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[53]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[78]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3096,7 +5045,7 @@ func (x *ListEvmLogPipelinesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListEvmLogPipelinesResponse.ProtoReflect.Descriptor instead.
 func (*ListEvmLogPipelinesResponse) Descriptor() ([]byte, []int) {
-	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{53}
+	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{78}
 }
 
 func (x *ListEvmLogPipelinesResponse) GetPipelines() []*EvmLogPipeline {
@@ -3116,7 +5065,7 @@ type DeleteEvmLogPipelineRequest struct {
 
 func (x *DeleteEvmLogPipelineRequest) Reset() {
 	*x = DeleteEvmLogPipelineRequest{}
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[54]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[79]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3128,7 +5077,7 @@ func (x *DeleteEvmLogPipelineRequest) String() string {
 func (*DeleteEvmLogPipelineRequest) ProtoMessage() {}
 
 func (x *DeleteEvmLogPipelineRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[54]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[79]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3141,7 +5090,7 @@ func (x *DeleteEvmLogPipelineRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteEvmLogPipelineRequest.ProtoReflect.Descriptor instead.
 func (*DeleteEvmLogPipelineRequest) Descriptor() ([]byte, []int) {
-	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{54}
+	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{79}
 }
 
 func (x *DeleteEvmLogPipelineRequest) GetId() uint32 {
@@ -3159,7 +5108,7 @@ type DeleteEvmLogPipelineResponse struct {
 
 func (x *DeleteEvmLogPipelineResponse) Reset() {
 	*x = DeleteEvmLogPipelineResponse{}
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[55]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[80]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3171,7 +5120,7 @@ func (x *DeleteEvmLogPipelineResponse) String() string {
 func (*DeleteEvmLogPipelineResponse) ProtoMessage() {}
 
 func (x *DeleteEvmLogPipelineResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[55]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[80]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3184,7 +5133,7 @@ func (x *DeleteEvmLogPipelineResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteEvmLogPipelineResponse.ProtoReflect.Descriptor instead.
 func (*DeleteEvmLogPipelineResponse) Descriptor() ([]byte, []int) {
-	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{55}
+	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{80}
 }
 
 // EvmLogSource
@@ -3198,7 +5147,7 @@ type CreateEvmLogSourceRequest struct {
 
 func (x *CreateEvmLogSourceRequest) Reset() {
 	*x = CreateEvmLogSourceRequest{}
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[56]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[81]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3210,7 +5159,7 @@ func (x *CreateEvmLogSourceRequest) String() string {
 func (*CreateEvmLogSourceRequest) ProtoMessage() {}
 
 func (x *CreateEvmLogSourceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[56]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[81]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3223,7 +5172,7 @@ func (x *CreateEvmLogSourceRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateEvmLogSourceRequest.ProtoReflect.Descriptor instead.
 func (*CreateEvmLogSourceRequest) Descriptor() ([]byte, []int) {
-	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{56}
+	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{81}
 }
 
 func (x *CreateEvmLogSourceRequest) GetSource() *EvmLogSource {
@@ -3243,7 +5192,7 @@ type CreateEvmLogSourceResponse struct {
 
 func (x *CreateEvmLogSourceResponse) Reset() {
 	*x = CreateEvmLogSourceResponse{}
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[57]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[82]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3255,7 +5204,7 @@ func (x *CreateEvmLogSourceResponse) String() string {
 func (*CreateEvmLogSourceResponse) ProtoMessage() {}
 
 func (x *CreateEvmLogSourceResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[57]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[82]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3268,7 +5217,7 @@ func (x *CreateEvmLogSourceResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateEvmLogSourceResponse.ProtoReflect.Descriptor instead.
 func (*CreateEvmLogSourceResponse) Descriptor() ([]byte, []int) {
-	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{57}
+	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{82}
 }
 
 func (x *CreateEvmLogSourceResponse) GetId() uint32 {
@@ -3288,7 +5237,7 @@ type GetEvmLogSourceRequest struct {
 
 func (x *GetEvmLogSourceRequest) Reset() {
 	*x = GetEvmLogSourceRequest{}
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[58]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[83]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3300,7 +5249,7 @@ func (x *GetEvmLogSourceRequest) String() string {
 func (*GetEvmLogSourceRequest) ProtoMessage() {}
 
 func (x *GetEvmLogSourceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[58]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[83]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3313,7 +5262,7 @@ func (x *GetEvmLogSourceRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetEvmLogSourceRequest.ProtoReflect.Descriptor instead.
 func (*GetEvmLogSourceRequest) Descriptor() ([]byte, []int) {
-	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{58}
+	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{83}
 }
 
 func (x *GetEvmLogSourceRequest) GetId() uint32 {
@@ -3333,7 +5282,7 @@ type GetEvmLogSourceResponse struct {
 
 func (x *GetEvmLogSourceResponse) Reset() {
 	*x = GetEvmLogSourceResponse{}
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[59]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[84]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3345,7 +5294,7 @@ func (x *GetEvmLogSourceResponse) String() string {
 func (*GetEvmLogSourceResponse) ProtoMessage() {}
 
 func (x *GetEvmLogSourceResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[59]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[84]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3358,7 +5307,7 @@ func (x *GetEvmLogSourceResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetEvmLogSourceResponse.ProtoReflect.Descriptor instead.
 func (*GetEvmLogSourceResponse) Descriptor() ([]byte, []int) {
-	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{59}
+	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{84}
 }
 
 func (x *GetEvmLogSourceResponse) GetSource() *EvmLogSource {
@@ -3378,7 +5327,7 @@ type UpdateEvmLogSourceRequest struct {
 
 func (x *UpdateEvmLogSourceRequest) Reset() {
 	*x = UpdateEvmLogSourceRequest{}
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[60]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[85]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3390,7 +5339,7 @@ func (x *UpdateEvmLogSourceRequest) String() string {
 func (*UpdateEvmLogSourceRequest) ProtoMessage() {}
 
 func (x *UpdateEvmLogSourceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[60]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[85]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3403,7 +5352,7 @@ func (x *UpdateEvmLogSourceRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateEvmLogSourceRequest.ProtoReflect.Descriptor instead.
 func (*UpdateEvmLogSourceRequest) Descriptor() ([]byte, []int) {
-	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{60}
+	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{85}
 }
 
 func (x *UpdateEvmLogSourceRequest) GetSource() *EvmLogSource {
@@ -3421,7 +5370,7 @@ type UpdateEvmLogSourceResponse struct {
 
 func (x *UpdateEvmLogSourceResponse) Reset() {
 	*x = UpdateEvmLogSourceResponse{}
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[61]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[86]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3433,7 +5382,7 @@ func (x *UpdateEvmLogSourceResponse) String() string {
 func (*UpdateEvmLogSourceResponse) ProtoMessage() {}
 
 func (x *UpdateEvmLogSourceResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[61]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[86]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3446,7 +5395,7 @@ func (x *UpdateEvmLogSourceResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateEvmLogSourceResponse.ProtoReflect.Descriptor instead.
 func (*UpdateEvmLogSourceResponse) Descriptor() ([]byte, []int) {
-	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{61}
+	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{86}
 }
 
 type ListEvmLogSourcesRequest struct {
@@ -3460,7 +5409,7 @@ type ListEvmLogSourcesRequest struct {
 
 func (x *ListEvmLogSourcesRequest) Reset() {
 	*x = ListEvmLogSourcesRequest{}
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[62]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[87]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3472,7 +5421,7 @@ func (x *ListEvmLogSourcesRequest) String() string {
 func (*ListEvmLogSourcesRequest) ProtoMessage() {}
 
 func (x *ListEvmLogSourcesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[62]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[87]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3485,7 +5434,7 @@ func (x *ListEvmLogSourcesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListEvmLogSourcesRequest.ProtoReflect.Descriptor instead.
 func (*ListEvmLogSourcesRequest) Descriptor() ([]byte, []int) {
-	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{62}
+	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{87}
 }
 
 func (x *ListEvmLogSourcesRequest) GetPagination() *Pagination {
@@ -3512,7 +5461,7 @@ type ListEvmLogSourcesResponse struct {
 
 func (x *ListEvmLogSourcesResponse) Reset() {
 	*x = ListEvmLogSourcesResponse{}
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[63]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[88]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3524,7 +5473,7 @@ func (x *ListEvmLogSourcesResponse) String() string {
 func (*ListEvmLogSourcesResponse) ProtoMessage() {}
 
 func (x *ListEvmLogSourcesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[63]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[88]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3537,7 +5486,7 @@ func (x *ListEvmLogSourcesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListEvmLogSourcesResponse.ProtoReflect.Descriptor instead.
 func (*ListEvmLogSourcesResponse) Descriptor() ([]byte, []int) {
-	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{63}
+	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{88}
 }
 
 func (x *ListEvmLogSourcesResponse) GetSources() []*EvmLogSource {
@@ -3557,7 +5506,7 @@ type DeleteEvmLogSourceRequest struct {
 
 func (x *DeleteEvmLogSourceRequest) Reset() {
 	*x = DeleteEvmLogSourceRequest{}
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[64]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[89]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3569,7 +5518,7 @@ func (x *DeleteEvmLogSourceRequest) String() string {
 func (*DeleteEvmLogSourceRequest) ProtoMessage() {}
 
 func (x *DeleteEvmLogSourceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[64]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[89]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3582,7 +5531,7 @@ func (x *DeleteEvmLogSourceRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteEvmLogSourceRequest.ProtoReflect.Descriptor instead.
 func (*DeleteEvmLogSourceRequest) Descriptor() ([]byte, []int) {
-	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{64}
+	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{89}
 }
 
 func (x *DeleteEvmLogSourceRequest) GetId() uint32 {
@@ -3600,7 +5549,7 @@ type DeleteEvmLogSourceResponse struct {
 
 func (x *DeleteEvmLogSourceResponse) Reset() {
 	*x = DeleteEvmLogSourceResponse{}
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[65]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[90]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3612,7 +5561,7 @@ func (x *DeleteEvmLogSourceResponse) String() string {
 func (*DeleteEvmLogSourceResponse) ProtoMessage() {}
 
 func (x *DeleteEvmLogSourceResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[65]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[90]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3625,7 +5574,7 @@ func (x *DeleteEvmLogSourceResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteEvmLogSourceResponse.ProtoReflect.Descriptor instead.
 func (*DeleteEvmLogSourceResponse) Descriptor() ([]byte, []int) {
-	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{65}
+	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{90}
 }
 
 type StreamEvmLogSourceUpdatesRequest struct {
@@ -3639,7 +5588,7 @@ type StreamEvmLogSourceUpdatesRequest struct {
 
 func (x *StreamEvmLogSourceUpdatesRequest) Reset() {
 	*x = StreamEvmLogSourceUpdatesRequest{}
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[66]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[91]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3651,7 +5600,7 @@ func (x *StreamEvmLogSourceUpdatesRequest) String() string {
 func (*StreamEvmLogSourceUpdatesRequest) ProtoMessage() {}
 
 func (x *StreamEvmLogSourceUpdatesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[66]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[91]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3664,7 +5613,7 @@ func (x *StreamEvmLogSourceUpdatesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StreamEvmLogSourceUpdatesRequest.ProtoReflect.Descriptor instead.
 func (*StreamEvmLogSourceUpdatesRequest) Descriptor() ([]byte, []int) {
-	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{66}
+	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{91}
 }
 
 func (x *StreamEvmLogSourceUpdatesRequest) GetPipelineId() uint32 {
@@ -3674,6 +5623,197 @@ func (x *StreamEvmLogSourceUpdatesRequest) GetPipelineId() uint32 {
 	return 0
 }
 
+type RedecodeEvmLogSourceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id uint32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *RedecodeEvmLogSourceRequest) Reset() {
+	*x = RedecodeEvmLogSourceRequest{}
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RedecodeEvmLogSourceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RedecodeEvmLogSourceRequest) ProtoMessage() {}
+
+func (x *RedecodeEvmLogSourceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[92]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RedecodeEvmLogSourceRequest.ProtoReflect.Descriptor instead.
+func (*RedecodeEvmLogSourceRequest) Descriptor() ([]byte, []int) {
+	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *RedecodeEvmLogSourceRequest) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type RedecodeEvmLogSourceResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *RedecodeEvmLogSourceResponse) Reset() {
+	*x = RedecodeEvmLogSourceResponse{}
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RedecodeEvmLogSourceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RedecodeEvmLogSourceResponse) ProtoMessage() {}
+
+func (x *RedecodeEvmLogSourceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[93]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RedecodeEvmLogSourceResponse.ProtoReflect.Descriptor instead.
+func (*RedecodeEvmLogSourceResponse) Descriptor() ([]byte, []int) {
+	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{93}
+}
+
+type ResyncEvmLogSourceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id uint32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The first block indexed again: the source's stored data at or above it
+	// is deleted.
+	FromBlock uint64 `protobuf:"varint,2,opt,name=from_block,json=fromBlock,proto3" json:"from_block,omitempty"`
+	// Also resync the factory children of the source, recursively.
+	IncludeChildren bool `protobuf:"varint,3,opt,name=include_children,json=includeChildren,proto3" json:"include_children,omitempty"`
+}
+
+func (x *ResyncEvmLogSourceRequest) Reset() {
+	*x = ResyncEvmLogSourceRequest{}
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[94]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResyncEvmLogSourceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResyncEvmLogSourceRequest) ProtoMessage() {}
+
+func (x *ResyncEvmLogSourceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[94]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResyncEvmLogSourceRequest.ProtoReflect.Descriptor instead.
+func (*ResyncEvmLogSourceRequest) Descriptor() ([]byte, []int) {
+	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *ResyncEvmLogSourceRequest) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ResyncEvmLogSourceRequest) GetFromBlock() uint64 {
+	if x != nil {
+		return x.FromBlock
+	}
+	return 0
+}
+
+func (x *ResyncEvmLogSourceRequest) GetIncludeChildren() bool {
+	if x != nil {
+		return x.IncludeChildren
+	}
+	return false
+}
+
+type ResyncEvmLogSourceResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The sources resynced: the source, then its children when included.
+	SourceIds []uint32 `protobuf:"varint,1,rep,packed,name=source_ids,json=sourceIds,proto3" json:"source_ids,omitempty"`
+}
+
+func (x *ResyncEvmLogSourceResponse) Reset() {
+	*x = ResyncEvmLogSourceResponse{}
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[95]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResyncEvmLogSourceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResyncEvmLogSourceResponse) ProtoMessage() {}
+
+func (x *ResyncEvmLogSourceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[95]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResyncEvmLogSourceResponse.ProtoReflect.Descriptor instead.
+func (*ResyncEvmLogSourceResponse) Descriptor() ([]byte, []int) {
+	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *ResyncEvmLogSourceResponse) GetSourceIds() []uint32 {
+	if x != nil {
+		return x.SourceIds
+	}
+	return nil
+}
+
 type StartSourceIndexerRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -3684,7 +5824,7 @@ type StartSourceIndexerRequest struct {
 
 func (x *StartSourceIndexerRequest) Reset() {
 	*x = StartSourceIndexerRequest{}
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[67]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[96]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3696,7 +5836,7 @@ func (x *StartSourceIndexerRequest) String() string {
 func (*StartSourceIndexerRequest) ProtoMessage() {}
 
 func (x *StartSourceIndexerRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[67]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[96]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3709,7 +5849,7 @@ func (x *StartSourceIndexerRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StartSourceIndexerRequest.ProtoReflect.Descriptor instead.
 func (*StartSourceIndexerRequest) Descriptor() ([]byte, []int) {
-	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{67}
+	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{96}
 }
 
 func (x *StartSourceIndexerRequest) GetId() uint32 {
@@ -3730,7 +5870,7 @@ type StartSourceIndexerResponse struct {
 
 func (x *StartSourceIndexerResponse) Reset() {
 	*x = StartSourceIndexerResponse{}
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[68]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[97]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3742,7 +5882,7 @@ func (x *StartSourceIndexerResponse) String() string {
 func (*StartSourceIndexerResponse) ProtoMessage() {}
 
 func (x *StartSourceIndexerResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[68]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[97]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3755,7 +5895,7 @@ func (x *StartSourceIndexerResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StartSourceIndexerResponse.ProtoReflect.Descriptor instead.
 func (*StartSourceIndexerResponse) Descriptor() ([]byte, []int) {
-	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{68}
+	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{97}
 }
 
 func (x *StartSourceIndexerResponse) GetSuccess() bool {
@@ -3782,7 +5922,7 @@ type StopSourceIndexerRequest struct {
 
 func (x *StopSourceIndexerRequest) Reset() {
 	*x = StopSourceIndexerRequest{}
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[69]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[98]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3794,7 +5934,7 @@ func (x *StopSourceIndexerRequest) String() string {
 func (*StopSourceIndexerRequest) ProtoMessage() {}
 
 func (x *StopSourceIndexerRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[69]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[98]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3807,7 +5947,7 @@ func (x *StopSourceIndexerRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StopSourceIndexerRequest.ProtoReflect.Descriptor instead.
 func (*StopSourceIndexerRequest) Descriptor() ([]byte, []int) {
-	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{69}
+	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{98}
 }
 
 func (x *StopSourceIndexerRequest) GetId() uint32 {
@@ -3828,7 +5968,7 @@ type StopSourceIndexerResponse struct {
 
 func (x *StopSourceIndexerResponse) Reset() {
 	*x = StopSourceIndexerResponse{}
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[70]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[99]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3840,7 +5980,7 @@ func (x *StopSourceIndexerResponse) String() string {
 func (*StopSourceIndexerResponse) ProtoMessage() {}
 
 func (x *StopSourceIndexerResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[70]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[99]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3853,7 +5993,7 @@ func (x *StopSourceIndexerResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StopSourceIndexerResponse.ProtoReflect.Descriptor instead.
 func (*StopSourceIndexerResponse) Descriptor() ([]byte, []int) {
-	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{70}
+	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{99}
 }
 
 func (x *StopSourceIndexerResponse) GetSuccess() bool {
@@ -3883,7 +6023,7 @@ type ListEvmLogsRequest struct {
 
 func (x *ListEvmLogsRequest) Reset() {
 	*x = ListEvmLogsRequest{}
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[71]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[100]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3895,7 +6035,7 @@ func (x *ListEvmLogsRequest) String() string {
 func (*ListEvmLogsRequest) ProtoMessage() {}
 
 func (x *ListEvmLogsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[71]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[100]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3908,7 +6048,7 @@ func (x *ListEvmLogsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListEvmLogsRequest.ProtoReflect.Descriptor instead.
 func (*ListEvmLogsRequest) Descriptor() ([]byte, []int) {
-	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{71}
+	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{100}
 }
 
 func (x *ListEvmLogsRequest) GetSourceId() uint32 {
@@ -3942,7 +6082,7 @@ type ListEvmLogsResponse struct {
 
 func (x *ListEvmLogsResponse) Reset() {
 	*x = ListEvmLogsResponse{}
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[72]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[101]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3954,7 +6094,7 @@ func (x *ListEvmLogsResponse) String() string {
 func (*ListEvmLogsResponse) ProtoMessage() {}
 
 func (x *ListEvmLogsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[72]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[101]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3967,7 +6107,7 @@ func (x *ListEvmLogsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListEvmLogsResponse.ProtoReflect.Descriptor instead.
 func (*ListEvmLogsResponse) Descriptor() ([]byte, []int) {
-	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{72}
+	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{101}
 }
 
 func (x *ListEvmLogsResponse) GetLogs() []*EvmLog {
@@ -3988,7 +6128,7 @@ type ListLatestEvmLogsRequest struct {
 
 func (x *ListLatestEvmLogsRequest) Reset() {
 	*x = ListLatestEvmLogsRequest{}
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[73]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[102]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4000,7 +6140,7 @@ func (x *ListLatestEvmLogsRequest) String() string {
 func (*ListLatestEvmLogsRequest) ProtoMessage() {}
 
 func (x *ListLatestEvmLogsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[73]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[102]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4013,7 +6153,7 @@ func (x *ListLatestEvmLogsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListLatestEvmLogsRequest.ProtoReflect.Descriptor instead.
 func (*ListLatestEvmLogsRequest) Descriptor() ([]byte, []int) {
-	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{73}
+	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{102}
 }
 
 func (x *ListLatestEvmLogsRequest) GetSourceId() uint32 {
@@ -4040,7 +6180,7 @@ type ListLatestEvmLogsResponse struct {
 
 func (x *ListLatestEvmLogsResponse) Reset() {
 	*x = ListLatestEvmLogsResponse{}
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[74]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[103]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4052,7 +6192,7 @@ func (x *ListLatestEvmLogsResponse) String() string {
 func (*ListLatestEvmLogsResponse) ProtoMessage() {}
 
 func (x *ListLatestEvmLogsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[74]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[103]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4065,7 +6205,7 @@ func (x *ListLatestEvmLogsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListLatestEvmLogsResponse.ProtoReflect.Descriptor instead.
 func (*ListLatestEvmLogsResponse) Descriptor() ([]byte, []int) {
-	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{74}
+	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{103}
 }
 
 func (x *ListLatestEvmLogsResponse) GetLogs() []*EvmLog {
@@ -4088,7 +6228,7 @@ type ListEvmTransactionsRequest struct {
 
 func (x *ListEvmTransactionsRequest) Reset() {
 	*x = ListEvmTransactionsRequest{}
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[75]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[104]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4100,7 +6240,7 @@ func (x *ListEvmTransactionsRequest) String() string {
 func (*ListEvmTransactionsRequest) ProtoMessage() {}
 
 func (x *ListEvmTransactionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[75]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[104]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4113,7 +6253,7 @@ func (x *ListEvmTransactionsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListEvmTransactionsRequest.ProtoReflect.Descriptor instead.
 func (*ListEvmTransactionsRequest) Descriptor() ([]byte, []int) {
-	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{75}
+	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{104}
 }
 
 func (x *ListEvmTransactionsRequest) GetSourceId() uint32 {
@@ -4147,7 +6287,7 @@ type ListEvmTransactionsResponse struct {
 
 func (x *ListEvmTransactionsResponse) Reset() {
 	*x = ListEvmTransactionsResponse{}
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[76]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[105]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4159,7 +6299,7 @@ func (x *ListEvmTransactionsResponse) String() string {
 func (*ListEvmTransactionsResponse) ProtoMessage() {}
 
 func (x *ListEvmTransactionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[76]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[105]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4172,7 +6312,7 @@ func (x *ListEvmTransactionsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListEvmTransactionsResponse.ProtoReflect.Descriptor instead.
 func (*ListEvmTransactionsResponse) Descriptor() ([]byte, []int) {
-	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{76}
+	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{105}
 }
 
 func (x *ListEvmTransactionsResponse) GetTransactions() []*EvmTransaction {
@@ -4182,6 +6322,220 @@ func (x *ListEvmTransactionsResponse) GetTransactions() []*EvmTransaction {
 	return nil
 }
 
+// EvmBlock
+type ListEvmBlocksRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SourceId  uint32 `protobuf:"varint,1,opt,name=source_id,json=sourceId,proto3" json:"source_id,omitempty"`
+	FromBlock uint64 `protobuf:"varint,2,opt,name=from_block,json=fromBlock,proto3" json:"from_block,omitempty"`
+	ToBlock   uint64 `protobuf:"varint,3,opt,name=to_block,json=toBlock,proto3" json:"to_block,omitempty"`
+}
+
+func (x *ListEvmBlocksRequest) Reset() {
+	*x = ListEvmBlocksRequest{}
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[106]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListEvmBlocksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEvmBlocksRequest) ProtoMessage() {}
+
+func (x *ListEvmBlocksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[106]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEvmBlocksRequest.ProtoReflect.Descriptor instead.
+func (*ListEvmBlocksRequest) Descriptor() ([]byte, []int) {
+	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *ListEvmBlocksRequest) GetSourceId() uint32 {
+	if x != nil {
+		return x.SourceId
+	}
+	return 0
+}
+
+func (x *ListEvmBlocksRequest) GetFromBlock() uint64 {
+	if x != nil {
+		return x.FromBlock
+	}
+	return 0
+}
+
+func (x *ListEvmBlocksRequest) GetToBlock() uint64 {
+	if x != nil {
+		return x.ToBlock
+	}
+	return 0
+}
+
+type ListEvmBlocksResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Blocks []*EvmBlock `protobuf:"bytes,1,rep,name=blocks,proto3" json:"blocks,omitempty"`
+}
+
+func (x *ListEvmBlocksResponse) Reset() {
+	*x = ListEvmBlocksResponse{}
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[107]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListEvmBlocksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEvmBlocksResponse) ProtoMessage() {}
+
+func (x *ListEvmBlocksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[107]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEvmBlocksResponse.ProtoReflect.Descriptor instead.
+func (*ListEvmBlocksResponse) Descriptor() ([]byte, []int) {
+	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *ListEvmBlocksResponse) GetBlocks() []*EvmBlock {
+	if x != nil {
+		return x.Blocks
+	}
+	return nil
+}
+
+// EvmTrace
+type ListEvmTracesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SourceId  uint32 `protobuf:"varint,1,opt,name=source_id,json=sourceId,proto3" json:"source_id,omitempty"`
+	FromBlock uint64 `protobuf:"varint,2,opt,name=from_block,json=fromBlock,proto3" json:"from_block,omitempty"`
+	ToBlock   uint64 `protobuf:"varint,3,opt,name=to_block,json=toBlock,proto3" json:"to_block,omitempty"`
+}
+
+func (x *ListEvmTracesRequest) Reset() {
+	*x = ListEvmTracesRequest{}
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[108]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListEvmTracesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEvmTracesRequest) ProtoMessage() {}
+
+func (x *ListEvmTracesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[108]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEvmTracesRequest.ProtoReflect.Descriptor instead.
+func (*ListEvmTracesRequest) Descriptor() ([]byte, []int) {
+	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *ListEvmTracesRequest) GetSourceId() uint32 {
+	if x != nil {
+		return x.SourceId
+	}
+	return 0
+}
+
+func (x *ListEvmTracesRequest) GetFromBlock() uint64 {
+	if x != nil {
+		return x.FromBlock
+	}
+	return 0
+}
+
+func (x *ListEvmTracesRequest) GetToBlock() uint64 {
+	if x != nil {
+		return x.ToBlock
+	}
+	return 0
+}
+
+type ListEvmTracesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Traces []*EvmTrace `protobuf:"bytes,1,rep,name=traces,proto3" json:"traces,omitempty"`
+}
+
+func (x *ListEvmTracesResponse) Reset() {
+	*x = ListEvmTracesResponse{}
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[109]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListEvmTracesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEvmTracesResponse) ProtoMessage() {}
+
+func (x *ListEvmTracesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[109]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEvmTracesResponse.ProtoReflect.Descriptor instead.
+func (*ListEvmTracesResponse) Descriptor() ([]byte, []int) {
+	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{109}
+}
+
+func (x *ListEvmTracesResponse) GetTraces() []*EvmTrace {
+	if x != nil {
+		return x.Traces
+	}
+	return nil
+}
+
 // Auth
 type AuthUser struct {
 	state         protoimpl.MessageState
@@ -4196,7 +6550,7 @@ type AuthUser struct {
 
 func (x *AuthUser) Reset() {
 	*x = AuthUser{}
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[77]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[110]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4208,7 +6562,7 @@ func (x *AuthUser) String() string {
 func (*AuthUser) ProtoMessage() {}
 
 func (x *AuthUser) ProtoReflect() protoreflect.Message {
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[77]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[110]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4221,7 +6575,7 @@ func (x *AuthUser) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AuthUser.ProtoReflect.Descriptor instead.
 func (*AuthUser) Descriptor() ([]byte, []int) {
-	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{77}
+	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{110}
 }
 
 func (x *AuthUser) GetId() uint32 {
@@ -4266,7 +6620,7 @@ type AccessTokenInfo struct {
 
 func (x *AccessTokenInfo) Reset() {
 	*x = AccessTokenInfo{}
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[78]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[111]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4278,7 +6632,7 @@ func (x *AccessTokenInfo) String() string {
 func (*AccessTokenInfo) ProtoMessage() {}
 
 func (x *AccessTokenInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[78]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[111]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4291,7 +6645,7 @@ func (x *AccessTokenInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AccessTokenInfo.ProtoReflect.Descriptor instead.
 func (*AccessTokenInfo) Descriptor() ([]byte, []int) {
-	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{78}
+	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{111}
 }
 
 func (x *AccessTokenInfo) GetId() uint32 {
@@ -4340,7 +6694,7 @@ type LoginRequest struct {
 
 func (x *LoginRequest) Reset() {
 	*x = LoginRequest{}
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[79]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[112]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4352,7 +6706,7 @@ func (x *LoginRequest) String() string {
 func (*LoginRequest) ProtoMessage() {}
 
 func (x *LoginRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[79]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[112]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4365,7 +6719,7 @@ func (x *LoginRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LoginRequest.ProtoReflect.Descriptor instead.
 func (*LoginRequest) Descriptor() ([]byte, []int) {
-	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{79}
+	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{112}
 }
 
 func (x *LoginRequest) GetUsername() string {
@@ -4393,7 +6747,7 @@ type LoginResponse struct {
 
 func (x *LoginResponse) Reset() {
 	*x = LoginResponse{}
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[80]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[113]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4405,7 +6759,7 @@ func (x *LoginResponse) String() string {
 func (*LoginResponse) ProtoMessage() {}
 
 func (x *LoginResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[80]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[113]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4418,7 +6772,7 @@ func (x *LoginResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LoginResponse.ProtoReflect.Descriptor instead.
 func (*LoginResponse) Descriptor() ([]byte, []int) {
-	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{80}
+	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{113}
 }
 
 func (x *LoginResponse) GetToken() string {
@@ -4443,7 +6797,7 @@ type MeRequest struct {
 
 func (x *MeRequest) Reset() {
 	*x = MeRequest{}
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[81]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[114]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4455,7 +6809,7 @@ func (x *MeRequest) String() string {
 func (*MeRequest) ProtoMessage() {}
 
 func (x *MeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[81]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[114]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4468,7 +6822,7 @@ func (x *MeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MeRequest.ProtoReflect.Descriptor instead.
 func (*MeRequest) Descriptor() ([]byte, []int) {
-	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{81}
+	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{114}
 }
 
 type MeResponse struct {
@@ -4481,7 +6835,7 @@ type MeResponse struct {
 
 func (x *MeResponse) Reset() {
 	*x = MeResponse{}
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[82]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[115]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4493,7 +6847,7 @@ func (x *MeResponse) String() string {
 func (*MeResponse) ProtoMessage() {}
 
 func (x *MeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[82]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[115]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4506,7 +6860,7 @@ func (x *MeResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MeResponse.ProtoReflect.Descriptor instead.
 func (*MeResponse) Descriptor() ([]byte, []int) {
-	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{82}
+	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{115}
 }
 
 func (x *MeResponse) GetUser() *AuthUser {
@@ -4527,7 +6881,7 @@ type CreateAccessTokenRequest struct {
 
 func (x *CreateAccessTokenRequest) Reset() {
 	*x = CreateAccessTokenRequest{}
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[83]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[116]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4539,7 +6893,7 @@ func (x *CreateAccessTokenRequest) String() string {
 func (*CreateAccessTokenRequest) ProtoMessage() {}
 
 func (x *CreateAccessTokenRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[83]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[116]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4552,7 +6906,7 @@ func (x *CreateAccessTokenRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateAccessTokenRequest.ProtoReflect.Descriptor instead.
 func (*CreateAccessTokenRequest) Descriptor() ([]byte, []int) {
-	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{83}
+	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{116}
 }
 
 func (x *CreateAccessTokenRequest) GetName() string {
@@ -4582,7 +6936,7 @@ type CreateAccessTokenResponse struct {
 
 func (x *CreateAccessTokenResponse) Reset() {
 	*x = CreateAccessTokenResponse{}
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[84]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[117]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4594,7 +6948,7 @@ func (x *CreateAccessTokenResponse) String() string {
 func (*CreateAccessTokenResponse) ProtoMessage() {}
 
 func (x *CreateAccessTokenResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[84]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[117]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4607,7 +6961,7 @@ func (x *CreateAccessTokenResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateAccessTokenResponse.ProtoReflect.Descriptor instead.
 func (*CreateAccessTokenResponse) Descriptor() ([]byte, []int) {
-	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{84}
+	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{117}
 }
 
 func (x *CreateAccessTokenResponse) GetId() uint32 {
@@ -4646,7 +7000,7 @@ type ListAccessTokensRequest struct {
 
 func (x *ListAccessTokensRequest) Reset() {
 	*x = ListAccessTokensRequest{}
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[85]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[118]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4658,7 +7012,7 @@ func (x *ListAccessTokensRequest) String() string {
 func (*ListAccessTokensRequest) ProtoMessage() {}
 
 func (x *ListAccessTokensRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[85]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[118]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4671,7 +7025,7 @@ func (x *ListAccessTokensRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListAccessTokensRequest.ProtoReflect.Descriptor instead.
 func (*ListAccessTokensRequest) Descriptor() ([]byte, []int) {
-	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{85}
+	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{118}
 }
 
 type ListAccessTokensResponse struct {
@@ -4684,7 +7038,7 @@ type ListAccessTokensResponse struct {
 
 func (x *ListAccessTokensResponse) Reset() {
 	*x = ListAccessTokensResponse{}
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[86]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[119]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4696,7 +7050,7 @@ func (x *ListAccessTokensResponse) String() string {
 func (*ListAccessTokensResponse) ProtoMessage() {}
 
 func (x *ListAccessTokensResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[86]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[119]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4709,7 +7063,7 @@ func (x *ListAccessTokensResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListAccessTokensResponse.ProtoReflect.Descriptor instead.
 func (*ListAccessTokensResponse) Descriptor() ([]byte, []int) {
-	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{86}
+	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{119}
 }
 
 func (x *ListAccessTokensResponse) GetTokens() []*AccessTokenInfo {
@@ -4729,7 +7083,7 @@ type RevokeAccessTokenRequest struct {
 
 func (x *RevokeAccessTokenRequest) Reset() {
 	*x = RevokeAccessTokenRequest{}
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[87]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[120]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4741,7 +7095,7 @@ func (x *RevokeAccessTokenRequest) String() string {
 func (*RevokeAccessTokenRequest) ProtoMessage() {}
 
 func (x *RevokeAccessTokenRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[87]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[120]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4754,7 +7108,7 @@ func (x *RevokeAccessTokenRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RevokeAccessTokenRequest.ProtoReflect.Descriptor instead.
 func (*RevokeAccessTokenRequest) Descriptor() ([]byte, []int) {
-	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{87}
+	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{120}
 }
 
 func (x *RevokeAccessTokenRequest) GetId() uint32 {
@@ -4772,7 +7126,7 @@ type RevokeAccessTokenResponse struct {
 
 func (x *RevokeAccessTokenResponse) Reset() {
 	*x = RevokeAccessTokenResponse{}
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[88]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[121]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4784,7 +7138,7 @@ func (x *RevokeAccessTokenResponse) String() string {
 func (*RevokeAccessTokenResponse) ProtoMessage() {}
 
 func (x *RevokeAccessTokenResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[88]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[121]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4797,7 +7151,7 @@ func (x *RevokeAccessTokenResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RevokeAccessTokenResponse.ProtoReflect.Descriptor instead.
 func (*RevokeAccessTokenResponse) Descriptor() ([]byte, []int) {
-	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{88}
+	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{121}
 }
 
 // OAuthProvider. client_secret is never returned; it is set via the separate
@@ -4820,7 +7174,7 @@ type OAuthProvider struct {
 
 func (x *OAuthProvider) Reset() {
 	*x = OAuthProvider{}
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[89]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[122]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4832,7 +7186,7 @@ func (x *OAuthProvider) String() string {
 func (*OAuthProvider) ProtoMessage() {}
 
 func (x *OAuthProvider) ProtoReflect() protoreflect.Message {
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[89]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[122]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4845,7 +7199,7 @@ func (x *OAuthProvider) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OAuthProvider.ProtoReflect.Descriptor instead.
 func (*OAuthProvider) Descriptor() ([]byte, []int) {
-	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{89}
+	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{122}
 }
 
 func (x *OAuthProvider) GetId() uint32 {
@@ -4922,7 +7276,7 @@ type CreateOAuthProviderRequest struct {
 
 func (x *CreateOAuthProviderRequest) Reset() {
 	*x = CreateOAuthProviderRequest{}
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[90]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[123]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4934,7 +7288,7 @@ func (x *CreateOAuthProviderRequest) String() string {
 func (*CreateOAuthProviderRequest) ProtoMessage() {}
 
 func (x *CreateOAuthProviderRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[90]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[123]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4947,7 +7301,7 @@ func (x *CreateOAuthProviderRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateOAuthProviderRequest.ProtoReflect.Descriptor instead.
 func (*CreateOAuthProviderRequest) Descriptor() ([]byte, []int) {
-	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{90}
+	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{123}
 }
 
 func (x *CreateOAuthProviderRequest) GetProvider() *OAuthProvider {
@@ -4974,7 +7328,7 @@ type CreateOAuthProviderResponse struct {
 
 func (x *CreateOAuthProviderResponse) Reset() {
 	*x = CreateOAuthProviderResponse{}
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[91]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[124]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4986,7 +7340,7 @@ func (x *CreateOAuthProviderResponse) String() string {
 func (*CreateOAuthProviderResponse) ProtoMessage() {}
 
 func (x *CreateOAuthProviderResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[91]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[124]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4999,7 +7353,7 @@ func (x *CreateOAuthProviderResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateOAuthProviderResponse.ProtoReflect.Descriptor instead.
 func (*CreateOAuthProviderResponse) Descriptor() ([]byte, []int) {
-	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{91}
+	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{124}
 }
 
 func (x *CreateOAuthProviderResponse) GetId() uint32 {
@@ -5020,7 +7374,7 @@ type UpdateOAuthProviderRequest struct {
 
 func (x *UpdateOAuthProviderRequest) Reset() {
 	*x = UpdateOAuthProviderRequest{}
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[92]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[125]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5032,7 +7386,7 @@ func (x *UpdateOAuthProviderRequest) String() string {
 func (*UpdateOAuthProviderRequest) ProtoMessage() {}
 
 func (x *UpdateOAuthProviderRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[92]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[125]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5045,7 +7399,7 @@ func (x *UpdateOAuthProviderRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateOAuthProviderRequest.ProtoReflect.Descriptor instead.
 func (*UpdateOAuthProviderRequest) Descriptor() ([]byte, []int) {
-	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{92}
+	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{125}
 }
 
 func (x *UpdateOAuthProviderRequest) GetProvider() *OAuthProvider {
@@ -5070,7 +7424,7 @@ type UpdateOAuthProviderResponse struct {
 
 func (x *UpdateOAuthProviderResponse) Reset() {
 	*x = UpdateOAuthProviderResponse{}
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[93]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[126]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5082,7 +7436,7 @@ func (x *UpdateOAuthProviderResponse) String() string {
 func (*UpdateOAuthProviderResponse) ProtoMessage() {}
 
 func (x *UpdateOAuthProviderResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[93]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[126]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5095,7 +7449,7 @@ func (x *UpdateOAuthProviderResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateOAuthProviderResponse.ProtoReflect.Descriptor instead.
 func (*UpdateOAuthProviderResponse) Descriptor() ([]byte, []int) {
-	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{93}
+	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{126}
 }
 
 type ListOAuthProvidersRequest struct {
@@ -5106,7 +7460,7 @@ type ListOAuthProvidersRequest struct {
 
 func (x *ListOAuthProvidersRequest) Reset() {
 	*x = ListOAuthProvidersRequest{}
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[94]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[127]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5118,7 +7472,7 @@ func (x *ListOAuthProvidersRequest) String() string {
 func (*ListOAuthProvidersRequest) ProtoMessage() {}
 
 func (x *ListOAuthProvidersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[94]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[127]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5131,7 +7485,7 @@ func (x *ListOAuthProvidersRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListOAuthProvidersRequest.ProtoReflect.Descriptor instead.
 func (*ListOAuthProvidersRequest) Descriptor() ([]byte, []int) {
-	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{94}
+	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{127}
 }
 
 type ListOAuthProvidersResponse struct {
@@ -5144,7 +7498,7 @@ type ListOAuthProvidersResponse struct {
 
 func (x *ListOAuthProvidersResponse) Reset() {
 	*x = ListOAuthProvidersResponse{}
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[95]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[128]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5156,7 +7510,7 @@ func (x *ListOAuthProvidersResponse) String() string {
 func (*ListOAuthProvidersResponse) ProtoMessage() {}
 
 func (x *ListOAuthProvidersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[95]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[128]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5169,7 +7523,7 @@ func (x *ListOAuthProvidersResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListOAuthProvidersResponse.ProtoReflect.Descriptor instead.
 func (*ListOAuthProvidersResponse) Descriptor() ([]byte, []int) {
-	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{95}
+	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{128}
 }
 
 func (x *ListOAuthProvidersResponse) GetProviders() []*OAuthProvider {
@@ -5189,7 +7543,7 @@ type DeleteOAuthProviderRequest struct {
 
 func (x *DeleteOAuthProviderRequest) Reset() {
 	*x = DeleteOAuthProviderRequest{}
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[96]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[129]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5201,7 +7555,7 @@ func (x *DeleteOAuthProviderRequest) String() string {
 func (*DeleteOAuthProviderRequest) ProtoMessage() {}
 
 func (x *DeleteOAuthProviderRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[96]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[129]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5214,7 +7568,7 @@ func (x *DeleteOAuthProviderRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteOAuthProviderRequest.ProtoReflect.Descriptor instead.
 func (*DeleteOAuthProviderRequest) Descriptor() ([]byte, []int) {
-	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{96}
+	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{129}
 }
 
 func (x *DeleteOAuthProviderRequest) GetId() uint32 {
@@ -5232,7 +7586,7 @@ type DeleteOAuthProviderResponse struct {
 
 func (x *DeleteOAuthProviderResponse) Reset() {
 	*x = DeleteOAuthProviderResponse{}
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[97]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[130]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5244,7 +7598,7 @@ func (x *DeleteOAuthProviderResponse) String() string {
 func (*DeleteOAuthProviderResponse) ProtoMessage() {}
 
 func (x *DeleteOAuthProviderResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[97]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[130]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5257,7 +7611,7 @@ func (x *DeleteOAuthProviderResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteOAuthProviderResponse.ProtoReflect.Descriptor instead.
 func (*DeleteOAuthProviderResponse) Descriptor() ([]byte, []int) {
-	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{97}
+	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{130}
 }
 
 // Public: the enabled providers a user can sign in with.
@@ -5273,7 +7627,7 @@ type OAuthLoginOption struct {
 
 func (x *OAuthLoginOption) Reset() {
 	*x = OAuthLoginOption{}
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[98]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[131]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5285,7 +7639,7 @@ func (x *OAuthLoginOption) String() string {
 func (*OAuthLoginOption) ProtoMessage() {}
 
 func (x *OAuthLoginOption) ProtoReflect() protoreflect.Message {
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[98]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[131]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5298,7 +7652,7 @@ func (x *OAuthLoginOption) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OAuthLoginOption.ProtoReflect.Descriptor instead.
 func (*OAuthLoginOption) Descriptor() ([]byte, []int) {
-	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{98}
+	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{131}
 }
 
 func (x *OAuthLoginOption) GetProviderId() uint32 {
@@ -5330,7 +7684,7 @@ type ListOAuthLoginUrlsRequest struct {
 
 func (x *ListOAuthLoginUrlsRequest) Reset() {
 	*x = ListOAuthLoginUrlsRequest{}
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[99]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[132]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5342,7 +7696,7 @@ func (x *ListOAuthLoginUrlsRequest) String() string {
 func (*ListOAuthLoginUrlsRequest) ProtoMessage() {}
 
 func (x *ListOAuthLoginUrlsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[99]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[132]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5355,7 +7709,7 @@ func (x *ListOAuthLoginUrlsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListOAuthLoginUrlsRequest.ProtoReflect.Descriptor instead.
 func (*ListOAuthLoginUrlsRequest) Descriptor() ([]byte, []int) {
-	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{99}
+	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{132}
 }
 
 type ListOAuthLoginUrlsResponse struct {
@@ -5368,7 +7722,7 @@ type ListOAuthLoginUrlsResponse struct {
 
 func (x *ListOAuthLoginUrlsResponse) Reset() {
 	*x = ListOAuthLoginUrlsResponse{}
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[100]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[133]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5380,7 +7734,7 @@ func (x *ListOAuthLoginUrlsResponse) String() string {
 func (*ListOAuthLoginUrlsResponse) ProtoMessage() {}
 
 func (x *ListOAuthLoginUrlsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[100]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[133]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5393,7 +7747,7 @@ func (x *ListOAuthLoginUrlsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListOAuthLoginUrlsResponse.ProtoReflect.Descriptor instead.
 func (*ListOAuthLoginUrlsResponse) Descriptor() ([]byte, []int) {
-	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{100}
+	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{133}
 }
 
 func (x *ListOAuthLoginUrlsResponse) GetOptions() []*OAuthLoginOption {
@@ -5412,7 +7766,7 @@ type ListUsersRequest struct {
 
 func (x *ListUsersRequest) Reset() {
 	*x = ListUsersRequest{}
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[101]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[134]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5424,7 +7778,7 @@ func (x *ListUsersRequest) String() string {
 func (*ListUsersRequest) ProtoMessage() {}
 
 func (x *ListUsersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[101]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[134]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5437,7 +7791,7 @@ func (x *ListUsersRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListUsersRequest.ProtoReflect.Descriptor instead.
 func (*ListUsersRequest) Descriptor() ([]byte, []int) {
-	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{101}
+	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{134}
 }
 
 type ListUsersResponse struct {
@@ -5450,7 +7804,7 @@ type ListUsersResponse struct {
 
 func (x *ListUsersResponse) Reset() {
 	*x = ListUsersResponse{}
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[102]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[135]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5462,7 +7816,7 @@ func (x *ListUsersResponse) String() string {
 func (*ListUsersResponse) ProtoMessage() {}
 
 func (x *ListUsersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[102]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[135]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5475,7 +7829,7 @@ func (x *ListUsersResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListUsersResponse.ProtoReflect.Descriptor instead.
 func (*ListUsersResponse) Descriptor() ([]byte, []int) {
-	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{102}
+	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{135}
 }
 
 func (x *ListUsersResponse) GetUsers() []*AuthUser {
@@ -5498,7 +7852,7 @@ type CreateUserRequest struct {
 
 func (x *CreateUserRequest) Reset() {
 	*x = CreateUserRequest{}
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[103]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[136]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5510,7 +7864,7 @@ func (x *CreateUserRequest) String() string {
 func (*CreateUserRequest) ProtoMessage() {}
 
 func (x *CreateUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[103]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[136]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5523,7 +7877,7 @@ func (x *CreateUserRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateUserRequest.ProtoReflect.Descriptor instead.
 func (*CreateUserRequest) Descriptor() ([]byte, []int) {
-	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{103}
+	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{136}
 }
 
 func (x *CreateUserRequest) GetUsername() string {
@@ -5564,7 +7918,7 @@ type CreateUserResponse struct {
 
 func (x *CreateUserResponse) Reset() {
 	*x = CreateUserResponse{}
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[104]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[137]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5576,7 +7930,7 @@ func (x *CreateUserResponse) String() string {
 func (*CreateUserResponse) ProtoMessage() {}
 
 func (x *CreateUserResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[104]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[137]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5589,7 +7943,7 @@ func (x *CreateUserResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateUserResponse.ProtoReflect.Descriptor instead.
 func (*CreateUserResponse) Descriptor() ([]byte, []int) {
-	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{104}
+	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{137}
 }
 
 func (x *CreateUserResponse) GetId() uint32 {
@@ -5614,7 +7968,7 @@ type UpdateUserRequest struct {
 
 func (x *UpdateUserRequest) Reset() {
 	*x = UpdateUserRequest{}
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[105]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[138]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5626,7 +7980,7 @@ func (x *UpdateUserRequest) String() string {
 func (*UpdateUserRequest) ProtoMessage() {}
 
 func (x *UpdateUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[105]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[138]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5639,7 +7993,7 @@ func (x *UpdateUserRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateUserRequest.ProtoReflect.Descriptor instead.
 func (*UpdateUserRequest) Descriptor() ([]byte, []int) {
-	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{105}
+	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{138}
 }
 
 func (x *UpdateUserRequest) GetId() uint32 {
@@ -5685,7 +8039,7 @@ type UpdateUserResponse struct {
 
 func (x *UpdateUserResponse) Reset() {
 	*x = UpdateUserResponse{}
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[106]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[139]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5697,7 +8051,7 @@ func (x *UpdateUserResponse) String() string {
 func (*UpdateUserResponse) ProtoMessage() {}
 
 func (x *UpdateUserResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[106]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[139]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5710,7 +8064,7 @@ func (x *UpdateUserResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateUserResponse.ProtoReflect.Descriptor instead.
 func (*UpdateUserResponse) Descriptor() ([]byte, []int) {
-	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{106}
+	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{139}
 }
 
 type DeleteUserRequest struct {
@@ -5723,7 +8077,7 @@ type DeleteUserRequest struct {
 
 func (x *DeleteUserRequest) Reset() {
 	*x = DeleteUserRequest{}
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[107]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[140]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5735,7 +8089,7 @@ func (x *DeleteUserRequest) String() string {
 func (*DeleteUserRequest) ProtoMessage() {}
 
 func (x *DeleteUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[107]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[140]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5748,7 +8102,7 @@ func (x *DeleteUserRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteUserRequest.ProtoReflect.Descriptor instead.
 func (*DeleteUserRequest) Descriptor() ([]byte, []int) {
-	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{107}
+	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{140}
 }
 
 func (x *DeleteUserRequest) GetId() uint32 {
@@ -5766,7 +8120,7 @@ type DeleteUserResponse struct {
 
 func (x *DeleteUserResponse) Reset() {
 	*x = DeleteUserResponse{}
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[108]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[141]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5778,7 +8132,7 @@ func (x *DeleteUserResponse) String() string {
 func (*DeleteUserResponse) ProtoMessage() {}
 
 func (x *DeleteUserResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[108]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[141]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5791,7 +8145,7 @@ func (x *DeleteUserResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteUserResponse.ProtoReflect.Descriptor instead.
 func (*DeleteUserResponse) Descriptor() ([]byte, []int) {
-	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{108}
+	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{141}
 }
 
 // EvmiExporter
@@ -5804,6 +8158,8 @@ type EvmiExporter struct {
 	Name             string  `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 	EvmLogPipelineId uint32  `protobuf:"varint,3,opt,name=evm_log_pipeline_id,json=evmLogPipelineId,proto3" json:"evm_log_pipeline_id,omitempty"`
 	Enabled          bool    `protobuf:"varint,4,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// RUNNING, STOPPED, FAILED or COMPLETED (every log of the pipeline's
+	// sources delivered, all of them completed).
 	Status           string  `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
 	StartBlock       uint64  `protobuf:"varint,6,opt,name=start_block,json=startBlock,proto3" json:"start_block,omitempty"`
 	SyncBlock        uint64  `protobuf:"varint,7,opt,name=sync_block,json=syncBlock,proto3" json:"sync_block,omitempty"`
@@ -5817,7 +8173,7 @@ type EvmiExporter struct {
 
 func (x *EvmiExporter) Reset() {
 	*x = EvmiExporter{}
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[109]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[142]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5829,7 +8185,7 @@ func (x *EvmiExporter) String() string {
 func (*EvmiExporter) ProtoMessage() {}
 
 func (x *EvmiExporter) ProtoReflect() protoreflect.Message {
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[109]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[142]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5842,7 +8198,7 @@ func (x *EvmiExporter) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EvmiExporter.ProtoReflect.Descriptor instead.
 func (*EvmiExporter) Descriptor() ([]byte, []int) {
-	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{109}
+	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{142}
 }
 
 func (x *EvmiExporter) GetId() uint32 {
@@ -5960,7 +8316,7 @@ type Plugin struct {
 
 func (x *Plugin) Reset() {
 	*x = Plugin{}
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[110]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[143]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5972,7 +8328,7 @@ func (x *Plugin) String() string {
 func (*Plugin) ProtoMessage() {}
 
 func (x *Plugin) ProtoReflect() protoreflect.Message {
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[110]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[143]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5985,7 +8341,7 @@ func (x *Plugin) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Plugin.ProtoReflect.Descriptor instead.
 func (*Plugin) Descriptor() ([]byte, []int) {
-	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{110}
+	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{143}
 }
 
 func (x *Plugin) GetId() uint32 {
@@ -6082,7 +8438,7 @@ type CreatePluginRequest struct {
 
 func (x *CreatePluginRequest) Reset() {
 	*x = CreatePluginRequest{}
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[111]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[144]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6094,7 +8450,7 @@ func (x *CreatePluginRequest) String() string {
 func (*CreatePluginRequest) ProtoMessage() {}
 
 func (x *CreatePluginRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[111]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[144]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6107,7 +8463,7 @@ func (x *CreatePluginRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreatePluginRequest.ProtoReflect.Descriptor instead.
 func (*CreatePluginRequest) Descriptor() ([]byte, []int) {
-	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{111}
+	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{144}
 }
 
 func (x *CreatePluginRequest) GetPlugin() *Plugin {
@@ -6127,7 +8483,7 @@ type CreatePluginResponse struct {
 
 func (x *CreatePluginResponse) Reset() {
 	*x = CreatePluginResponse{}
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[112]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[145]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6139,7 +8495,7 @@ func (x *CreatePluginResponse) String() string {
 func (*CreatePluginResponse) ProtoMessage() {}
 
 func (x *CreatePluginResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[112]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[145]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6152,7 +8508,7 @@ func (x *CreatePluginResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreatePluginResponse.ProtoReflect.Descriptor instead.
 func (*CreatePluginResponse) Descriptor() ([]byte, []int) {
-	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{112}
+	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{145}
 }
 
 func (x *CreatePluginResponse) GetId() uint32 {
@@ -6172,7 +8528,7 @@ type GetPluginRequest struct {
 
 func (x *GetPluginRequest) Reset() {
 	*x = GetPluginRequest{}
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[113]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[146]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6184,7 +8540,7 @@ func (x *GetPluginRequest) String() string {
 func (*GetPluginRequest) ProtoMessage() {}
 
 func (x *GetPluginRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[113]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[146]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6197,7 +8553,7 @@ func (x *GetPluginRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetPluginRequest.ProtoReflect.Descriptor instead.
 func (*GetPluginRequest) Descriptor() ([]byte, []int) {
-	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{113}
+	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{146}
 }
 
 func (x *GetPluginRequest) GetId() uint32 {
@@ -6217,7 +8573,7 @@ type GetPluginResponse struct {
 
 func (x *GetPluginResponse) Reset() {
 	*x = GetPluginResponse{}
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[114]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[147]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6229,7 +8585,7 @@ func (x *GetPluginResponse) String() string {
 func (*GetPluginResponse) ProtoMessage() {}
 
 func (x *GetPluginResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[114]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[147]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6242,7 +8598,7 @@ func (x *GetPluginResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetPluginResponse.ProtoReflect.Descriptor instead.
 func (*GetPluginResponse) Descriptor() ([]byte, []int) {
-	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{114}
+	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{147}
 }
 
 func (x *GetPluginResponse) GetPlugin() *Plugin {
@@ -6262,7 +8618,7 @@ type UpdatePluginRequest struct {
 
 func (x *UpdatePluginRequest) Reset() {
 	*x = UpdatePluginRequest{}
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[115]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[148]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6274,7 +8630,7 @@ func (x *UpdatePluginRequest) String() string {
 func (*UpdatePluginRequest) ProtoMessage() {}
 
 func (x *UpdatePluginRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[115]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[148]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6287,7 +8643,7 @@ func (x *UpdatePluginRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdatePluginRequest.ProtoReflect.Descriptor instead.
 func (*UpdatePluginRequest) Descriptor() ([]byte, []int) {
-	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{115}
+	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{148}
 }
 
 func (x *UpdatePluginRequest) GetPlugin() *Plugin {
@@ -6305,7 +8661,7 @@ type UpdatePluginResponse struct {
 
 func (x *UpdatePluginResponse) Reset() {
 	*x = UpdatePluginResponse{}
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[116]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[149]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6317,7 +8673,7 @@ func (x *UpdatePluginResponse) String() string {
 func (*UpdatePluginResponse) ProtoMessage() {}
 
 func (x *UpdatePluginResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[116]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[149]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6330,7 +8686,7 @@ func (x *UpdatePluginResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdatePluginResponse.ProtoReflect.Descriptor instead.
 func (*UpdatePluginResponse) Descriptor() ([]byte, []int) {
-	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{116}
+	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{149}
 }
 
 type ListPluginsRequest struct {
@@ -6343,7 +8699,7 @@ type ListPluginsRequest struct {
 
 func (x *ListPluginsRequest) Reset() {
 	*x = ListPluginsRequest{}
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[117]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[150]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6355,7 +8711,7 @@ func (x *ListPluginsRequest) String() string {
 func (*ListPluginsRequest) ProtoMessage() {}
 
 func (x *ListPluginsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[117]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[150]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6368,7 +8724,7 @@ func (x *ListPluginsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListPluginsRequest.ProtoReflect.Descriptor instead.
 func (*ListPluginsRequest) Descriptor() ([]byte, []int) {
-	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{117}
+	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{150}
 }
 
 func (x *ListPluginsRequest) GetPagination() *Pagination {
@@ -6388,7 +8744,7 @@ type ListPluginsResponse struct {
 
 func (x *ListPluginsResponse) Reset() {
 	*x = ListPluginsResponse{}
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[118]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[151]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6400,7 +8756,7 @@ func (x *ListPluginsResponse) String() string {
 func (*ListPluginsResponse) ProtoMessage() {}
 
 func (x *ListPluginsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[118]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[151]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6413,7 +8769,7 @@ func (x *ListPluginsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListPluginsResponse.ProtoReflect.Descriptor instead.
 func (*ListPluginsResponse) Descriptor() ([]byte, []int) {
-	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{118}
+	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{151}
 }
 
 func (x *ListPluginsResponse) GetPlugins() []*Plugin {
@@ -6433,7 +8789,7 @@ type DeletePluginRequest struct {
 
 func (x *DeletePluginRequest) Reset() {
 	*x = DeletePluginRequest{}
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[119]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[152]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6445,7 +8801,7 @@ func (x *DeletePluginRequest) String() string {
 func (*DeletePluginRequest) ProtoMessage() {}
 
 func (x *DeletePluginRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[119]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[152]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6458,7 +8814,7 @@ func (x *DeletePluginRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeletePluginRequest.ProtoReflect.Descriptor instead.
 func (*DeletePluginRequest) Descriptor() ([]byte, []int) {
-	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{119}
+	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{152}
 }
 
 func (x *DeletePluginRequest) GetId() uint32 {
@@ -6476,7 +8832,7 @@ type DeletePluginResponse struct {
 
 func (x *DeletePluginResponse) Reset() {
 	*x = DeletePluginResponse{}
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[120]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[153]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6488,7 +8844,7 @@ func (x *DeletePluginResponse) String() string {
 func (*DeletePluginResponse) ProtoMessage() {}
 
 func (x *DeletePluginResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[120]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[153]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6501,7 +8857,7 @@ func (x *DeletePluginResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeletePluginResponse.ProtoReflect.Descriptor instead.
 func (*DeletePluginResponse) Descriptor() ([]byte, []int) {
-	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{120}
+	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{153}
 }
 
 type InstallPluginRequest struct {
@@ -6514,7 +8870,7 @@ type InstallPluginRequest struct {
 
 func (x *InstallPluginRequest) Reset() {
 	*x = InstallPluginRequest{}
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[121]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[154]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6526,7 +8882,7 @@ func (x *InstallPluginRequest) String() string {
 func (*InstallPluginRequest) ProtoMessage() {}
 
 func (x *InstallPluginRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[121]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[154]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6539,7 +8895,7 @@ func (x *InstallPluginRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InstallPluginRequest.ProtoReflect.Descriptor instead.
 func (*InstallPluginRequest) Descriptor() ([]byte, []int) {
-	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{121}
+	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{154}
 }
 
 func (x *InstallPluginRequest) GetId() uint32 {
@@ -6561,7 +8917,7 @@ type InstallPluginResponse struct {
 
 func (x *InstallPluginResponse) Reset() {
 	*x = InstallPluginResponse{}
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[122]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[155]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6573,7 +8929,7 @@ func (x *InstallPluginResponse) String() string {
 func (*InstallPluginResponse) ProtoMessage() {}
 
 func (x *InstallPluginResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[122]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[155]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6586,7 +8942,7 @@ func (x *InstallPluginResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InstallPluginResponse.ProtoReflect.Descriptor instead.
 func (*InstallPluginResponse) Descriptor() ([]byte, []int) {
-	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{122}
+	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{155}
 }
 
 func (x *InstallPluginResponse) GetSuccess() bool {
@@ -6622,7 +8978,7 @@ type ListPluginGitRefsRequest struct {
 
 func (x *ListPluginGitRefsRequest) Reset() {
 	*x = ListPluginGitRefsRequest{}
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[123]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[156]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6634,7 +8990,7 @@ func (x *ListPluginGitRefsRequest) String() string {
 func (*ListPluginGitRefsRequest) ProtoMessage() {}
 
 func (x *ListPluginGitRefsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[123]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[156]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6647,7 +9003,7 @@ func (x *ListPluginGitRefsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListPluginGitRefsRequest.ProtoReflect.Descriptor instead.
 func (*ListPluginGitRefsRequest) Descriptor() ([]byte, []int) {
-	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{123}
+	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{156}
 }
 
 func (x *ListPluginGitRefsRequest) GetGitUrl() string {
@@ -6668,7 +9024,7 @@ type ListPluginGitRefsResponse struct {
 
 func (x *ListPluginGitRefsResponse) Reset() {
 	*x = ListPluginGitRefsResponse{}
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[124]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[157]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6680,7 +9036,7 @@ func (x *ListPluginGitRefsResponse) String() string {
 func (*ListPluginGitRefsResponse) ProtoMessage() {}
 
 func (x *ListPluginGitRefsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[124]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[157]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6693,7 +9049,7 @@ func (x *ListPluginGitRefsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListPluginGitRefsResponse.ProtoReflect.Descriptor instead.
 func (*ListPluginGitRefsResponse) Descriptor() ([]byte, []int) {
-	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{124}
+	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{157}
 }
 
 func (x *ListPluginGitRefsResponse) GetBranches() []string {
@@ -6720,7 +9076,7 @@ type CreateEvmiExporterRequest struct {
 
 func (x *CreateEvmiExporterRequest) Reset() {
 	*x = CreateEvmiExporterRequest{}
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[125]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[158]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6732,7 +9088,7 @@ func (x *CreateEvmiExporterRequest) String() string {
 func (*CreateEvmiExporterRequest) ProtoMessage() {}
 
 func (x *CreateEvmiExporterRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[125]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[158]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6745,7 +9101,7 @@ func (x *CreateEvmiExporterRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateEvmiExporterRequest.ProtoReflect.Descriptor instead.
 func (*CreateEvmiExporterRequest) Descriptor() ([]byte, []int) {
-	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{125}
+	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{158}
 }
 
 func (x *CreateEvmiExporterRequest) GetExporter() *EvmiExporter {
@@ -6765,7 +9121,7 @@ type CreateEvmiExporterResponse struct {
 
 func (x *CreateEvmiExporterResponse) Reset() {
 	*x = CreateEvmiExporterResponse{}
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[126]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[159]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6777,7 +9133,7 @@ func (x *CreateEvmiExporterResponse) String() string {
 func (*CreateEvmiExporterResponse) ProtoMessage() {}
 
 func (x *CreateEvmiExporterResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[126]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[159]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6790,7 +9146,7 @@ func (x *CreateEvmiExporterResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateEvmiExporterResponse.ProtoReflect.Descriptor instead.
 func (*CreateEvmiExporterResponse) Descriptor() ([]byte, []int) {
-	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{126}
+	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{159}
 }
 
 func (x *CreateEvmiExporterResponse) GetId() uint32 {
@@ -6810,7 +9166,7 @@ type GetEvmiExporterRequest struct {
 
 func (x *GetEvmiExporterRequest) Reset() {
 	*x = GetEvmiExporterRequest{}
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[127]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[160]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6822,7 +9178,7 @@ func (x *GetEvmiExporterRequest) String() string {
 func (*GetEvmiExporterRequest) ProtoMessage() {}
 
 func (x *GetEvmiExporterRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[127]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[160]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6835,7 +9191,7 @@ func (x *GetEvmiExporterRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetEvmiExporterRequest.ProtoReflect.Descriptor instead.
 func (*GetEvmiExporterRequest) Descriptor() ([]byte, []int) {
-	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{127}
+	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{160}
 }
 
 func (x *GetEvmiExporterRequest) GetId() uint32 {
@@ -6855,7 +9211,7 @@ type GetEvmiExporterResponse struct {
 
 func (x *GetEvmiExporterResponse) Reset() {
 	*x = GetEvmiExporterResponse{}
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[128]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[161]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6867,7 +9223,7 @@ func (x *GetEvmiExporterResponse) String() string {
 func (*GetEvmiExporterResponse) ProtoMessage() {}
 
 func (x *GetEvmiExporterResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[128]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[161]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6880,7 +9236,7 @@ func (x *GetEvmiExporterResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetEvmiExporterResponse.ProtoReflect.Descriptor instead.
 func (*GetEvmiExporterResponse) Descriptor() ([]byte, []int) {
-	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{128}
+	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{161}
 }
 
 func (x *GetEvmiExporterResponse) GetExporter() *EvmiExporter {
@@ -6900,7 +9256,7 @@ type UpdateEvmiExporterRequest struct {
 
 func (x *UpdateEvmiExporterRequest) Reset() {
 	*x = UpdateEvmiExporterRequest{}
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[129]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[162]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6912,7 +9268,7 @@ func (x *UpdateEvmiExporterRequest) String() string {
 func (*UpdateEvmiExporterRequest) ProtoMessage() {}
 
 func (x *UpdateEvmiExporterRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[129]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[162]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6925,7 +9281,7 @@ func (x *UpdateEvmiExporterRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateEvmiExporterRequest.ProtoReflect.Descriptor instead.
 func (*UpdateEvmiExporterRequest) Descriptor() ([]byte, []int) {
-	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{129}
+	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{162}
 }
 
 func (x *UpdateEvmiExporterRequest) GetExporter() *EvmiExporter {
@@ -6943,7 +9299,7 @@ type UpdateEvmiExporterResponse struct {
 
 func (x *UpdateEvmiExporterResponse) Reset() {
 	*x = UpdateEvmiExporterResponse{}
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[130]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[163]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6955,7 +9311,7 @@ func (x *UpdateEvmiExporterResponse) String() string {
 func (*UpdateEvmiExporterResponse) ProtoMessage() {}
 
 func (x *UpdateEvmiExporterResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[130]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[163]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6968,7 +9324,7 @@ func (x *UpdateEvmiExporterResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateEvmiExporterResponse.ProtoReflect.Descriptor instead.
 func (*UpdateEvmiExporterResponse) Descriptor() ([]byte, []int) {
-	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{130}
+	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{163}
 }
 
 type ListEvmiExportersRequest struct {
@@ -6982,7 +9338,7 @@ type ListEvmiExportersRequest struct {
 
 func (x *ListEvmiExportersRequest) Reset() {
 	*x = ListEvmiExportersRequest{}
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[131]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[164]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6994,7 +9350,7 @@ func (x *ListEvmiExportersRequest) String() string {
 func (*ListEvmiExportersRequest) ProtoMessage() {}
 
 func (x *ListEvmiExportersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[131]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[164]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7007,7 +9363,7 @@ func (x *ListEvmiExportersRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListEvmiExportersRequest.ProtoReflect.Descriptor instead.
 func (*ListEvmiExportersRequest) Descriptor() ([]byte, []int) {
-	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{131}
+	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{164}
 }
 
 func (x *ListEvmiExportersRequest) GetPagination() *Pagination {
@@ -7034,7 +9390,7 @@ type ListEvmiExportersResponse struct {
 
 func (x *ListEvmiExportersResponse) Reset() {
 	*x = ListEvmiExportersResponse{}
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[132]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[165]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7046,7 +9402,7 @@ func (x *ListEvmiExportersResponse) String() string {
 func (*ListEvmiExportersResponse) ProtoMessage() {}
 
 func (x *ListEvmiExportersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[132]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[165]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7059,7 +9415,7 @@ func (x *ListEvmiExportersResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListEvmiExportersResponse.ProtoReflect.Descriptor instead.
 func (*ListEvmiExportersResponse) Descriptor() ([]byte, []int) {
-	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{132}
+	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{165}
 }
 
 func (x *ListEvmiExportersResponse) GetExporters() []*EvmiExporter {
@@ -7079,7 +9435,7 @@ type DeleteEvmiExporterRequest struct {
 
 func (x *DeleteEvmiExporterRequest) Reset() {
 	*x = DeleteEvmiExporterRequest{}
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[133]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[166]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7091,7 +9447,7 @@ func (x *DeleteEvmiExporterRequest) String() string {
 func (*DeleteEvmiExporterRequest) ProtoMessage() {}
 
 func (x *DeleteEvmiExporterRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[133]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[166]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7104,7 +9460,7 @@ func (x *DeleteEvmiExporterRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteEvmiExporterRequest.ProtoReflect.Descriptor instead.
 func (*DeleteEvmiExporterRequest) Descriptor() ([]byte, []int) {
-	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{133}
+	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{166}
 }
 
 func (x *DeleteEvmiExporterRequest) GetId() uint32 {
@@ -7122,7 +9478,7 @@ type DeleteEvmiExporterResponse struct {
 
 func (x *DeleteEvmiExporterResponse) Reset() {
 	*x = DeleteEvmiExporterResponse{}
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[134]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[167]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7134,7 +9490,7 @@ func (x *DeleteEvmiExporterResponse) String() string {
 func (*DeleteEvmiExporterResponse) ProtoMessage() {}
 
 func (x *DeleteEvmiExporterResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[134]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[167]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7147,7 +9503,7 @@ func (x *DeleteEvmiExporterResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteEvmiExporterResponse.ProtoReflect.Descriptor instead.
 func (*DeleteEvmiExporterResponse) Descriptor() ([]byte, []int) {
-	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{134}
+	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{167}
 }
 
 type StartExporterRequest struct {
@@ -7160,7 +9516,7 @@ type StartExporterRequest struct {
 
 func (x *StartExporterRequest) Reset() {
 	*x = StartExporterRequest{}
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[135]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[168]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7172,7 +9528,7 @@ func (x *StartExporterRequest) String() string {
 func (*StartExporterRequest) ProtoMessage() {}
 
 func (x *StartExporterRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[135]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[168]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7185,7 +9541,7 @@ func (x *StartExporterRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StartExporterRequest.ProtoReflect.Descriptor instead.
 func (*StartExporterRequest) Descriptor() ([]byte, []int) {
-	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{135}
+	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{168}
 }
 
 func (x *StartExporterRequest) GetId() uint32 {
@@ -7206,7 +9562,7 @@ type StartExporterResponse struct {
 
 func (x *StartExporterResponse) Reset() {
 	*x = StartExporterResponse{}
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[136]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[169]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7218,7 +9574,7 @@ func (x *StartExporterResponse) String() string {
 func (*StartExporterResponse) ProtoMessage() {}
 
 func (x *StartExporterResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[136]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[169]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7231,7 +9587,7 @@ func (x *StartExporterResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StartExporterResponse.ProtoReflect.Descriptor instead.
 func (*StartExporterResponse) Descriptor() ([]byte, []int) {
-	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{136}
+	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{169}
 }
 
 func (x *StartExporterResponse) GetSuccess() bool {
@@ -7258,7 +9614,7 @@ type StopExporterRequest struct {
 
 func (x *StopExporterRequest) Reset() {
 	*x = StopExporterRequest{}
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[137]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[170]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7270,7 +9626,7 @@ func (x *StopExporterRequest) String() string {
 func (*StopExporterRequest) ProtoMessage() {}
 
 func (x *StopExporterRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[137]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[170]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7283,7 +9639,7 @@ func (x *StopExporterRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StopExporterRequest.ProtoReflect.Descriptor instead.
 func (*StopExporterRequest) Descriptor() ([]byte, []int) {
-	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{137}
+	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{170}
 }
 
 func (x *StopExporterRequest) GetId() uint32 {
@@ -7304,7 +9660,7 @@ type StopExporterResponse struct {
 
 func (x *StopExporterResponse) Reset() {
 	*x = StopExporterResponse{}
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[138]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[171]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7316,7 +9672,7 @@ func (x *StopExporterResponse) String() string {
 func (*StopExporterResponse) ProtoMessage() {}
 
 func (x *StopExporterResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[138]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[171]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7329,7 +9685,7 @@ func (x *StopExporterResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StopExporterResponse.ProtoReflect.Descriptor instead.
 func (*StopExporterResponse) Descriptor() ([]byte, []int) {
-	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{138}
+	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{171}
 }
 
 func (x *StopExporterResponse) GetSuccess() bool {
@@ -7357,7 +9713,7 @@ type StreamEvmiExporterUpdatesRequest struct {
 
 func (x *StreamEvmiExporterUpdatesRequest) Reset() {
 	*x = StreamEvmiExporterUpdatesRequest{}
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[139]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[172]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7369,7 +9725,7 @@ func (x *StreamEvmiExporterUpdatesRequest) String() string {
 func (*StreamEvmiExporterUpdatesRequest) ProtoMessage() {}
 
 func (x *StreamEvmiExporterUpdatesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[139]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[172]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7382,7 +9738,7 @@ func (x *StreamEvmiExporterUpdatesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StreamEvmiExporterUpdatesRequest.ProtoReflect.Descriptor instead.
 func (*StreamEvmiExporterUpdatesRequest) Descriptor() ([]byte, []int) {
-	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{139}
+	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{172}
 }
 
 func (x *StreamEvmiExporterUpdatesRequest) GetPipelineId() uint32 {
@@ -7400,7 +9756,7 @@ type ExportConfigurationRequest struct {
 
 func (x *ExportConfigurationRequest) Reset() {
 	*x = ExportConfigurationRequest{}
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[140]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[173]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7412,7 +9768,7 @@ func (x *ExportConfigurationRequest) String() string {
 func (*ExportConfigurationRequest) ProtoMessage() {}
 
 func (x *ExportConfigurationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[140]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[173]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7425,7 +9781,7 @@ func (x *ExportConfigurationRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ExportConfigurationRequest.ProtoReflect.Descriptor instead.
 func (*ExportConfigurationRequest) Descriptor() ([]byte, []int) {
-	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{140}
+	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{173}
 }
 
 type ExportConfigurationResponse struct {
@@ -7442,7 +9798,7 @@ type ExportConfigurationResponse struct {
 
 func (x *ExportConfigurationResponse) Reset() {
 	*x = ExportConfigurationResponse{}
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[141]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[174]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7454,7 +9810,7 @@ func (x *ExportConfigurationResponse) String() string {
 func (*ExportConfigurationResponse) ProtoMessage() {}
 
 func (x *ExportConfigurationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[141]
+	mi := &file_evm_indexer_v1_evm_indexer_proto_msgTypes[174]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7467,7 +9823,7 @@ func (x *ExportConfigurationResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ExportConfigurationResponse.ProtoReflect.Descriptor instead.
 func (*ExportConfigurationResponse) Descriptor() ([]byte, []int) {
-	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{141}
+	return file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP(), []int{174}
 }
 
 func (x *ExportConfigurationResponse) GetConfigJson() string {
@@ -7500,7 +9856,7 @@ var file_evm_indexer_v1_evm_indexer_proto_rawDesc = []byte{
 	0x01, 0x42, 0x05, 0x0a, 0x03, 0x5f, 0x69, 0x64, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x63, 0x72, 0x65,
 	0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x75, 0x70, 0x64, 0x61,
 	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x64, 0x5f, 0x61, 0x74, 0x22, 0xa0, 0x03, 0x0a, 0x0d, 0x45, 0x76, 0x6d, 0x42, 0x6c, 0x6f,
+	0x65, 0x64, 0x5f, 0x61, 0x74, 0x22, 0xfa, 0x07, 0x0a, 0x0d, 0x45, 0x76, 0x6d, 0x42, 0x6c, 0x6f,
 	0x63, 0x6b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x12, 0x13, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
 	0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x02, 0x69, 0x64, 0x88, 0x01, 0x01, 0x12, 0x19, 0x0a, 0x08,
 	0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07,
@@ -7523,139 +9879,241 @@ var file_evm_indexer_v1_evm_indexer_proto_rawDesc = []byte{
 	0x48, 0x02, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01,
 	0x12, 0x22, 0x0a, 0x0a, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0b,
 	0x20, 0x01, 0x28, 0x0d, 0x48, 0x03, 0x52, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x09, 0x68, 0x65, 0x61, 0x64, 0x5f, 0x6d, 0x6f, 0x64,
+	0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x68, 0x65, 0x61, 0x64, 0x4d, 0x6f, 0x64,
+	0x65, 0x12, 0x2d, 0x0a, 0x12, 0x68, 0x65, 0x61, 0x64, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72,
+	0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x68,
+	0x65, 0x61, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x12, 0x32, 0x0a, 0x15, 0x73, 0x71, 0x64, 0x5f, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x5f,
+	0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x13, 0x73, 0x71, 0x64, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x41, 0x76, 0x61, 0x69, 0x6c,
+	0x61, 0x62, 0x6c, 0x65, 0x12, 0x26, 0x0a, 0x0f, 0x73, 0x71, 0x64, 0x5f, 0x67, 0x61, 0x74, 0x65,
+	0x77, 0x61, 0x79, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x73,
+	0x71, 0x64, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x55, 0x72, 0x6c, 0x12, 0x43, 0x0a, 0x0d,
+	0x72, 0x70, 0x63, 0x5f, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x10, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x6d, 0x52, 0x70, 0x63, 0x45, 0x6e, 0x64, 0x70, 0x6f,
+	0x69, 0x6e, 0x74, 0x52, 0x0c, 0x72, 0x70, 0x63, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+	0x73, 0x12, 0x15, 0x0a, 0x06, 0x77, 0x73, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x11, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x77, 0x73, 0x55, 0x72, 0x6c, 0x12, 0x2a, 0x0a, 0x11, 0x77, 0x73, 0x5f, 0x73,
+	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x5f, 0x6c, 0x6f, 0x67, 0x73, 0x18, 0x12, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0f, 0x77, 0x73, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
+	0x4c, 0x6f, 0x67, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x6c, 0x6f, 0x67, 0x73, 0x5f, 0x6d, 0x61, 0x78,
+	0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x13, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x10, 0x6c, 0x6f, 0x67, 0x73, 0x4d, 0x61, 0x78, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x65, 0x73, 0x12, 0x34, 0x0a, 0x16, 0x74, 0x72, 0x61, 0x63, 0x65, 0x5f, 0x66, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x5f, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x14, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x14, 0x74, 0x72, 0x61, 0x63, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x41,
+	0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x24, 0x0a, 0x0e, 0x72, 0x70, 0x63, 0x5f,
+	0x72, 0x61, 0x74, 0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x15, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x0c, 0x72, 0x70, 0x63, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x5b,
+	0x0a, 0x10, 0x72, 0x70, 0x63, 0x5f, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x5f, 0x63, 0x6f, 0x73,
+	0x74, 0x73, 0x18, 0x16, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69,
+	0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x6d, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x52, 0x70, 0x63, 0x4d, 0x65, 0x74, 0x68, 0x6f,
+	0x64, 0x43, 0x6f, 0x73, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0e, 0x72, 0x70, 0x63,
+	0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x43, 0x6f, 0x73, 0x74, 0x73, 0x1a, 0x41, 0x0a, 0x13, 0x52,
+	0x70, 0x63, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x43, 0x6f, 0x73, 0x74, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0x05,
+	0x0a, 0x03, 0x5f, 0x69, 0x64, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x5f, 0x61, 0x74, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f,
+	0x61, 0x74, 0x22, 0x9f, 0x02, 0x0a, 0x0e, 0x45, 0x76, 0x6d, 0x52, 0x70, 0x63, 0x45, 0x6e, 0x64,
+	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x13, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0d, 0x48, 0x00, 0x52, 0x02, 0x69, 0x64, 0x88, 0x01, 0x01, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72,
+	0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x16, 0x0a, 0x06,
+	0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x77, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x79, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x79, 0x12, 0x1d,
+	0x0a, 0x0a, 0x68, 0x65, 0x61, 0x64, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x09, 0x68, 0x65, 0x61, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x1d, 0x0a,
+	0x0a, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x6d, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x09, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4d, 0x73, 0x12, 0x1d, 0x0a, 0x0a,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x61, 0x74, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x6c,
+	0x61, 0x73, 0x74, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x6c, 0x61, 0x73, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x22, 0x0a, 0x0a, 0x63, 0x68,
+	0x65, 0x63, 0x6b, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x01,
+	0x52, 0x09, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x42, 0x05,
+	0x0a, 0x03, 0x5f, 0x69, 0x64, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x65,
+	0x64, 0x5f, 0x61, 0x74, 0x22, 0x80, 0x02, 0x0a, 0x0a, 0x45, 0x76, 0x6d, 0x4a, 0x73, 0x6f, 0x6e,
+	0x41, 0x62, 0x69, 0x12, 0x13, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x48,
+	0x00, 0x52, 0x02, 0x69, 0x64, 0x88, 0x01, 0x01, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x74,
+	0x72, 0x61, 0x63, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a,
+	0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x22, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x01, 0x52, 0x09, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x22, 0x0a, 0x0a, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x48,
+	0x02, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12,
+	0x22, 0x0a, 0x0a, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0d, 0x48, 0x03, 0x52, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x41, 0x74,
+	0x88, 0x01, 0x01, 0x42, 0x05, 0x0a, 0x03, 0x5f, 0x69, 0x64, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x75, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x64, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x22, 0xcf, 0x02, 0x0a, 0x0b, 0x45, 0x76, 0x6d, 0x4c,
+	0x6f, 0x67, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x12, 0x13, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x02, 0x69, 0x64, 0x88, 0x01, 0x01, 0x12, 0x1e, 0x0a, 0x0a,
+	0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x20, 0x0a, 0x0b,
+	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d,
+	0x0a, 0x0a, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x2a, 0x0a,
+	0x11, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x6a, 0x73,
+	0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x4a, 0x73, 0x6f, 0x6e, 0x12, 0x22, 0x0a, 0x0a, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x01, 0x52,
+	0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x22, 0x0a,
+	0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x0d, 0x48, 0x02, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01,
+	0x01, 0x12, 0x22, 0x0a, 0x0a, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x03, 0x52, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64,
+	0x41, 0x74, 0x88, 0x01, 0x01, 0x42, 0x05, 0x0a, 0x03, 0x5f, 0x69, 0x64, 0x42, 0x0d, 0x0a, 0x0b,
+	0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x42, 0x0d, 0x0a, 0x0b, 0x5f,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x64,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x22, 0xac, 0x02, 0x0a, 0x0e, 0x45, 0x76,
+	0x6d, 0x4c, 0x6f, 0x67, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x13, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x02, 0x69, 0x64, 0x88, 0x01,
+	0x01, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x28, 0x0a, 0x10, 0x65, 0x76, 0x6d, 0x69, 0x5f, 0x69, 0x6e,
+	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x0e, 0x65, 0x76, 0x6d, 0x69, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12,
+	0x27, 0x0a, 0x10, 0x65, 0x76, 0x6d, 0x5f, 0x6c, 0x6f, 0x67, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x65,
+	0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x65, 0x76, 0x6d, 0x4c, 0x6f,
+	0x67, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x01, 0x52, 0x09,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x22, 0x0a, 0x0a,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d,
+	0x48, 0x02, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01,
+	0x12, 0x22, 0x0a, 0x0a, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x0d, 0x48, 0x03, 0x52, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x41,
 	0x74, 0x88, 0x01, 0x01, 0x42, 0x05, 0x0a, 0x03, 0x5f, 0x69, 0x64, 0x42, 0x0d, 0x0a, 0x0b, 0x5f,
 	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x75,
 	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x64, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x22, 0x80, 0x02, 0x0a, 0x0a, 0x45, 0x76, 0x6d,
-	0x4a, 0x73, 0x6f, 0x6e, 0x41, 0x62, 0x69, 0x12, 0x13, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x02, 0x69, 0x64, 0x88, 0x01, 0x01, 0x12, 0x23, 0x0a, 0x0d,
-	0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x4e, 0x61, 0x6d,
-	0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x22, 0x0a, 0x0a, 0x63,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x48,
-	0x01, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12,
-	0x22, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x0d, 0x48, 0x02, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
-	0x88, 0x01, 0x01, 0x12, 0x22, 0x0a, 0x0a, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f, 0x61,
-	0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x03, 0x52, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x42, 0x05, 0x0a, 0x03, 0x5f, 0x69, 0x64, 0x42, 0x0d,
-	0x0a, 0x0b, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x42, 0x0d, 0x0a,
-	0x0b, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x42, 0x0d, 0x0a, 0x0b,
-	0x5f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x22, 0xcf, 0x02, 0x0a, 0x0b,
-	0x45, 0x76, 0x6d, 0x4c, 0x6f, 0x67, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x12, 0x13, 0x0a, 0x02, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x02, 0x69, 0x64, 0x88, 0x01, 0x01,
-	0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72,
-	0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x54, 0x79, 0x70,
-	0x65, 0x12, 0x2a, 0x0a, 0x11, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x5f, 0x6a, 0x73, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x73, 0x74,
-	0x6f, 0x72, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x4a, 0x73, 0x6f, 0x6e, 0x12, 0x22, 0x0a,
-	0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x0d, 0x48, 0x01, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01,
-	0x01, 0x12, 0x22, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
-	0x07, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x02, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
-	0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x22, 0x0a, 0x0a, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64,
-	0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x03, 0x52, 0x09, 0x64, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x42, 0x05, 0x0a, 0x03, 0x5f, 0x69, 0x64,
-	0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x42,
-	0x0d, 0x0a, 0x0b, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x42, 0x0d,
-	0x0a, 0x0b, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x22, 0xac, 0x02,
-	0x0a, 0x0e, 0x45, 0x76, 0x6d, 0x4c, 0x6f, 0x67, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65,
-	0x12, 0x13, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x02,
-	0x69, 0x64, 0x88, 0x01, 0x01, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x28, 0x0a, 0x10, 0x65, 0x76, 0x6d,
-	0x69, 0x5f, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x0d, 0x52, 0x0e, 0x65, 0x76, 0x6d, 0x69, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
-	0x65, 0x49, 0x64, 0x12, 0x27, 0x0a, 0x10, 0x65, 0x76, 0x6d, 0x5f, 0x6c, 0x6f, 0x67, 0x5f, 0x73,
-	0x74, 0x6f, 0x72, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x65,
-	0x76, 0x6d, 0x4c, 0x6f, 0x67, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x0a,
-	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d,
-	0x48, 0x01, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01,
-	0x12, 0x22, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x08,
-	0x20, 0x01, 0x28, 0x0d, 0x48, 0x02, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41,
-	0x74, 0x88, 0x01, 0x01, 0x12, 0x22, 0x0a, 0x0a, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f,
-	0x61, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x03, 0x52, 0x09, 0x64, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x42, 0x05, 0x0a, 0x03, 0x5f, 0x69, 0x64, 0x42,
+	0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x22, 0x5a, 0x0a, 0x14, 0x46, 0x61, 0x63, 0x74,
+	0x6f, 0x72, 0x79, 0x52, 0x75, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x10, 0x0a, 0x03, 0x61, 0x72, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x61,
+	0x72, 0x67, 0x12, 0x1a, 0x0a, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x14,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x22, 0xfc, 0x02, 0x0a, 0x0b, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79,
+	0x52, 0x75, 0x6c, 0x65, 0x12, 0x13, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
+	0x48, 0x00, 0x52, 0x02, 0x69, 0x64, 0x88, 0x01, 0x01, 0x12, 0x34, 0x0a, 0x16, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x66, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x37, 0x0a, 0x18, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x5f, 0x6c, 0x6f, 0x67, 0x5f, 0x61, 0x72, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x15, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x4c, 0x6f, 0x67, 0x41, 0x72, 0x67, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x69, 0x6c,
+	0x64, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x68,
+	0x69, 0x6c, 0x64, 0x54, 0x79, 0x70, 0x65, 0x12, 0x25, 0x0a, 0x0f, 0x65, 0x76, 0x6d, 0x5f, 0x6a,
+	0x73, 0x6f, 0x6e, 0x5f, 0x61, 0x62, 0x69, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x0c, 0x65, 0x76, 0x6d, 0x4a, 0x73, 0x6f, 0x6e, 0x41, 0x62, 0x69, 0x49, 0x64, 0x12, 0x3c,
+	0x0a, 0x0b, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x5f, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x06, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x75, 0x6c, 0x65,
+	0x52, 0x0a, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x44, 0x0a, 0x0a,
+	0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x24, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x75, 0x6c, 0x65, 0x43, 0x6f, 0x6e,
+	0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x42, 0x05, 0x0a, 0x03,
+	0x5f, 0x69, 0x64, 0x22, 0xe4, 0x09, 0x0a, 0x0c, 0x45, 0x76, 0x6d, 0x4c, 0x6f, 0x67, 0x53, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x12, 0x13, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
+	0x48, 0x00, 0x52, 0x02, 0x69, 0x64, 0x88, 0x01, 0x01, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a,
+	0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
+	0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x1f, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x79, 0x6e, 0x63, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x73, 0x79, 0x6e, 0x63, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12,
+	0x1b, 0x0a, 0x09, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x1d, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x08, 0x65, 0x6e, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x28, 0x0a, 0x10,
+	0x63, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x75, 0x70, 0x5f, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x73,
+	0x18, 0x1e, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x63, 0x61, 0x74, 0x63, 0x68, 0x55, 0x70, 0x57,
+	0x6f, 0x72, 0x6b, 0x65, 0x72, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x18, 0x1f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6c, 0x61, 0x73, 0x74,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x27, 0x0a, 0x0d, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x5f, 0x61, 0x74, 0x18, 0x20, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x01, 0x52, 0x0b,
+	0x6c, 0x61, 0x73, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x31,
+	0x0a, 0x15, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x66, 0x72, 0x6f,
+	0x6d, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x21, 0x20, 0x01, 0x28, 0x04, 0x52, 0x12, 0x6c,
+	0x61, 0x73, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x46, 0x72, 0x6f, 0x6d, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x12, 0x2d, 0x0a, 0x13, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f,
+	0x74, 0x6f, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x22, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10,
+	0x6c, 0x61, 0x73, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x54, 0x6f, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x12, 0x31, 0x0a, 0x14, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x63, 0x75, 0x74, 0x69, 0x76, 0x65, 0x5f,
+	0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x18, 0x23, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x13,
+	0x63, 0x6f, 0x6e, 0x73, 0x65, 0x63, 0x75, 0x74, 0x69, 0x76, 0x65, 0x46, 0x61, 0x69, 0x6c, 0x75,
+	0x72, 0x65, 0x73, 0x12, 0x1d, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x88,
+	0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x30, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x09, 0x48, 0x03, 0x52, 0x06, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x30, 0x88, 0x01, 0x01, 0x12,
+	0x23, 0x0a, 0x0d, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73,
+	0x18, 0x09, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x46, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e,
+	0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x12, 0x40,
+	0x0a, 0x0d, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x5f, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18,
+	0x17, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65,
+	0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x75,
+	0x6c, 0x65, 0x52, 0x0c, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x75, 0x6c, 0x65, 0x73,
+	0x12, 0x2d, 0x0a, 0x13, 0x65, 0x76, 0x6d, 0x5f, 0x6c, 0x6f, 0x67, 0x5f, 0x70, 0x69, 0x70, 0x65,
+	0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x65,
+	0x76, 0x6d, 0x4c, 0x6f, 0x67, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x49, 0x64, 0x12,
+	0x25, 0x0a, 0x0f, 0x65, 0x76, 0x6d, 0x5f, 0x6a, 0x73, 0x6f, 0x6e, 0x5f, 0x61, 0x62, 0x69, 0x5f,
+	0x69, 0x64, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x65, 0x76, 0x6d, 0x4a, 0x73, 0x6f,
+	0x6e, 0x41, 0x62, 0x69, 0x49, 0x64, 0x12, 0x2a, 0x0a, 0x11, 0x65, 0x76, 0x6d, 0x5f, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x0f, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x0f, 0x65, 0x76, 0x6d, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x63, 0x68, 0x61, 0x69, 0x6e,
+	0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x30, 0x73, 0x18, 0x18, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x07, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x30, 0x73, 0x12, 0x27, 0x0a, 0x10,
+	0x65, 0x76, 0x6d, 0x5f, 0x6a, 0x73, 0x6f, 0x6e, 0x5f, 0x61, 0x62, 0x69, 0x5f, 0x69, 0x64, 0x73,
+	0x18, 0x19, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x0d, 0x65, 0x76, 0x6d, 0x4a, 0x73, 0x6f, 0x6e, 0x41,
+	0x62, 0x69, 0x49, 0x64, 0x73, 0x12, 0x44, 0x0a, 0x08, 0x72, 0x65, 0x64, 0x65, 0x63, 0x6f, 0x64,
+	0x65, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e,
+	0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x6d, 0x52, 0x65, 0x64, 0x65,
+	0x63, 0x6f, 0x64, 0x65, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x48, 0x04, 0x52, 0x08,
+	0x72, 0x65, 0x64, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x88, 0x01, 0x01, 0x12, 0x1c, 0x0a, 0x09, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x1b, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x12, 0x2b, 0x0a, 0x11, 0x66, 0x75, 0x6e,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x1c,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x66, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65,
+	0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x22, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x5f, 0x61, 0x74, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x05, 0x52, 0x09, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x22, 0x0a, 0x0a, 0x75, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x06,
+	0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x22,
+	0x0a, 0x0a, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x12, 0x20, 0x01,
+	0x28, 0x0d, 0x48, 0x07, 0x52, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x41, 0x74, 0x88,
+	0x01, 0x01, 0x42, 0x05, 0x0a, 0x03, 0x5f, 0x69, 0x64, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x6c, 0x61,
+	0x73, 0x74, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x61, 0x74, 0x42, 0x0a, 0x0a, 0x08, 0x5f,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x74, 0x6f, 0x70, 0x69,
+	0x63, 0x30, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x72, 0x65, 0x64, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x42,
 	0x0d, 0x0a, 0x0b, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x42, 0x0d,
 	0x0a, 0x0b, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x42, 0x0d, 0x0a,
-	0x0b, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x22, 0x5a, 0x0a, 0x14,
-	0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x75, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x64, 0x69,
-	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x72, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x03, 0x61, 0x72, 0x67, 0x12, 0x1a, 0x0a, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74,
-	0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74,
-	0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0xe2, 0x02, 0x0a, 0x0b, 0x46, 0x61, 0x63,
-	0x74, 0x6f, 0x72, 0x79, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x13, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x02, 0x69, 0x64, 0x88, 0x01, 0x01, 0x12, 0x34, 0x0a,
-	0x16, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x66, 0x75, 0x6e, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x63,
-	0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4e,
-	0x61, 0x6d, 0x65, 0x12, 0x37, 0x0a, 0x18, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
-	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x6c, 0x6f, 0x67, 0x5f, 0x61, 0x72, 0x67, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x4c, 0x6f, 0x67, 0x41, 0x72, 0x67, 0x12, 0x1d, 0x0a, 0x0a,
-	0x63, 0x68, 0x69, 0x6c, 0x64, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x09, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x54, 0x79, 0x70, 0x65, 0x12, 0x25, 0x0a, 0x0f, 0x65,
-	0x76, 0x6d, 0x5f, 0x6a, 0x73, 0x6f, 0x6e, 0x5f, 0x61, 0x62, 0x69, 0x5f, 0x69, 0x64, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x65, 0x76, 0x6d, 0x4a, 0x73, 0x6f, 0x6e, 0x41, 0x62, 0x69,
-	0x49, 0x64, 0x12, 0x3c, 0x0a, 0x0b, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x5f, 0x72, 0x75, 0x6c, 0x65,
-	0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e,
-	0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79,
-	0x52, 0x75, 0x6c, 0x65, 0x52, 0x0a, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x52, 0x75, 0x6c, 0x65, 0x73,
-	0x12, 0x44, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x07,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78,
-	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x75, 0x6c,
-	0x65, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x64,
-	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x42, 0x05, 0x0a, 0x03, 0x5f, 0x69, 0x64, 0x22, 0xcd, 0x05,
-	0x0a, 0x0c, 0x45, 0x76, 0x6d, 0x4c, 0x6f, 0x67, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x13,
-	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x02, 0x69, 0x64,
-	0x88, 0x01, 0x01, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c,
-	0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65,
-	0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x74, 0x61,
-	0x72, 0x74, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a,
-	0x73, 0x74, 0x61, 0x72, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x79,
-	0x6e, 0x63, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09,
-	0x73, 0x79, 0x6e, 0x63, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x1d, 0x0a, 0x07, 0x61, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x07, 0x61, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06, 0x74, 0x6f, 0x70, 0x69,
-	0x63, 0x30, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x06, 0x74, 0x6f, 0x70, 0x69,
-	0x63, 0x30, 0x88, 0x01, 0x01, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x5f, 0x66,
-	0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x74, 0x6f,
-	0x70, 0x69, 0x63, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x70, 0x61,
-	0x72, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x0c,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x53, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x49, 0x64, 0x12, 0x40, 0x0a, 0x0d, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x5f,
-	0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x17, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x65, 0x76,
-	0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x61, 0x63,
-	0x74, 0x6f, 0x72, 0x79, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x0c, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72,
-	0x79, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x2d, 0x0a, 0x13, 0x65, 0x76, 0x6d, 0x5f, 0x6c, 0x6f,
-	0x67, 0x5f, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x0d, 0x20,
-	0x01, 0x28, 0x0d, 0x52, 0x10, 0x65, 0x76, 0x6d, 0x4c, 0x6f, 0x67, 0x50, 0x69, 0x70, 0x65, 0x6c,
-	0x69, 0x6e, 0x65, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x0f, 0x65, 0x76, 0x6d, 0x5f, 0x6a, 0x73, 0x6f,
-	0x6e, 0x5f, 0x61, 0x62, 0x69, 0x5f, 0x69, 0x64, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c,
-	0x65, 0x76, 0x6d, 0x4a, 0x73, 0x6f, 0x6e, 0x41, 0x62, 0x69, 0x49, 0x64, 0x12, 0x2a, 0x0a, 0x11,
-	0x65, 0x76, 0x6d, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69,
-	0x64, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x65, 0x76, 0x6d, 0x42, 0x6c, 0x6f, 0x63,
-	0x6b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x03, 0x52, 0x09,
-	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x22, 0x0a, 0x0a,
-	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0d,
-	0x48, 0x04, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01,
-	0x12, 0x22, 0x0a, 0x0a, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x12,
-	0x20, 0x01, 0x28, 0x0d, 0x48, 0x05, 0x52, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x41,
-	0x74, 0x88, 0x01, 0x01, 0x42, 0x05, 0x0a, 0x03, 0x5f, 0x69, 0x64, 0x42, 0x0a, 0x0a, 0x08, 0x5f,
-	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x74, 0x6f, 0x70, 0x69,
-	0x63, 0x30, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61,
-	0x74, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
-	0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x4a,
-	0x04, 0x08, 0x0a, 0x10, 0x0b, 0x4a, 0x04, 0x08, 0x0b, 0x10, 0x0c, 0x4a, 0x04, 0x08, 0x14, 0x10,
-	0x15, 0x4a, 0x04, 0x08, 0x15, 0x10, 0x16, 0x4a, 0x04, 0x08, 0x16, 0x10, 0x17, 0x22, 0xac, 0x02,
+	0x0b, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x4a, 0x04, 0x08, 0x0a,
+	0x10, 0x0b, 0x4a, 0x04, 0x08, 0x0b, 0x10, 0x0c, 0x4a, 0x04, 0x08, 0x14, 0x10, 0x15, 0x4a, 0x04,
+	0x08, 0x15, 0x10, 0x16, 0x4a, 0x04, 0x08, 0x16, 0x10, 0x17, 0x22, 0xb0, 0x01, 0x0a, 0x13, 0x45,
+	0x76, 0x6d, 0x52, 0x65, 0x64, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65,
+	0x73, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x72,
+	0x6f, 0x6d, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09,
+	0x66, 0x72, 0x6f, 0x6d, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x1d, 0x0a, 0x0a, 0x6e, 0x65, 0x78,
+	0x74, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x6e,
+	0x65, 0x78, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x6f, 0x5f, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x74, 0x6f, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x6f, 0x67, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x04, 0x6c, 0x6f, 0x67, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0xac, 0x02,
 	0x0a, 0x0b, 0x45, 0x76, 0x6d, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x28, 0x0a,
 	0x0d, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
 	0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74,
@@ -7706,7 +10164,7 @@ var file_evm_indexer_v1_evm_indexer_proto_rawDesc = []byte{
 	0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x27, 0x0a, 0x0f, 0x62, 0x6c,
 	0x6f, 0x63, 0x6b, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x0f, 0x20,
 	0x01, 0x28, 0x04, 0x52, 0x0e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
-	0x61, 0x6d, 0x70, 0x22, 0x82, 0x03, 0x0a, 0x0e, 0x45, 0x76, 0x6d, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x6d, 0x70, 0x22, 0xa2, 0x05, 0x0a, 0x0e, 0x45, 0x76, 0x6d, 0x54, 0x72, 0x61, 0x6e, 0x73,
 	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
 	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
 	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x73, 0x6f, 0x75, 0x72, 0x63,
@@ -7730,1020 +10188,1368 @@ var file_evm_indexer_v1_evm_indexer_proto_rawDesc = []byte{
 	0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12,
 	0x27, 0x0a, 0x0f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
 	0x6d, 0x70, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x54,
-	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x3a, 0x0a, 0x0a, 0x50, 0x61, 0x67, 0x69,
-	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x16, 0x0a, 0x06,
-	0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x6f, 0x66,
-	0x66, 0x73, 0x65, 0x74, 0x22, 0x28, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x45, 0x76, 0x6d, 0x69, 0x49,
-	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e,
-	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x69, 0x64, 0x22, 0x53,
-	0x0a, 0x17, 0x47, 0x65, 0x74, 0x45, 0x76, 0x6d, 0x69, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
-	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x08, 0x69, 0x6e, 0x73,
-	0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x65, 0x76,
-	0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x6d,
-	0x69, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61,
-	0x6e, 0x63, 0x65, 0x22, 0x56, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x76, 0x6d, 0x69, 0x49,
-	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x3a, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65,
-	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
-	0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x57, 0x0a, 0x19, 0x4c,
-	0x69, 0x73, 0x74, 0x45, 0x76, 0x6d, 0x69, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x09, 0x69, 0x6e, 0x73, 0x74,
-	0x61, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x65, 0x76,
-	0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x6d,
-	0x69, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x09, 0x69, 0x6e, 0x73, 0x74, 0x61,
-	0x6e, 0x63, 0x65, 0x73, 0x22, 0x5b, 0x0a, 0x1a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x76,
-	0x6d, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x3d, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x63, 0x68, 0x61, 0x69, 0x6e,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64,
-	0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x6d, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
-	0x63, 0x68, 0x61, 0x69, 0x6e, 0x52, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x63, 0x68, 0x61, 0x69,
-	0x6e, 0x22, 0x2d, 0x0a, 0x1b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x76, 0x6d, 0x42, 0x6c,
-	0x6f, 0x63, 0x6b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x0e, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x67, 0x61, 0x73, 0x5f, 0x75, 0x73, 0x65, 0x64,
+	0x18, 0x10, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x67, 0x61, 0x73, 0x55, 0x73, 0x65, 0x64, 0x12,
+	0x2e, 0x0a, 0x13, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x67, 0x61, 0x73,
+	0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x11, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x65, 0x66,
+	0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x47, 0x61, 0x73, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12,
+	0x29, 0x0a, 0x10, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x18, 0x12, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x74, 0x72,
+	0x61, 0x63, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x22, 0x0a, 0x0d, 0x62, 0x6c,
+	0x6f, 0x62, 0x5f, 0x67, 0x61, 0x73, 0x5f, 0x75, 0x73, 0x65, 0x64, 0x18, 0x13, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x62, 0x47, 0x61, 0x73, 0x55, 0x73, 0x65, 0x64, 0x12, 0x24,
+	0x0a, 0x0e, 0x62, 0x6c, 0x6f, 0x62, 0x5f, 0x67, 0x61, 0x73, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65,
+	0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x62, 0x6c, 0x6f, 0x62, 0x47, 0x61, 0x73, 0x50,
+	0x72, 0x69, 0x63, 0x65, 0x12, 0x32, 0x0a, 0x15, 0x62, 0x6c, 0x6f, 0x62, 0x5f, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x65, 0x64, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x65, 0x73, 0x18, 0x15, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x13, 0x62, 0x6c, 0x6f, 0x62, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x65, 0x64, 0x48, 0x61, 0x73, 0x68, 0x65, 0x73, 0x22, 0xe2, 0x03, 0x0a, 0x08, 0x45, 0x76, 0x6d,
+	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x21, 0x0a,
+	0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+	0x12, 0x27, 0x0a, 0x0f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73,
+	0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x12, 0x1f, 0x0a,
+	0x0b, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x48, 0x61, 0x73, 0x68, 0x12, 0x14,
+	0x0a, 0x05, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6d,
+	0x69, 0x6e, 0x65, 0x72, 0x12, 0x19, 0x0a, 0x08, 0x67, 0x61, 0x73, 0x5f, 0x75, 0x73, 0x65, 0x64,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x67, 0x61, 0x73, 0x55, 0x73, 0x65, 0x64, 0x12,
+	0x1b, 0x0a, 0x09, 0x67, 0x61, 0x73, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x08, 0x67, 0x61, 0x73, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x27, 0x0a, 0x10,
+	0x62, 0x61, 0x73, 0x65, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x67, 0x61, 0x73,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x62, 0x61, 0x73, 0x65, 0x46, 0x65, 0x65, 0x50,
+	0x65, 0x72, 0x47, 0x61, 0x73, 0x12, 0x22, 0x0a, 0x0d, 0x62, 0x6c, 0x6f, 0x62, 0x5f, 0x67, 0x61,
+	0x73, 0x5f, 0x75, 0x73, 0x65, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x62, 0x6c,
+	0x6f, 0x62, 0x47, 0x61, 0x73, 0x55, 0x73, 0x65, 0x64, 0x12, 0x26, 0x0a, 0x0f, 0x65, 0x78, 0x63,
+	0x65, 0x73, 0x73, 0x5f, 0x62, 0x6c, 0x6f, 0x62, 0x5f, 0x67, 0x61, 0x73, 0x18, 0x0d, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0d, 0x65, 0x78, 0x63, 0x65, 0x73, 0x73, 0x42, 0x6c, 0x6f, 0x62, 0x47, 0x61,
+	0x73, 0x12, 0x2b, 0x0a, 0x11, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x74, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1d,
+	0x0a, 0x0a, 0x6c, 0x6f, 0x67, 0x73, 0x5f, 0x62, 0x6c, 0x6f, 0x6f, 0x6d, 0x18, 0x0f, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x6c, 0x6f, 0x67, 0x73, 0x42, 0x6c, 0x6f, 0x6f, 0x6d, 0x22, 0xda, 0x03,
+	0x0a, 0x08, 0x45, 0x76, 0x6d, 0x54, 0x72, 0x61, 0x63, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x69, 0x6e,
+	0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e,
+	0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62,
+	0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x27, 0x0a, 0x0f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x29,
+	0x0a, 0x10, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x68, 0x61,
+	0x73, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x61, 0x73, 0x68, 0x12, 0x2b, 0x0a, 0x11, 0x74, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x72, 0x61, 0x63, 0x65, 0x5f,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x74,
+	0x72, 0x61, 0x63, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66,
+	0x72, 0x6f, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x74, 0x6f, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x0c, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x70,
+	0x75, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x12,
+	0x16, 0x0a, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x67, 0x61, 0x73, 0x18, 0x0f,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x67, 0x61, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x67, 0x61, 0x73,
+	0x5f, 0x75, 0x73, 0x65, 0x64, 0x18, 0x10, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x67, 0x61, 0x73,
+	0x55, 0x73, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x11, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x3a, 0x0a, 0x0a, 0x50, 0x61,
+	0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x16,
+	0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06,
+	0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0x28, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x45, 0x76, 0x6d,
+	0x69, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
 	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x69, 0x64,
-	0x22, 0x29, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x45, 0x76, 0x6d, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x63,
-	0x68, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x69, 0x64, 0x22, 0x59, 0x0a, 0x18, 0x47,
-	0x65, 0x74, 0x45, 0x76, 0x6d, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3d, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
-	0x63, 0x68, 0x61, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x65, 0x76,
-	0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x6d,
-	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x52, 0x0a, 0x62, 0x6c, 0x6f, 0x63,
-	0x6b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x22, 0x5b, 0x0a, 0x1a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x22, 0x53, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x45, 0x76, 0x6d, 0x69, 0x49, 0x6e, 0x73, 0x74, 0x61,
+	0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x08, 0x69,
+	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
+	0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45,
+	0x76, 0x6d, 0x69, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x08, 0x69, 0x6e, 0x73,
+	0x74, 0x61, 0x6e, 0x63, 0x65, 0x22, 0x56, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x76, 0x6d,
+	0x69, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x3a, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65,
+	0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x57, 0x0a,
+	0x19, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x76, 0x6d, 0x69, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
+	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x09, 0x69, 0x6e,
+	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
+	0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45,
+	0x76, 0x6d, 0x69, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x09, 0x69, 0x6e, 0x73,
+	0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x22, 0x5b, 0x0a, 0x1a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
 	0x45, 0x76, 0x6d, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x71,
 	0x75, 0x65, 0x73, 0x74, 0x12, 0x3d, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x63, 0x68, 0x61,
 	0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69,
 	0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x6d, 0x42, 0x6c, 0x6f,
 	0x63, 0x6b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x52, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x63, 0x68,
-	0x61, 0x69, 0x6e, 0x22, 0x1d, 0x0a, 0x1b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x76, 0x6d,
+	0x61, 0x69, 0x6e, 0x22, 0x2d, 0x0a, 0x1b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x76, 0x6d,
 	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x57, 0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x76, 0x6d, 0x42, 0x6c, 0x6f,
-	0x63, 0x6b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x3a, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65,
-	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
-	0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x5d, 0x0a, 0x1a, 0x4c,
-	0x69, 0x73, 0x74, 0x45, 0x76, 0x6d, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x63, 0x68, 0x61, 0x69, 0x6e,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a, 0x0b, 0x62, 0x6c, 0x6f,
-	0x63, 0x6b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d,
-	0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
-	0x45, 0x76, 0x6d, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x52, 0x0b, 0x62,
-	0x6c, 0x6f, 0x63, 0x6b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x73, 0x22, 0x2c, 0x0a, 0x1a, 0x44, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x45, 0x76, 0x6d, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x63, 0x68, 0x61, 0x69,
-	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x69, 0x64, 0x22, 0x1d, 0x0a, 0x1b, 0x44, 0x65, 0x6c, 0x65,
+	0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02,
+	0x69, 0x64, 0x22, 0x29, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x45, 0x76, 0x6d, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x69, 0x64, 0x22, 0x59, 0x0a,
+	0x18, 0x47, 0x65, 0x74, 0x45, 0x76, 0x6d, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x63, 0x68, 0x61, 0x69,
+	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3d, 0x0a, 0x0a, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e,
+	0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45,
+	0x76, 0x6d, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x52, 0x0a, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x22, 0x5b, 0x0a, 0x1a, 0x55, 0x70, 0x64, 0x61,
 	0x74, 0x65, 0x45, 0x76, 0x6d, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x47, 0x0a, 0x17, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x45, 0x76, 0x6d, 0x4a, 0x73, 0x6f, 0x6e, 0x41, 0x62, 0x69, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x2c, 0x0a, 0x03, 0x61, 0x62, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1a, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31,
-	0x2e, 0x45, 0x76, 0x6d, 0x4a, 0x73, 0x6f, 0x6e, 0x41, 0x62, 0x69, 0x52, 0x03, 0x61, 0x62, 0x69,
-	0x22, 0x2a, 0x0a, 0x18, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x76, 0x6d, 0x4a, 0x73, 0x6f,
-	0x6e, 0x41, 0x62, 0x69, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x69, 0x64, 0x22, 0x26, 0x0a, 0x14,
-	0x47, 0x65, 0x74, 0x45, 0x76, 0x6d, 0x4a, 0x73, 0x6f, 0x6e, 0x41, 0x62, 0x69, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x02, 0x69, 0x64, 0x22, 0x45, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x45, 0x76, 0x6d, 0x4a, 0x73,
-	0x6f, 0x6e, 0x41, 0x62, 0x69, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a,
-	0x03, 0x61, 0x62, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x65, 0x76, 0x6d,
-	0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x6d, 0x4a,
-	0x73, 0x6f, 0x6e, 0x41, 0x62, 0x69, 0x52, 0x03, 0x61, 0x62, 0x69, 0x22, 0x47, 0x0a, 0x17, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x76, 0x6d, 0x4a, 0x73, 0x6f, 0x6e, 0x41, 0x62, 0x69, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x03, 0x61, 0x62, 0x69, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65,
-	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x6d, 0x4a, 0x73, 0x6f, 0x6e, 0x41, 0x62, 0x69, 0x52,
-	0x03, 0x61, 0x62, 0x69, 0x22, 0x1a, 0x0a, 0x18, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x76,
-	0x6d, 0x4a, 0x73, 0x6f, 0x6e, 0x41, 0x62, 0x69, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x54, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x76, 0x6d, 0x4a, 0x73, 0x6f, 0x6e, 0x41,
-	0x62, 0x69, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3a, 0x0a, 0x0a, 0x70, 0x61,
-	0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
-	0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
-	0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69,
-	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x49, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x76,
-	0x6d, 0x4a, 0x73, 0x6f, 0x6e, 0x41, 0x62, 0x69, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x2e, 0x0a, 0x04, 0x61, 0x62, 0x69, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x1a, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31,
-	0x2e, 0x45, 0x76, 0x6d, 0x4a, 0x73, 0x6f, 0x6e, 0x41, 0x62, 0x69, 0x52, 0x04, 0x61, 0x62, 0x69,
-	0x73, 0x22, 0x29, 0x0a, 0x17, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x76, 0x6d, 0x4a, 0x73,
-	0x6f, 0x6e, 0x41, 0x62, 0x69, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x69, 0x64, 0x22, 0x1a, 0x0a, 0x18,
-	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x76, 0x6d, 0x4a, 0x73, 0x6f, 0x6e, 0x41, 0x62, 0x69,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4d, 0x0a, 0x18, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x45, 0x76, 0x6d, 0x4c, 0x6f, 0x67, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x31, 0x0a, 0x05, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65,
-	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x6d, 0x4c, 0x6f, 0x67, 0x53, 0x74, 0x6f, 0x72, 0x65,
-	0x52, 0x05, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x22, 0x2b, 0x0a, 0x19, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x45, 0x76, 0x6d, 0x4c, 0x6f, 0x67, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x02, 0x69, 0x64, 0x22, 0x27, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x45, 0x76, 0x6d, 0x4c, 0x6f,
-	0x67, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a,
-	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x69, 0x64, 0x22, 0x4b, 0x0a,
-	0x16, 0x47, 0x65, 0x74, 0x45, 0x76, 0x6d, 0x4c, 0x6f, 0x67, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x05, 0x73, 0x74, 0x6f, 0x72, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64,
-	0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x6d, 0x4c, 0x6f, 0x67, 0x53, 0x74,
-	0x6f, 0x72, 0x65, 0x52, 0x05, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x22, 0x4d, 0x0a, 0x18, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x45, 0x76, 0x6d, 0x4c, 0x6f, 0x67, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x31, 0x0a, 0x05, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65,
-	0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x6d, 0x4c, 0x6f, 0x67, 0x53, 0x74, 0x6f,
-	0x72, 0x65, 0x52, 0x05, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x22, 0x1b, 0x0a, 0x19, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x45, 0x76, 0x6d, 0x4c, 0x6f, 0x67, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x55, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x76,
-	0x6d, 0x4c, 0x6f, 0x67, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3d, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x63,
+	0x68, 0x61, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x65, 0x76, 0x6d,
+	0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x6d, 0x42,
+	0x6c, 0x6f, 0x63, 0x6b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x52, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x22, 0x1d, 0x0a, 0x1b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45,
+	0x76, 0x6d, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x57, 0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x76, 0x6d, 0x42,
+	0x6c, 0x6f, 0x63, 0x6b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
 	0x74, 0x12, 0x3a, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
 	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65,
 	0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x4f, 0x0a,
-	0x18, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x76, 0x6d, 0x4c, 0x6f, 0x67, 0x53, 0x74, 0x6f, 0x72, 0x65,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x06, 0x73, 0x74, 0x6f,
-	0x72, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x65, 0x76, 0x6d, 0x5f,
-	0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x6d, 0x4c, 0x6f,
-	0x67, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x06, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x73, 0x22, 0x2a,
-	0x0a, 0x18, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x76, 0x6d, 0x4c, 0x6f, 0x67, 0x53, 0x74,
-	0x6f, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x69, 0x64, 0x22, 0x1b, 0x0a, 0x19, 0x44, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x45, 0x76, 0x6d, 0x4c, 0x6f, 0x67, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x59, 0x0a, 0x1b, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x45, 0x76, 0x6d, 0x4c, 0x6f, 0x67, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3a, 0x0a, 0x08, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69,
-	0x6e, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69,
-	0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x6d, 0x4c, 0x6f, 0x67,
-	0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x08, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69,
-	0x6e, 0x65, 0x22, 0x2e, 0x0a, 0x1c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x76, 0x6d, 0x4c,
-	0x6f, 0x67, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02,
-	0x69, 0x64, 0x22, 0x2a, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x45, 0x76, 0x6d, 0x4c, 0x6f, 0x67, 0x50,
-	0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e,
-	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x69, 0x64, 0x22, 0x57,
-	0x0a, 0x19, 0x47, 0x65, 0x74, 0x45, 0x76, 0x6d, 0x4c, 0x6f, 0x67, 0x50, 0x69, 0x70, 0x65, 0x6c,
-	0x69, 0x6e, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x08, 0x70,
-	0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e,
+	0x6e, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x5d, 0x0a,
+	0x1a, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x76, 0x6d, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x63, 0x68, 0x61,
+	0x69, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a, 0x0b, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1d, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x45, 0x76, 0x6d, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x52,
+	0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x73, 0x22, 0x2c, 0x0a, 0x1a,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x76, 0x6d, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x63, 0x68,
+	0x61, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x69, 0x64, 0x22, 0x1d, 0x0a, 0x1b, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x45, 0x76, 0x6d, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x63, 0x68, 0x61, 0x69,
+	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x47, 0x0a, 0x17, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x45, 0x76, 0x6d, 0x4a, 0x73, 0x6f, 0x6e, 0x41, 0x62, 0x69, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x03, 0x61, 0x62, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x45, 0x76, 0x6d, 0x4a, 0x73, 0x6f, 0x6e, 0x41, 0x62, 0x69, 0x52, 0x03, 0x61,
+	0x62, 0x69, 0x22, 0x2a, 0x0a, 0x18, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x76, 0x6d, 0x4a,
+	0x73, 0x6f, 0x6e, 0x41, 0x62, 0x69, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x69, 0x64, 0x22, 0x26,
+	0x0a, 0x14, 0x47, 0x65, 0x74, 0x45, 0x76, 0x6d, 0x4a, 0x73, 0x6f, 0x6e, 0x41, 0x62, 0x69, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x02, 0x69, 0x64, 0x22, 0x45, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x45, 0x76, 0x6d,
+	0x4a, 0x73, 0x6f, 0x6e, 0x41, 0x62, 0x69, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x2c, 0x0a, 0x03, 0x61, 0x62, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x65,
+	0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76,
+	0x6d, 0x4a, 0x73, 0x6f, 0x6e, 0x41, 0x62, 0x69, 0x52, 0x03, 0x61, 0x62, 0x69, 0x22, 0x47, 0x0a,
+	0x17, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x76, 0x6d, 0x4a, 0x73, 0x6f, 0x6e, 0x41, 0x62,
+	0x69, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x03, 0x61, 0x62, 0x69, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65,
+	0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x6d, 0x4a, 0x73, 0x6f, 0x6e, 0x41, 0x62,
+	0x69, 0x52, 0x03, 0x61, 0x62, 0x69, 0x22, 0x1a, 0x0a, 0x18, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x45, 0x76, 0x6d, 0x4a, 0x73, 0x6f, 0x6e, 0x41, 0x62, 0x69, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x54, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x76, 0x6d, 0x4a, 0x73, 0x6f,
+	0x6e, 0x41, 0x62, 0x69, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3a, 0x0a, 0x0a,
+	0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x70, 0x61,
+	0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x49, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74,
+	0x45, 0x76, 0x6d, 0x4a, 0x73, 0x6f, 0x6e, 0x41, 0x62, 0x69, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x04, 0x61, 0x62, 0x69, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x45, 0x76, 0x6d, 0x4a, 0x73, 0x6f, 0x6e, 0x41, 0x62, 0x69, 0x52, 0x04, 0x61,
+	0x62, 0x69, 0x73, 0x22, 0x29, 0x0a, 0x17, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x76, 0x6d,
+	0x4a, 0x73, 0x6f, 0x6e, 0x41, 0x62, 0x69, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x69, 0x64, 0x22, 0x1a,
+	0x0a, 0x18, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x76, 0x6d, 0x4a, 0x73, 0x6f, 0x6e, 0x41,
+	0x62, 0x69, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x8e, 0x01, 0x0a, 0x0c, 0x45,
+	0x76, 0x6d, 0x41, 0x72, 0x67, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x64, 0x12, 0x3c, 0x0a,
+	0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1c, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x45, 0x76, 0x6d, 0x41, 0x72, 0x67, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52,
+	0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x90, 0x01, 0x0a, 0x0e,
+	0x45, 0x76, 0x6d, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65,
+	0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x30, 0x0a, 0x04,
+	0x61, 0x72, 0x67, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x65, 0x76, 0x6d,
+	0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x6d, 0x41,
+	0x72, 0x67, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x04, 0x61, 0x72, 0x67, 0x73, 0x22, 0x2c,
+	0x0a, 0x1a, 0x47, 0x65, 0x74, 0x45, 0x76, 0x6d, 0x4a, 0x73, 0x6f, 0x6e, 0x41, 0x62, 0x69, 0x53,
+	0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x69, 0x64, 0x22, 0x93, 0x01, 0x0a,
+	0x1b, 0x47, 0x65, 0x74, 0x45, 0x76, 0x6d, 0x4a, 0x73, 0x6f, 0x6e, 0x41, 0x62, 0x69, 0x53, 0x63,
+	0x68, 0x65, 0x6d, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x06,
+	0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x65,
+	0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76,
+	0x6d, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x06, 0x65, 0x76,
+	0x65, 0x6e, 0x74, 0x73, 0x12, 0x3c, 0x0a, 0x09, 0x66, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e,
+	0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x6d, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x09, 0x66, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x22, 0x9f, 0x01, 0x0a, 0x14, 0x45, 0x76, 0x6d, 0x49, 0x6d, 0x70, 0x6c, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x62, 0x69, 0x12, 0x13, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x02, 0x69, 0x64, 0x88, 0x01, 0x01,
+	0x12, 0x2a, 0x0a, 0x11, 0x65, 0x76, 0x6d, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x63, 0x68, 0x61,
+	0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x65, 0x76, 0x6d,
+	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x25, 0x0a, 0x0f, 0x65, 0x76, 0x6d, 0x5f, 0x6a, 0x73,
+	0x6f, 0x6e, 0x5f, 0x61, 0x62, 0x69, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x0c, 0x65, 0x76, 0x6d, 0x4a, 0x73, 0x6f, 0x6e, 0x41, 0x62, 0x69, 0x49, 0x64, 0x42, 0x05, 0x0a,
+	0x03, 0x5f, 0x69, 0x64, 0x22, 0x75, 0x0a, 0x1e, 0x53, 0x65, 0x74, 0x45, 0x76, 0x6d, 0x49, 0x6d,
+	0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x62, 0x69, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x53, 0x0a, 0x12, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x61, 0x62, 0x69, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x24, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x6d, 0x49, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x62, 0x69, 0x52, 0x11, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x62, 0x69, 0x22, 0x31, 0x0a, 0x1f, 0x53,
+	0x65, 0x74, 0x45, 0x76, 0x6d, 0x49, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x41, 0x62, 0x69, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x69, 0x64, 0x22, 0x8a,
+	0x01, 0x0a, 0x20, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x76, 0x6d, 0x49, 0x6d, 0x70, 0x6c, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x62, 0x69, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x2a, 0x0a, 0x11, 0x65, 0x76, 0x6d, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f,
+	0x65, 0x76, 0x6d, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12,
+	0x3a, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x7a, 0x0a, 0x21, 0x4c,
+	0x69, 0x73, 0x74, 0x45, 0x76, 0x6d, 0x49, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x41, 0x62, 0x69, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x55, 0x0a, 0x13, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x61, 0x62, 0x69, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e,
 	0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45,
-	0x76, 0x6d, 0x4c, 0x6f, 0x67, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x08, 0x70,
-	0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x22, 0x59, 0x0a, 0x1b, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x45, 0x76, 0x6d, 0x4c, 0x6f, 0x67, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3a, 0x0a, 0x08, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69,
-	0x6e, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69,
-	0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x6d, 0x4c, 0x6f, 0x67,
-	0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x08, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69,
-	0x6e, 0x65, 0x22, 0x1e, 0x0a, 0x1c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x76, 0x6d, 0x4c,
-	0x6f, 0x67, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x58, 0x0a, 0x1a, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x76, 0x6d, 0x4c, 0x6f, 0x67,
-	0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x3a, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78,
-	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x5b, 0x0a, 0x1b,
-	0x4c, 0x69, 0x73, 0x74, 0x45, 0x76, 0x6d, 0x4c, 0x6f, 0x67, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69,
-	0x6e, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a, 0x09, 0x70,
-	0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e,
-	0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
-	0x45, 0x76, 0x6d, 0x4c, 0x6f, 0x67, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x09,
-	0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x22, 0x2d, 0x0a, 0x1b, 0x44, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x45, 0x76, 0x6d, 0x4c, 0x6f, 0x67, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e,
-	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x69, 0x64, 0x22, 0x1e, 0x0a, 0x1c, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x45, 0x76, 0x6d, 0x4c, 0x6f, 0x67, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x51, 0x0a, 0x19, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x45, 0x76, 0x6d, 0x4c, 0x6f, 0x67, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x34, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65,
-	0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x6d, 0x4c, 0x6f, 0x67, 0x53, 0x6f, 0x75,
-	0x72, 0x63, 0x65, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x22, 0x2c, 0x0a, 0x1a, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x76, 0x6d, 0x4c, 0x6f, 0x67, 0x53, 0x6f, 0x75, 0x72, 0x63,
-	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x69, 0x64, 0x22, 0x28, 0x0a, 0x16, 0x47, 0x65, 0x74,
-	0x45, 0x76, 0x6d, 0x4c, 0x6f, 0x67, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52,
-	0x02, 0x69, 0x64, 0x22, 0x4f, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x45, 0x76, 0x6d, 0x4c, 0x6f, 0x67,
-	0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34,
-	0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c,
+	0x76, 0x6d, 0x49, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x41, 0x62, 0x69, 0x52, 0x12, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x41, 0x62, 0x69, 0x73, 0x22, 0x33, 0x0a, 0x21, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x45, 0x76, 0x6d, 0x49, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x41, 0x62, 0x69, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x69, 0x64, 0x22, 0x24, 0x0a, 0x22,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x76, 0x6d, 0x49, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x62, 0x69, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0xfc, 0x01, 0x0a, 0x16, 0x45, 0x76, 0x6d, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x49,
+	0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a,
+	0x0a, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x65,
+	0x61, 0x63, 0x6f, 0x6e, 0x12, 0x26, 0x0a, 0x0e, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x69, 0x6d,
+	0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a,
+	0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x09, 0x66, 0x72, 0x6f, 0x6d, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x24, 0x0a, 0x0e, 0x66,
+	0x72, 0x6f, 0x6d, 0x5f, 0x6c, 0x6f, 0x67, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0c, 0x66, 0x72, 0x6f, 0x6d, 0x4c, 0x6f, 0x67, 0x49, 0x6e, 0x64, 0x65,
+	0x78, 0x12, 0x2a, 0x0a, 0x0f, 0x65, 0x76, 0x6d, 0x5f, 0x6a, 0x73, 0x6f, 0x6e, 0x5f, 0x61, 0x62,
+	0x69, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x0c, 0x65, 0x76,
+	0x6d, 0x4a, 0x73, 0x6f, 0x6e, 0x41, 0x62, 0x69, 0x49, 0x64, 0x88, 0x01, 0x01, 0x42, 0x12, 0x0a,
+	0x10, 0x5f, 0x65, 0x76, 0x6d, 0x5f, 0x6a, 0x73, 0x6f, 0x6e, 0x5f, 0x61, 0x62, 0x69, 0x5f, 0x69,
+	0x64, 0x22, 0x41, 0x0a, 0x22, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x76, 0x6d, 0x50, 0x72, 0x6f, 0x78,
+	0x79, 0x49, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x49, 0x64, 0x22, 0x77, 0x0a, 0x23, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x76, 0x6d, 0x50,
+	0x72, 0x6f, 0x78, 0x79, 0x49, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x50, 0x0a, 0x0f, 0x69,
+	0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x6d, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x49, 0x6d,
+	0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0f, 0x69, 0x6d,
+	0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xca, 0x01,
+	0x0a, 0x11, 0x45, 0x76, 0x6d, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74,
+	0x75, 0x72, 0x65, 0x12, 0x13, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x48,
+	0x00, 0x52, 0x02, 0x69, 0x64, 0x88, 0x01, 0x01, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x6f, 0x70, 0x69,
+	0x63, 0x30, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x30,
+	0x12, 0x23, 0x0a, 0x0d, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x64,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74,
+	0x75, 0x72, 0x65, 0x12, 0x2a, 0x0a, 0x0f, 0x65, 0x76, 0x6d, 0x5f, 0x6a, 0x73, 0x6f, 0x6e, 0x5f,
+	0x61, 0x62, 0x69, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x01, 0x52, 0x0c,
+	0x65, 0x76, 0x6d, 0x4a, 0x73, 0x6f, 0x6e, 0x41, 0x62, 0x69, 0x49, 0x64, 0x88, 0x01, 0x01, 0x42,
+	0x05, 0x0a, 0x03, 0x5f, 0x69, 0x64, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x65, 0x76, 0x6d, 0x5f, 0x6a,
+	0x73, 0x6f, 0x6e, 0x5f, 0x61, 0x62, 0x69, 0x5f, 0x69, 0x64, 0x22, 0x3b, 0x0a, 0x1f, 0x49, 0x6d,
+	0x70, 0x6f, 0x72, 0x74, 0x45, 0x76, 0x6d, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x69, 0x67, 0x6e,
+	0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a,
+	0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22, 0x58, 0x0a, 0x20, 0x49, 0x6d, 0x70, 0x6f, 0x72,
+	0x74, 0x45, 0x76, 0x6d, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x69,
+	0x6d, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x69,
+	0x6d, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x6b, 0x69, 0x70, 0x70,
+	0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65,
+	0x64, 0x22, 0x73, 0x0a, 0x1d, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x76, 0x6d, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x30, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x30, 0x12, 0x3a, 0x0a, 0x0a, 0x70, 0x61,
+	0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
 	0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
-	0x45, 0x76, 0x6d, 0x4c, 0x6f, 0x67, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x06, 0x73, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x22, 0x51, 0x0a, 0x19, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x76,
-	0x6d, 0x4c, 0x6f, 0x67, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x34, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1c, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e,
-	0x76, 0x31, 0x2e, 0x45, 0x76, 0x6d, 0x4c, 0x6f, 0x67, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52,
-	0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x22, 0x1c, 0x0a, 0x1a, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x45, 0x76, 0x6d, 0x4c, 0x6f, 0x67, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x77, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x76, 0x6d,
-	0x4c, 0x6f, 0x67, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x3a, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65,
-	0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a,
-	0x0b, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0d, 0x52, 0x0a, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x49, 0x64, 0x22, 0x53,
-	0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x76, 0x6d, 0x4c, 0x6f, 0x67, 0x53, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x07, 0x73,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x65,
+	0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x63, 0x0a, 0x1e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x76,
+	0x6d, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x0a, 0x73, 0x69, 0x67, 0x6e,
+	0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x65,
 	0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76,
-	0x6d, 0x4c, 0x6f, 0x67, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x07, 0x73, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x73, 0x22, 0x2b, 0x0a, 0x19, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x76, 0x6d,
-	0x4c, 0x6f, 0x67, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x69, 0x64,
-	0x22, 0x1c, 0x0a, 0x1a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x76, 0x6d, 0x4c, 0x6f, 0x67,
-	0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x43,
-	0x0a, 0x20, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x45, 0x76, 0x6d, 0x4c, 0x6f, 0x67, 0x53, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e,
-	0x65, 0x49, 0x64, 0x22, 0x2b, 0x0a, 0x19, 0x53, 0x74, 0x61, 0x72, 0x74, 0x53, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x69, 0x64,
-	0x22, 0x4c, 0x0a, 0x1a, 0x53, 0x74, 0x61, 0x72, 0x74, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49,
-	0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18,
-	0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f,
-	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x2a,
-	0x0a, 0x18, 0x53, 0x74, 0x6f, 0x70, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x6e, 0x64, 0x65,
-	0x78, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x69, 0x64, 0x22, 0x4b, 0x0a, 0x19, 0x53, 0x74,
-	0x6f, 0x70, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65,
-	0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
-	0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x6b, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x45,
-	0x76, 0x6d, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a,
-	0x09, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x08, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x72,
-	0x6f, 0x6d, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09,
-	0x66, 0x72, 0x6f, 0x6d, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x6f, 0x5f,
-	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x74, 0x6f, 0x42,
-	0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x41, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x76, 0x6d, 0x4c,
-	0x6f, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x04, 0x6c,
-	0x6f, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x65, 0x76, 0x6d, 0x5f,
-	0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x6d, 0x4c, 0x6f,
-	0x67, 0x52, 0x04, 0x6c, 0x6f, 0x67, 0x73, 0x22, 0x4d, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x4c,
-	0x61, 0x74, 0x65, 0x73, 0x74, 0x45, 0x76, 0x6d, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64,
-	0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x47, 0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x61,
-	0x74, 0x65, 0x73, 0x74, 0x45, 0x76, 0x6d, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x04, 0x6c, 0x6f, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x16, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e,
-	0x76, 0x31, 0x2e, 0x45, 0x76, 0x6d, 0x4c, 0x6f, 0x67, 0x52, 0x04, 0x6c, 0x6f, 0x67, 0x73, 0x22,
-	0x73, 0x0a, 0x1a, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x76, 0x6d, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a,
-	0x09, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x08, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x72,
-	0x6f, 0x6d, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09,
-	0x66, 0x72, 0x6f, 0x6d, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x6f, 0x5f,
-	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x74, 0x6f, 0x42,
-	0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x61, 0x0a, 0x1b, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x76, 0x6d, 0x54,
-	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x42, 0x0a, 0x0c, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x65, 0x76, 0x6d, 0x5f,
-	0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x6d, 0x54, 0x72,
-	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x74, 0x72, 0x61, 0x6e, 0x73,
-	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x60, 0x0a, 0x08, 0x41, 0x75, 0x74, 0x68, 0x55,
-	0x73, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52,
-	0x02, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12,
-	0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x22, 0xd3, 0x01, 0x0a, 0x0f, 0x41, 0x63,
-	0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x0e, 0x0a,
-	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x12, 0x22, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
-	0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x22, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73,
-	0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x48, 0x01, 0x52, 0x09, 0x65, 0x78, 0x70,
-	0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x25, 0x0a, 0x0c, 0x6c, 0x61, 0x73,
-	0x74, 0x5f, 0x75, 0x73, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x48,
-	0x02, 0x52, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x55, 0x73, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01,
-	0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x42,
-	0x0d, 0x0a, 0x0b, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x42, 0x0f,
-	0x0a, 0x0d, 0x5f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x73, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x22,
-	0x46, 0x0a, 0x0c, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70,
-	0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70,
-	0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x58, 0x0a, 0x0d, 0x4c, 0x6f, 0x67, 0x69, 0x6e,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65,
-	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x22,
-	0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x03, 0x48, 0x00, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x88,
-	0x01, 0x01, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61,
-	0x74, 0x22, 0x0b, 0x0a, 0x09, 0x4d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x3a,
-	0x0a, 0x0a, 0x4d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x04,
-	0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x65, 0x76, 0x6d,
-	0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x74, 0x68,
-	0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x22, 0x56, 0x0a, 0x18, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x26, 0x0a, 0x0f, 0x65, 0x78,
-	0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x69, 0x6e, 0x5f, 0x64, 0x61, 0x79, 0x73, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0d, 0x52, 0x0d, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x49, 0x6e, 0x44, 0x61,
-	0x79, 0x73, 0x22, 0x88, 0x01, 0x0a, 0x19, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63,
-	0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x69, 0x64,
-	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x22, 0x0a, 0x0a, 0x65, 0x78,
-	0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00,
-	0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x88, 0x01, 0x01, 0x42, 0x0d,
-	0x0a, 0x0b, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x22, 0x19, 0x0a,
-	0x17, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x53, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74,
-	0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x06, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78,
-	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65,
-	0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x06, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x22, 0x2a, 0x0a,
-	0x18, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b,
-	0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x69, 0x64, 0x22, 0x1b, 0x0a, 0x19, 0x52, 0x65, 0x76,
-	0x6f, 0x6b, 0x65, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x8d, 0x02, 0x0a, 0x0d, 0x4f, 0x41, 0x75, 0x74, 0x68,
-	0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x13, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x02, 0x69, 0x64, 0x88, 0x01, 0x01, 0x12, 0x18, 0x0a,
-	0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
-	0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x63,
-	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
-	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x75, 0x74, 0x68,
-	0x5f, 0x75, 0x72, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x75, 0x74, 0x68,
-	0x55, 0x72, 0x6c, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x75, 0x72, 0x6c,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x55, 0x72, 0x6c,
-	0x12, 0x22, 0x0a, 0x0d, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x5f, 0x75, 0x72,
-	0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x75, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66,
-	0x6f, 0x55, 0x72, 0x6c, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74,
-	0x5f, 0x75, 0x72, 0x6c, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x64, 0x69,
-	0x72, 0x65, 0x63, 0x74, 0x55, 0x72, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x63, 0x6f, 0x70, 0x65,
-	0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x42,
-	0x05, 0x0a, 0x03, 0x5f, 0x69, 0x64, 0x22, 0x7c, 0x0a, 0x1a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x4f, 0x41, 0x75, 0x74, 0x68, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x39, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64,
-	0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x41, 0x75, 0x74, 0x68, 0x50, 0x72, 0x6f,
-	0x76, 0x69, 0x64, 0x65, 0x72, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12,
-	0x23, 0x0a, 0x0d, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x53, 0x65,
-	0x63, 0x72, 0x65, 0x74, 0x22, 0x2d, 0x0a, 0x1b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x41,
-	0x75, 0x74, 0x68, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52,
-	0x02, 0x69, 0x64, 0x22, 0x7c, 0x0a, 0x1a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x41, 0x75,
-	0x74, 0x68, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x39, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65,
-	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x41, 0x75, 0x74, 0x68, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64,
-	0x65, 0x72, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x23, 0x0a, 0x0d,
-	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x63, 0x72, 0x65,
-	0x74, 0x22, 0x1d, 0x0a, 0x1b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x41, 0x75, 0x74, 0x68,
-	0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x1b, 0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x41, 0x75, 0x74, 0x68, 0x50, 0x72, 0x6f,
-	0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x59, 0x0a,
-	0x1a, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x41, 0x75, 0x74, 0x68, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64,
-	0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x09, 0x70,
-	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d,
+	0x6d, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x52,
+	0x0a, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x22, 0x30, 0x0a, 0x1e, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x76, 0x6d, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x69, 0x67,
+	0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x69, 0x64, 0x22, 0x21, 0x0a,
+	0x1f, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x76, 0x6d, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53,
+	0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x4d, 0x0a, 0x18, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x76, 0x6d, 0x4c, 0x6f, 0x67,
+	0x53, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x31, 0x0a, 0x05,
+	0x73, 0x74, 0x6f, 0x72, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x65, 0x76,
+	0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x6d,
+	0x4c, 0x6f, 0x67, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x05, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x22,
+	0x2b, 0x0a, 0x19, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x76, 0x6d, 0x4c, 0x6f, 0x67, 0x53,
+	0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x69, 0x64, 0x22, 0x27, 0x0a, 0x15,
+	0x47, 0x65, 0x74, 0x45, 0x76, 0x6d, 0x4c, 0x6f, 0x67, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x02, 0x69, 0x64, 0x22, 0x4b, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x45, 0x76, 0x6d, 0x4c,
+	0x6f, 0x67, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x31, 0x0a, 0x05, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b,
 	0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
-	0x4f, 0x41, 0x75, 0x74, 0x68, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x52, 0x09, 0x70,
-	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x22, 0x2c, 0x0a, 0x1a, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x4f, 0x41, 0x75, 0x74, 0x68, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x52,
+	0x45, 0x76, 0x6d, 0x4c, 0x6f, 0x67, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x05, 0x73, 0x74, 0x6f,
+	0x72, 0x65, 0x22, 0x4d, 0x0a, 0x18, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x76, 0x6d, 0x4c,
+	0x6f, 0x67, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x31,
+	0x0a, 0x05, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
+	0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45,
+	0x76, 0x6d, 0x4c, 0x6f, 0x67, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x05, 0x73, 0x74, 0x6f, 0x72,
+	0x65, 0x22, 0x1b, 0x0a, 0x19, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x76, 0x6d, 0x4c, 0x6f,
+	0x67, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x55,
+	0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x76, 0x6d, 0x4c, 0x6f, 0x67, 0x53, 0x74, 0x6f, 0x72,
+	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3a, 0x0a, 0x0a, 0x70, 0x61, 0x67,
+	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50,
+	0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x4f, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x76, 0x6d,
+	0x4c, 0x6f, 0x67, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x33, 0x0a, 0x06, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1b, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x45, 0x76, 0x6d, 0x4c, 0x6f, 0x67, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x06,
+	0x73, 0x74, 0x6f, 0x72, 0x65, 0x73, 0x22, 0x2a, 0x0a, 0x18, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x45, 0x76, 0x6d, 0x4c, 0x6f, 0x67, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02,
+	0x69, 0x64, 0x22, 0x1b, 0x0a, 0x19, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x76, 0x6d, 0x4c,
+	0x6f, 0x67, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x59, 0x0a, 0x1b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x76, 0x6d, 0x4c, 0x6f, 0x67, 0x50,
+	0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3a,
+	0x0a, 0x08, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1e, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x45, 0x76, 0x6d, 0x4c, 0x6f, 0x67, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65,
+	0x52, 0x08, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x22, 0x2e, 0x0a, 0x1c, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x45, 0x76, 0x6d, 0x4c, 0x6f, 0x67, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69,
+	0x6e, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x69, 0x64, 0x22, 0x2a, 0x0a, 0x18, 0x47, 0x65,
+	0x74, 0x45, 0x76, 0x6d, 0x4c, 0x6f, 0x67, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x52,
 	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0d, 0x52, 0x02, 0x69, 0x64, 0x22, 0x1d, 0x0a, 0x1b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
-	0x4f, 0x41, 0x75, 0x74, 0x68, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x59, 0x0a, 0x10, 0x4f, 0x41, 0x75, 0x74, 0x68, 0x4c, 0x6f,
-	0x67, 0x69, 0x6e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x6f,
-	0x76, 0x69, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a,
-	0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x10,
-	0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c,
-	0x22, 0x1b, 0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x41, 0x75, 0x74, 0x68, 0x4c, 0x6f, 0x67,
-	0x69, 0x6e, 0x55, 0x72, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x58, 0x0a,
-	0x1a, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x41, 0x75, 0x74, 0x68, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x55,
-	0x72, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x07, 0x6f,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x65,
-	0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x41,
-	0x75, 0x74, 0x68, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07,
-	0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x12, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x55,
-	0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x43, 0x0a, 0x11, 0x4c,
-	0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x2e, 0x0a, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x18, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31,
-	0x2e, 0x41, 0x75, 0x74, 0x68, 0x55, 0x73, 0x65, 0x72, 0x52, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73,
-	0x22, 0x75, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d,
-	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x12, 0x0a,
-	0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x6f, 0x6c,
-	0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x22, 0x24, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a,
-	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x69, 0x64, 0x22, 0x85, 0x01,
-	0x0a, 0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52,
-	0x02, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12,
-	0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72,
-	0x6f, 0x6c, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73,
-	0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73,
-	0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x14, 0x0a, 0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55,
-	0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x23, 0x0a, 0x11, 0x44,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x69, 0x64,
-	0x22, 0x14, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xe9, 0x03, 0x0a, 0x0c, 0x45, 0x76, 0x6d, 0x69, 0x45,
-	0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x12, 0x13, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x02, 0x69, 0x64, 0x88, 0x01, 0x01, 0x12, 0x12, 0x0a, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x12, 0x2d, 0x0a, 0x13, 0x65, 0x76, 0x6d, 0x5f, 0x6c, 0x6f, 0x67, 0x5f, 0x70, 0x69, 0x70, 0x65,
-	0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x65,
-	0x76, 0x6d, 0x4c, 0x6f, 0x67, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x49, 0x64, 0x12,
-	0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x42, 0x6c, 0x6f,
-	0x63, 0x6b, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x79, 0x6e, 0x63, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
-	0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x73, 0x79, 0x6e, 0x63, 0x42, 0x6c, 0x6f, 0x63,
-	0x6b, 0x12, 0x24, 0x0a, 0x0e, 0x73, 0x79, 0x6e, 0x63, 0x5f, 0x6c, 0x6f, 0x67, 0x5f, 0x69, 0x6e,
-	0x64, 0x65, 0x78, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x73, 0x79, 0x6e, 0x63, 0x4c,
-	0x6f, 0x67, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x2c, 0x0a, 0x12, 0x70, 0x6c, 0x75, 0x67, 0x69,
-	0x6e, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x6a, 0x73, 0x6f, 0x6e, 0x18, 0x09, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x10, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x4a, 0x73, 0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x5f,
-	0x69, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e,
-	0x49, 0x64, 0x12, 0x22, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
-	0x18, 0x0d, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x01, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x22, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x64, 0x5f, 0x61, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x02, 0x52, 0x09, 0x75, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x22, 0x0a, 0x0a, 0x64, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x03,
-	0x52, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x42, 0x05,
-	0x0a, 0x03, 0x5f, 0x69, 0x64, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x64, 0x5f, 0x61, 0x74, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
-	0x5f, 0x61, 0x74, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f,
-	0x61, 0x74, 0x22, 0xa6, 0x03, 0x0a, 0x06, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x12, 0x13, 0x0a,
-	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x02, 0x69, 0x64, 0x88,
-	0x01, 0x01, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73,
-	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x69, 0x74, 0x5f,
-	0x75, 0x72, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x69, 0x74, 0x55, 0x72,
-	0x6c, 0x12, 0x17, 0x0a, 0x07, 0x73, 0x6f, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x07, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x06, 0x73, 0x6f, 0x50, 0x61, 0x74, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x69, 0x74, 0x5f,
-	0x72, 0x65, 0x66, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x69, 0x74, 0x52, 0x65,
-	0x66, 0x12, 0x2c, 0x0a, 0x12, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x73, 0x63, 0x68, 0x65,
-	0x6d, 0x61, 0x5f, 0x6a, 0x73, 0x6f, 0x6e, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x63,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x4a, 0x73, 0x6f, 0x6e, 0x12,
-	0x22, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0a, 0x20,
-	0x01, 0x28, 0x0d, 0x48, 0x01, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
-	0x88, 0x01, 0x01, 0x12, 0x22, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61,
-	0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x02, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x22, 0x0a, 0x0a, 0x64, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x03, 0x52, 0x09, 0x64,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x42, 0x05, 0x0a, 0x03, 0x5f,
-	0x69, 0x64, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61,
-	0x74, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
-	0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x4a,
-	0x04, 0x08, 0x05, 0x10, 0x06, 0x4a, 0x04, 0x08, 0x06, 0x10, 0x07, 0x22, 0x45, 0x0a, 0x13, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x2e, 0x0a, 0x06, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x16, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72,
-	0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x52, 0x06, 0x70, 0x6c, 0x75, 0x67,
-	0x69, 0x6e, 0x22, 0x26, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6c, 0x75, 0x67,
-	0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x69, 0x64, 0x22, 0x22, 0x0a, 0x10, 0x47, 0x65,
-	0x74, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e,
-	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x69, 0x64, 0x22, 0x43,
-	0x0a, 0x11, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x06, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65,
-	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x52, 0x06, 0x70, 0x6c, 0x75,
-	0x67, 0x69, 0x6e, 0x22, 0x45, 0x0a, 0x13, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x6c, 0x75,
-	0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2e, 0x0a, 0x06, 0x70, 0x6c,
-	0x75, 0x67, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x65, 0x76, 0x6d,
-	0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6c, 0x75, 0x67,
-	0x69, 0x6e, 0x52, 0x06, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x22, 0x16, 0x0a, 0x14, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x50, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e,
+	0x28, 0x0d, 0x52, 0x02, 0x69, 0x64, 0x22, 0x57, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x45, 0x76, 0x6d,
+	0x4c, 0x6f, 0x67, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x08, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65,
+	0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x6d, 0x4c, 0x6f, 0x67, 0x50, 0x69, 0x70,
+	0x65, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x08, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x22,
+	0x59, 0x0a, 0x1b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x76, 0x6d, 0x4c, 0x6f, 0x67, 0x50,
+	0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3a,
+	0x0a, 0x08, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1e, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x45, 0x76, 0x6d, 0x4c, 0x6f, 0x67, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65,
+	0x52, 0x08, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x22, 0x1e, 0x0a, 0x1c, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x45, 0x76, 0x6d, 0x4c, 0x6f, 0x67, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69,
+	0x6e, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x58, 0x0a, 0x1a, 0x4c, 0x69,
+	0x73, 0x74, 0x45, 0x76, 0x6d, 0x4c, 0x6f, 0x67, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65,
 	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3a, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69,
 	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x65,
 	0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61,
 	0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x22, 0x47, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6c, 0x75, 0x67,
-	0x69, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x07, 0x70,
-	0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x65,
-	0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6c,
-	0x75, 0x67, 0x69, 0x6e, 0x52, 0x07, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x22, 0x25, 0x0a,
-	0x13, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x02, 0x69, 0x64, 0x22, 0x16, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6c,
-	0x75, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x26, 0x0a, 0x14,
-	0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x02, 0x69, 0x64, 0x22, 0x5f, 0x0a, 0x15, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x50,
-	0x6c, 0x75, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a,
-	0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
-	0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x16, 0x0a,
-	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x33, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6c, 0x75,
-	0x67, 0x69, 0x6e, 0x47, 0x69, 0x74, 0x52, 0x65, 0x66, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x69, 0x74, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x06, 0x67, 0x69, 0x74, 0x55, 0x72, 0x6c, 0x22, 0x4b, 0x0a, 0x19, 0x4c, 0x69,
-	0x73, 0x74, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x47, 0x69, 0x74, 0x52, 0x65, 0x66, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x62, 0x72, 0x61, 0x6e, 0x63,
-	0x68, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x62, 0x72, 0x61, 0x6e, 0x63,
-	0x68, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
-	0x09, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x22, 0x55, 0x0a, 0x19, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x45, 0x76, 0x6d, 0x69, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x38, 0x0a, 0x08, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72,
+	0x74, 0x69, 0x6f, 0x6e, 0x22, 0x5b, 0x0a, 0x1b, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x76, 0x6d, 0x4c,
+	0x6f, 0x67, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a, 0x09, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64,
+	0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x6d, 0x4c, 0x6f, 0x67, 0x50, 0x69,
+	0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x09, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65,
+	0x73, 0x22, 0x2d, 0x0a, 0x1b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x76, 0x6d, 0x4c, 0x6f,
+	0x67, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x69, 0x64,
+	0x22, 0x1e, 0x0a, 0x1c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x76, 0x6d, 0x4c, 0x6f, 0x67,
+	0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x51, 0x0a, 0x19, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x76, 0x6d, 0x4c, 0x6f, 0x67,
+	0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x34, 0x0a,
+	0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
+	0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45,
+	0x76, 0x6d, 0x4c, 0x6f, 0x67, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x06, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x22, 0x2c, 0x0a, 0x1a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x76, 0x6d,
+	0x4c, 0x6f, 0x67, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x69,
+	0x64, 0x22, 0x28, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x45, 0x76, 0x6d, 0x4c, 0x6f, 0x67, 0x53, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x69, 0x64, 0x22, 0x4f, 0x0a, 0x17, 0x47,
+	0x65, 0x74, 0x45, 0x76, 0x6d, 0x4c, 0x6f, 0x67, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
 	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64,
-	0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x6d, 0x69, 0x45, 0x78, 0x70, 0x6f,
-	0x72, 0x74, 0x65, 0x72, 0x52, 0x08, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x22, 0x2c,
-	0x0a, 0x1a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x76, 0x6d, 0x69, 0x45, 0x78, 0x70, 0x6f,
-	0x72, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x69, 0x64, 0x22, 0x28, 0x0a, 0x16,
-	0x47, 0x65, 0x74, 0x45, 0x76, 0x6d, 0x69, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0d, 0x52, 0x02, 0x69, 0x64, 0x22, 0x53, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x45, 0x76, 0x6d,
-	0x69, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x38, 0x0a, 0x08, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65,
-	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x6d, 0x69, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65,
-	0x72, 0x52, 0x08, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x22, 0x55, 0x0a, 0x19, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x76, 0x6d, 0x69, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65,
-	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x38, 0x0a, 0x08, 0x65, 0x78, 0x70, 0x6f,
-	0x72, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x65, 0x76, 0x6d,
-	0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x6d, 0x69,
-	0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x52, 0x08, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74,
-	0x65, 0x72, 0x22, 0x1c, 0x0a, 0x1a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x76, 0x6d, 0x69,
-	0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x77, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x76, 0x6d, 0x69, 0x45, 0x78, 0x70, 0x6f,
-	0x72, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3a, 0x0a, 0x0a,
-	0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1a, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76,
-	0x31, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x70, 0x61,
-	0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x69, 0x70, 0x65,
-	0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x70,
-	0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x49, 0x64, 0x22, 0x57, 0x0a, 0x19, 0x4c, 0x69, 0x73,
-	0x74, 0x45, 0x76, 0x6d, 0x69, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x09, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74,
-	0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x65, 0x76, 0x6d, 0x5f,
-	0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x6d, 0x69, 0x45,
-	0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x52, 0x09, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65,
-	0x72, 0x73, 0x22, 0x2b, 0x0a, 0x19, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x76, 0x6d, 0x69,
-	0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x69, 0x64, 0x22,
-	0x1c, 0x0a, 0x1a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x76, 0x6d, 0x69, 0x45, 0x78, 0x70,
-	0x6f, 0x72, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x26, 0x0a,
-	0x14, 0x53, 0x74, 0x61, 0x72, 0x74, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x52, 0x65,
+	0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x6d, 0x4c, 0x6f, 0x67, 0x53, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x22, 0x51, 0x0a, 0x19,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x76, 0x6d, 0x4c, 0x6f, 0x67, 0x53, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x34, 0x0a, 0x06, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x65, 0x76, 0x6d, 0x5f,
+	0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x6d, 0x4c, 0x6f,
+	0x67, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x22,
+	0x1c, 0x0a, 0x1a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x76, 0x6d, 0x4c, 0x6f, 0x67, 0x53,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x77, 0x0a,
+	0x18, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x76, 0x6d, 0x4c, 0x6f, 0x67, 0x53, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3a, 0x0a, 0x0a, 0x70, 0x61, 0x67,
+	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50,
+	0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e,
+	0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x70, 0x69, 0x70, 0x65,
+	0x6c, 0x69, 0x6e, 0x65, 0x49, 0x64, 0x22, 0x53, 0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x76,
+	0x6d, 0x4c, 0x6f, 0x67, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x07, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x6d, 0x4c, 0x6f, 0x67, 0x53, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x52, 0x07, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x22, 0x2b, 0x0a, 0x19, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x76, 0x6d, 0x4c, 0x6f, 0x67, 0x53, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x69, 0x64, 0x22, 0x1c, 0x0a, 0x1a, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x45, 0x76, 0x6d, 0x4c, 0x6f, 0x67, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x43, 0x0a, 0x20, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x45, 0x76, 0x6d, 0x4c, 0x6f, 0x67, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x69,
+	0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x0a, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x49, 0x64, 0x22, 0x2d, 0x0a, 0x1b, 0x52,
+	0x65, 0x64, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x45, 0x76, 0x6d, 0x4c, 0x6f, 0x67, 0x53, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x69, 0x64, 0x22, 0x1e, 0x0a, 0x1c, 0x52, 0x65,
+	0x64, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x45, 0x76, 0x6d, 0x4c, 0x6f, 0x67, 0x53, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x75, 0x0a, 0x19, 0x52, 0x65,
+	0x73, 0x79, 0x6e, 0x63, 0x45, 0x76, 0x6d, 0x4c, 0x6f, 0x67, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x72, 0x6f, 0x6d, 0x5f,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x66, 0x72, 0x6f,
+	0x6d, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x29, 0x0a, 0x10, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64,
+	0x65, 0x5f, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0f, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65,
+	0x6e, 0x22, 0x3b, 0x0a, 0x1a, 0x52, 0x65, 0x73, 0x79, 0x6e, 0x63, 0x45, 0x76, 0x6d, 0x4c, 0x6f,
+	0x67, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x1d, 0x0a, 0x0a, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0d, 0x52, 0x09, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x73, 0x22, 0x2b,
+	0x0a, 0x19, 0x53, 0x74, 0x61, 0x72, 0x74, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x6e, 0x64,
+	0x65, 0x78, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x69, 0x64, 0x22, 0x4c, 0x0a, 0x1a, 0x53,
+	0x74, 0x61, 0x72, 0x74, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x65,
+	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x2a, 0x0a, 0x18, 0x53, 0x74, 0x6f,
+	0x70, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x02, 0x69, 0x64, 0x22, 0x4b, 0x0a, 0x19, 0x53, 0x74, 0x6f, 0x70, 0x53, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x22, 0x6b, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x76, 0x6d, 0x4c, 0x6f, 0x67,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x66, 0x72, 0x6f, 0x6d, 0x42,
+	0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x6f, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x74, 0x6f, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x22,
+	0x41, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x76, 0x6d, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x04, 0x6c, 0x6f, 0x67, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x6d, 0x4c, 0x6f, 0x67, 0x52, 0x04, 0x6c, 0x6f,
+	0x67, 0x73, 0x22, 0x4d, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x61, 0x74, 0x65, 0x73, 0x74,
+	0x45, 0x76, 0x6d, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b,
+	0x0a, 0x09, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x08, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c,
+	0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69,
+	0x74, 0x22, 0x47, 0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x45,
+	0x76, 0x6d, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a,
+	0x0a, 0x04, 0x6c, 0x6f, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x65,
+	0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76,
+	0x6d, 0x4c, 0x6f, 0x67, 0x52, 0x04, 0x6c, 0x6f, 0x67, 0x73, 0x22, 0x73, 0x0a, 0x1a, 0x4c, 0x69,
+	0x73, 0x74, 0x45, 0x76, 0x6d, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x66, 0x72, 0x6f, 0x6d, 0x42,
+	0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x6f, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x74, 0x6f, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x22,
+	0x61, 0x0a, 0x1b, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x76, 0x6d, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x42,
+	0x0a, 0x0c, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x6d, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x22, 0x6d, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x76, 0x6d, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x72, 0x6f, 0x6d, 0x5f,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x66, 0x72, 0x6f,
+	0x6d, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x6f, 0x5f, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x74, 0x6f, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x22, 0x49, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x76, 0x6d, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x06, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x65, 0x76, 0x6d,
+	0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x6d, 0x42,
+	0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x06, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x22, 0x6d, 0x0a, 0x14,
+	0x4c, 0x69, 0x73, 0x74, 0x45, 0x76, 0x6d, 0x54, 0x72, 0x61, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49,
+	0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x66, 0x72, 0x6f, 0x6d, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x12, 0x19, 0x0a, 0x08, 0x74, 0x6f, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x07, 0x74, 0x6f, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x49, 0x0a, 0x15, 0x4c,
+	0x69, 0x73, 0x74, 0x45, 0x76, 0x6d, 0x54, 0x72, 0x61, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x06, 0x74, 0x72, 0x61, 0x63, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x6d, 0x54, 0x72, 0x61, 0x63, 0x65, 0x52, 0x06,
+	0x74, 0x72, 0x61, 0x63, 0x65, 0x73, 0x22, 0x60, 0x0a, 0x08, 0x41, 0x75, 0x74, 0x68, 0x55, 0x73,
+	0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65,
+	0x6d, 0x61, 0x69, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x22, 0xd3, 0x01, 0x0a, 0x0f, 0x41, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x22, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x88, 0x01, 0x01, 0x12, 0x22, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f,
+	0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x48, 0x01, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69,
+	0x72, 0x65, 0x73, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x25, 0x0a, 0x0c, 0x6c, 0x61, 0x73, 0x74,
+	0x5f, 0x75, 0x73, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x48, 0x02,
+	0x52, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x55, 0x73, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x42,
+	0x0d, 0x0a, 0x0b, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x42, 0x0d,
+	0x0a, 0x0b, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x42, 0x0f, 0x0a,
+	0x0d, 0x5f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x73, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x22, 0x46,
+	0x0a, 0x0c, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a,
+	0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61,
+	0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61,
+	0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x58, 0x0a, 0x0d, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x22, 0x0a,
+	0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x48, 0x00, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x88, 0x01,
+	0x01, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74,
+	0x22, 0x0b, 0x0a, 0x09, 0x4d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x3a, 0x0a,
+	0x0a, 0x4d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x04, 0x75,
+	0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x65, 0x76, 0x6d, 0x5f,
+	0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x55,
+	0x73, 0x65, 0x72, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x22, 0x56, 0x0a, 0x18, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x26, 0x0a, 0x0f, 0x65, 0x78, 0x70,
+	0x69, 0x72, 0x65, 0x73, 0x5f, 0x69, 0x6e, 0x5f, 0x64, 0x61, 0x79, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x0d, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x49, 0x6e, 0x44, 0x61, 0x79,
+	0x73, 0x22, 0x88, 0x01, 0x0a, 0x19, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x22, 0x0a, 0x0a, 0x65, 0x78, 0x70,
+	0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52,
+	0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x88, 0x01, 0x01, 0x42, 0x0d, 0x0a,
+	0x0b, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x22, 0x19, 0x0a, 0x17,
+	0x4c, 0x69, 0x73, 0x74, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x53, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x41,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x06, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x06, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x22, 0x2a, 0x0a, 0x18,
+	0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x69, 0x64, 0x22, 0x1b, 0x0a, 0x19, 0x52, 0x65, 0x76, 0x6f,
+	0x6b, 0x65, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x8d, 0x02, 0x0a, 0x0d, 0x4f, 0x41, 0x75, 0x74, 0x68, 0x50,
+	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x13, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x02, 0x69, 0x64, 0x88, 0x01, 0x01, 0x12, 0x18, 0x0a, 0x07,
+	0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65,
+	0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x75, 0x74, 0x68, 0x5f,
+	0x75, 0x72, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x75, 0x74, 0x68, 0x55,
+	0x72, 0x6c, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x75, 0x72, 0x6c, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x55, 0x72, 0x6c, 0x12,
+	0x22, 0x0a, 0x0d, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x5f, 0x75, 0x72, 0x6c,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x75, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f,
+	0x55, 0x72, 0x6c, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x5f,
+	0x75, 0x72, 0x6c, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x64, 0x69, 0x72,
+	0x65, 0x63, 0x74, 0x55, 0x72, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x73,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x42, 0x05,
+	0x0a, 0x03, 0x5f, 0x69, 0x64, 0x22, 0x7c, 0x0a, 0x1a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f,
+	0x41, 0x75, 0x74, 0x68, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x39, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65,
+	0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x41, 0x75, 0x74, 0x68, 0x50, 0x72, 0x6f, 0x76,
+	0x69, 0x64, 0x65, 0x72, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x23,
+	0x0a, 0x0d, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x63,
+	0x72, 0x65, 0x74, 0x22, 0x2d, 0x0a, 0x1b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x41, 0x75,
+	0x74, 0x68, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02,
+	0x69, 0x64, 0x22, 0x7c, 0x0a, 0x1a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x41, 0x75, 0x74,
+	0x68, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x39, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x41, 0x75, 0x74, 0x68, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
+	0x72, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x63,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0c, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74,
+	0x22, 0x1d, 0x0a, 0x1b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x41, 0x75, 0x74, 0x68, 0x50,
+	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x1b, 0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x41, 0x75, 0x74, 0x68, 0x50, 0x72, 0x6f, 0x76,
+	0x69, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x59, 0x0a, 0x1a,
+	0x4c, 0x69, 0x73, 0x74, 0x4f, 0x41, 0x75, 0x74, 0x68, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
+	0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x09, 0x70, 0x72,
+	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e,
+	0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4f,
+	0x41, 0x75, 0x74, 0x68, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x52, 0x09, 0x70, 0x72,
+	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x22, 0x2c, 0x0a, 0x1a, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x4f, 0x41, 0x75, 0x74, 0x68, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x52, 0x65,
 	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0d, 0x52, 0x02, 0x69, 0x64, 0x22, 0x47, 0x0a, 0x15, 0x53, 0x74, 0x61, 0x72, 0x74, 0x45, 0x78,
-	0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18,
-	0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f,
-	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x25,
-	0x0a, 0x13, 0x53, 0x74, 0x6f, 0x70, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x52, 0x65,
+	0x0d, 0x52, 0x02, 0x69, 0x64, 0x22, 0x1d, 0x0a, 0x1b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4f,
+	0x41, 0x75, 0x74, 0x68, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x59, 0x0a, 0x10, 0x4f, 0x41, 0x75, 0x74, 0x68, 0x4c, 0x6f, 0x67,
+	0x69, 0x6e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x76,
+	0x69, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x70,
+	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a,
+	0x03, 0x75, 0x72, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x22,
+	0x1b, 0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x41, 0x75, 0x74, 0x68, 0x4c, 0x6f, 0x67, 0x69,
+	0x6e, 0x55, 0x72, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x58, 0x0a, 0x1a,
+	0x4c, 0x69, 0x73, 0x74, 0x4f, 0x41, 0x75, 0x74, 0x68, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x55, 0x72,
+	0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x07, 0x6f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x65, 0x76,
+	0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x41, 0x75,
+	0x74, 0x68, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x6f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x12, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x73,
+	0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x43, 0x0a, 0x11, 0x4c, 0x69,
+	0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x2e, 0x0a, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18,
+	0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
+	0x41, 0x75, 0x74, 0x68, 0x55, 0x73, 0x65, 0x72, 0x52, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x22,
+	0x75, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x12, 0x0a, 0x04,
+	0x72, 0x6f, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x22, 0x24, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x69, 0x64, 0x22, 0x85, 0x01, 0x0a,
+	0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x6f,
+	0x6c, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73,
+	0x77, 0x6f, 0x72, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73,
+	0x77, 0x6f, 0x72, 0x64, 0x22, 0x14, 0x0a, 0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73,
+	0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x23, 0x0a, 0x11, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x69, 0x64, 0x22,
+	0x14, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xe9, 0x03, 0x0a, 0x0c, 0x45, 0x76, 0x6d, 0x69, 0x45, 0x78,
+	0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x12, 0x13, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0d, 0x48, 0x00, 0x52, 0x02, 0x69, 0x64, 0x88, 0x01, 0x01, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x2d, 0x0a, 0x13, 0x65, 0x76, 0x6d, 0x5f, 0x6c, 0x6f, 0x67, 0x5f, 0x70, 0x69, 0x70, 0x65, 0x6c,
+	0x69, 0x6e, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x65, 0x76,
+	0x6d, 0x4c, 0x6f, 0x67, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x49, 0x64, 0x12, 0x18,
+	0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x79, 0x6e, 0x63, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x73, 0x79, 0x6e, 0x63, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x12, 0x24, 0x0a, 0x0e, 0x73, 0x79, 0x6e, 0x63, 0x5f, 0x6c, 0x6f, 0x67, 0x5f, 0x69, 0x6e, 0x64,
+	0x65, 0x78, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x73, 0x79, 0x6e, 0x63, 0x4c, 0x6f,
+	0x67, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x2c, 0x0a, 0x12, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e,
+	0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x6a, 0x73, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x10, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x4a, 0x73, 0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x5f, 0x69,
+	0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x49,
+	0x64, 0x12, 0x22, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
+	0x0d, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x01, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x22, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x02, 0x52, 0x09, 0x75, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x22, 0x0a, 0x0a, 0x64, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x03, 0x52,
+	0x09, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x42, 0x05, 0x0a,
+	0x03, 0x5f, 0x69, 0x64, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f,
+	0x61, 0x74, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f, 0x61,
+	0x74, 0x22, 0xa6, 0x03, 0x0a, 0x06, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x12, 0x13, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x02, 0x69, 0x64, 0x88, 0x01,
+	0x01, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x69, 0x74, 0x5f, 0x75,
+	0x72, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x69, 0x74, 0x55, 0x72, 0x6c,
+	0x12, 0x17, 0x0a, 0x07, 0x73, 0x6f, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x73, 0x6f, 0x50, 0x61, 0x74, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x69, 0x74, 0x5f, 0x72,
+	0x65, 0x66, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x69, 0x74, 0x52, 0x65, 0x66,
+	0x12, 0x2c, 0x0a, 0x12, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x6d,
+	0x61, 0x5f, 0x6a, 0x73, 0x6f, 0x6e, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x4a, 0x73, 0x6f, 0x6e, 0x12, 0x22,
+	0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x0d, 0x48, 0x01, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x88,
+	0x01, 0x01, 0x12, 0x22, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x02, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x22, 0x0a, 0x0a, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x64, 0x5f, 0x61, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x03, 0x52, 0x09, 0x64, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x42, 0x05, 0x0a, 0x03, 0x5f, 0x69,
+	0x64, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
+	0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x42,
+	0x0d, 0x0a, 0x0b, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x4a, 0x04,
+	0x08, 0x05, 0x10, 0x06, 0x4a, 0x04, 0x08, 0x06, 0x10, 0x07, 0x22, 0x45, 0x0a, 0x13, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x2e, 0x0a, 0x06, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x16, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x52, 0x06, 0x70, 0x6c, 0x75, 0x67, 0x69,
+	0x6e, 0x22, 0x26, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6c, 0x75, 0x67, 0x69,
+	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x69, 0x64, 0x22, 0x22, 0x0a, 0x10, 0x47, 0x65, 0x74,
+	0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x69, 0x64, 0x22, 0x43, 0x0a,
+	0x11, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x2e, 0x0a, 0x06, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x16, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x52, 0x06, 0x70, 0x6c, 0x75, 0x67,
+	0x69, 0x6e, 0x22, 0x45, 0x0a, 0x13, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x6c, 0x75, 0x67,
+	0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2e, 0x0a, 0x06, 0x70, 0x6c, 0x75,
+	0x67, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x65, 0x76, 0x6d, 0x5f,
+	0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6c, 0x75, 0x67, 0x69,
+	0x6e, 0x52, 0x06, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x22, 0x16, 0x0a, 0x14, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x50, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3a, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x65, 0x76,
+	0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x67,
+	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x22, 0x47, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6c, 0x75, 0x67, 0x69,
+	0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x07, 0x70, 0x6c,
+	0x75, 0x67, 0x69, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x65, 0x76,
+	0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6c, 0x75,
+	0x67, 0x69, 0x6e, 0x52, 0x07, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x22, 0x25, 0x0a, 0x13,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x02, 0x69, 0x64, 0x22, 0x16, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6c, 0x75,
+	0x67, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x26, 0x0a, 0x14, 0x49,
+	0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x02, 0x69, 0x64, 0x22, 0x5f, 0x0a, 0x15, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x50, 0x6c,
+	0x75, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73,
+	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x22, 0x33, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6c, 0x75, 0x67,
+	0x69, 0x6e, 0x47, 0x69, 0x74, 0x52, 0x65, 0x66, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x17, 0x0a, 0x07, 0x67, 0x69, 0x74, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x67, 0x69, 0x74, 0x55, 0x72, 0x6c, 0x22, 0x4b, 0x0a, 0x19, 0x4c, 0x69, 0x73,
+	0x74, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x47, 0x69, 0x74, 0x52, 0x65, 0x66, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68,
+	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68,
+	0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x22, 0x55, 0x0a, 0x19, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x45, 0x76, 0x6d, 0x69, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x38, 0x0a, 0x08, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65,
+	0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x6d, 0x69, 0x45, 0x78, 0x70, 0x6f, 0x72,
+	0x74, 0x65, 0x72, 0x52, 0x08, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x22, 0x2c, 0x0a,
+	0x1a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x76, 0x6d, 0x69, 0x45, 0x78, 0x70, 0x6f, 0x72,
+	0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x69, 0x64, 0x22, 0x28, 0x0a, 0x16, 0x47,
+	0x65, 0x74, 0x45, 0x76, 0x6d, 0x69, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x52, 0x65,
 	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0d, 0x52, 0x02, 0x69, 0x64, 0x22, 0x46, 0x0a, 0x14, 0x53, 0x74, 0x6f, 0x70, 0x45, 0x78, 0x70,
+	0x0d, 0x52, 0x02, 0x69, 0x64, 0x22, 0x53, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x45, 0x76, 0x6d, 0x69,
+	0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x38, 0x0a, 0x08, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x6d, 0x69, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72,
+	0x52, 0x08, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x22, 0x55, 0x0a, 0x19, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x45, 0x76, 0x6d, 0x69, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x38, 0x0a, 0x08, 0x65, 0x78, 0x70, 0x6f, 0x72,
+	0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x65, 0x76, 0x6d, 0x5f,
+	0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x6d, 0x69, 0x45,
+	0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x52, 0x08, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65,
+	0x72, 0x22, 0x1c, 0x0a, 0x1a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x76, 0x6d, 0x69, 0x45,
+	0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x77, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x76, 0x6d, 0x69, 0x45, 0x78, 0x70, 0x6f, 0x72,
+	0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3a, 0x0a, 0x0a, 0x70,
+	0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x70, 0x61, 0x67,
+	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x69, 0x70, 0x65, 0x6c,
+	0x69, 0x6e, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x70, 0x69,
+	0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x49, 0x64, 0x22, 0x57, 0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74,
+	0x45, 0x76, 0x6d, 0x69, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x09, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65,
+	0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69,
+	0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x6d, 0x69, 0x45, 0x78,
+	0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x52, 0x09, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72,
+	0x73, 0x22, 0x2b, 0x0a, 0x19, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x76, 0x6d, 0x69, 0x45,
+	0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x69, 0x64, 0x22, 0x1c,
+	0x0a, 0x1a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x76, 0x6d, 0x69, 0x45, 0x78, 0x70, 0x6f,
+	0x72, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x26, 0x0a, 0x14,
+	0x53, 0x74, 0x61, 0x72, 0x74, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x02, 0x69, 0x64, 0x22, 0x47, 0x0a, 0x15, 0x53, 0x74, 0x61, 0x72, 0x74, 0x45, 0x78, 0x70,
 	0x6f, 0x72, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a,
 	0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
 	0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x43, 0x0a,
-	0x20, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x45, 0x76, 0x6d, 0x69, 0x45, 0x78, 0x70, 0x6f, 0x72,
-	0x74, 0x65, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65,
-	0x49, 0x64, 0x22, 0x1c, 0x0a, 0x1a, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x22, 0x3e, 0x0a, 0x1b, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x1f, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x6a, 0x73, 0x6f, 0x6e, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x4a, 0x73, 0x6f, 0x6e,
-	0x32, 0xaf, 0x32, 0x0a, 0x11, 0x45, 0x76, 0x6d, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x53,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x62, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x45, 0x76, 0x6d,
-	0x69, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x26, 0x2e, 0x65, 0x76, 0x6d, 0x5f,
-	0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x76,
-	0x6d, 0x69, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x27, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e,
-	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x76, 0x6d, 0x69, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e,
-	0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x68, 0x0a, 0x11, 0x4c, 0x69,
-	0x73, 0x74, 0x45, 0x76, 0x6d, 0x69, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x12,
-	0x28, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31,
-	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x76, 0x6d, 0x69, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
-	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x65, 0x76, 0x6d, 0x5f,
-	0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45,
-	0x76, 0x6d, 0x69, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6e, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x76,
-	0x6d, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x12, 0x2a, 0x2e, 0x65, 0x76,
-	0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x45, 0x76, 0x6d, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x63, 0x68, 0x61, 0x69, 0x6e,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e,
-	0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45,
-	0x76, 0x6d, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x65, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x45, 0x76, 0x6d, 0x42, 0x6c,
-	0x6f, 0x63, 0x6b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x12, 0x27, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x25, 0x0a,
+	0x13, 0x53, 0x74, 0x6f, 0x70, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x02, 0x69, 0x64, 0x22, 0x46, 0x0a, 0x14, 0x53, 0x74, 0x6f, 0x70, 0x45, 0x78, 0x70, 0x6f,
+	0x72, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73,
+	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x43, 0x0a, 0x20,
+	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x45, 0x76, 0x6d, 0x69, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74,
+	0x65, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x49,
+	0x64, 0x22, 0x1c, 0x0a, 0x1a, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22,
+	0x3e, 0x0a, 0x1b, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f,
+	0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x6a, 0x73, 0x6f, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x4a, 0x73, 0x6f, 0x6e, 0x32,
+	0xbd, 0x3d, 0x0a, 0x11, 0x45, 0x76, 0x6d, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x62, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x45, 0x76, 0x6d, 0x69,
+	0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x26, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69,
 	0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x76, 0x6d,
-	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x28, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e,
-	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x76, 0x6d, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x63, 0x68,
-	0x61, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6e, 0x0a, 0x13, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x76, 0x6d, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x63, 0x68, 0x61,
-	0x69, 0x6e, 0x12, 0x2a, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72,
-	0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x76, 0x6d, 0x42, 0x6c, 0x6f,
-	0x63, 0x6b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b,
+	0x69, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x27, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x76, 0x6d, 0x69, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x68, 0x0a, 0x11, 0x4c, 0x69, 0x73,
+	0x74, 0x45, 0x76, 0x6d, 0x69, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x28,
 	0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x76, 0x6d, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x63, 0x68,
-	0x61, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6b, 0x0a, 0x12, 0x4c,
-	0x69, 0x73, 0x74, 0x45, 0x76, 0x6d, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x63, 0x68, 0x61, 0x69, 0x6e,
-	0x73, 0x12, 0x29, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e,
-	0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x76, 0x6d, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x63,
-	0x68, 0x61, 0x69, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x65,
-	0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69,
+	0x4c, 0x69, 0x73, 0x74, 0x45, 0x76, 0x6d, 0x69, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69,
+	0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x76,
+	0x6d, 0x69, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x6e, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x76, 0x6d,
+	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x12, 0x2a, 0x2e, 0x65, 0x76, 0x6d,
+	0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x45, 0x76, 0x6d, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64,
+	0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x76,
+	0x6d, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x65, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x45, 0x76, 0x6d, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x12, 0x27, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e,
+	0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x76, 0x6d, 0x42,
+	0x6c, 0x6f, 0x63, 0x6b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x28, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x76, 0x6d, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x63, 0x68, 0x61,
+	0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6e, 0x0a, 0x13, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x45, 0x76, 0x6d, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x63, 0x68, 0x61, 0x69,
+	0x6e, 0x12, 0x2a, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x76, 0x6d, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e,
+	0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x76, 0x6d, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x63, 0x68, 0x61,
+	0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6b, 0x0a, 0x12, 0x4c, 0x69,
 	0x73, 0x74, 0x45, 0x76, 0x6d, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6e, 0x0a, 0x13, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x45, 0x76, 0x6d, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x12,
-	0x2a, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31,
-	0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x76, 0x6d, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x63,
-	0x68, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x65, 0x76,
-	0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x45, 0x76, 0x6d, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x63, 0x68, 0x61, 0x69, 0x6e,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x65, 0x0a, 0x10, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x45, 0x76, 0x6d, 0x4a, 0x73, 0x6f, 0x6e, 0x41, 0x62, 0x69, 0x12, 0x27, 0x2e, 0x65,
-	0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x45, 0x76, 0x6d, 0x4a, 0x73, 0x6f, 0x6e, 0x41, 0x62, 0x69, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65,
-	0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x76, 0x6d,
-	0x4a, 0x73, 0x6f, 0x6e, 0x41, 0x62, 0x69, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x5c, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x45, 0x76, 0x6d, 0x4a, 0x73, 0x6f, 0x6e, 0x41, 0x62, 0x69,
-	0x12, 0x24, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76,
-	0x31, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x76, 0x6d, 0x4a, 0x73, 0x6f, 0x6e, 0x41, 0x62, 0x69, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64,
-	0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x76, 0x6d, 0x4a, 0x73,
-	0x6f, 0x6e, 0x41, 0x62, 0x69, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x65, 0x0a,
-	0x10, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x76, 0x6d, 0x4a, 0x73, 0x6f, 0x6e, 0x41, 0x62,
-	0x69, 0x12, 0x27, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e,
-	0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x76, 0x6d, 0x4a, 0x73, 0x6f, 0x6e,
-	0x41, 0x62, 0x69, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x65, 0x76, 0x6d,
-	0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x45, 0x76, 0x6d, 0x4a, 0x73, 0x6f, 0x6e, 0x41, 0x62, 0x69, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x62, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x76, 0x6d, 0x4a,
-	0x73, 0x6f, 0x6e, 0x41, 0x62, 0x69, 0x73, 0x12, 0x26, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e,
-	0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x76, 0x6d,
-	0x4a, 0x73, 0x6f, 0x6e, 0x41, 0x62, 0x69, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x27, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31,
-	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x76, 0x6d, 0x4a, 0x73, 0x6f, 0x6e, 0x41, 0x62, 0x69, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x65, 0x0a, 0x10, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x45, 0x76, 0x6d, 0x4a, 0x73, 0x6f, 0x6e, 0x41, 0x62, 0x69, 0x12, 0x27, 0x2e, 0x65,
-	0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x45, 0x76, 0x6d, 0x4a, 0x73, 0x6f, 0x6e, 0x41, 0x62, 0x69, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65,
-	0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x76, 0x6d,
-	0x4a, 0x73, 0x6f, 0x6e, 0x41, 0x62, 0x69, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x68, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x76, 0x6d, 0x4c, 0x6f, 0x67, 0x53,
-	0x74, 0x6f, 0x72, 0x65, 0x12, 0x28, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78,
-	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x76, 0x6d, 0x4c,
-	0x6f, 0x67, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29,
+	0x12, 0x29, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x76, 0x6d, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x63, 0x68,
+	0x61, 0x69, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x65, 0x76,
+	0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x45, 0x76, 0x6d, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6e, 0x0a, 0x13, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x45, 0x76, 0x6d, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x12, 0x2a,
 	0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x76, 0x6d, 0x4c, 0x6f, 0x67, 0x53, 0x74, 0x6f, 0x72,
-	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5f, 0x0a, 0x0e, 0x47, 0x65, 0x74,
-	0x45, 0x76, 0x6d, 0x4c, 0x6f, 0x67, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x12, 0x25, 0x2e, 0x65, 0x76,
-	0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
-	0x45, 0x76, 0x6d, 0x4c, 0x6f, 0x67, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x26, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72,
-	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x76, 0x6d, 0x4c, 0x6f, 0x67, 0x53, 0x74, 0x6f,
-	0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x68, 0x0a, 0x11, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x45, 0x76, 0x6d, 0x4c, 0x6f, 0x67, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x12,
-	0x28, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31,
-	0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x76, 0x6d, 0x4c, 0x6f, 0x67, 0x53, 0x74, 0x6f,
-	0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x65, 0x76, 0x6d, 0x5f,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x76, 0x6d, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x63, 0x68,
+	0x61, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x65, 0x76, 0x6d,
+	0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x45, 0x76, 0x6d, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x65, 0x0a, 0x10, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x45, 0x76, 0x6d, 0x4a, 0x73, 0x6f, 0x6e, 0x41, 0x62, 0x69, 0x12, 0x27, 0x2e, 0x65, 0x76,
+	0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x45, 0x76, 0x6d, 0x4a, 0x73, 0x6f, 0x6e, 0x41, 0x62, 0x69, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x76, 0x6d, 0x4a,
+	0x73, 0x6f, 0x6e, 0x41, 0x62, 0x69, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5c,
+	0x0a, 0x0d, 0x47, 0x65, 0x74, 0x45, 0x76, 0x6d, 0x4a, 0x73, 0x6f, 0x6e, 0x41, 0x62, 0x69, 0x12,
+	0x24, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x47, 0x65, 0x74, 0x45, 0x76, 0x6d, 0x4a, 0x73, 0x6f, 0x6e, 0x41, 0x62, 0x69, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65,
+	0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x76, 0x6d, 0x4a, 0x73, 0x6f,
+	0x6e, 0x41, 0x62, 0x69, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x65, 0x0a, 0x10,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x76, 0x6d, 0x4a, 0x73, 0x6f, 0x6e, 0x41, 0x62, 0x69,
+	0x12, 0x27, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x76, 0x6d, 0x4a, 0x73, 0x6f, 0x6e, 0x41,
+	0x62, 0x69, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x65, 0x76, 0x6d, 0x5f,
 	0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x45, 0x76, 0x6d, 0x4c, 0x6f, 0x67, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x65, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x76, 0x6d, 0x4c,
-	0x6f, 0x67, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x73, 0x12, 0x27, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69,
+	0x65, 0x45, 0x76, 0x6d, 0x4a, 0x73, 0x6f, 0x6e, 0x41, 0x62, 0x69, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x62, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x76, 0x6d, 0x4a, 0x73,
+	0x6f, 0x6e, 0x41, 0x62, 0x69, 0x73, 0x12, 0x26, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64,
+	0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x76, 0x6d, 0x4a,
+	0x73, 0x6f, 0x6e, 0x41, 0x62, 0x69, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27,
+	0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x45, 0x76, 0x6d, 0x4a, 0x73, 0x6f, 0x6e, 0x41, 0x62, 0x69, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x65, 0x0a, 0x10, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x45, 0x76, 0x6d, 0x4a, 0x73, 0x6f, 0x6e, 0x41, 0x62, 0x69, 0x12, 0x27, 0x2e, 0x65, 0x76,
+	0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x45, 0x76, 0x6d, 0x4a, 0x73, 0x6f, 0x6e, 0x41, 0x62, 0x69, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x76, 0x6d, 0x4a,
+	0x73, 0x6f, 0x6e, 0x41, 0x62, 0x69, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6e,
+	0x0a, 0x13, 0x47, 0x65, 0x74, 0x45, 0x76, 0x6d, 0x4a, 0x73, 0x6f, 0x6e, 0x41, 0x62, 0x69, 0x53,
+	0x63, 0x68, 0x65, 0x6d, 0x61, 0x12, 0x2a, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65,
+	0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x76, 0x6d, 0x4a, 0x73, 0x6f,
+	0x6e, 0x41, 0x62, 0x69, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x2b, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x76, 0x6d, 0x4a, 0x73, 0x6f, 0x6e, 0x41, 0x62, 0x69,
+	0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7a,
+	0x0a, 0x17, 0x53, 0x65, 0x74, 0x45, 0x76, 0x6d, 0x49, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x62, 0x69, 0x12, 0x2e, 0x2e, 0x65, 0x76, 0x6d, 0x5f,
+	0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x45, 0x76,
+	0x6d, 0x49, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41,
+	0x62, 0x69, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x65, 0x76, 0x6d, 0x5f,
+	0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x45, 0x76,
+	0x6d, 0x49, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41,
+	0x62, 0x69, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x80, 0x01, 0x0a, 0x19, 0x4c,
+	0x69, 0x73, 0x74, 0x45, 0x76, 0x6d, 0x49, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x41, 0x62, 0x69, 0x73, 0x12, 0x30, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69,
 	0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x76,
-	0x6d, 0x4c, 0x6f, 0x67, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x28, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e,
-	0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x76, 0x6d, 0x4c, 0x6f, 0x67, 0x53, 0x74, 0x6f,
-	0x72, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x68, 0x0a, 0x11, 0x44,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x76, 0x6d, 0x4c, 0x6f, 0x67, 0x53, 0x74, 0x6f, 0x72, 0x65,
+	0x6d, 0x49, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41,
+	0x62, 0x69, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x65, 0x76, 0x6d,
+	0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x45, 0x76, 0x6d, 0x49, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x41, 0x62, 0x69, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x83, 0x01,
+	0x0a, 0x1a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x76, 0x6d, 0x49, 0x6d, 0x70, 0x6c, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x62, 0x69, 0x12, 0x31, 0x2e, 0x65,
+	0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x45, 0x76, 0x6d, 0x49, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x62, 0x69, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x32, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x76, 0x6d, 0x49, 0x6d, 0x70, 0x6c, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x62, 0x69, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x86, 0x01, 0x0a, 0x1b, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x76, 0x6d, 0x50,
+	0x72, 0x6f, 0x78, 0x79, 0x49, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x32, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x76, 0x6d, 0x50, 0x72, 0x6f, 0x78,
+	0x79, 0x49, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x33, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e,
+	0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x76, 0x6d,
+	0x50, 0x72, 0x6f, 0x78, 0x79, 0x49, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7d, 0x0a, 0x18,
+	0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x45, 0x76, 0x6d, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x69,
+	0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x12, 0x2f, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69,
+	0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74,
+	0x45, 0x76, 0x6d, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72,
+	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x65, 0x76, 0x6d, 0x5f,
+	0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72,
+	0x74, 0x45, 0x76, 0x6d, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x77, 0x0a, 0x16, 0x4c,
+	0x69, 0x73, 0x74, 0x45, 0x76, 0x6d, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x73, 0x12, 0x2d, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65,
+	0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x76, 0x6d, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x76, 0x6d, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7a, 0x0a, 0x17, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x76,
+	0x6d, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12,
+	0x2e, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x76, 0x6d, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53,
+	0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x2f, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x76, 0x6d, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53,
+	0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x68, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x76, 0x6d, 0x4c, 0x6f, 0x67,
+	0x53, 0x74, 0x6f, 0x72, 0x65, 0x12, 0x28, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65,
+	0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x76, 0x6d,
+	0x4c, 0x6f, 0x67, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x29, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x76, 0x6d, 0x4c, 0x6f, 0x67, 0x53, 0x74, 0x6f,
+	0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5f, 0x0a, 0x0e, 0x47, 0x65,
+	0x74, 0x45, 0x76, 0x6d, 0x4c, 0x6f, 0x67, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x12, 0x25, 0x2e, 0x65,
+	0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
+	0x74, 0x45, 0x76, 0x6d, 0x4c, 0x6f, 0x67, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x76, 0x6d, 0x4c, 0x6f, 0x67, 0x53, 0x74,
+	0x6f, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x68, 0x0a, 0x11, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x76, 0x6d, 0x4c, 0x6f, 0x67, 0x53, 0x74, 0x6f, 0x72, 0x65,
 	0x12, 0x28, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76,
-	0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x76, 0x6d, 0x4c, 0x6f, 0x67, 0x53, 0x74,
+	0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x76, 0x6d, 0x4c, 0x6f, 0x67, 0x53, 0x74,
 	0x6f, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x65, 0x76, 0x6d,
-	0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x45, 0x76, 0x6d, 0x4c, 0x6f, 0x67, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x71, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45,
-	0x76, 0x6d, 0x4c, 0x6f, 0x67, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x2b, 0x2e,
-	0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x76, 0x6d, 0x4c, 0x6f, 0x67, 0x50, 0x69, 0x70, 0x65, 0x6c,
-	0x69, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x65, 0x76, 0x6d,
-	0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x45, 0x76, 0x6d, 0x4c, 0x6f, 0x67, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x68, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x45,
-	0x76, 0x6d, 0x4c, 0x6f, 0x67, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x28, 0x2e,
-	0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47,
-	0x65, 0x74, 0x45, 0x76, 0x6d, 0x4c, 0x6f, 0x67, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e,
-	0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x76, 0x6d, 0x4c,
-	0x6f, 0x67, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x71, 0x0a, 0x14, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x76, 0x6d, 0x4c,
-	0x6f, 0x67, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x2b, 0x2e, 0x65, 0x76, 0x6d,
 	0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x45, 0x76, 0x6d, 0x4c, 0x6f, 0x67, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e,
-	0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45,
-	0x76, 0x6d, 0x4c, 0x6f, 0x67, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6e, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x76, 0x6d,
-	0x4c, 0x6f, 0x67, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x12, 0x2a, 0x2e, 0x65,
-	0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69,
-	0x73, 0x74, 0x45, 0x76, 0x6d, 0x4c, 0x6f, 0x67, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69,
-	0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x76,
-	0x6d, 0x4c, 0x6f, 0x67, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x71, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45,
-	0x76, 0x6d, 0x4c, 0x6f, 0x67, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x2b, 0x2e,
-	0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x44,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x76, 0x6d, 0x4c, 0x6f, 0x67, 0x50, 0x69, 0x70, 0x65, 0x6c,
-	0x69, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x65, 0x76, 0x6d,
-	0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x45, 0x76, 0x6d, 0x4c, 0x6f, 0x67, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6d, 0x0a, 0x12, 0x53, 0x74, 0x61, 0x72,
-	0x74, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x12, 0x29,
+	0x74, 0x65, 0x45, 0x76, 0x6d, 0x4c, 0x6f, 0x67, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x65, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x76, 0x6d,
+	0x4c, 0x6f, 0x67, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x73, 0x12, 0x27, 0x2e, 0x65, 0x76, 0x6d, 0x5f,
+	0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45,
+	0x76, 0x6d, 0x4c, 0x6f, 0x67, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x28, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x76, 0x6d, 0x4c, 0x6f, 0x67, 0x53, 0x74,
+	0x6f, 0x72, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x68, 0x0a, 0x11,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x76, 0x6d, 0x4c, 0x6f, 0x67, 0x53, 0x74, 0x6f, 0x72,
+	0x65, 0x12, 0x28, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x76, 0x6d, 0x4c, 0x6f, 0x67, 0x53,
+	0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x65, 0x76,
+	0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x45, 0x76, 0x6d, 0x4c, 0x6f, 0x67, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x71, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x45, 0x76, 0x6d, 0x4c, 0x6f, 0x67, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x2b,
 	0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
-	0x53, 0x74, 0x61, 0x72, 0x74, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78,
-	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x65, 0x76, 0x6d, 0x5f,
-	0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74,
-	0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6a, 0x0a, 0x11, 0x53, 0x74, 0x6f, 0x70, 0x53,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x12, 0x28, 0x2e, 0x65,
-	0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74,
-	0x6f, 0x70, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64,
-	0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x6f, 0x70, 0x53, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x00, 0x12, 0x6b, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x76, 0x6d,
-	0x4c, 0x6f, 0x67, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x29, 0x2e, 0x65, 0x76, 0x6d, 0x5f,
-	0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x45, 0x76, 0x6d, 0x4c, 0x6f, 0x67, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78,
-	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x76, 0x6d, 0x4c,
-	0x6f, 0x67, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x62, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x45, 0x76, 0x6d, 0x4c, 0x6f, 0x67, 0x53, 0x6f, 0x75,
-	0x72, 0x63, 0x65, 0x12, 0x26, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65,
-	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x76, 0x6d, 0x4c, 0x6f, 0x67, 0x53, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x65, 0x76,
-	0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
-	0x45, 0x76, 0x6d, 0x4c, 0x6f, 0x67, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6b, 0x0a, 0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x76,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x76, 0x6d, 0x4c, 0x6f, 0x67, 0x50, 0x69, 0x70, 0x65,
+	0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x65, 0x76,
+	0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x45, 0x76, 0x6d, 0x4c, 0x6f, 0x67, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x68, 0x0a, 0x11, 0x47, 0x65, 0x74,
+	0x45, 0x76, 0x6d, 0x4c, 0x6f, 0x67, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x28,
+	0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
+	0x47, 0x65, 0x74, 0x45, 0x76, 0x6d, 0x4c, 0x6f, 0x67, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69,
+	0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x76, 0x6d,
+	0x4c, 0x6f, 0x67, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x71, 0x0a, 0x14, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x76, 0x6d,
+	0x4c, 0x6f, 0x67, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x2b, 0x2e, 0x65, 0x76,
+	0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x45, 0x76, 0x6d, 0x4c, 0x6f, 0x67, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69,
+	0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x45, 0x76, 0x6d, 0x4c, 0x6f, 0x67, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6e, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x76,
+	0x6d, 0x4c, 0x6f, 0x67, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x12, 0x2a, 0x2e,
+	0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x45, 0x76, 0x6d, 0x4c, 0x6f, 0x67, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e,
+	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x65, 0x76, 0x6d, 0x5f,
+	0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45,
+	0x76, 0x6d, 0x4c, 0x6f, 0x67, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x71, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x45, 0x76, 0x6d, 0x4c, 0x6f, 0x67, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x2b,
+	0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x76, 0x6d, 0x4c, 0x6f, 0x67, 0x50, 0x69, 0x70, 0x65,
+	0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x65, 0x76,
+	0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x45, 0x76, 0x6d, 0x4c, 0x6f, 0x67, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6d, 0x0a, 0x12, 0x53, 0x74, 0x61,
+	0x72, 0x74, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x12,
+	0x29, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x6e, 0x64, 0x65,
+	0x78, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x65, 0x76, 0x6d,
+	0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x72,
+	0x74, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6a, 0x0a, 0x11, 0x53, 0x74, 0x6f, 0x70,
+	0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x12, 0x28, 0x2e,
+	0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53,
+	0x74, 0x6f, 0x70, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e,
+	0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x6f, 0x70, 0x53, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x6b, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x76,
 	0x6d, 0x4c, 0x6f, 0x67, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x29, 0x2e, 0x65, 0x76, 0x6d,
-	0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61,
+	0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61,
 	0x74, 0x65, 0x45, 0x76, 0x6d, 0x4c, 0x6f, 0x67, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65,
 	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65,
-	0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x76, 0x6d,
+	0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x76, 0x6d,
 	0x4c, 0x6f, 0x67, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x68, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x76, 0x6d, 0x4c, 0x6f, 0x67, 0x53,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x28, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64,
-	0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x76, 0x6d, 0x4c,
-	0x6f, 0x67, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x29, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76,
-	0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x76, 0x6d, 0x4c, 0x6f, 0x67, 0x53, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6b, 0x0a, 0x12, 0x44,
+	0x65, 0x12, 0x62, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x45, 0x76, 0x6d, 0x4c, 0x6f, 0x67, 0x53, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x12, 0x26, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x76, 0x6d, 0x4c, 0x6f, 0x67, 0x53,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x65,
+	0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
+	0x74, 0x45, 0x76, 0x6d, 0x4c, 0x6f, 0x67, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6b, 0x0a, 0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45,
+	0x76, 0x6d, 0x4c, 0x6f, 0x67, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x29, 0x2e, 0x65, 0x76,
+	0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x45, 0x76, 0x6d, 0x4c, 0x6f, 0x67, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64,
+	0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x76,
+	0x6d, 0x4c, 0x6f, 0x67, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x68, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x76, 0x6d, 0x4c, 0x6f, 0x67,
+	0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x28, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e,
+	0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x76, 0x6d,
+	0x4c, 0x6f, 0x67, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x29, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x76, 0x6d, 0x4c, 0x6f, 0x67, 0x53, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6b, 0x0a, 0x12,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x76, 0x6d, 0x4c, 0x6f, 0x67, 0x53, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x12, 0x29, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x76, 0x6d, 0x4c, 0x6f, 0x67,
+	0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e,
+	0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x44,
 	0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x76, 0x6d, 0x4c, 0x6f, 0x67, 0x53, 0x6f, 0x75, 0x72, 0x63,
-	0x65, 0x12, 0x29, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e,
-	0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x76, 0x6d, 0x4c, 0x6f, 0x67, 0x53,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x65,
-	0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x45, 0x76, 0x6d, 0x4c, 0x6f, 0x67, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6d, 0x0a, 0x19, 0x53, 0x74, 0x72, 0x65,
-	0x61, 0x6d, 0x45, 0x76, 0x6d, 0x4c, 0x6f, 0x67, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x73, 0x12, 0x30, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65,
-	0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x45, 0x76, 0x6d,
-	0x4c, 0x6f, 0x67, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e,
-	0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x6d, 0x4c, 0x6f, 0x67, 0x53,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x30, 0x01, 0x12, 0x56, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x45,
-	0x76, 0x6d, 0x4c, 0x6f, 0x67, 0x73, 0x12, 0x22, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64,
-	0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x76, 0x6d, 0x4c,
-	0x6f, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x65, 0x76, 0x6d,
-	0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74,
-	0x45, 0x76, 0x6d, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x68, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x45, 0x76, 0x6d,
-	0x4c, 0x6f, 0x67, 0x73, 0x12, 0x28, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78,
-	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x61, 0x74, 0x65, 0x73, 0x74,
-	0x45, 0x76, 0x6d, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x71, 0x0a, 0x14, 0x52, 0x65, 0x64,
+	0x65, 0x63, 0x6f, 0x64, 0x65, 0x45, 0x76, 0x6d, 0x4c, 0x6f, 0x67, 0x53, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x12, 0x2b, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x52, 0x65, 0x64, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x45, 0x76, 0x6d, 0x4c, 0x6f,
+	0x67, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c,
 	0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
-	0x4c, 0x69, 0x73, 0x74, 0x4c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x45, 0x76, 0x6d, 0x4c, 0x6f, 0x67,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6e, 0x0a, 0x13, 0x4c, 0x69, 0x73,
-	0x74, 0x45, 0x76, 0x6d, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x12, 0x2a, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76,
-	0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x76, 0x6d, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x65,
-	0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69,
+	0x52, 0x65, 0x64, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x45, 0x76, 0x6d, 0x4c, 0x6f, 0x67, 0x53, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6b, 0x0a, 0x12,
+	0x52, 0x65, 0x73, 0x79, 0x6e, 0x63, 0x45, 0x76, 0x6d, 0x4c, 0x6f, 0x67, 0x53, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x12, 0x29, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x79, 0x6e, 0x63, 0x45, 0x76, 0x6d, 0x4c, 0x6f, 0x67,
+	0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e,
+	0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52,
+	0x65, 0x73, 0x79, 0x6e, 0x63, 0x45, 0x76, 0x6d, 0x4c, 0x6f, 0x67, 0x53, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6d, 0x0a, 0x19, 0x53, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x45, 0x76, 0x6d, 0x4c, 0x6f, 0x67, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x12, 0x30, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64,
+	0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x45, 0x76,
+	0x6d, 0x4c, 0x6f, 0x67, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69,
+	0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x6d, 0x4c, 0x6f, 0x67,
+	0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x30, 0x01, 0x12, 0x56, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74,
+	0x45, 0x76, 0x6d, 0x4c, 0x6f, 0x67, 0x73, 0x12, 0x22, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e,
+	0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x76, 0x6d,
+	0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x65, 0x76,
+	0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x45, 0x76, 0x6d, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x68, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x45, 0x76,
+	0x6d, 0x4c, 0x6f, 0x67, 0x73, 0x12, 0x28, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65,
+	0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x61, 0x74, 0x65, 0x73,
+	0x74, 0x45, 0x76, 0x6d, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x29, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x45, 0x76, 0x6d, 0x4c, 0x6f,
+	0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6e, 0x0a, 0x13, 0x4c, 0x69,
 	0x73, 0x74, 0x45, 0x76, 0x6d, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x05, 0x4c, 0x6f, 0x67,
-	0x69, 0x6e, 0x12, 0x1c, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72,
-	0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x1d, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76,
-	0x31, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x6b, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x41, 0x75, 0x74, 0x68, 0x4c, 0x6f, 0x67, 0x69,
-	0x6e, 0x55, 0x72, 0x6c, 0x73, 0x12, 0x29, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65,
-	0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x41, 0x75, 0x74, 0x68,
-	0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x55, 0x72, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x2a, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76,
-	0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x41, 0x75, 0x74, 0x68, 0x4c, 0x6f, 0x67, 0x69, 0x6e,
-	0x55, 0x72, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x02,
-	0x4d, 0x65, 0x12, 0x19, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72,
-	0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e,
-	0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4d,
-	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x68, 0x0a, 0x11, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x28,
-	0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65,
-	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69,
-	0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x65, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x63, 0x63, 0x65, 0x73,
-	0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x12, 0x27, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e,
-	0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x63, 0x63,
-	0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x28, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76,
-	0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65,
-	0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x68, 0x0a, 0x11, 0x52, 0x65,
-	0x76, 0x6f, 0x6b, 0x65, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12,
-	0x28, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31,
-	0x2e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b,
-	0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x65, 0x76, 0x6d, 0x5f,
-	0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x76, 0x6f, 0x6b,
-	0x65, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6b, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x41, 0x75, 0x74,
-	0x68, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x12, 0x29, 0x2e, 0x65, 0x76, 0x6d,
-	0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74,
-	0x4f, 0x41, 0x75, 0x74, 0x68, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65,
-	0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x41, 0x75, 0x74, 0x68,
-	0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x6e, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x41, 0x75, 0x74, 0x68,
-	0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x2a, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69,
-	0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x4f, 0x41, 0x75, 0x74, 0x68, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78,
-	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x41, 0x75, 0x74,
-	0x68, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x6e, 0x0a, 0x13, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x41, 0x75, 0x74, 0x68,
-	0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x2a, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69,
-	0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x4f, 0x41, 0x75, 0x74, 0x68, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78,
-	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x41, 0x75, 0x74,
-	0x68, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x6e, 0x0a, 0x13, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4f, 0x41, 0x75, 0x74, 0x68,
-	0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x2a, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69,
-	0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
-	0x4f, 0x41, 0x75, 0x74, 0x68, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78,
-	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4f, 0x41, 0x75, 0x74,
-	0x68, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x50, 0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x12, 0x20,
+	0x73, 0x12, 0x2a, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x76, 0x6d, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e,
+	0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x45, 0x76, 0x6d, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5c, 0x0a, 0x0d, 0x4c, 0x69,
+	0x73, 0x74, 0x45, 0x76, 0x6d, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x24, 0x2e, 0x65, 0x76,
+	0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x45, 0x76, 0x6d, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x25, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x76, 0x6d, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5c, 0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74,
+	0x45, 0x76, 0x6d, 0x54, 0x72, 0x61, 0x63, 0x65, 0x73, 0x12, 0x24, 0x2e, 0x65, 0x76, 0x6d, 0x5f,
+	0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45,
+	0x76, 0x6d, 0x54, 0x72, 0x61, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x25, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x76, 0x6d, 0x54, 0x72, 0x61, 0x63, 0x65, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x05, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x12,
+	0x1c, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e,
+	0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c,
+	0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6b, 0x0a, 0x12,
+	0x4c, 0x69, 0x73, 0x74, 0x4f, 0x41, 0x75, 0x74, 0x68, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x55, 0x72,
+	0x6c, 0x73, 0x12, 0x29, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x41, 0x75, 0x74, 0x68, 0x4c, 0x6f, 0x67,
+	0x69, 0x6e, 0x55, 0x72, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e,
+	0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x4f, 0x41, 0x75, 0x74, 0x68, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x55, 0x72, 0x6c,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x02, 0x4d, 0x65, 0x12,
+	0x19, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x4d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x65, 0x76, 0x6d,
+	0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x68, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x28, 0x2e, 0x65, 0x76,
+	0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65,
+	0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x65, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x73, 0x12, 0x27, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e,
+	0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x68, 0x0a, 0x11, 0x52, 0x65, 0x76, 0x6f, 0x6b,
+	0x65, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x28, 0x2e, 0x65,
+	0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65,
+	0x76, 0x6f, 0x6b, 0x65, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64,
+	0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x41, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x6b, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x41, 0x75, 0x74, 0x68, 0x50, 0x72,
+	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x12, 0x29, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e,
+	0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x41, 0x75,
+	0x74, 0x68, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x41, 0x75, 0x74, 0x68, 0x50, 0x72, 0x6f,
+	0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6e,
+	0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x41, 0x75, 0x74, 0x68, 0x50, 0x72, 0x6f,
+	0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x2a, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65,
+	0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x41, 0x75,
+	0x74, 0x68, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x2b, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x41, 0x75, 0x74, 0x68, 0x50, 0x72,
+	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6e,
+	0x0a, 0x13, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x41, 0x75, 0x74, 0x68, 0x50, 0x72, 0x6f,
+	0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x2a, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65,
+	0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x41, 0x75,
+	0x74, 0x68, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x2b, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x41, 0x75, 0x74, 0x68, 0x50, 0x72,
+	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6e,
+	0x0a, 0x13, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4f, 0x41, 0x75, 0x74, 0x68, 0x50, 0x72, 0x6f,
+	0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x2a, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65,
+	0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4f, 0x41, 0x75,
+	0x74, 0x68, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x2b, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4f, 0x41, 0x75, 0x74, 0x68, 0x50, 0x72,
+	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x50,
+	0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x12, 0x20, 0x2e, 0x65, 0x76,
+	0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e,
+	0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x53, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x12, 0x21,
 	0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
-	0x4c, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x21, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76,
-	0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65,
-	0x72, 0x12, 0x21, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e,
-	0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78,
-	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a, 0x0a, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x12, 0x21, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x22, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a, 0x0a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55,
+	0x73, 0x65, 0x72, 0x12, 0x21, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64,
 	0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73,
-	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x65, 0x76, 0x6d, 0x5f,
-	0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a,
-	0x0a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x12, 0x21, 0x2e, 0x65, 0x76,
+	0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a, 0x0a, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x12, 0x21, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69,
+	0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x65, 0x76,
 	0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22,
-	0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
-	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x6b, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x76, 0x6d, 0x69,
-	0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x12, 0x29, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69,
-	0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x45, 0x76, 0x6d, 0x69, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65,
-	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x76, 0x6d, 0x69, 0x45,
-	0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x62, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x45, 0x76, 0x6d, 0x69, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74,
-	0x65, 0x72, 0x12, 0x26, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72,
-	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x76, 0x6d, 0x69, 0x45, 0x78, 0x70, 0x6f, 0x72,
-	0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x65, 0x76, 0x6d,
-	0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x45,
-	0x76, 0x6d, 0x69, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x6b, 0x0a, 0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x76, 0x6d,
-	0x69, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x12, 0x29, 0x2e, 0x65, 0x76, 0x6d, 0x5f,
-	0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x45, 0x76, 0x6d, 0x69, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78,
-	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x76, 0x6d, 0x69,
+	0x65, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x6b, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x76, 0x6d, 0x69, 0x45, 0x78, 0x70,
+	0x6f, 0x72, 0x74, 0x65, 0x72, 0x12, 0x29, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65,
+	0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x76, 0x6d,
+	0x69, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x2a, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x76, 0x6d, 0x69, 0x45, 0x78, 0x70, 0x6f,
+	0x72, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x62, 0x0a, 0x0f,
+	0x47, 0x65, 0x74, 0x45, 0x76, 0x6d, 0x69, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x12,
+	0x26, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x47, 0x65, 0x74, 0x45, 0x76, 0x6d, 0x69, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e,
+	0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x76, 0x6d, 0x69,
 	0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x68, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x76, 0x6d, 0x69, 0x45, 0x78, 0x70, 0x6f,
-	0x72, 0x74, 0x65, 0x72, 0x73, 0x12, 0x28, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65,
-	0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x76, 0x6d, 0x69, 0x45,
-	0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x29, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31,
-	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x76, 0x6d, 0x69, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65,
-	0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6b, 0x0a, 0x12, 0x44, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x45, 0x76, 0x6d, 0x69, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72,
-	0x12, 0x29, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76,
-	0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x76, 0x6d, 0x69, 0x45, 0x78, 0x70, 0x6f,
-	0x72, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x65, 0x76,
-	0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x45, 0x76, 0x6d, 0x69, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5c, 0x0a, 0x0d, 0x53, 0x74, 0x61, 0x72, 0x74,
-	0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x12, 0x24, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69,
-	0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x45,
-	0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25,
+	0x12, 0x6b, 0x0a, 0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x76, 0x6d, 0x69, 0x45, 0x78,
+	0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x12, 0x29, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64,
+	0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x76,
+	0x6d, 0x69, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x2a, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x76, 0x6d, 0x69, 0x45, 0x78, 0x70,
+	0x6f, 0x72, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x68, 0x0a,
+	0x11, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x76, 0x6d, 0x69, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65,
+	0x72, 0x73, 0x12, 0x28, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x76, 0x6d, 0x69, 0x45, 0x78, 0x70, 0x6f,
+	0x72, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x65,
+	0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x45, 0x76, 0x6d, 0x69, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6b, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x45, 0x76, 0x6d, 0x69, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x12, 0x29, 0x2e,
+	0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x76, 0x6d, 0x69, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65,
+	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69,
+	0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x45, 0x76, 0x6d, 0x69, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5c, 0x0a, 0x0d, 0x53, 0x74, 0x61, 0x72, 0x74, 0x45, 0x78, 0x70,
+	0x6f, 0x72, 0x74, 0x65, 0x72, 0x12, 0x24, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65,
+	0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x45, 0x78, 0x70, 0x6f,
+	0x72, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x65, 0x76,
+	0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61,
+	0x72, 0x74, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x59, 0x0a, 0x0c, 0x53, 0x74, 0x6f, 0x70, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74,
+	0x65, 0x72, 0x12, 0x23, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x6f, 0x70, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e,
+	0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x6f, 0x70, 0x45, 0x78, 0x70,
+	0x6f, 0x72, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6d, 0x0a,
+	0x19, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x45, 0x76, 0x6d, 0x69, 0x45, 0x78, 0x70, 0x6f, 0x72,
+	0x74, 0x65, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x12, 0x30, 0x2e, 0x65, 0x76, 0x6d,
+	0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x45, 0x76, 0x6d, 0x69, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x65,
+	0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76,
+	0x6d, 0x69, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x30, 0x01, 0x12, 0x59, 0x0a, 0x0c,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x12, 0x23, 0x2e, 0x65,
+	0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x24, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x50, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x50, 0x6c,
+	0x75, 0x67, 0x69, 0x6e, 0x12, 0x20, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64,
+	0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x75, 0x67, 0x69,
+	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x59, 0x0a, 0x0c, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x12, 0x23, 0x2e, 0x65, 0x76, 0x6d, 0x5f,
+	0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24,
 	0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
-	0x53, 0x74, 0x61, 0x72, 0x74, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x59, 0x0a, 0x0c, 0x53, 0x74, 0x6f, 0x70, 0x45, 0x78, 0x70,
-	0x6f, 0x72, 0x74, 0x65, 0x72, 0x12, 0x23, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65,
-	0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x6f, 0x70, 0x45, 0x78, 0x70, 0x6f, 0x72,
-	0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x65, 0x76, 0x6d,
-	0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x6f, 0x70,
-	0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x6d, 0x0a, 0x19, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x45, 0x76, 0x6d, 0x69, 0x45, 0x78,
-	0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x12, 0x30, 0x2e,
-	0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53,
-	0x74, 0x72, 0x65, 0x61, 0x6d, 0x45, 0x76, 0x6d, 0x69, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65,
-	0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x1c, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31,
-	0x2e, 0x45, 0x76, 0x6d, 0x69, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x30, 0x01, 0x12,
-	0x59, 0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x12,
-	0x23, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31,
-	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78,
-	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6c, 0x75, 0x67,
-	0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x50, 0x0a, 0x09, 0x47, 0x65,
-	0x74, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x12, 0x20, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e,
-	0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x75, 0x67,
-	0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x65, 0x76, 0x6d, 0x5f,
-	0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6c,
-	0x75, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x59, 0x0a, 0x0c,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x12, 0x23, 0x2e, 0x65,
-	0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x56, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6c, 0x75, 0x67,
+	0x69, 0x6e, 0x73, 0x12, 0x22, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e,
+	0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6c, 0x75,
+	0x67, 0x69, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x59, 0x0a, 0x0c,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x12, 0x23, 0x2e, 0x65,
+	0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
 	0x74, 0x1a, 0x24, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e,
-	0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x56, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x50,
-	0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x12, 0x22, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64,
-	0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6c, 0x75, 0x67,
-	0x69, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x65, 0x76, 0x6d,
+	0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5c, 0x0a, 0x0d, 0x49, 0x6e, 0x73, 0x74, 0x61,
+	0x6c, 0x6c, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x12, 0x24, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69,
+	0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c,
+	0x6c, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25,
+	0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
+	0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x68, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6c, 0x75,
+	0x67, 0x69, 0x6e, 0x47, 0x69, 0x74, 0x52, 0x65, 0x66, 0x73, 0x12, 0x28, 0x2e, 0x65, 0x76, 0x6d,
 	0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74,
-	0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x59, 0x0a, 0x0c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x12,
-	0x23, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31,
-	0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78,
-	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6c, 0x75, 0x67,
-	0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5c, 0x0a, 0x0d, 0x49, 0x6e,
-	0x73, 0x74, 0x61, 0x6c, 0x6c, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x12, 0x24, 0x2e, 0x65, 0x76,
-	0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x73,
-	0x74, 0x61, 0x6c, 0x6c, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x25, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e,
-	0x76, 0x31, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x68, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74,
-	0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x47, 0x69, 0x74, 0x52, 0x65, 0x66, 0x73, 0x12, 0x28, 0x2e,
-	0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c,
-	0x69, 0x73, 0x74, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x47, 0x69, 0x74, 0x52, 0x65, 0x66, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e,
-	0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6c, 0x75,
-	0x67, 0x69, 0x6e, 0x47, 0x69, 0x74, 0x52, 0x65, 0x66, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x6e, 0x0a, 0x13, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2a, 0x2e, 0x65, 0x76, 0x6d, 0x5f,
-	0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72,
-	0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65,
-	0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x42, 0xd5, 0x01, 0x0a, 0x12, 0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69,
-	0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x42, 0x0f, 0x45, 0x76, 0x6d, 0x49, 0x6e,
-	0x64, 0x65, 0x78, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x59, 0x67, 0x69,
-	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x65, 0x76, 0x6d, 0x69, 0x2d, 0x63, 0x6c,
-	0x6f, 0x75, 0x64, 0x2f, 0x67, 0x6f, 0x2d, 0x65, 0x76, 0x6d, 0x2d, 0x69, 0x6e, 0x64, 0x65, 0x78,
-	0x65, 0x72, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x67, 0x72, 0x70, 0x63,
-	0x2f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x2f, 0x65, 0x76, 0x6d, 0x5f, 0x69,
-	0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2f, 0x76, 0x31, 0x3b, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e,
-	0x64, 0x65, 0x78, 0x65, 0x72, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x45, 0x58, 0x58, 0xaa, 0x02, 0x0d,
-	0x45, 0x76, 0x6d, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0d,
-	0x45, 0x76, 0x6d, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x19,
-	0x45, 0x76, 0x6d, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50,
-	0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0e, 0x45, 0x76, 0x6d, 0x49,
-	0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x33,
+	0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x47, 0x69, 0x74, 0x52, 0x65, 0x66, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e,
+	0x47, 0x69, 0x74, 0x52, 0x65, 0x66, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x6e, 0x0a, 0x13, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2a, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64,
+	0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42,
+	0xd5, 0x01, 0x0a, 0x12, 0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65,
+	0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x42, 0x0f, 0x45, 0x76, 0x6d, 0x49, 0x6e, 0x64, 0x65, 0x78,
+	0x65, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x59, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x65, 0x76, 0x6d, 0x69, 0x2d, 0x63, 0x6c, 0x6f, 0x75, 0x64,
+	0x2f, 0x67, 0x6f, 0x2d, 0x65, 0x76, 0x6d, 0x2d, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2f,
+	0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x2f, 0x67, 0x65,
+	0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x2f, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65,
+	0x78, 0x65, 0x72, 0x2f, 0x76, 0x31, 0x3b, 0x65, 0x76, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78,
+	0x65, 0x72, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x45, 0x58, 0x58, 0xaa, 0x02, 0x0d, 0x45, 0x76, 0x6d,
+	0x49, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0d, 0x45, 0x76, 0x6d,
+	0x49, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x19, 0x45, 0x76, 0x6d,
+	0x49, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0e, 0x45, 0x76, 0x6d, 0x49, 0x6e, 0x64, 0x65,
+	0x78, 0x65, 0x72, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -8758,338 +11564,411 @@ func file_evm_indexer_v1_evm_indexer_proto_rawDescGZIP() []byte {
 	return file_evm_indexer_v1_evm_indexer_proto_rawDescData
 }
 
-var file_evm_indexer_v1_evm_indexer_proto_msgTypes = make([]protoimpl.MessageInfo, 143)
+var file_evm_indexer_v1_evm_indexer_proto_msgTypes = make([]protoimpl.MessageInfo, 177)
 var file_evm_indexer_v1_evm_indexer_proto_goTypes = []any{
-	(*EvmiInstance)(nil),                     // 0: evm_indexer.v1.EvmiInstance
-	(*EvmBlockchain)(nil),                    // 1: evm_indexer.v1.EvmBlockchain
-	(*EvmJsonAbi)(nil),                       // 2: evm_indexer.v1.EvmJsonAbi
-	(*EvmLogStore)(nil),                      // 3: evm_indexer.v1.EvmLogStore
-	(*EvmLogPipeline)(nil),                   // 4: evm_indexer.v1.EvmLogPipeline
-	(*FactoryRuleCondition)(nil),             // 5: evm_indexer.v1.FactoryRuleCondition
-	(*FactoryRule)(nil),                      // 6: evm_indexer.v1.FactoryRule
-	(*EvmLogSource)(nil),                     // 7: evm_indexer.v1.EvmLogSource
-	(*EvmMetadata)(nil),                      // 8: evm_indexer.v1.EvmMetadata
-	(*EvmLog)(nil),                           // 9: evm_indexer.v1.EvmLog
-	(*EvmTransaction)(nil),                   // 10: evm_indexer.v1.EvmTransaction
-	(*Pagination)(nil),                       // 11: evm_indexer.v1.Pagination
-	(*GetEvmiInstanceRequest)(nil),           // 12: evm_indexer.v1.GetEvmiInstanceRequest
-	(*GetEvmiInstanceResponse)(nil),          // 13: evm_indexer.v1.GetEvmiInstanceResponse
-	(*ListEvmiInstancesRequest)(nil),         // 14: evm_indexer.v1.ListEvmiInstancesRequest
-	(*ListEvmiInstancesResponse)(nil),        // 15: evm_indexer.v1.ListEvmiInstancesResponse
-	(*CreateEvmBlockchainRequest)(nil),       // 16: evm_indexer.v1.CreateEvmBlockchainRequest
-	(*CreateEvmBlockchainResponse)(nil),      // 17: evm_indexer.v1.CreateEvmBlockchainResponse
-	(*GetEvmBlockchainRequest)(nil),          // 18: evm_indexer.v1.GetEvmBlockchainRequest
-	(*GetEvmBlockchainResponse)(nil),         // 19: evm_indexer.v1.GetEvmBlockchainResponse
-	(*UpdateEvmBlockchainRequest)(nil),       // 20: evm_indexer.v1.UpdateEvmBlockchainRequest
-	(*UpdateEvmBlockchainResponse)(nil),      // 21: evm_indexer.v1.UpdateEvmBlockchainResponse
-	(*ListEvmBlockchainsRequest)(nil),        // 22: evm_indexer.v1.ListEvmBlockchainsRequest
-	(*ListEvmBlockchainsResponse)(nil),       // 23: evm_indexer.v1.ListEvmBlockchainsResponse
-	(*DeleteEvmBlockchainRequest)(nil),       // 24: evm_indexer.v1.DeleteEvmBlockchainRequest
-	(*DeleteEvmBlockchainResponse)(nil),      // 25: evm_indexer.v1.DeleteEvmBlockchainResponse
-	(*CreateEvmJsonAbiRequest)(nil),          // 26: evm_indexer.v1.CreateEvmJsonAbiRequest
-	(*CreateEvmJsonAbiResponse)(nil),         // 27: evm_indexer.v1.CreateEvmJsonAbiResponse
-	(*GetEvmJsonAbiRequest)(nil),             // 28: evm_indexer.v1.GetEvmJsonAbiRequest
-	(*GetEvmJsonAbiResponse)(nil),            // 29: evm_indexer.v1.GetEvmJsonAbiResponse
-	(*UpdateEvmJsonAbiRequest)(nil),          // 30: evm_indexer.v1.UpdateEvmJsonAbiRequest
-	(*UpdateEvmJsonAbiResponse)(nil),         // 31: evm_indexer.v1.UpdateEvmJsonAbiResponse
-	(*ListEvmJsonAbisRequest)(nil),           // 32: evm_indexer.v1.ListEvmJsonAbisRequest
-	(*ListEvmJsonAbisResponse)(nil),          // 33: evm_indexer.v1.ListEvmJsonAbisResponse
-	(*DeleteEvmJsonAbiRequest)(nil),          // 34: evm_indexer.v1.DeleteEvmJsonAbiRequest
-	(*DeleteEvmJsonAbiResponse)(nil),         // 35: evm_indexer.v1.DeleteEvmJsonAbiResponse
-	(*CreateEvmLogStoreRequest)(nil),         // 36: evm_indexer.v1.CreateEvmLogStoreRequest
-	(*CreateEvmLogStoreResponse)(nil),        // 37: evm_indexer.v1.CreateEvmLogStoreResponse
-	(*GetEvmLogStoreRequest)(nil),            // 38: evm_indexer.v1.GetEvmLogStoreRequest
-	(*GetEvmLogStoreResponse)(nil),           // 39: evm_indexer.v1.GetEvmLogStoreResponse
-	(*UpdateEvmLogStoreRequest)(nil),         // 40: evm_indexer.v1.UpdateEvmLogStoreRequest
-	(*UpdateEvmLogStoreResponse)(nil),        // 41: evm_indexer.v1.UpdateEvmLogStoreResponse
-	(*ListEvmLogStoresRequest)(nil),          // 42: evm_indexer.v1.ListEvmLogStoresRequest
-	(*ListEvmLogStoresResponse)(nil),         // 43: evm_indexer.v1.ListEvmLogStoresResponse
-	(*DeleteEvmLogStoreRequest)(nil),         // 44: evm_indexer.v1.DeleteEvmLogStoreRequest
-	(*DeleteEvmLogStoreResponse)(nil),        // 45: evm_indexer.v1.DeleteEvmLogStoreResponse
-	(*CreateEvmLogPipelineRequest)(nil),      // 46: evm_indexer.v1.CreateEvmLogPipelineRequest
-	(*CreateEvmLogPipelineResponse)(nil),     // 47: evm_indexer.v1.CreateEvmLogPipelineResponse
-	(*GetEvmLogPipelineRequest)(nil),         // 48: evm_indexer.v1.GetEvmLogPipelineRequest
-	(*GetEvmLogPipelineResponse)(nil),        // 49: evm_indexer.v1.GetEvmLogPipelineResponse
-	(*UpdateEvmLogPipelineRequest)(nil),      // 50: evm_indexer.v1.UpdateEvmLogPipelineRequest
-	(*UpdateEvmLogPipelineResponse)(nil),     // 51: evm_indexer.v1.UpdateEvmLogPipelineResponse
-	(*ListEvmLogPipelinesRequest)(nil),       // 52: evm_indexer.v1.ListEvmLogPipelinesRequest
-	(*ListEvmLogPipelinesResponse)(nil),      // 53: evm_indexer.v1.ListEvmLogPipelinesResponse
-	(*DeleteEvmLogPipelineRequest)(nil),      // 54: evm_indexer.v1.DeleteEvmLogPipelineRequest
-	(*DeleteEvmLogPipelineResponse)(nil),     // 55: evm_indexer.v1.DeleteEvmLogPipelineResponse
-	(*CreateEvmLogSourceRequest)(nil),        // 56: evm_indexer.v1.CreateEvmLogSourceRequest
-	(*CreateEvmLogSourceResponse)(nil),       // 57: evm_indexer.v1.CreateEvmLogSourceResponse
-	(*GetEvmLogSourceRequest)(nil),           // 58: evm_indexer.v1.GetEvmLogSourceRequest
-	(*GetEvmLogSourceResponse)(nil),          // 59: evm_indexer.v1.GetEvmLogSourceResponse
-	(*UpdateEvmLogSourceRequest)(nil),        // 60: evm_indexer.v1.UpdateEvmLogSourceRequest
-	(*UpdateEvmLogSourceResponse)(nil),       // 61: evm_indexer.v1.UpdateEvmLogSourceResponse
-	(*ListEvmLogSourcesRequest)(nil),         // 62: evm_indexer.v1.ListEvmLogSourcesRequest
-	(*ListEvmLogSourcesResponse)(nil),        // 63: evm_indexer.v1.ListEvmLogSourcesResponse
-	(*DeleteEvmLogSourceRequest)(nil),        // 64: evm_indexer.v1.DeleteEvmLogSourceRequest
-	(*DeleteEvmLogSourceResponse)(nil),       // 65: evm_indexer.v1.DeleteEvmLogSourceResponse
-	(*StreamEvmLogSourceUpdatesRequest)(nil), // 66: evm_indexer.v1.StreamEvmLogSourceUpdatesRequest
-	(*StartSourceIndexerRequest)(nil),        // 67: evm_indexer.v1.StartSourceIndexerRequest
-	(*StartSourceIndexerResponse)(nil),       // 68: evm_indexer.v1.StartSourceIndexerResponse
-	(*StopSourceIndexerRequest)(nil),         // 69: evm_indexer.v1.StopSourceIndexerRequest
-	(*StopSourceIndexerResponse)(nil),        // 70: evm_indexer.v1.StopSourceIndexerResponse
-	(*ListEvmLogsRequest)(nil),               // 71: evm_indexer.v1.ListEvmLogsRequest
-	(*ListEvmLogsResponse)(nil),              // 72: evm_indexer.v1.ListEvmLogsResponse
-	(*ListLatestEvmLogsRequest)(nil),         // 73: evm_indexer.v1.ListLatestEvmLogsRequest
-	(*ListLatestEvmLogsResponse)(nil),        // 74: evm_indexer.v1.ListLatestEvmLogsResponse
-	(*ListEvmTransactionsRequest)(nil),       // 75: evm_indexer.v1.ListEvmTransactionsRequest
-	(*ListEvmTransactionsResponse)(nil),      // 76: evm_indexer.v1.ListEvmTransactionsResponse
-	(*AuthUser)(nil),                         // 77: evm_indexer.v1.AuthUser
-	(*AccessTokenInfo)(nil),                  // 78: evm_indexer.v1.AccessTokenInfo
-	(*LoginRequest)(nil),                     // 79: evm_indexer.v1.LoginRequest
-	(*LoginResponse)(nil),                    // 80: evm_indexer.v1.LoginResponse
-	(*MeRequest)(nil),                        // 81: evm_indexer.v1.MeRequest
-	(*MeResponse)(nil),                       // 82: evm_indexer.v1.MeResponse
-	(*CreateAccessTokenRequest)(nil),         // 83: evm_indexer.v1.CreateAccessTokenRequest
-	(*CreateAccessTokenResponse)(nil),        // 84: evm_indexer.v1.CreateAccessTokenResponse
-	(*ListAccessTokensRequest)(nil),          // 85: evm_indexer.v1.ListAccessTokensRequest
-	(*ListAccessTokensResponse)(nil),         // 86: evm_indexer.v1.ListAccessTokensResponse
-	(*RevokeAccessTokenRequest)(nil),         // 87: evm_indexer.v1.RevokeAccessTokenRequest
-	(*RevokeAccessTokenResponse)(nil),        // 88: evm_indexer.v1.RevokeAccessTokenResponse
-	(*OAuthProvider)(nil),                    // 89: evm_indexer.v1.OAuthProvider
-	(*CreateOAuthProviderRequest)(nil),       // 90: evm_indexer.v1.CreateOAuthProviderRequest
-	(*CreateOAuthProviderResponse)(nil),      // 91: evm_indexer.v1.CreateOAuthProviderResponse
-	(*UpdateOAuthProviderRequest)(nil),       // 92: evm_indexer.v1.UpdateOAuthProviderRequest
-	(*UpdateOAuthProviderResponse)(nil),      // 93: evm_indexer.v1.UpdateOAuthProviderResponse
-	(*ListOAuthProvidersRequest)(nil),        // 94: evm_indexer.v1.ListOAuthProvidersRequest
-	(*ListOAuthProvidersResponse)(nil),       // 95: evm_indexer.v1.ListOAuthProvidersResponse
-	(*DeleteOAuthProviderRequest)(nil),       // 96: evm_indexer.v1.DeleteOAuthProviderRequest
-	(*DeleteOAuthProviderResponse)(nil),      // 97: evm_indexer.v1.DeleteOAuthProviderResponse
-	(*OAuthLoginOption)(nil),                 // 98: evm_indexer.v1.OAuthLoginOption
-	(*ListOAuthLoginUrlsRequest)(nil),        // 99: evm_indexer.v1.ListOAuthLoginUrlsRequest
-	(*ListOAuthLoginUrlsResponse)(nil),       // 100: evm_indexer.v1.ListOAuthLoginUrlsResponse
-	(*ListUsersRequest)(nil),                 // 101: evm_indexer.v1.ListUsersRequest
-	(*ListUsersResponse)(nil),                // 102: evm_indexer.v1.ListUsersResponse
-	(*CreateUserRequest)(nil),                // 103: evm_indexer.v1.CreateUserRequest
-	(*CreateUserResponse)(nil),               // 104: evm_indexer.v1.CreateUserResponse
-	(*UpdateUserRequest)(nil),                // 105: evm_indexer.v1.UpdateUserRequest
-	(*UpdateUserResponse)(nil),               // 106: evm_indexer.v1.UpdateUserResponse
-	(*DeleteUserRequest)(nil),                // 107: evm_indexer.v1.DeleteUserRequest
-	(*DeleteUserResponse)(nil),               // 108: evm_indexer.v1.DeleteUserResponse
-	(*EvmiExporter)(nil),                     // 109: evm_indexer.v1.EvmiExporter
-	(*Plugin)(nil),                           // 110: evm_indexer.v1.Plugin
-	(*CreatePluginRequest)(nil),              // 111: evm_indexer.v1.CreatePluginRequest
-	(*CreatePluginResponse)(nil),             // 112: evm_indexer.v1.CreatePluginResponse
-	(*GetPluginRequest)(nil),                 // 113: evm_indexer.v1.GetPluginRequest
-	(*GetPluginResponse)(nil),                // 114: evm_indexer.v1.GetPluginResponse
-	(*UpdatePluginRequest)(nil),              // 115: evm_indexer.v1.UpdatePluginRequest
-	(*UpdatePluginResponse)(nil),             // 116: evm_indexer.v1.UpdatePluginResponse
-	(*ListPluginsRequest)(nil),               // 117: evm_indexer.v1.ListPluginsRequest
-	(*ListPluginsResponse)(nil),              // 118: evm_indexer.v1.ListPluginsResponse
-	(*DeletePluginRequest)(nil),              // 119: evm_indexer.v1.DeletePluginRequest
-	(*DeletePluginResponse)(nil),             // 120: evm_indexer.v1.DeletePluginResponse
-	(*InstallPluginRequest)(nil),             // 121: evm_indexer.v1.InstallPluginRequest
-	(*InstallPluginResponse)(nil),            // 122: evm_indexer.v1.InstallPluginResponse
-	(*ListPluginGitRefsRequest)(nil),         // 123: evm_indexer.v1.ListPluginGitRefsRequest
-	(*ListPluginGitRefsResponse)(nil),        // 124: evm_indexer.v1.ListPluginGitRefsResponse
-	(*CreateEvmiExporterRequest)(nil),        // 125: evm_indexer.v1.CreateEvmiExporterRequest
-	(*CreateEvmiExporterResponse)(nil),       // 126: evm_indexer.v1.CreateEvmiExporterResponse
-	(*GetEvmiExporterRequest)(nil),           // 127: evm_indexer.v1.GetEvmiExporterRequest
-	(*GetEvmiExporterResponse)(nil),          // 128: evm_indexer.v1.GetEvmiExporterResponse
-	(*UpdateEvmiExporterRequest)(nil),        // 129: evm_indexer.v1.UpdateEvmiExporterRequest
-	(*UpdateEvmiExporterResponse)(nil),       // 130: evm_indexer.v1.UpdateEvmiExporterResponse
-	(*ListEvmiExportersRequest)(nil),         // 131: evm_indexer.v1.ListEvmiExportersRequest
-	(*ListEvmiExportersResponse)(nil),        // 132: evm_indexer.v1.ListEvmiExportersResponse
-	(*DeleteEvmiExporterRequest)(nil),        // 133: evm_indexer.v1.DeleteEvmiExporterRequest
-	(*DeleteEvmiExporterResponse)(nil),       // 134: evm_indexer.v1.DeleteEvmiExporterResponse
-	(*StartExporterRequest)(nil),             // 135: evm_indexer.v1.StartExporterRequest
-	(*StartExporterResponse)(nil),            // 136: evm_indexer.v1.StartExporterResponse
-	(*StopExporterRequest)(nil),              // 137: evm_indexer.v1.StopExporterRequest
-	(*StopExporterResponse)(nil),             // 138: evm_indexer.v1.StopExporterResponse
-	(*StreamEvmiExporterUpdatesRequest)(nil), // 139: evm_indexer.v1.StreamEvmiExporterUpdatesRequest
-	(*ExportConfigurationRequest)(nil),       // 140: evm_indexer.v1.ExportConfigurationRequest
-	(*ExportConfigurationResponse)(nil),      // 141: evm_indexer.v1.ExportConfigurationResponse
-	nil,                                      // 142: evm_indexer.v1.EvmMetadata.DataEntry
+	(*EvmiInstance)(nil),                        // 0: evm_indexer.v1.EvmiInstance
+	(*EvmBlockchain)(nil),                       // 1: evm_indexer.v1.EvmBlockchain
+	(*EvmRpcEndpoint)(nil),                      // 2: evm_indexer.v1.EvmRpcEndpoint
+	(*EvmJsonAbi)(nil),                          // 3: evm_indexer.v1.EvmJsonAbi
+	(*EvmLogStore)(nil),                         // 4: evm_indexer.v1.EvmLogStore
+	(*EvmLogPipeline)(nil),                      // 5: evm_indexer.v1.EvmLogPipeline
+	(*FactoryRuleCondition)(nil),                // 6: evm_indexer.v1.FactoryRuleCondition
+	(*FactoryRule)(nil),                         // 7: evm_indexer.v1.FactoryRule
+	(*EvmLogSource)(nil),                        // 8: evm_indexer.v1.EvmLogSource
+	(*EvmRedecodeProgress)(nil),                 // 9: evm_indexer.v1.EvmRedecodeProgress
+	(*EvmMetadata)(nil),                         // 10: evm_indexer.v1.EvmMetadata
+	(*EvmLog)(nil),                              // 11: evm_indexer.v1.EvmLog
+	(*EvmTransaction)(nil),                      // 12: evm_indexer.v1.EvmTransaction
+	(*EvmBlock)(nil),                            // 13: evm_indexer.v1.EvmBlock
+	(*EvmTrace)(nil),                            // 14: evm_indexer.v1.EvmTrace
+	(*Pagination)(nil),                          // 15: evm_indexer.v1.Pagination
+	(*GetEvmiInstanceRequest)(nil),              // 16: evm_indexer.v1.GetEvmiInstanceRequest
+	(*GetEvmiInstanceResponse)(nil),             // 17: evm_indexer.v1.GetEvmiInstanceResponse
+	(*ListEvmiInstancesRequest)(nil),            // 18: evm_indexer.v1.ListEvmiInstancesRequest
+	(*ListEvmiInstancesResponse)(nil),           // 19: evm_indexer.v1.ListEvmiInstancesResponse
+	(*CreateEvmBlockchainRequest)(nil),          // 20: evm_indexer.v1.CreateEvmBlockchainRequest
+	(*CreateEvmBlockchainResponse)(nil),         // 21: evm_indexer.v1.CreateEvmBlockchainResponse
+	(*GetEvmBlockchainRequest)(nil),             // 22: evm_indexer.v1.GetEvmBlockchainRequest
+	(*GetEvmBlockchainResponse)(nil),            // 23: evm_indexer.v1.GetEvmBlockchainResponse
+	(*UpdateEvmBlockchainRequest)(nil),          // 24: evm_indexer.v1.UpdateEvmBlockchainRequest
+	(*UpdateEvmBlockchainResponse)(nil),         // 25: evm_indexer.v1.UpdateEvmBlockchainResponse
+	(*ListEvmBlockchainsRequest)(nil),           // 26: evm_indexer.v1.ListEvmBlockchainsRequest
+	(*ListEvmBlockchainsResponse)(nil),          // 27: evm_indexer.v1.ListEvmBlockchainsResponse
+	(*DeleteEvmBlockchainRequest)(nil),          // 28: evm_indexer.v1.DeleteEvmBlockchainRequest
+	(*DeleteEvmBlockchainResponse)(nil),         // 29: evm_indexer.v1.DeleteEvmBlockchainResponse
+	(*CreateEvmJsonAbiRequest)(nil),             // 30: evm_indexer.v1.CreateEvmJsonAbiRequest
+	(*CreateEvmJsonAbiResponse)(nil),            // 31: evm_indexer.v1.CreateEvmJsonAbiResponse
+	(*GetEvmJsonAbiRequest)(nil),                // 32: evm_indexer.v1.GetEvmJsonAbiRequest
+	(*GetEvmJsonAbiResponse)(nil),               // 33: evm_indexer.v1.GetEvmJsonAbiResponse
+	(*UpdateEvmJsonAbiRequest)(nil),             // 34: evm_indexer.v1.UpdateEvmJsonAbiRequest
+	(*UpdateEvmJsonAbiResponse)(nil),            // 35: evm_indexer.v1.UpdateEvmJsonAbiResponse
+	(*ListEvmJsonAbisRequest)(nil),              // 36: evm_indexer.v1.ListEvmJsonAbisRequest
+	(*ListEvmJsonAbisResponse)(nil),             // 37: evm_indexer.v1.ListEvmJsonAbisResponse
+	(*DeleteEvmJsonAbiRequest)(nil),             // 38: evm_indexer.v1.DeleteEvmJsonAbiRequest
+	(*DeleteEvmJsonAbiResponse)(nil),            // 39: evm_indexer.v1.DeleteEvmJsonAbiResponse
+	(*EvmArgSchema)(nil),                        // 40: evm_indexer.v1.EvmArgSchema
+	(*EvmEntrySchema)(nil),                      // 41: evm_indexer.v1.EvmEntrySchema
+	(*GetEvmJsonAbiSchemaRequest)(nil),          // 42: evm_indexer.v1.GetEvmJsonAbiSchemaRequest
+	(*GetEvmJsonAbiSchemaResponse)(nil),         // 43: evm_indexer.v1.GetEvmJsonAbiSchemaResponse
+	(*EvmImplementationAbi)(nil),                // 44: evm_indexer.v1.EvmImplementationAbi
+	(*SetEvmImplementationAbiRequest)(nil),      // 45: evm_indexer.v1.SetEvmImplementationAbiRequest
+	(*SetEvmImplementationAbiResponse)(nil),     // 46: evm_indexer.v1.SetEvmImplementationAbiResponse
+	(*ListEvmImplementationAbisRequest)(nil),    // 47: evm_indexer.v1.ListEvmImplementationAbisRequest
+	(*ListEvmImplementationAbisResponse)(nil),   // 48: evm_indexer.v1.ListEvmImplementationAbisResponse
+	(*DeleteEvmImplementationAbiRequest)(nil),   // 49: evm_indexer.v1.DeleteEvmImplementationAbiRequest
+	(*DeleteEvmImplementationAbiResponse)(nil),  // 50: evm_indexer.v1.DeleteEvmImplementationAbiResponse
+	(*EvmProxyImplementation)(nil),              // 51: evm_indexer.v1.EvmProxyImplementation
+	(*ListEvmProxyImplementationsRequest)(nil),  // 52: evm_indexer.v1.ListEvmProxyImplementationsRequest
+	(*ListEvmProxyImplementationsResponse)(nil), // 53: evm_indexer.v1.ListEvmProxyImplementationsResponse
+	(*EvmEventSignature)(nil),                   // 54: evm_indexer.v1.EvmEventSignature
+	(*ImportEvmEventSignaturesRequest)(nil),     // 55: evm_indexer.v1.ImportEvmEventSignaturesRequest
+	(*ImportEvmEventSignaturesResponse)(nil),    // 56: evm_indexer.v1.ImportEvmEventSignaturesResponse
+	(*ListEvmEventSignaturesRequest)(nil),       // 57: evm_indexer.v1.ListEvmEventSignaturesRequest
+	(*ListEvmEventSignaturesResponse)(nil),      // 58: evm_indexer.v1.ListEvmEventSignaturesResponse
+	(*DeleteEvmEventSignatureRequest)(nil),      // 59: evm_indexer.v1.DeleteEvmEventSignatureRequest
+	(*DeleteEvmEventSignatureResponse)(nil),     // 60: evm_indexer.v1.DeleteEvmEventSignatureResponse
+	(*CreateEvmLogStoreRequest)(nil),            // 61: evm_indexer.v1.CreateEvmLogStoreRequest
+	(*CreateEvmLogStoreResponse)(nil),           // 62: evm_indexer.v1.CreateEvmLogStoreResponse
+	(*GetEvmLogStoreRequest)(nil),               // 63: evm_indexer.v1.GetEvmLogStoreRequest
+	(*GetEvmLogStoreResponse)(nil),              // 64: evm_indexer.v1.GetEvmLogStoreResponse
+	(*UpdateEvmLogStoreRequest)(nil),            // 65: evm_indexer.v1.UpdateEvmLogStoreRequest
+	(*UpdateEvmLogStoreResponse)(nil),           // 66: evm_indexer.v1.UpdateEvmLogStoreResponse
+	(*ListEvmLogStoresRequest)(nil),             // 67: evm_indexer.v1.ListEvmLogStoresRequest
+	(*ListEvmLogStoresResponse)(nil),            // 68: evm_indexer.v1.ListEvmLogStoresResponse
+	(*DeleteEvmLogStoreRequest)(nil),            // 69: evm_indexer.v1.DeleteEvmLogStoreRequest
+	(*DeleteEvmLogStoreResponse)(nil),           // 70: evm_indexer.v1.DeleteEvmLogStoreResponse
+	(*CreateEvmLogPipelineRequest)(nil),         // 71: evm_indexer.v1.CreateEvmLogPipelineRequest
+	(*CreateEvmLogPipelineResponse)(nil),        // 72: evm_indexer.v1.CreateEvmLogPipelineResponse
+	(*GetEvmLogPipelineRequest)(nil),            // 73: evm_indexer.v1.GetEvmLogPipelineRequest
+	(*GetEvmLogPipelineResponse)(nil),           // 74: evm_indexer.v1.GetEvmLogPipelineResponse
+	(*UpdateEvmLogPipelineRequest)(nil),         // 75: evm_indexer.v1.UpdateEvmLogPipelineRequest
+	(*UpdateEvmLogPipelineResponse)(nil),        // 76: evm_indexer.v1.UpdateEvmLogPipelineResponse
+	(*ListEvmLogPipelinesRequest)(nil),          // 77: evm_indexer.v1.ListEvmLogPipelinesRequest
+	(*ListEvmLogPipelinesResponse)(nil),         // 78: evm_indexer.v1.ListEvmLogPipelinesResponse
+	(*DeleteEvmLogPipelineRequest)(nil),         // 79: evm_indexer.v1.DeleteEvmLogPipelineRequest
+	(*DeleteEvmLogPipelineResponse)(nil),        // 80: evm_indexer.v1.DeleteEvmLogPipelineResponse
+	(*CreateEvmLogSourceRequest)(nil),           // 81: evm_indexer.v1.CreateEvmLogSourceRequest
+	(*CreateEvmLogSourceResponse)(nil),          // 82: evm_indexer.v1.CreateEvmLogSourceResponse
+	(*GetEvmLogSourceRequest)(nil),              // 83: evm_indexer.v1.GetEvmLogSourceRequest
+	(*GetEvmLogSourceResponse)(nil),             // 84: evm_indexer.v1.GetEvmLogSourceResponse
+	(*UpdateEvmLogSourceRequest)(nil),           // 85: evm_indexer.v1.UpdateEvmLogSourceRequest
+	(*UpdateEvmLogSourceResponse)(nil),          // 86: evm_indexer.v1.UpdateEvmLogSourceResponse
+	(*ListEvmLogSourcesRequest)(nil),            // 87: evm_indexer.v1.ListEvmLogSourcesRequest
+	(*ListEvmLogSourcesResponse)(nil),           // 88: evm_indexer.v1.ListEvmLogSourcesResponse
+	(*DeleteEvmLogSourceRequest)(nil),           // 89: evm_indexer.v1.DeleteEvmLogSourceRequest
+	(*DeleteEvmLogSourceResponse)(nil),          // 90: evm_indexer.v1.DeleteEvmLogSourceResponse
+	(*StreamEvmLogSourceUpdatesRequest)(nil),    // 91: evm_indexer.v1.StreamEvmLogSourceUpdatesRequest
+	(*RedecodeEvmLogSourceRequest)(nil),         // 92: evm_indexer.v1.RedecodeEvmLogSourceRequest
+	(*RedecodeEvmLogSourceResponse)(nil),        // 93: evm_indexer.v1.RedecodeEvmLogSourceResponse
+	(*ResyncEvmLogSourceRequest)(nil),           // 94: evm_indexer.v1.ResyncEvmLogSourceRequest
+	(*ResyncEvmLogSourceResponse)(nil),          // 95: evm_indexer.v1.ResyncEvmLogSourceResponse
+	(*StartSourceIndexerRequest)(nil),           // 96: evm_indexer.v1.StartSourceIndexerRequest
+	(*StartSourceIndexerResponse)(nil),          // 97: evm_indexer.v1.StartSourceIndexerResponse
+	(*StopSourceIndexerRequest)(nil),            // 98: evm_indexer.v1.StopSourceIndexerRequest
+	(*StopSourceIndexerResponse)(nil),           // 99: evm_indexer.v1.StopSourceIndexerResponse
+	(*ListEvmLogsRequest)(nil),                  // 100: evm_indexer.v1.ListEvmLogsRequest
+	(*ListEvmLogsResponse)(nil),                 // 101: evm_indexer.v1.ListEvmLogsResponse
+	(*ListLatestEvmLogsRequest)(nil),            // 102: evm_indexer.v1.ListLatestEvmLogsRequest
+	(*ListLatestEvmLogsResponse)(nil),           // 103: evm_indexer.v1.ListLatestEvmLogsResponse
+	(*ListEvmTransactionsRequest)(nil),          // 104: evm_indexer.v1.ListEvmTransactionsRequest
+	(*ListEvmTransactionsResponse)(nil),         // 105: evm_indexer.v1.ListEvmTransactionsResponse
+	(*ListEvmBlocksRequest)(nil),                // 106: evm_indexer.v1.ListEvmBlocksRequest
+	(*ListEvmBlocksResponse)(nil),               // 107: evm_indexer.v1.ListEvmBlocksResponse
+	(*ListEvmTracesRequest)(nil),                // 108: evm_indexer.v1.ListEvmTracesRequest
+	(*ListEvmTracesResponse)(nil),               // 109: evm_indexer.v1.ListEvmTracesResponse
+	(*AuthUser)(nil),                            // 110: evm_indexer.v1.AuthUser
+	(*AccessTokenInfo)(nil),                     // 111: evm_indexer.v1.AccessTokenInfo
+	(*LoginRequest)(nil),                        // 112: evm_indexer.v1.LoginRequest
+	(*LoginResponse)(nil),                       // 113: evm_indexer.v1.LoginResponse
+	(*MeRequest)(nil),                           // 114: evm_indexer.v1.MeRequest
+	(*MeResponse)(nil),                          // 115: evm_indexer.v1.MeResponse
+	(*CreateAccessTokenRequest)(nil),            // 116: evm_indexer.v1.CreateAccessTokenRequest
+	(*CreateAccessTokenResponse)(nil),           // 117: evm_indexer.v1.CreateAccessTokenResponse
+	(*ListAccessTokensRequest)(nil),             // 118: evm_indexer.v1.ListAccessTokensRequest
+	(*ListAccessTokensResponse)(nil),            // 119: evm_indexer.v1.ListAccessTokensResponse
+	(*RevokeAccessTokenRequest)(nil),            // 120: evm_indexer.v1.RevokeAccessTokenRequest
+	(*RevokeAccessTokenResponse)(nil),           // 121: evm_indexer.v1.RevokeAccessTokenResponse
+	(*OAuthProvider)(nil),                       // 122: evm_indexer.v1.OAuthProvider
+	(*CreateOAuthProviderRequest)(nil),          // 123: evm_indexer.v1.CreateOAuthProviderRequest
+	(*CreateOAuthProviderResponse)(nil),         // 124: evm_indexer.v1.CreateOAuthProviderResponse
+	(*UpdateOAuthProviderRequest)(nil),          // 125: evm_indexer.v1.UpdateOAuthProviderRequest
+	(*UpdateOAuthProviderResponse)(nil),         // 126: evm_indexer.v1.UpdateOAuthProviderResponse
+	(*ListOAuthProvidersRequest)(nil),           // 127: evm_indexer.v1.ListOAuthProvidersRequest
+	(*ListOAuthProvidersResponse)(nil),          // 128: evm_indexer.v1.ListOAuthProvidersResponse
+	(*DeleteOAuthProviderRequest)(nil),          // 129: evm_indexer.v1.DeleteOAuthProviderRequest
+	(*DeleteOAuthProviderResponse)(nil),         // 130: evm_indexer.v1.DeleteOAuthProviderResponse
+	(*OAuthLoginOption)(nil),                    // 131: evm_indexer.v1.OAuthLoginOption
+	(*ListOAuthLoginUrlsRequest)(nil),           // 132: evm_indexer.v1.ListOAuthLoginUrlsRequest
+	(*ListOAuthLoginUrlsResponse)(nil),          // 133: evm_indexer.v1.ListOAuthLoginUrlsResponse
+	(*ListUsersRequest)(nil),                    // 134: evm_indexer.v1.ListUsersRequest
+	(*ListUsersResponse)(nil),                   // 135: evm_indexer.v1.ListUsersResponse
+	(*CreateUserRequest)(nil),                   // 136: evm_indexer.v1.CreateUserRequest
+	(*CreateUserResponse)(nil),                  // 137: evm_indexer.v1.CreateUserResponse
+	(*UpdateUserRequest)(nil),                   // 138: evm_indexer.v1.UpdateUserRequest
+	(*UpdateUserResponse)(nil),                  // 139: evm_indexer.v1.UpdateUserResponse
+	(*DeleteUserRequest)(nil),                   // 140: evm_indexer.v1.DeleteUserRequest
+	(*DeleteUserResponse)(nil),                  // 141: evm_indexer.v1.DeleteUserResponse
+	(*EvmiExporter)(nil),                        // 142: evm_indexer.v1.EvmiExporter
+	(*Plugin)(nil),                              // 143: evm_indexer.v1.Plugin
+	(*CreatePluginRequest)(nil),                 // 144: evm_indexer.v1.CreatePluginRequest
+	(*CreatePluginResponse)(nil),                // 145: evm_indexer.v1.CreatePluginResponse
+	(*GetPluginRequest)(nil),                    // 146: evm_indexer.v1.GetPluginRequest
+	(*GetPluginResponse)(nil),                   // 147: evm_indexer.v1.GetPluginResponse
+	(*UpdatePluginRequest)(nil),                 // 148: evm_indexer.v1.UpdatePluginRequest
+	(*UpdatePluginResponse)(nil),                // 149: evm_indexer.v1.UpdatePluginResponse
+	(*ListPluginsRequest)(nil),                  // 150: evm_indexer.v1.ListPluginsRequest
+	(*ListPluginsResponse)(nil),                 // 151: evm_indexer.v1.ListPluginsResponse
+	(*DeletePluginRequest)(nil),                 // 152: evm_indexer.v1.DeletePluginRequest
+	(*DeletePluginResponse)(nil),                // 153: evm_indexer.v1.DeletePluginResponse
+	(*InstallPluginRequest)(nil),                // 154: evm_indexer.v1.InstallPluginRequest
+	(*InstallPluginResponse)(nil),               // 155: evm_indexer.v1.InstallPluginResponse
+	(*ListPluginGitRefsRequest)(nil),            // 156: evm_indexer.v1.ListPluginGitRefsRequest
+	(*ListPluginGitRefsResponse)(nil),           // 157: evm_indexer.v1.ListPluginGitRefsResponse
+	(*CreateEvmiExporterRequest)(nil),           // 158: evm_indexer.v1.CreateEvmiExporterRequest
+	(*CreateEvmiExporterResponse)(nil),          // 159: evm_indexer.v1.CreateEvmiExporterResponse
+	(*GetEvmiExporterRequest)(nil),              // 160: evm_indexer.v1.GetEvmiExporterRequest
+	(*GetEvmiExporterResponse)(nil),             // 161: evm_indexer.v1.GetEvmiExporterResponse
+	(*UpdateEvmiExporterRequest)(nil),           // 162: evm_indexer.v1.UpdateEvmiExporterRequest
+	(*UpdateEvmiExporterResponse)(nil),          // 163: evm_indexer.v1.UpdateEvmiExporterResponse
+	(*ListEvmiExportersRequest)(nil),            // 164: evm_indexer.v1.ListEvmiExportersRequest
+	(*ListEvmiExportersResponse)(nil),           // 165: evm_indexer.v1.ListEvmiExportersResponse
+	(*DeleteEvmiExporterRequest)(nil),           // 166: evm_indexer.v1.DeleteEvmiExporterRequest
+	(*DeleteEvmiExporterResponse)(nil),          // 167: evm_indexer.v1.DeleteEvmiExporterResponse
+	(*StartExporterRequest)(nil),                // 168: evm_indexer.v1.StartExporterRequest
+	(*StartExporterResponse)(nil),               // 169: evm_indexer.v1.StartExporterResponse
+	(*StopExporterRequest)(nil),                 // 170: evm_indexer.v1.StopExporterRequest
+	(*StopExporterResponse)(nil),                // 171: evm_indexer.v1.StopExporterResponse
+	(*StreamEvmiExporterUpdatesRequest)(nil),    // 172: evm_indexer.v1.StreamEvmiExporterUpdatesRequest
+	(*ExportConfigurationRequest)(nil),          // 173: evm_indexer.v1.ExportConfigurationRequest
+	(*ExportConfigurationResponse)(nil),         // 174: evm_indexer.v1.ExportConfigurationResponse
+	nil,                                         // 175: evm_indexer.v1.EvmBlockchain.RpcMethodCostsEntry
+	nil,                                         // 176: evm_indexer.v1.EvmMetadata.DataEntry
 }
 var file_evm_indexer_v1_evm_indexer_proto_depIdxs = []int32{
-	6,   // 0: evm_indexer.v1.FactoryRule.child_rules:type_name -> evm_indexer.v1.FactoryRule
-	5,   // 1: evm_indexer.v1.FactoryRule.conditions:type_name -> evm_indexer.v1.FactoryRuleCondition
-	6,   // 2: evm_indexer.v1.EvmLogSource.factory_rules:type_name -> evm_indexer.v1.FactoryRule
-	142, // 3: evm_indexer.v1.EvmMetadata.data:type_name -> evm_indexer.v1.EvmMetadata.DataEntry
-	8,   // 4: evm_indexer.v1.EvmLog.metadata:type_name -> evm_indexer.v1.EvmMetadata
-	8,   // 5: evm_indexer.v1.EvmTransaction.metadata:type_name -> evm_indexer.v1.EvmMetadata
-	0,   // 6: evm_indexer.v1.GetEvmiInstanceResponse.instance:type_name -> evm_indexer.v1.EvmiInstance
-	11,  // 7: evm_indexer.v1.ListEvmiInstancesRequest.pagination:type_name -> evm_indexer.v1.Pagination
-	0,   // 8: evm_indexer.v1.ListEvmiInstancesResponse.instances:type_name -> evm_indexer.v1.EvmiInstance
-	1,   // 9: evm_indexer.v1.CreateEvmBlockchainRequest.blockchain:type_name -> evm_indexer.v1.EvmBlockchain
-	1,   // 10: evm_indexer.v1.GetEvmBlockchainResponse.blockchain:type_name -> evm_indexer.v1.EvmBlockchain
-	1,   // 11: evm_indexer.v1.UpdateEvmBlockchainRequest.blockchain:type_name -> evm_indexer.v1.EvmBlockchain
-	11,  // 12: evm_indexer.v1.ListEvmBlockchainsRequest.pagination:type_name -> evm_indexer.v1.Pagination
-	1,   // 13: evm_indexer.v1.ListEvmBlockchainsResponse.blockchains:type_name -> evm_indexer.v1.EvmBlockchain
-	2,   // 14: evm_indexer.v1.CreateEvmJsonAbiRequest.abi:type_name -> evm_indexer.v1.EvmJsonAbi
-	2,   // 15: evm_indexer.v1.GetEvmJsonAbiResponse.abi:type_name -> evm_indexer.v1.EvmJsonAbi
-	2,   // 16: evm_indexer.v1.UpdateEvmJsonAbiRequest.abi:type_name -> evm_indexer.v1.EvmJsonAbi
-	11,  // 17: evm_indexer.v1.ListEvmJsonAbisRequest.pagination:type_name -> evm_indexer.v1.Pagination
-	2,   // 18: evm_indexer.v1.ListEvmJsonAbisResponse.abis:type_name -> evm_indexer.v1.EvmJsonAbi
-	3,   // 19: evm_indexer.v1.CreateEvmLogStoreRequest.store:type_name -> evm_indexer.v1.EvmLogStore
-	3,   // 20: evm_indexer.v1.GetEvmLogStoreResponse.store:type_name -> evm_indexer.v1.EvmLogStore
-	3,   // 21: evm_indexer.v1.UpdateEvmLogStoreRequest.store:type_name -> evm_indexer.v1.EvmLogStore
-	11,  // 22: evm_indexer.v1.ListEvmLogStoresRequest.pagination:type_name -> evm_indexer.v1.Pagination
-	3,   // 23: evm_indexer.v1.ListEvmLogStoresResponse.stores:type_name -> evm_indexer.v1.EvmLogStore
-	4,   // 24: evm_indexer.v1.CreateEvmLogPipelineRequest.pipeline:type_name -> evm_indexer.v1.EvmLogPipeline
-	4,   // 25: evm_indexer.v1.GetEvmLogPipelineResponse.pipeline:type_name -> evm_indexer.v1.EvmLogPipeline
-	4,   // 26: evm_indexer.v1.UpdateEvmLogPipelineRequest.pipeline:type_name -> evm_indexer.v1.EvmLogPipeline
-	11,  // 27: evm_indexer.v1.ListEvmLogPipelinesRequest.pagination:type_name -> evm_indexer.v1.Pagination
-	4,   // 28: evm_indexer.v1.ListEvmLogPipelinesResponse.pipelines:type_name -> evm_indexer.v1.EvmLogPipeline
-	7,   // 29: evm_indexer.v1.CreateEvmLogSourceRequest.source:type_name -> evm_indexer.v1.EvmLogSource
-	7,   // 30: evm_indexer.v1.GetEvmLogSourceResponse.source:type_name -> evm_indexer.v1.EvmLogSource
-	7,   // 31: evm_indexer.v1.UpdateEvmLogSourceRequest.source:type_name -> evm_indexer.v1.EvmLogSource
-	11,  // 32: evm_indexer.v1.ListEvmLogSourcesRequest.pagination:type_name -> evm_indexer.v1.Pagination
-	7,   // 33: evm_indexer.v1.ListEvmLogSourcesResponse.sources:type_name -> evm_indexer.v1.EvmLogSource
-	9,   // 34: evm_indexer.v1.ListEvmLogsResponse.logs:type_name -> evm_indexer.v1.EvmLog
-	9,   // 35: evm_indexer.v1.ListLatestEvmLogsResponse.logs:type_name -> evm_indexer.v1.EvmLog
-	10,  // 36: evm_indexer.v1.ListEvmTransactionsResponse.transactions:type_name -> evm_indexer.v1.EvmTransaction
-	77,  // 37: evm_indexer.v1.MeResponse.user:type_name -> evm_indexer.v1.AuthUser
-	78,  // 38: evm_indexer.v1.ListAccessTokensResponse.tokens:type_name -> evm_indexer.v1.AccessTokenInfo
-	89,  // 39: evm_indexer.v1.CreateOAuthProviderRequest.provider:type_name -> evm_indexer.v1.OAuthProvider
-	89,  // 40: evm_indexer.v1.UpdateOAuthProviderRequest.provider:type_name -> evm_indexer.v1.OAuthProvider
-	89,  // 41: evm_indexer.v1.ListOAuthProvidersResponse.providers:type_name -> evm_indexer.v1.OAuthProvider
-	98,  // 42: evm_indexer.v1.ListOAuthLoginUrlsResponse.options:type_name -> evm_indexer.v1.OAuthLoginOption
-	77,  // 43: evm_indexer.v1.ListUsersResponse.users:type_name -> evm_indexer.v1.AuthUser
-	110, // 44: evm_indexer.v1.CreatePluginRequest.plugin:type_name -> evm_indexer.v1.Plugin
-	110, // 45: evm_indexer.v1.GetPluginResponse.plugin:type_name -> evm_indexer.v1.Plugin
-	110, // 46: evm_indexer.v1.UpdatePluginRequest.plugin:type_name -> evm_indexer.v1.Plugin
-	11,  // 47: evm_indexer.v1.ListPluginsRequest.pagination:type_name -> evm_indexer.v1.Pagination
-	110, // 48: evm_indexer.v1.ListPluginsResponse.plugins:type_name -> evm_indexer.v1.Plugin
-	109, // 49: evm_indexer.v1.CreateEvmiExporterRequest.exporter:type_name -> evm_indexer.v1.EvmiExporter
-	109, // 50: evm_indexer.v1.GetEvmiExporterResponse.exporter:type_name -> evm_indexer.v1.EvmiExporter
-	109, // 51: evm_indexer.v1.UpdateEvmiExporterRequest.exporter:type_name -> evm_indexer.v1.EvmiExporter
-	11,  // 52: evm_indexer.v1.ListEvmiExportersRequest.pagination:type_name -> evm_indexer.v1.Pagination
-	109, // 53: evm_indexer.v1.ListEvmiExportersResponse.exporters:type_name -> evm_indexer.v1.EvmiExporter
-	12,  // 54: evm_indexer.v1.EvmIndexerService.GetEvmiInstance:input_type -> evm_indexer.v1.GetEvmiInstanceRequest
-	14,  // 55: evm_indexer.v1.EvmIndexerService.ListEvmiInstances:input_type -> evm_indexer.v1.ListEvmiInstancesRequest
-	16,  // 56: evm_indexer.v1.EvmIndexerService.CreateEvmBlockchain:input_type -> evm_indexer.v1.CreateEvmBlockchainRequest
-	18,  // 57: evm_indexer.v1.EvmIndexerService.GetEvmBlockchain:input_type -> evm_indexer.v1.GetEvmBlockchainRequest
-	20,  // 58: evm_indexer.v1.EvmIndexerService.UpdateEvmBlockchain:input_type -> evm_indexer.v1.UpdateEvmBlockchainRequest
-	22,  // 59: evm_indexer.v1.EvmIndexerService.ListEvmBlockchains:input_type -> evm_indexer.v1.ListEvmBlockchainsRequest
-	24,  // 60: evm_indexer.v1.EvmIndexerService.DeleteEvmBlockchain:input_type -> evm_indexer.v1.DeleteEvmBlockchainRequest
-	26,  // 61: evm_indexer.v1.EvmIndexerService.CreateEvmJsonAbi:input_type -> evm_indexer.v1.CreateEvmJsonAbiRequest
-	28,  // 62: evm_indexer.v1.EvmIndexerService.GetEvmJsonAbi:input_type -> evm_indexer.v1.GetEvmJsonAbiRequest
-	30,  // 63: evm_indexer.v1.EvmIndexerService.UpdateEvmJsonAbi:input_type -> evm_indexer.v1.UpdateEvmJsonAbiRequest
-	32,  // 64: evm_indexer.v1.EvmIndexerService.ListEvmJsonAbis:input_type -> evm_indexer.v1.ListEvmJsonAbisRequest
-	34,  // 65: evm_indexer.v1.EvmIndexerService.DeleteEvmJsonAbi:input_type -> evm_indexer.v1.DeleteEvmJsonAbiRequest
-	36,  // 66: evm_indexer.v1.EvmIndexerService.CreateEvmLogStore:input_type -> evm_indexer.v1.CreateEvmLogStoreRequest
-	38,  // 67: evm_indexer.v1.EvmIndexerService.GetEvmLogStore:input_type -> evm_indexer.v1.GetEvmLogStoreRequest
-	40,  // 68: evm_indexer.v1.EvmIndexerService.UpdateEvmLogStore:input_type -> evm_indexer.v1.UpdateEvmLogStoreRequest
-	42,  // 69: evm_indexer.v1.EvmIndexerService.ListEvmLogStores:input_type -> evm_indexer.v1.ListEvmLogStoresRequest
-	44,  // 70: evm_indexer.v1.EvmIndexerService.DeleteEvmLogStore:input_type -> evm_indexer.v1.DeleteEvmLogStoreRequest
-	46,  // 71: evm_indexer.v1.EvmIndexerService.CreateEvmLogPipeline:input_type -> evm_indexer.v1.CreateEvmLogPipelineRequest
-	48,  // 72: evm_indexer.v1.EvmIndexerService.GetEvmLogPipeline:input_type -> evm_indexer.v1.GetEvmLogPipelineRequest
-	50,  // 73: evm_indexer.v1.EvmIndexerService.UpdateEvmLogPipeline:input_type -> evm_indexer.v1.UpdateEvmLogPipelineRequest
-	52,  // 74: evm_indexer.v1.EvmIndexerService.ListEvmLogPipelines:input_type -> evm_indexer.v1.ListEvmLogPipelinesRequest
-	54,  // 75: evm_indexer.v1.EvmIndexerService.DeleteEvmLogPipeline:input_type -> evm_indexer.v1.DeleteEvmLogPipelineRequest
-	67,  // 76: evm_indexer.v1.EvmIndexerService.StartSourceIndexer:input_type -> evm_indexer.v1.StartSourceIndexerRequest
-	69,  // 77: evm_indexer.v1.EvmIndexerService.StopSourceIndexer:input_type -> evm_indexer.v1.StopSourceIndexerRequest
-	56,  // 78: evm_indexer.v1.EvmIndexerService.CreateEvmLogSource:input_type -> evm_indexer.v1.CreateEvmLogSourceRequest
-	58,  // 79: evm_indexer.v1.EvmIndexerService.GetEvmLogSource:input_type -> evm_indexer.v1.GetEvmLogSourceRequest
-	60,  // 80: evm_indexer.v1.EvmIndexerService.UpdateEvmLogSource:input_type -> evm_indexer.v1.UpdateEvmLogSourceRequest
-	62,  // 81: evm_indexer.v1.EvmIndexerService.ListEvmLogSources:input_type -> evm_indexer.v1.ListEvmLogSourcesRequest
-	64,  // 82: evm_indexer.v1.EvmIndexerService.DeleteEvmLogSource:input_type -> evm_indexer.v1.DeleteEvmLogSourceRequest
-	66,  // 83: evm_indexer.v1.EvmIndexerService.StreamEvmLogSourceUpdates:input_type -> evm_indexer.v1.StreamEvmLogSourceUpdatesRequest
-	71,  // 84: evm_indexer.v1.EvmIndexerService.ListEvmLogs:input_type -> evm_indexer.v1.ListEvmLogsRequest
-	73,  // 85: evm_indexer.v1.EvmIndexerService.ListLatestEvmLogs:input_type -> evm_indexer.v1.ListLatestEvmLogsRequest
-	75,  // 86: evm_indexer.v1.EvmIndexerService.ListEvmTransactions:input_type -> evm_indexer.v1.ListEvmTransactionsRequest
-	79,  // 87: evm_indexer.v1.EvmIndexerService.Login:input_type -> evm_indexer.v1.LoginRequest
-	99,  // 88: evm_indexer.v1.EvmIndexerService.ListOAuthLoginUrls:input_type -> evm_indexer.v1.ListOAuthLoginUrlsRequest
-	81,  // 89: evm_indexer.v1.EvmIndexerService.Me:input_type -> evm_indexer.v1.MeRequest
-	83,  // 90: evm_indexer.v1.EvmIndexerService.CreateAccessToken:input_type -> evm_indexer.v1.CreateAccessTokenRequest
-	85,  // 91: evm_indexer.v1.EvmIndexerService.ListAccessTokens:input_type -> evm_indexer.v1.ListAccessTokensRequest
-	87,  // 92: evm_indexer.v1.EvmIndexerService.RevokeAccessToken:input_type -> evm_indexer.v1.RevokeAccessTokenRequest
-	94,  // 93: evm_indexer.v1.EvmIndexerService.ListOAuthProviders:input_type -> evm_indexer.v1.ListOAuthProvidersRequest
-	90,  // 94: evm_indexer.v1.EvmIndexerService.CreateOAuthProvider:input_type -> evm_indexer.v1.CreateOAuthProviderRequest
-	92,  // 95: evm_indexer.v1.EvmIndexerService.UpdateOAuthProvider:input_type -> evm_indexer.v1.UpdateOAuthProviderRequest
-	96,  // 96: evm_indexer.v1.EvmIndexerService.DeleteOAuthProvider:input_type -> evm_indexer.v1.DeleteOAuthProviderRequest
-	101, // 97: evm_indexer.v1.EvmIndexerService.ListUsers:input_type -> evm_indexer.v1.ListUsersRequest
-	103, // 98: evm_indexer.v1.EvmIndexerService.CreateUser:input_type -> evm_indexer.v1.CreateUserRequest
-	105, // 99: evm_indexer.v1.EvmIndexerService.UpdateUser:input_type -> evm_indexer.v1.UpdateUserRequest
-	107, // 100: evm_indexer.v1.EvmIndexerService.DeleteUser:input_type -> evm_indexer.v1.DeleteUserRequest
-	125, // 101: evm_indexer.v1.EvmIndexerService.CreateEvmiExporter:input_type -> evm_indexer.v1.CreateEvmiExporterRequest
-	127, // 102: evm_indexer.v1.EvmIndexerService.GetEvmiExporter:input_type -> evm_indexer.v1.GetEvmiExporterRequest
-	129, // 103: evm_indexer.v1.EvmIndexerService.UpdateEvmiExporter:input_type -> evm_indexer.v1.UpdateEvmiExporterRequest
-	131, // 104: evm_indexer.v1.EvmIndexerService.ListEvmiExporters:input_type -> evm_indexer.v1.ListEvmiExportersRequest
-	133, // 105: evm_indexer.v1.EvmIndexerService.DeleteEvmiExporter:input_type -> evm_indexer.v1.DeleteEvmiExporterRequest
-	135, // 106: evm_indexer.v1.EvmIndexerService.StartExporter:input_type -> evm_indexer.v1.StartExporterRequest
-	137, // 107: evm_indexer.v1.EvmIndexerService.StopExporter:input_type -> evm_indexer.v1.StopExporterRequest
-	139, // 108: evm_indexer.v1.EvmIndexerService.StreamEvmiExporterUpdates:input_type -> evm_indexer.v1.StreamEvmiExporterUpdatesRequest
-	111, // 109: evm_indexer.v1.EvmIndexerService.CreatePlugin:input_type -> evm_indexer.v1.CreatePluginRequest
-	113, // 110: evm_indexer.v1.EvmIndexerService.GetPlugin:input_type -> evm_indexer.v1.GetPluginRequest
-	115, // 111: evm_indexer.v1.EvmIndexerService.UpdatePlugin:input_type -> evm_indexer.v1.UpdatePluginRequest
-	117, // 112: evm_indexer.v1.EvmIndexerService.ListPlugins:input_type -> evm_indexer.v1.ListPluginsRequest
-	119, // 113: evm_indexer.v1.EvmIndexerService.DeletePlugin:input_type -> evm_indexer.v1.DeletePluginRequest
-	121, // 114: evm_indexer.v1.EvmIndexerService.InstallPlugin:input_type -> evm_indexer.v1.InstallPluginRequest
-	123, // 115: evm_indexer.v1.EvmIndexerService.ListPluginGitRefs:input_type -> evm_indexer.v1.ListPluginGitRefsRequest
-	140, // 116: evm_indexer.v1.EvmIndexerService.ExportConfiguration:input_type -> evm_indexer.v1.ExportConfigurationRequest
-	13,  // 117: evm_indexer.v1.EvmIndexerService.GetEvmiInstance:output_type -> evm_indexer.v1.GetEvmiInstanceResponse
-	15,  // 118: evm_indexer.v1.EvmIndexerService.ListEvmiInstances:output_type -> evm_indexer.v1.ListEvmiInstancesResponse
-	17,  // 119: evm_indexer.v1.EvmIndexerService.CreateEvmBlockchain:output_type -> evm_indexer.v1.CreateEvmBlockchainResponse
-	19,  // 120: evm_indexer.v1.EvmIndexerService.GetEvmBlockchain:output_type -> evm_indexer.v1.GetEvmBlockchainResponse
-	21,  // 121: evm_indexer.v1.EvmIndexerService.UpdateEvmBlockchain:output_type -> evm_indexer.v1.UpdateEvmBlockchainResponse
-	23,  // 122: evm_indexer.v1.EvmIndexerService.ListEvmBlockchains:output_type -> evm_indexer.v1.ListEvmBlockchainsResponse
-	25,  // 123: evm_indexer.v1.EvmIndexerService.DeleteEvmBlockchain:output_type -> evm_indexer.v1.DeleteEvmBlockchainResponse
-	27,  // 124: evm_indexer.v1.EvmIndexerService.CreateEvmJsonAbi:output_type -> evm_indexer.v1.CreateEvmJsonAbiResponse
-	29,  // 125: evm_indexer.v1.EvmIndexerService.GetEvmJsonAbi:output_type -> evm_indexer.v1.GetEvmJsonAbiResponse
-	31,  // 126: evm_indexer.v1.EvmIndexerService.UpdateEvmJsonAbi:output_type -> evm_indexer.v1.UpdateEvmJsonAbiResponse
-	33,  // 127: evm_indexer.v1.EvmIndexerService.ListEvmJsonAbis:output_type -> evm_indexer.v1.ListEvmJsonAbisResponse
-	35,  // 128: evm_indexer.v1.EvmIndexerService.DeleteEvmJsonAbi:output_type -> evm_indexer.v1.DeleteEvmJsonAbiResponse
-	37,  // 129: evm_indexer.v1.EvmIndexerService.CreateEvmLogStore:output_type -> evm_indexer.v1.CreateEvmLogStoreResponse
-	39,  // 130: evm_indexer.v1.EvmIndexerService.GetEvmLogStore:output_type -> evm_indexer.v1.GetEvmLogStoreResponse
-	41,  // 131: evm_indexer.v1.EvmIndexerService.UpdateEvmLogStore:output_type -> evm_indexer.v1.UpdateEvmLogStoreResponse
-	43,  // 132: evm_indexer.v1.EvmIndexerService.ListEvmLogStores:output_type -> evm_indexer.v1.ListEvmLogStoresResponse
-	45,  // 133: evm_indexer.v1.EvmIndexerService.DeleteEvmLogStore:output_type -> evm_indexer.v1.DeleteEvmLogStoreResponse
-	47,  // 134: evm_indexer.v1.EvmIndexerService.CreateEvmLogPipeline:output_type -> evm_indexer.v1.CreateEvmLogPipelineResponse
-	49,  // 135: evm_indexer.v1.EvmIndexerService.GetEvmLogPipeline:output_type -> evm_indexer.v1.GetEvmLogPipelineResponse
-	51,  // 136: evm_indexer.v1.EvmIndexerService.UpdateEvmLogPipeline:output_type -> evm_indexer.v1.UpdateEvmLogPipelineResponse
-	53,  // 137: evm_indexer.v1.EvmIndexerService.ListEvmLogPipelines:output_type -> evm_indexer.v1.ListEvmLogPipelinesResponse
-	55,  // 138: evm_indexer.v1.EvmIndexerService.DeleteEvmLogPipeline:output_type -> evm_indexer.v1.DeleteEvmLogPipelineResponse
-	68,  // 139: evm_indexer.v1.EvmIndexerService.StartSourceIndexer:output_type -> evm_indexer.v1.StartSourceIndexerResponse
-	70,  // 140: evm_indexer.v1.EvmIndexerService.StopSourceIndexer:output_type -> evm_indexer.v1.StopSourceIndexerResponse
-	57,  // 141: evm_indexer.v1.EvmIndexerService.CreateEvmLogSource:output_type -> evm_indexer.v1.CreateEvmLogSourceResponse
-	59,  // 142: evm_indexer.v1.EvmIndexerService.GetEvmLogSource:output_type -> evm_indexer.v1.GetEvmLogSourceResponse
-	61,  // 143: evm_indexer.v1.EvmIndexerService.UpdateEvmLogSource:output_type -> evm_indexer.v1.UpdateEvmLogSourceResponse
-	63,  // 144: evm_indexer.v1.EvmIndexerService.ListEvmLogSources:output_type -> evm_indexer.v1.ListEvmLogSourcesResponse
-	65,  // 145: evm_indexer.v1.EvmIndexerService.DeleteEvmLogSource:output_type -> evm_indexer.v1.DeleteEvmLogSourceResponse
-	7,   // 146: evm_indexer.v1.EvmIndexerService.StreamEvmLogSourceUpdates:output_type -> evm_indexer.v1.EvmLogSource
-	72,  // 147: evm_indexer.v1.EvmIndexerService.ListEvmLogs:output_type -> evm_indexer.v1.ListEvmLogsResponse
-	74,  // 148: evm_indexer.v1.EvmIndexerService.ListLatestEvmLogs:output_type -> evm_indexer.v1.ListLatestEvmLogsResponse
-	76,  // 149: evm_indexer.v1.EvmIndexerService.ListEvmTransactions:output_type -> evm_indexer.v1.ListEvmTransactionsResponse
-	80,  // 150: evm_indexer.v1.EvmIndexerService.Login:output_type -> evm_indexer.v1.LoginResponse
-	100, // 151: evm_indexer.v1.EvmIndexerService.ListOAuthLoginUrls:output_type -> evm_indexer.v1.ListOAuthLoginUrlsResponse
-	82,  // 152: evm_indexer.v1.EvmIndexerService.Me:output_type -> evm_indexer.v1.MeResponse
-	84,  // 153: evm_indexer.v1.EvmIndexerService.CreateAccessToken:output_type -> evm_indexer.v1.CreateAccessTokenResponse
-	86,  // 154: evm_indexer.v1.EvmIndexerService.ListAccessTokens:output_type -> evm_indexer.v1.ListAccessTokensResponse
-	88,  // 155: evm_indexer.v1.EvmIndexerService.RevokeAccessToken:output_type -> evm_indexer.v1.RevokeAccessTokenResponse
-	95,  // 156: evm_indexer.v1.EvmIndexerService.ListOAuthProviders:output_type -> evm_indexer.v1.ListOAuthProvidersResponse
-	91,  // 157: evm_indexer.v1.EvmIndexerService.CreateOAuthProvider:output_type -> evm_indexer.v1.CreateOAuthProviderResponse
-	93,  // 158: evm_indexer.v1.EvmIndexerService.UpdateOAuthProvider:output_type -> evm_indexer.v1.UpdateOAuthProviderResponse
-	97,  // 159: evm_indexer.v1.EvmIndexerService.DeleteOAuthProvider:output_type -> evm_indexer.v1.DeleteOAuthProviderResponse
-	102, // 160: evm_indexer.v1.EvmIndexerService.ListUsers:output_type -> evm_indexer.v1.ListUsersResponse
-	104, // 161: evm_indexer.v1.EvmIndexerService.CreateUser:output_type -> evm_indexer.v1.CreateUserResponse
-	106, // 162: evm_indexer.v1.EvmIndexerService.UpdateUser:output_type -> evm_indexer.v1.UpdateUserResponse
-	108, // 163: evm_indexer.v1.EvmIndexerService.DeleteUser:output_type -> evm_indexer.v1.DeleteUserResponse
-	126, // 164: evm_indexer.v1.EvmIndexerService.CreateEvmiExporter:output_type -> evm_indexer.v1.CreateEvmiExporterResponse
-	128, // 165: evm_indexer.v1.EvmIndexerService.GetEvmiExporter:output_type -> evm_indexer.v1.GetEvmiExporterResponse
-	130, // 166: evm_indexer.v1.EvmIndexerService.UpdateEvmiExporter:output_type -> evm_indexer.v1.UpdateEvmiExporterResponse
-	132, // 167: evm_indexer.v1.EvmIndexerService.ListEvmiExporters:output_type -> evm_indexer.v1.ListEvmiExportersResponse
-	134, // 168: evm_indexer.v1.EvmIndexerService.DeleteEvmiExporter:output_type -> evm_indexer.v1.DeleteEvmiExporterResponse
-	136, // 169: evm_indexer.v1.EvmIndexerService.StartExporter:output_type -> evm_indexer.v1.StartExporterResponse
-	138, // 170: evm_indexer.v1.EvmIndexerService.StopExporter:output_type -> evm_indexer.v1.StopExporterResponse
-	109, // 171: evm_indexer.v1.EvmIndexerService.StreamEvmiExporterUpdates:output_type -> evm_indexer.v1.EvmiExporter
-	112, // 172: evm_indexer.v1.EvmIndexerService.CreatePlugin:output_type -> evm_indexer.v1.CreatePluginResponse
-	114, // 173: evm_indexer.v1.EvmIndexerService.GetPlugin:output_type -> evm_indexer.v1.GetPluginResponse
-	116, // 174: evm_indexer.v1.EvmIndexerService.UpdatePlugin:output_type -> evm_indexer.v1.UpdatePluginResponse
-	118, // 175: evm_indexer.v1.EvmIndexerService.ListPlugins:output_type -> evm_indexer.v1.ListPluginsResponse
-	120, // 176: evm_indexer.v1.EvmIndexerService.DeletePlugin:output_type -> evm_indexer.v1.DeletePluginResponse
-	122, // 177: evm_indexer.v1.EvmIndexerService.InstallPlugin:output_type -> evm_indexer.v1.InstallPluginResponse
-	124, // 178: evm_indexer.v1.EvmIndexerService.ListPluginGitRefs:output_type -> evm_indexer.v1.ListPluginGitRefsResponse
-	141, // 179: evm_indexer.v1.EvmIndexerService.ExportConfiguration:output_type -> evm_indexer.v1.ExportConfigurationResponse
-	117, // [117:180] is the sub-list for method output_type
-	54,  // [54:117] is the sub-list for method input_type
-	54,  // [54:54] is the sub-list for extension type_name
-	54,  // [54:54] is the sub-list for extension extendee
-	0,   // [0:54] is the sub-list for field type_name
+	2,   // 0: evm_indexer.v1.EvmBlockchain.rpc_endpoints:type_name -> evm_indexer.v1.EvmRpcEndpoint
+	175, // 1: evm_indexer.v1.EvmBlockchain.rpc_method_costs:type_name -> evm_indexer.v1.EvmBlockchain.RpcMethodCostsEntry
+	7,   // 2: evm_indexer.v1.FactoryRule.child_rules:type_name -> evm_indexer.v1.FactoryRule
+	6,   // 3: evm_indexer.v1.FactoryRule.conditions:type_name -> evm_indexer.v1.FactoryRuleCondition
+	7,   // 4: evm_indexer.v1.EvmLogSource.factory_rules:type_name -> evm_indexer.v1.FactoryRule
+	9,   // 5: evm_indexer.v1.EvmLogSource.redecode:type_name -> evm_indexer.v1.EvmRedecodeProgress
+	176, // 6: evm_indexer.v1.EvmMetadata.data:type_name -> evm_indexer.v1.EvmMetadata.DataEntry
+	10,  // 7: evm_indexer.v1.EvmLog.metadata:type_name -> evm_indexer.v1.EvmMetadata
+	10,  // 8: evm_indexer.v1.EvmTransaction.metadata:type_name -> evm_indexer.v1.EvmMetadata
+	0,   // 9: evm_indexer.v1.GetEvmiInstanceResponse.instance:type_name -> evm_indexer.v1.EvmiInstance
+	15,  // 10: evm_indexer.v1.ListEvmiInstancesRequest.pagination:type_name -> evm_indexer.v1.Pagination
+	0,   // 11: evm_indexer.v1.ListEvmiInstancesResponse.instances:type_name -> evm_indexer.v1.EvmiInstance
+	1,   // 12: evm_indexer.v1.CreateEvmBlockchainRequest.blockchain:type_name -> evm_indexer.v1.EvmBlockchain
+	1,   // 13: evm_indexer.v1.GetEvmBlockchainResponse.blockchain:type_name -> evm_indexer.v1.EvmBlockchain
+	1,   // 14: evm_indexer.v1.UpdateEvmBlockchainRequest.blockchain:type_name -> evm_indexer.v1.EvmBlockchain
+	15,  // 15: evm_indexer.v1.ListEvmBlockchainsRequest.pagination:type_name -> evm_indexer.v1.Pagination
+	1,   // 16: evm_indexer.v1.ListEvmBlockchainsResponse.blockchains:type_name -> evm_indexer.v1.EvmBlockchain
+	3,   // 17: evm_indexer.v1.CreateEvmJsonAbiRequest.abi:type_name -> evm_indexer.v1.EvmJsonAbi
+	3,   // 18: evm_indexer.v1.GetEvmJsonAbiResponse.abi:type_name -> evm_indexer.v1.EvmJsonAbi
+	3,   // 19: evm_indexer.v1.UpdateEvmJsonAbiRequest.abi:type_name -> evm_indexer.v1.EvmJsonAbi
+	15,  // 20: evm_indexer.v1.ListEvmJsonAbisRequest.pagination:type_name -> evm_indexer.v1.Pagination
+	3,   // 21: evm_indexer.v1.ListEvmJsonAbisResponse.abis:type_name -> evm_indexer.v1.EvmJsonAbi
+	40,  // 22: evm_indexer.v1.EvmArgSchema.components:type_name -> evm_indexer.v1.EvmArgSchema
+	40,  // 23: evm_indexer.v1.EvmEntrySchema.args:type_name -> evm_indexer.v1.EvmArgSchema
+	41,  // 24: evm_indexer.v1.GetEvmJsonAbiSchemaResponse.events:type_name -> evm_indexer.v1.EvmEntrySchema
+	41,  // 25: evm_indexer.v1.GetEvmJsonAbiSchemaResponse.functions:type_name -> evm_indexer.v1.EvmEntrySchema
+	44,  // 26: evm_indexer.v1.SetEvmImplementationAbiRequest.implementation_abi:type_name -> evm_indexer.v1.EvmImplementationAbi
+	15,  // 27: evm_indexer.v1.ListEvmImplementationAbisRequest.pagination:type_name -> evm_indexer.v1.Pagination
+	44,  // 28: evm_indexer.v1.ListEvmImplementationAbisResponse.implementation_abis:type_name -> evm_indexer.v1.EvmImplementationAbi
+	51,  // 29: evm_indexer.v1.ListEvmProxyImplementationsResponse.implementations:type_name -> evm_indexer.v1.EvmProxyImplementation
+	15,  // 30: evm_indexer.v1.ListEvmEventSignaturesRequest.pagination:type_name -> evm_indexer.v1.Pagination
+	54,  // 31: evm_indexer.v1.ListEvmEventSignaturesResponse.signatures:type_name -> evm_indexer.v1.EvmEventSignature
+	4,   // 32: evm_indexer.v1.CreateEvmLogStoreRequest.store:type_name -> evm_indexer.v1.EvmLogStore
+	4,   // 33: evm_indexer.v1.GetEvmLogStoreResponse.store:type_name -> evm_indexer.v1.EvmLogStore
+	4,   // 34: evm_indexer.v1.UpdateEvmLogStoreRequest.store:type_name -> evm_indexer.v1.EvmLogStore
+	15,  // 35: evm_indexer.v1.ListEvmLogStoresRequest.pagination:type_name -> evm_indexer.v1.Pagination
+	4,   // 36: evm_indexer.v1.ListEvmLogStoresResponse.stores:type_name -> evm_indexer.v1.EvmLogStore
+	5,   // 37: evm_indexer.v1.CreateEvmLogPipelineRequest.pipeline:type_name -> evm_indexer.v1.EvmLogPipeline
+	5,   // 38: evm_indexer.v1.GetEvmLogPipelineResponse.pipeline:type_name -> evm_indexer.v1.EvmLogPipeline
+	5,   // 39: evm_indexer.v1.UpdateEvmLogPipelineRequest.pipeline:type_name -> evm_indexer.v1.EvmLogPipeline
+	15,  // 40: evm_indexer.v1.ListEvmLogPipelinesRequest.pagination:type_name -> evm_indexer.v1.Pagination
+	5,   // 41: evm_indexer.v1.ListEvmLogPipelinesResponse.pipelines:type_name -> evm_indexer.v1.EvmLogPipeline
+	8,   // 42: evm_indexer.v1.CreateEvmLogSourceRequest.source:type_name -> evm_indexer.v1.EvmLogSource
+	8,   // 43: evm_indexer.v1.GetEvmLogSourceResponse.source:type_name -> evm_indexer.v1.EvmLogSource
+	8,   // 44: evm_indexer.v1.UpdateEvmLogSourceRequest.source:type_name -> evm_indexer.v1.EvmLogSource
+	15,  // 45: evm_indexer.v1.ListEvmLogSourcesRequest.pagination:type_name -> evm_indexer.v1.Pagination
+	8,   // 46: evm_indexer.v1.ListEvmLogSourcesResponse.sources:type_name -> evm_indexer.v1.EvmLogSource
+	11,  // 47: evm_indexer.v1.ListEvmLogsResponse.logs:type_name -> evm_indexer.v1.EvmLog
+	11,  // 48: evm_indexer.v1.ListLatestEvmLogsResponse.logs:type_name -> evm_indexer.v1.EvmLog
+	12,  // 49: evm_indexer.v1.ListEvmTransactionsResponse.transactions:type_name -> evm_indexer.v1.EvmTransaction
+	13,  // 50: evm_indexer.v1.ListEvmBlocksResponse.blocks:type_name -> evm_indexer.v1.EvmBlock
+	14,  // 51: evm_indexer.v1.ListEvmTracesResponse.traces:type_name -> evm_indexer.v1.EvmTrace
+	110, // 52: evm_indexer.v1.MeResponse.user:type_name -> evm_indexer.v1.AuthUser
+	111, // 53: evm_indexer.v1.ListAccessTokensResponse.tokens:type_name -> evm_indexer.v1.AccessTokenInfo
+	122, // 54: evm_indexer.v1.CreateOAuthProviderRequest.provider:type_name -> evm_indexer.v1.OAuthProvider
+	122, // 55: evm_indexer.v1.UpdateOAuthProviderRequest.provider:type_name -> evm_indexer.v1.OAuthProvider
+	122, // 56: evm_indexer.v1.ListOAuthProvidersResponse.providers:type_name -> evm_indexer.v1.OAuthProvider
+	131, // 57: evm_indexer.v1.ListOAuthLoginUrlsResponse.options:type_name -> evm_indexer.v1.OAuthLoginOption
+	110, // 58: evm_indexer.v1.ListUsersResponse.users:type_name -> evm_indexer.v1.AuthUser
+	143, // 59: evm_indexer.v1.CreatePluginRequest.plugin:type_name -> evm_indexer.v1.Plugin
+	143, // 60: evm_indexer.v1.GetPluginResponse.plugin:type_name -> evm_indexer.v1.Plugin
+	143, // 61: evm_indexer.v1.UpdatePluginRequest.plugin:type_name -> evm_indexer.v1.Plugin
+	15,  // 62: evm_indexer.v1.ListPluginsRequest.pagination:type_name -> evm_indexer.v1.Pagination
+	143, // 63: evm_indexer.v1.ListPluginsResponse.plugins:type_name -> evm_indexer.v1.Plugin
+	142, // 64: evm_indexer.v1.CreateEvmiExporterRequest.exporter:type_name -> evm_indexer.v1.EvmiExporter
+	142, // 65: evm_indexer.v1.GetEvmiExporterResponse.exporter:type_name -> evm_indexer.v1.EvmiExporter
+	142, // 66: evm_indexer.v1.UpdateEvmiExporterRequest.exporter:type_name -> evm_indexer.v1.EvmiExporter
+	15,  // 67: evm_indexer.v1.ListEvmiExportersRequest.pagination:type_name -> evm_indexer.v1.Pagination
+	142, // 68: evm_indexer.v1.ListEvmiExportersResponse.exporters:type_name -> evm_indexer.v1.EvmiExporter
+	16,  // 69: evm_indexer.v1.EvmIndexerService.GetEvmiInstance:input_type -> evm_indexer.v1.GetEvmiInstanceRequest
+	18,  // 70: evm_indexer.v1.EvmIndexerService.ListEvmiInstances:input_type -> evm_indexer.v1.ListEvmiInstancesRequest
+	20,  // 71: evm_indexer.v1.EvmIndexerService.CreateEvmBlockchain:input_type -> evm_indexer.v1.CreateEvmBlockchainRequest
+	22,  // 72: evm_indexer.v1.EvmIndexerService.GetEvmBlockchain:input_type -> evm_indexer.v1.GetEvmBlockchainRequest
+	24,  // 73: evm_indexer.v1.EvmIndexerService.UpdateEvmBlockchain:input_type -> evm_indexer.v1.UpdateEvmBlockchainRequest
+	26,  // 74: evm_indexer.v1.EvmIndexerService.ListEvmBlockchains:input_type -> evm_indexer.v1.ListEvmBlockchainsRequest
+	28,  // 75: evm_indexer.v1.EvmIndexerService.DeleteEvmBlockchain:input_type -> evm_indexer.v1.DeleteEvmBlockchainRequest
+	30,  // 76: evm_indexer.v1.EvmIndexerService.CreateEvmJsonAbi:input_type -> evm_indexer.v1.CreateEvmJsonAbiRequest
+	32,  // 77: evm_indexer.v1.EvmIndexerService.GetEvmJsonAbi:input_type -> evm_indexer.v1.GetEvmJsonAbiRequest
+	34,  // 78: evm_indexer.v1.EvmIndexerService.UpdateEvmJsonAbi:input_type -> evm_indexer.v1.UpdateEvmJsonAbiRequest
+	36,  // 79: evm_indexer.v1.EvmIndexerService.ListEvmJsonAbis:input_type -> evm_indexer.v1.ListEvmJsonAbisRequest
+	38,  // 80: evm_indexer.v1.EvmIndexerService.DeleteEvmJsonAbi:input_type -> evm_indexer.v1.DeleteEvmJsonAbiRequest
+	42,  // 81: evm_indexer.v1.EvmIndexerService.GetEvmJsonAbiSchema:input_type -> evm_indexer.v1.GetEvmJsonAbiSchemaRequest
+	45,  // 82: evm_indexer.v1.EvmIndexerService.SetEvmImplementationAbi:input_type -> evm_indexer.v1.SetEvmImplementationAbiRequest
+	47,  // 83: evm_indexer.v1.EvmIndexerService.ListEvmImplementationAbis:input_type -> evm_indexer.v1.ListEvmImplementationAbisRequest
+	49,  // 84: evm_indexer.v1.EvmIndexerService.DeleteEvmImplementationAbi:input_type -> evm_indexer.v1.DeleteEvmImplementationAbiRequest
+	52,  // 85: evm_indexer.v1.EvmIndexerService.ListEvmProxyImplementations:input_type -> evm_indexer.v1.ListEvmProxyImplementationsRequest
+	55,  // 86: evm_indexer.v1.EvmIndexerService.ImportEvmEventSignatures:input_type -> evm_indexer.v1.ImportEvmEventSignaturesRequest
+	57,  // 87: evm_indexer.v1.EvmIndexerService.ListEvmEventSignatures:input_type -> evm_indexer.v1.ListEvmEventSignaturesRequest
+	59,  // 88: evm_indexer.v1.EvmIndexerService.DeleteEvmEventSignature:input_type -> evm_indexer.v1.DeleteEvmEventSignatureRequest
+	61,  // 89: evm_indexer.v1.EvmIndexerService.CreateEvmLogStore:input_type -> evm_indexer.v1.CreateEvmLogStoreRequest
+	63,  // 90: evm_indexer.v1.EvmIndexerService.GetEvmLogStore:input_type -> evm_indexer.v1.GetEvmLogStoreRequest
+	65,  // 91: evm_indexer.v1.EvmIndexerService.UpdateEvmLogStore:input_type -> evm_indexer.v1.UpdateEvmLogStoreRequest
+	67,  // 92: evm_indexer.v1.EvmIndexerService.ListEvmLogStores:input_type -> evm_indexer.v1.ListEvmLogStoresRequest
+	69,  // 93: evm_indexer.v1.EvmIndexerService.DeleteEvmLogStore:input_type -> evm_indexer.v1.DeleteEvmLogStoreRequest
+	71,  // 94: evm_indexer.v1.EvmIndexerService.CreateEvmLogPipeline:input_type -> evm_indexer.v1.CreateEvmLogPipelineRequest
+	73,  // 95: evm_indexer.v1.EvmIndexerService.GetEvmLogPipeline:input_type -> evm_indexer.v1.GetEvmLogPipelineRequest
+	75,  // 96: evm_indexer.v1.EvmIndexerService.UpdateEvmLogPipeline:input_type -> evm_indexer.v1.UpdateEvmLogPipelineRequest
+	77,  // 97: evm_indexer.v1.EvmIndexerService.ListEvmLogPipelines:input_type -> evm_indexer.v1.ListEvmLogPipelinesRequest
+	79,  // 98: evm_indexer.v1.EvmIndexerService.DeleteEvmLogPipeline:input_type -> evm_indexer.v1.DeleteEvmLogPipelineRequest
+	96,  // 99: evm_indexer.v1.EvmIndexerService.StartSourceIndexer:input_type -> evm_indexer.v1.StartSourceIndexerRequest
+	98,  // 100: evm_indexer.v1.EvmIndexerService.StopSourceIndexer:input_type -> evm_indexer.v1.StopSourceIndexerRequest
+	81,  // 101: evm_indexer.v1.EvmIndexerService.CreateEvmLogSource:input_type -> evm_indexer.v1.CreateEvmLogSourceRequest
+	83,  // 102: evm_indexer.v1.EvmIndexerService.GetEvmLogSource:input_type -> evm_indexer.v1.GetEvmLogSourceRequest
+	85,  // 103: evm_indexer.v1.EvmIndexerService.UpdateEvmLogSource:input_type -> evm_indexer.v1.UpdateEvmLogSourceRequest
+	87,  // 104: evm_indexer.v1.EvmIndexerService.ListEvmLogSources:input_type -> evm_indexer.v1.ListEvmLogSourcesRequest
+	89,  // 105: evm_indexer.v1.EvmIndexerService.DeleteEvmLogSource:input_type -> evm_indexer.v1.DeleteEvmLogSourceRequest
+	92,  // 106: evm_indexer.v1.EvmIndexerService.RedecodeEvmLogSource:input_type -> evm_indexer.v1.RedecodeEvmLogSourceRequest
+	94,  // 107: evm_indexer.v1.EvmIndexerService.ResyncEvmLogSource:input_type -> evm_indexer.v1.ResyncEvmLogSourceRequest
+	91,  // 108: evm_indexer.v1.EvmIndexerService.StreamEvmLogSourceUpdates:input_type -> evm_indexer.v1.StreamEvmLogSourceUpdatesRequest
+	100, // 109: evm_indexer.v1.EvmIndexerService.ListEvmLogs:input_type -> evm_indexer.v1.ListEvmLogsRequest
+	102, // 110: evm_indexer.v1.EvmIndexerService.ListLatestEvmLogs:input_type -> evm_indexer.v1.ListLatestEvmLogsRequest
+	104, // 111: evm_indexer.v1.EvmIndexerService.ListEvmTransactions:input_type -> evm_indexer.v1.ListEvmTransactionsRequest
+	106, // 112: evm_indexer.v1.EvmIndexerService.ListEvmBlocks:input_type -> evm_indexer.v1.ListEvmBlocksRequest
+	108, // 113: evm_indexer.v1.EvmIndexerService.ListEvmTraces:input_type -> evm_indexer.v1.ListEvmTracesRequest
+	112, // 114: evm_indexer.v1.EvmIndexerService.Login:input_type -> evm_indexer.v1.LoginRequest
+	132, // 115: evm_indexer.v1.EvmIndexerService.ListOAuthLoginUrls:input_type -> evm_indexer.v1.ListOAuthLoginUrlsRequest
+	114, // 116: evm_indexer.v1.EvmIndexerService.Me:input_type -> evm_indexer.v1.MeRequest
+	116, // 117: evm_indexer.v1.EvmIndexerService.CreateAccessToken:input_type -> evm_indexer.v1.CreateAccessTokenRequest
+	118, // 118: evm_indexer.v1.EvmIndexerService.ListAccessTokens:input_type -> evm_indexer.v1.ListAccessTokensRequest
+	120, // 119: evm_indexer.v1.EvmIndexerService.RevokeAccessToken:input_type -> evm_indexer.v1.RevokeAccessTokenRequest
+	127, // 120: evm_indexer.v1.EvmIndexerService.ListOAuthProviders:input_type -> evm_indexer.v1.ListOAuthProvidersRequest
+	123, // 121: evm_indexer.v1.EvmIndexerService.CreateOAuthProvider:input_type -> evm_indexer.v1.CreateOAuthProviderRequest
+	125, // 122: evm_indexer.v1.EvmIndexerService.UpdateOAuthProvider:input_type -> evm_indexer.v1.UpdateOAuthProviderRequest
+	129, // 123: evm_indexer.v1.EvmIndexerService.DeleteOAuthProvider:input_type -> evm_indexer.v1.DeleteOAuthProviderRequest
+	134, // 124: evm_indexer.v1.EvmIndexerService.ListUsers:input_type -> evm_indexer.v1.ListUsersRequest
+	136, // 125: evm_indexer.v1.EvmIndexerService.CreateUser:input_type -> evm_indexer.v1.CreateUserRequest
+	138, // 126: evm_indexer.v1.EvmIndexerService.UpdateUser:input_type -> evm_indexer.v1.UpdateUserRequest
+	140, // 127: evm_indexer.v1.EvmIndexerService.DeleteUser:input_type -> evm_indexer.v1.DeleteUserRequest
+	158, // 128: evm_indexer.v1.EvmIndexerService.CreateEvmiExporter:input_type -> evm_indexer.v1.CreateEvmiExporterRequest
+	160, // 129: evm_indexer.v1.EvmIndexerService.GetEvmiExporter:input_type -> evm_indexer.v1.GetEvmiExporterRequest
+	162, // 130: evm_indexer.v1.EvmIndexerService.UpdateEvmiExporter:input_type -> evm_indexer.v1.UpdateEvmiExporterRequest
+	164, // 131: evm_indexer.v1.EvmIndexerService.ListEvmiExporters:input_type -> evm_indexer.v1.ListEvmiExportersRequest
+	166, // 132: evm_indexer.v1.EvmIndexerService.DeleteEvmiExporter:input_type -> evm_indexer.v1.DeleteEvmiExporterRequest
+	168, // 133: evm_indexer.v1.EvmIndexerService.StartExporter:input_type -> evm_indexer.v1.StartExporterRequest
+	170, // 134: evm_indexer.v1.EvmIndexerService.StopExporter:input_type -> evm_indexer.v1.StopExporterRequest
+	172, // 135: evm_indexer.v1.EvmIndexerService.StreamEvmiExporterUpdates:input_type -> evm_indexer.v1.StreamEvmiExporterUpdatesRequest
+	144, // 136: evm_indexer.v1.EvmIndexerService.CreatePlugin:input_type -> evm_indexer.v1.CreatePluginRequest
+	146, // 137: evm_indexer.v1.EvmIndexerService.GetPlugin:input_type -> evm_indexer.v1.GetPluginRequest
+	148, // 138: evm_indexer.v1.EvmIndexerService.UpdatePlugin:input_type -> evm_indexer.v1.UpdatePluginRequest
+	150, // 139: evm_indexer.v1.EvmIndexerService.ListPlugins:input_type -> evm_indexer.v1.ListPluginsRequest
+	152, // 140: evm_indexer.v1.EvmIndexerService.DeletePlugin:input_type -> evm_indexer.v1.DeletePluginRequest
+	154, // 141: evm_indexer.v1.EvmIndexerService.InstallPlugin:input_type -> evm_indexer.v1.InstallPluginRequest
+	156, // 142: evm_indexer.v1.EvmIndexerService.ListPluginGitRefs:input_type -> evm_indexer.v1.ListPluginGitRefsRequest
+	173, // 143: evm_indexer.v1.EvmIndexerService.ExportConfiguration:input_type -> evm_indexer.v1.ExportConfigurationRequest
+	17,  // 144: evm_indexer.v1.EvmIndexerService.GetEvmiInstance:output_type -> evm_indexer.v1.GetEvmiInstanceResponse
+	19,  // 145: evm_indexer.v1.EvmIndexerService.ListEvmiInstances:output_type -> evm_indexer.v1.ListEvmiInstancesResponse
+	21,  // 146: evm_indexer.v1.EvmIndexerService.CreateEvmBlockchain:output_type -> evm_indexer.v1.CreateEvmBlockchainResponse
+	23,  // 147: evm_indexer.v1.EvmIndexerService.GetEvmBlockchain:output_type -> evm_indexer.v1.GetEvmBlockchainResponse
+	25,  // 148: evm_indexer.v1.EvmIndexerService.UpdateEvmBlockchain:output_type -> evm_indexer.v1.UpdateEvmBlockchainResponse
+	27,  // 149: evm_indexer.v1.EvmIndexerService.ListEvmBlockchains:output_type -> evm_indexer.v1.ListEvmBlockchainsResponse
+	29,  // 150: evm_indexer.v1.EvmIndexerService.DeleteEvmBlockchain:output_type -> evm_indexer.v1.DeleteEvmBlockchainResponse
+	31,  // 151: evm_indexer.v1.EvmIndexerService.CreateEvmJsonAbi:output_type -> evm_indexer.v1.CreateEvmJsonAbiResponse
+	33,  // 152: evm_indexer.v1.EvmIndexerService.GetEvmJsonAbi:output_type -> evm_indexer.v1.GetEvmJsonAbiResponse
+	35,  // 153: evm_indexer.v1.EvmIndexerService.UpdateEvmJsonAbi:output_type -> evm_indexer.v1.UpdateEvmJsonAbiResponse
+	37,  // 154: evm_indexer.v1.EvmIndexerService.ListEvmJsonAbis:output_type -> evm_indexer.v1.ListEvmJsonAbisResponse
+	39,  // 155: evm_indexer.v1.EvmIndexerService.DeleteEvmJsonAbi:output_type -> evm_indexer.v1.DeleteEvmJsonAbiResponse
+	43,  // 156: evm_indexer.v1.EvmIndexerService.GetEvmJsonAbiSchema:output_type -> evm_indexer.v1.GetEvmJsonAbiSchemaResponse
+	46,  // 157: evm_indexer.v1.EvmIndexerService.SetEvmImplementationAbi:output_type -> evm_indexer.v1.SetEvmImplementationAbiResponse
+	48,  // 158: evm_indexer.v1.EvmIndexerService.ListEvmImplementationAbis:output_type -> evm_indexer.v1.ListEvmImplementationAbisResponse
+	50,  // 159: evm_indexer.v1.EvmIndexerService.DeleteEvmImplementationAbi:output_type -> evm_indexer.v1.DeleteEvmImplementationAbiResponse
+	53,  // 160: evm_indexer.v1.EvmIndexerService.ListEvmProxyImplementations:output_type -> evm_indexer.v1.ListEvmProxyImplementationsResponse
+	56,  // 161: evm_indexer.v1.EvmIndexerService.ImportEvmEventSignatures:output_type -> evm_indexer.v1.ImportEvmEventSignaturesResponse
+	58,  // 162: evm_indexer.v1.EvmIndexerService.ListEvmEventSignatures:output_type -> evm_indexer.v1.ListEvmEventSignaturesResponse
+	60,  // 163: evm_indexer.v1.EvmIndexerService.DeleteEvmEventSignature:output_type -> evm_indexer.v1.DeleteEvmEventSignatureResponse
+	62,  // 164: evm_indexer.v1.EvmIndexerService.CreateEvmLogStore:output_type -> evm_indexer.v1.CreateEvmLogStoreResponse
+	64,  // 165: evm_indexer.v1.EvmIndexerService.GetEvmLogStore:output_type -> evm_indexer.v1.GetEvmLogStoreResponse
+	66,  // 166: evm_indexer.v1.EvmIndexerService.UpdateEvmLogStore:output_type -> evm_indexer.v1.UpdateEvmLogStoreResponse
+	68,  // 167: evm_indexer.v1.EvmIndexerService.ListEvmLogStores:output_type -> evm_indexer.v1.ListEvmLogStoresResponse
+	70,  // 168: evm_indexer.v1.EvmIndexerService.DeleteEvmLogStore:output_type -> evm_indexer.v1.DeleteEvmLogStoreResponse
+	72,  // 169: evm_indexer.v1.EvmIndexerService.CreateEvmLogPipeline:output_type -> evm_indexer.v1.CreateEvmLogPipelineResponse
+	74,  // 170: evm_indexer.v1.EvmIndexerService.GetEvmLogPipeline:output_type -> evm_indexer.v1.GetEvmLogPipelineResponse
+	76,  // 171: evm_indexer.v1.EvmIndexerService.UpdateEvmLogPipeline:output_type -> evm_indexer.v1.UpdateEvmLogPipelineResponse
+	78,  // 172: evm_indexer.v1.EvmIndexerService.ListEvmLogPipelines:output_type -> evm_indexer.v1.ListEvmLogPipelinesResponse
+	80,  // 173: evm_indexer.v1.EvmIndexerService.DeleteEvmLogPipeline:output_type -> evm_indexer.v1.DeleteEvmLogPipelineResponse
+	97,  // 174: evm_indexer.v1.EvmIndexerService.StartSourceIndexer:output_type -> evm_indexer.v1.StartSourceIndexerResponse
+	99,  // 175: evm_indexer.v1.EvmIndexerService.StopSourceIndexer:output_type -> evm_indexer.v1.StopSourceIndexerResponse
+	82,  // 176: evm_indexer.v1.EvmIndexerService.CreateEvmLogSource:output_type -> evm_indexer.v1.CreateEvmLogSourceResponse
+	84,  // 177: evm_indexer.v1.EvmIndexerService.GetEvmLogSource:output_type -> evm_indexer.v1.GetEvmLogSourceResponse
+	86,  // 178: evm_indexer.v1.EvmIndexerService.UpdateEvmLogSource:output_type -> evm_indexer.v1.UpdateEvmLogSourceResponse
+	88,  // 179: evm_indexer.v1.EvmIndexerService.ListEvmLogSources:output_type -> evm_indexer.v1.ListEvmLogSourcesResponse
+	90,  // 180: evm_indexer.v1.EvmIndexerService.DeleteEvmLogSource:output_type -> evm_indexer.v1.DeleteEvmLogSourceResponse
+	93,  // 181: evm_indexer.v1.EvmIndexerService.RedecodeEvmLogSource:output_type -> evm_indexer.v1.RedecodeEvmLogSourceResponse
+	95,  // 182: evm_indexer.v1.EvmIndexerService.ResyncEvmLogSource:output_type -> evm_indexer.v1.ResyncEvmLogSourceResponse
+	8,   // 183: evm_indexer.v1.EvmIndexerService.StreamEvmLogSourceUpdates:output_type -> evm_indexer.v1.EvmLogSource
+	101, // 184: evm_indexer.v1.EvmIndexerService.ListEvmLogs:output_type -> evm_indexer.v1.ListEvmLogsResponse
+	103, // 185: evm_indexer.v1.EvmIndexerService.ListLatestEvmLogs:output_type -> evm_indexer.v1.ListLatestEvmLogsResponse
+	105, // 186: evm_indexer.v1.EvmIndexerService.ListEvmTransactions:output_type -> evm_indexer.v1.ListEvmTransactionsResponse
+	107, // 187: evm_indexer.v1.EvmIndexerService.ListEvmBlocks:output_type -> evm_indexer.v1.ListEvmBlocksResponse
+	109, // 188: evm_indexer.v1.EvmIndexerService.ListEvmTraces:output_type -> evm_indexer.v1.ListEvmTracesResponse
+	113, // 189: evm_indexer.v1.EvmIndexerService.Login:output_type -> evm_indexer.v1.LoginResponse
+	133, // 190: evm_indexer.v1.EvmIndexerService.ListOAuthLoginUrls:output_type -> evm_indexer.v1.ListOAuthLoginUrlsResponse
+	115, // 191: evm_indexer.v1.EvmIndexerService.Me:output_type -> evm_indexer.v1.MeResponse
+	117, // 192: evm_indexer.v1.EvmIndexerService.CreateAccessToken:output_type -> evm_indexer.v1.CreateAccessTokenResponse
+	119, // 193: evm_indexer.v1.EvmIndexerService.ListAccessTokens:output_type -> evm_indexer.v1.ListAccessTokensResponse
+	121, // 194: evm_indexer.v1.EvmIndexerService.RevokeAccessToken:output_type -> evm_indexer.v1.RevokeAccessTokenResponse
+	128, // 195: evm_indexer.v1.EvmIndexerService.ListOAuthProviders:output_type -> evm_indexer.v1.ListOAuthProvidersResponse
+	124, // 196: evm_indexer.v1.EvmIndexerService.CreateOAuthProvider:output_type -> evm_indexer.v1.CreateOAuthProviderResponse
+	126, // 197: evm_indexer.v1.EvmIndexerService.UpdateOAuthProvider:output_type -> evm_indexer.v1.UpdateOAuthProviderResponse
+	130, // 198: evm_indexer.v1.EvmIndexerService.DeleteOAuthProvider:output_type -> evm_indexer.v1.DeleteOAuthProviderResponse
+	135, // 199: evm_indexer.v1.EvmIndexerService.ListUsers:output_type -> evm_indexer.v1.ListUsersResponse
+	137, // 200: evm_indexer.v1.EvmIndexerService.CreateUser:output_type -> evm_indexer.v1.CreateUserResponse
+	139, // 201: evm_indexer.v1.EvmIndexerService.UpdateUser:output_type -> evm_indexer.v1.UpdateUserResponse
+	141, // 202: evm_indexer.v1.EvmIndexerService.DeleteUser:output_type -> evm_indexer.v1.DeleteUserResponse
+	159, // 203: evm_indexer.v1.EvmIndexerService.CreateEvmiExporter:output_type -> evm_indexer.v1.CreateEvmiExporterResponse
+	161, // 204: evm_indexer.v1.EvmIndexerService.GetEvmiExporter:output_type -> evm_indexer.v1.GetEvmiExporterResponse
+	163, // 205: evm_indexer.v1.EvmIndexerService.UpdateEvmiExporter:output_type -> evm_indexer.v1.UpdateEvmiExporterResponse
+	165, // 206: evm_indexer.v1.EvmIndexerService.ListEvmiExporters:output_type -> evm_indexer.v1.ListEvmiExportersResponse
+	167, // 207: evm_indexer.v1.EvmIndexerService.DeleteEvmiExporter:output_type -> evm_indexer.v1.DeleteEvmiExporterResponse
+	169, // 208: evm_indexer.v1.EvmIndexerService.StartExporter:output_type -> evm_indexer.v1.StartExporterResponse
+	171, // 209: evm_indexer.v1.EvmIndexerService.StopExporter:output_type -> evm_indexer.v1.StopExporterResponse
+	142, // 210: evm_indexer.v1.EvmIndexerService.StreamEvmiExporterUpdates:output_type -> evm_indexer.v1.EvmiExporter
+	145, // 211: evm_indexer.v1.EvmIndexerService.CreatePlugin:output_type -> evm_indexer.v1.CreatePluginResponse
+	147, // 212: evm_indexer.v1.EvmIndexerService.GetPlugin:output_type -> evm_indexer.v1.GetPluginResponse
+	149, // 213: evm_indexer.v1.EvmIndexerService.UpdatePlugin:output_type -> evm_indexer.v1.UpdatePluginResponse
+	151, // 214: evm_indexer.v1.EvmIndexerService.ListPlugins:output_type -> evm_indexer.v1.ListPluginsResponse
+	153, // 215: evm_indexer.v1.EvmIndexerService.DeletePlugin:output_type -> evm_indexer.v1.DeletePluginResponse
+	155, // 216: evm_indexer.v1.EvmIndexerService.InstallPlugin:output_type -> evm_indexer.v1.InstallPluginResponse
+	157, // 217: evm_indexer.v1.EvmIndexerService.ListPluginGitRefs:output_type -> evm_indexer.v1.ListPluginGitRefsResponse
+	174, // 218: evm_indexer.v1.EvmIndexerService.ExportConfiguration:output_type -> evm_indexer.v1.ExportConfigurationResponse
+	144, // [144:219] is the sub-list for method output_type
+	69,  // [69:144] is the sub-list for method input_type
+	69,  // [69:69] is the sub-list for extension type_name
+	69,  // [69:69] is the sub-list for extension extendee
+	0,   // [0:69] is the sub-list for field type_name
 }
 
 func init() { file_evm_indexer_v1_evm_indexer_proto_init() }
@@ -9102,22 +11981,26 @@ func file_evm_indexer_v1_evm_indexer_proto_init() {
 	file_evm_indexer_v1_evm_indexer_proto_msgTypes[2].OneofWrappers = []any{}
 	file_evm_indexer_v1_evm_indexer_proto_msgTypes[3].OneofWrappers = []any{}
 	file_evm_indexer_v1_evm_indexer_proto_msgTypes[4].OneofWrappers = []any{}
-	file_evm_indexer_v1_evm_indexer_proto_msgTypes[6].OneofWrappers = []any{}
+	file_evm_indexer_v1_evm_indexer_proto_msgTypes[5].OneofWrappers = []any{}
 	file_evm_indexer_v1_evm_indexer_proto_msgTypes[7].OneofWrappers = []any{}
 	file_evm_indexer_v1_evm_indexer_proto_msgTypes[8].OneofWrappers = []any{}
-	file_evm_indexer_v1_evm_indexer_proto_msgTypes[78].OneofWrappers = []any{}
-	file_evm_indexer_v1_evm_indexer_proto_msgTypes[80].OneofWrappers = []any{}
-	file_evm_indexer_v1_evm_indexer_proto_msgTypes[84].OneofWrappers = []any{}
-	file_evm_indexer_v1_evm_indexer_proto_msgTypes[89].OneofWrappers = []any{}
-	file_evm_indexer_v1_evm_indexer_proto_msgTypes[109].OneofWrappers = []any{}
-	file_evm_indexer_v1_evm_indexer_proto_msgTypes[110].OneofWrappers = []any{}
+	file_evm_indexer_v1_evm_indexer_proto_msgTypes[10].OneofWrappers = []any{}
+	file_evm_indexer_v1_evm_indexer_proto_msgTypes[44].OneofWrappers = []any{}
+	file_evm_indexer_v1_evm_indexer_proto_msgTypes[51].OneofWrappers = []any{}
+	file_evm_indexer_v1_evm_indexer_proto_msgTypes[54].OneofWrappers = []any{}
+	file_evm_indexer_v1_evm_indexer_proto_msgTypes[111].OneofWrappers = []any{}
+	file_evm_indexer_v1_evm_indexer_proto_msgTypes[113].OneofWrappers = []any{}
+	file_evm_indexer_v1_evm_indexer_proto_msgTypes[117].OneofWrappers = []any{}
+	file_evm_indexer_v1_evm_indexer_proto_msgTypes[122].OneofWrappers = []any{}
+	file_evm_indexer_v1_evm_indexer_proto_msgTypes[142].OneofWrappers = []any{}
+	file_evm_indexer_v1_evm_indexer_proto_msgTypes[143].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_evm_indexer_v1_evm_indexer_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   143,
+			NumMessages:   177,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
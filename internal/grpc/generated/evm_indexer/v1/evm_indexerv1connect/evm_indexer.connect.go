@@ -69,6 +69,30 @@ const (
 	// EvmIndexerServiceDeleteEvmJsonAbiProcedure is the fully-qualified name of the EvmIndexerService's
 	// DeleteEvmJsonAbi RPC.
 	EvmIndexerServiceDeleteEvmJsonAbiProcedure = "/evm_indexer.v1.EvmIndexerService/DeleteEvmJsonAbi"
+	// EvmIndexerServiceGetEvmJsonAbiSchemaProcedure is the fully-qualified name of the
+	// EvmIndexerService's GetEvmJsonAbiSchema RPC.
+	EvmIndexerServiceGetEvmJsonAbiSchemaProcedure = "/evm_indexer.v1.EvmIndexerService/GetEvmJsonAbiSchema"
+	// EvmIndexerServiceSetEvmImplementationAbiProcedure is the fully-qualified name of the
+	// EvmIndexerService's SetEvmImplementationAbi RPC.
+	EvmIndexerServiceSetEvmImplementationAbiProcedure = "/evm_indexer.v1.EvmIndexerService/SetEvmImplementationAbi"
+	// EvmIndexerServiceListEvmImplementationAbisProcedure is the fully-qualified name of the
+	// EvmIndexerService's ListEvmImplementationAbis RPC.
+	EvmIndexerServiceListEvmImplementationAbisProcedure = "/evm_indexer.v1.EvmIndexerService/ListEvmImplementationAbis"
+	// EvmIndexerServiceDeleteEvmImplementationAbiProcedure is the fully-qualified name of the
+	// EvmIndexerService's DeleteEvmImplementationAbi RPC.
+	EvmIndexerServiceDeleteEvmImplementationAbiProcedure = "/evm_indexer.v1.EvmIndexerService/DeleteEvmImplementationAbi"
+	// EvmIndexerServiceListEvmProxyImplementationsProcedure is the fully-qualified name of the
+	// EvmIndexerService's ListEvmProxyImplementations RPC.
+	EvmIndexerServiceListEvmProxyImplementationsProcedure = "/evm_indexer.v1.EvmIndexerService/ListEvmProxyImplementations"
+	// EvmIndexerServiceImportEvmEventSignaturesProcedure is the fully-qualified name of the
+	// EvmIndexerService's ImportEvmEventSignatures RPC.
+	EvmIndexerServiceImportEvmEventSignaturesProcedure = "/evm_indexer.v1.EvmIndexerService/ImportEvmEventSignatures"
+	// EvmIndexerServiceListEvmEventSignaturesProcedure is the fully-qualified name of the
+	// EvmIndexerService's ListEvmEventSignatures RPC.
+	EvmIndexerServiceListEvmEventSignaturesProcedure = "/evm_indexer.v1.EvmIndexerService/ListEvmEventSignatures"
+	// EvmIndexerServiceDeleteEvmEventSignatureProcedure is the fully-qualified name of the
+	// EvmIndexerService's DeleteEvmEventSignature RPC.
+	EvmIndexerServiceDeleteEvmEventSignatureProcedure = "/evm_indexer.v1.EvmIndexerService/DeleteEvmEventSignature"
 	// EvmIndexerServiceCreateEvmLogStoreProcedure is the fully-qualified name of the
 	// EvmIndexerService's CreateEvmLogStore RPC.
 	EvmIndexerServiceCreateEvmLogStoreProcedure = "/evm_indexer.v1.EvmIndexerService/CreateEvmLogStore"
@@ -120,6 +144,12 @@ const (
 	// EvmIndexerServiceDeleteEvmLogSourceProcedure is the fully-qualified name of the
 	// EvmIndexerService's DeleteEvmLogSource RPC.
 	EvmIndexerServiceDeleteEvmLogSourceProcedure = "/evm_indexer.v1.EvmIndexerService/DeleteEvmLogSource"
+	// EvmIndexerServiceRedecodeEvmLogSourceProcedure is the fully-qualified name of the
+	// EvmIndexerService's RedecodeEvmLogSource RPC.
+	EvmIndexerServiceRedecodeEvmLogSourceProcedure = "/evm_indexer.v1.EvmIndexerService/RedecodeEvmLogSource"
+	// EvmIndexerServiceResyncEvmLogSourceProcedure is the fully-qualified name of the
+	// EvmIndexerService's ResyncEvmLogSource RPC.
+	EvmIndexerServiceResyncEvmLogSourceProcedure = "/evm_indexer.v1.EvmIndexerService/ResyncEvmLogSource"
 	// EvmIndexerServiceStreamEvmLogSourceUpdatesProcedure is the fully-qualified name of the
 	// EvmIndexerService's StreamEvmLogSourceUpdates RPC.
 	EvmIndexerServiceStreamEvmLogSourceUpdatesProcedure = "/evm_indexer.v1.EvmIndexerService/StreamEvmLogSourceUpdates"
@@ -132,6 +162,12 @@ const (
 	// EvmIndexerServiceListEvmTransactionsProcedure is the fully-qualified name of the
 	// EvmIndexerService's ListEvmTransactions RPC.
 	EvmIndexerServiceListEvmTransactionsProcedure = "/evm_indexer.v1.EvmIndexerService/ListEvmTransactions"
+	// EvmIndexerServiceListEvmBlocksProcedure is the fully-qualified name of the EvmIndexerService's
+	// ListEvmBlocks RPC.
+	EvmIndexerServiceListEvmBlocksProcedure = "/evm_indexer.v1.EvmIndexerService/ListEvmBlocks"
+	// EvmIndexerServiceListEvmTracesProcedure is the fully-qualified name of the EvmIndexerService's
+	// ListEvmTraces RPC.
+	EvmIndexerServiceListEvmTracesProcedure = "/evm_indexer.v1.EvmIndexerService/ListEvmTraces"
 	// EvmIndexerServiceLoginProcedure is the fully-qualified name of the EvmIndexerService's Login RPC.
 	EvmIndexerServiceLoginProcedure = "/evm_indexer.v1.EvmIndexerService/Login"
 	// EvmIndexerServiceListOAuthLoginUrlsProcedure is the fully-qualified name of the
@@ -224,70 +260,82 @@ const (
 
 // These variables are the protoreflect.Descriptor objects for the RPCs defined in this package.
 var (
-	evmIndexerServiceServiceDescriptor                         = v1.File_evm_indexer_v1_evm_indexer_proto.Services().ByName("EvmIndexerService")
-	evmIndexerServiceGetEvmiInstanceMethodDescriptor           = evmIndexerServiceServiceDescriptor.Methods().ByName("GetEvmiInstance")
-	evmIndexerServiceListEvmiInstancesMethodDescriptor         = evmIndexerServiceServiceDescriptor.Methods().ByName("ListEvmiInstances")
-	evmIndexerServiceCreateEvmBlockchainMethodDescriptor       = evmIndexerServiceServiceDescriptor.Methods().ByName("CreateEvmBlockchain")
-	evmIndexerServiceGetEvmBlockchainMethodDescriptor          = evmIndexerServiceServiceDescriptor.Methods().ByName("GetEvmBlockchain")
-	evmIndexerServiceUpdateEvmBlockchainMethodDescriptor       = evmIndexerServiceServiceDescriptor.Methods().ByName("UpdateEvmBlockchain")
-	evmIndexerServiceListEvmBlockchainsMethodDescriptor        = evmIndexerServiceServiceDescriptor.Methods().ByName("ListEvmBlockchains")
-	evmIndexerServiceDeleteEvmBlockchainMethodDescriptor       = evmIndexerServiceServiceDescriptor.Methods().ByName("DeleteEvmBlockchain")
-	evmIndexerServiceCreateEvmJsonAbiMethodDescriptor          = evmIndexerServiceServiceDescriptor.Methods().ByName("CreateEvmJsonAbi")
-	evmIndexerServiceGetEvmJsonAbiMethodDescriptor             = evmIndexerServiceServiceDescriptor.Methods().ByName("GetEvmJsonAbi")
-	evmIndexerServiceUpdateEvmJsonAbiMethodDescriptor          = evmIndexerServiceServiceDescriptor.Methods().ByName("UpdateEvmJsonAbi")
-	evmIndexerServiceListEvmJsonAbisMethodDescriptor           = evmIndexerServiceServiceDescriptor.Methods().ByName("ListEvmJsonAbis")
-	evmIndexerServiceDeleteEvmJsonAbiMethodDescriptor          = evmIndexerServiceServiceDescriptor.Methods().ByName("DeleteEvmJsonAbi")
-	evmIndexerServiceCreateEvmLogStoreMethodDescriptor         = evmIndexerServiceServiceDescriptor.Methods().ByName("CreateEvmLogStore")
-	evmIndexerServiceGetEvmLogStoreMethodDescriptor            = evmIndexerServiceServiceDescriptor.Methods().ByName("GetEvmLogStore")
-	evmIndexerServiceUpdateEvmLogStoreMethodDescriptor         = evmIndexerServiceServiceDescriptor.Methods().ByName("UpdateEvmLogStore")
-	evmIndexerServiceListEvmLogStoresMethodDescriptor          = evmIndexerServiceServiceDescriptor.Methods().ByName("ListEvmLogStores")
-	evmIndexerServiceDeleteEvmLogStoreMethodDescriptor         = evmIndexerServiceServiceDescriptor.Methods().ByName("DeleteEvmLogStore")
-	evmIndexerServiceCreateEvmLogPipelineMethodDescriptor      = evmIndexerServiceServiceDescriptor.Methods().ByName("CreateEvmLogPipeline")
-	evmIndexerServiceGetEvmLogPipelineMethodDescriptor         = evmIndexerServiceServiceDescriptor.Methods().ByName("GetEvmLogPipeline")
-	evmIndexerServiceUpdateEvmLogPipelineMethodDescriptor      = evmIndexerServiceServiceDescriptor.Methods().ByName("UpdateEvmLogPipeline")
-	evmIndexerServiceListEvmLogPipelinesMethodDescriptor       = evmIndexerServiceServiceDescriptor.Methods().ByName("ListEvmLogPipelines")
-	evmIndexerServiceDeleteEvmLogPipelineMethodDescriptor      = evmIndexerServiceServiceDescriptor.Methods().ByName("DeleteEvmLogPipeline")
-	evmIndexerServiceStartSourceIndexerMethodDescriptor        = evmIndexerServiceServiceDescriptor.Methods().ByName("StartSourceIndexer")
-	evmIndexerServiceStopSourceIndexerMethodDescriptor         = evmIndexerServiceServiceDescriptor.Methods().ByName("StopSourceIndexer")
-	evmIndexerServiceCreateEvmLogSourceMethodDescriptor        = evmIndexerServiceServiceDescriptor.Methods().ByName("CreateEvmLogSource")
-	evmIndexerServiceGetEvmLogSourceMethodDescriptor           = evmIndexerServiceServiceDescriptor.Methods().ByName("GetEvmLogSource")
-	evmIndexerServiceUpdateEvmLogSourceMethodDescriptor        = evmIndexerServiceServiceDescriptor.Methods().ByName("UpdateEvmLogSource")
-	evmIndexerServiceListEvmLogSourcesMethodDescriptor         = evmIndexerServiceServiceDescriptor.Methods().ByName("ListEvmLogSources")
-	evmIndexerServiceDeleteEvmLogSourceMethodDescriptor        = evmIndexerServiceServiceDescriptor.Methods().ByName("DeleteEvmLogSource")
-	evmIndexerServiceStreamEvmLogSourceUpdatesMethodDescriptor = evmIndexerServiceServiceDescriptor.Methods().ByName("StreamEvmLogSourceUpdates")
-	evmIndexerServiceListEvmLogsMethodDescriptor               = evmIndexerServiceServiceDescriptor.Methods().ByName("ListEvmLogs")
-	evmIndexerServiceListLatestEvmLogsMethodDescriptor         = evmIndexerServiceServiceDescriptor.Methods().ByName("ListLatestEvmLogs")
-	evmIndexerServiceListEvmTransactionsMethodDescriptor       = evmIndexerServiceServiceDescriptor.Methods().ByName("ListEvmTransactions")
-	evmIndexerServiceLoginMethodDescriptor                     = evmIndexerServiceServiceDescriptor.Methods().ByName("Login")
-	evmIndexerServiceListOAuthLoginUrlsMethodDescriptor        = evmIndexerServiceServiceDescriptor.Methods().ByName("ListOAuthLoginUrls")
-	evmIndexerServiceMeMethodDescriptor                        = evmIndexerServiceServiceDescriptor.Methods().ByName("Me")
-	evmIndexerServiceCreateAccessTokenMethodDescriptor         = evmIndexerServiceServiceDescriptor.Methods().ByName("CreateAccessToken")
-	evmIndexerServiceListAccessTokensMethodDescriptor          = evmIndexerServiceServiceDescriptor.Methods().ByName("ListAccessTokens")
-	evmIndexerServiceRevokeAccessTokenMethodDescriptor         = evmIndexerServiceServiceDescriptor.Methods().ByName("RevokeAccessToken")
-	evmIndexerServiceListOAuthProvidersMethodDescriptor        = evmIndexerServiceServiceDescriptor.Methods().ByName("ListOAuthProviders")
-	evmIndexerServiceCreateOAuthProviderMethodDescriptor       = evmIndexerServiceServiceDescriptor.Methods().ByName("CreateOAuthProvider")
-	evmIndexerServiceUpdateOAuthProviderMethodDescriptor       = evmIndexerServiceServiceDescriptor.Methods().ByName("UpdateOAuthProvider")
-	evmIndexerServiceDeleteOAuthProviderMethodDescriptor       = evmIndexerServiceServiceDescriptor.Methods().ByName("DeleteOAuthProvider")
-	evmIndexerServiceListUsersMethodDescriptor                 = evmIndexerServiceServiceDescriptor.Methods().ByName("ListUsers")
-	evmIndexerServiceCreateUserMethodDescriptor                = evmIndexerServiceServiceDescriptor.Methods().ByName("CreateUser")
-	evmIndexerServiceUpdateUserMethodDescriptor                = evmIndexerServiceServiceDescriptor.Methods().ByName("UpdateUser")
-	evmIndexerServiceDeleteUserMethodDescriptor                = evmIndexerServiceServiceDescriptor.Methods().ByName("DeleteUser")
-	evmIndexerServiceCreateEvmiExporterMethodDescriptor        = evmIndexerServiceServiceDescriptor.Methods().ByName("CreateEvmiExporter")
-	evmIndexerServiceGetEvmiExporterMethodDescriptor           = evmIndexerServiceServiceDescriptor.Methods().ByName("GetEvmiExporter")
-	evmIndexerServiceUpdateEvmiExporterMethodDescriptor        = evmIndexerServiceServiceDescriptor.Methods().ByName("UpdateEvmiExporter")
-	evmIndexerServiceListEvmiExportersMethodDescriptor         = evmIndexerServiceServiceDescriptor.Methods().ByName("ListEvmiExporters")
-	evmIndexerServiceDeleteEvmiExporterMethodDescriptor        = evmIndexerServiceServiceDescriptor.Methods().ByName("DeleteEvmiExporter")
-	evmIndexerServiceStartExporterMethodDescriptor             = evmIndexerServiceServiceDescriptor.Methods().ByName("StartExporter")
-	evmIndexerServiceStopExporterMethodDescriptor              = evmIndexerServiceServiceDescriptor.Methods().ByName("StopExporter")
-	evmIndexerServiceStreamEvmiExporterUpdatesMethodDescriptor = evmIndexerServiceServiceDescriptor.Methods().ByName("StreamEvmiExporterUpdates")
-	evmIndexerServiceCreatePluginMethodDescriptor              = evmIndexerServiceServiceDescriptor.Methods().ByName("CreatePlugin")
-	evmIndexerServiceGetPluginMethodDescriptor                 = evmIndexerServiceServiceDescriptor.Methods().ByName("GetPlugin")
-	evmIndexerServiceUpdatePluginMethodDescriptor              = evmIndexerServiceServiceDescriptor.Methods().ByName("UpdatePlugin")
-	evmIndexerServiceListPluginsMethodDescriptor               = evmIndexerServiceServiceDescriptor.Methods().ByName("ListPlugins")
-	evmIndexerServiceDeletePluginMethodDescriptor              = evmIndexerServiceServiceDescriptor.Methods().ByName("DeletePlugin")
-	evmIndexerServiceInstallPluginMethodDescriptor             = evmIndexerServiceServiceDescriptor.Methods().ByName("InstallPlugin")
-	evmIndexerServiceListPluginGitRefsMethodDescriptor         = evmIndexerServiceServiceDescriptor.Methods().ByName("ListPluginGitRefs")
-	evmIndexerServiceExportConfigurationMethodDescriptor       = evmIndexerServiceServiceDescriptor.Methods().ByName("ExportConfiguration")
+	evmIndexerServiceServiceDescriptor                           = v1.File_evm_indexer_v1_evm_indexer_proto.Services().ByName("EvmIndexerService")
+	evmIndexerServiceGetEvmiInstanceMethodDescriptor             = evmIndexerServiceServiceDescriptor.Methods().ByName("GetEvmiInstance")
+	evmIndexerServiceListEvmiInstancesMethodDescriptor           = evmIndexerServiceServiceDescriptor.Methods().ByName("ListEvmiInstances")
+	evmIndexerServiceCreateEvmBlockchainMethodDescriptor         = evmIndexerServiceServiceDescriptor.Methods().ByName("CreateEvmBlockchain")
+	evmIndexerServiceGetEvmBlockchainMethodDescriptor            = evmIndexerServiceServiceDescriptor.Methods().ByName("GetEvmBlockchain")
+	evmIndexerServiceUpdateEvmBlockchainMethodDescriptor         = evmIndexerServiceServiceDescriptor.Methods().ByName("UpdateEvmBlockchain")
+	evmIndexerServiceListEvmBlockchainsMethodDescriptor          = evmIndexerServiceServiceDescriptor.Methods().ByName("ListEvmBlockchains")
+	evmIndexerServiceDeleteEvmBlockchainMethodDescriptor         = evmIndexerServiceServiceDescriptor.Methods().ByName("DeleteEvmBlockchain")
+	evmIndexerServiceCreateEvmJsonAbiMethodDescriptor            = evmIndexerServiceServiceDescriptor.Methods().ByName("CreateEvmJsonAbi")
+	evmIndexerServiceGetEvmJsonAbiMethodDescriptor               = evmIndexerServiceServiceDescriptor.Methods().ByName("GetEvmJsonAbi")
+	evmIndexerServiceUpdateEvmJsonAbiMethodDescriptor            = evmIndexerServiceServiceDescriptor.Methods().ByName("UpdateEvmJsonAbi")
+	evmIndexerServiceListEvmJsonAbisMethodDescriptor             = evmIndexerServiceServiceDescriptor.Methods().ByName("ListEvmJsonAbis")
+	evmIndexerServiceDeleteEvmJsonAbiMethodDescriptor            = evmIndexerServiceServiceDescriptor.Methods().ByName("DeleteEvmJsonAbi")
+	evmIndexerServiceGetEvmJsonAbiSchemaMethodDescriptor         = evmIndexerServiceServiceDescriptor.Methods().ByName("GetEvmJsonAbiSchema")
+	evmIndexerServiceSetEvmImplementationAbiMethodDescriptor     = evmIndexerServiceServiceDescriptor.Methods().ByName("SetEvmImplementationAbi")
+	evmIndexerServiceListEvmImplementationAbisMethodDescriptor   = evmIndexerServiceServiceDescriptor.Methods().ByName("ListEvmImplementationAbis")
+	evmIndexerServiceDeleteEvmImplementationAbiMethodDescriptor  = evmIndexerServiceServiceDescriptor.Methods().ByName("DeleteEvmImplementationAbi")
+	evmIndexerServiceListEvmProxyImplementationsMethodDescriptor = evmIndexerServiceServiceDescriptor.Methods().ByName("ListEvmProxyImplementations")
+	evmIndexerServiceImportEvmEventSignaturesMethodDescriptor    = evmIndexerServiceServiceDescriptor.Methods().ByName("ImportEvmEventSignatures")
+	evmIndexerServiceListEvmEventSignaturesMethodDescriptor      = evmIndexerServiceServiceDescriptor.Methods().ByName("ListEvmEventSignatures")
+	evmIndexerServiceDeleteEvmEventSignatureMethodDescriptor     = evmIndexerServiceServiceDescriptor.Methods().ByName("DeleteEvmEventSignature")
+	evmIndexerServiceCreateEvmLogStoreMethodDescriptor           = evmIndexerServiceServiceDescriptor.Methods().ByName("CreateEvmLogStore")
+	evmIndexerServiceGetEvmLogStoreMethodDescriptor              = evmIndexerServiceServiceDescriptor.Methods().ByName("GetEvmLogStore")
+	evmIndexerServiceUpdateEvmLogStoreMethodDescriptor           = evmIndexerServiceServiceDescriptor.Methods().ByName("UpdateEvmLogStore")
+	evmIndexerServiceListEvmLogStoresMethodDescriptor            = evmIndexerServiceServiceDescriptor.Methods().ByName("ListEvmLogStores")
+	evmIndexerServiceDeleteEvmLogStoreMethodDescriptor           = evmIndexerServiceServiceDescriptor.Methods().ByName("DeleteEvmLogStore")
+	evmIndexerServiceCreateEvmLogPipelineMethodDescriptor        = evmIndexerServiceServiceDescriptor.Methods().ByName("CreateEvmLogPipeline")
+	evmIndexerServiceGetEvmLogPipelineMethodDescriptor           = evmIndexerServiceServiceDescriptor.Methods().ByName("GetEvmLogPipeline")
+	evmIndexerServiceUpdateEvmLogPipelineMethodDescriptor        = evmIndexerServiceServiceDescriptor.Methods().ByName("UpdateEvmLogPipeline")
+	evmIndexerServiceListEvmLogPipelinesMethodDescriptor         = evmIndexerServiceServiceDescriptor.Methods().ByName("ListEvmLogPipelines")
+	evmIndexerServiceDeleteEvmLogPipelineMethodDescriptor        = evmIndexerServiceServiceDescriptor.Methods().ByName("DeleteEvmLogPipeline")
+	evmIndexerServiceStartSourceIndexerMethodDescriptor          = evmIndexerServiceServiceDescriptor.Methods().ByName("StartSourceIndexer")
+	evmIndexerServiceStopSourceIndexerMethodDescriptor           = evmIndexerServiceServiceDescriptor.Methods().ByName("StopSourceIndexer")
+	evmIndexerServiceCreateEvmLogSourceMethodDescriptor          = evmIndexerServiceServiceDescriptor.Methods().ByName("CreateEvmLogSource")
+	evmIndexerServiceGetEvmLogSourceMethodDescriptor             = evmIndexerServiceServiceDescriptor.Methods().ByName("GetEvmLogSource")
+	evmIndexerServiceUpdateEvmLogSourceMethodDescriptor          = evmIndexerServiceServiceDescriptor.Methods().ByName("UpdateEvmLogSource")
+	evmIndexerServiceListEvmLogSourcesMethodDescriptor           = evmIndexerServiceServiceDescriptor.Methods().ByName("ListEvmLogSources")
+	evmIndexerServiceDeleteEvmLogSourceMethodDescriptor          = evmIndexerServiceServiceDescriptor.Methods().ByName("DeleteEvmLogSource")
+	evmIndexerServiceRedecodeEvmLogSourceMethodDescriptor        = evmIndexerServiceServiceDescriptor.Methods().ByName("RedecodeEvmLogSource")
+	evmIndexerServiceResyncEvmLogSourceMethodDescriptor          = evmIndexerServiceServiceDescriptor.Methods().ByName("ResyncEvmLogSource")
+	evmIndexerServiceStreamEvmLogSourceUpdatesMethodDescriptor   = evmIndexerServiceServiceDescriptor.Methods().ByName("StreamEvmLogSourceUpdates")
+	evmIndexerServiceListEvmLogsMethodDescriptor                 = evmIndexerServiceServiceDescriptor.Methods().ByName("ListEvmLogs")
+	evmIndexerServiceListLatestEvmLogsMethodDescriptor           = evmIndexerServiceServiceDescriptor.Methods().ByName("ListLatestEvmLogs")
+	evmIndexerServiceListEvmTransactionsMethodDescriptor         = evmIndexerServiceServiceDescriptor.Methods().ByName("ListEvmTransactions")
+	evmIndexerServiceListEvmBlocksMethodDescriptor               = evmIndexerServiceServiceDescriptor.Methods().ByName("ListEvmBlocks")
+	evmIndexerServiceListEvmTracesMethodDescriptor               = evmIndexerServiceServiceDescriptor.Methods().ByName("ListEvmTraces")
+	evmIndexerServiceLoginMethodDescriptor                       = evmIndexerServiceServiceDescriptor.Methods().ByName("Login")
+	evmIndexerServiceListOAuthLoginUrlsMethodDescriptor          = evmIndexerServiceServiceDescriptor.Methods().ByName("ListOAuthLoginUrls")
+	evmIndexerServiceMeMethodDescriptor                          = evmIndexerServiceServiceDescriptor.Methods().ByName("Me")
+	evmIndexerServiceCreateAccessTokenMethodDescriptor           = evmIndexerServiceServiceDescriptor.Methods().ByName("CreateAccessToken")
+	evmIndexerServiceListAccessTokensMethodDescriptor            = evmIndexerServiceServiceDescriptor.Methods().ByName("ListAccessTokens")
+	evmIndexerServiceRevokeAccessTokenMethodDescriptor           = evmIndexerServiceServiceDescriptor.Methods().ByName("RevokeAccessToken")
+	evmIndexerServiceListOAuthProvidersMethodDescriptor          = evmIndexerServiceServiceDescriptor.Methods().ByName("ListOAuthProviders")
+	evmIndexerServiceCreateOAuthProviderMethodDescriptor         = evmIndexerServiceServiceDescriptor.Methods().ByName("CreateOAuthProvider")
+	evmIndexerServiceUpdateOAuthProviderMethodDescriptor         = evmIndexerServiceServiceDescriptor.Methods().ByName("UpdateOAuthProvider")
+	evmIndexerServiceDeleteOAuthProviderMethodDescriptor         = evmIndexerServiceServiceDescriptor.Methods().ByName("DeleteOAuthProvider")
+	evmIndexerServiceListUsersMethodDescriptor                   = evmIndexerServiceServiceDescriptor.Methods().ByName("ListUsers")
+	evmIndexerServiceCreateUserMethodDescriptor                  = evmIndexerServiceServiceDescriptor.Methods().ByName("CreateUser")
+	evmIndexerServiceUpdateUserMethodDescriptor                  = evmIndexerServiceServiceDescriptor.Methods().ByName("UpdateUser")
+	evmIndexerServiceDeleteUserMethodDescriptor                  = evmIndexerServiceServiceDescriptor.Methods().ByName("DeleteUser")
+	evmIndexerServiceCreateEvmiExporterMethodDescriptor          = evmIndexerServiceServiceDescriptor.Methods().ByName("CreateEvmiExporter")
+	evmIndexerServiceGetEvmiExporterMethodDescriptor             = evmIndexerServiceServiceDescriptor.Methods().ByName("GetEvmiExporter")
+	evmIndexerServiceUpdateEvmiExporterMethodDescriptor          = evmIndexerServiceServiceDescriptor.Methods().ByName("UpdateEvmiExporter")
+	evmIndexerServiceListEvmiExportersMethodDescriptor           = evmIndexerServiceServiceDescriptor.Methods().ByName("ListEvmiExporters")
+	evmIndexerServiceDeleteEvmiExporterMethodDescriptor          = evmIndexerServiceServiceDescriptor.Methods().ByName("DeleteEvmiExporter")
+	evmIndexerServiceStartExporterMethodDescriptor               = evmIndexerServiceServiceDescriptor.Methods().ByName("StartExporter")
+	evmIndexerServiceStopExporterMethodDescriptor                = evmIndexerServiceServiceDescriptor.Methods().ByName("StopExporter")
+	evmIndexerServiceStreamEvmiExporterUpdatesMethodDescriptor   = evmIndexerServiceServiceDescriptor.Methods().ByName("StreamEvmiExporterUpdates")
+	evmIndexerServiceCreatePluginMethodDescriptor                = evmIndexerServiceServiceDescriptor.Methods().ByName("CreatePlugin")
+	evmIndexerServiceGetPluginMethodDescriptor                   = evmIndexerServiceServiceDescriptor.Methods().ByName("GetPlugin")
+	evmIndexerServiceUpdatePluginMethodDescriptor                = evmIndexerServiceServiceDescriptor.Methods().ByName("UpdatePlugin")
+	evmIndexerServiceListPluginsMethodDescriptor                 = evmIndexerServiceServiceDescriptor.Methods().ByName("ListPlugins")
+	evmIndexerServiceDeletePluginMethodDescriptor                = evmIndexerServiceServiceDescriptor.Methods().ByName("DeletePlugin")
+	evmIndexerServiceInstallPluginMethodDescriptor               = evmIndexerServiceServiceDescriptor.Methods().ByName("InstallPlugin")
+	evmIndexerServiceListPluginGitRefsMethodDescriptor           = evmIndexerServiceServiceDescriptor.Methods().ByName("ListPluginGitRefs")
+	evmIndexerServiceExportConfigurationMethodDescriptor         = evmIndexerServiceServiceDescriptor.Methods().ByName("ExportConfiguration")
 )
 
 // EvmIndexerServiceClient is a client for the evm_indexer.v1.EvmIndexerService service.
@@ -307,6 +355,16 @@ type EvmIndexerServiceClient interface {
 	UpdateEvmJsonAbi(context.Context, *connect.Request[v1.UpdateEvmJsonAbiRequest]) (*connect.Response[v1.UpdateEvmJsonAbiResponse], error)
 	ListEvmJsonAbis(context.Context, *connect.Request[v1.ListEvmJsonAbisRequest]) (*connect.Response[v1.ListEvmJsonAbisResponse], error)
 	DeleteEvmJsonAbi(context.Context, *connect.Request[v1.DeleteEvmJsonAbiRequest]) (*connect.Response[v1.DeleteEvmJsonAbiResponse], error)
+	GetEvmJsonAbiSchema(context.Context, *connect.Request[v1.GetEvmJsonAbiSchemaRequest]) (*connect.Response[v1.GetEvmJsonAbiSchemaResponse], error)
+	// Proxies
+	SetEvmImplementationAbi(context.Context, *connect.Request[v1.SetEvmImplementationAbiRequest]) (*connect.Response[v1.SetEvmImplementationAbiResponse], error)
+	ListEvmImplementationAbis(context.Context, *connect.Request[v1.ListEvmImplementationAbisRequest]) (*connect.Response[v1.ListEvmImplementationAbisResponse], error)
+	DeleteEvmImplementationAbi(context.Context, *connect.Request[v1.DeleteEvmImplementationAbiRequest]) (*connect.Response[v1.DeleteEvmImplementationAbiResponse], error)
+	ListEvmProxyImplementations(context.Context, *connect.Request[v1.ListEvmProxyImplementationsRequest]) (*connect.Response[v1.ListEvmProxyImplementationsResponse], error)
+	// Event signatures
+	ImportEvmEventSignatures(context.Context, *connect.Request[v1.ImportEvmEventSignaturesRequest]) (*connect.Response[v1.ImportEvmEventSignaturesResponse], error)
+	ListEvmEventSignatures(context.Context, *connect.Request[v1.ListEvmEventSignaturesRequest]) (*connect.Response[v1.ListEvmEventSignaturesResponse], error)
+	DeleteEvmEventSignature(context.Context, *connect.Request[v1.DeleteEvmEventSignatureRequest]) (*connect.Response[v1.DeleteEvmEventSignatureResponse], error)
 	// EvmLogStore
 	CreateEvmLogStore(context.Context, *connect.Request[v1.CreateEvmLogStoreRequest]) (*connect.Response[v1.CreateEvmLogStoreResponse], error)
 	GetEvmLogStore(context.Context, *connect.Request[v1.GetEvmLogStoreRequest]) (*connect.Response[v1.GetEvmLogStoreResponse], error)
@@ -327,6 +385,12 @@ type EvmIndexerServiceClient interface {
 	UpdateEvmLogSource(context.Context, *connect.Request[v1.UpdateEvmLogSourceRequest]) (*connect.Response[v1.UpdateEvmLogSourceResponse], error)
 	ListEvmLogSources(context.Context, *connect.Request[v1.ListEvmLogSourcesRequest]) (*connect.Response[v1.ListEvmLogSourcesResponse], error)
 	DeleteEvmLogSource(context.Context, *connect.Request[v1.DeleteEvmLogSourceRequest]) (*connect.Response[v1.DeleteEvmLogSourceResponse], error)
+	// Starts re-decoding the source's stored logs with its current ABIs; the
+	// progress is streamed as source updates.
+	RedecodeEvmLogSource(context.Context, *connect.Request[v1.RedecodeEvmLogSourceRequest]) (*connect.Response[v1.RedecodeEvmLogSourceResponse], error)
+	// Stops the source, deletes its stored data from from_block, rewinds its
+	// cursor and its pipeline's exporters, then starts it again if enabled.
+	ResyncEvmLogSource(context.Context, *connect.Request[v1.ResyncEvmLogSourceRequest]) (*connect.Response[v1.ResyncEvmLogSourceResponse], error)
 	// Server stream of live source updates (indexing progress, status changes).
 	StreamEvmLogSourceUpdates(context.Context, *connect.Request[v1.StreamEvmLogSourceUpdatesRequest]) (*connect.ServerStreamForClient[v1.EvmLogSource], error)
 	// EvmLog
@@ -334,6 +398,8 @@ type EvmIndexerServiceClient interface {
 	ListLatestEvmLogs(context.Context, *connect.Request[v1.ListLatestEvmLogsRequest]) (*connect.Response[v1.ListLatestEvmLogsResponse], error)
 	// EvmTransaction
 	ListEvmTransactions(context.Context, *connect.Request[v1.ListEvmTransactionsRequest]) (*connect.Response[v1.ListEvmTransactionsResponse], error)
+	ListEvmBlocks(context.Context, *connect.Request[v1.ListEvmBlocksRequest]) (*connect.Response[v1.ListEvmBlocksResponse], error)
+	ListEvmTraces(context.Context, *connect.Request[v1.ListEvmTracesRequest]) (*connect.Response[v1.ListEvmTracesResponse], error)
 	// Auth (Login and GetOAuthLoginUrl are public; all others require a token)
 	Login(context.Context, *connect.Request[v1.LoginRequest]) (*connect.Response[v1.LoginResponse], error)
 	ListOAuthLoginUrls(context.Context, *connect.Request[v1.ListOAuthLoginUrlsRequest]) (*connect.Response[v1.ListOAuthLoginUrlsResponse], error)
@@ -455,6 +521,54 @@ func NewEvmIndexerServiceClient(httpClient connect.HTTPClient, baseURL string, o
 			connect.WithSchema(evmIndexerServiceDeleteEvmJsonAbiMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		getEvmJsonAbiSchema: connect.NewClient[v1.GetEvmJsonAbiSchemaRequest, v1.GetEvmJsonAbiSchemaResponse](
+			httpClient,
+			baseURL+EvmIndexerServiceGetEvmJsonAbiSchemaProcedure,
+			connect.WithSchema(evmIndexerServiceGetEvmJsonAbiSchemaMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		setEvmImplementationAbi: connect.NewClient[v1.SetEvmImplementationAbiRequest, v1.SetEvmImplementationAbiResponse](
+			httpClient,
+			baseURL+EvmIndexerServiceSetEvmImplementationAbiProcedure,
+			connect.WithSchema(evmIndexerServiceSetEvmImplementationAbiMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		listEvmImplementationAbis: connect.NewClient[v1.ListEvmImplementationAbisRequest, v1.ListEvmImplementationAbisResponse](
+			httpClient,
+			baseURL+EvmIndexerServiceListEvmImplementationAbisProcedure,
+			connect.WithSchema(evmIndexerServiceListEvmImplementationAbisMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		deleteEvmImplementationAbi: connect.NewClient[v1.DeleteEvmImplementationAbiRequest, v1.DeleteEvmImplementationAbiResponse](
+			httpClient,
+			baseURL+EvmIndexerServiceDeleteEvmImplementationAbiProcedure,
+			connect.WithSchema(evmIndexerServiceDeleteEvmImplementationAbiMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		listEvmProxyImplementations: connect.NewClient[v1.ListEvmProxyImplementationsRequest, v1.ListEvmProxyImplementationsResponse](
+			httpClient,
+			baseURL+EvmIndexerServiceListEvmProxyImplementationsProcedure,
+			connect.WithSchema(evmIndexerServiceListEvmProxyImplementationsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		importEvmEventSignatures: connect.NewClient[v1.ImportEvmEventSignaturesRequest, v1.ImportEvmEventSignaturesResponse](
+			httpClient,
+			baseURL+EvmIndexerServiceImportEvmEventSignaturesProcedure,
+			connect.WithSchema(evmIndexerServiceImportEvmEventSignaturesMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		listEvmEventSignatures: connect.NewClient[v1.ListEvmEventSignaturesRequest, v1.ListEvmEventSignaturesResponse](
+			httpClient,
+			baseURL+EvmIndexerServiceListEvmEventSignaturesProcedure,
+			connect.WithSchema(evmIndexerServiceListEvmEventSignaturesMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		deleteEvmEventSignature: connect.NewClient[v1.DeleteEvmEventSignatureRequest, v1.DeleteEvmEventSignatureResponse](
+			httpClient,
+			baseURL+EvmIndexerServiceDeleteEvmEventSignatureProcedure,
+			connect.WithSchema(evmIndexerServiceDeleteEvmEventSignatureMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 		createEvmLogStore: connect.NewClient[v1.CreateEvmLogStoreRequest, v1.CreateEvmLogStoreResponse](
 			httpClient,
 			baseURL+EvmIndexerServiceCreateEvmLogStoreProcedure,
@@ -557,6 +671,18 @@ func NewEvmIndexerServiceClient(httpClient connect.HTTPClient, baseURL string, o
 			connect.WithSchema(evmIndexerServiceDeleteEvmLogSourceMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		redecodeEvmLogSource: connect.NewClient[v1.RedecodeEvmLogSourceRequest, v1.RedecodeEvmLogSourceResponse](
+			httpClient,
+			baseURL+EvmIndexerServiceRedecodeEvmLogSourceProcedure,
+			connect.WithSchema(evmIndexerServiceRedecodeEvmLogSourceMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		resyncEvmLogSource: connect.NewClient[v1.ResyncEvmLogSourceRequest, v1.ResyncEvmLogSourceResponse](
+			httpClient,
+			baseURL+EvmIndexerServiceResyncEvmLogSourceProcedure,
+			connect.WithSchema(evmIndexerServiceResyncEvmLogSourceMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 		streamEvmLogSourceUpdates: connect.NewClient[v1.StreamEvmLogSourceUpdatesRequest, v1.EvmLogSource](
 			httpClient,
 			baseURL+EvmIndexerServiceStreamEvmLogSourceUpdatesProcedure,
@@ -581,6 +707,18 @@ func NewEvmIndexerServiceClient(httpClient connect.HTTPClient, baseURL string, o
 			connect.WithSchema(evmIndexerServiceListEvmTransactionsMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		listEvmBlocks: connect.NewClient[v1.ListEvmBlocksRequest, v1.ListEvmBlocksResponse](
+			httpClient,
+			baseURL+EvmIndexerServiceListEvmBlocksProcedure,
+			connect.WithSchema(evmIndexerServiceListEvmBlocksMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		listEvmTraces: connect.NewClient[v1.ListEvmTracesRequest, v1.ListEvmTracesResponse](
+			httpClient,
+			baseURL+EvmIndexerServiceListEvmTracesProcedure,
+			connect.WithSchema(evmIndexerServiceListEvmTracesMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 		login: connect.NewClient[v1.LoginRequest, v1.LoginResponse](
 			httpClient,
 			baseURL+EvmIndexerServiceLoginProcedure,
@@ -766,69 +904,81 @@ func NewEvmIndexerServiceClient(httpClient connect.HTTPClient, baseURL string, o
 
 // evmIndexerServiceClient implements EvmIndexerServiceClient.
 type evmIndexerServiceClient struct {
-	getEvmiInstance           *connect.Client[v1.GetEvmiInstanceRequest, v1.GetEvmiInstanceResponse]
-	listEvmiInstances         *connect.Client[v1.ListEvmiInstancesRequest, v1.ListEvmiInstancesResponse]
-	createEvmBlockchain       *connect.Client[v1.CreateEvmBlockchainRequest, v1.CreateEvmBlockchainResponse]
-	getEvmBlockchain          *connect.Client[v1.GetEvmBlockchainRequest, v1.GetEvmBlockchainResponse]
-	updateEvmBlockchain       *connect.Client[v1.UpdateEvmBlockchainRequest, v1.UpdateEvmBlockchainResponse]
-	listEvmBlockchains        *connect.Client[v1.ListEvmBlockchainsRequest, v1.ListEvmBlockchainsResponse]
-	deleteEvmBlockchain       *connect.Client[v1.DeleteEvmBlockchainRequest, v1.DeleteEvmBlockchainResponse]
-	createEvmJsonAbi          *connect.Client[v1.CreateEvmJsonAbiRequest, v1.CreateEvmJsonAbiResponse]
-	getEvmJsonAbi             *connect.Client[v1.GetEvmJsonAbiRequest, v1.GetEvmJsonAbiResponse]
-	updateEvmJsonAbi          *connect.Client[v1.UpdateEvmJsonAbiRequest, v1.UpdateEvmJsonAbiResponse]
-	listEvmJsonAbis           *connect.Client[v1.ListEvmJsonAbisRequest, v1.ListEvmJsonAbisResponse]
-	deleteEvmJsonAbi          *connect.Client[v1.DeleteEvmJsonAbiRequest, v1.DeleteEvmJsonAbiResponse]
-	createEvmLogStore         *connect.Client[v1.CreateEvmLogStoreRequest, v1.CreateEvmLogStoreResponse]
-	getEvmLogStore            *connect.Client[v1.GetEvmLogStoreRequest, v1.GetEvmLogStoreResponse]
-	updateEvmLogStore         *connect.Client[v1.UpdateEvmLogStoreRequest, v1.UpdateEvmLogStoreResponse]
-	listEvmLogStores          *connect.Client[v1.ListEvmLogStoresRequest, v1.ListEvmLogStoresResponse]
-	deleteEvmLogStore         *connect.Client[v1.DeleteEvmLogStoreRequest, v1.DeleteEvmLogStoreResponse]
-	createEvmLogPipeline      *connect.Client[v1.CreateEvmLogPipelineRequest, v1.CreateEvmLogPipelineResponse]
-	getEvmLogPipeline         *connect.Client[v1.GetEvmLogPipelineRequest, v1.GetEvmLogPipelineResponse]
-	updateEvmLogPipeline      *connect.Client[v1.UpdateEvmLogPipelineRequest, v1.UpdateEvmLogPipelineResponse]
-	listEvmLogPipelines       *connect.Client[v1.ListEvmLogPipelinesRequest, v1.ListEvmLogPipelinesResponse]
-	deleteEvmLogPipeline      *connect.Client[v1.DeleteEvmLogPipelineRequest, v1.DeleteEvmLogPipelineResponse]
-	startSourceIndexer        *connect.Client[v1.StartSourceIndexerRequest, v1.StartSourceIndexerResponse]
-	stopSourceIndexer         *connect.Client[v1.StopSourceIndexerRequest, v1.StopSourceIndexerResponse]
-	createEvmLogSource        *connect.Client[v1.CreateEvmLogSourceRequest, v1.CreateEvmLogSourceResponse]
-	getEvmLogSource           *connect.Client[v1.GetEvmLogSourceRequest, v1.GetEvmLogSourceResponse]
-	updateEvmLogSource        *connect.Client[v1.UpdateEvmLogSourceRequest, v1.UpdateEvmLogSourceResponse]
-	listEvmLogSources         *connect.Client[v1.ListEvmLogSourcesRequest, v1.ListEvmLogSourcesResponse]
-	deleteEvmLogSource        *connect.Client[v1.DeleteEvmLogSourceRequest, v1.DeleteEvmLogSourceResponse]
-	streamEvmLogSourceUpdates *connect.Client[v1.StreamEvmLogSourceUpdatesRequest, v1.EvmLogSource]
-	listEvmLogs               *connect.Client[v1.ListEvmLogsRequest, v1.ListEvmLogsResponse]
-	listLatestEvmLogs         *connect.Client[v1.ListLatestEvmLogsRequest, v1.ListLatestEvmLogsResponse]
-	listEvmTransactions       *connect.Client[v1.ListEvmTransactionsRequest, v1.ListEvmTransactionsResponse]
-	login                     *connect.Client[v1.LoginRequest, v1.LoginResponse]
-	listOAuthLoginUrls        *connect.Client[v1.ListOAuthLoginUrlsRequest, v1.ListOAuthLoginUrlsResponse]
-	me                        *connect.Client[v1.MeRequest, v1.MeResponse]
-	createAccessToken         *connect.Client[v1.CreateAccessTokenRequest, v1.CreateAccessTokenResponse]
-	listAccessTokens          *connect.Client[v1.ListAccessTokensRequest, v1.ListAccessTokensResponse]
-	revokeAccessToken         *connect.Client[v1.RevokeAccessTokenRequest, v1.RevokeAccessTokenResponse]
-	listOAuthProviders        *connect.Client[v1.ListOAuthProvidersRequest, v1.ListOAuthProvidersResponse]
-	createOAuthProvider       *connect.Client[v1.CreateOAuthProviderRequest, v1.CreateOAuthProviderResponse]
-	updateOAuthProvider       *connect.Client[v1.UpdateOAuthProviderRequest, v1.UpdateOAuthProviderResponse]
-	deleteOAuthProvider       *connect.Client[v1.DeleteOAuthProviderRequest, v1.DeleteOAuthProviderResponse]
-	listUsers                 *connect.Client[v1.ListUsersRequest, v1.ListUsersResponse]
-	createUser                *connect.Client[v1.CreateUserRequest, v1.CreateUserResponse]
-	updateUser                *connect.Client[v1.UpdateUserRequest, v1.UpdateUserResponse]
-	deleteUser                *connect.Client[v1.DeleteUserRequest, v1.DeleteUserResponse]
-	createEvmiExporter        *connect.Client[v1.CreateEvmiExporterRequest, v1.CreateEvmiExporterResponse]
-	getEvmiExporter           *connect.Client[v1.GetEvmiExporterRequest, v1.GetEvmiExporterResponse]
-	updateEvmiExporter        *connect.Client[v1.UpdateEvmiExporterRequest, v1.UpdateEvmiExporterResponse]
-	listEvmiExporters         *connect.Client[v1.ListEvmiExportersRequest, v1.ListEvmiExportersResponse]
-	deleteEvmiExporter        *connect.Client[v1.DeleteEvmiExporterRequest, v1.DeleteEvmiExporterResponse]
-	startExporter             *connect.Client[v1.StartExporterRequest, v1.StartExporterResponse]
-	stopExporter              *connect.Client[v1.StopExporterRequest, v1.StopExporterResponse]
-	streamEvmiExporterUpdates *connect.Client[v1.StreamEvmiExporterUpdatesRequest, v1.EvmiExporter]
-	createPlugin              *connect.Client[v1.CreatePluginRequest, v1.CreatePluginResponse]
-	getPlugin                 *connect.Client[v1.GetPluginRequest, v1.GetPluginResponse]
-	updatePlugin              *connect.Client[v1.UpdatePluginRequest, v1.UpdatePluginResponse]
-	listPlugins               *connect.Client[v1.ListPluginsRequest, v1.ListPluginsResponse]
-	deletePlugin              *connect.Client[v1.DeletePluginRequest, v1.DeletePluginResponse]
-	installPlugin             *connect.Client[v1.InstallPluginRequest, v1.InstallPluginResponse]
-	listPluginGitRefs         *connect.Client[v1.ListPluginGitRefsRequest, v1.ListPluginGitRefsResponse]
-	exportConfiguration       *connect.Client[v1.ExportConfigurationRequest, v1.ExportConfigurationResponse]
+	getEvmiInstance             *connect.Client[v1.GetEvmiInstanceRequest, v1.GetEvmiInstanceResponse]
+	listEvmiInstances           *connect.Client[v1.ListEvmiInstancesRequest, v1.ListEvmiInstancesResponse]
+	createEvmBlockchain         *connect.Client[v1.CreateEvmBlockchainRequest, v1.CreateEvmBlockchainResponse]
+	getEvmBlockchain            *connect.Client[v1.GetEvmBlockchainRequest, v1.GetEvmBlockchainResponse]
+	updateEvmBlockchain         *connect.Client[v1.UpdateEvmBlockchainRequest, v1.UpdateEvmBlockchainResponse]
+	listEvmBlockchains          *connect.Client[v1.ListEvmBlockchainsRequest, v1.ListEvmBlockchainsResponse]
+	deleteEvmBlockchain         *connect.Client[v1.DeleteEvmBlockchainRequest, v1.DeleteEvmBlockchainResponse]
+	createEvmJsonAbi            *connect.Client[v1.CreateEvmJsonAbiRequest, v1.CreateEvmJsonAbiResponse]
+	getEvmJsonAbi               *connect.Client[v1.GetEvmJsonAbiRequest, v1.GetEvmJsonAbiResponse]
+	updateEvmJsonAbi            *connect.Client[v1.UpdateEvmJsonAbiRequest, v1.UpdateEvmJsonAbiResponse]
+	listEvmJsonAbis             *connect.Client[v1.ListEvmJsonAbisRequest, v1.ListEvmJsonAbisResponse]
+	deleteEvmJsonAbi            *connect.Client[v1.DeleteEvmJsonAbiRequest, v1.DeleteEvmJsonAbiResponse]
+	getEvmJsonAbiSchema         *connect.Client[v1.GetEvmJsonAbiSchemaRequest, v1.GetEvmJsonAbiSchemaResponse]
+	setEvmImplementationAbi     *connect.Client[v1.SetEvmImplementationAbiRequest, v1.SetEvmImplementationAbiResponse]
+	listEvmImplementationAbis   *connect.Client[v1.ListEvmImplementationAbisRequest, v1.ListEvmImplementationAbisResponse]
+	deleteEvmImplementationAbi  *connect.Client[v1.DeleteEvmImplementationAbiRequest, v1.DeleteEvmImplementationAbiResponse]
+	listEvmProxyImplementations *connect.Client[v1.ListEvmProxyImplementationsRequest, v1.ListEvmProxyImplementationsResponse]
+	importEvmEventSignatures    *connect.Client[v1.ImportEvmEventSignaturesRequest, v1.ImportEvmEventSignaturesResponse]
+	listEvmEventSignatures      *connect.Client[v1.ListEvmEventSignaturesRequest, v1.ListEvmEventSignaturesResponse]
+	deleteEvmEventSignature     *connect.Client[v1.DeleteEvmEventSignatureRequest, v1.DeleteEvmEventSignatureResponse]
+	createEvmLogStore           *connect.Client[v1.CreateEvmLogStoreRequest, v1.CreateEvmLogStoreResponse]
+	getEvmLogStore              *connect.Client[v1.GetEvmLogStoreRequest, v1.GetEvmLogStoreResponse]
+	updateEvmLogStore           *connect.Client[v1.UpdateEvmLogStoreRequest, v1.UpdateEvmLogStoreResponse]
+	listEvmLogStores            *connect.Client[v1.ListEvmLogStoresRequest, v1.ListEvmLogStoresResponse]
+	deleteEvmLogStore           *connect.Client[v1.DeleteEvmLogStoreRequest, v1.DeleteEvmLogStoreResponse]
+	createEvmLogPipeline        *connect.Client[v1.CreateEvmLogPipelineRequest, v1.CreateEvmLogPipelineResponse]
+	getEvmLogPipeline           *connect.Client[v1.GetEvmLogPipelineRequest, v1.GetEvmLogPipelineResponse]
+	updateEvmLogPipeline        *connect.Client[v1.UpdateEvmLogPipelineRequest, v1.UpdateEvmLogPipelineResponse]
+	listEvmLogPipelines         *connect.Client[v1.ListEvmLogPipelinesRequest, v1.ListEvmLogPipelinesResponse]
+	deleteEvmLogPipeline        *connect.Client[v1.DeleteEvmLogPipelineRequest, v1.DeleteEvmLogPipelineResponse]
+	startSourceIndexer          *connect.Client[v1.StartSourceIndexerRequest, v1.StartSourceIndexerResponse]
+	stopSourceIndexer           *connect.Client[v1.StopSourceIndexerRequest, v1.StopSourceIndexerResponse]
+	createEvmLogSource          *connect.Client[v1.CreateEvmLogSourceRequest, v1.CreateEvmLogSourceResponse]
+	getEvmLogSource             *connect.Client[v1.GetEvmLogSourceRequest, v1.GetEvmLogSourceResponse]
+	updateEvmLogSource          *connect.Client[v1.UpdateEvmLogSourceRequest, v1.UpdateEvmLogSourceResponse]
+	listEvmLogSources           *connect.Client[v1.ListEvmLogSourcesRequest, v1.ListEvmLogSourcesResponse]
+	deleteEvmLogSource          *connect.Client[v1.DeleteEvmLogSourceRequest, v1.DeleteEvmLogSourceResponse]
+	redecodeEvmLogSource        *connect.Client[v1.RedecodeEvmLogSourceRequest, v1.RedecodeEvmLogSourceResponse]
+	resyncEvmLogSource          *connect.Client[v1.ResyncEvmLogSourceRequest, v1.ResyncEvmLogSourceResponse]
+	streamEvmLogSourceUpdates   *connect.Client[v1.StreamEvmLogSourceUpdatesRequest, v1.EvmLogSource]
+	listEvmLogs                 *connect.Client[v1.ListEvmLogsRequest, v1.ListEvmLogsResponse]
+	listLatestEvmLogs           *connect.Client[v1.ListLatestEvmLogsRequest, v1.ListLatestEvmLogsResponse]
+	listEvmTransactions         *connect.Client[v1.ListEvmTransactionsRequest, v1.ListEvmTransactionsResponse]
+	listEvmBlocks               *connect.Client[v1.ListEvmBlocksRequest, v1.ListEvmBlocksResponse]
+	listEvmTraces               *connect.Client[v1.ListEvmTracesRequest, v1.ListEvmTracesResponse]
+	login                       *connect.Client[v1.LoginRequest, v1.LoginResponse]
+	listOAuthLoginUrls          *connect.Client[v1.ListOAuthLoginUrlsRequest, v1.ListOAuthLoginUrlsResponse]
+	me                          *connect.Client[v1.MeRequest, v1.MeResponse]
+	createAccessToken           *connect.Client[v1.CreateAccessTokenRequest, v1.CreateAccessTokenResponse]
+	listAccessTokens            *connect.Client[v1.ListAccessTokensRequest, v1.ListAccessTokensResponse]
+	revokeAccessToken           *connect.Client[v1.RevokeAccessTokenRequest, v1.RevokeAccessTokenResponse]
+	listOAuthProviders          *connect.Client[v1.ListOAuthProvidersRequest, v1.ListOAuthProvidersResponse]
+	createOAuthProvider         *connect.Client[v1.CreateOAuthProviderRequest, v1.CreateOAuthProviderResponse]
+	updateOAuthProvider         *connect.Client[v1.UpdateOAuthProviderRequest, v1.UpdateOAuthProviderResponse]
+	deleteOAuthProvider         *connect.Client[v1.DeleteOAuthProviderRequest, v1.DeleteOAuthProviderResponse]
+	listUsers                   *connect.Client[v1.ListUsersRequest, v1.ListUsersResponse]
+	createUser                  *connect.Client[v1.CreateUserRequest, v1.CreateUserResponse]
+	updateUser                  *connect.Client[v1.UpdateUserRequest, v1.UpdateUserResponse]
+	deleteUser                  *connect.Client[v1.DeleteUserRequest, v1.DeleteUserResponse]
+	createEvmiExporter          *connect.Client[v1.CreateEvmiExporterRequest, v1.CreateEvmiExporterResponse]
+	getEvmiExporter             *connect.Client[v1.GetEvmiExporterRequest, v1.GetEvmiExporterResponse]
+	updateEvmiExporter          *connect.Client[v1.UpdateEvmiExporterRequest, v1.UpdateEvmiExporterResponse]
+	listEvmiExporters           *connect.Client[v1.ListEvmiExportersRequest, v1.ListEvmiExportersResponse]
+	deleteEvmiExporter          *connect.Client[v1.DeleteEvmiExporterRequest, v1.DeleteEvmiExporterResponse]
+	startExporter               *connect.Client[v1.StartExporterRequest, v1.StartExporterResponse]
+	stopExporter                *connect.Client[v1.StopExporterRequest, v1.StopExporterResponse]
+	streamEvmiExporterUpdates   *connect.Client[v1.StreamEvmiExporterUpdatesRequest, v1.EvmiExporter]
+	createPlugin                *connect.Client[v1.CreatePluginRequest, v1.CreatePluginResponse]
+	getPlugin                   *connect.Client[v1.GetPluginRequest, v1.GetPluginResponse]
+	updatePlugin                *connect.Client[v1.UpdatePluginRequest, v1.UpdatePluginResponse]
+	listPlugins                 *connect.Client[v1.ListPluginsRequest, v1.ListPluginsResponse]
+	deletePlugin                *connect.Client[v1.DeletePluginRequest, v1.DeletePluginResponse]
+	installPlugin               *connect.Client[v1.InstallPluginRequest, v1.InstallPluginResponse]
+	listPluginGitRefs           *connect.Client[v1.ListPluginGitRefsRequest, v1.ListPluginGitRefsResponse]
+	exportConfiguration         *connect.Client[v1.ExportConfigurationRequest, v1.ExportConfigurationResponse]
 }
 
 // GetEvmiInstance calls evm_indexer.v1.EvmIndexerService.GetEvmiInstance.
@@ -891,6 +1041,46 @@ func (c *evmIndexerServiceClient) DeleteEvmJsonAbi(ctx context.Context, req *con
 	return c.deleteEvmJsonAbi.CallUnary(ctx, req)
 }
 
+// GetEvmJsonAbiSchema calls evm_indexer.v1.EvmIndexerService.GetEvmJsonAbiSchema.
+func (c *evmIndexerServiceClient) GetEvmJsonAbiSchema(ctx context.Context, req *connect.Request[v1.GetEvmJsonAbiSchemaRequest]) (*connect.Response[v1.GetEvmJsonAbiSchemaResponse], error) {
+	return c.getEvmJsonAbiSchema.CallUnary(ctx, req)
+}
+
+// SetEvmImplementationAbi calls evm_indexer.v1.EvmIndexerService.SetEvmImplementationAbi.
+func (c *evmIndexerServiceClient) SetEvmImplementationAbi(ctx context.Context, req *connect.Request[v1.SetEvmImplementationAbiRequest]) (*connect.Response[v1.SetEvmImplementationAbiResponse], error) {
+	return c.setEvmImplementationAbi.CallUnary(ctx, req)
+}
+
+// ListEvmImplementationAbis calls evm_indexer.v1.EvmIndexerService.ListEvmImplementationAbis.
+func (c *evmIndexerServiceClient) ListEvmImplementationAbis(ctx context.Context, req *connect.Request[v1.ListEvmImplementationAbisRequest]) (*connect.Response[v1.ListEvmImplementationAbisResponse], error) {
+	return c.listEvmImplementationAbis.CallUnary(ctx, req)
+}
+
+// DeleteEvmImplementationAbi calls evm_indexer.v1.EvmIndexerService.DeleteEvmImplementationAbi.
+func (c *evmIndexerServiceClient) DeleteEvmImplementationAbi(ctx context.Context, req *connect.Request[v1.DeleteEvmImplementationAbiRequest]) (*connect.Response[v1.DeleteEvmImplementationAbiResponse], error) {
+	return c.deleteEvmImplementationAbi.CallUnary(ctx, req)
+}
+
+// ListEvmProxyImplementations calls evm_indexer.v1.EvmIndexerService.ListEvmProxyImplementations.
+func (c *evmIndexerServiceClient) ListEvmProxyImplementations(ctx context.Context, req *connect.Request[v1.ListEvmProxyImplementationsRequest]) (*connect.Response[v1.ListEvmProxyImplementationsResponse], error) {
+	return c.listEvmProxyImplementations.CallUnary(ctx, req)
+}
+
+// ImportEvmEventSignatures calls evm_indexer.v1.EvmIndexerService.ImportEvmEventSignatures.
+func (c *evmIndexerServiceClient) ImportEvmEventSignatures(ctx context.Context, req *connect.Request[v1.ImportEvmEventSignaturesRequest]) (*connect.Response[v1.ImportEvmEventSignaturesResponse], error) {
+	return c.importEvmEventSignatures.CallUnary(ctx, req)
+}
+
+// ListEvmEventSignatures calls evm_indexer.v1.EvmIndexerService.ListEvmEventSignatures.
+func (c *evmIndexerServiceClient) ListEvmEventSignatures(ctx context.Context, req *connect.Request[v1.ListEvmEventSignaturesRequest]) (*connect.Response[v1.ListEvmEventSignaturesResponse], error) {
+	return c.listEvmEventSignatures.CallUnary(ctx, req)
+}
+
+// DeleteEvmEventSignature calls evm_indexer.v1.EvmIndexerService.DeleteEvmEventSignature.
+func (c *evmIndexerServiceClient) DeleteEvmEventSignature(ctx context.Context, req *connect.Request[v1.DeleteEvmEventSignatureRequest]) (*connect.Response[v1.DeleteEvmEventSignatureResponse], error) {
+	return c.deleteEvmEventSignature.CallUnary(ctx, req)
+}
+
 // CreateEvmLogStore calls evm_indexer.v1.EvmIndexerService.CreateEvmLogStore.
 func (c *evmIndexerServiceClient) CreateEvmLogStore(ctx context.Context, req *connect.Request[v1.CreateEvmLogStoreRequest]) (*connect.Response[v1.CreateEvmLogStoreResponse], error) {
 	return c.createEvmLogStore.CallUnary(ctx, req)
@@ -976,6 +1166,16 @@ func (c *evmIndexerServiceClient) DeleteEvmLogSource(ctx context.Context, req *c
 	return c.deleteEvmLogSource.CallUnary(ctx, req)
 }
 
+// RedecodeEvmLogSource calls evm_indexer.v1.EvmIndexerService.RedecodeEvmLogSource.
+func (c *evmIndexerServiceClient) RedecodeEvmLogSource(ctx context.Context, req *connect.Request[v1.RedecodeEvmLogSourceRequest]) (*connect.Response[v1.RedecodeEvmLogSourceResponse], error) {
+	return c.redecodeEvmLogSource.CallUnary(ctx, req)
+}
+
+// ResyncEvmLogSource calls evm_indexer.v1.EvmIndexerService.ResyncEvmLogSource.
+func (c *evmIndexerServiceClient) ResyncEvmLogSource(ctx context.Context, req *connect.Request[v1.ResyncEvmLogSourceRequest]) (*connect.Response[v1.ResyncEvmLogSourceResponse], error) {
+	return c.resyncEvmLogSource.CallUnary(ctx, req)
+}
+
 // StreamEvmLogSourceUpdates calls evm_indexer.v1.EvmIndexerService.StreamEvmLogSourceUpdates.
 func (c *evmIndexerServiceClient) StreamEvmLogSourceUpdates(ctx context.Context, req *connect.Request[v1.StreamEvmLogSourceUpdatesRequest]) (*connect.ServerStreamForClient[v1.EvmLogSource], error) {
 	return c.streamEvmLogSourceUpdates.CallServerStream(ctx, req)
@@ -996,6 +1196,16 @@ func (c *evmIndexerServiceClient) ListEvmTransactions(ctx context.Context, req *
 	return c.listEvmTransactions.CallUnary(ctx, req)
 }
 
+// ListEvmBlocks calls evm_indexer.v1.EvmIndexerService.ListEvmBlocks.
+func (c *evmIndexerServiceClient) ListEvmBlocks(ctx context.Context, req *connect.Request[v1.ListEvmBlocksRequest]) (*connect.Response[v1.ListEvmBlocksResponse], error) {
+	return c.listEvmBlocks.CallUnary(ctx, req)
+}
+
+// ListEvmTraces calls evm_indexer.v1.EvmIndexerService.ListEvmTraces.
+func (c *evmIndexerServiceClient) ListEvmTraces(ctx context.Context, req *connect.Request[v1.ListEvmTracesRequest]) (*connect.Response[v1.ListEvmTracesResponse], error) {
+	return c.listEvmTraces.CallUnary(ctx, req)
+}
+
 // Login calls evm_indexer.v1.EvmIndexerService.Login.
 func (c *evmIndexerServiceClient) Login(ctx context.Context, req *connect.Request[v1.LoginRequest]) (*connect.Response[v1.LoginResponse], error) {
 	return c.login.CallUnary(ctx, req)
@@ -1163,6 +1373,16 @@ type EvmIndexerServiceHandler interface {
 	UpdateEvmJsonAbi(context.Context, *connect.Request[v1.UpdateEvmJsonAbiRequest]) (*connect.Response[v1.UpdateEvmJsonAbiResponse], error)
 	ListEvmJsonAbis(context.Context, *connect.Request[v1.ListEvmJsonAbisRequest]) (*connect.Response[v1.ListEvmJsonAbisResponse], error)
 	DeleteEvmJsonAbi(context.Context, *connect.Request[v1.DeleteEvmJsonAbiRequest]) (*connect.Response[v1.DeleteEvmJsonAbiResponse], error)
+	GetEvmJsonAbiSchema(context.Context, *connect.Request[v1.GetEvmJsonAbiSchemaRequest]) (*connect.Response[v1.GetEvmJsonAbiSchemaResponse], error)
+	// Proxies
+	SetEvmImplementationAbi(context.Context, *connect.Request[v1.SetEvmImplementationAbiRequest]) (*connect.Response[v1.SetEvmImplementationAbiResponse], error)
+	ListEvmImplementationAbis(context.Context, *connect.Request[v1.ListEvmImplementationAbisRequest]) (*connect.Response[v1.ListEvmImplementationAbisResponse], error)
+	DeleteEvmImplementationAbi(context.Context, *connect.Request[v1.DeleteEvmImplementationAbiRequest]) (*connect.Response[v1.DeleteEvmImplementationAbiResponse], error)
+	ListEvmProxyImplementations(context.Context, *connect.Request[v1.ListEvmProxyImplementationsRequest]) (*connect.Response[v1.ListEvmProxyImplementationsResponse], error)
+	// Event signatures
+	ImportEvmEventSignatures(context.Context, *connect.Request[v1.ImportEvmEventSignaturesRequest]) (*connect.Response[v1.ImportEvmEventSignaturesResponse], error)
+	ListEvmEventSignatures(context.Context, *connect.Request[v1.ListEvmEventSignaturesRequest]) (*connect.Response[v1.ListEvmEventSignaturesResponse], error)
+	DeleteEvmEventSignature(context.Context, *connect.Request[v1.DeleteEvmEventSignatureRequest]) (*connect.Response[v1.DeleteEvmEventSignatureResponse], error)
 	// EvmLogStore
 	CreateEvmLogStore(context.Context, *connect.Request[v1.CreateEvmLogStoreRequest]) (*connect.Response[v1.CreateEvmLogStoreResponse], error)
 	GetEvmLogStore(context.Context, *connect.Request[v1.GetEvmLogStoreRequest]) (*connect.Response[v1.GetEvmLogStoreResponse], error)
@@ -1183,6 +1403,12 @@ type EvmIndexerServiceHandler interface {
 	UpdateEvmLogSource(context.Context, *connect.Request[v1.UpdateEvmLogSourceRequest]) (*connect.Response[v1.UpdateEvmLogSourceResponse], error)
 	ListEvmLogSources(context.Context, *connect.Request[v1.ListEvmLogSourcesRequest]) (*connect.Response[v1.ListEvmLogSourcesResponse], error)
 	DeleteEvmLogSource(context.Context, *connect.Request[v1.DeleteEvmLogSourceRequest]) (*connect.Response[v1.DeleteEvmLogSourceResponse], error)
+	// Starts re-decoding the source's stored logs with its current ABIs; the
+	// progress is streamed as source updates.
+	RedecodeEvmLogSource(context.Context, *connect.Request[v1.RedecodeEvmLogSourceRequest]) (*connect.Response[v1.RedecodeEvmLogSourceResponse], error)
+	// Stops the source, deletes its stored data from from_block, rewinds its
+	// cursor and its pipeline's exporters, then starts it again if enabled.
+	ResyncEvmLogSource(context.Context, *connect.Request[v1.ResyncEvmLogSourceRequest]) (*connect.Response[v1.ResyncEvmLogSourceResponse], error)
 	// Server stream of live source updates (indexing progress, status changes).
 	StreamEvmLogSourceUpdates(context.Context, *connect.Request[v1.StreamEvmLogSourceUpdatesRequest], *connect.ServerStream[v1.EvmLogSource]) error
 	// EvmLog
@@ -1190,6 +1416,8 @@ type EvmIndexerServiceHandler interface {
 	ListLatestEvmLogs(context.Context, *connect.Request[v1.ListLatestEvmLogsRequest]) (*connect.Response[v1.ListLatestEvmLogsResponse], error)
 	// EvmTransaction
 	ListEvmTransactions(context.Context, *connect.Request[v1.ListEvmTransactionsRequest]) (*connect.Response[v1.ListEvmTransactionsResponse], error)
+	ListEvmBlocks(context.Context, *connect.Request[v1.ListEvmBlocksRequest]) (*connect.Response[v1.ListEvmBlocksResponse], error)
+	ListEvmTraces(context.Context, *connect.Request[v1.ListEvmTracesRequest]) (*connect.Response[v1.ListEvmTracesResponse], error)
 	// Auth (Login and GetOAuthLoginUrl are public; all others require a token)
 	Login(context.Context, *connect.Request[v1.LoginRequest]) (*connect.Response[v1.LoginResponse], error)
 	ListOAuthLoginUrls(context.Context, *connect.Request[v1.ListOAuthLoginUrlsRequest]) (*connect.Response[v1.ListOAuthLoginUrlsResponse], error)
@@ -1307,6 +1535,54 @@ func NewEvmIndexerServiceHandler(svc EvmIndexerServiceHandler, opts ...connect.H
 		connect.WithSchema(evmIndexerServiceDeleteEvmJsonAbiMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	evmIndexerServiceGetEvmJsonAbiSchemaHandler := connect.NewUnaryHandler(
+		EvmIndexerServiceGetEvmJsonAbiSchemaProcedure,
+		svc.GetEvmJsonAbiSchema,
+		connect.WithSchema(evmIndexerServiceGetEvmJsonAbiSchemaMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	evmIndexerServiceSetEvmImplementationAbiHandler := connect.NewUnaryHandler(
+		EvmIndexerServiceSetEvmImplementationAbiProcedure,
+		svc.SetEvmImplementationAbi,
+		connect.WithSchema(evmIndexerServiceSetEvmImplementationAbiMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	evmIndexerServiceListEvmImplementationAbisHandler := connect.NewUnaryHandler(
+		EvmIndexerServiceListEvmImplementationAbisProcedure,
+		svc.ListEvmImplementationAbis,
+		connect.WithSchema(evmIndexerServiceListEvmImplementationAbisMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	evmIndexerServiceDeleteEvmImplementationAbiHandler := connect.NewUnaryHandler(
+		EvmIndexerServiceDeleteEvmImplementationAbiProcedure,
+		svc.DeleteEvmImplementationAbi,
+		connect.WithSchema(evmIndexerServiceDeleteEvmImplementationAbiMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	evmIndexerServiceListEvmProxyImplementationsHandler := connect.NewUnaryHandler(
+		EvmIndexerServiceListEvmProxyImplementationsProcedure,
+		svc.ListEvmProxyImplementations,
+		connect.WithSchema(evmIndexerServiceListEvmProxyImplementationsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	evmIndexerServiceImportEvmEventSignaturesHandler := connect.NewUnaryHandler(
+		EvmIndexerServiceImportEvmEventSignaturesProcedure,
+		svc.ImportEvmEventSignatures,
+		connect.WithSchema(evmIndexerServiceImportEvmEventSignaturesMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	evmIndexerServiceListEvmEventSignaturesHandler := connect.NewUnaryHandler(
+		EvmIndexerServiceListEvmEventSignaturesProcedure,
+		svc.ListEvmEventSignatures,
+		connect.WithSchema(evmIndexerServiceListEvmEventSignaturesMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	evmIndexerServiceDeleteEvmEventSignatureHandler := connect.NewUnaryHandler(
+		EvmIndexerServiceDeleteEvmEventSignatureProcedure,
+		svc.DeleteEvmEventSignature,
+		connect.WithSchema(evmIndexerServiceDeleteEvmEventSignatureMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	evmIndexerServiceCreateEvmLogStoreHandler := connect.NewUnaryHandler(
 		EvmIndexerServiceCreateEvmLogStoreProcedure,
 		svc.CreateEvmLogStore,
@@ -1409,6 +1685,18 @@ func NewEvmIndexerServiceHandler(svc EvmIndexerServiceHandler, opts ...connect.H
 		connect.WithSchema(evmIndexerServiceDeleteEvmLogSourceMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	evmIndexerServiceRedecodeEvmLogSourceHandler := connect.NewUnaryHandler(
+		EvmIndexerServiceRedecodeEvmLogSourceProcedure,
+		svc.RedecodeEvmLogSource,
+		connect.WithSchema(evmIndexerServiceRedecodeEvmLogSourceMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	evmIndexerServiceResyncEvmLogSourceHandler := connect.NewUnaryHandler(
+		EvmIndexerServiceResyncEvmLogSourceProcedure,
+		svc.ResyncEvmLogSource,
+		connect.WithSchema(evmIndexerServiceResyncEvmLogSourceMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	evmIndexerServiceStreamEvmLogSourceUpdatesHandler := connect.NewServerStreamHandler(
 		EvmIndexerServiceStreamEvmLogSourceUpdatesProcedure,
 		svc.StreamEvmLogSourceUpdates,
@@ -1433,6 +1721,18 @@ func NewEvmIndexerServiceHandler(svc EvmIndexerServiceHandler, opts ...connect.H
 		connect.WithSchema(evmIndexerServiceListEvmTransactionsMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	evmIndexerServiceListEvmBlocksHandler := connect.NewUnaryHandler(
+		EvmIndexerServiceListEvmBlocksProcedure,
+		svc.ListEvmBlocks,
+		connect.WithSchema(evmIndexerServiceListEvmBlocksMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	evmIndexerServiceListEvmTracesHandler := connect.NewUnaryHandler(
+		EvmIndexerServiceListEvmTracesProcedure,
+		svc.ListEvmTraces,
+		connect.WithSchema(evmIndexerServiceListEvmTracesMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	evmIndexerServiceLoginHandler := connect.NewUnaryHandler(
 		EvmIndexerServiceLoginProcedure,
 		svc.Login,
@@ -1639,6 +1939,22 @@ func NewEvmIndexerServiceHandler(svc EvmIndexerServiceHandler, opts ...connect.H
 			evmIndexerServiceListEvmJsonAbisHandler.ServeHTTP(w, r)
 		case EvmIndexerServiceDeleteEvmJsonAbiProcedure:
 			evmIndexerServiceDeleteEvmJsonAbiHandler.ServeHTTP(w, r)
+		case EvmIndexerServiceGetEvmJsonAbiSchemaProcedure:
+			evmIndexerServiceGetEvmJsonAbiSchemaHandler.ServeHTTP(w, r)
+		case EvmIndexerServiceSetEvmImplementationAbiProcedure:
+			evmIndexerServiceSetEvmImplementationAbiHandler.ServeHTTP(w, r)
+		case EvmIndexerServiceListEvmImplementationAbisProcedure:
+			evmIndexerServiceListEvmImplementationAbisHandler.ServeHTTP(w, r)
+		case EvmIndexerServiceDeleteEvmImplementationAbiProcedure:
+			evmIndexerServiceDeleteEvmImplementationAbiHandler.ServeHTTP(w, r)
+		case EvmIndexerServiceListEvmProxyImplementationsProcedure:
+			evmIndexerServiceListEvmProxyImplementationsHandler.ServeHTTP(w, r)
+		case EvmIndexerServiceImportEvmEventSignaturesProcedure:
+			evmIndexerServiceImportEvmEventSignaturesHandler.ServeHTTP(w, r)
+		case EvmIndexerServiceListEvmEventSignaturesProcedure:
+			evmIndexerServiceListEvmEventSignaturesHandler.ServeHTTP(w, r)
+		case EvmIndexerServiceDeleteEvmEventSignatureProcedure:
+			evmIndexerServiceDeleteEvmEventSignatureHandler.ServeHTTP(w, r)
 		case EvmIndexerServiceCreateEvmLogStoreProcedure:
 			evmIndexerServiceCreateEvmLogStoreHandler.ServeHTTP(w, r)
 		case EvmIndexerServiceGetEvmLogStoreProcedure:
@@ -1673,6 +1989,10 @@ func NewEvmIndexerServiceHandler(svc EvmIndexerServiceHandler, opts ...connect.H
 			evmIndexerServiceListEvmLogSourcesHandler.ServeHTTP(w, r)
 		case EvmIndexerServiceDeleteEvmLogSourceProcedure:
 			evmIndexerServiceDeleteEvmLogSourceHandler.ServeHTTP(w, r)
+		case EvmIndexerServiceRedecodeEvmLogSourceProcedure:
+			evmIndexerServiceRedecodeEvmLogSourceHandler.ServeHTTP(w, r)
+		case EvmIndexerServiceResyncEvmLogSourceProcedure:
+			evmIndexerServiceResyncEvmLogSourceHandler.ServeHTTP(w, r)
 		case EvmIndexerServiceStreamEvmLogSourceUpdatesProcedure:
 			evmIndexerServiceStreamEvmLogSourceUpdatesHandler.ServeHTTP(w, r)
 		case EvmIndexerServiceListEvmLogsProcedure:
@@ -1681,6 +2001,10 @@ func NewEvmIndexerServiceHandler(svc EvmIndexerServiceHandler, opts ...connect.H
 			evmIndexerServiceListLatestEvmLogsHandler.ServeHTTP(w, r)
 		case EvmIndexerServiceListEvmTransactionsProcedure:
 			evmIndexerServiceListEvmTransactionsHandler.ServeHTTP(w, r)
+		case EvmIndexerServiceListEvmBlocksProcedure:
+			evmIndexerServiceListEvmBlocksHandler.ServeHTTP(w, r)
+		case EvmIndexerServiceListEvmTracesProcedure:
+			evmIndexerServiceListEvmTracesHandler.ServeHTTP(w, r)
 		case EvmIndexerServiceLoginProcedure:
 			evmIndexerServiceLoginHandler.ServeHTTP(w, r)
 		case EvmIndexerServiceListOAuthLoginUrlsProcedure:
@@ -1798,6 +2122,38 @@ func (UnimplementedEvmIndexerServiceHandler) DeleteEvmJsonAbi(context.Context, *
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("evm_indexer.v1.EvmIndexerService.DeleteEvmJsonAbi is not implemented"))
 }
 
+func (UnimplementedEvmIndexerServiceHandler) GetEvmJsonAbiSchema(context.Context, *connect.Request[v1.GetEvmJsonAbiSchemaRequest]) (*connect.Response[v1.GetEvmJsonAbiSchemaResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("evm_indexer.v1.EvmIndexerService.GetEvmJsonAbiSchema is not implemented"))
+}
+
+func (UnimplementedEvmIndexerServiceHandler) SetEvmImplementationAbi(context.Context, *connect.Request[v1.SetEvmImplementationAbiRequest]) (*connect.Response[v1.SetEvmImplementationAbiResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("evm_indexer.v1.EvmIndexerService.SetEvmImplementationAbi is not implemented"))
+}
+
+func (UnimplementedEvmIndexerServiceHandler) ListEvmImplementationAbis(context.Context, *connect.Request[v1.ListEvmImplementationAbisRequest]) (*connect.Response[v1.ListEvmImplementationAbisResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("evm_indexer.v1.EvmIndexerService.ListEvmImplementationAbis is not implemented"))
+}
+
+func (UnimplementedEvmIndexerServiceHandler) DeleteEvmImplementationAbi(context.Context, *connect.Request[v1.DeleteEvmImplementationAbiRequest]) (*connect.Response[v1.DeleteEvmImplementationAbiResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("evm_indexer.v1.EvmIndexerService.DeleteEvmImplementationAbi is not implemented"))
+}
+
+func (UnimplementedEvmIndexerServiceHandler) ListEvmProxyImplementations(context.Context, *connect.Request[v1.ListEvmProxyImplementationsRequest]) (*connect.Response[v1.ListEvmProxyImplementationsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("evm_indexer.v1.EvmIndexerService.ListEvmProxyImplementations is not implemented"))
+}
+
+func (UnimplementedEvmIndexerServiceHandler) ImportEvmEventSignatures(context.Context, *connect.Request[v1.ImportEvmEventSignaturesRequest]) (*connect.Response[v1.ImportEvmEventSignaturesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("evm_indexer.v1.EvmIndexerService.ImportEvmEventSignatures is not implemented"))
+}
+
+func (UnimplementedEvmIndexerServiceHandler) ListEvmEventSignatures(context.Context, *connect.Request[v1.ListEvmEventSignaturesRequest]) (*connect.Response[v1.ListEvmEventSignaturesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("evm_indexer.v1.EvmIndexerService.ListEvmEventSignatures is not implemented"))
+}
+
+func (UnimplementedEvmIndexerServiceHandler) DeleteEvmEventSignature(context.Context, *connect.Request[v1.DeleteEvmEventSignatureRequest]) (*connect.Response[v1.DeleteEvmEventSignatureResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("evm_indexer.v1.EvmIndexerService.DeleteEvmEventSignature is not implemented"))
+}
+
 func (UnimplementedEvmIndexerServiceHandler) CreateEvmLogStore(context.Context, *connect.Request[v1.CreateEvmLogStoreRequest]) (*connect.Response[v1.CreateEvmLogStoreResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("evm_indexer.v1.EvmIndexerService.CreateEvmLogStore is not implemented"))
 }
@@ -1866,6 +2222,14 @@ func (UnimplementedEvmIndexerServiceHandler) DeleteEvmLogSource(context.Context,
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("evm_indexer.v1.EvmIndexerService.DeleteEvmLogSource is not implemented"))
 }
 
+func (UnimplementedEvmIndexerServiceHandler) RedecodeEvmLogSource(context.Context, *connect.Request[v1.RedecodeEvmLogSourceRequest]) (*connect.Response[v1.RedecodeEvmLogSourceResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("evm_indexer.v1.EvmIndexerService.RedecodeEvmLogSource is not implemented"))
+}
+
+func (UnimplementedEvmIndexerServiceHandler) ResyncEvmLogSource(context.Context, *connect.Request[v1.ResyncEvmLogSourceRequest]) (*connect.Response[v1.ResyncEvmLogSourceResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("evm_indexer.v1.EvmIndexerService.ResyncEvmLogSource is not implemented"))
+}
+
 func (UnimplementedEvmIndexerServiceHandler) StreamEvmLogSourceUpdates(context.Context, *connect.Request[v1.StreamEvmLogSourceUpdatesRequest], *connect.ServerStream[v1.EvmLogSource]) error {
 	return connect.NewError(connect.CodeUnimplemented, errors.New("evm_indexer.v1.EvmIndexerService.StreamEvmLogSourceUpdates is not implemented"))
 }
@@ -1882,6 +2246,14 @@ func (UnimplementedEvmIndexerServiceHandler) ListEvmTransactions(context.Context
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("evm_indexer.v1.EvmIndexerService.ListEvmTransactions is not implemented"))
 }
 
+func (UnimplementedEvmIndexerServiceHandler) ListEvmBlocks(context.Context, *connect.Request[v1.ListEvmBlocksRequest]) (*connect.Response[v1.ListEvmBlocksResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("evm_indexer.v1.EvmIndexerService.ListEvmBlocks is not implemented"))
+}
+
+func (UnimplementedEvmIndexerServiceHandler) ListEvmTraces(context.Context, *connect.Request[v1.ListEvmTracesRequest]) (*connect.Response[v1.ListEvmTracesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("evm_indexer.v1.EvmIndexerService.ListEvmTraces is not implemented"))
+}
+
 func (UnimplementedEvmIndexerServiceHandler) Login(context.Context, *connect.Request[v1.LoginRequest]) (*connect.Response[v1.LoginResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("evm_indexer.v1.EvmIndexerService.Login is not implemented"))
 }
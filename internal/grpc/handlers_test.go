@@ -7,8 +7,10 @@ import (
 	"testing"
 
 	"connectrpc.com/connect"
+	internal_bus "github.com/evmi-cloud/go-evm-indexer/internal/bus"
 	evmi_database "github.com/evmi-cloud/go-evm-indexer/internal/database/evmi-database"
 	evm_indexerv1 "github.com/evmi-cloud/go-evm-indexer/internal/grpc/generated/evm_indexer/v1"
+	"github.com/mustafaturan/bus/v3"
 	"github.com/rs/zerolog"
 	"gorm.io/driver/sqlite"
 	"gorm.io/gorm"
@@ -20,7 +22,9 @@ func newServerForTest(t *testing.T) *EvmIndexerServer {
 	if err != nil {
 		t.Fatalf("open sqlite: %v", err)
 	}
-	if err := db.AutoMigrate(&evmi_database.EvmBlockchain{}); err != nil {
+	if err := db.AutoMigrate(&evmi_database.EvmBlockchain{}, &evmi_database.EvmRpcEndpoint{}, &evmi_database.EvmJsonAbi{},
+		&evmi_database.EvmImplementationAbi{}, &evmi_database.EvmLogSource{}, &evmi_database.EvmProxyImplementation{},
+		&evmi_database.EvmEventSignature{}, &evmi_database.EvmLogSourceRedecode{}); err != nil {
 		t.Fatalf("migrate: %v", err)
 	}
 	return &EvmIndexerServer{db: &evmi_database.EvmiDatabase{Conn: db}, logger: zerolog.Nop()}
@@ -60,6 +64,32 @@ func TestListEvmBlockchainsPagination(t *testing.T) {
 	}
 }
 
+func TestEvmBlockchainHeadMode(t *testing.T) {
+	e := newServerForTest(t)
+	ctx := context.Background()
+
+	res, err := e.CreateEvmBlockchain(ctx, connect.NewRequest(&evm_indexerv1.CreateEvmBlockchainRequest{
+		Blockchain: &evm_indexerv1.EvmBlockchain{Name: "eth", ChainId: 1, HeadMode: "confirmations", HeadConfirmations: 12},
+	}))
+	if err != nil {
+		t.Fatalf("create: %v", err)
+	}
+	got, err := e.GetEvmBlockchain(ctx, connect.NewRequest(&evm_indexerv1.GetEvmBlockchainRequest{Id: res.Msg.Id}))
+	if err != nil {
+		t.Fatalf("get: %v", err)
+	}
+	if got.Msg.Blockchain.HeadMode != "CONFIRMATIONS" || got.Msg.Blockchain.HeadConfirmations != 12 {
+		t.Errorf("head mode = %q/%d, want CONFIRMATIONS/12", got.Msg.Blockchain.HeadMode, got.Msg.Blockchain.HeadConfirmations)
+	}
+
+	_, err = e.UpdateEvmBlockchain(ctx, connect.NewRequest(&evm_indexerv1.UpdateEvmBlockchainRequest{
+		Blockchain: &evm_indexerv1.EvmBlockchain{Id: &res.Msg.Id, Name: "eth", ChainId: 1, HeadMode: "eventually"},
+	}))
+	if connect.CodeOf(err) != connect.CodeInvalidArgument {
+		t.Fatalf("update with unknown head mode: code = %v, want InvalidArgument", connect.CodeOf(err))
+	}
+}
+
 func TestGetEvmBlockchainNotFound(t *testing.T) {
 	e := newServerForTest(t)
 
@@ -71,3 +101,343 @@ func TestGetEvmBlockchainNotFound(t *testing.T) {
 		t.Errorf("code = %v, want NotFound", connect.CodeOf(err))
 	}
 }
+
+func TestEvmBlockchainRpcEndpoints(t *testing.T) {
+	e := newServerForTest(t)
+	ctx := context.Background()
+
+	res, err := e.CreateEvmBlockchain(ctx, connect.NewRequest(&evm_indexerv1.CreateEvmBlockchainRequest{
+		Blockchain: &evm_indexerv1.EvmBlockchain{Name: "eth", ChainId: 1, RpcEndpoints: []*evm_indexerv1.EvmRpcEndpoint{
+			{Url: "https://a.example", Weight: 3},
+			{Url: "https://b.example"},
+		}},
+	}))
+	if err != nil {
+		t.Fatalf("create: %v", err)
+	}
+
+	// Health reported by an indexer survives an update keeping the endpoint.
+	if err := e.db.Conn.Model(&evmi_database.EvmRpcEndpoint{}).Where("url = ?", "https://a.example").
+		Updates(map[string]interface{}{"healthy": true, "head_block": 100}).Error; err != nil {
+		t.Fatal(err)
+	}
+	_, err = e.UpdateEvmBlockchain(ctx, connect.NewRequest(&evm_indexerv1.UpdateEvmBlockchainRequest{
+		Blockchain: &evm_indexerv1.EvmBlockchain{Id: &res.Msg.Id, Name: "eth", ChainId: 1, RpcEndpoints: []*evm_indexerv1.EvmRpcEndpoint{
+			{Url: "https://a.example", Weight: 5},
+			{Url: "https://c.example", Weight: 1},
+		}},
+	}))
+	if err != nil {
+		t.Fatalf("update: %v", err)
+	}
+
+	got, err := e.GetEvmBlockchain(ctx, connect.NewRequest(&evm_indexerv1.GetEvmBlockchainRequest{Id: res.Msg.Id}))
+	if err != nil {
+		t.Fatalf("get: %v", err)
+	}
+	b := got.Msg.Blockchain
+	if b.RpcUrl != "https://a.example" {
+		t.Errorf("rpc url = %q, want the first endpoint", b.RpcUrl)
+	}
+	if len(b.RpcEndpoints) != 2 {
+		t.Fatalf("got %d endpoints, want 2", len(b.RpcEndpoints))
+	}
+	a, c := b.RpcEndpoints[0], b.RpcEndpoints[1]
+	if a.Url != "https://a.example" || a.Weight != 5 || !a.Healthy || a.HeadBlock != 100 {
+		t.Errorf("kept endpoint = %+v", a)
+	}
+	if c.Url != "https://c.example" || c.Healthy {
+		t.Errorf("added endpoint = %+v", c)
+	}
+
+	_, err = e.UpdateEvmBlockchain(ctx, connect.NewRequest(&evm_indexerv1.UpdateEvmBlockchainRequest{
+		Blockchain: &evm_indexerv1.EvmBlockchain{Id: &res.Msg.Id, Name: "eth", ChainId: 1, RpcEndpoints: []*evm_indexerv1.EvmRpcEndpoint{
+			{Url: "https://a.example"}, {Url: "https://a.example"},
+		}},
+	}))
+	if connect.CodeOf(err) != connect.CodeInvalidArgument {
+		t.Fatalf("update with duplicate endpoints: code = %v, want InvalidArgument", connect.CodeOf(err))
+	}
+}
+
+func TestEvmBlockchainRpcRateLimit(t *testing.T) {
+	e := newServerForTest(t)
+	ctx := context.Background()
+
+	res, err := e.CreateEvmBlockchain(ctx, connect.NewRequest(&evm_indexerv1.CreateEvmBlockchainRequest{
+		Blockchain: &evm_indexerv1.EvmBlockchain{Name: "eth", ChainId: 1, RpcRateLimit: 25,
+			RpcMethodCosts: map[string]uint64{"eth_getLogs": 75}},
+	}))
+	if err != nil {
+		t.Fatalf("create: %v", err)
+	}
+	got, err := e.GetEvmBlockchain(ctx, connect.NewRequest(&evm_indexerv1.GetEvmBlockchainRequest{Id: res.Msg.Id}))
+	if err != nil {
+		t.Fatalf("get: %v", err)
+	}
+	if b := got.Msg.Blockchain; b.RpcRateLimit != 25 || len(b.RpcMethodCosts) != 1 || b.RpcMethodCosts["eth_getLogs"] != 75 {
+		t.Errorf("rate limit = %v, costs = %v", b.RpcRateLimit, b.RpcMethodCosts)
+	}
+
+	_, err = e.UpdateEvmBlockchain(ctx, connect.NewRequest(&evm_indexerv1.UpdateEvmBlockchainRequest{
+		Blockchain: &evm_indexerv1.EvmBlockchain{Id: &res.Msg.Id, Name: "eth", ChainId: 1, RpcRateLimit: -1},
+	}))
+	if connect.CodeOf(err) != connect.CodeInvalidArgument {
+		t.Fatalf("update with a negative rate limit: code = %v, want InvalidArgument", connect.CodeOf(err))
+	}
+}
+
+func TestGetEvmJsonAbiSchema(t *testing.T) {
+	e := newServerForTest(t)
+	ctx := context.Background()
+
+	content := `[{
+		"anonymous": false, "name": "Swap", "type": "event",
+		"inputs": [
+			{"indexed": true, "name": "sender", "type": "address"},
+			{"indexed": false, "name": "key", "type": "tuple", "components": [
+				{"name": "currency0", "type": "address"},
+				{"name": "fee", "type": "uint24"}
+			]},
+			{"indexed": false, "name": "", "type": "int256[]"}
+		]
+	}, {
+		"name": "transfer", "type": "function", "stateMutability": "nonpayable", "outputs": [],
+		"inputs": [{"name": "to", "type": "address"}, {"name": "value", "type": "uint256"}]
+	}]`
+	created, err := e.CreateEvmJsonAbi(ctx, connect.NewRequest(&evm_indexerv1.CreateEvmJsonAbiRequest{
+		Abi: &evm_indexerv1.EvmJsonAbi{ContractName: "Pool", Content: content},
+	}))
+	if err != nil {
+		t.Fatalf("create: %v", err)
+	}
+
+	res, err := e.GetEvmJsonAbiSchema(ctx, connect.NewRequest(&evm_indexerv1.GetEvmJsonAbiSchemaRequest{Id: created.Msg.Id}))
+	if err != nil {
+		t.Fatalf("schema: %v", err)
+	}
+	if len(res.Msg.Events) != 1 || len(res.Msg.Functions) != 1 {
+		t.Fatalf("schema = %v, want one event and one function", res.Msg)
+	}
+	swap := res.Msg.Events[0]
+	if swap.Signature != "Swap(address,(address,uint24),int256[])" || len(swap.Args) != 3 {
+		t.Fatalf("event = %v", swap)
+	}
+	if !swap.Args[0].Indexed || swap.Args[0].Type != "address" {
+		t.Errorf("sender = %v, want an indexed address", swap.Args[0])
+	}
+	if key := swap.Args[1]; key.Type != "(address,uint24)" || len(key.Components) != 2 || key.Components[1].Name != "fee" || key.Components[1].Type != "uint24" {
+		t.Errorf("key = %v", key)
+	}
+	if swap.Args[2].Name != "arg2" {
+		t.Errorf("unnamed argument = %q, want arg2", swap.Args[2].Name)
+	}
+	if transfer := res.Msg.Functions[0]; transfer.Selector != "0xa9059cbb" || transfer.Args[1].Type != "uint256" {
+		t.Errorf("function = %v", transfer)
+	}
+
+	_, err = e.GetEvmJsonAbiSchema(ctx, connect.NewRequest(&evm_indexerv1.GetEvmJsonAbiSchemaRequest{Id: 999}))
+	if connect.CodeOf(err) != connect.CodeNotFound {
+		t.Errorf("missing abi: code = %v, want NotFound", connect.CodeOf(err))
+	}
+}
+
+func TestEvmImplementationAbis(t *testing.T) {
+	e := newServerForTest(t)
+	ctx := context.Background()
+
+	chain := evmi_database.EvmBlockchain{Name: "chain", ChainId: 1}
+	e.db.Conn.Create(&chain)
+	v1 := evmi_database.EvmJsonAbi{ContractName: "TokenV1", Content: "[]"}
+	e.db.Conn.Create(&v1)
+	v2 := evmi_database.EvmJsonAbi{ContractName: "TokenV2", Content: "[]"}
+	e.db.Conn.Create(&v2)
+
+	set := func(address string, abiID uint) (*connect.Response[evm_indexerv1.SetEvmImplementationAbiResponse], error) {
+		return e.SetEvmImplementationAbi(ctx, connect.NewRequest(&evm_indexerv1.SetEvmImplementationAbiRequest{
+			ImplementationAbi: &evm_indexerv1.EvmImplementationAbi{EvmBlockchainId: uint32(chain.ID), Address: address, EvmJsonAbiId: uint32(abiID)},
+		}))
+	}
+
+	// Addresses are stored checksummed, and setting one again replaces its ABI.
+	created, err := set("0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed", v1.ID)
+	if err != nil {
+		t.Fatalf("set: %v", err)
+	}
+	updated, err := set("0x5AAEB6053F3E94C9B9A09F33669435E7EF1BEAED", v2.ID)
+	if err != nil {
+		t.Fatalf("set again: %v", err)
+	}
+	if updated.Msg.Id != created.Msg.Id {
+		t.Errorf("set again created mapping %d, want %d updated", updated.Msg.Id, created.Msg.Id)
+	}
+
+	if _, err := set("not an address", v1.ID); connect.CodeOf(err) != connect.CodeInvalidArgument {
+		t.Errorf("invalid address: code = %v, want InvalidArgument", connect.CodeOf(err))
+	}
+	if _, err := set("0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed", 999); connect.CodeOf(err) != connect.CodeNotFound {
+		t.Errorf("missing abi: code = %v, want NotFound", connect.CodeOf(err))
+	}
+
+	list, err := e.ListEvmImplementationAbis(ctx, connect.NewRequest(&evm_indexerv1.ListEvmImplementationAbisRequest{EvmBlockchainId: uint32(chain.ID)}))
+	if err != nil {
+		t.Fatalf("list: %v", err)
+	}
+	if len(list.Msg.ImplementationAbis) != 1 {
+		t.Fatalf("got %d mappings, want 1", len(list.Msg.ImplementationAbis))
+	}
+	if m := list.Msg.ImplementationAbis[0]; m.Address != "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed" || m.EvmJsonAbiId != uint32(v2.ID) {
+		t.Errorf("mapping = %v", m)
+	}
+
+	// The proxy history reports the ABI mapped to each implementation.
+	source := evmi_database.EvmLogSource{Type: "CONTRACT", EvmBlockchainID: chain.ID}
+	e.db.Conn.Create(&source)
+	e.db.Conn.Create(&evmi_database.EvmProxyImplementation{EvmLogSourceID: source.ID, ProxyType: "EIP1967", Implementation: "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed", FromBlock: 10})
+	e.db.Conn.Create(&evmi_database.EvmProxyImplementation{EvmLogSourceID: source.ID, ProxyType: "EIP1967", Implementation: "0x00000000000000000000000000000000000000Aa"})
+	history, err := e.ListEvmProxyImplementations(ctx, connect.NewRequest(&evm_indexerv1.ListEvmProxyImplementationsRequest{SourceId: uint32(source.ID)}))
+	if err != nil {
+		t.Fatalf("history: %v", err)
+	}
+	if len(history.Msg.Implementations) != 2 {
+		t.Fatalf("got %d history entries, want 2", len(history.Msg.Implementations))
+	}
+	if first := history.Msg.Implementations[0]; first.FromBlock != 0 || first.EvmJsonAbiId != nil {
+		t.Errorf("first entry = %v, want block 0 without abi", first)
+	}
+	if second := history.Msg.Implementations[1]; second.EvmJsonAbiId == nil || *second.EvmJsonAbiId != uint32(v2.ID) {
+		t.Errorf("second entry = %v, want abi %d", second, v2.ID)
+	}
+
+	if _, err := e.DeleteEvmImplementationAbi(ctx, connect.NewRequest(&evm_indexerv1.DeleteEvmImplementationAbiRequest{Id: created.Msg.Id})); err != nil {
+		t.Fatalf("delete: %v", err)
+	}
+	list, _ = e.ListEvmImplementationAbis(ctx, connect.NewRequest(&evm_indexerv1.ListEvmImplementationAbisRequest{}))
+	if len(list.Msg.ImplementationAbis) != 0 {
+		t.Errorf("got %d mappings after delete, want 0", len(list.Msg.ImplementationAbis))
+	}
+}
+
+func TestEvmEventSignatures(t *testing.T) {
+	e := newServerForTest(t)
+	ctx := context.Background()
+
+	e.db.Conn.Create(&evmi_database.EvmJsonAbi{ContractName: "Token", Content: `[{"type":"event","name":"Transfer","inputs":[
+		{"name":"from","type":"address","indexed":true},{"name":"to","type":"address","indexed":true},{"name":"value","type":"uint256"}]}]`})
+
+	// ERC-721 Transfer shares the ERC-20 topic0 with a third indexed argument.
+	imported, err := e.ImportEvmEventSignatures(ctx, connect.NewRequest(&evm_indexerv1.ImportEvmEventSignaturesRequest{Content: `
+# NFTs
+event Transfer(address indexed from, address indexed to, uint256 indexed tokenId)
+Swap(address indexed sender, (uint amount, address token)[] legs)
+`}))
+	if err != nil {
+		t.Fatalf("import: %v", err)
+	}
+	if imported.Msg.Imported != 2 || imported.Msg.Skipped != 0 {
+		t.Errorf("import = %v, want 2 imported", imported.Msg)
+	}
+	again, err := e.ImportEvmEventSignatures(ctx, connect.NewRequest(&evm_indexerv1.ImportEvmEventSignaturesRequest{
+		Content: "Transfer(address indexed from, address indexed to, uint256 indexed tokenId)",
+	}))
+	if err != nil {
+		t.Fatalf("import again: %v", err)
+	}
+	if again.Msg.Imported != 0 || again.Msg.Skipped != 1 {
+		t.Errorf("import again = %v, want 1 skipped", again.Msg)
+	}
+	if _, err := e.ImportEvmEventSignatures(ctx, connect.NewRequest(&evm_indexerv1.ImportEvmEventSignaturesRequest{Content: "Transfer(address"})); connect.CodeOf(err) != connect.CodeInvalidArgument {
+		t.Errorf("invalid signature: code = %v, want InvalidArgument", connect.CodeOf(err))
+	}
+
+	transferTopic0 := "0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef"
+	list, err := e.ListEvmEventSignatures(ctx, connect.NewRequest(&evm_indexerv1.ListEvmEventSignaturesRequest{Topic0: transferTopic0}))
+	if err != nil {
+		t.Fatalf("list: %v", err)
+	}
+	if len(list.Msg.Signatures) != 2 {
+		t.Fatalf("got %d Transfer signatures, want 2", len(list.Msg.Signatures))
+	}
+	fromAbi, nft := list.Msg.Signatures[0], list.Msg.Signatures[1]
+	if fromAbi.EvmJsonAbiId == nil || fromAbi.Id != nil || fromAbi.IndexedCount != 2 ||
+		fromAbi.Signature != "Transfer(address indexed from, address indexed to, uint256 value)" {
+		t.Errorf("abi signature = %v", fromAbi)
+	}
+	if nft.Id == nil || nft.IndexedCount != 3 || nft.Topic0 != transferTopic0 {
+		t.Errorf("imported signature = %v", nft)
+	}
+
+	all, _ := e.ListEvmEventSignatures(ctx, connect.NewRequest(&evm_indexerv1.ListEvmEventSignaturesRequest{}))
+	if len(all.Msg.Signatures) != 3 {
+		t.Fatalf("got %d signatures, want 3", len(all.Msg.Signatures))
+	}
+	if swap := all.Msg.Signatures[2]; swap.Signature != "Swap(address indexed sender, (uint256 amount, address token)[] legs)" {
+		t.Errorf("tuple signature = %q", swap.Signature)
+	}
+
+	if _, err := e.DeleteEvmEventSignature(ctx, connect.NewRequest(&evm_indexerv1.DeleteEvmEventSignatureRequest{Id: *nft.Id})); err != nil {
+		t.Fatalf("delete: %v", err)
+	}
+	all, _ = e.ListEvmEventSignatures(ctx, connect.NewRequest(&evm_indexerv1.ListEvmEventSignaturesRequest{}))
+	if len(all.Msg.Signatures) != 2 {
+		t.Errorf("got %d signatures after delete, want 2", len(all.Msg.Signatures))
+	}
+}
+
+func TestRedecodeEvmLogSource(t *testing.T) {
+	e := newServerForTest(t)
+	e.bus = internal_bus.InitializeBus()
+	ctx := context.Background()
+
+	source := evmi_database.EvmLogSource{Type: "CONTRACT", StartBlock: 100, SyncBlock: 500}
+	if err := e.db.Conn.Create(&source).Error; err != nil {
+		t.Fatal(err)
+	}
+	var started []uint
+	e.bus.RegisterHandler("redecode-test", bus.Handler{
+		Matcher: internal_bus.RedecodeSourceTopic,
+		Handle: func(_ context.Context, event bus.Event) {
+			started = append(started, event.Data.(uint))
+		},
+	})
+
+	req := connect.NewRequest(&evm_indexerv1.RedecodeEvmLogSourceRequest{Id: uint32(source.ID)})
+	if _, err := e.RedecodeEvmLogSource(ctx, req); err != nil {
+		t.Fatalf("redecode: %v", err)
+	}
+	if len(started) != 1 || started[0] != source.ID {
+		t.Errorf("redecode events = %v", started)
+	}
+
+	got, err := e.GetEvmLogSource(ctx, connect.NewRequest(&evm_indexerv1.GetEvmLogSourceRequest{Id: uint32(source.ID)}))
+	if err != nil {
+		t.Fatalf("get: %v", err)
+	}
+	progress := got.Msg.Source.Redecode
+	if progress == nil || progress.Status != "RUNNING" || progress.FromBlock != 100 || progress.NextBlock != 100 || progress.ToBlock != 500 {
+		t.Fatalf("progress = %v", progress)
+	}
+
+	// One job at a time.
+	if _, err := e.RedecodeEvmLogSource(ctx, req); connect.CodeOf(err) != connect.CodeFailedPrecondition {
+		t.Errorf("second redecode err = %v, want FailedPrecondition", err)
+	}
+
+	// A finished job restarts from the start block, up to the new sync block.
+	e.db.Conn.Model(&evmi_database.EvmLogSourceRedecode{}).Where("evm_log_source_id = ?", source.ID).
+		Updates(map[string]interface{}{"status": "FAILED", "error": "boom", "next_block": 300, "logs": 7})
+	e.db.Conn.Model(&source).Update("sync_block", 800)
+	if _, err := e.RedecodeEvmLogSource(ctx, req); err != nil {
+		t.Fatalf("redecode after failure: %v", err)
+	}
+	got, _ = e.GetEvmLogSource(ctx, connect.NewRequest(&evm_indexerv1.GetEvmLogSourceRequest{Id: uint32(source.ID)}))
+	if progress := got.Msg.Source.Redecode; progress.Status != "RUNNING" || progress.NextBlock != 100 || progress.ToBlock != 800 ||
+		progress.Logs != 0 || progress.Error != "" {
+		t.Errorf("restarted progress = %v", progress)
+	}
+
+	missing := connect.NewRequest(&evm_indexerv1.RedecodeEvmLogSourceRequest{Id: 999})
+	if _, err := e.RedecodeEvmLogSource(ctx, missing); connect.CodeOf(err) != connect.CodeNotFound {
+		t.Errorf("missing source err = %v, want NotFound", err)
+	}
+}
@@ -141,6 +141,92 @@ func (e *EvmIndexerServer) ListEvmTransactions(ctx context.Context, req *connect
 	}, nil
 }
 
+// ListEvmBlocks implements evm_indexerv1connect.EvmIndexerServiceHandler.
+func (e *EvmIndexerServer) ListEvmBlocks(ctx context.Context, req *connect.Request[evm_indexerv1.ListEvmBlocksRequest]) (*connect.Response[evm_indexerv1.ListEvmBlocksResponse], error) {
+	var source evmi_database.EvmLogSource
+	result := e.db.Conn.First(&source, req.Msg.SourceId)
+	if result.Error != nil {
+		return nil, dbError(result.Error)
+	}
+
+	var pipeline evmi_database.EvmLogPipeline
+	result = e.db.Conn.First(&pipeline, source.EvmLogPipelineID)
+	if result.Error != nil {
+		return nil, dbError(result.Error)
+	}
+
+	var storeInfo evmi_database.EvmLogStore
+	result = e.db.Conn.First(&storeInfo, pipeline.EvmLogStoreId)
+	if result.Error != nil {
+		return nil, dbError(result.Error)
+	}
+
+	var storeConfig map[string]string
+	err := json.Unmarshal(storeInfo.StoreConfig, &storeConfig)
+	if err != nil {
+		return nil, dbError(err)
+	}
+
+	store, err := log_stores.LoadStore(storeInfo.StoreType, storeConfig, e.logger)
+	if err != nil {
+		return nil, dbError(err)
+	}
+
+	blocks, err := store.GetStorage().GetBlocks(uint64(req.Msg.SourceId), req.Msg.FromBlock, req.Msg.ToBlock)
+	if err != nil {
+		return nil, dbError(err)
+	}
+
+	return &connect.Response[evm_indexerv1.ListEvmBlocksResponse]{
+		Msg: &evm_indexerv1.ListEvmBlocksResponse{
+			Blocks: toGrpcBlocks(blocks),
+		},
+	}, nil
+}
+
+// ListEvmTraces implements evm_indexerv1connect.EvmIndexerServiceHandler.
+func (e *EvmIndexerServer) ListEvmTraces(ctx context.Context, req *connect.Request[evm_indexerv1.ListEvmTracesRequest]) (*connect.Response[evm_indexerv1.ListEvmTracesResponse], error) {
+	var source evmi_database.EvmLogSource
+	result := e.db.Conn.First(&source, req.Msg.SourceId)
+	if result.Error != nil {
+		return nil, dbError(result.Error)
+	}
+
+	var pipeline evmi_database.EvmLogPipeline
+	result = e.db.Conn.First(&pipeline, source.EvmLogPipelineID)
+	if result.Error != nil {
+		return nil, dbError(result.Error)
+	}
+
+	var storeInfo evmi_database.EvmLogStore
+	result = e.db.Conn.First(&storeInfo, pipeline.EvmLogStoreId)
+	if result.Error != nil {
+		return nil, dbError(result.Error)
+	}
+
+	var storeConfig map[string]string
+	err := json.Unmarshal(storeInfo.StoreConfig, &storeConfig)
+	if err != nil {
+		return nil, dbError(err)
+	}
+
+	store, err := log_stores.LoadStore(storeInfo.StoreType, storeConfig, e.logger)
+	if err != nil {
+		return nil, dbError(err)
+	}
+
+	traces, err := store.GetStorage().GetTraces(uint64(req.Msg.SourceId), req.Msg.FromBlock, req.Msg.ToBlock)
+	if err != nil {
+		return nil, dbError(err)
+	}
+
+	return &connect.Response[evm_indexerv1.ListEvmTracesResponse]{
+		Msg: &evm_indexerv1.ListEvmTracesResponse{
+			Traces: toGrpcTraces(traces),
+		},
+	}, nil
+}
+
 func toGrpcLogs(logs []types.EvmLog) []*evm_indexerv1.EvmLog {
 	var result []*evm_indexerv1.EvmLog
 	for _, log := range logs {
@@ -187,6 +273,15 @@ func toGrpcTransactions(txs []types.EvmTransaction) []*evm_indexerv1.EvmTransact
 			To:               tx.To,
 			Hash:             tx.Hash,
 
+			Type:                tx.Type,
+			Status:              tx.Status,
+			GasUsed:             tx.GasUsed,
+			EffectiveGasPrice:   tx.EffectiveGasPrice,
+			ContractAddress:     tx.ContractAddress,
+			BlobGasUsed:         tx.BlobGasUsed,
+			BlobGasPrice:        tx.BlobGasPrice,
+			BlobVersionedHashes: tx.BlobVersionedHashes,
+
 			Metadata: &evm_indexerv1.EvmMetadata{
 				ContractName: &tx.Metadata.ContractName,
 				EventName:    &tx.Metadata.EventName,
@@ -198,3 +293,55 @@ func toGrpcTransactions(txs []types.EvmTransaction) []*evm_indexerv1.EvmTransact
 
 	return result
 }
+
+func toGrpcBlocks(blocks []types.EvmBlock) []*evm_indexerv1.EvmBlock {
+	var result []*evm_indexerv1.EvmBlock
+	for _, block := range blocks {
+		result = append(result, &evm_indexerv1.EvmBlock{
+			Id:               block.Id,
+			SourceId:         uint32(block.SourceId),
+			ChainId:          block.ChainId,
+			BlockNumber:      block.BlockNumber,
+			BlockTimestamp:   block.BlockTimestamp,
+			Hash:             block.Hash,
+			ParentHash:       block.ParentHash,
+			Miner:            block.Miner,
+			GasUsed:          block.GasUsed,
+			GasLimit:         block.GasLimit,
+			BaseFeePerGas:    block.BaseFeePerGas,
+			BlobGasUsed:      block.BlobGasUsed,
+			ExcessBlobGas:    block.ExcessBlobGas,
+			TransactionCount: block.TransactionCount,
+			LogsBloom:        block.LogsBloom,
+		})
+	}
+
+	return result
+}
+
+func toGrpcTraces(traces []types.EvmTrace) []*evm_indexerv1.EvmTrace {
+	var result []*evm_indexerv1.EvmTrace
+	for _, trace := range traces {
+		result = append(result, &evm_indexerv1.EvmTrace{
+			Id:               trace.Id,
+			SourceId:         uint32(trace.SourceId),
+			ChainId:          trace.ChainId,
+			BlockNumber:      trace.BlockNumber,
+			BlockTimestamp:   trace.BlockTimestamp,
+			TransactionHash:  trace.TransactionHash,
+			TransactionIndex: trace.TransactionIndex,
+			TraceAddress:     trace.TraceAddress,
+			Type:             trace.Type,
+			From:             trace.From,
+			To:               trace.To,
+			Value:            trace.Value,
+			Input:            trace.Input,
+			Output:           trace.Output,
+			Gas:              trace.Gas,
+			GasUsed:          trace.GasUsed,
+			Error:            trace.Error,
+		})
+	}
+
+	return result
+}
@@ -0,0 +1,151 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"connectrpc.com/connect"
+	"github.com/ethereum/go-ethereum/common"
+	evmi_database "github.com/evmi-cloud/go-evm-indexer/internal/database/evmi-database"
+	evm_indexerv1 "github.com/evmi-cloud/go-evm-indexer/internal/grpc/generated/evm_indexer/v1"
+)
+
+// SetEvmImplementationAbi implements evm_indexerv1connect.EvmIndexerServiceHandler.
+func (e *EvmIndexerServer) SetEvmImplementationAbi(ctx context.Context, req *connect.Request[evm_indexerv1.SetEvmImplementationAbiRequest]) (*connect.Response[evm_indexerv1.SetEvmImplementationAbiResponse], error) {
+	msg := req.Msg.ImplementationAbi
+	if msg == nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("implementation_abi is required"))
+	}
+	if !common.IsHexAddress(msg.Address) {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid address %q", msg.Address))
+	}
+	// The indexer looks implementations up by their checksummed address.
+	address := common.HexToAddress(msg.Address).Hex()
+
+	if err := e.db.Conn.First(&evmi_database.EvmBlockchain{}, msg.EvmBlockchainId).Error; err != nil {
+		return nil, dbError(err)
+	}
+	if err := e.db.Conn.First(&evmi_database.EvmJsonAbi{}, msg.EvmJsonAbiId).Error; err != nil {
+		return nil, dbError(err)
+	}
+
+	var mappings []evmi_database.EvmImplementationAbi
+	result := e.db.Conn.Where("evm_blockchain_id = ? AND address = ?", msg.EvmBlockchainId, address).Limit(1).Find(&mappings)
+	if result.Error != nil {
+		return nil, dbError(result.Error)
+	}
+
+	var mapping evmi_database.EvmImplementationAbi
+	if len(mappings) > 0 {
+		mapping = mappings[0]
+		result = e.db.Conn.Model(&mapping).Update("evm_json_abi_id", msg.EvmJsonAbiId)
+	} else {
+		mapping = evmi_database.EvmImplementationAbi{
+			EvmBlockchainID: uint(msg.EvmBlockchainId),
+			Address:         address,
+			EvmJsonAbiID:    uint(msg.EvmJsonAbiId),
+		}
+		result = e.db.Conn.Create(&mapping)
+	}
+	if result.Error != nil {
+		return nil, dbError(result.Error)
+	}
+
+	return &connect.Response[evm_indexerv1.SetEvmImplementationAbiResponse]{
+		Msg: &evm_indexerv1.SetEvmImplementationAbiResponse{
+			Id: uint32(mapping.ID),
+		},
+	}, nil
+}
+
+// ListEvmImplementationAbis implements evm_indexerv1connect.EvmIndexerServiceHandler.
+func (e *EvmIndexerServer) ListEvmImplementationAbis(ctx context.Context, req *connect.Request[evm_indexerv1.ListEvmImplementationAbisRequest]) (*connect.Response[evm_indexerv1.ListEvmImplementationAbisResponse], error) {
+	var mappings []evmi_database.EvmImplementationAbi
+
+	query := e.db.Conn.Model(&evmi_database.EvmImplementationAbi{}).Order("id")
+	if req.Msg.EvmBlockchainId != 0 {
+		query = query.Where("evm_blockchain_id = ?", req.Msg.EvmBlockchainId)
+	}
+	if req.Msg.Pagination != nil && req.Msg.Pagination.Limit > 0 {
+		query = query.Offset(int(req.Msg.Pagination.Offset)).Limit(int(req.Msg.Pagination.Limit))
+	}
+	result := query.Find(&mappings)
+	if result.Error != nil {
+		return nil, dbError(result.Error)
+	}
+
+	var implementationAbis []*evm_indexerv1.EvmImplementationAbi
+	for _, mapping := range mappings {
+		id := uint32(mapping.ID)
+		implementationAbis = append(implementationAbis, &evm_indexerv1.EvmImplementationAbi{
+			Id:              &id,
+			EvmBlockchainId: uint32(mapping.EvmBlockchainID),
+			Address:         mapping.Address,
+			EvmJsonAbiId:    uint32(mapping.EvmJsonAbiID),
+		})
+	}
+
+	return &connect.Response[evm_indexerv1.ListEvmImplementationAbisResponse]{
+		Msg: &evm_indexerv1.ListEvmImplementationAbisResponse{
+			ImplementationAbis: implementationAbis,
+		},
+	}, nil
+}
+
+// DeleteEvmImplementationAbi implements evm_indexerv1connect.EvmIndexerServiceHandler.
+func (e *EvmIndexerServer) DeleteEvmImplementationAbi(ctx context.Context, req *connect.Request[evm_indexerv1.DeleteEvmImplementationAbiRequest]) (*connect.Response[evm_indexerv1.DeleteEvmImplementationAbiResponse], error) {
+	result := e.db.Conn.Delete(&evmi_database.EvmImplementationAbi{}, req.Msg.Id)
+	if result.Error != nil {
+		return nil, dbError(result.Error)
+	}
+
+	return &connect.Response[evm_indexerv1.DeleteEvmImplementationAbiResponse]{
+		Msg: &evm_indexerv1.DeleteEvmImplementationAbiResponse{},
+	}, nil
+}
+
+// ListEvmProxyImplementations implements evm_indexerv1connect.EvmIndexerServiceHandler.
+func (e *EvmIndexerServer) ListEvmProxyImplementations(ctx context.Context, req *connect.Request[evm_indexerv1.ListEvmProxyImplementationsRequest]) (*connect.Response[evm_indexerv1.ListEvmProxyImplementationsResponse], error) {
+	var source evmi_database.EvmLogSource
+	if err := e.db.Conn.First(&source, req.Msg.SourceId).Error; err != nil {
+		return nil, dbError(err)
+	}
+
+	var history []evmi_database.EvmProxyImplementation
+	result := e.db.Conn.Where("evm_log_source_id = ?", source.ID).Order("from_block, from_log_index").Find(&history)
+	if result.Error != nil {
+		return nil, dbError(result.Error)
+	}
+
+	var mappings []evmi_database.EvmImplementationAbi
+	result = e.db.Conn.Where("evm_blockchain_id = ?", source.EvmBlockchainID).Find(&mappings)
+	if result.Error != nil {
+		return nil, dbError(result.Error)
+	}
+	abiIDs := map[string]uint32{}
+	for _, mapping := range mappings {
+		abiIDs[mapping.Address] = uint32(mapping.EvmJsonAbiID)
+	}
+
+	var implementations []*evm_indexerv1.EvmProxyImplementation
+	for _, entry := range history {
+		implementation := &evm_indexerv1.EvmProxyImplementation{
+			ProxyType:      entry.ProxyType,
+			Beacon:         entry.Beacon,
+			Implementation: entry.Implementation,
+			FromBlock:      entry.FromBlock,
+			FromLogIndex:   entry.FromLogIndex,
+		}
+		if id, ok := abiIDs[entry.Implementation]; ok {
+			implementation.EvmJsonAbiId = &id
+		}
+		implementations = append(implementations, implementation)
+	}
+
+	return &connect.Response[evm_indexerv1.ListEvmProxyImplementationsResponse]{
+		Msg: &evm_indexerv1.ListEvmProxyImplementationsResponse{
+			Implementations: implementations,
+		},
+	}, nil
+}
@@ -0,0 +1,142 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	"connectrpc.com/connect"
+	evmi_database "github.com/evmi-cloud/go-evm-indexer/internal/database/evmi-database"
+	evm_indexerv1 "github.com/evmi-cloud/go-evm-indexer/internal/grpc/generated/evm_indexer/v1"
+	"github.com/evmi-cloud/go-evm-indexer/internal/types"
+)
+
+// ImportEvmEventSignatures implements evm_indexerv1connect.EvmIndexerServiceHandler.
+func (e *EvmIndexerServer) ImportEvmEventSignatures(ctx context.Context, req *connect.Request[evm_indexerv1.ImportEvmEventSignaturesRequest]) (*connect.Response[evm_indexerv1.ImportEvmEventSignaturesResponse], error) {
+	events, err := types.ParseEventSignatures(req.Msg.Content)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	if len(events) == 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("no event signature to import"))
+	}
+
+	var imported, skipped uint32
+	for _, event := range events {
+		signature := types.FormatEventSignature(event)
+		var count int64
+		result := e.db.Conn.Model(&evmi_database.EvmEventSignature{}).Where("topic0 = ? AND signature = ?", event.ID.Hex(), signature).Count(&count)
+		if result.Error != nil {
+			return nil, dbError(result.Error)
+		}
+		if count > 0 {
+			skipped++
+			continue
+		}
+
+		indexedCount := uint(0)
+		for _, input := range event.Inputs {
+			if input.Indexed {
+				indexedCount++
+			}
+		}
+		result = e.db.Conn.Create(&evmi_database.EvmEventSignature{
+			Topic0:       event.ID.Hex(),
+			IndexedCount: indexedCount,
+			Signature:    signature,
+		})
+		if result.Error != nil {
+			return nil, dbError(result.Error)
+		}
+		imported++
+	}
+
+	return &connect.Response[evm_indexerv1.ImportEvmEventSignaturesResponse]{
+		Msg: &evm_indexerv1.ImportEvmEventSignaturesResponse{
+			Imported: imported,
+			Skipped:  skipped,
+		},
+	}, nil
+}
+
+// ListEvmEventSignatures implements evm_indexerv1connect.EvmIndexerServiceHandler.
+// The registry is listed in the order sources look it up in: the events of
+// the ABIs, by id, then the imported signatures.
+func (e *EvmIndexerServer) ListEvmEventSignatures(ctx context.Context, req *connect.Request[evm_indexerv1.ListEvmEventSignaturesRequest]) (*connect.Response[evm_indexerv1.ListEvmEventSignaturesResponse], error) {
+	var abis []evmi_database.EvmJsonAbi
+	result := e.db.Conn.Order("id").Find(&abis)
+	if result.Error != nil {
+		return nil, dbError(result.Error)
+	}
+
+	var signatures []*evm_indexerv1.EvmEventSignature
+	for _, abiEntry := range abis {
+		// ABIs that do not parse are not in the registry either.
+		events, err := types.ParseEventSignatures(abiEntry.Content)
+		if err != nil {
+			continue
+		}
+		abiID := uint32(abiEntry.ID)
+		for _, event := range events {
+			if req.Msg.Topic0 != "" && event.ID.Hex() != req.Msg.Topic0 {
+				continue
+			}
+			indexedCount := uint32(0)
+			for _, input := range event.Inputs {
+				if input.Indexed {
+					indexedCount++
+				}
+			}
+			signatures = append(signatures, &evm_indexerv1.EvmEventSignature{
+				Topic0:       event.ID.Hex(),
+				IndexedCount: indexedCount,
+				Signature:    types.FormatEventSignature(event),
+				EvmJsonAbiId: &abiID,
+			})
+		}
+	}
+
+	var imported []evmi_database.EvmEventSignature
+	query := e.db.Conn.Order("id")
+	if req.Msg.Topic0 != "" {
+		query = query.Where("topic0 = ?", req.Msg.Topic0)
+	}
+	result = query.Find(&imported)
+	if result.Error != nil {
+		return nil, dbError(result.Error)
+	}
+	for _, signature := range imported {
+		id := uint32(signature.ID)
+		signatures = append(signatures, &evm_indexerv1.EvmEventSignature{
+			Id:           &id,
+			Topic0:       signature.Topic0,
+			IndexedCount: uint32(signature.IndexedCount),
+			Signature:    signature.Signature,
+		})
+	}
+
+	if req.Msg.Pagination != nil && req.Msg.Pagination.Limit > 0 {
+		offset := min(int(req.Msg.Pagination.Offset), len(signatures))
+		end := min(offset+int(req.Msg.Pagination.Limit), len(signatures))
+		signatures = signatures[offset:end]
+	}
+
+	return &connect.Response[evm_indexerv1.ListEvmEventSignaturesResponse]{
+		Msg: &evm_indexerv1.ListEvmEventSignaturesResponse{
+			Signatures: signatures,
+		},
+	}, nil
+}
+
+// DeleteEvmEventSignature implements evm_indexerv1connect.EvmIndexerServiceHandler.
+// Only imported signatures can be deleted; the events of an ABI leave the
+// registry with it.
+func (e *EvmIndexerServer) DeleteEvmEventSignature(ctx context.Context, req *connect.Request[evm_indexerv1.DeleteEvmEventSignatureRequest]) (*connect.Response[evm_indexerv1.DeleteEvmEventSignatureResponse], error) {
+	result := e.db.Conn.Delete(&evmi_database.EvmEventSignature{}, req.Msg.Id)
+	if result.Error != nil {
+		return nil, dbError(result.Error)
+	}
+
+	return &connect.Response[evm_indexerv1.DeleteEvmEventSignatureResponse]{
+		Msg: &evm_indexerv1.DeleteEvmEventSignatureResponse{},
+	}, nil
+}
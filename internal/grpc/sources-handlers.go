@@ -5,6 +5,9 @@ import (
 	"database/sql"
 	"encoding/json"
 	"errors"
+	"fmt"
+	"regexp"
+	"strings"
 	"time"
 
 	"connectrpc.com/connect"
@@ -12,28 +15,42 @@ import (
 	evmi_database "github.com/evmi-cloud/go-evm-indexer/internal/database/evmi-database"
 	log_stores "github.com/evmi-cloud/go-evm-indexer/internal/database/log-stores"
 	evm_indexerv1 "github.com/evmi-cloud/go-evm-indexer/internal/grpc/generated/evm_indexer/v1"
+	"github.com/evmi-cloud/go-evm-indexer/internal/types"
 )
 
+var functionSelectorRegex = regexp.MustCompile(`^0x[0-9a-fA-F]{8}$`)
+
 // CreateEvmLogSource implements evm_indexerv1connect.EvmIndexerServiceHandler.
 func (e *EvmIndexerServer) CreateEvmLogSource(ctx context.Context, req *connect.Request[evm_indexerv1.CreateEvmLogSourceRequest]) (*connect.Response[evm_indexerv1.CreateEvmLogSourceResponse], error) {
+	functionSelector, err := parseFunctionSelector(req.Msg.Source.FunctionSelector)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := validateEndBlock(req.Msg.Source); err != nil {
+		return nil, err
+	}
+	if err := validateTransactionsSource(req.Msg.Source); err != nil {
+		return nil, err
+	}
+
 	newLogSource := evmi_database.EvmLogSource{
-		Type:       req.Msg.Source.Type,
-		StartBlock: req.Msg.Source.StartBlock,
-		SyncBlock:  req.Msg.Source.SyncBlock,
+		Type:           req.Msg.Source.Type,
+		StartBlock:     req.Msg.Source.StartBlock,
+		SyncBlock:      req.Msg.Source.SyncBlock,
+		EndBlock:       req.Msg.Source.EndBlock,
+		CatchUpWorkers: uint(req.Msg.Source.CatchUpWorkers),
 		Address: sql.NullString{
 			String: DerefOrEmpty(req.Msg.Source.Address),
 			Valid:  IsNotNil(req.Msg.Source.Address),
 		},
-		Topic0: sql.NullString{
-			String: DerefOrEmpty(req.Msg.Source.Topic0),
-			Valid:  IsNotNil(req.Msg.Source.Topic0),
-		},
-		TopicFilters: req.Msg.Source.TopicFilters,
+		TopicFilters:     req.Msg.Source.TopicFilters,
+		FunctionSelector: functionSelector,
 
 		EvmLogPipelineID: uint(req.Msg.Source.EvmLogPipelineId),
-		EvmJsonAbiID:     uint(req.Msg.Source.EvmJsonAbiId),
 		EvmBlockchainID:  uint(req.Msg.Source.EvmBlockchainId),
 	}
+	setSourceLists(&newLogSource, req.Msg.Source)
 
 	result := e.db.Conn.Create(&newLogSource)
 	if result.Error != nil {
@@ -87,12 +104,19 @@ func (e *EvmIndexerServer) DeleteEvmLogSource(ctx context.Context, req *connect.
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
 
-	// Delete each source's factory rules (+conditions), then the source rows.
+	// Delete each source's factory rules (+conditions), proxy implementation
+	// history and re-decode job, then the source rows.
 	for _, id := range ids {
 		if err := e.deleteFactoryRules(id); err != nil {
 			return nil, dbError(err)
 		}
 	}
+	if err := e.db.Conn.Where("evm_log_source_id IN ?", ids).Delete(&evmi_database.EvmProxyImplementation{}).Error; err != nil {
+		return nil, dbError(err)
+	}
+	if err := e.db.Conn.Unscoped().Where("evm_log_source_id IN ?", ids).Delete(&evmi_database.EvmLogSourceRedecode{}).Error; err != nil {
+		return nil, dbError(err)
+	}
 	if err := e.db.Conn.Where("id IN ?", ids).Delete(&evmi_database.EvmLogSource{}).Error; err != nil {
 		return nil, dbError(err)
 	}
@@ -102,6 +126,170 @@ func (e *EvmIndexerServer) DeleteEvmLogSource(ctx context.Context, req *connect.
 	}, nil
 }
 
+// RedecodeEvmLogSource implements evm_indexerv1connect.EvmIndexerServiceHandler.
+// It records a RUNNING job over the blocks the source has indexed so far and
+// hands it to the indexer owning the source, which reports its progress as
+// source updates.
+func (e *EvmIndexerServer) RedecodeEvmLogSource(ctx context.Context, req *connect.Request[evm_indexerv1.RedecodeEvmLogSourceRequest]) (*connect.Response[evm_indexerv1.RedecodeEvmLogSourceResponse], error) {
+	var logSource evmi_database.EvmLogSource
+	if err := e.db.Conn.First(&logSource, req.Msg.Id).Error; err != nil {
+		return nil, dbError(err)
+	}
+
+	var job evmi_database.EvmLogSourceRedecode
+	result := e.db.Conn.Where("evm_log_source_id = ?", logSource.ID).Limit(1).Find(&job)
+	if result.Error != nil {
+		return nil, dbError(result.Error)
+	}
+	if job.Status == string(evmi_database.RunningRedecodeStatus) {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, errors.New("the source is already being re-decoded"))
+	}
+
+	job.EvmLogSourceID = logSource.ID
+	job.Status = string(evmi_database.RunningRedecodeStatus)
+	job.FromBlock = logSource.StartBlock
+	job.NextBlock = logSource.StartBlock
+	job.ToBlock = logSource.SyncBlock
+	job.Logs = 0
+	job.Error = ""
+	if err := e.db.Conn.Save(&job).Error; err != nil {
+		return nil, dbError(err)
+	}
+
+	e.bus.Emit(context.Background(), internal_bus.RedecodeSourceTopic, logSource.ID)
+	e.bus.Emit(context.Background(), internal_bus.SourceUpdateTopic, logSource)
+
+	return &connect.Response[evm_indexerv1.RedecodeEvmLogSourceResponse]{
+		Msg: &evm_indexerv1.RedecodeEvmLogSourceResponse{},
+	}, nil
+}
+
+// ResyncEvmLogSource implements evm_indexerv1connect.EvmIndexerServiceHandler.
+// It stops the source, and its factory children when included, deletes their
+// stored data at or above from_block, rewinds their cursors and the cursors of
+// the pipeline's exporters, then starts again the sources that were enabled.
+func (e *EvmIndexerServer) ResyncEvmLogSource(ctx context.Context, req *connect.Request[evm_indexerv1.ResyncEvmLogSourceRequest]) (*connect.Response[evm_indexerv1.ResyncEvmLogSourceResponse], error) {
+	var logSource evmi_database.EvmLogSource
+	if err := e.db.Conn.First(&logSource, req.Msg.Id).Error; err != nil {
+		return nil, dbError(err)
+	}
+	fromBlock := req.Msg.FromBlock
+
+	ids := []uint{logSource.ID}
+	if req.Msg.IncludeChildren {
+		var err error
+		if ids, err = e.collectSourceSubtree(logSource.ID); err != nil {
+			return nil, dbError(err)
+		}
+	}
+	var sources []evmi_database.EvmLogSource
+	if err := e.db.Conn.Where("id IN ?", ids).Order("id").Find(&sources).Error; err != nil {
+		return nil, dbError(err)
+	}
+	var chain evmi_database.EvmBlockchain
+	if err := e.db.Conn.Limit(1).Find(&chain, logSource.EvmBlockchainID).Error; err != nil {
+		return nil, dbError(err)
+	}
+
+	// Stop indexing first so no worker writes the range again while it is
+	// deleted, nor its cursor back. The sources are resumed however the resync
+	// ends, so a failure below does not leave them stopped.
+	for _, source := range sources {
+		e.bus.Emit(context.Background(), internal_bus.DisableSourceTopic, source.ID)
+	}
+	defer e.resumeSources(sources)
+
+	// Factory children inherit their parent's pipeline, hence store.
+	store, err := e.sourceStore(logSource)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	defer store.Close()
+	for _, source := range sources {
+		if err := store.GetStorage().RollbackSourceData(uint64(source.ID), fromBlock); err != nil {
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+	}
+	if err := e.db.Conn.Unscoped().Where("evm_log_source_id IN ? AND from_block >= ?", ids, fromBlock).Delete(&evmi_database.EvmProxyImplementation{}).Error; err != nil {
+		return nil, dbError(err)
+	}
+
+	// Column-scoped: the cursor only moves back, never past the start block.
+	resynced := make([]uint32, 0, len(sources))
+	for i := range sources {
+		source := &sources[i]
+		if cursor := resyncCursor(*source, fromBlock); cursor < source.SyncBlock {
+			if err := e.db.Conn.Model(source).Update("sync_block", cursor).Error; err != nil {
+				return nil, dbError(err)
+			}
+			source.SyncBlock = cursor
+		}
+		resynced = append(resynced, uint32(source.ID))
+	}
+
+	if err := e.rewindExporters(sources, chain.ChainId, fromBlock); err != nil {
+		return nil, dbError(err)
+	}
+
+	return &connect.Response[evm_indexerv1.ResyncEvmLogSourceResponse]{
+		Msg: &evm_indexerv1.ResyncEvmLogSourceResponse{SourceIds: resynced},
+	}, nil
+}
+
+// resumeSources starts again the sources a resync stopped that are enabled,
+// and publishes the state of the others.
+func (e *EvmIndexerServer) resumeSources(sources []evmi_database.EvmLogSource) {
+	for _, source := range sources {
+		if source.Enabled {
+			e.bus.Emit(context.Background(), internal_bus.EnableSourceTopic, source.ID)
+		} else {
+			e.bus.Emit(context.Background(), internal_bus.SourceUpdateTopic, source)
+		}
+	}
+}
+
+// resyncCursor is the sync block of a source indexing again from fromBlock:
+// the block before it, or the source's start block when it is below.
+func resyncCursor(source evmi_database.EvmLogSource, fromBlock uint64) uint64 {
+	if fromBlock <= source.StartBlock+1 {
+		return source.StartBlock
+	}
+	return fromBlock - 1
+}
+
+// rewindExporters moves the persisted cursor of every exporter of the
+// sources' pipeline back before fromBlock, whatever its status says, then
+// sends a source rollback, which tells the plugin of a running exporter and
+// rewinds its cursor in memory. Rewinding twice is harmless.
+func (e *EvmIndexerServer) rewindExporters(sources []evmi_database.EvmLogSource, chainId uint64, fromBlock uint64) error {
+	var exporters []evmi_database.EvmiExporter
+	if err := e.db.Conn.Where("evm_log_pipeline_id = ?", sources[0].EvmLogPipelineID).Find(&exporters).Error; err != nil {
+		return err
+	}
+	for _, exporter := range exporters {
+		rewind, past := exporter.RollbackCursor(fromBlock)
+		if !past {
+			continue
+		}
+		if err := e.db.Conn.Model(&exporter).Updates(map[string]interface{}{
+			"sync_block":     rewind,
+			"sync_log_index": -1,
+		}).Error; err != nil {
+			return err
+		}
+	}
+
+	for _, source := range sources {
+		e.bus.Emit(context.Background(), internal_bus.SourceRollbackTopic, types.SourceRollback{
+			SourceId:   source.ID,
+			PipelineId: source.EvmLogPipelineID,
+			ChainId:    chainId,
+			FromBlock:  fromBlock,
+		})
+	}
+	return nil
+}
+
 // collectSourceSubtree returns rootID followed by every descendant source id
 // (breadth-first over parent_source_id), so deleting walks the whole factory tree.
 func (e *EvmIndexerServer) collectSourceSubtree(rootID uint) ([]uint, error) {
@@ -121,33 +309,39 @@ func (e *EvmIndexerServer) collectSourceSubtree(rootID uint) ([]uint, error) {
 	return ids, nil
 }
 
-// deleteSubtreeStoreData removes the stored logs and transactions of every source
-// id from the pipeline's log store. The store is resolved from the (top) source's
-// pipeline, which all factory children share.
+// deleteSubtreeStoreData removes the stored logs, transactions and blocks of
+// every source id from the pipeline's log store. The store is resolved from
+// the (top) source's pipeline, which all factory children share.
 func (e *EvmIndexerServer) deleteSubtreeStoreData(source evmi_database.EvmLogSource, ids []uint) error {
+	store, err := e.sourceStore(source)
+	if err != nil {
+		return err
+	}
+	defer store.Close()
+	for _, id := range ids {
+		if err := store.GetStorage().DeleteSourceData(uint64(id)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// sourceStore connects to the log store of the source's pipeline. The caller
+// closes it.
+func (e *EvmIndexerServer) sourceStore(source evmi_database.EvmLogSource) (*log_stores.IndexerStore, error) {
 	var pipeline evmi_database.EvmLogPipeline
 	if err := e.db.Conn.First(&pipeline, source.EvmLogPipelineID).Error; err != nil {
-		return err
+		return nil, err
 	}
 	var storeInfo evmi_database.EvmLogStore
 	if err := e.db.Conn.First(&storeInfo, pipeline.EvmLogStoreId).Error; err != nil {
-		return err
+		return nil, err
 	}
 	var storeConfig map[string]string
 	if err := json.Unmarshal(storeInfo.StoreConfig, &storeConfig); err != nil {
-		return err
-	}
-	store, err := log_stores.LoadStore(storeInfo.StoreType, storeConfig, e.logger)
-	if err != nil {
-		return err
-	}
-	storage := store.GetStorage()
-	for _, id := range ids {
-		if err := storage.DeleteSourceData(uint64(id)); err != nil {
-			return err
-		}
+		return nil, err
 	}
-	return nil
+	return log_stores.LoadStore(storeInfo.StoreType, storeConfig, e.logger)
 }
 
 // GetEvmLogSource implements evm_indexerv1connect.EvmIndexerServiceHandler.
@@ -201,23 +395,32 @@ func (e *EvmIndexerServer) UpdateEvmLogSource(ctx context.Context, req *connect.
 		return nil, connect.NewError(connect.CodeFailedPrecondition,
 			errors.New("this source was created by a factory and cannot be edited; only its indexation can be started or stopped"))
 	}
+	functionSelector, err := parseFunctionSelector(req.Msg.Source.FunctionSelector)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateEndBlock(req.Msg.Source); err != nil {
+		return nil, err
+	}
+	if err := validateTransactionsSource(req.Msg.Source); err != nil {
+		return nil, err
+	}
 
 	logSoure.Type = req.Msg.Source.Type
 	logSoure.StartBlock = req.Msg.Source.StartBlock
 	logSoure.SyncBlock = req.Msg.Source.SyncBlock
+	logSoure.EndBlock = req.Msg.Source.EndBlock
+	logSoure.CatchUpWorkers = uint(req.Msg.Source.CatchUpWorkers)
 	logSoure.Address = sql.NullString{
 		String: DerefOrEmpty(req.Msg.Source.Address),
 		Valid:  IsNotNil(req.Msg.Source.Address),
 	}
-	logSoure.Topic0 = sql.NullString{
-		String: DerefOrEmpty(req.Msg.Source.Topic0),
-		Valid:  IsNotNil(req.Msg.Source.Topic0),
-	}
 	logSoure.TopicFilters = req.Msg.Source.TopicFilters
+	logSoure.FunctionSelector = functionSelector
 
 	logSoure.EvmLogPipelineID = uint(req.Msg.Source.EvmLogPipelineId)
-	logSoure.EvmJsonAbiID = uint(req.Msg.Source.EvmJsonAbiId)
 	logSoure.EvmBlockchainID = uint(req.Msg.Source.EvmBlockchainId)
+	setSourceLists(&logSoure, req.Msg.Source)
 
 	result = e.db.Conn.Save(&logSoure)
 	if result.Error != nil {
@@ -265,7 +468,8 @@ func (e *EvmIndexerServer) StartSourceIndexer(ctx context.Context, req *connect.
 			}, nil
 		}
 
-		if source.Status == string(evmi_database.RunningLogSourceStatus) {
+		// A bounded source already past its end block completes right away.
+		if source.Status == string(evmi_database.RunningLogSourceStatus) || source.Status == string(evmi_database.CompletedLogSourceStatus) {
 			return &connect.Response[evm_indexerv1.StartSourceIndexerResponse]{
 				Msg: &evm_indexerv1.StartSourceIndexerResponse{
 					Success: true,
@@ -308,7 +512,8 @@ func (e *EvmIndexerServer) StopSourceIndexer(ctx context.Context, req *connect.R
 			}, nil
 		}
 
-		if source.Status == string(evmi_database.StoppedLogSourceStatus) {
+		// A completed source was not running and keeps its status.
+		if source.Status == string(evmi_database.StoppedLogSourceStatus) || source.Status == string(evmi_database.CompletedLogSourceStatus) {
 			return &connect.Response[evm_indexerv1.StopSourceIndexerResponse]{
 				Msg: &evm_indexerv1.StopSourceIndexerResponse{
 					Success: true,
@@ -322,6 +527,39 @@ func (e *EvmIndexerServer) StopSourceIndexer(ctx context.Context, req *connect.R
 	}, nil
 }
 
+// validateEndBlock rejects a bounded source ending before its start block.
+func validateEndBlock(source *evm_indexerv1.EvmLogSource) error {
+	if source.EndBlock != 0 && source.EndBlock < source.StartBlock {
+		return connect.NewError(connect.CodeInvalidArgument,
+			fmt.Errorf("end block %d is before start block %d", source.EndBlock, source.StartBlock))
+	}
+	return nil
+}
+
+// validateTransactionsSource rejects a TRANSACTIONS source without addresses:
+// it would match no transaction while reading every block of the chain.
+func validateTransactionsSource(source *evm_indexerv1.EvmLogSource) error {
+	if source.Type != string(evmi_database.TransactionsLogSourceType) {
+		return nil
+	}
+	if len(source.Addresses) == 0 && DerefOrEmpty(source.Address) == "" {
+		return connect.NewError(connect.CodeInvalidArgument, errors.New("a TRANSACTIONS source needs at least one address"))
+	}
+	return nil
+}
+
+// parseFunctionSelector normalizes the function selector of a TRANSACTIONS
+// source (empty for any call), rejecting anything but 0x and 4 bytes.
+func parseFunctionSelector(selector string) (string, error) {
+	if selector == "" {
+		return "", nil
+	}
+	if !functionSelectorRegex.MatchString(selector) {
+		return "", connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid function selector %q", selector))
+	}
+	return strings.ToLower(selector), nil
+}
+
 func DerefOrEmpty[T any](val *T) T {
 	if val == nil {
 		var empty T
@@ -334,6 +572,38 @@ func IsNotNil[T any](val *T) bool {
 	return val != nil
 }
 
+// setSourceLists sets the topic0, address and ABI lists of a source from msg:
+// the repeated fields when set, the single topic0 / address / evm_json_abi_id
+// otherwise.
+func setSourceLists(source *evmi_database.EvmLogSource, msg *evm_indexerv1.EvmLogSource) {
+	if len(msg.Topic0S) > 0 {
+		source.SetTopic0s(msg.Topic0S)
+	} else {
+		source.Topic0 = sql.NullString{
+			String: DerefOrEmpty(msg.Topic0),
+			Valid:  IsNotNil(msg.Topic0),
+		}
+		source.Topic0s = nil
+	}
+
+	if len(msg.Addresses) > 0 {
+		source.SetAddresses(msg.Addresses)
+	} else {
+		source.Addresses = nil
+	}
+
+	if len(msg.EvmJsonAbiIds) > 0 {
+		ids := make([]uint, len(msg.EvmJsonAbiIds))
+		for i, id := range msg.EvmJsonAbiIds {
+			ids[i] = uint(id)
+		}
+		source.SetAbiIDs(ids)
+	} else {
+		source.EvmJsonAbiID = uint(msg.EvmJsonAbiId)
+		source.EvmJsonAbiIDs = nil
+	}
+}
+
 func (e *EvmIndexerServer) toGrpcLogSource(logSource evmi_database.EvmLogSource) *evm_indexerv1.EvmLogSource {
 	id := uint32(logSource.ID)
 	createdAt := uint32(logSource.CreatedAt.Unix())
@@ -344,6 +614,27 @@ func (e *EvmIndexerServer) toGrpcLogSource(logSource evmi_database.EvmLogSource)
 	if logSource.Type == string(evmi_database.FactoryLogSourceType) {
 		rules, _ = e.loadFactoryRules(logSource.ID)
 	}
+	var abiIDs []uint32
+	for _, abiID := range logSource.AbiIDs() {
+		abiIDs = append(abiIDs, uint32(abiID))
+	}
+	var redecode *evm_indexerv1.EvmRedecodeProgress
+	var job evmi_database.EvmLogSourceRedecode
+	if e.db.Conn.Where("evm_log_source_id = ?", logSource.ID).Limit(1).Find(&job).RowsAffected > 0 {
+		redecode = &evm_indexerv1.EvmRedecodeProgress{
+			Status:    job.Status,
+			FromBlock: job.FromBlock,
+			NextBlock: job.NextBlock,
+			ToBlock:   job.ToBlock,
+			Logs:      job.Logs,
+			Error:     job.Error,
+		}
+	}
+	var lastErrorAt *uint32
+	if logSource.LastErrorAt != nil {
+		at := uint32(logSource.LastErrorAt.Unix())
+		lastErrorAt = &at
+	}
 	return &evm_indexerv1.EvmLogSource{
 		Id:               &id,
 		Type:             string(logSource.Type),
@@ -351,6 +642,8 @@ func (e *EvmIndexerServer) toGrpcLogSource(logSource evmi_database.EvmLogSource)
 		Status:           string(logSource.Status),
 		StartBlock:       logSource.StartBlock,
 		SyncBlock:        logSource.SyncBlock,
+		EndBlock:         logSource.EndBlock,
+		CatchUpWorkers:   uint32(logSource.CatchUpWorkers),
 		Address:          &logSource.Address.String,
 		Topic0:           &logSource.Topic0.String,
 		TopicFilters:     logSource.TopicFilters,
@@ -359,6 +652,17 @@ func (e *EvmIndexerServer) toGrpcLogSource(logSource evmi_database.EvmLogSource)
 		EvmBlockchainId:  uint32(logSource.EvmBlockchainID),
 		EvmLogPipelineId: uint32(logSource.EvmLogPipelineID),
 		EvmJsonAbiId:     uint32(logSource.EvmJsonAbiID),
+		Topic0S:          logSource.Topic0Values(),
+		EvmJsonAbiIds:    abiIDs,
+		Redecode:         redecode,
+		Addresses:        logSource.AddressValues(),
+		FunctionSelector: logSource.FunctionSelector,
+
+		LastError:           logSource.LastError,
+		LastErrorAt:         lastErrorAt,
+		LastErrorFromBlock:  logSource.LastErrorFromBlock,
+		LastErrorToBlock:    logSource.LastErrorToBlock,
+		ConsecutiveFailures: uint32(logSource.ConsecutiveFailures),
 
 		CreatedAt: &createdAt,
 		UpdatedAt: &updatedAt,
@@ -394,6 +698,7 @@ func (e *EvmIndexerServer) createFactoryRules(sourceID uint, parentRuleID *uint,
 	for _, r := range rules {
 		row := evmi_database.EvmFactoryRule{
 			ParentRuleID:          parentRuleID,
+			Trigger:               r.Trigger,
 			CreationFunctionName:  r.CreationFunctionName,
 			CreationAddressLogArg: r.CreationAddressLogArg,
 			ChildType:             r.ChildType,
@@ -484,6 +789,7 @@ func (e *EvmIndexerServer) loadRulesAt(sourceID uint, parentRuleID *uint) ([]*ev
 		}
 		out = append(out, &evm_indexerv1.FactoryRule{
 			Id:                    &rid,
+			Trigger:               row.Trigger,
 			CreationFunctionName:  row.CreationFunctionName,
 			CreationAddressLogArg: row.CreationAddressLogArg,
 			ChildType:             row.ChildType,
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"connectrpc.com/connect"
 	internal_bus "github.com/evmi-cloud/go-evm-indexer/internal/bus"
@@ -13,6 +14,7 @@ import (
 	log_stores "github.com/evmi-cloud/go-evm-indexer/internal/database/log-stores"
 	evm_indexerv1 "github.com/evmi-cloud/go-evm-indexer/internal/grpc/generated/evm_indexer/v1"
 	"github.com/evmi-cloud/go-evm-indexer/internal/types"
+	"github.com/mustafaturan/bus/v3"
 	"github.com/rs/zerolog"
 	"gorm.io/datatypes"
 	"gorm.io/driver/sqlite"
@@ -28,7 +30,8 @@ func newSourceServer(t *testing.T) *EvmIndexerServer {
 	if err != nil {
 		t.Fatalf("open sqlite: %v", err)
 	}
-	if err := db.AutoMigrate(&evmi_database.EvmLogSource{}, &evmi_database.EvmFactoryRule{}, &evmi_database.EvmFactoryRuleCondition{}); err != nil {
+	if err := db.AutoMigrate(&evmi_database.EvmLogSource{}, &evmi_database.EvmFactoryRule{}, &evmi_database.EvmFactoryRuleCondition{},
+		&evmi_database.EvmProxyImplementation{}, &evmi_database.EvmLogSourceRedecode{}); err != nil {
 		t.Fatalf("migrate: %v", err)
 	}
 	return &EvmIndexerServer{db: &evmi_database.EvmiDatabase{Conn: db}, logger: zerolog.Nop()}
@@ -44,7 +47,8 @@ func newSourceServerWithStore(t *testing.T) *EvmIndexerServer {
 		t.Fatalf("open sqlite: %v", err)
 	}
 	if err := db.AutoMigrate(&evmi_database.EvmLogSource{}, &evmi_database.EvmFactoryRule{}, &evmi_database.EvmFactoryRuleCondition{},
-		&evmi_database.EvmLogPipeline{}, &evmi_database.EvmLogStore{}); err != nil {
+		&evmi_database.EvmLogPipeline{}, &evmi_database.EvmLogStore{}, &evmi_database.EvmProxyImplementation{},
+		&evmi_database.EvmLogSourceRedecode{}); err != nil {
 		t.Fatalf("migrate: %v", err)
 	}
 	return &EvmIndexerServer{db: &evmi_database.EvmiDatabase{Conn: db}, bus: internal_bus.InitializeBus(), logger: zerolog.Nop()}
@@ -106,7 +110,7 @@ func TestFactoryRulesRoundTrip(t *testing.T) {
 	// Update to a single rule; the old tree (incl. nested) must be gone.
 	_, err = e.UpdateEvmLogSource(ctx, connect.NewRequest(&evm_indexerv1.UpdateEvmLogSourceRequest{
 		Source: &evm_indexerv1.EvmLogSource{Id: &id, Type: "FACTORY", EvmJsonAbiId: 9, FactoryRules: []*evm_indexerv1.FactoryRule{
-			{CreationFunctionName: "OnlyOne", CreationAddressLogArg: "addr", ChildType: "CONTRACT", EvmJsonAbiId: 4},
+			{Trigger: "TRACE", ChildType: "CONTRACT", EvmJsonAbiId: 4},
 		}},
 	}))
 	if err != nil {
@@ -114,7 +118,7 @@ func TestFactoryRulesRoundTrip(t *testing.T) {
 	}
 
 	got2, _ := e.GetEvmLogSource(ctx, connect.NewRequest(&evm_indexerv1.GetEvmLogSourceRequest{Id: id}))
-	if len(got2.Msg.Source.FactoryRules) != 1 || got2.Msg.Source.FactoryRules[0].CreationFunctionName != "OnlyOne" {
+	if len(got2.Msg.Source.FactoryRules) != 1 || got2.Msg.Source.FactoryRules[0].Trigger != "TRACE" {
 		t.Fatalf("update did not replace rules: %+v", got2.Msg.Source.FactoryRules)
 	}
 	// No orphaned rule rows remain for this source.
@@ -189,6 +193,7 @@ func TestDeleteSourceCascadesSubtreeAndStoreData(t *testing.T) {
 	grand := evmi_database.EvmLogSource{Type: "CONTRACT", EvmLogPipelineID: pipeline.ID, ParentSourceID: child.ID}
 	e.db.Conn.Create(&grand)
 	allIDs := []uint{factory.ID, child.ID, grand.ID}
+	e.db.Conn.Create(&evmi_database.EvmProxyImplementation{EvmLogSourceID: child.ID, ProxyType: "EIP1967"})
 
 	// Seed stored logs for each source through the same parquet store.
 	ps, err := log_stores.LoadStore("parquet", map[string]string{"path": dir}, zerolog.Nop())
@@ -216,6 +221,10 @@ func TestDeleteSourceCascadesSubtreeAndStoreData(t *testing.T) {
 	if count != 0 {
 		t.Fatalf("expected 0 sources after cascade, got %d", count)
 	}
+	e.db.Conn.Model(&evmi_database.EvmProxyImplementation{}).Count(&count)
+	if count != 0 {
+		t.Fatalf("expected 0 proxy implementations after cascade, got %d", count)
+	}
 
 	// Stored data is gone for every source in the subtree.
 	for _, id := range allIDs {
@@ -228,3 +237,269 @@ func TestDeleteSourceCascadesSubtreeAndStoreData(t *testing.T) {
 		}
 	}
 }
+
+// The topic0 and ABI lists round-trip through create → get, and a single
+// topic0 / ABI is reported as a list of one.
+func TestSourceListsRoundTrip(t *testing.T) {
+	e := newSourceServer(t)
+	ctx := context.Background()
+
+	created, err := e.CreateEvmLogSource(ctx, connect.NewRequest(&evm_indexerv1.CreateEvmLogSourceRequest{
+		Source: &evm_indexerv1.EvmLogSource{Type: "TOPIC", Topic0S: []string{"0xaa", "0xbb"}, EvmJsonAbiIds: []uint32{3, 4}},
+	}))
+	if err != nil {
+		t.Fatalf("create: %v", err)
+	}
+	got, err := e.GetEvmLogSource(ctx, connect.NewRequest(&evm_indexerv1.GetEvmLogSourceRequest{Id: created.Msg.Id}))
+	if err != nil {
+		t.Fatalf("get: %v", err)
+	}
+	src := got.Msg.Source
+	if src.GetTopic0() != "0xaa" || len(src.Topic0S) != 2 || src.Topic0S[1] != "0xbb" {
+		t.Errorf("topic0s = %q %v", src.GetTopic0(), src.Topic0S)
+	}
+	if src.EvmJsonAbiId != 3 || len(src.EvmJsonAbiIds) != 2 || src.EvmJsonAbiIds[1] != 4 {
+		t.Errorf("abis = %d %v", src.EvmJsonAbiId, src.EvmJsonAbiIds)
+	}
+
+	topic0 := "0xcc"
+	src.Topic0, src.Topic0S = &topic0, nil
+	src.EvmJsonAbiId, src.EvmJsonAbiIds = 5, nil
+	if _, err := e.UpdateEvmLogSource(ctx, connect.NewRequest(&evm_indexerv1.UpdateEvmLogSourceRequest{Source: src})); err != nil {
+		t.Fatalf("update: %v", err)
+	}
+	got, _ = e.GetEvmLogSource(ctx, connect.NewRequest(&evm_indexerv1.GetEvmLogSourceRequest{Id: created.Msg.Id}))
+	if src := got.Msg.Source; len(src.Topic0S) != 1 || src.Topic0S[0] != "0xcc" || len(src.EvmJsonAbiIds) != 1 || src.EvmJsonAbiIds[0] != 5 {
+		t.Errorf("single values = %v %v", src.Topic0S, src.EvmJsonAbiIds)
+	}
+}
+
+func TestTransactionsSourceFields(t *testing.T) {
+	e := newSourceServer(t)
+	ctx := context.Background()
+
+	if _, err := e.CreateEvmLogSource(ctx, connect.NewRequest(&evm_indexerv1.CreateEvmLogSourceRequest{
+		Source: &evm_indexerv1.EvmLogSource{Type: "TRANSACTIONS", FunctionSelector: "0xa9059c"},
+	})); connect.CodeOf(err) != connect.CodeInvalidArgument {
+		t.Fatalf("short selector: err = %v, want InvalidArgument", err)
+	}
+	if _, err := e.CreateEvmLogSource(ctx, connect.NewRequest(&evm_indexerv1.CreateEvmLogSourceRequest{
+		Source: &evm_indexerv1.EvmLogSource{Type: "TRANSACTIONS", FunctionSelector: "0xa9059cbb"},
+	})); connect.CodeOf(err) != connect.CodeInvalidArgument {
+		t.Fatalf("no address: err = %v, want InvalidArgument", err)
+	}
+
+	created, err := e.CreateEvmLogSource(ctx, connect.NewRequest(&evm_indexerv1.CreateEvmLogSourceRequest{
+		Source: &evm_indexerv1.EvmLogSource{Type: "TRANSACTIONS", Addresses: []string{"0xaa", "0xbb"}, FunctionSelector: "0xA9059CBB"},
+	}))
+	if err != nil {
+		t.Fatalf("create: %v", err)
+	}
+	got, err := e.GetEvmLogSource(ctx, connect.NewRequest(&evm_indexerv1.GetEvmLogSourceRequest{Id: created.Msg.Id}))
+	if err != nil {
+		t.Fatalf("get: %v", err)
+	}
+	src := got.Msg.Source
+	if len(src.Addresses) != 2 || src.Addresses[1] != "0xbb" || src.FunctionSelector != "0xa9059cbb" {
+		t.Errorf("source = %v %q", src.Addresses, src.FunctionSelector)
+	}
+
+	id := created.Msg.Id
+	if _, err := e.UpdateEvmLogSource(ctx, connect.NewRequest(&evm_indexerv1.UpdateEvmLogSourceRequest{
+		Source: &evm_indexerv1.EvmLogSource{Id: &id, Type: "TRANSACTIONS"},
+	})); connect.CodeOf(err) != connect.CodeInvalidArgument {
+		t.Fatalf("update without address: err = %v, want InvalidArgument", err)
+	}
+}
+
+func TestSourceEndBlock(t *testing.T) {
+	e := newSourceServer(t)
+	ctx := context.Background()
+
+	if _, err := e.CreateEvmLogSource(ctx, connect.NewRequest(&evm_indexerv1.CreateEvmLogSourceRequest{
+		Source: &evm_indexerv1.EvmLogSource{Type: "FULL", StartBlock: 100, EndBlock: 99},
+	})); connect.CodeOf(err) != connect.CodeInvalidArgument {
+		t.Fatalf("end before start: err = %v, want InvalidArgument", err)
+	}
+
+	created, err := e.CreateEvmLogSource(ctx, connect.NewRequest(&evm_indexerv1.CreateEvmLogSourceRequest{
+		Source: &evm_indexerv1.EvmLogSource{Type: "FULL", StartBlock: 100, EndBlock: 200},
+	}))
+	if err != nil {
+		t.Fatalf("create: %v", err)
+	}
+	id := created.Msg.Id
+	if _, err := e.UpdateEvmLogSource(ctx, connect.NewRequest(&evm_indexerv1.UpdateEvmLogSourceRequest{
+		Source: &evm_indexerv1.EvmLogSource{Id: &id, Type: "FULL", StartBlock: 100, EndBlock: 300},
+	})); err != nil {
+		t.Fatalf("update: %v", err)
+	}
+	got, err := e.GetEvmLogSource(ctx, connect.NewRequest(&evm_indexerv1.GetEvmLogSourceRequest{Id: id}))
+	if err != nil {
+		t.Fatalf("get: %v", err)
+	}
+	if got.Msg.Source.EndBlock != 300 {
+		t.Errorf("end block = %d, want 300", got.Msg.Source.EndBlock)
+	}
+}
+
+func TestSourceLastError(t *testing.T) {
+	e := newSourceServer(t)
+	ctx := context.Background()
+
+	at := time.Unix(1700000000, 0)
+	source := evmi_database.EvmLogSource{
+		Type: "FULL", Status: "LOOPBACKOFF", LastError: "blocks [10, 20]: boom", LastErrorAt: &at,
+		LastErrorFromBlock: 10, LastErrorToBlock: 20, ConsecutiveFailures: 3,
+	}
+	e.db.Conn.Create(&source)
+
+	got, err := e.GetEvmLogSource(ctx, connect.NewRequest(&evm_indexerv1.GetEvmLogSourceRequest{Id: uint32(source.ID)}))
+	if err != nil {
+		t.Fatalf("get: %v", err)
+	}
+	s := got.Msg.Source
+	if s.Status != "LOOPBACKOFF" || s.LastError != "blocks [10, 20]: boom" || s.GetLastErrorAt() != 1700000000 ||
+		s.LastErrorFromBlock != 10 || s.LastErrorToBlock != 20 || s.ConsecutiveFailures != 3 {
+		t.Errorf("source = %+v", s)
+	}
+
+	// A source that never failed has no error time.
+	healthy := evmi_database.EvmLogSource{Type: "FULL"}
+	e.db.Conn.Create(&healthy)
+	got, err = e.GetEvmLogSource(ctx, connect.NewRequest(&evm_indexerv1.GetEvmLogSourceRequest{Id: uint32(healthy.ID)}))
+	if err != nil {
+		t.Fatalf("get: %v", err)
+	}
+	if got.Msg.Source.LastErrorAt != nil {
+		t.Errorf("last error at = %v, want unset", *got.Msg.Source.LastErrorAt)
+	}
+}
+
+func TestResyncSourceRewindsCursorsAndStoreData(t *testing.T) {
+	e := newSourceServerWithStore(t)
+	ctx := context.Background()
+	if err := e.db.Conn.AutoMigrate(&evmi_database.EvmBlockchain{}, &evmi_database.EvmiExporter{}); err != nil {
+		t.Fatalf("migrate: %v", err)
+	}
+
+	dir := t.TempDir()
+	cfg, _ := json.Marshal(map[string]string{"path": dir})
+	store := evmi_database.EvmLogStore{StoreType: "parquet", StoreConfig: datatypes.JSON(cfg)}
+	e.db.Conn.Create(&store)
+	pipeline := evmi_database.EvmLogPipeline{EvmLogStoreId: store.ID}
+	e.db.Conn.Create(&pipeline)
+
+	factory := evmi_database.EvmLogSource{Type: "FACTORY", Enabled: true, EvmLogPipelineID: pipeline.ID, StartBlock: 10, SyncBlock: 200}
+	e.db.Conn.Create(&factory)
+	child := evmi_database.EvmLogSource{Type: "CONTRACT", EvmLogPipelineID: pipeline.ID, ParentSourceID: factory.ID, StartBlock: 120, SyncBlock: 200}
+	e.db.Conn.Create(&child)
+	e.db.Conn.Create(&evmi_database.EvmProxyImplementation{EvmLogSourceID: factory.ID, FromBlock: 20})
+	e.db.Conn.Create(&evmi_database.EvmProxyImplementation{EvmLogSourceID: factory.ID, FromBlock: 150})
+
+	ps, err := log_stores.LoadStore("parquet", map[string]string{"path": dir}, zerolog.Nop())
+	if err != nil {
+		t.Fatalf("load store: %v", err)
+	}
+	storage := ps.GetStorage()
+	for _, id := range []uint{factory.ID, child.ID} {
+		for _, block := range []uint64{50, 100, 150} {
+			if err := storage.InsertLogs([]types.EvmLog{{Id: fmt.Sprintf("log-%d-%d", id, block), SourceId: id, ChainId: 1, BlockNumber: block}}); err != nil {
+				t.Fatalf("seed logs: %v", err)
+			}
+		}
+	}
+
+	// Exporters past the resync are rewound, running or not, and one behind it
+	// is not. Running ones are also sent the rollback.
+	exporters := []evmi_database.EvmiExporter{
+		{Name: "ahead", EvmLogPipelineID: pipeline.ID, Status: string(evmi_database.StoppedExporterStatus), SyncBlock: 180, SyncLogIndex: 2},
+		{Name: "behind", EvmLogPipelineID: pipeline.ID, Status: string(evmi_database.StoppedExporterStatus), SyncBlock: 90, SyncLogIndex: -1},
+		{Name: "running", EvmLogPipelineID: pipeline.ID, Status: string(evmi_database.RunningExporterStatus), SyncBlock: 180, SyncLogIndex: -1},
+	}
+	for i := range exporters {
+		e.db.Conn.Create(&exporters[i])
+	}
+	var rollbacks []types.SourceRollback
+	var enabled []uint
+	e.bus.RegisterHandler("test-rollback", bus.Handler{
+		Matcher: internal_bus.SourceRollbackTopic,
+		Handle:  func(_ context.Context, ev bus.Event) { rollbacks = append(rollbacks, ev.Data.(types.SourceRollback)) },
+	})
+	e.bus.RegisterHandler("test-enable", bus.Handler{
+		Matcher: internal_bus.EnableSourceTopic,
+		Handle:  func(_ context.Context, ev bus.Event) { enabled = append(enabled, ev.Data.(uint)) },
+	})
+
+	res, err := e.ResyncEvmLogSource(ctx, connect.NewRequest(&evm_indexerv1.ResyncEvmLogSourceRequest{
+		Id: uint32(factory.ID), FromBlock: 100, IncludeChildren: true,
+	}))
+	if err != nil {
+		t.Fatalf("resync: %v", err)
+	}
+	if len(res.Msg.SourceIds) != 2 {
+		t.Errorf("resynced = %v, want the factory and its child", res.Msg.SourceIds)
+	}
+
+	for _, id := range []uint{factory.ID, child.ID} {
+		logs, _ := storage.GetLogs(uint64(id), 0, 1000)
+		if len(logs) != 1 || logs[0].BlockNumber != 50 {
+			t.Errorf("source %d logs = %+v, want only block 50", id, logs)
+		}
+	}
+	var gotFactory, gotChild evmi_database.EvmLogSource
+	e.db.Conn.First(&gotFactory, factory.ID)
+	e.db.Conn.First(&gotChild, child.ID)
+	if gotFactory.SyncBlock != 99 || gotChild.SyncBlock != 120 {
+		t.Errorf("cursors = %d, %d; want 99 and the child's start block 120", gotFactory.SyncBlock, gotChild.SyncBlock)
+	}
+	var proxies int64
+	e.db.Conn.Model(&evmi_database.EvmProxyImplementation{}).Count(&proxies)
+	if proxies != 1 {
+		t.Errorf("proxy implementations = %d, want the one below the resync", proxies)
+	}
+
+	want := map[string][2]int64{"ahead": {99, -1}, "behind": {90, -1}, "running": {99, -1}}
+	for name, cursor := range want {
+		var got evmi_database.EvmiExporter
+		e.db.Conn.Where("name = ?", name).First(&got)
+		if int64(got.SyncBlock) != cursor[0] || got.SyncLogIndex != cursor[1] {
+			t.Errorf("exporter %s cursor = (%d,%d), want %v", name, got.SyncBlock, got.SyncLogIndex, cursor)
+		}
+	}
+	if len(rollbacks) != 2 || rollbacks[0].SourceId != factory.ID || rollbacks[0].FromBlock != 100 || rollbacks[0].PipelineId != pipeline.ID {
+		t.Errorf("rollbacks = %+v", rollbacks)
+	}
+	// Only the enabled factory is started again.
+	if len(enabled) != 1 || enabled[0] != factory.ID {
+		t.Errorf("enabled = %v, want the factory", enabled)
+	}
+}
+
+func TestResyncSourceResumesSourcesOnFailure(t *testing.T) {
+	e := newSourceServerWithStore(t)
+	if err := e.db.Conn.AutoMigrate(&evmi_database.EvmBlockchain{}, &evmi_database.EvmiExporter{}); err != nil {
+		t.Fatalf("migrate: %v", err)
+	}
+	store := evmi_database.EvmLogStore{StoreType: "unknown", StoreConfig: datatypes.JSON(`{}`)}
+	e.db.Conn.Create(&store)
+	pipeline := evmi_database.EvmLogPipeline{EvmLogStoreId: store.ID}
+	e.db.Conn.Create(&pipeline)
+	source := evmi_database.EvmLogSource{Type: "CONTRACT", Enabled: true, EvmLogPipelineID: pipeline.ID, SyncBlock: 200}
+	e.db.Conn.Create(&source)
+
+	var enabled []uint
+	e.bus.RegisterHandler("test-enable", bus.Handler{
+		Matcher: internal_bus.EnableSourceTopic,
+		Handle:  func(_ context.Context, ev bus.Event) { enabled = append(enabled, ev.Data.(uint)) },
+	})
+
+	if _, err := e.ResyncEvmLogSource(context.Background(), connect.NewRequest(&evm_indexerv1.ResyncEvmLogSourceRequest{
+		Id: uint32(source.ID), FromBlock: 100,
+	})); err == nil {
+		t.Fatal("resync succeeded without a loadable store")
+	}
+	if len(enabled) != 1 || enabled[0] != source.ID {
+		t.Errorf("enabled = %v, want the source started again", enabled)
+	}
+}
@@ -19,7 +19,8 @@ import (
 // the pipeline filter is honored.
 func TestStreamEvmLogSourceUpdates(t *testing.T) {
 	b := internal_bus.InitializeBus()
-	server := &EvmIndexerServer{bus: b, logger: zerolog.Nop()}
+	server := newServerForTest(t)
+	server.bus = b
 
 	mux := http.NewServeMux()
 	path, handler := evm_indexerv1connect.NewEvmIndexerServiceHandler(server)
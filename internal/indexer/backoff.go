@@ -0,0 +1,90 @@
+package indexer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	evmi_database "github.com/evmi-cloud/go-evm-indexer/internal/database/evmi-database"
+)
+
+const (
+	// failureBackoffBase is the wait after a source's first consecutive
+	// failure, doubled on each of the next ones up to failureBackoffMax.
+	failureBackoffBase = 5 * time.Second
+	failureBackoffMax  = 5 * time.Minute
+)
+
+// rangeError is an error indexing the blocks [from, to].
+type rangeError struct {
+	from, to uint64
+	err      error
+}
+
+func (e *rangeError) Error() string {
+	return fmt.Sprintf("blocks [%d, %d]: %s", e.from, e.to, e.err.Error())
+}
+
+func (e *rangeError) Unwrap() error {
+	return e.err
+}
+
+// failureBackoff returns the wait before restarting a source after its
+// failures-th consecutive failure.
+func failureBackoff(failures uint) time.Duration {
+	delay := failureBackoffBase
+	for i := uint(1); i < failures && delay < failureBackoffMax; i++ {
+		delay *= 2
+	}
+	return min(delay, failureBackoffMax)
+}
+
+// failed records err as the source's last error, with the range it failed on
+// when it is a rangeError, sets the LOOPBACKOFF status and waits the backoff
+// of the source's consecutive failures before returning err for the
+// supervisor to restart it. A stop during the wait returns the source to
+// STOPPED instead. The error is returned even when it cannot be recorded.
+func (p *SourceIndexerService) failed(ctx context.Context, err error) error {
+	now := time.Now()
+	var from, to uint64
+	var failing *rangeError
+	if errors.As(err, &failing) {
+		from, to = failing.from, failing.to
+	}
+
+	p.source.Status = string(evmi_database.LoopbackOffLogSourceStatus)
+	p.source.LastError = err.Error()
+	p.source.LastErrorAt = &now
+	p.source.LastErrorFromBlock = from
+	p.source.LastErrorToBlock = to
+	p.source.ConsecutiveFailures++
+	// Column-scoped, as the manager owns the other columns of the row.
+	if tx := p.db.Conn.Model(&p.source).Updates(map[string]interface{}{
+		"status":                p.source.Status,
+		"last_error":            p.source.LastError,
+		"last_error_at":         p.source.LastErrorAt,
+		"last_error_from_block": from,
+		"last_error_to_block":   to,
+		"consecutive_failures":  p.source.ConsecutiveFailures,
+	}); tx.Error != nil {
+		p.logger.Error().Msg("recording the source failure failed: " + tx.Error.Error())
+	}
+	p.metrics.IncSourceFailures(p.sourceLabels())
+	p.emitSourceUpdate()
+
+	delay := failureBackoff(p.source.ConsecutiveFailures)
+	p.logger.Error().
+		Uint("failures", p.source.ConsecutiveFailures).
+		Dur("backoff", delay).
+		Msg("source failed: " + err.Error())
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return err
+	case <-ctx.Done():
+		return p.markStopped()
+	}
+}
@@ -0,0 +1,65 @@
+package indexer
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	internal_bus "github.com/evmi-cloud/go-evm-indexer/internal/bus"
+	evmi_database "github.com/evmi-cloud/go-evm-indexer/internal/database/evmi-database"
+	"github.com/mustafaturan/bus/v3"
+)
+
+func TestFailureBackoffDoublesUpToTheMax(t *testing.T) {
+	for failures, want := range map[uint]time.Duration{
+		1:   5 * time.Second,
+		2:   10 * time.Second,
+		3:   20 * time.Second,
+		7:   failureBackoffMax,
+		100: failureBackoffMax,
+	} {
+		if got := failureBackoff(failures); got != want {
+			t.Errorf("failureBackoff(%d) = %v, want %v", failures, got, want)
+		}
+	}
+}
+
+func TestFailedRecordsTheErrorUntilARangeIsIndexed(t *testing.T) {
+	p := newSourceIndexerForTest(t, evmi_database.EvmLogSource{Enabled: true, Type: string(evmi_database.ContractLogSourceType)})
+	var updates []evmi_database.EvmLogSource
+	p.bus.RegisterHandler("test-source-failure", bus.Handler{
+		Matcher: internal_bus.SourceUpdateTopic,
+		Handle: func(_ context.Context, e bus.Event) {
+			updates = append(updates, e.Data.(evmi_database.EvmLogSource))
+		},
+	})
+
+	// Stopped during the backoff: the source ends STOPPED with the error kept.
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	for range 2 {
+		if err := p.failed(ctx, &rangeError{from: 10, to: 20, err: errors.New("boom")}); err != nil {
+			t.Fatalf("failed: %v", err)
+		}
+	}
+
+	var source evmi_database.EvmLogSource
+	p.db.Conn.First(&source, p.source.ID)
+	if source.LastError != "blocks [10, 20]: boom" || source.LastErrorAt == nil || source.LastErrorFromBlock != 10 ||
+		source.LastErrorToBlock != 20 || source.ConsecutiveFailures != 2 || source.Status != string(evmi_database.StoppedLogSourceStatus) {
+		t.Errorf("source = %+v", source)
+	}
+	if len(updates) == 0 || updates[0].Status != string(evmi_database.LoopbackOffLogSourceStatus) || updates[0].LastError == "" {
+		t.Errorf("updates = %+v, want the LOOPBACKOFF status streamed first", updates)
+	}
+
+	if err := p.commitRange(ctx, nil, &indexedRange{from: 21, to: 30}, 100); err != nil {
+		t.Fatalf("commit: %v", err)
+	}
+	p.db.Conn.First(&source, p.source.ID)
+	if source.ConsecutiveFailures != 0 || source.Status != string(evmi_database.RunningLogSourceStatus) ||
+		source.SyncBlock != 30 || source.LastError == "" {
+		t.Errorf("after a range indexed: %+v", source)
+	}
+}
@@ -0,0 +1,283 @@
+package indexer
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	ethTypes "github.com/ethereum/go-ethereum/core/types"
+	evmi_database "github.com/evmi-cloud/go-evm-indexer/internal/database/evmi-database"
+)
+
+const (
+	// logsBatchGatherDelay is how long a batched range waits for the other
+	// sources of its group at the same cursor before it is fetched without
+	// them.
+	logsBatchGatherDelay = 500 * time.Millisecond
+	// defaultLogsMaxAddresses is the number of addresses per eth_getLogs call
+	// of a batched range when the chain sets no LogsMaxAddresses.
+	defaultLogsMaxAddresses = 1000
+)
+
+// batchedLogs is the share of a batched eth_getLogs range returned to one
+// source: its logs over [from, to]. to may be lower than the source asked for
+// when another source of the call had a smaller window or an older head.
+type batchedLogs struct {
+	logs []ethTypes.Log
+	to   uint64
+}
+
+type logsBatchKey struct {
+	pipelineId uint
+	chainId    uint
+}
+
+// logsBatches holds one logsBatch per pipeline and blockchain.
+type logsBatches struct {
+	mu     sync.Mutex
+	groups map[logsBatchKey]*logsBatch
+}
+
+func newLogsBatches() *logsBatches {
+	return &logsBatches{groups: make(map[logsBatchKey]*logsBatch)}
+}
+
+// join adds p to the group of its pipeline and chain. Only sources filtering
+// on their own address (CONTRACT and FACTORY, factory children included) are
+// batched: the others, and every source when the registry is nil, get a nil
+// group and fetch their ranges alone. Every join must be paired with a leave.
+func (r *logsBatches) join(p *SourceIndexerService, next uint64) *logsBatch {
+	if r == nil || !p.source.Address.Valid {
+		return nil
+	}
+	switch p.source.Type {
+	case string(evmi_database.ContractLogSourceType), string(evmi_database.FactoryLogSourceType):
+	default:
+		return nil
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	key := logsBatchKey{pipelineId: p.pipeline.ID, chainId: p.chain.ID}
+	b, ok := r.groups[key]
+	if !ok {
+		b = &logsBatch{members: make(map[uint]uint64), pending: make(map[uint64]*logsBatchCall)}
+		r.groups[key] = b
+	}
+	b.mu.Lock()
+	b.members[p.source.ID] = next
+	b.mu.Unlock()
+	return b
+}
+
+func (r *logsBatches) leave(b *logsBatch, p *SourceIndexerService) {
+	if r == nil || b == nil {
+		return
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	delete(b.members, p.source.ID)
+	if len(b.members) == 0 {
+		delete(r.groups, logsBatchKey{pipelineId: p.pipeline.ID, chainId: p.chain.ID})
+	}
+}
+
+// logsBatch coalesces the eth_getLogs ranges of the address sources of one
+// pipeline and chain. Sources whose next range starts at the same block are
+// fetched together with an address list; a source still catching up fetches
+// alone (or with whoever shares its cursor) and joins the others once it
+// reaches their cursor. A range is fetched as soon as every member at its
+// cursor asked for it, or after logsBatchGatherDelay.
+type logsBatch struct {
+	mu sync.Mutex
+	// members is the next block of each member source, by source id.
+	members map[uint]uint64
+	// pending are the calls still gathering sources, by first block.
+	pending map[uint64]*logsBatchCall
+}
+
+// logsBatchCall is one batched range. Its first source leads it: once the call
+// fires, the eth_getLogs calls run on the leader's window and endpoint pool
+// while every source of the call waits for done. The call runs on its own
+// goroutine and context, so a source that gives up waiting does not hold the
+// others, and is cancelled once its last waiter left.
+type logsBatchCall struct {
+	from, to uint64
+	leader   *SourceIndexerService
+	client   *rpcPool
+	sources  map[uint]common.Address
+	timer    *time.Timer
+	fired    bool
+	// waiters counts the sources inside fetch for the call, guarded by the
+	// batch's mutex.
+	waiters int
+	ctx     context.Context
+	cancel  context.CancelFunc
+
+	done chan struct{}
+	logs map[common.Address][]ethTypes.Log
+	err  error
+}
+
+// moved records the next block of p, after it indexed or rolled back a range.
+func (b *logsBatch) moved(p *SourceIndexerService, next uint64) {
+	if b == nil {
+		return
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.members[p.source.ID] = next
+}
+
+// fetch returns p's logs over [from, to] or a prefix of it, from a range
+// shared with the other sources of the group at the same cursor. A source
+// alone at its cursor fetches right away, without waiting for the gather
+// delay. fetch returns ctx's error as soon as ctx is done.
+func (b *logsBatch) fetch(ctx context.Context, p *SourceIndexerService, client *rpcPool, from, to uint64) (*batchedLogs, error) {
+	address := common.HexToAddress(p.source.Address.String)
+
+	b.mu.Lock()
+	b.members[p.source.ID] = from
+	call, ok := b.pending[from]
+	if !ok {
+		call = &logsBatchCall{
+			from:    from,
+			to:      to,
+			leader:  p,
+			client:  client,
+			sources: make(map[uint]common.Address),
+			done:    make(chan struct{}),
+		}
+		call.ctx, call.cancel = context.WithCancel(context.Background())
+		if b.shared(p, from) {
+			b.pending[from] = call
+			call.timer = time.AfterFunc(logsBatchGatherDelay, func() {
+				b.mu.Lock()
+				defer b.mu.Unlock()
+				b.fire(call)
+			})
+		}
+	}
+	call.to = min(call.to, to)
+	call.sources[p.source.ID] = address
+	call.waiters++
+	if b.gathered(call) {
+		b.fire(call)
+	}
+	b.mu.Unlock()
+	defer b.release(call)
+
+	select {
+	case <-call.done:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+	if call.err != nil {
+		return nil, call.err
+	}
+	return &batchedLogs{logs: call.logs[address], to: call.to}, nil
+}
+
+// release drops a waiter of call, once it got its logs or gave up. The last
+// one cancels the call, which nobody is left to use, and withdraws it when it
+// is still gathering sources.
+func (b *logsBatch) release(call *logsBatchCall) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	call.waiters--
+	if call.waiters > 0 {
+		return
+	}
+	if !call.fired {
+		call.fired = true
+		if call.timer != nil {
+			call.timer.Stop()
+		}
+		if b.pending[call.from] == call {
+			delete(b.pending, call.from)
+		}
+	}
+	call.cancel()
+}
+
+// shared reports whether another member of the group is at from.
+func (b *logsBatch) shared(p *SourceIndexerService, from uint64) bool {
+	for id, next := range b.members {
+		if id != p.source.ID && next == from {
+			return true
+		}
+	}
+	return false
+}
+
+// gathered reports whether every member at the call's cursor joined it.
+func (b *logsBatch) gathered(call *logsBatchCall) bool {
+	for id, next := range b.members {
+		if _, ok := call.sources[id]; next == call.from && !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// fire closes the call to new sources and starts it.
+func (b *logsBatch) fire(call *logsBatchCall) {
+	if call.fired {
+		return
+	}
+	call.fired = true
+	if call.timer != nil {
+		call.timer.Stop()
+	}
+	if b.pending[call.from] == call {
+		delete(b.pending, call.from)
+	}
+	go call.run()
+}
+
+// run fetches the call's range for all of its addresses, in chunks of the
+// chain's LogsMaxAddresses, and splits the logs by address. The call serves
+// several sources, so it runs on its own context rather than any of theirs:
+// see release.
+func (c *logsBatchCall) run() {
+	defer close(c.done)
+
+	seen := make(map[common.Address]bool, len(c.sources))
+	addresses := make([]common.Address, 0, len(c.sources))
+	for _, address := range c.sources {
+		if !seen[address] {
+			seen[address] = true
+			addresses = append(addresses, address)
+		}
+	}
+
+	chunkSize := int(c.leader.chain.LogsMaxAddresses)
+	if chunkSize == 0 {
+		chunkSize = defaultLogsMaxAddresses
+	}
+
+	if len(c.sources) > 1 {
+		c.leader.logger.Info().Msg(fmt.Sprintf("Fetch logs [%d, %d] for %d batched sources", c.from, c.to, len(c.sources)))
+	}
+
+	c.logs = make(map[common.Address][]ethTypes.Log, len(addresses))
+	for start := 0; start < len(addresses); start += chunkSize {
+		chunk := addresses[start:min(start+chunkSize, len(addresses))]
+		logs, err := c.leader.getLogs(c.ctx, c.client, func(fromBlock, toBlock *big.Int) ethereum.FilterQuery {
+			return ethereum.FilterQuery{FromBlock: fromBlock, ToBlock: toBlock, Addresses: chunk}
+		}, c.from, c.to)
+		if err != nil {
+			c.err = err
+			return
+		}
+		for _, log := range logs {
+			c.logs[log.Address] = append(c.logs[log.Address], log)
+		}
+	}
+}
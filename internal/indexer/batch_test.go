@@ -0,0 +1,191 @@
+package indexer
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	evmi_database "github.com/evmi-cloud/go-evm-indexer/internal/database/evmi-database"
+)
+
+// addressLogsNode answers eth_getLogs with one log per requested address, at
+// the range's first block, recording the address count of each call.
+type addressLogsNode struct {
+	mu    sync.Mutex
+	calls []int
+}
+
+func (n *addressLogsNode) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		ID     json.RawMessage `json:"id"`
+		Params []struct {
+			FromBlock hexutil.Uint64   `json:"fromBlock"`
+			Address   []common.Address `json:"address"`
+		} `json:"params"`
+	}
+	_ = json.NewDecoder(r.Body).Decode(&req)
+	filter := req.Params[0]
+
+	n.mu.Lock()
+	n.calls = append(n.calls, len(filter.Address))
+	n.mu.Unlock()
+
+	logs := []map[string]any{}
+	for i, address := range filter.Address {
+		logs = append(logs, map[string]any{
+			"address":          address,
+			"topics":           []common.Hash{},
+			"data":             "0x",
+			"blockNumber":      filter.FromBlock,
+			"transactionHash":  common.BigToHash(common.Big1),
+			"transactionIndex": "0x0",
+			"blockHash":        common.BigToHash(common.Big2),
+			"logIndex":         hexutil.Uint(i),
+			"removed":          false,
+		})
+	}
+	result, _ := json.Marshal(logs)
+	w.Header().Set("Content-Type", "application/json")
+	fmt.Fprintf(w, `{"jsonrpc":"2.0","id":%s,"result":%s}`, req.ID, result)
+}
+
+func newBatchedSource(t *testing.T, id uint, address common.Address) *SourceIndexerService {
+	t.Helper()
+	p := newDecoderForTest(t, erc20TransferAbi)
+	p.source.ID = id
+	p.source.Address = sql.NullString{String: address.Hex(), Valid: true}
+	p.pipeline.ID = 1
+	p.chain.ID = 1
+	p.chain.BlockRange = 100
+	p.chain.LogsMaxAddresses = 2
+	return p
+}
+
+func TestLogsBatchCoalescesSourcesAtTheSameCursor(t *testing.T) {
+	node := &addressLogsNode{}
+	server := httptest.NewServer(node)
+	defer server.Close()
+	client := newTestPool(t, server.URL)
+
+	batches := newLogsBatches()
+	addresses := []common.Address{
+		common.HexToAddress("0x00000000000000000000000000000000000000a1"),
+		common.HexToAddress("0x00000000000000000000000000000000000000a2"),
+		common.HexToAddress("0x00000000000000000000000000000000000000a3"),
+	}
+	sources := make([]*SourceIndexerService, len(addresses))
+	var batch *logsBatch
+	for i, address := range addresses {
+		sources[i] = newBatchedSource(t, uint(i+1), address)
+		batch = batches.join(sources[i], 101)
+	}
+	// A TOPIC source of the same pipeline is not batched.
+	topic := newDecoderForTest(t, erc20TransferAbi)
+	topic.source.Type = string(evmi_database.TopicLogSourceType)
+	if batches.join(topic, 101) != nil {
+		t.Fatal("a TOPIC source should not join a batch")
+	}
+
+	// One source has a smaller window: the whole call stops where it does.
+	tos := []uint64{150, 150, 140}
+	results := make([]*batchedLogs, len(sources))
+	var wg sync.WaitGroup
+	for i, p := range sources {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			got, err := batch.fetch(context.Background(), p, client, 101, tos[i])
+			if err != nil {
+				t.Errorf("fetch %d: %v", i, err)
+				return
+			}
+			results[i] = got
+		}()
+	}
+	wg.Wait()
+
+	for i, got := range results {
+		if got == nil {
+			t.FailNow()
+		}
+		if got.to != 140 || len(got.logs) != 1 || got.logs[0].Address != addresses[i] {
+			t.Errorf("source %d got to=%d logs=%v, want its own log up to 140", i, got.to, got.logs)
+		}
+	}
+	// Three addresses, two per call.
+	if len(node.calls) != 2 || node.calls[0]+node.calls[1] != 3 {
+		t.Errorf("eth_getLogs calls = %v, want 2 calls covering 3 addresses", node.calls)
+	}
+
+	// A source still catching up fetches alone without waiting for the group.
+	for _, p := range sources[:2] {
+		batch.moved(p, 141)
+	}
+	batch.moved(sources[2], 51)
+	node.calls = nil
+	start := time.Now()
+	got, err := batch.fetch(context.Background(), sources[2], client, 51, 100)
+	if err != nil {
+		t.Fatalf("fetch: %v", err)
+	}
+	if elapsed := time.Since(start); elapsed >= logsBatchGatherDelay {
+		t.Errorf("catching-up source waited %s for the group", elapsed)
+	}
+	if got.to != 100 || len(got.logs) != 1 || len(node.calls) != 1 || node.calls[0] != 1 {
+		t.Errorf("got to=%d logs=%d calls=%v, want a lone call to 100", got.to, len(got.logs), node.calls)
+	}
+
+	for _, p := range sources {
+		batches.leave(batch, p)
+	}
+	if len(batches.groups) != 0 {
+		t.Error("group should be dropped with its last source")
+	}
+}
+
+func TestLogsBatchFetchReturnsOnCancel(t *testing.T) {
+	node := &addressLogsNode{}
+	server := httptest.NewServer(node)
+	defer server.Close()
+	client := newTestPool(t, server.URL)
+
+	batches := newLogsBatches()
+	first := newBatchedSource(t, 1, common.HexToAddress("0x00000000000000000000000000000000000000a1"))
+	second := newBatchedSource(t, 2, common.HexToAddress("0x00000000000000000000000000000000000000a2"))
+	batch := batches.join(first, 101)
+	batches.join(second, 101)
+	defer batches.leave(batch, first)
+	defer batches.leave(batch, second)
+
+	// The second source never asks for the range: the first one gives up
+	// waiting for it as soon as its context is done.
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(10*time.Millisecond, cancel)
+	start := time.Now()
+	if _, err := batch.fetch(ctx, first, client, 101, 150); err != context.Canceled {
+		t.Fatalf("err = %v, want context.Canceled", err)
+	}
+	if elapsed := time.Since(start); elapsed >= logsBatchGatherDelay {
+		t.Errorf("cancelled fetch returned after %s", elapsed)
+	}
+
+	// Its last waiter gone, the call is withdrawn instead of fetched.
+	time.Sleep(logsBatchGatherDelay + 50*time.Millisecond)
+	batch.mu.Lock()
+	pending := len(batch.pending)
+	batch.mu.Unlock()
+	node.mu.Lock()
+	calls := len(node.calls)
+	node.mu.Unlock()
+	if pending != 0 || calls != 0 {
+		t.Errorf("pending calls = %d, eth_getLogs calls = %d, want the abandoned call dropped", pending, calls)
+	}
+}
@@ -0,0 +1,98 @@
+package indexer
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethTypes "github.com/ethereum/go-ethereum/core/types"
+	evmi_database "github.com/evmi-cloud/go-evm-indexer/internal/database/evmi-database"
+	"github.com/evmi-cloud/go-evm-indexer/internal/types"
+	"github.com/lmittmann/w3/module/eth"
+	"github.com/lmittmann/w3/w3types"
+)
+
+// scansBlocks reports whether the source reads blocks or traces rather than
+// logs: TRANSACTIONS, BLOCKS and TRACES sources neither use the SQD archive nor
+// subscribe to logs.
+func (p *SourceIndexerService) scansBlocks() bool {
+	switch p.source.Type {
+	case string(evmi_database.TransactionsLogSourceType), string(evmi_database.BlocksLogSourceType), string(evmi_database.TracesLogSourceType):
+		return true
+	}
+	return false
+}
+
+// fetchBlocks returns the headers of [from, to] for a BLOCKS source, with the
+// transaction count of each block. Both are batched by RpcMaxBatchSize.
+func (p *SourceIndexerService) fetchBlocks(ctx context.Context, client *rpcPool, from, to uint64) ([]types.EvmBlock, error) {
+	headers, err := p.loadHeadersByNumber(ctx, client, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	counts := make([]uint, len(headers))
+	request := make([]w3types.RPCCaller, len(headers))
+	for i := range headers {
+		request[i] = eth.BlockTxCountByNumber(new(big.Int).SetUint64(from + uint64(i))).Returns(&counts[i])
+	}
+	if err := p.callInBatches(ctx, client, "eth_getBlockTransactionCountByNumber", request); err != nil {
+		return nil, err
+	}
+
+	blocks := make([]types.EvmBlock, len(headers))
+	for i, header := range headers {
+		blocks[i] = p.toEvmBlock(header, uint64(counts[i]))
+	}
+	return blocks, nil
+}
+
+func (p *SourceIndexerService) toEvmBlock(header *ethTypes.Header, transactionCount uint64) types.EvmBlock {
+	block := types.EvmBlock{
+		Id:               fmt.Sprintf("%d:%d:%d", p.chain.ChainId, p.source.ID, header.Number.Uint64()),
+		SourceId:         p.source.ID,
+		ChainId:          p.chain.ChainId,
+		BlockNumber:      header.Number.Uint64(),
+		BlockTimestamp:   header.Time,
+		Hash:             header.Hash().Hex(),
+		ParentHash:       header.ParentHash.Hex(),
+		Miner:            header.Coinbase.Hex(),
+		GasUsed:          header.GasUsed,
+		GasLimit:         header.GasLimit,
+		TransactionCount: transactionCount,
+		LogsBloom:        hexutil.Encode(header.Bloom[:]),
+	}
+	if header.BaseFee != nil {
+		block.BaseFeePerGas = header.BaseFee.String()
+	}
+	if header.BlobGasUsed != nil {
+		block.BlobGasUsed = *header.BlobGasUsed
+	}
+	if header.ExcessBlobGas != nil {
+		block.ExcessBlobGas = *header.ExcessBlobGas
+	}
+	return block
+}
+
+// loadStoredHashes fills the source's block hash window with the blocks a
+// BLOCKS source stored up to cursor, so a reorg across a process restart is
+// detected against them instead of going unnoticed.
+func (p *SourceIndexerService) loadStoredHashes(cursor uint64) error {
+	if p.recentBlocks == nil {
+		p.recentBlocks = newBlockHashWindow()
+	}
+	from := uint64(0)
+	if cursor >= reorgTrackingDepth {
+		from = cursor - reorgTrackingDepth + 1
+	}
+	blocks, err := p.store.GetStorage().GetBlocks(uint64(p.source.ID), from, cursor)
+	if err != nil {
+		return err
+	}
+	for _, block := range blocks {
+		p.recentBlocks.record(block.BlockNumber, common.HexToHash(block.Hash))
+	}
+	return nil
+}
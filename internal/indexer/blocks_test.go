@@ -0,0 +1,118 @@
+package indexer
+
+import (
+	"context"
+	"math/big"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	evmi_database "github.com/evmi-cloud/go-evm-indexer/internal/database/evmi-database"
+	log_stores "github.com/evmi-cloud/go-evm-indexer/internal/database/log-stores"
+	"github.com/evmi-cloud/go-evm-indexer/internal/types"
+	"github.com/rs/zerolog"
+)
+
+func TestFetchBlocksReadsHeadersAndCounts(t *testing.T) {
+	signer := ethTypes.LatestSignerForChainID(big.NewInt(1))
+	key, _ := crypto.GenerateKey()
+	tx := func(nonce uint64) *ethTypes.Transaction {
+		return ethTypes.MustSignNewTx(key, signer, &ethTypes.DynamicFeeTx{
+			ChainID: big.NewInt(1), Nonce: nonce, Gas: 21000, GasFeeCap: big.NewInt(1), GasTipCap: big.NewInt(1),
+		})
+	}
+	miner := common.HexToAddress("0x00000000000000000000000000000000000000aa")
+	block := func(number uint64, baseFee *big.Int, txs ...*ethTypes.Transaction) *ethTypes.Block {
+		header := &ethTypes.Header{
+			Number: new(big.Int).SetUint64(number), Time: 1000 + number, Difficulty: big.NewInt(0),
+			Coinbase: miner, GasUsed: 21000 * uint64(len(txs)), GasLimit: 30000000, BaseFee: baseFee,
+		}
+		return ethTypes.NewBlockWithHeader(header).WithBody(ethTypes.Body{Transactions: txs})
+	}
+	node := &blocksNode{
+		blocks: map[uint64]*ethTypes.Block{
+			10: block(10, nil, tx(0), tx(1)),
+			11: block(11, big.NewInt(7), tx(2)),
+			12: block(12, big.NewInt(8)),
+		},
+		requests: map[string]int{},
+	}
+	server := httptest.NewServer(node)
+	defer server.Close()
+
+	p := &SourceIndexerService{
+		source: evmi_database.EvmLogSource{Type: string(evmi_database.BlocksLogSourceType)},
+		chain:  evmi_database.EvmBlockchain{ChainId: 1, RpcMaxBatchSize: 2},
+		logger: zerolog.Nop(),
+	}
+	p.source.ID = 4
+
+	blocks, err := p.fetchBlocks(context.Background(), newTestPool(t, server.URL), 10, 12)
+	if err != nil {
+		t.Fatalf("fetch: %v", err)
+	}
+	if len(blocks) != 3 {
+		t.Fatalf("blocks = %+v", blocks)
+	}
+	first := blocks[0]
+	if first.Id != "1:4:10" || first.SourceId != 4 || first.Hash != node.blocks[10].Hash().Hex() ||
+		first.BlockTimestamp != 1010 || first.Miner != miner.Hex() || first.TransactionCount != 2 ||
+		first.GasUsed != 42000 || first.BaseFeePerGas != "" {
+		t.Errorf("block 10 = %+v", first)
+	}
+	if blocks[1].ParentHash != node.blocks[11].ParentHash().Hex() || blocks[1].BaseFeePerGas != "7" ||
+		blocks[1].TransactionCount != 1 || blocks[2].TransactionCount != 0 {
+		t.Errorf("blocks 11-12 = %+v", blocks[1:])
+	}
+	// Three blocks in batches of two, for the headers and the counts.
+	if node.requests["eth_getBlockByNumber"] != 2 || node.requests["eth_getBlockTransactionCountByNumber"] != 2 {
+		t.Errorf("requests = %v", node.requests)
+	}
+}
+
+// blocksStore serves GetBlocks from a fixed list; other methods are unused.
+type blocksStore struct {
+	log_stores.EvmIndexerStorage
+	blocks   []types.EvmBlock
+	from, to uint64
+}
+
+func (s *blocksStore) GetBlocks(sourceId uint64, fromBlock uint64, toBlock uint64) ([]types.EvmBlock, error) {
+	s.from, s.to = fromBlock, toBlock
+	var blocks []types.EvmBlock
+	for _, block := range s.blocks {
+		if block.BlockNumber >= fromBlock && block.BlockNumber <= toBlock {
+			blocks = append(blocks, block)
+		}
+	}
+	return blocks, nil
+}
+
+func TestLoadStoredHashesSeedsReorgWindow(t *testing.T) {
+	cursor := uint64(reorgTrackingDepth + 50)
+	hash := common.HexToHash("0x01")
+	store := &blocksStore{blocks: []types.EvmBlock{
+		{BlockNumber: cursor - 1, Hash: hash.Hex()},
+		{BlockNumber: cursor, Hash: hash.Hex()},
+	}}
+	p := &SourceIndexerService{
+		source: evmi_database.EvmLogSource{Type: string(evmi_database.BlocksLogSourceType)},
+		store:  log_stores.NewIndexerStore(store),
+		logger: zerolog.Nop(),
+	}
+
+	if err := p.loadStoredHashes(cursor); err != nil {
+		t.Fatalf("load: %v", err)
+	}
+	if store.from != 51 || store.to != cursor {
+		t.Errorf("GetBlocks(%d, %d), want the last %d blocks", store.from, store.to, reorgTrackingDepth)
+	}
+	if recorded, ok := p.recentBlocks.hash(cursor); !ok || recorded != hash {
+		t.Errorf("hash(%d) = %v, %v", cursor, recorded, ok)
+	}
+	if lowest, ok := p.recentBlocks.lowest(); !ok || lowest != cursor-1 {
+		t.Errorf("lowest = %d, %v", lowest, ok)
+	}
+}
@@ -0,0 +1,155 @@
+package indexer
+
+import (
+	"context"
+	"math/big"
+	"sync"
+
+	"github.com/ethereum/go-ethereum"
+)
+
+// catchUpRangesPerWorker is how many fetched ranges per worker may wait for
+// the ranges below them to be committed. It bounds the memory a slow range
+// holds up.
+const catchUpRangesPerWorker = 2
+
+// catchUpWorkers returns the number of ranges the source fetches concurrently
+// while catching up, 1 when it indexes sequentially. Proxy sources always do:
+// their logs decode with the implementation history read from the ranges
+// before them.
+func (p *SourceIndexerService) catchUpWorkers() int {
+	if p.proxy != nil || p.source.CatchUpWorkers <= 1 {
+		return 1
+	}
+	return int(p.source.CatchUpWorkers)
+}
+
+// catchUpCeiling returns the last block the catch-up workers index from block
+// from: lastBlock, or the last block below the reorg tracking depth of head if
+// lower. Near the head the source indexes sequentially, with its ranges
+// checked for reorgs: ok is false when fewer than two ranges are left below
+// the ceiling, or when the source has a single worker.
+func (p *SourceIndexerService) catchUpCeiling(from, lastBlock, head uint64) (uint64, bool) {
+	if p.catchUpWorkers() <= 1 || head < reorgTrackingDepth {
+		return 0, false
+	}
+	ceiling := min(head-reorgTrackingDepth, lastBlock)
+	if ceiling < from || ceiling-from+1 < 2*p.logsWindow() {
+		return 0, false
+	}
+	return ceiling, true
+}
+
+// catchUp indexes [from, to] with the source's catch-up workers fetching and
+// decoding ranges concurrently. The ranges are committed by the calling
+// goroutine, strictly in block order: a range fetched before the ones below it
+// is held until they are committed, so the cursor never skips a block and a
+// FACTORY source registers its children in the order they were created. A
+// failed range stops handing out new ones: the ranges below it are still
+// committed, then its error is returned, the cursor standing just before it.
+// The ranges are below the reorg tracking depth and are not checked for
+// reorgs; a batched source fetches them alone and rejoins its group at the
+// cursor it reaches.
+func (p *SourceIndexerService) catchUp(ctx context.Context, client *rpcPool, filter func(fromBlock, toBlock *big.Int) ethereum.FilterQuery, batch *logsBatch, from, to, head uint64) error {
+	workers := p.catchUpWorkers()
+	ctx, cancel := context.WithCancel(ctx)
+	dispatchCtx, stopDispatch := context.WithCancel(ctx)
+
+	type blockRange struct{ from, to uint64 }
+	type fetchResult struct {
+		from    uint64
+		fetched *indexedRange
+		err     error
+	}
+	ranges := make(chan blockRange)
+	results := make(chan fetchResult)
+	// A slot is taken per range handed out and given back once it is
+	// committed.
+	slots := make(chan struct{}, workers*catchUpRangesPerWorker)
+
+	go func() {
+		defer close(ranges)
+		for i := from; i <= to; {
+			end := min(i+p.logsWindow()-1, to)
+			// Split at the archive height, as the sequential loop does.
+			if i <= p.sqdHeight && end > p.sqdHeight {
+				end = p.sqdHeight
+			}
+			select {
+			case slots <- struct{}{}:
+			case <-dispatchCtx.Done():
+				return
+			}
+			select {
+			case ranges <- blockRange{from: i, to: end}:
+			case <-dispatchCtx.Done():
+				return
+			}
+			i = end + 1
+		}
+	}()
+
+	var wg sync.WaitGroup
+	for range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for r := range ranges {
+				fetched, err := p.fetchIndexedRange(ctx, client, filter, nil, r.from, r.to)
+				if err != nil {
+					err = &rangeError{from: r.from, to: r.to, err: err}
+				}
+				select {
+				case results <- fetchResult{from: r.from, fetched: fetched, err: err}:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}()
+	}
+	go func() {
+		wg.Wait()
+		close(results)
+	}()
+
+	// No worker outlives the call: they are stopped and drained first.
+	defer func() {
+		stopDispatch()
+		cancel()
+		for range results {
+		}
+	}()
+
+	p.logger.Info().Uint64("fromBlock", from).Uint64("toBlock", to).Int("workers", workers).Msg("catching up")
+
+	pending := make(map[uint64]*indexedRange)
+	var failed *fetchResult
+	next := from
+	for result := range results {
+		if result.err != nil {
+			if failed == nil || result.from < failed.from {
+				failed = &result
+			}
+			stopDispatch()
+		} else {
+			pending[result.from] = result.fetched
+		}
+		for fetched, ok := pending[next]; ok; fetched, ok = pending[next] {
+			delete(pending, next)
+			if err := p.commitRange(ctx, client, fetched, head); err != nil {
+				return &rangeError{from: fetched.from, to: fetched.to, err: err}
+			}
+			batch.moved(p, fetched.to+1)
+			next = fetched.to + 1
+			<-slots
+		}
+		if failed != nil && next == failed.from {
+			return failed.err
+		}
+		if next > to {
+			return nil
+		}
+	}
+	// The results end early only when the service is stopped.
+	return ctx.Err()
+}
@@ -0,0 +1,131 @@
+package indexer
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	ethTypes "github.com/ethereum/go-ethereum/core/types"
+	evmi_database "github.com/evmi-cloud/go-evm-indexer/internal/database/evmi-database"
+	log_stores "github.com/evmi-cloud/go-evm-indexer/internal/database/log-stores"
+	"github.com/evmi-cloud/go-evm-indexer/internal/types"
+)
+
+// insertedBlocksStore records the blocks inserted, in order; other methods
+// are unused.
+type insertedBlocksStore struct {
+	log_stores.EvmIndexerStorage
+	blocks []uint64
+}
+
+func (s *insertedBlocksStore) InsertBlocks(blocks []types.EvmBlock) error {
+	for _, block := range blocks {
+		s.blocks = append(s.blocks, block.BlockNumber)
+	}
+	return nil
+}
+
+// slowFirstBlock delays the requests reading block 1, so the ranges above it
+// are fetched first.
+type slowFirstBlock struct{ next http.Handler }
+
+func (h slowFirstBlock) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, _ := io.ReadAll(r.Body)
+	if bytes.Contains(body, []byte(`"0x1"`)) {
+		time.Sleep(100 * time.Millisecond)
+	}
+	r.Body = io.NopCloser(bytes.NewReader(body))
+	h.next.ServeHTTP(w, r)
+}
+
+func newCatchUpIndexer(t *testing.T, blocks uint64, missing uint64) (*SourceIndexerService, *insertedBlocksStore, *rpcPool) {
+	t.Helper()
+	node := &blocksNode{blocks: map[uint64]*ethTypes.Block{}, requests: map[string]int{}}
+	for n := uint64(1); n <= blocks; n++ {
+		if n != missing {
+			node.blocks[n] = ethTypes.NewBlockWithHeader(&ethTypes.Header{Number: new(big.Int).SetUint64(n), Difficulty: big.NewInt(0)})
+		}
+	}
+	server := httptest.NewServer(slowFirstBlock{next: node})
+	t.Cleanup(server.Close)
+
+	p := newSourceIndexerForTest(t, evmi_database.EvmLogSource{Type: string(evmi_database.BlocksLogSourceType), CatchUpWorkers: 4})
+	p.chain = evmi_database.EvmBlockchain{ChainId: 1, BlockRange: 2, RpcMaxBatchSize: 10}
+	store := &insertedBlocksStore{}
+	p.store = log_stores.NewIndexerStore(store)
+	return p, store, newTestPool(t, server.URL)
+}
+
+func TestCatchUpCommitsRangesInOrder(t *testing.T) {
+	p, store, client := newCatchUpIndexer(t, 20, 0)
+
+	if err := p.catchUp(context.Background(), client, nil, nil, 1, 20, 1000); err != nil {
+		t.Fatalf("catch up: %v", err)
+	}
+	if len(store.blocks) != 20 {
+		t.Fatalf("inserted = %v", store.blocks)
+	}
+	for i, block := range store.blocks {
+		if block != uint64(i+1) {
+			t.Fatalf("inserted = %v, want blocks 1 to 20 in order", store.blocks)
+		}
+	}
+	var source evmi_database.EvmLogSource
+	p.db.Conn.First(&source, p.source.ID)
+	if source.SyncBlock != 20 || p.source.SyncBlock != 20 {
+		t.Errorf("sync block = %d, %d; want 20", source.SyncBlock, p.source.SyncBlock)
+	}
+}
+
+func TestCatchUpStopsAtTheFailedRange(t *testing.T) {
+	// Block 7 is missing: the range [7, 8] fails, and the ranges above it,
+	// fetched or not, are not committed.
+	p, store, client := newCatchUpIndexer(t, 20, 7)
+
+	if err := p.catchUp(context.Background(), client, nil, nil, 1, 20, 1000); err == nil {
+		t.Fatal("catch up succeeded over a missing block")
+	}
+	if len(store.blocks) != 6 || store.blocks[5] != 6 {
+		t.Errorf("inserted = %v, want blocks 1 to 6", store.blocks)
+	}
+	var source evmi_database.EvmLogSource
+	p.db.Conn.First(&source, p.source.ID)
+	if source.SyncBlock != 6 {
+		t.Errorf("sync block = %d, want 6", source.SyncBlock)
+	}
+}
+
+func TestCatchUpCeiling(t *testing.T) {
+	p := &SourceIndexerService{
+		source: evmi_database.EvmLogSource{CatchUpWorkers: 4},
+		chain:  evmi_database.EvmBlockchain{BlockRange: 10},
+	}
+	head := uint64(1000)
+
+	if ceiling, ok := p.catchUpCeiling(1, head, head); !ok || ceiling != head-reorgTrackingDepth {
+		t.Errorf("ceiling = %d, %v; want %d", ceiling, ok, head-reorgTrackingDepth)
+	}
+	// A bounded source stops at its last block.
+	if ceiling, ok := p.catchUpCeiling(1, 500, head); !ok || ceiling != 500 {
+		t.Errorf("bounded ceiling = %d, %v; want 500", ceiling, ok)
+	}
+	// Less than two ranges below the tracking depth: sequential.
+	if _, ok := p.catchUpCeiling(head-reorgTrackingDepth-10, head, head); ok {
+		t.Error("caught up in parallel near the head")
+	}
+
+	p.proxy = &proxyState{}
+	if _, ok := p.catchUpCeiling(1, head, head); ok {
+		t.Error("proxy source caught up in parallel")
+	}
+	p.proxy = nil
+	p.source.CatchUpWorkers = 1
+	if _, ok := p.catchUpCeiling(1, head, head); ok {
+		t.Error("single worker caught up in parallel")
+	}
+}
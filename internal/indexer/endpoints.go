@@ -0,0 +1,588 @@
+package indexer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/rpc"
+	evmi_database "github.com/evmi-cloud/go-evm-indexer/internal/database/evmi-database"
+	"github.com/evmi-cloud/go-evm-indexer/internal/metrics"
+	"github.com/lmittmann/w3"
+	"github.com/lmittmann/w3/module/eth"
+	"github.com/lmittmann/w3/w3types"
+	"github.com/rs/zerolog"
+)
+
+const (
+	// endpointMaxLag is how many blocks an endpoint's head may trail the highest
+	// head reported on the chain before it stops receiving calls.
+	endpointMaxLag = 5
+	// endpointFailureThreshold consecutive failed calls put an endpoint in
+	// cooldown for endpointCooldown, or until a head probe succeeds again.
+	endpointFailureThreshold = 3
+	endpointCooldown         = 30 * time.Second
+	// endpointProbeTimeout bounds the eth_blockNumber probe of one endpoint.
+	endpointProbeTimeout = 10 * time.Second
+	// endpointHeadRefresh is the minimum delay between two probes of the chain's
+	// endpoints, however many sources poll it.
+	endpointHeadRefresh = time.Second
+	// endpointHealthFlush is the minimum delay between two writes of the
+	// endpoints' health to the database.
+	endpointHealthFlush = 15 * time.Second
+	// endpointEwmaAlpha weights the latest call in the latency and error-rate
+	// moving averages.
+	endpointEwmaAlpha = 0.2
+	// endpointUnsupportedRecheck is how long an endpoint that answered it does
+	// not serve a method is left out of that method's calls.
+	endpointUnsupportedRecheck = 10 * time.Minute
+)
+
+// errMethodUnsupported is returned for a call no endpoint of the pool serves:
+// each of them answered it does not serve the method within the last
+// endpointUnsupportedRecheck, none being due for a recheck yet.
+var errMethodUnsupported = errors.New("method not supported by any rpc endpoint")
+
+// rpcEndpoint is one JSON-RPC node of a chain and its observed health. Fields
+// below client are guarded by the pool's mutex.
+type rpcEndpoint struct {
+	id     uint // EvmRpcEndpoint row; 0 for the chain's RpcUrl fallback
+	url    string
+	label  string
+	weight uint64
+	client *w3.Client
+
+	latency   time.Duration
+	errorRate float64
+	failures  int
+	downUntil time.Time
+	head      uint64
+	lagging   bool
+	lastError string
+	checkedAt time.Time
+	// unsupported holds, by method, when the endpoint answered it does not
+	// serve it.
+	unsupported map[string]time.Time
+
+	// current is the smooth weighted round-robin credit.
+	current float64
+}
+
+// routable reports whether the endpoint may receive calls: not cooling down
+// after repeated failures and not lagging behind the chain.
+func (e *rpcEndpoint) routable(now time.Time) bool {
+	return !now.Before(e.downUntil) && !e.lagging
+}
+
+// serves reports whether the endpoint may receive calls of method: it did not
+// answer it does not serve it in the last endpointUnsupportedRecheck.
+func (e *rpcEndpoint) serves(method string, now time.Time) bool {
+	at, ok := e.unsupported[method]
+	return !ok || now.Sub(at) >= endpointUnsupportedRecheck
+}
+
+// rpcPool spreads a chain's JSON-RPC calls over its endpoints. Calls go to the
+// routable endpoints by weighted round-robin, the configured weight being
+// scaled down by the endpoint's error rate and latency; a failed call is
+// retried on the next endpoint, so a range fails over mid-way instead of
+// failing. One pool is shared by every source of the chain (see rpcPools), so
+// they share its health view and its rate limiter.
+type rpcPool struct {
+	chainId   uint64
+	signature string
+	limiter   *rpcLimiter
+	db        *evmi_database.EvmiDatabase
+	metrics   *metrics.MetricService
+	logger    zerolog.Logger
+
+	mu        sync.Mutex
+	endpoints []*rpcEndpoint
+	head      uint64
+	headAt    time.Time
+	flushedAt time.Time
+
+	// refreshMu serializes head probes so concurrent sources reuse one result.
+	refreshMu sync.Mutex
+
+	// refs counts the holders of the pool (see rpcPools), guarded by the
+	// registry's mutex.
+	refs int
+}
+
+// endpointLabel is the metric label of an endpoint: its host, leaving out the
+// path, query and credentials where providers put API keys.
+func endpointLabel(rawUrl string) string {
+	u, err := url.Parse(rawUrl)
+	if err != nil || u.Host == "" {
+		return "invalid"
+	}
+	return u.Host
+}
+
+// chainEndpoints returns the endpoints configured for chain, or its RpcUrl as a
+// single endpoint when it has none.
+func chainEndpoints(chain evmi_database.EvmBlockchain) []evmi_database.EvmRpcEndpoint {
+	if len(chain.RpcEndpoints) == 0 {
+		return []evmi_database.EvmRpcEndpoint{{Url: chain.RpcUrl, Weight: 1}}
+	}
+	return chain.RpcEndpoints
+}
+
+// endpointsSignature identifies an endpoint configuration, to rebuild a pool
+// when it changes.
+func endpointsSignature(endpoints []evmi_database.EvmRpcEndpoint) string {
+	var b strings.Builder
+	for _, e := range endpoints {
+		fmt.Fprintf(&b, "%d=%s*%d;", e.ID, e.Url, e.Weight)
+	}
+	return b.String()
+}
+
+// dialEndpoint connects to an endpoint. HTTP endpoints report their 429
+// answers to the chain's limiter through throttle.
+func dialEndpoint(rawUrl string, throttle http.RoundTripper) (*w3.Client, error) {
+	if !strings.HasPrefix(rawUrl, "http://") && !strings.HasPrefix(rawUrl, "https://") {
+		return w3.Dial(rawUrl)
+	}
+	client, err := rpc.DialOptions(context.Background(), rawUrl, rpc.WithHTTPClient(&http.Client{Transport: throttle}))
+	if err != nil {
+		return nil, err
+	}
+	return w3.NewClient(client), nil
+}
+
+func newRpcPool(chain evmi_database.EvmBlockchain, db *evmi_database.EvmiDatabase, metrics *metrics.MetricService, logger zerolog.Logger) (*rpcPool, error) {
+	return dialRpcPool(chain, newRpcLimiter(chain), db, metrics, logger)
+}
+
+// dialRpcPool builds a pool of the chain's endpoints whose calls are paced by
+// limiter.
+func dialRpcPool(chain evmi_database.EvmBlockchain, limiter *rpcLimiter, db *evmi_database.EvmiDatabase, metrics *metrics.MetricService, logger zerolog.Logger) (*rpcPool, error) {
+	configured := chainEndpoints(chain)
+	pool := &rpcPool{
+		chainId:   chain.ChainId,
+		signature: endpointsSignature(configured),
+		limiter:   limiter,
+		db:        db,
+		metrics:   metrics,
+		logger:    logger,
+	}
+	for _, e := range configured {
+		label := endpointLabel(e.Url)
+		client, err := dialEndpoint(e.Url, throttleTransport{
+			next:       http.DefaultTransport,
+			limiter:    limiter,
+			onThrottle: func() { metrics.IncRPCRateLimited(chain.ChainId, label) },
+		})
+		if err != nil {
+			pool.close()
+			return nil, fmt.Errorf("dial rpc endpoint %s: %w", endpointLabel(e.Url), err)
+		}
+		weight := e.Weight
+		if weight == 0 {
+			weight = 1
+		}
+		pool.endpoints = append(pool.endpoints, &rpcEndpoint{
+			id:     e.ID,
+			url:    e.Url,
+			label:  label,
+			weight: weight,
+			client: client,
+		})
+		metrics.SetRPCEndpointUp(chain.ChainId, label, true)
+	}
+	return pool, nil
+}
+
+func (p *rpcPool) close() {
+	for _, e := range p.endpoints {
+		e.client.Close()
+	}
+}
+
+// call runs calls (a single request or a batch) on the best routable endpoint,
+// failing over to the next one on error. Every attempt first waits for the
+// chain's rate limiter, giving up once ctx is done. A 429 answer is retried up
+// to rpcThrottleRetries times, once the pause it imposed on the limiter is
+// over, without counting as the endpoint's try nor against its health.
+// Range-too-large eth_getLogs answers are returned as is: it is the caller's
+// request, not the endpoint, that has to change. An endpoint answering it does
+// not serve the method is left out of the method's calls for
+// endpointUnsupportedRecheck. Once no endpoint is left to try, the last error
+// of the call is returned, or errMethodUnsupported when every endpoint was
+// left out before being tried.
+func (p *rpcPool) call(ctx context.Context, method string, calls ...w3types.RPCCaller) error {
+	tried := make(map[*rpcEndpoint]bool, len(p.endpoints))
+	throttled := 0
+	var err error
+	for {
+		e := p.pick(method, tried)
+		if e == nil {
+			if err == nil && len(p.endpoints) > 0 {
+				err = errMethodUnsupported
+			} else if err == nil {
+				err = errors.New("no rpc endpoint configured")
+			}
+			return err
+		}
+		tried[e] = true
+
+		waited, waitErr := p.limiter.wait(ctx, method, len(calls))
+		if waitErr != nil {
+			return waitErr
+		}
+		p.metrics.ObserveRPCThrottle(p.chainId, method, waited)
+		start := time.Now()
+		err = e.client.CallCtx(ctx, calls...)
+		d := time.Since(start)
+		p.metrics.RecordRPC(p.chainId, e.label, method, d, err)
+
+		if isRateLimited(err) {
+			if throttled < rpcThrottleRetries {
+				throttled++
+				delete(tried, e)
+				p.logger.Warn().Msg(fmt.Sprintf("%s rate limited by %s, retrying", method, e.label))
+				continue
+			}
+			return err
+		}
+		if err == nil || (method == "eth_getLogs" && isRangeTooLarge(err)) {
+			p.observe(e, d, nil)
+			return err
+		}
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if isMethodUnsupported(err) {
+			p.observe(e, d, nil)
+			p.unsupported(e, method, err)
+			continue
+		}
+		p.observe(e, d, err)
+		if len(tried) < len(p.endpoints) {
+			p.logger.Warn().Msg(fmt.Sprintf("%s failed on %s, failing over: %s", method, e.label, err.Error()))
+		}
+	}
+}
+
+// pick returns the next endpoint to call method on among those not tried yet
+// and serving it: the routable ones by smooth weighted round-robin or, when
+// none is, the remaining ones so a chain whose endpoints are all unhealthy
+// still gets a chance.
+func (p *rpcPool) pick(method string, tried map[*rpcEndpoint]bool) *rpcEndpoint {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	now := time.Now()
+	var candidates, fallback []*rpcEndpoint
+	for _, e := range p.endpoints {
+		if tried[e] || !e.serves(method, now) {
+			continue
+		}
+		if e.routable(now) {
+			candidates = append(candidates, e)
+		} else {
+			fallback = append(fallback, e)
+		}
+	}
+	if len(candidates) == 0 {
+		candidates = fallback
+	}
+	if len(candidates) == 0 {
+		return nil
+	}
+
+	var fastest time.Duration
+	for _, e := range candidates {
+		if e.latency > 0 && (fastest == 0 || e.latency < fastest) {
+			fastest = e.latency
+		}
+	}
+
+	var best *rpcEndpoint
+	var total float64
+	for _, e := range candidates {
+		score := float64(e.weight) * max(1-e.errorRate, 0.05)
+		if fastest > 0 && e.latency > 0 {
+			score *= float64(fastest) / float64(e.latency)
+		}
+		e.current += score
+		total += score
+		if best == nil || e.current > best.current {
+			best = e
+		}
+	}
+	best.current -= total
+	return best
+}
+
+// unsupported records that e answered it does not serve method.
+func (p *rpcPool) unsupported(e *rpcEndpoint, method string, err error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if e.unsupported == nil {
+		e.unsupported = make(map[string]time.Time)
+	}
+	e.unsupported[method] = time.Now()
+	p.logger.Warn().Msg(fmt.Sprintf("rpc endpoint %s does not serve %s, routing it elsewhere: %s", e.label, method, err.Error()))
+}
+
+// observe folds one call into the endpoint's health.
+func (p *rpcPool) observe(e *rpcEndpoint, d time.Duration, err error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	now := time.Now()
+	if e.latency == 0 {
+		e.latency = d
+	} else {
+		e.latency = time.Duration(endpointEwmaAlpha*float64(d) + (1-endpointEwmaAlpha)*float64(e.latency))
+	}
+	failed := 0.0
+	if err != nil {
+		failed = 1
+	}
+	e.errorRate = endpointEwmaAlpha*failed + (1-endpointEwmaAlpha)*e.errorRate
+	e.checkedAt = now
+
+	if err == nil {
+		e.failures = 0
+		return
+	}
+	e.failures++
+	e.lastError = err.Error()
+	if e.failures >= endpointFailureThreshold && !now.Before(e.downUntil) {
+		e.downUntil = now.Add(endpointCooldown)
+		p.logger.Warn().Msg(fmt.Sprintf("rpc endpoint %s failed %d times in a row, cooling down for %s", e.label, e.failures, endpointCooldown))
+		p.metrics.SetRPCEndpointUp(p.chainId, e.label, false)
+	}
+}
+
+// refreshHead probes every endpoint with eth_blockNumber, flags the ones whose
+// head trails the highest one by more than endpointMaxLag, and returns the
+// lowest head among the routable endpoints: every block up to it can be served
+// by whichever endpoint a call is routed to. Probes run at most once per
+// endpointHeadRefresh; a successful probe ends an endpoint's cooldown and a
+// 429 answer does not count against its health.
+func (p *rpcPool) refreshHead(ctx context.Context) (uint64, error) {
+	p.refreshMu.Lock()
+	defer p.refreshMu.Unlock()
+
+	p.mu.Lock()
+	if p.head > 0 && time.Since(p.headAt) < endpointHeadRefresh {
+		head := p.head
+		p.mu.Unlock()
+		return head, nil
+	}
+	p.mu.Unlock()
+
+	type probe struct {
+		head    uint64
+		latency time.Duration
+		err     error
+	}
+	probes := make([]probe, len(p.endpoints))
+	var wg sync.WaitGroup
+	for i, e := range p.endpoints {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			ctx, cancel := context.WithTimeout(ctx, endpointProbeTimeout)
+			defer cancel()
+
+			var block *big.Int
+			waited, err := p.limiter.wait(ctx, "eth_blockNumber", 1)
+			if err != nil {
+				probes[i] = probe{err: err}
+				return
+			}
+			p.metrics.ObserveRPCThrottle(p.chainId, "eth_blockNumber", waited)
+			start := time.Now()
+			err = e.client.CallCtx(ctx, eth.BlockNumber().Returns(&block))
+			probes[i] = probe{latency: time.Since(start), err: err}
+			if err == nil {
+				probes[i].head = block.Uint64()
+			}
+			p.metrics.RecordRPC(p.chainId, e.label, "eth_blockNumber", probes[i].latency, err)
+		}()
+	}
+	wg.Wait()
+	if ctx.Err() != nil {
+		return 0, ctx.Err()
+	}
+
+	for i, e := range p.endpoints {
+		if !isRateLimited(probes[i].err) {
+			p.observe(e, probes[i].latency, probes[i].err)
+		}
+	}
+
+	p.mu.Lock()
+	now := time.Now()
+	var highest uint64
+	for i, e := range p.endpoints {
+		if probes[i].err != nil {
+			continue
+		}
+		e.head = probes[i].head
+		e.failures = 0
+		e.downUntil = time.Time{}
+		highest = max(highest, e.head)
+	}
+
+	var head uint64
+	var errs []error
+	routable := 0
+	for i, e := range p.endpoints {
+		if probes[i].err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", e.label, probes[i].err))
+		} else {
+			lagging := e.head+endpointMaxLag < highest
+			if lagging != e.lagging {
+				if lagging {
+					p.logger.Warn().Msg(fmt.Sprintf("rpc endpoint %s is %d blocks behind, routing around it", e.label, highest-e.head))
+				} else {
+					p.logger.Info().Msg(fmt.Sprintf("rpc endpoint %s caught up", e.label))
+				}
+			}
+			e.lagging = lagging
+		}
+		up := e.routable(now)
+		p.metrics.SetRPCEndpointUp(p.chainId, e.label, up)
+		if !up || probes[i].err != nil {
+			continue
+		}
+		if routable == 0 || e.head < head {
+			head = e.head
+		}
+		routable++
+	}
+	if routable == 0 {
+		p.mu.Unlock()
+		return 0, fmt.Errorf("no healthy rpc endpoint: %w", errors.Join(errs...))
+	}
+	p.head, p.headAt = head, now
+	p.mu.Unlock()
+
+	p.flushHealth()
+	return head, nil
+}
+
+// flushHealth writes the endpoints' health to their EvmRpcEndpoint rows, at
+// most once per endpointHealthFlush. Column-scoped: the API owns url/weight.
+func (p *rpcPool) flushHealth() {
+	if p.db == nil {
+		return
+	}
+	p.mu.Lock()
+	now := time.Now()
+	if now.Sub(p.flushedAt) < endpointHealthFlush {
+		p.mu.Unlock()
+		return
+	}
+	p.flushedAt = now
+	rows := make(map[uint]map[string]interface{}, len(p.endpoints))
+	for _, e := range p.endpoints {
+		if e.id == 0 {
+			continue
+		}
+		checkedAt := e.checkedAt
+		rows[e.id] = map[string]interface{}{
+			"healthy":    e.routable(now),
+			"head_block": e.head,
+			"latency_ms": uint64(e.latency.Milliseconds()),
+			"error_rate": e.errorRate,
+			"last_error": e.lastError,
+			"checked_at": &checkedAt,
+		}
+	}
+	p.mu.Unlock()
+
+	for id, health := range rows {
+		if err := p.db.Conn.Model(&evmi_database.EvmRpcEndpoint{}).Where("id = ?", id).Updates(health).Error; err != nil {
+			p.logger.Warn().Msg("failed to save rpc endpoint health: " + err.Error())
+		}
+	}
+}
+
+// rpcPools holds one rpcPool per blockchain, shared by the sources indexing it,
+// and the chain's rate limiter, kept across pool rebuilds. A pool is closed
+// once nothing holds it: neither the chain's head tracker nor a source.
+type rpcPools struct {
+	mu       sync.Mutex
+	pools    map[uint]*rpcPool
+	limiters map[uint]*rpcLimiter
+}
+
+func newRpcPools() *rpcPools {
+	return &rpcPools{pools: make(map[uint]*rpcPool), limiters: make(map[uint]*rpcLimiter)}
+}
+
+// acquire returns the chain's pool, building it on first use or when the
+// chain's endpoint configuration changed since. The holders of a replaced pool
+// keep using it until they release it, the last release closing its clients.
+// The chain's rate limit and method costs apply to every pool of the chain as
+// soon as one of its sources acquires it. Every acquire must be paired with a
+// release. A nil registry builds a pool private to the caller.
+func (r *rpcPools) acquire(chain evmi_database.EvmBlockchain, db *evmi_database.EvmiDatabase, metrics *metrics.MetricService, logger zerolog.Logger) (*rpcPool, error) {
+	if r == nil {
+		return newRpcPool(chain, db, metrics, logger)
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	limiter, ok := r.limiters[chain.ID]
+	if ok {
+		limiter.configure(chain)
+	} else {
+		limiter = newRpcLimiter(chain)
+		r.limiters[chain.ID] = limiter
+	}
+	if pool, ok := r.pools[chain.ID]; ok && pool.signature == endpointsSignature(chainEndpoints(chain)) {
+		pool.refs++
+		return pool, nil
+	}
+	pool, err := dialRpcPool(chain, limiter, db, metrics, logger)
+	if err != nil {
+		return nil, err
+	}
+	pool.refs = 1
+	r.pools[chain.ID] = pool
+	return pool, nil
+}
+
+// retain takes one more hold on a pool the caller already holds.
+func (r *rpcPools) retain(pool *rpcPool) {
+	if r == nil {
+		return
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	pool.refs++
+}
+
+// release gives back a hold taken by acquire or retain, closing the pool's
+// clients once nothing holds it. A nil registry closes the pool.
+func (r *rpcPools) release(pool *rpcPool) {
+	if r == nil {
+		pool.close()
+		return
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	pool.refs--
+	if pool.refs > 0 {
+		return
+	}
+	pool.close()
+	for id, current := range r.pools {
+		if current == pool {
+			delete(r.pools, id)
+		}
+	}
+}
@@ -0,0 +1,207 @@
+package indexer
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+
+	evmi_database "github.com/evmi-cloud/go-evm-indexer/internal/database/evmi-database"
+	"github.com/lmittmann/w3/module/eth"
+	"github.com/rs/zerolog"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+// newTestPool returns a private pool over urls, all of weight 1.
+func newTestPool(t *testing.T, urls ...string) *rpcPool {
+	t.Helper()
+	var chain evmi_database.EvmBlockchain
+	for _, u := range urls {
+		chain.RpcEndpoints = append(chain.RpcEndpoints, evmi_database.EvmRpcEndpoint{Url: u, Weight: 1})
+	}
+	pool, err := newRpcPool(chain, nil, nil, zerolog.Nop())
+	if err != nil {
+		t.Fatalf("newRpcPool: %v", err)
+	}
+	t.Cleanup(pool.close)
+	return pool
+}
+
+// countingServer serves handler, counting the requests it receives.
+func countingServer(t *testing.T, handler http.Handler) (*httptest.Server, *atomic.Int64) {
+	t.Helper()
+	var count atomic.Int64
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		count.Add(1)
+		handler.ServeHTTP(w, r)
+	}))
+	t.Cleanup(server.Close)
+	return server, &count
+}
+
+func deadNode() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "upstream unavailable", http.StatusBadGateway)
+	})
+}
+
+func TestPoolFailsOverAndCoolsDownFailingEndpoint(t *testing.T) {
+	chain := &fakeChain{}
+	chain.set(buildChain(42, 43, ""))
+	dead, deadCalls := countingServer(t, deadNode())
+	live, _ := countingServer(t, chain)
+	// Weighted so the dead endpoint stays first choice until its cooldown.
+	pool, err := newRpcPool(evmi_database.EvmBlockchain{RpcEndpoints: []evmi_database.EvmRpcEndpoint{
+		{Url: dead.URL, Weight: 100},
+		{Url: live.URL, Weight: 1},
+	}}, nil, nil, zerolog.Nop())
+	if err != nil {
+		t.Fatalf("newRpcPool: %v", err)
+	}
+	t.Cleanup(pool.close)
+
+	for i := 0; i < 10; i++ {
+		var block *big.Int
+		if err := pool.call(context.Background(), "eth_blockNumber", eth.BlockNumber().Returns(&block)); err != nil {
+			t.Fatalf("call %d: %v", i, err)
+		}
+		if block.Uint64() != 42 {
+			t.Fatalf("block = %d, want 42", block.Uint64())
+		}
+	}
+
+	// The dead endpoint is dropped after endpointFailureThreshold failures.
+	if n := deadCalls.Load(); n != endpointFailureThreshold {
+		t.Errorf("dead endpoint called %d times, want %d", n, endpointFailureThreshold)
+	}
+	if e := pool.endpoints[0]; e.errorRate == 0 || e.lastError == "" || e.downUntil.IsZero() {
+		t.Errorf("dead endpoint health = %+v, want errors recorded and a cooldown", e)
+	}
+}
+
+func TestPoolReturnsLastErrorWhenAllEndpointsFail(t *testing.T) {
+	a, aCalls := countingServer(t, deadNode())
+	b, bCalls := countingServer(t, deadNode())
+	pool := newTestPool(t, a.URL, b.URL)
+
+	var block *big.Int
+	if err := pool.call(context.Background(), "eth_blockNumber", eth.BlockNumber().Returns(&block)); err == nil {
+		t.Fatal("expected an error when every endpoint fails")
+	}
+	if aCalls.Load() != 1 || bCalls.Load() != 1 {
+		t.Errorf("calls = %d/%d, want each endpoint tried once", aCalls.Load(), bCalls.Load())
+	}
+}
+
+func TestPickFollowsWeights(t *testing.T) {
+	heavy := &rpcEndpoint{label: "heavy", weight: 3}
+	light := &rpcEndpoint{label: "light", weight: 1}
+	pool := &rpcPool{endpoints: []*rpcEndpoint{heavy, light}}
+
+	picks := map[string]int{}
+	for i := 0; i < 8; i++ {
+		picks[pool.pick("eth_call", nil).label]++
+	}
+	if picks["heavy"] != 6 || picks["light"] != 2 {
+		t.Errorf("picks = %v, want heavy:6 light:2", picks)
+	}
+
+	// A failing endpoint loses its share even before its cooldown.
+	heavy.errorRate = 0.9
+	picks = map[string]int{}
+	for i := 0; i < 8; i++ {
+		picks[pool.pick("eth_call", nil).label]++
+	}
+	if picks["heavy"] >= picks["light"] {
+		t.Errorf("picks = %v, want the erroring endpoint picked less", picks)
+	}
+}
+
+func TestRefreshHeadRoutesAroundLaggingEndpoint(t *testing.T) {
+	gormDb, err := gorm.Open(sqlite.Open(filepath.Join(t.TempDir(), "endpoints.db")), &gorm.Config{})
+	if err != nil {
+		t.Fatalf("open sqlite: %v", err)
+	}
+	if err := gormDb.AutoMigrate(&evmi_database.EvmRpcEndpoint{}); err != nil {
+		t.Fatalf("migrate: %v", err)
+	}
+
+	ahead := &fakeChain{}
+	ahead.set(buildChain(100, 101, ""))
+	behind := &fakeChain{}
+	behind.set(buildChain(90, 101, ""))
+	aheadServer, _ := countingServer(t, ahead)
+	behindServer, behindCalls := countingServer(t, behind)
+
+	rows := []evmi_database.EvmRpcEndpoint{
+		{EvmBlockchainID: 1, Url: aheadServer.URL, Weight: 1},
+		{EvmBlockchainID: 1, Url: behindServer.URL, Weight: 10},
+	}
+	if err := gormDb.Create(&rows).Error; err != nil {
+		t.Fatalf("create endpoints: %v", err)
+	}
+	pool, err := newRpcPool(evmi_database.EvmBlockchain{RpcEndpoints: rows}, &evmi_database.EvmiDatabase{Conn: gormDb}, nil, zerolog.Nop())
+	if err != nil {
+		t.Fatalf("newRpcPool: %v", err)
+	}
+	t.Cleanup(pool.close)
+
+	head, err := pool.refreshHead(context.Background())
+	if err != nil {
+		t.Fatalf("refreshHead: %v", err)
+	}
+	if head != 100 || !pool.endpoints[1].lagging {
+		t.Fatalf("head=%d lagging=%v, want 100 with the second endpoint lagging", head, pool.endpoints[1].lagging)
+	}
+
+	// The lagging endpoint gets no calls despite its weight.
+	probes := behindCalls.Load()
+	for i := 0; i < 5; i++ {
+		var block *big.Int
+		if err := pool.call(context.Background(), "eth_blockNumber", eth.BlockNumber().Returns(&block)); err != nil {
+			t.Fatalf("call: %v", err)
+		}
+	}
+	if behindCalls.Load() != probes {
+		t.Errorf("lagging endpoint received %d calls", behindCalls.Load()-probes)
+	}
+
+	var stored []evmi_database.EvmRpcEndpoint
+	if err := gormDb.Order("id").Find(&stored).Error; err != nil {
+		t.Fatalf("reload endpoints: %v", err)
+	}
+	if !stored[0].Healthy || stored[0].HeadBlock != 100 || stored[1].Healthy || stored[1].HeadBlock != 90 || stored[1].CheckedAt == nil {
+		t.Errorf("stored health = %+v / %+v", stored[0], stored[1])
+	}
+
+	// Once within endpointMaxLag it is routed to again, and the pool head is the
+	// lowest head every routable endpoint can serve.
+	behind.set(buildChain(97, 101, ""))
+	pool.headAt = pool.headAt.Add(-endpointHeadRefresh)
+	if head, err := pool.refreshHead(context.Background()); err != nil || head != 97 {
+		t.Errorf("refreshHead = %d, %v, want 97", head, err)
+	}
+}
+
+func TestPoolReportsTheEndpointErrorOverUnsupported(t *testing.T) {
+	dead, _ := countingServer(t, deadNode())
+	other, otherCalls := countingServer(t, deadNode())
+	pool := newTestPool(t, dead.URL, other.URL)
+	pool.unsupported(pool.endpoints[1], "eth_blockNumber", errors.New("method not found"))
+
+	var block *big.Int
+	err := pool.call(context.Background(), "eth_blockNumber", eth.BlockNumber().Returns(&block))
+	if err == nil || errors.Is(err, errMethodUnsupported) || otherCalls.Load() != 0 {
+		t.Errorf("err = %v after %d calls to the unsupported endpoint, want the failing endpoint's error", err, otherCalls.Load())
+	}
+
+	pool.unsupported(pool.endpoints[0], "eth_blockNumber", errors.New("method not found"))
+	if err := pool.call(context.Background(), "eth_blockNumber", eth.BlockNumber().Returns(&block)); !errors.Is(err, errMethodUnsupported) {
+		t.Errorf("err = %v, want errMethodUnsupported once no endpoint serves the method", err)
+	}
+}
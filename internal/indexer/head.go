@@ -0,0 +1,469 @@
+package indexer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	ethTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+	evmi_database "github.com/evmi-cloud/go-evm-indexer/internal/database/evmi-database"
+	"github.com/evmi-cloud/go-evm-indexer/internal/metrics"
+	"github.com/lmittmann/w3/w3types"
+	"github.com/rs/zerolog"
+)
+
+// headByTag is an eth_getBlockByNumber call for a named block tag ("safe",
+// "finalized"), which w3's eth.HeaderByNumber cannot express.
+type headByTag struct {
+	tag string
+	ret **ethTypes.Header
+}
+
+func (c *headByTag) CreateRequest() (rpc.BatchElem, error) {
+	return rpc.BatchElem{
+		Method: "eth_getBlockByNumber",
+		Args:   []any{c.tag, false},
+		Result: c.ret,
+	}, nil
+}
+
+func (c *headByTag) HandleResponse(elem rpc.BatchElem) error {
+	if elem.Error != nil {
+		return elem.Error
+	}
+	if *c.ret == nil {
+		return fmt.Errorf("no %q block reported by the node", c.tag)
+	}
+	return nil
+}
+
+var _ w3types.RPCCaller = (*headByTag)(nil)
+
+// headConfig is the part of a chain's configuration a headTracker depends on;
+// a tracker is reconfigured when it changes.
+type headConfig struct {
+	chainId         uint64
+	headMode        string
+	confirmations   uint64
+	pullInterval    uint64
+	wsUrl           string
+	wsSubscribeLogs bool
+}
+
+func headConfigOf(chain evmi_database.EvmBlockchain) headConfig {
+	return headConfig{
+		chainId:         chain.ChainId,
+		headMode:        chain.HeadMode,
+		confirmations:   chain.HeadConfirmations,
+		pullInterval:    chain.PullInterval,
+		wsUrl:           chain.WsUrl,
+		wsSubscribeLogs: chain.WsSubscribeLogs,
+	}
+}
+
+// errHeadTrackerStopped is returned to sources waiting on a tracker that was
+// stopped; they restart and pick up the chain's current tracker.
+var errHeadTrackerStopped = errors.New("chain head tracker stopped")
+
+// headTracker resolves the head of one chain for all of its sources, so the
+// node sees one head read per PullInterval (or per newHeads notification)
+// whatever the number of sources. Each new head is published by closing the
+// updated channel and replacing it; sources compare seq with the last one they
+// consumed, so a head published while a source was busy is not missed, and
+// heads published meanwhile are coalesced into the latest. It is the only
+// writer of the chain head metrics. A configuration change swaps the chain
+// and pool in place (see swap), its sources waiting on through it.
+type headTracker struct {
+	metrics *metrics.MetricService
+	logger  zerolog.Logger
+
+	mu sync.Mutex
+	// chain, config and pool are the configuration the tracker runs with; gen
+	// counts their swaps, a head read under a previous one being dropped.
+	chain   evmi_database.EvmBlockchain
+	config  headConfig
+	pool    *rpcPool
+	gen     uint64
+	head    uint64
+	seq     uint64
+	updated chan struct{}
+	stopped bool
+
+	// refs counts the sources using the tracker (see headTrackers).
+	refs int
+	// nudge asks for a head read before the next poll; sources in logs push
+	// mode send it when one of their logs is notified.
+	nudge  chan struct{}
+	cancel context.CancelFunc
+	// reconfigured wakes the tracker after a swap.
+	reconfigured chan struct{}
+
+	// push is the chain's newHeads subscription, when it has a WsUrl (and is not
+	// in logs mode); pushAttempt is the time of the last subscription attempt.
+	push        *pushSubscription
+	pushAttempt time.Time
+
+	// logsClient is the WebSocket connection the chain's sources subscribe to
+	// their logs over in logs push mode, one per chain whatever the number of
+	// sources.
+	logsMu     sync.Mutex
+	logsClient *rpc.Client
+}
+
+func newHeadTracker(chain evmi_database.EvmBlockchain, pool *rpcPool, metrics *metrics.MetricService, logger zerolog.Logger) *headTracker {
+	return &headTracker{
+		chain:   chain,
+		config:  headConfigOf(chain),
+		pool:    pool,
+		metrics: metrics,
+		logger:  logger,
+		updated: make(chan struct{}),
+		nudge:   make(chan struct{}, 1),
+
+		reconfigured: make(chan struct{}, 1),
+	}
+}
+
+// current returns the configuration the tracker runs with and its generation.
+func (t *headTracker) current() (evmi_database.EvmBlockchain, *rpcPool, uint64) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.chain, t.pool, t.gen
+}
+
+// swap moves the tracker to chain and pool without stopping it and returns
+// the pool it used. The sources keep waiting on it and move to the new pool on
+// their next head (see headTrackers.switchPool); the tracker reads the head
+// under the new configuration right away, resubscribing when the WebSocket
+// settings changed.
+func (t *headTracker) swap(chain evmi_database.EvmBlockchain, pool *rpcPool) *rpcPool {
+	t.mu.Lock()
+	previous := t.pool
+	wsChanged := t.config.wsUrl != chain.WsUrl || t.config.wsSubscribeLogs != chain.WsSubscribeLogs
+	t.chain, t.config, t.pool = chain, headConfigOf(chain), pool
+	t.gen++
+	t.mu.Unlock()
+
+	if wsChanged {
+		t.closeLogs()
+	}
+	select {
+	case t.reconfigured <- struct{}{}:
+	default:
+	}
+	return previous
+}
+
+func (t *headTracker) start() {
+	ctx, cancel := context.WithCancel(context.Background())
+	t.cancel = cancel
+	go t.run(ctx)
+}
+
+func (t *headTracker) stop() {
+	if t.cancel != nil {
+		t.cancel()
+	}
+}
+
+// run reads the head, publishes it and waits for the next poll until the
+// tracker is stopped. A failed read is logged and retried on the next poll;
+// sources keep waiting meanwhile.
+func (t *headTracker) run(ctx context.Context) {
+	defer t.close()
+	for {
+		chain, _, gen := t.current()
+		head, err := t.resolve(ctx)
+		if ctx.Err() != nil {
+			return
+		}
+		if err != nil {
+			t.logger.Warn().Msg(fmt.Sprintf("failed to read chain %d head: %s", chain.ChainId, err.Error()))
+		} else {
+			t.publish(head, gen)
+		}
+		if err := t.wait(ctx); err != nil {
+			return
+		}
+	}
+}
+
+// publish records head, read under the configuration of generation gen, and
+// wakes the waiting sources when it moved.
+func (t *headTracker) publish(head uint64, gen uint64) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if gen != t.gen || (t.seq > 0 && head == t.head) {
+		return
+	}
+	t.head = head
+	t.seq++
+	close(t.updated)
+	t.updated = make(chan struct{})
+}
+
+func (t *headTracker) close() {
+	t.closePush()
+	t.closeLogs()
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.stopped = true
+	close(t.updated)
+}
+
+// latest returns the last published head with its sequence number (0 before
+// the first one) and the channel closed on the next publication.
+func (t *headTracker) latest() (head uint64, seq uint64, updated <-chan struct{}, err error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.stopped {
+		return 0, 0, nil, errHeadTrackerStopped
+	}
+	return t.head, t.seq, t.updated, nil
+}
+
+// refresh asks for a head read without waiting for the next poll.
+func (t *headTracker) refresh() {
+	select {
+	case t.nudge <- struct{}{}:
+	default:
+	}
+}
+
+// wait blocks until the next head read: a newHeads notification when the
+// chain has a WsUrl and the subscription is up, a refresh, or the poll
+// interval. The interval is PullInterval, stretched to pushFallbackPoll while
+// notifications (newHeads, or the sources' logs) are expected. A dropped
+// subscription falls back to polling right away and is retried every
+// pushRetryInterval.
+func (t *headTracker) wait(ctx context.Context) error {
+	chain, _, _ := t.current()
+	interval := time.Duration(chain.PullInterval) * time.Second
+	if chain.WsUrl != "" && chain.WsSubscribeLogs {
+		interval = max(pushFallbackPoll, interval)
+	} else if chain.WsUrl != "" {
+		if t.push == nil && time.Since(t.pushAttempt) >= pushRetryInterval {
+			t.pushAttempt = time.Now()
+			subCtx, cancel := context.WithTimeout(ctx, pushSubscribeTimeout)
+			push, err := subscribePush(subCtx, chain.WsUrl)
+			cancel()
+			if err != nil {
+				t.logger.Warn().Msg("websocket subscription failed, polling: " + err.Error())
+			} else {
+				t.logger.Info().Msg("subscribed to newHeads over websocket")
+				t.push = push
+			}
+		}
+		if t.push != nil {
+			interval = max(pushFallbackPoll, interval)
+		}
+	}
+
+	var wake, done <-chan struct{}
+	if t.push != nil {
+		wake, done = t.push.wake, t.push.done
+	}
+
+	timer := time.NewTimer(interval)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-wake:
+		t.logger.Debug().Msg(fmt.Sprintf("newHeads notification at block %d", t.push.last.Load()))
+		return nil
+	case <-done:
+		t.logger.Warn().Msg("websocket subscription dropped, polling: " + t.push.reason())
+		t.closePush()
+		return nil
+	case <-t.nudge:
+		return nil
+	case <-t.reconfigured:
+		// The newHeads subscription is redone under the new configuration.
+		t.closePush()
+		t.pushAttempt = time.Time{}
+		return nil
+	case <-timer.C:
+		return nil
+	}
+}
+
+func (t *headTracker) closePush() {
+	if t.push != nil {
+		t.push.close()
+		t.push = nil
+	}
+}
+
+func (t *headTracker) closeLogs() {
+	t.logsMu.Lock()
+	defer t.logsMu.Unlock()
+	if t.logsClient != nil {
+		t.logsClient.Close()
+		t.logsClient = nil
+	}
+}
+
+// subscribeLogs subscribes a source to the logs matching query over the
+// chain's logs connection, dialing it on first use or once it dropped. An
+// error answered by the node leaves the connection to the other sources.
+func (t *headTracker) subscribeLogs(ctx context.Context, query ethereum.FilterQuery) (*pushSubscription, error) {
+	t.logsMu.Lock()
+	defer t.logsMu.Unlock()
+	if t.logsClient != nil {
+		push, err := subscribe(ctx, t.logsClient, true, query)
+		var rpcErr rpc.Error
+		if err == nil || errors.As(err, &rpcErr) {
+			return push, err
+		}
+		t.logsClient.Close()
+		t.logsClient = nil
+	}
+
+	chain, _, _ := t.current()
+	client, err := rpc.DialContext(ctx, chain.WsUrl)
+	if err != nil {
+		return nil, err
+	}
+	push, err := subscribe(ctx, client, true, query)
+	if err != nil {
+		client.Close()
+		return nil, err
+	}
+	t.logsClient = client
+	return push, nil
+}
+
+// resolve returns the block the chain's sources may index up to under its
+// HeadMode. The latest block is the lowest head of the chain's routable
+// endpoints (see rpcPool.refreshHead). The head read is published as the chain
+// head metric under the block tag it was read from.
+func (t *headTracker) resolve(ctx context.Context) (uint64, error) {
+	chain, pool, _ := t.current()
+	mode := evmi_database.ChainHeadMode(strings.ToUpper(chain.HeadMode))
+	switch mode {
+	case "", evmi_database.LatestChainHeadMode, evmi_database.ConfirmationsChainHeadMode:
+		latest, err := pool.refreshHead(ctx)
+		if err != nil {
+			return 0, err
+		}
+		t.metrics.SetChainHead(chain.ChainId, "latest", latest)
+
+		if mode != evmi_database.ConfirmationsChainHeadMode {
+			return latest, nil
+		}
+		if latest < chain.HeadConfirmations {
+			return 0, nil
+		}
+		return latest - chain.HeadConfirmations, nil
+
+	case evmi_database.SafeChainHeadMode, evmi_database.FinalizedChainHeadMode:
+		tag := strings.ToLower(string(mode))
+		var header *ethTypes.Header
+		if err := pool.call(ctx, "eth_getBlockByNumber", &headByTag{tag: tag, ret: &header}); err != nil {
+			return 0, err
+		}
+		t.metrics.SetChainHead(chain.ChainId, tag, header.Number.Uint64())
+		return header.Number.Uint64(), nil
+	}
+	return 0, errors.New("unknown chain head mode " + chain.HeadMode)
+}
+
+// headTrackers holds one headTracker per blockchain, along with the chains'
+// endpoint pools. A tracker runs while at least one source of its chain holds
+// it.
+type headTrackers struct {
+	pools *rpcPools
+
+	mu       sync.Mutex
+	trackers map[uint]*headTracker
+}
+
+func newHeadTrackers() *headTrackers {
+	return &headTrackers{pools: newRpcPools(), trackers: make(map[uint]*headTracker)}
+}
+
+// acquire returns the chain's running tracker and its endpoint pool, held for
+// the caller, starting the tracker on first use. When the chain's head or
+// endpoint configuration changed since, the tracker is swapped to it in place:
+// the sources waiting on it carry on, moving to the new pool on their next
+// head. A nil registry starts a tracker private to the caller. Every acquire
+// must be paired with a release.
+func (r *headTrackers) acquire(chain evmi_database.EvmBlockchain, db *evmi_database.EvmiDatabase, metrics *metrics.MetricService, logger zerolog.Logger) (*headTracker, *rpcPool, error) {
+	if r == nil {
+		pool, err := newRpcPool(chain, db, metrics, logger)
+		if err != nil {
+			return nil, nil, err
+		}
+		t := newHeadTracker(chain, pool, metrics, logger)
+		t.start()
+		return t, pool, nil
+	}
+
+	pool, err := r.pools.acquire(chain, db, metrics, logger)
+	if err != nil {
+		return nil, nil, err
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	// The tracker holds its pool for its head reads, on top of the sources.
+	t, ok := r.trackers[chain.ID]
+	if ok {
+		if t.pool != pool || t.config != headConfigOf(chain) {
+			logger.Info().Msg(fmt.Sprintf("chain %d configuration changed, swapping its head tracker", chain.ChainId))
+			r.pools.retain(pool)
+			r.pools.release(t.swap(chain, pool))
+		}
+		t.refs++
+		return t, pool, nil
+	}
+	r.pools.retain(pool)
+	t = newHeadTracker(chain, pool, metrics, logger)
+	t.refs = 1
+	r.trackers[chain.ID] = t
+	t.start()
+	return t, pool, nil
+}
+
+// release gives back a tracker and pool obtained from acquire, stopping the
+// tracker once no source uses it and closing the pool once nothing holds it.
+func (r *headTrackers) release(t *headTracker, pool *rpcPool) {
+	if r == nil {
+		t.stop()
+		pool.close()
+		return
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.pools.release(pool)
+	t.refs--
+	if t.refs > 0 {
+		return
+	}
+	t.stop()
+	r.pools.release(t.pool)
+	if r.trackers[t.chain.ID] == t {
+		delete(r.trackers, t.chain.ID)
+	}
+}
+
+// switchPool moves a source holding pool to its tracker's current pool, when a
+// configuration change swapped it, and returns the pool the source holds.
+func (r *headTrackers) switchPool(t *headTracker, pool *rpcPool) *rpcPool {
+	if r == nil {
+		return pool
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if t.pool == pool {
+		return pool
+	}
+	r.pools.retain(t.pool)
+	r.pools.release(pool)
+	return t.pool
+}
@@ -0,0 +1,165 @@
+package indexer
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	evmi_database "github.com/evmi-cloud/go-evm-indexer/internal/database/evmi-database"
+	"github.com/rs/zerolog"
+	"gorm.io/gorm"
+)
+
+func (c *fakeChain) setTag(tag string, n uint64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.tags[tag] = n
+}
+
+// waitForHeadAsync runs waitForHead in the background, bounded by a timeout.
+func waitForHeadAsync(p *SourceIndexerService, tracker *headTracker, query ethereum.FilterQuery) <-chan uint64 {
+	heads := make(chan uint64, 1)
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		head, err := p.waitForHead(ctx, tracker, query)
+		if err != nil {
+			p.logger.Error().Msg(err.Error())
+		}
+		heads <- head
+	}()
+	return heads
+}
+
+func TestResolveHeadByMode(t *testing.T) {
+	chain := &fakeChain{tags: map[string]uint64{"safe": 90, "finalized": 64}}
+	chain.set(buildChain(100, 101, ""))
+	client := newReorgClient(t, chain)
+
+	cases := []struct {
+		mode          string
+		confirmations uint64
+		want          uint64
+	}{
+		{"", 0, 100},
+		{string(evmi_database.LatestChainHeadMode), 0, 100},
+		{string(evmi_database.ConfirmationsChainHeadMode), 12, 88},
+		{string(evmi_database.ConfirmationsChainHeadMode), 500, 0},
+		{string(evmi_database.SafeChainHeadMode), 0, 90},
+		{"finalized", 0, 64},
+	}
+	for _, c := range cases {
+		tracker := newHeadTracker(evmi_database.EvmBlockchain{HeadMode: c.mode, HeadConfirmations: c.confirmations}, client, nil, zerolog.Nop())
+		got, err := tracker.resolve(context.Background())
+		if err != nil {
+			t.Fatalf("resolve(%q): %v", c.mode, err)
+		}
+		if got != c.want {
+			t.Errorf("resolve(%q, %d) = %d, want %d", c.mode, c.confirmations, got, c.want)
+		}
+	}
+}
+
+func TestResolveHeadFailsWithoutTag(t *testing.T) {
+	chain := &fakeChain{}
+	chain.set(buildChain(10, 11, ""))
+	client := newReorgClient(t, chain)
+
+	tracker := newHeadTracker(evmi_database.EvmBlockchain{HeadMode: string(evmi_database.FinalizedChainHeadMode)}, client, nil, zerolog.Nop())
+	if _, err := tracker.resolve(context.Background()); err == nil {
+		t.Fatal("expected an error when the node reports no finalized block")
+	}
+
+	tracker.chain.HeadMode = "SOON"
+	if _, err := tracker.resolve(context.Background()); err == nil {
+		t.Fatal("expected an error for an unknown head mode")
+	}
+}
+
+func TestHeadTrackerFansOutOneReadPerPoll(t *testing.T) {
+	node := &fakeChain{tags: map[string]uint64{"safe": 90}}
+	node.set(buildChain(100, 101, ""))
+	server, calls := countingServer(t, node)
+
+	chain := evmi_database.EvmBlockchain{
+		Model:        gorm.Model{ID: 1},
+		ChainId:      1,
+		RpcUrl:       server.URL,
+		PullInterval: 3600,
+		HeadMode:     string(evmi_database.SafeChainHeadMode),
+	}
+	trackers := newHeadTrackers()
+	tracker, pool, err := trackers.acquire(chain, nil, nil, zerolog.Nop())
+	if err != nil {
+		t.Fatalf("acquire: %v", err)
+	}
+	again, againPool, _ := trackers.acquire(chain, nil, nil, zerolog.Nop())
+	if again != tracker || againPool != pool {
+		t.Fatal("sources of the same chain should share a tracker and pool")
+	}
+
+	sources := []*SourceIndexerService{newDecoderForTest(t, erc20TransferAbi), newDecoderForTest(t, erc20TransferAbi)}
+	for _, p := range sources {
+		if head := <-waitForHeadAsync(p, tracker, ethereum.FilterQuery{}); head != 90 {
+			t.Fatalf("head = %d, want 90", head)
+		}
+	}
+	if n := calls.Load(); n != 1 {
+		t.Errorf("node received %d head reads, want 1 for both sources", n)
+	}
+
+	// A new head reaches every source; a source that was busy still gets it.
+	first := waitForHeadAsync(sources[0], tracker, ethereum.FilterQuery{})
+	node.setTag("safe", 95)
+	tracker.refresh()
+	if head := <-first; head != 95 {
+		t.Errorf("head = %d, want 95", head)
+	}
+	if head := <-waitForHeadAsync(sources[1], tracker, ethereum.FilterQuery{}); head != 95 {
+		t.Errorf("head = %d, want 95", head)
+	}
+
+	// A head mode change swaps the tracker in place: its sources keep
+	// waiting on it and get the head read under the new mode.
+	chain.HeadMode = string(evmi_database.LatestChainHeadMode)
+	swapped, swappedPool, err := trackers.acquire(chain, nil, nil, zerolog.Nop())
+	if err != nil || swapped != tracker || swappedPool != pool {
+		t.Fatalf("acquire after change = %p, %v, want the same tracker", swapped, err)
+	}
+	if head := <-waitForHeadAsync(sources[0], tracker, ethereum.FilterQuery{}); head != 100 {
+		t.Errorf("head = %d, want the latest block 100", head)
+	}
+
+	// An endpoint change swaps the pool; sources move to it on their next
+	// head and the previous one is closed with its last holder.
+	moved, _ := countingServer(t, node)
+	chain.RpcUrl = moved.URL
+	last, lastPool, err := trackers.acquire(chain, nil, nil, zerolog.Nop())
+	if err != nil || last != tracker || lastPool == pool {
+		t.Fatalf("acquire after endpoint change = %p, %v, want the same tracker and a new pool", last, err)
+	}
+	held := []*rpcPool{pool, againPool, swappedPool}
+	for i := range held {
+		held[i] = trackers.switchPool(tracker, held[i])
+	}
+	if held[0] != lastPool || pool.refs != 0 {
+		t.Errorf("sources hold %p, previous pool has %d holders; want the new pool and none", held[0], pool.refs)
+	}
+
+	for _, p := range append(held, lastPool) {
+		trackers.release(tracker, p)
+	}
+	if _, ok := trackers.trackers[chain.ID]; ok {
+		t.Error("tracker should be dropped with its last source")
+	}
+	if _, ok := trackers.pools.pools[chain.ID]; ok || lastPool.refs != 0 {
+		t.Errorf("pool kept with %d holders after its last release", lastPool.refs)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if _, err := sources[0].waitForHead(ctx, tracker, ethereum.FilterQuery{}); !errors.Is(err, errHeadTrackerStopped) {
+		t.Errorf("waitForHead on a released tracker = %v, want errHeadTrackerStopped", err)
+	}
+}
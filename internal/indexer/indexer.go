@@ -10,23 +10,27 @@ import (
 	"math/big"
 	"os"
 	"reflect"
+	"strconv"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	ethTypes "github.com/ethereum/go-ethereum/core/types"
 	internal_bus "github.com/evmi-cloud/go-evm-indexer/internal/bus"
 	evmi_database "github.com/evmi-cloud/go-evm-indexer/internal/database/evmi-database"
 	log_stores "github.com/evmi-cloud/go-evm-indexer/internal/database/log-stores"
 	"github.com/evmi-cloud/go-evm-indexer/internal/metrics"
 	"github.com/evmi-cloud/go-evm-indexer/internal/types"
-	"github.com/lmittmann/w3"
 	"github.com/lmittmann/w3/module/eth"
 	"github.com/lmittmann/w3/w3types"
 	"github.com/mustafaturan/bus/v3"
 	"github.com/rs/zerolog"
+	"github.com/thejerf/suture/v4"
 )
 
 type SourceIndexerService struct {
@@ -36,12 +40,59 @@ type SourceIndexerService struct {
 
 	store *log_stores.IndexerStore
 
-	chain        evmi_database.EvmBlockchain
-	pipeline     evmi_database.EvmLogPipeline
-	storeInfo    evmi_database.EvmLogStore
-	source       evmi_database.EvmLogSource
-	contractName string
-	abi          abi.ABI
+	chain     evmi_database.EvmBlockchain
+	pipeline  evmi_database.EvmLogPipeline
+	storeInfo evmi_database.EvmLogStore
+	source    evmi_database.EvmLogSource
+	// decoder decodes with the source's first ABI. Proxy sources decode with
+	// the decoder of the implementation active at each log instead, when one
+	// has an ABI mapped. See proxyState. extraDecoders are the source's other
+	// ABIs, for the logs and calldata the first one does not declare. See
+	// decoderOf.
+	decoder       *abiDecoder
+	extraDecoders []*abiDecoder
+	proxy         *proxyState
+	// signatures decodes the logs of FULL sources, and those of TOPIC sources
+	// their ABIs do not declare. See signatureRegistry.
+	signatures *signatureRegistry
+	// traceRules are the TRACE creation rules of a FACTORY source, loaded
+	// with the source. See registerTraceFactoryChildren.
+	traceRules []evmi_database.EvmFactoryRule
+
+	// recentBlocks holds the hashes of the blocks indexed near the head, to
+	// detect reorgs. See blockHashWindow.
+	recentBlocks *blockHashWindow
+
+	// sqd backfills ranges below sqdHeight, the archive height read on the last
+	// poll, when the chain has an SQD gateway. sqdDone is set once the cursor
+	// has caught up with the archive.
+	sqd       *sqdClient
+	sqdHeight uint64
+	sqdDone   bool
+
+	// window is the adaptive eth_getLogs range size, shared by the catch-up
+	// workers under windowMu. See logsWindow.
+	window   uint64
+	windowMu sync.Mutex
+
+	// push is the source's logs subscription when the chain is in logs push
+	// mode; pushAttempt is the time of the last subscription attempt. headSeq is
+	// the sequence number of the last head consumed from the chain's tracker.
+	// See waitForHead.
+	push        *pushSubscription
+	pushAttempt time.Time
+	headSeq     uint64
+
+	// heads holds the chain's head tracker and JSON-RPC endpoint pool, shared
+	// with the other sources of the chain.
+	heads *headTrackers
+	// batches coalesces the eth_getLogs ranges of the pipeline's address
+	// sources on the chain. See logsBatch.
+	batches *logsBatches
+
+	// completed is set once a bounded source indexed its end block and its
+	// service ended. See markCompleted.
+	completed atomic.Bool
 
 	logger zerolog.Logger
 }
@@ -50,6 +101,8 @@ func NewSourceIndexerService(
 	db *evmi_database.EvmiDatabase,
 	bus *bus.Bus,
 	metrics *metrics.MetricService,
+	heads *headTrackers,
+	batches *logsBatches,
 	source evmi_database.EvmLogSource,
 ) *SourceIndexerService {
 
@@ -61,6 +114,8 @@ func NewSourceIndexerService(
 		db:      db,
 		bus:     bus,
 		metrics: metrics,
+		heads:   heads,
+		batches: batches,
 		source:  source,
 		logger:  logger,
 	}
@@ -146,6 +201,7 @@ func (p *SourceIndexerService) cloneFactoryRuleSubtree(childSourceID uint, templ
 	for _, t := range templates {
 		clone := evmi_database.EvmFactoryRule{
 			ParentRuleID:          newParentRuleID,
+			Trigger:               t.Trigger,
 			CreationFunctionName:  t.CreationFunctionName,
 			CreationAddressLogArg: t.CreationAddressLogArg,
 			ChildType:             t.ChildType,
@@ -205,6 +261,10 @@ func (p *SourceIndexerService) registerFactoryChild(rule evmi_database.EvmFactor
 		EvmLogPipelineID: p.pipeline.ID,
 		EvmJsonAbiID:     rule.EvmJsonAbiID,
 		EvmBlockchainID:  p.source.EvmBlockchainID,
+		// The children of a bounded factory end with it, and catch up like
+		// it.
+		EndBlock:       p.source.EndBlock,
+		CatchUpWorkers: p.source.CatchUpWorkers,
 	}
 	if err := p.db.Conn.Create(&child).Error; err != nil {
 		return err
@@ -236,7 +296,7 @@ func (p *SourceIndexerService) registerFactoryChildren(dbLogs []types.EvmLog) er
 
 	for _, log := range dbLogs {
 		for _, rule := range rules {
-			if log.Metadata.EventName != rule.CreationFunctionName {
+			if rule.Trigger == string(evmi_database.TraceFactoryRuleTrigger) || log.Metadata.EventName != rule.CreationFunctionName {
 				continue
 			}
 
@@ -266,61 +326,70 @@ func (p *SourceIndexerService) registerFactoryChildren(dbLogs []types.EvmLog) er
 	return nil
 }
 
-func (p *SourceIndexerService) Serve(ctx context.Context) error {
-
-	logParams := map[string]interface{}{
-		"type": p.source.Type,
+// loadTraceRules loads the TRACE creation rules of a FACTORY source.
+func (p *SourceIndexerService) loadTraceRules() error {
+	rules, err := p.factoryRules()
+	if err != nil {
+		return err
 	}
+	p.traceRules = traceRules(rules)
+	return nil
+}
 
-	p.logger.Info().Fields(logParams).Msg("starting subprocess")
-
-	p.logger.Info().Fields(logParams).Msg("loading blockchain")
-	result := p.db.Conn.First(&p.chain, p.source.EvmBlockchainID)
-	if result.Error != nil {
-		return result.Error
+// registerTraceFactoryChildren registers a child source for each contract the
+// factory created in [from, to] that matches one of its TRACE rules, loaded
+// with the source. The traces are only read when the factory has such a rule.
+// As for creation events, a failure is returned so the range is retried.
+func (p *SourceIndexerService) registerTraceFactoryChildren(ctx context.Context, client *rpcPool, from, to uint64) error {
+	if len(p.traceRules) == 0 {
+		return nil
 	}
 
-	p.logger.Info().Fields(logParams).Msg("loading pipeline")
-	result = p.db.Conn.First(&p.pipeline, p.source.EvmLogPipelineID)
-	if result.Error != nil {
-		return result.Error
+	creations, err := p.traceCreations(ctx, client, from, to)
+	if err != nil {
+		p.logger.Error().Msg("factory creation traces failed: " + err.Error())
+		return err
 	}
-
-	p.logger.Info().Fields(logParams).Msg("loading store")
-	result = p.db.Conn.First(&p.storeInfo, p.pipeline.EvmLogStoreId)
-	if result.Error != nil {
-		return result.Error
+	for _, creation := range creations {
+		for _, rule := range p.traceRules {
+			if !ruleConditionsPass(rule, creationArgs(creation)) {
+				continue
+			}
+			if err := p.registerFactoryChild(rule, creation.To, creation.BlockNumber); err != nil {
+				p.logger.Error().Msg("factory child registration failed: " + err.Error())
+				return err
+			}
+		}
 	}
+	return nil
+}
 
-	// FULL sources index every log without decoding, so they need no ABI.
-	if p.source.Type != string(evmi_database.FullLogSourceType) {
-		p.logger.Info().Fields(logParams).Msg("loading abi")
-		var abiEntry evmi_database.EvmJsonAbi
-		result = p.db.Conn.First(&abiEntry, p.source.EvmJsonAbiID)
-		if result.Error != nil {
-			return result.Error
-		}
+// Serve runs the source's indexer until it is stopped, completes or fails. A
+// failure is recorded on the source, which waits in LOOPBACKOFF before the
+// supervisor restarts it. See failed. A stopped head tracker is not a failure:
+// the source is restarted at once onto the chain's current tracker.
+func (p *SourceIndexerService) Serve(ctx context.Context) error {
+	err := p.serve(ctx)
+	if err == nil || errors.Is(err, suture.ErrDoNotRestart) || errors.Is(err, errHeadTrackerStopped) || ctx.Err() != nil {
+		return err
+	}
+	return p.failed(ctx, err)
+}
 
-		abi, err := abi.JSON(strings.NewReader(abiEntry.Content))
-		if err != nil {
-			return err
-		}
+func (p *SourceIndexerService) serve(ctx context.Context) error {
 
-		p.contractName = abiEntry.ContractName
-		p.abi = abi
+	logParams := map[string]interface{}{
+		"type": p.source.Type,
 	}
 
-	p.logger.Info().Fields(logParams).Msg("loading store config")
-	var storeConfig map[string]string
-	err := json.Unmarshal(p.storeInfo.StoreConfig, &storeConfig)
-	if err != nil {
-		return err
+	p.logger.Info().Fields(logParams).Msg("starting subprocess")
+
+	// A bounded source restarted after its end block has nothing left to do.
+	if p.reachedEnd() {
+		return p.markCompleted()
 	}
 
-	p.logger.Info().Fields(logParams).Msg("connecting store")
-	p.store, err = log_stores.LoadStore(p.storeInfo.StoreType, storeConfig, p.logger)
-	if err != nil {
-		p.logger.Error().Msg(err.Error())
+	if err := p.loadSource(logParams); err != nil {
 		return err
 	}
 
@@ -328,8 +397,13 @@ func (p *SourceIndexerService) Serve(ctx context.Context) error {
 	// Only update the columns this worker owns (status, sync_block): the row is
 	// shared with the manager (enabled) and a full-row Save would write a stale
 	// copy of the other columns back.
+	// A source restarted after failures stays in LOOPBACKOFF until it indexes
+	// a range.
 	p.source.Status = string(evmi_database.RunningLogSourceStatus)
-	result = p.db.Conn.Model(&p.source).Update("status", p.source.Status)
+	if p.source.ConsecutiveFailures > 0 {
+		p.source.Status = string(evmi_database.LoopbackOffLogSourceStatus)
+	}
+	result := p.db.Conn.Model(&p.source).Update("status", p.source.Status)
 	if result.Error != nil {
 		return result.Error
 	}
@@ -365,17 +439,127 @@ func (p *SourceIndexerService) Serve(ctx context.Context) error {
 				ToBlock:   toBlock,
 			}
 		})
+	case string(evmi_database.TransactionsLogSourceType), string(evmi_database.BlocksLogSourceType), string(evmi_database.TracesLogSourceType):
+		// The blocks are scanned for the source's transactions, stored or
+		// traced: the query is not used.
+		return p.serveIndexation(ctx, func(fromBlock, toBlock *big.Int) ethereum.FilterQuery {
+			return ethereum.FilterQuery{
+				FromBlock: fromBlock,
+				ToBlock:   toBlock,
+			}
+		})
 	}
 
 	return errors.New("config types invalid")
 }
 
-// topicFilters generates a positional topic request: topics[0] is the event
-// signature (topic0); topics[1..] are per-indexed-argument filters in
+// loadSource loads what indexing and decoding the source need: its chain,
+// pipeline and store, its ABIs, factory trace rules and signature registry,
+// and the store connection, with the event tables of its ABIs migrated.
+func (p *SourceIndexerService) loadSource(logParams map[string]interface{}) error {
+	p.logger.Info().Fields(logParams).Msg("loading blockchain")
+	result := p.db.Conn.Preload("RpcEndpoints").First(&p.chain, p.source.EvmBlockchainID)
+	if result.Error != nil {
+		return result.Error
+	}
+
+	p.logger.Info().Fields(logParams).Msg("loading pipeline")
+	result = p.db.Conn.First(&p.pipeline, p.source.EvmLogPipelineID)
+	if result.Error != nil {
+		return result.Error
+	}
+
+	p.logger.Info().Fields(logParams).Msg("loading store")
+	result = p.db.Conn.First(&p.storeInfo, p.pipeline.EvmLogStoreId)
+	if result.Error != nil {
+		return result.Error
+	}
+
+	// FULL sources index every log without decoding, and BLOCKS and TRACES
+	// sources no log, so they need no ABI.
+	decodes := p.source.Type != string(evmi_database.FullLogSourceType) && p.source.Type != string(evmi_database.BlocksLogSourceType) &&
+		p.source.Type != string(evmi_database.TracesLogSourceType)
+	if decodes {
+		p.logger.Info().Fields(logParams).Msg("loading abi")
+		abiIDs := p.source.AbiIDs()
+		if len(abiIDs) == 0 {
+			abiIDs = []uint{p.source.EvmJsonAbiID}
+		}
+		p.extraDecoders = nil
+		for i, abiID := range abiIDs {
+			var abiEntry evmi_database.EvmJsonAbi
+			result = p.db.Conn.First(&abiEntry, abiID)
+			if result.Error != nil {
+				return result.Error
+			}
+
+			abi, err := abi.JSON(strings.NewReader(abiEntry.Content))
+			if err != nil {
+				return err
+			}
+
+			if i == 0 {
+				p.setAbi(abiEntry.ContractName, abi)
+			} else {
+				p.addAbi(abiEntry.ContractName, abi)
+			}
+		}
+	}
+
+	if p.source.Type == string(evmi_database.FactoryLogSourceType) {
+		p.logger.Info().Fields(logParams).Msg("loading factory trace rules")
+		if err := p.loadTraceRules(); err != nil {
+			return err
+		}
+	}
+
+	if p.source.Type == string(evmi_database.FullLogSourceType) || p.source.Type == string(evmi_database.TopicLogSourceType) {
+		p.logger.Info().Fields(logParams).Msg("loading signature registry")
+		signatures, err := loadSignatureRegistry(p.db, p.logger)
+		if err != nil {
+			return err
+		}
+		p.signatures = signatures
+	}
+
+	p.logger.Info().Fields(logParams).Msg("loading store config")
+	var storeConfig map[string]string
+	err := json.Unmarshal(p.storeInfo.StoreConfig, &storeConfig)
+	if err != nil {
+		return err
+	}
+
+	p.logger.Info().Fields(logParams).Msg("connecting store")
+	p.store, err = log_stores.LoadStore(p.storeInfo.StoreType, storeConfig, p.logger)
+	if err != nil {
+		p.logger.Error().Msg(err.Error())
+		return err
+	}
+
+	if tables, ok := p.store.GetStorage().(log_stores.EventTablesStorage); ok && decodes {
+		p.logger.Info().Fields(logParams).Msg("migrating event tables")
+		for _, decoder := range append([]*abiDecoder{p.decoder}, p.extraDecoders...) {
+			err = tables.EnsureEventTables(decoder.contractName, types.NewEvmAbiSchema(decoder.abi).Events)
+			if err != nil {
+				p.logger.Error().Msg(err.Error())
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// topicFilters generates a positional topic request: topics[0] is the OR-list
+// of event signatures (topic0s); topics[1..] are per-indexed-argument filters in
 // declaration order. An empty filter entry is a wildcard (match any value at
 // that position), represented by a nil inner slice.
 func (p *SourceIndexerService) topicFilters() [][]common.Hash {
-	topics := [][]common.Hash{{common.HexToHash(p.source.Topic0.String)}}
+	var topic0s []common.Hash
+	for _, topic0 := range p.source.Topic0Values() {
+		topic0s = append(topic0s, common.HexToHash(topic0))
+	}
+	topics := [][]common.Hash{topic0s}
 	for _, topic := range p.source.TopicFilters {
 		if len(topic) == 0 {
 			topics = append(topics, nil)
@@ -387,101 +571,253 @@ func (p *SourceIndexerService) topicFilters() [][]common.Hash {
 }
 
 // serveIndexation is the poll loop shared by every source type: wait for the
-// chain head to move, fetch the filtered logs in BlockRange windows, decode and
-// store them, advance the SyncBlock cursor. Errors are returned (never fataled)
-// so the supervisor restarts this source alone — the cursor was not advanced,
-// so the failed range is replayed on restart.
+// chain head (as selected by the chain's HeadMode) to move, fetch the filtered
+// logs in windows of up to BlockRange blocks (see logsWindow), decode and store
+// them, advance the SyncBlock cursor. Heads come from the chain's headTracker,
+// shared by all of its sources (see waitForHead). Address sources of the same
+// pipeline and chain at the same cursor share their eth_getLogs calls (see
+// logsBatch). When the chain has an SQD gateway, ranges the archive holds are
+// fetched from it instead of JSON-RPC. A source with catch-up workers fetches
+// the ranges far from the head concurrently (see catchUp). Ranges near the head
+// are checked for reorgs first; on a reorg the source is rolled back to the
+// common ancestor and the loop resumes from there. Errors are returned (never
+// fataled) so the supervisor restarts this source alone — the cursor was not
+// advanced, so the failed range is replayed on restart.
 func (p *SourceIndexerService) serveIndexation(ctx context.Context, filter func(fromBlock, toBlock *big.Int) ethereum.FilterQuery) error {
-	client, err := w3.Dial(p.chain.RpcUrl)
+	tracker, client, err := p.heads.acquire(p.chain, p.db, p.metrics, p.logger)
 	if err != nil {
 		return err
 	}
-	defer client.Close()
+	// client moves to the chain's new pool when its configuration changes.
+	defer func() { p.heads.release(tracker, client) }()
+	defer p.closePush()
+	p.headSeq = 0
+
+	// The archive path fetches logs: sources scanning blocks use JSON-RPC
+	// only.
+	if p.chain.SqdGatewayAvailable && p.chain.SqdGatewayUrl != "" && p.sqd == nil && !p.scansBlocks() {
+		p.sqd = newSqdClient(p.chain.SqdGatewayUrl)
+	}
+
+	p.setLogsWindow(p.logsWindow())
 
 	latestBlockNumberIndexed := p.source.SyncBlock
 	if latestBlockNumberIndexed < p.source.StartBlock {
 		latestBlockNumberIndexed = p.source.StartBlock
 	}
 
+	if p.source.Type == string(evmi_database.ContractLogSourceType) || p.source.Type == string(evmi_database.FactoryLogSourceType) {
+		if err := p.initProxy(ctx, client, latestBlockNumberIndexed+1); err != nil {
+			return err
+		}
+	}
+	if p.source.Type == string(evmi_database.BlocksLogSourceType) && p.source.SyncBlock > 0 {
+		if err := p.loadStoredHashes(p.source.SyncBlock); err != nil {
+			return err
+		}
+	}
+
+	batch := p.batches.join(p, latestBlockNumberIndexed+1)
+	defer p.batches.leave(batch, p)
+
 	for {
-		if err := p.waitPullInterval(ctx); err != nil {
-			return p.markStopped()
+		if p.reachedEnd() {
+			return p.markCompleted()
 		}
 
-		var block *big.Int
-		if err := p.timedRPC("eth_blockNumber", func() error {
-			return client.Call(eth.BlockNumber().Returns(&block))
-		}); err != nil {
+		currentBlock, err := p.waitForHead(ctx, tracker, filter(nil, nil))
+		if err != nil {
+			if ctx.Err() != nil {
+				return p.markStopped()
+			}
 			return err
 		}
+		client = p.heads.switchPool(tracker, client)
 
-		p.metrics.SetChainHead(p.chain.ChainId, block.Uint64())
-		p.metrics.SetSourceProgress(p.sourceLabels(), block.Uint64(), p.source.SyncBlock)
+		p.metrics.SetSourceProgress(p.sourceLabels(), currentBlock, p.source.SyncBlock)
 
-		currentBlock := block.Uint64()
+		// A bounded source indexes up to its end block, the last range being
+		// indexed even when shorter than BlockSlice.
+		lastBlock := currentBlock
+		if p.source.EndBlock > 0 && lastBlock > p.source.EndBlock {
+			lastBlock = p.source.EndBlock
+		}
 
 		// The head must be at least BlockSlice ahead of the cursor. Compared
 		// without subtracting first: a lagging RPC node can report a head below
 		// the cursor and the unsigned difference would wrap around.
-		if currentBlock <= latestBlockNumberIndexed || currentBlock-latestBlockNumberIndexed < p.chain.BlockSlice {
+		if lastBlock <= latestBlockNumberIndexed || (lastBlock-latestBlockNumberIndexed < p.chain.BlockSlice && lastBlock != p.source.EndBlock) {
 			continue
 		}
 
-		for i := latestBlockNumberIndexed + 1; i <= currentBlock; i += p.chain.BlockRange {
+		p.refreshSqdHeight(ctx, latestBlockNumberIndexed)
+
+		var toBlock uint64
+		for i := latestBlockNumberIndexed + 1; i <= lastBlock; i = toBlock + 1 {
 			if ctx.Err() != nil {
 				return p.markStopped()
 			}
 
-			toBlock := i + p.chain.BlockRange - 1
-			if toBlock > currentBlock {
-				toBlock = currentBlock
+			// Far from the head, the catch-up workers index the ranges.
+			if ceiling, ok := p.catchUpCeiling(i, lastBlock, currentBlock); ok {
+				if err := p.catchUp(ctx, client, filter, batch, i, ceiling, currentBlock); err != nil {
+					if ctx.Err() != nil {
+						return p.markStopped()
+					}
+					return err
+				}
+				toBlock = ceiling
+				continue
 			}
 
-			if err := p.indexRange(client, filter, i, toBlock, currentBlock); err != nil {
-				return err
+			toBlock = i + p.logsWindow() - 1
+			if toBlock > lastBlock {
+				toBlock = lastBlock
+			}
+			// Split at the archive height so the archive serves the range up to
+			// it and JSON-RPC the rest.
+			if i <= p.sqdHeight && toBlock > p.sqdHeight {
+				toBlock = p.sqdHeight
 			}
+
+			// Batched ranges are fetched first: the batch may end the range
+			// early, and only the blocks indexed are checked for reorgs.
+			var batched *batchedLogs
+			if batch != nil && !p.sqdCovers(toBlock) {
+				batched, err = batch.fetch(ctx, p, client, i, toBlock)
+				if err != nil {
+					return &rangeError{from: i, to: toBlock, err: err}
+				}
+				toBlock = batched.to
+			}
+
+			headers, ancestor, reorged, err := p.checkReorg(ctx, client, i, toBlock, currentBlock)
+			if err != nil {
+				return &rangeError{from: i, to: toBlock, err: err}
+			}
+			if reorged {
+				if err := p.rollback(ancestor); err != nil {
+					return err
+				}
+				break
+			}
+
+			if err := p.indexRange(ctx, client, filter, batched, i, toBlock, currentBlock); err != nil {
+				return &rangeError{from: i, to: toBlock, err: err}
+			}
+			p.recordHeaders(headers)
+			batch.moved(p, toBlock+1)
 		}
 
-		latestBlockNumberIndexed = currentBlock
+		latestBlockNumberIndexed = p.source.SyncBlock
+		batch.moved(p, latestBlockNumberIndexed+1)
 	}
 }
 
 // indexRange fetches, decodes and stores the logs of [fromBlock, toBlock], then
-// advances the source cursor. The cursor is only written after a successful
-// store write, so a failure anywhere replays the whole range.
-func (p *SourceIndexerService) indexRange(client *w3.Client, filter func(fromBlock, toBlock *big.Int) ethereum.FilterQuery, from, to, head uint64) error {
+// advances the source cursor. batched holds the range's logs when they were
+// already fetched by the source's logsBatch. The cursor is only written after a
+// successful store write, so a failure anywhere replays the whole range.
+func (p *SourceIndexerService) indexRange(ctx context.Context, client *rpcPool, filter func(fromBlock, toBlock *big.Int) ethereum.FilterQuery, batched *batchedLogs, from, to, head uint64) error {
 	rangeStart := time.Now()
-	fromBlock := new(big.Int).SetUint64(from)
-	toBlock := new(big.Int).SetUint64(to)
 
+	// Upgrades are recorded first so the range's logs decode with the
+	// implementation active at each of them.
+	if err := p.trackUpgrades(ctx, client, from, to); err != nil {
+		p.logger.Error().Fields(p.rangeLogParams(from, to)).Msg(err.Error())
+		return err
+	}
+
+	fetched, err := p.fetchIndexedRange(ctx, client, filter, batched, from, to)
+	if err != nil {
+		return err
+	}
+	fetched.start = rangeStart
+	return p.commitRange(ctx, client, fetched, head)
+}
+
+// indexedRange is what indexing [from, to] stores: the decoded logs and
+// transactions, or the blocks or traces of the source. start is when the
+// range began, for the batch duration metric.
+type indexedRange struct {
+	from, to uint64
+	start    time.Time
+
+	logs   []types.EvmLog
+	txs    []types.EvmTransaction
+	blocks []types.EvmBlock
+	traces []types.EvmTrace
+}
+
+func (p *SourceIndexerService) rangeLogParams(from, to uint64) map[string]interface{} {
 	source := p.source.Address.String
 	if source == "" {
 		source = p.source.Topic0.String
 	}
 
-	logParams := map[string]interface{}{
+	return map[string]interface{}{
 		"store":     p.storeInfo.Identifier,
 		"source":    source,
-		"fromBlock": fromBlock,
-		"toBlock":   toBlock,
+		"fromBlock": new(big.Int).SetUint64(from),
+		"toBlock":   new(big.Int).SetUint64(to),
 	}
+}
 
-	p.logger.Info().Fields(logParams).Msg("Fetch logs")
+// fetchIndexedRange fetches and decodes the data of [from, to] without
+// storing anything, so catch-up workers can run it for several ranges at
+// once. See catchUp.
+func (p *SourceIndexerService) fetchIndexedRange(ctx context.Context, client *rpcPool, filter func(fromBlock, toBlock *big.Int) ethereum.FilterQuery, batched *batchedLogs, from, to uint64) (*indexedRange, error) {
+	fetched := &indexedRange{from: from, to: to, start: time.Now()}
+	logParams := p.rangeLogParams(from, to)
 
-	var logs []ethTypes.Log
-	if err := p.timedRPC("eth_getLogs", func() error {
-		return client.Call(eth.Logs(filter(fromBlock, toBlock)).Returns(&logs))
-	}); err != nil {
-		p.logger.Error().Fields(logParams).Msg(err.Error())
-		return err
+	var err error
+	switch p.source.Type {
+	case string(evmi_database.BlocksLogSourceType):
+		p.logger.Info().Fields(logParams).Msg("Fetch blocks")
+		fetched.blocks, err = p.fetchBlocks(ctx, client, from, to)
+	case string(evmi_database.TracesLogSourceType):
+		p.logger.Info().Fields(logParams).Msg("Fetch traces")
+		var addresses []common.Address
+		for _, address := range p.source.AddressValues() {
+			addresses = append(addresses, common.HexToAddress(address))
+		}
+		fetched.traces, err = p.fetchTraces(ctx, client, from, to, addresses)
+	default:
+		fetched.logs, fetched.txs, err = p.fetchRange(ctx, client, filter, batched, new(big.Int).SetUint64(from), new(big.Int).SetUint64(to), logParams)
 	}
-
-	dbLogs, dbTxs, err := p.computeLogsAndTxs(client, logs)
 	if err != nil {
 		p.logger.Error().Fields(logParams).Msg(err.Error())
-		return err
+		return nil, err
 	}
+	return fetched, nil
+}
 
+// commitRange stores a fetched range, registers the children a FACTORY source
+// created in it, then advances the source cursor to its last block. Ranges
+// must be committed in block order.
+func (p *SourceIndexerService) commitRange(ctx context.Context, client *rpcPool, fetched *indexedRange, head uint64) error {
+	var err error
+	if len(fetched.blocks) > 0 {
+		blocksStart := time.Now()
+		err = p.store.GetStorage().InsertBlocks(fetched.blocks)
+		p.metrics.ObserveStoreWrite(p.storeInfo.Identifier, "blocks", time.Since(blocksStart), err)
+		if err != nil {
+			p.logger.Error().Msg(err.Error())
+			return err
+		}
+	}
+
+	if len(fetched.traces) > 0 {
+		tracesStart := time.Now()
+		err = p.store.GetStorage().InsertTraces(fetched.traces)
+		p.metrics.ObserveStoreWrite(p.storeInfo.Identifier, "traces", time.Since(tracesStart), err)
+		if err != nil {
+			p.logger.Error().Msg(err.Error())
+			return err
+		}
+	}
+
+	dbLogs := fetched.logs
 	if len(dbLogs) > 0 {
 		logsStart := time.Now()
 		err = p.store.GetStorage().InsertLogs(dbLogs)
@@ -503,43 +839,81 @@ func (p *SourceIndexerService) indexRange(client *w3.Client, filter func(fromBlo
 		p.metrics.AddLogsIndexed(p.sourceLabels(), uint64(len(dbLogs)))
 	}
 
-	if len(dbTxs) > 0 {
+	if p.source.Type == string(evmi_database.FactoryLogSourceType) {
+		if err := p.registerTraceFactoryChildren(ctx, client, fetched.from, fetched.to); err != nil {
+			return err
+		}
+	}
+
+	if len(fetched.txs) > 0 {
 		txStart := time.Now()
-		err = p.store.GetStorage().InsertTransactions(dbTxs)
+		err = p.store.GetStorage().InsertTransactions(fetched.txs)
 		p.metrics.ObserveStoreWrite(p.storeInfo.Identifier, "transactions", time.Since(txStart), err)
 		if err != nil {
 			p.logger.Error().Msg(err.Error())
 			return err
 		}
-		p.metrics.AddTransactionsIndexed(p.sourceLabels(), uint64(len(dbTxs)))
+		p.metrics.AddTransactionsIndexed(p.sourceLabels(), uint64(len(fetched.txs)))
 	}
 
-	p.source.SyncBlock = to
-	tx := p.db.Conn.Model(&p.source).Update("sync_block", to)
+	// The first range indexed after failures ends the LOOPBACKOFF status; the
+	// last error is kept.
+	updates := map[string]interface{}{"sync_block": fetched.to}
+	if p.source.ConsecutiveFailures > 0 {
+		updates["consecutive_failures"] = 0
+		updates["status"] = string(evmi_database.RunningLogSourceStatus)
+	}
+	tx := p.db.Conn.Model(&p.source).Updates(updates)
 	if tx.Error != nil {
 		p.logger.Error().Msg(tx.Error.Error())
 		return tx.Error
 	}
+	p.source.SyncBlock = fetched.to
+	if p.source.ConsecutiveFailures > 0 {
+		p.source.ConsecutiveFailures = 0
+		p.source.Status = string(evmi_database.RunningLogSourceStatus)
+	}
 
 	p.emitSourceUpdate()
 
 	p.metrics.SetSourceProgress(p.sourceLabels(), head, p.source.SyncBlock)
-	p.metrics.ObserveBatchDuration(p.sourceLabels(), time.Since(rangeStart))
+	p.metrics.ObserveBatchDuration(p.sourceLabels(), time.Since(fetched.start))
 	return nil
 }
 
-// waitPullInterval sleeps one PullInterval, returning early with the context
-// error when the supervisor stops this source, so disable/shutdown doesn't wait
-// a full interval.
-func (p *SourceIndexerService) waitPullInterval(ctx context.Context) error {
-	timer := time.NewTimer(time.Duration(p.chain.PullInterval) * time.Second)
-	defer timer.Stop()
-	select {
-	case <-ctx.Done():
-		return ctx.Err()
-	case <-timer.C:
-		return nil
+// fetchRange returns the decoded logs and transactions of [fromBlock, toBlock],
+// from the batched logs when given, from the SQD archive when it holds the
+// whole range and over JSON-RPC otherwise. An archive failure falls back to
+// JSON-RPC for the range. TRANSACTIONS sources have no logs: their blocks are
+// scanned for their transactions instead.
+func (p *SourceIndexerService) fetchRange(ctx context.Context, client *rpcPool, filter func(fromBlock, toBlock *big.Int) ethereum.FilterQuery, batched *batchedLogs, fromBlock, toBlock *big.Int, logParams map[string]interface{}) ([]types.EvmLog, []types.EvmTransaction, error) {
+	if batched != nil {
+		return p.computeLogsAndTxs(ctx, client, batched.logs)
+	}
+
+	if p.source.Type == string(evmi_database.TransactionsLogSourceType) {
+		p.logger.Info().Fields(logParams).Msg("Scan blocks for transactions")
+		dbTxs, err := p.fetchTransactions(ctx, client, fromBlock.Uint64(), toBlock.Uint64())
+		return nil, dbTxs, err
 	}
+
+	if p.sqdCovers(toBlock.Uint64()) {
+		p.logger.Info().Fields(logParams).Msg("Fetch logs from sqd archive")
+		dbLogs, dbTxs, err := p.fetchFromSqd(ctx, filter(fromBlock, toBlock), fromBlock.Uint64(), toBlock.Uint64())
+		if err == nil {
+			return dbLogs, dbTxs, nil
+		}
+		p.logger.Warn().Fields(logParams).Msg("sqd archive failed, falling back to JSON-RPC: " + err.Error())
+	}
+
+	p.logger.Info().Fields(logParams).Msg("Fetch logs")
+
+	logs, err := p.getLogs(ctx, client, filter, fromBlock.Uint64(), toBlock.Uint64())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return p.computeLogsAndTxs(ctx, client, logs)
 }
 
 // markStopped persists the STOPPED status when the supervisor cancels this
@@ -553,6 +927,26 @@ func (p *SourceIndexerService) markStopped() error {
 	return nil
 }
 
+// reachedEnd reports whether a bounded source indexed its end block.
+func (p *SourceIndexerService) reachedEnd() bool {
+	end := p.source.EndBlock
+	return end > 0 && (p.source.SyncBlock >= end || p.source.StartBlock >= end)
+}
+
+// markCompleted persists the COMPLETED status of a bounded source that indexed
+// its end block, and stops the service for good: the supervisor does not
+// restart it.
+func (p *SourceIndexerService) markCompleted() error {
+	p.source.Status = string(evmi_database.CompletedLogSourceStatus)
+	if tx := p.db.Conn.Model(&p.source).Update("status", p.source.Status); tx.Error != nil {
+		return tx.Error
+	}
+	p.completed.Store(true)
+	p.logger.Info().Uint64("endBlock", p.source.EndBlock).Msg("source completed")
+	p.emitSourceUpdate()
+	return suture.ErrDoNotRestart
+}
+
 // emitSourceUpdate broadcasts the source's current state (sync block, status, …)
 // on the bus so subscribers (e.g. the StreamEvmLogSourceUpdates gRPC stream) can
 // observe indexing progress live.
@@ -572,71 +966,174 @@ func (p *SourceIndexerService) sourceLabels() metrics.SourceLabels {
 	}
 }
 
-// timedRPC runs one JSON-RPC call, recording its latency and outcome under the
-// given method name.
-func (p *SourceIndexerService) timedRPC(method string, fn func() error) error {
-	start := time.Now()
-	err := fn()
-	p.metrics.RecordRPC(p.chain.ChainId, method, time.Since(start), err)
-	return err
+// abiDecoder decodes logs and calldata with one ABI. eventArgs and
+// functionArgs are the argument schemas of the ABI's entries, by their
+// go-ethereum (overload-unique) name.
+type abiDecoder struct {
+	contractName string
+	abi          abi.ABI
+	eventArgs    map[string][]types.EvmArgSchema
+	functionArgs map[string][]types.EvmArgSchema
 }
 
-func (p *SourceIndexerService) GetLogMetadata(log ethTypes.Log) types.EvmMetadata {
-
-	// FULL sources are not decoded; anonymous events (no topic0) cannot be
-	// matched against the ABI.
-	if p.source.Type == string(evmi_database.FullLogSourceType) || len(log.Topics) == 0 {
-		return types.EvmMetadata{
-			ContractName: "Unknown",
-			EventName:    "Unknown",
-			Data:         map[string]string{},
-		}
+func newAbiDecoder(contractName string, parsed abi.ABI) *abiDecoder {
+	d := &abiDecoder{
+		contractName: contractName,
+		abi:          parsed,
+		eventArgs:    make(map[string][]types.EvmArgSchema, len(parsed.Events)),
+		functionArgs: make(map[string][]types.EvmArgSchema, len(parsed.Methods)),
+	}
+	for name, event := range parsed.Events {
+		d.eventArgs[name] = types.EventSchema(event).Args
 	}
+	for name, method := range parsed.Methods {
+		d.functionArgs[name] = types.FunctionSchema(method).Args
+	}
+	return d
+}
 
-	var eventName string = ""
-	event := map[string]any{}
-	for _, evInfo := range p.abi.Events {
-		if evInfo.ID.Hex() != log.Topics[0].Hex() {
-			continue
-		}
+// setAbi sets the ABI logs and calldata are decoded with.
+func (p *SourceIndexerService) setAbi(contractName string, parsed abi.ABI) {
+	p.decoder = newAbiDecoder(contractName, parsed)
+}
 
-		eventName = evInfo.RawName
+// addAbi adds an ABI for the logs and calldata the previous ones do not
+// declare.
+func (p *SourceIndexerService) addAbi(contractName string, parsed abi.ABI) {
+	p.extraDecoders = append(p.extraDecoders, newAbiDecoder(contractName, parsed))
+}
 
-		indexed := make([]abi.Argument, 0)
-		for _, input := range evInfo.Inputs {
-			if input.Indexed {
-				indexed = append(indexed, input)
-			}
+// decoderOf returns the first decoder, among the one active at the log at
+// (block, logIndex) and the source's other ABIs, whose ABI declares an entry
+// (an event or function). It returns the active one when none does.
+func (p *SourceIndexerService) decoderOf(block uint64, logIndex uint, declares func(abi.ABI) bool) *abiDecoder {
+	active := p.decoderAt(block, logIndex)
+	if declares(active.abi) {
+		return active
+	}
+	for _, decoder := range p.extraDecoders {
+		if declares(decoder.abi) {
+			return decoder
 		}
+	}
+	return active
+}
 
-		// The raw topics and data are stored on the log regardless, so a decode
-		// failure must not stop the source: keep whatever decoded and move on.
-		if err := abi.ParseTopicsIntoMap(event, indexed, log.Topics[1:]); err != nil {
-			p.logger.Warn().Str("event", eventName).Msg("topics decode failed: " + err.Error())
+func (p *SourceIndexerService) GetLogMetadata(log ethTypes.Log) types.EvmMetadata {
+
+	// Anonymous events (no topic0) cannot be matched against an ABI.
+	if len(log.Topics) == 0 {
+		return unknownLogMetadata()
+	}
+
+	// FULL sources have no ABI: their logs are decoded with the signature
+	// registry only.
+	if p.source.Type != string(evmi_database.FullLogSourceType) {
+		decoder := p.decoderOf(log.BlockNumber, log.Index, func(a abi.ABI) bool {
+			_, err := a.EventByID(log.Topics[0])
+			return err == nil
+		})
+		event, err := decoder.abi.EventByID(log.Topics[0])
+		if err == nil {
+			return p.eventMetadata(decoder.contractName, *event, decoder.eventArgs[event.Name], log)
 		}
-		if err := p.abi.UnpackIntoMap(event, evInfo.Name, log.Data); err != nil {
-			p.logger.Warn().Str("event", eventName).Msg("data decode failed: " + err.Error())
+		if p.source.Type != string(evmi_database.TopicLogSourceType) {
+			return types.EvmMetadata{ContractName: decoder.contractName, Data: map[string]string{}}
 		}
+	}
 
-		break
+	// The registry decodes the event, but the contract emitting it is not
+	// known.
+	if registered, ok := p.signatures.lookup(log.Topics); ok {
+		return p.eventMetadata("Unknown", registered.event, registered.args, log)
 	}
+	return unknownLogMetadata()
+}
 
+func unknownLogMetadata() types.EvmMetadata {
+	return types.EvmMetadata{
+		ContractName: "Unknown",
+		EventName:    "Unknown",
+		Data:         map[string]string{},
+	}
+}
+
+// eventMetadata decodes log as an event of contractName. The raw topics and
+// data are stored on the log regardless, so a decode failure must not stop the
+// source: decodeEvent keeps whatever decoded.
+func (p *SourceIndexerService) eventMetadata(contractName string, event abi.Event, args []types.EvmArgSchema, log ethTypes.Log) types.EvmMetadata {
 	formatedEvent := map[string]string{}
-	for k, v := range event {
+	for k, v := range decodeEvent(event, log.Topics, log.Data, p.logger) {
 		formatedEvent[k] = formatArgValue(v)
 	}
 
 	return types.EvmMetadata{
-		ContractName: p.contractName,
-		EventName:    eventName,
+		ContractName: contractName,
+		EventName:    event.RawName,
 		Data:         formatedEvent,
+		Args:         args,
+	}
+}
+
+// GetTransactionMetadata decodes a transaction's calldata against the source's
+// ABI (for proxies, as of the log at blockNumber and logIndex the transaction
+// was loaded for): the 4-byte selector picks the function, the rest is
+// unpacked into its arguments. Calldata the ABI does not know (another
+// contract's function, a plain transfer) leaves FunctionName empty.
+func (p *SourceIndexerService) GetTransactionMetadata(data []byte, blockNumber uint64, logIndex uint) types.EvmMetadata {
+
+	// FULL sources are not decoded.
+	if p.source.Type == string(evmi_database.FullLogSourceType) {
+		return types.EvmMetadata{
+			ContractName: "Unknown",
+			FunctionName: "Unknown",
+			Data:         map[string]string{},
+		}
+	}
+
+	decoder := p.decoderOf(blockNumber, logIndex, func(a abi.ABI) bool {
+		if len(data) < 4 {
+			return false
+		}
+		_, err := a.MethodById(data[:4])
+		return err == nil
+	})
+	formatedArgs := map[string]string{}
+	if len(data) < 4 {
+		return types.EvmMetadata{ContractName: decoder.contractName, Data: formatedArgs}
+	}
+	method, err := decoder.abi.MethodById(data[:4])
+	if err != nil {
+		return types.EvmMetadata{ContractName: decoder.contractName, Data: formatedArgs}
+	}
+
+	// As for events, the raw calldata is stored regardless: an argument decode
+	// failure keeps the function name and moves on.
+	args, err := method.Inputs.Unpack(data[4:])
+	if err != nil {
+		p.logger.Warn().Str("function", method.RawName).Msg("calldata decode failed: " + err.Error())
+	}
+	for i, v := range args {
+		name := method.Inputs[i].Name
+		if name == "" {
+			name = fmt.Sprintf("arg%d", i)
+		}
+		formatedArgs[name] = formatArgValue(v)
+	}
+
+	return types.EvmMetadata{
+		ContractName: decoder.contractName,
+		FunctionName: method.RawName,
+		Data:         formatedArgs,
+		Args:         decoder.functionArgs[method.Name],
 	}
 }
 
 // formatArgValue renders one decoded ABI argument as a string. Byte arrays and
-// slices are hex-encoded; anything without a dedicated case falls back to
-// fmt.Sprint so an exotic Solidity type degrades to a readable value instead of
-// stopping the source.
+// slices are hex-encoded; tuples and arrays are rendered as canonical JSON (see
+// jsonArgValue); anything without a dedicated case falls back to fmt.Sprint so
+// an exotic Solidity type degrades to a readable value instead of stopping the
+// source.
 func formatArgValue(v any) string {
 	switch val := v.(type) {
 	case string:
@@ -661,10 +1158,81 @@ func formatArgValue(v any) string {
 		return hex.EncodeToString(bytes)
 	}
 
+	switch rv.Kind() {
+	case reflect.Struct, reflect.Slice, reflect.Array:
+		if encoded, err := json.Marshal(jsonArgValue(rv)); err == nil {
+			return string(encoded)
+		}
+	}
+
 	return fmt.Sprint(v)
 }
 
-func (p *SourceIndexerService) computeLogsAndTxs(client *w3.Client, logs []ethTypes.Log) ([]types.EvmLog, []types.EvmTransaction, error) {
+// jsonArgValue converts a decoded ABI value into its canonical JSON form:
+// tuples become objects keyed by the ABI component names (go-ethereum tags the
+// generated struct fields with them), arrays become arrays, integers become
+// decimal strings so no precision is lost, bytes and addresses 0x-hex strings.
+// Objects are marshalled from maps, so their keys come out sorted.
+func jsonArgValue(rv reflect.Value) any {
+	if !rv.IsValid() {
+		return nil
+	}
+	switch val := rv.Interface().(type) {
+	case *big.Int:
+		if val == nil {
+			return nil
+		}
+		return val.String()
+	case common.Address:
+		return val.Hex()
+	}
+
+	switch rv.Kind() {
+	case reflect.Pointer, reflect.Interface:
+		if rv.IsNil() {
+			return nil
+		}
+		return jsonArgValue(rv.Elem())
+	case reflect.Bool:
+		return rv.Bool()
+	case reflect.String:
+		return rv.String()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(rv.Int(), 10)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(rv.Uint(), 10)
+	case reflect.Slice, reflect.Array:
+		if rv.Type().Elem().Kind() == reflect.Uint8 {
+			bytes := make([]byte, rv.Len())
+			for i := range bytes {
+				bytes[i] = byte(rv.Index(i).Uint())
+			}
+			return hexutil.Encode(bytes)
+		}
+		items := make([]any, rv.Len())
+		for i := range items {
+			items[i] = jsonArgValue(rv.Index(i))
+		}
+		return items
+	case reflect.Struct:
+		fields := make(map[string]any, rv.NumField())
+		for i := 0; i < rv.NumField(); i++ {
+			field := rv.Type().Field(i)
+			if !field.IsExported() {
+				continue
+			}
+			name := field.Name
+			if tag, _, _ := strings.Cut(field.Tag.Get("json"), ","); tag != "" {
+				name = tag
+			}
+			fields[name] = jsonArgValue(rv.Field(i))
+		}
+		return fields
+	}
+	return fmt.Sprint(rv.Interface())
+}
+
+func (p *SourceIndexerService) computeLogsAndTxs(ctx context.Context, client *rpcPool, logs []ethTypes.Log) ([]types.EvmLog, []types.EvmTransaction, error) {
 	dbLogs := []types.EvmLog{}
 	dbTxs := []types.EvmTransaction{}
 
@@ -695,7 +1263,7 @@ func (p *SourceIndexerService) computeLogsAndTxs(client *w3.Client, logs []ethTy
 
 	// Load the block headers of the range's blocks to get their timestamps
 	// (block.timestamp, not on the transaction itself), keyed by block hash.
-	blockTimestamps, err := p.loadBlockTimestamps(client, blockToLoad)
+	blockTimestamps, err := p.loadBlockTimestamps(ctx, client, blockToLoad)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -727,9 +1295,7 @@ func (p *SourceIndexerService) computeLogsAndTxs(client *w3.Client, logs []ethTy
 			}
 		}
 
-		batchStart := time.Now()
-		batchCallErr := client.Call(request...)
-		p.metrics.RecordRPC(p.chain.ChainId, "eth_getTransactionByHash", time.Since(batchStart), batchCallErr)
+		batchCallErr := client.call(ctx, "eth_getTransactionByHash", request...)
 		if batchCallErr != nil {
 			// A partial batch failure (w3.CallErrors) leaves nil transactions
 			// behind: fail the whole range so the supervisor replays it.
@@ -742,6 +1308,12 @@ func (p *SourceIndexerService) computeLogsAndTxs(client *w3.Client, logs []ethTy
 		}
 	}
 
+	receipts, err := p.loadReceipts(ctx, client, blockToLoad, transactionToLoad)
+	if err != nil {
+		p.logger.Error().Msg(err.Error())
+		return nil, nil, err
+	}
+
 	for _, log := range logs {
 
 		logData := map[string]interface{}{
@@ -756,15 +1328,10 @@ func (p *SourceIndexerService) computeLogsAndTxs(client *w3.Client, logs []ethTy
 
 		p.logger.Info().Fields(logData).Msg("Log found")
 
-		var (
-			transaction      *ethTypes.Transaction
-			transactionIndex int
-		)
-
-		for index, tx := range transactions {
+		var transaction *ethTypes.Transaction
+		for _, tx := range transactions {
 			if tx != nil && tx.Hash().Hex() == log.TxHash.Hex() {
 				transaction = tx
-				transactionIndex = index
 			}
 		}
 
@@ -795,46 +1362,57 @@ func (p *SourceIndexerService) computeLogsAndTxs(client *w3.Client, logs []ethTy
 			From:             sender,
 			Data:             common.Bytes2Hex(transaction.Data()),
 			Value:            transaction.Value().String(),
-			TransactionIndex: uint64(transactionIndex),
+			TransactionIndex: uint64(log.TxIndex),
 			Nonce:            transaction.Nonce(),
 			To:               to,
 			Hash:             log.TxHash.Hex(),
+			Metadata:         p.GetTransactionMetadata(transaction.Data(), log.BlockNumber, log.Index),
 		}
 
-		dbTxs = append(dbTxs, evmTx)
-
-		logTopics := []string{}
-		for _, topic := range log.Topics {
-			logTopics = append(logTopics, topic.Hex())
+		receipt := receipts[log.TxHash]
+		if receipt == nil {
+			return nil, nil, errors.New("receipt not found for " + log.TxHash.Hex())
 		}
+		applyReceipt(&evmTx, transaction, receipt)
 
-		l := types.EvmLog{
-			Id:               fmt.Sprintf("%d:%d:%d", transaction.ChainId().Uint64(), log.BlockNumber, log.Index),
-			SourceId:         p.source.ID,
-			ChainId:          p.chain.ChainId,
-			Address:          log.Address.Hex(),
-			Topics:           logTopics,
-			Data:             common.Bytes2Hex(log.Data),
-			BlockNumber:      log.BlockNumber,
-			BlockTimestamp:   blockTimestamps[log.BlockHash.Hex()],
-			TransactionHash:  log.TxHash.Hex(),
-			TransactionFrom:  sender,
-			TransactionIndex: uint64(log.TxIndex),
-			BlockHash:        log.BlockHash.Hex(),
-			LogIndex:         uint64(log.Index),
-			Removed:          log.Removed,
-			Metadata:         p.GetLogMetadata(log),
-		}
+		dbTxs = append(dbTxs, evmTx)
 
-		dbLogs = append(dbLogs, l)
+		dbLogs = append(dbLogs, p.toEvmLog(log, transaction.ChainId().Uint64(), blockTimestamps[log.BlockHash.Hex()], sender))
 	}
 
 	return dbLogs, dbTxs, nil
 }
 
+// toEvmLog builds the stored row of a log. idChainId is the chain id used in the
+// row Id; timestamp and sender come from the log's block and transaction.
+func (p *SourceIndexerService) toEvmLog(log ethTypes.Log, idChainId uint64, timestamp uint64, sender string) types.EvmLog {
+	logTopics := []string{}
+	for _, topic := range log.Topics {
+		logTopics = append(logTopics, topic.Hex())
+	}
+
+	return types.EvmLog{
+		Id:               fmt.Sprintf("%d:%d:%d", idChainId, log.BlockNumber, log.Index),
+		SourceId:         p.source.ID,
+		ChainId:          p.chain.ChainId,
+		Address:          log.Address.Hex(),
+		Topics:           logTopics,
+		Data:             common.Bytes2Hex(log.Data),
+		BlockNumber:      log.BlockNumber,
+		BlockTimestamp:   timestamp,
+		TransactionHash:  log.TxHash.Hex(),
+		TransactionFrom:  sender,
+		TransactionIndex: uint64(log.TxIndex),
+		BlockHash:        log.BlockHash.Hex(),
+		LogIndex:         uint64(log.Index),
+		Removed:          log.Removed,
+		Metadata:         p.GetLogMetadata(log),
+	}
+}
+
 // loadBlockTimestamps batch-fetches the headers of the given block hashes and
 // returns a blockHash(hex) -> unix timestamp map, honoring RpcMaxBatchSize.
-func (p *SourceIndexerService) loadBlockTimestamps(client *w3.Client, blockHashes []common.Hash) (map[string]uint64, error) {
+func (p *SourceIndexerService) loadBlockTimestamps(ctx context.Context, client *rpcPool, blockHashes []common.Hash) (map[string]uint64, error) {
 	timestamps := make(map[string]uint64, len(blockHashes))
 	if len(blockHashes) == 0 {
 		return timestamps, nil
@@ -857,9 +1435,7 @@ func (p *SourceIndexerService) loadBlockTimestamps(client *w3.Client, blockHashe
 			request = append(request, eth.HeaderByHash(blockHashes[i]).Returns(&headers[i]))
 		}
 
-		batchStart := time.Now()
-		batchCallErr := client.Call(request...)
-		p.metrics.RecordRPC(p.chain.ChainId, "eth_getBlockByHash", time.Since(batchStart), batchCallErr)
+		batchCallErr := client.call(ctx, "eth_getBlockByHash", request...)
 		if batchCallErr != nil {
 			p.logger.Error().Msg(batchCallErr.Error())
 			return nil, batchCallErr
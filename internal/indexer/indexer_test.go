@@ -29,12 +29,12 @@ func newDecoderForTest(t *testing.T, abiJson string) *SourceIndexerService {
 	if err != nil {
 		t.Fatalf("parse abi: %v", err)
 	}
-	return &SourceIndexerService{
-		source:       evmi_database.EvmLogSource{Type: string(evmi_database.ContractLogSourceType)},
-		contractName: "Token",
-		abi:          parsed,
-		logger:       zerolog.Nop(),
+	p := &SourceIndexerService{
+		source: evmi_database.EvmLogSource{Type: string(evmi_database.ContractLogSourceType)},
+		logger: zerolog.Nop(),
 	}
+	p.setAbi("Token", parsed)
+	return p
 }
 
 func TestGetLogMetadataDecodesEvent(t *testing.T) {
@@ -44,7 +44,7 @@ func TestGetLogMetadataDecodesEvent(t *testing.T) {
 	to := common.HexToAddress("0x00000000000000000000000000000000000000bb")
 	log := ethTypes.Log{
 		Topics: []common.Hash{
-			s.abi.Events["Transfer"].ID,
+			s.decoder.abi.Events["Transfer"].ID,
 			common.BytesToHash(common.LeftPadBytes(from.Bytes(), 32)),
 			common.BytesToHash(common.LeftPadBytes(to.Bytes(), 32)),
 		},
@@ -61,6 +61,12 @@ func TestGetLogMetadataDecodesEvent(t *testing.T) {
 	if meta.Data["value"] != "1000" {
 		t.Errorf("value = %q, want 1000", meta.Data["value"])
 	}
+	if len(meta.Args) != 3 || !meta.Args[0].Indexed || meta.Args[2].Type != "uint256" {
+		t.Errorf("schema not attached: %+v", meta.Args)
+	}
+	if typed := meta.TypedArgs(); typed.Uint["value"].Int64() != 1000 || typed.Address["to"] != to.Hex() {
+		t.Errorf("typed args = %+v", typed)
+	}
 }
 
 func TestGetLogMetadataUnknownTopicAndAnonymousEvent(t *testing.T) {
@@ -85,7 +91,7 @@ func TestGetLogMetadataSurvivesUndecodableData(t *testing.T) {
 	from := common.HexToAddress("0x00000000000000000000000000000000000000aa")
 	log := ethTypes.Log{
 		Topics: []common.Hash{
-			s.abi.Events["Transfer"].ID,
+			s.decoder.abi.Events["Transfer"].ID,
 			common.BytesToHash(common.LeftPadBytes(from.Bytes(), 32)),
 			common.BytesToHash(common.LeftPadBytes(from.Bytes(), 32)),
 		},
@@ -103,6 +109,103 @@ func TestGetLogMetadataSurvivesUndecodableData(t *testing.T) {
 	}
 }
 
+const routerAbi = `[{
+	"inputs": [
+		{"name": "amountIn", "type": "uint256"},
+		{"name": "amountOutMin", "type": "uint256"},
+		{"name": "path", "type": "address[]"},
+		{"name": "to", "type": "address"},
+		{"name": "deadline", "type": "uint256"}
+	],
+	"name": "swapExactTokensForTokens",
+	"outputs": [{"name": "amounts", "type": "uint256[]"}],
+	"stateMutability": "nonpayable",
+	"type": "function"
+}, {
+	"inputs": [{"name": "", "type": "bytes[]"}],
+	"name": "multicall",
+	"outputs": [],
+	"stateMutability": "payable",
+	"type": "function"
+}]`
+
+func TestGetTransactionMetadataDecodesCalldata(t *testing.T) {
+	s := newDecoderForTest(t, routerAbi)
+
+	to := common.HexToAddress("0x00000000000000000000000000000000000000bb")
+	path := []common.Address{common.HexToAddress("0x00000000000000000000000000000000000000a1")}
+	data, err := s.decoder.abi.Pack("swapExactTokensForTokens", big.NewInt(1000), big.NewInt(990), path, to, big.NewInt(1700000000))
+	if err != nil {
+		t.Fatalf("pack: %v", err)
+	}
+
+	meta := s.GetTransactionMetadata(data, 0, 0)
+	if meta.ContractName != "Token" || meta.FunctionName != "swapExactTokensForTokens" {
+		t.Fatalf("function not matched: %+v", meta)
+	}
+	if meta.Data["amountIn"] != "1000" || meta.Data["to"] != to.Hex() || meta.Data["deadline"] != "1700000000" {
+		t.Errorf("args not decoded: %+v", meta.Data)
+	}
+
+	// Unnamed arguments are keyed by position.
+	data, _ = s.decoder.abi.Pack("multicall", [][]byte{{0x01}})
+	if meta := s.GetTransactionMetadata(data, 0, 0); meta.FunctionName != "multicall" || meta.Data["arg0"] == "" {
+		t.Errorf("multicall = %+v, want its arg0", meta)
+	}
+
+	// Unknown selector, plain transfer and truncated args degrade without failing.
+	if meta := s.GetTransactionMetadata([]byte{0xde, 0xad, 0xbe, 0xef}, 0, 0); meta.FunctionName != "" || len(meta.Data) != 0 {
+		t.Errorf("unknown selector should decode nothing, got %+v", meta)
+	}
+	if meta := s.GetTransactionMetadata(nil, 0, 0); meta.FunctionName != "" {
+		t.Errorf("empty calldata should decode nothing, got %+v", meta)
+	}
+	if meta := s.GetTransactionMetadata(data[:8], 0, 0); meta.FunctionName != "multicall" {
+		t.Errorf("function should still be matched on truncated args, got %+v", meta)
+	}
+}
+
+const tupleEventAbi = `[{
+	"anonymous": false,
+	"inputs": [
+		{"indexed": true, "name": "id", "type": "bytes32"},
+		{"indexed": false, "name": "key", "type": "tuple", "components": [
+			{"name": "currency0", "type": "address"},
+			{"name": "fee", "type": "uint24"},
+			{"name": "hooks", "type": "address[]"}
+		]},
+		{"indexed": false, "name": "amounts", "type": "int128[]"}
+	],
+	"name": "Initialize",
+	"type": "event"
+}]`
+
+func TestGetLogMetadataDecodesTuplesAsJson(t *testing.T) {
+	s := newDecoderForTest(t, tupleEventAbi)
+	event := s.decoder.abi.Events["Initialize"]
+
+	currency := common.HexToAddress("0x00000000000000000000000000000000000000c0")
+	hook := common.HexToAddress("0x00000000000000000000000000000000000000d0")
+	key := struct {
+		Currency0 common.Address
+		Fee       *big.Int
+		Hooks     []common.Address
+	}{currency, big.NewInt(3000), []common.Address{hook}}
+	data, err := event.Inputs.NonIndexed().Pack(key, []*big.Int{big.NewInt(-1), big.NewInt(2)})
+	if err != nil {
+		t.Fatalf("pack: %v", err)
+	}
+
+	meta := s.GetLogMetadata(ethTypes.Log{Topics: []common.Hash{event.ID, common.HexToHash("0x01")}, Data: data})
+	wantKey := `{"currency0":"` + currency.Hex() + `","fee":"3000","hooks":["` + hook.Hex() + `"]}`
+	if meta.Data["key"] != wantKey {
+		t.Errorf("key = %s, want %s", meta.Data["key"], wantKey)
+	}
+	if meta.Data["amounts"] != `["-1","2"]` {
+		t.Errorf("amounts = %s", meta.Data["amounts"])
+	}
+}
+
 func TestFormatArgValue(t *testing.T) {
 	addr := common.HexToAddress("0x00000000000000000000000000000000000000aa")
 	cases := []struct {
@@ -122,7 +225,19 @@ func TestFormatArgValue(t *testing.T) {
 		{common.HexToHash("0xff00000000000000000000000000000000000000000000000000000000000000"), "ff00000000000000000000000000000000000000000000000000000000000000"},
 		// No dedicated case: must degrade to a readable value, never panic.
 		{uint16(9), "9"},
-		{[]*big.Int{big.NewInt(1), big.NewInt(2)}, "[1 2]"},
+		// Arrays and tuples are canonical JSON.
+		{[]*big.Int{big.NewInt(1), big.NewInt(2)}, `["1","2"]`},
+		{[]common.Address{addr}, `["` + addr.Hex() + `"]`},
+		{[][]byte{{0xde, 0xad}}, `["0xdead"]`},
+		{struct {
+			Owner  common.Address `json:"owner"`
+			Amount *big.Int       `json:"amount"`
+			Nested []struct {
+				Flag bool `json:"flag"`
+			} `json:"nested"`
+		}{addr, big.NewInt(5), []struct {
+			Flag bool `json:"flag"`
+		}{{true}}}, `{"amount":"5","nested":[{"flag":true}],"owner":"` + addr.Hex() + `"}`},
 	}
 	for _, c := range cases {
 		if got := formatArgValue(c.in); got != c.want {
@@ -130,3 +245,54 @@ func TestFormatArgValue(t *testing.T) {
 		}
 	}
 }
+
+func TestGetLogMetadataPicksTheAbiDeclaringTheEvent(t *testing.T) {
+	s := newDecoderForTest(t, erc20TransferAbi)
+	parsed, err := abi.JSON(strings.NewReader(`[{
+		"anonymous": false,
+		"inputs": [
+			{"indexed": true, "name": "operator", "type": "address"},
+			{"indexed": true, "name": "from", "type": "address"},
+			{"indexed": true, "name": "to", "type": "address"},
+			{"indexed": false, "name": "id", "type": "uint256"},
+			{"indexed": false, "name": "value", "type": "uint256"}
+		],
+		"name": "TransferSingle",
+		"type": "event"
+	}]`))
+	if err != nil {
+		t.Fatalf("parse abi: %v", err)
+	}
+	s.addAbi("MultiToken", parsed)
+
+	holder := common.BytesToHash(common.LeftPadBytes(common.HexToAddress("0xaa").Bytes(), 32))
+	meta := s.GetLogMetadata(ethTypes.Log{
+		Topics: []common.Hash{parsed.Events["TransferSingle"].ID, holder, holder, holder},
+		Data:   append(common.LeftPadBytes([]byte{1}, 32), common.LeftPadBytes([]byte{2}, 32)...),
+	})
+	if meta.ContractName != "MultiToken" || meta.EventName != "TransferSingle" || meta.Data["value"] != "2" {
+		t.Errorf("second abi event = %+v", meta)
+	}
+
+	meta = s.GetLogMetadata(ethTypes.Log{
+		Topics: []common.Hash{s.decoder.abi.Events["Transfer"].ID, holder, holder},
+		Data:   common.LeftPadBytes([]byte{3}, 32),
+	})
+	if meta.ContractName != "Token" || meta.EventName != "Transfer" {
+		t.Errorf("first abi event = %+v", meta)
+	}
+}
+
+func TestTopicFiltersMatchAnyTopic0(t *testing.T) {
+	s := newDecoderForTest(t, erc20TransferAbi)
+	s.source.SetTopic0s([]string{"0x01", "0x02"})
+	s.source.TopicFilters = []string{"", "0x03"}
+
+	topics := s.topicFilters()
+	if len(topics) != 3 || len(topics[0]) != 2 || topics[0][1] != common.HexToHash("0x02") {
+		t.Fatalf("topics = %v", topics)
+	}
+	if topics[1] != nil || topics[2][0] != common.HexToHash("0x03") {
+		t.Errorf("argument filters = %v", topics[1:])
+	}
+}
@@ -0,0 +1,144 @@
+package indexer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"strings"
+
+	"github.com/ethereum/go-ethereum"
+	ethTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/lmittmann/w3"
+	"github.com/lmittmann/w3/module/eth"
+)
+
+// sparseRangeLogs is the log count under which a range fetched in one
+// eth_getLogs call counts as sparse, letting the window grow back.
+const sparseRangeLogs = 1000
+
+// rangeTooLargeMessages are provider messages for an eth_getLogs request that
+// may succeed over a smaller block range: result-count, response-size and
+// block-span caps, and query timeouts on dense ranges. Rate limits are left
+// out, whatever their code (Infura answers both a result cap and a rate limit
+// with -32005): splitting the range would multiply the calls instead of making
+// them succeed.
+var rangeTooLargeMessages = []string{
+	"query returned more than",
+	"too many results",
+	"response size",
+	"response is too big",
+	"range is too large",
+	"range too large",
+	"range is too wide",
+	"block range limit",
+	"max block range",
+	"maximum block range",
+	"query timeout",
+	"query timed out",
+	"execution timeout",
+}
+
+// isRangeTooLarge reports whether an eth_getLogs error is one a smaller block
+// range may avoid. Other methods' errors are never such.
+func isRangeTooLarge(err error) bool {
+	var callErrs w3.CallErrors
+	if errors.As(err, &callErrs) {
+		for _, e := range callErrs {
+			if e != nil && isRangeTooLarge(e) {
+				return true
+			}
+		}
+		return false
+	}
+
+	var httpErr rpc.HTTPError
+	if errors.As(err, &httpErr) {
+		switch httpErr.StatusCode {
+		case http.StatusRequestEntityTooLarge, http.StatusGatewayTimeout, http.StatusRequestTimeout:
+			return true
+		}
+	}
+
+	msg := strings.ToLower(err.Error())
+	for _, m := range rangeTooLargeMessages {
+		if strings.Contains(msg, m) {
+			return true
+		}
+	}
+	return false
+}
+
+// logsWindow is the number of blocks requested per range. It starts at the
+// chain's BlockRange, shrinks when the provider rejects a range and grows back,
+// up to BlockRange, over sparse ranges.
+func (p *SourceIndexerService) logsWindow() uint64 {
+	p.windowMu.Lock()
+	defer p.windowMu.Unlock()
+	if p.window == 0 || p.window > p.chain.BlockRange {
+		p.window = p.chain.BlockRange
+	}
+	if p.window == 0 {
+		p.window = 1
+	}
+	return p.window
+}
+
+// setLogsWindow changes the window and publishes it.
+func (p *SourceIndexerService) setLogsWindow(blocks uint64) {
+	if blocks == 0 {
+		blocks = 1
+	}
+	p.windowMu.Lock()
+	defer p.windowMu.Unlock()
+	if blocks != p.window {
+		p.logger.Info().Msg(fmt.Sprintf("eth_getLogs window %d -> %d blocks", p.window, blocks))
+	}
+	p.window = blocks
+	p.metrics.SetLogsWindow(p.sourceLabels(), blocks)
+}
+
+// getLogs runs eth_getLogs over [from, to], bisecting the range recursively
+// when the provider rejects it as too large. Every split narrows the window
+// used for the next ranges; a range served in one call with few logs doubles
+// it back.
+func (p *SourceIndexerService) getLogs(ctx context.Context, client *rpcPool, filter func(fromBlock, toBlock *big.Int) ethereum.FilterQuery, from, to uint64) ([]ethTypes.Log, error) {
+	window := p.logsWindow()
+	logs, split, err := p.getLogsSplitting(ctx, client, filter, from, to)
+	if err != nil {
+		return nil, err
+	}
+	if !split && len(logs) < sparseRangeLogs && to-from+1 >= window && window < p.chain.BlockRange {
+		p.setLogsWindow(min(window*2, p.chain.BlockRange))
+	}
+	return logs, nil
+}
+
+func (p *SourceIndexerService) getLogsSplitting(ctx context.Context, client *rpcPool, filter func(fromBlock, toBlock *big.Int) ethereum.FilterQuery, from, to uint64) ([]ethTypes.Log, bool, error) {
+	var logs []ethTypes.Log
+	err := client.call(ctx, "eth_getLogs", eth.Logs(filter(new(big.Int).SetUint64(from), new(big.Int).SetUint64(to))).Returns(&logs))
+	if err == nil {
+		return logs, false, nil
+	}
+	if from == to || !isRangeTooLarge(err) {
+		return nil, false, err
+	}
+
+	mid := from + (to-from)/2
+	if half := mid - from + 1; half < p.logsWindow() {
+		p.setLogsWindow(half)
+	}
+	p.logger.Warn().Msg(fmt.Sprintf("eth_getLogs [%d, %d] rejected, splitting: %s", from, to, err.Error()))
+
+	left, _, err := p.getLogsSplitting(ctx, client, filter, from, mid)
+	if err != nil {
+		return nil, true, err
+	}
+	right, _, err := p.getLogsSplitting(ctx, client, filter, mid+1, to)
+	if err != nil {
+		return nil, true, err
+	}
+	return append(left, right...), true, nil
+}
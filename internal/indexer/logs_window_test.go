@@ -0,0 +1,131 @@
+package indexer
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/lmittmann/w3"
+)
+
+// cappedLogsNode answers eth_getLogs with a "limit exceeded" error for ranges
+// wider than maxSpan blocks and with no logs otherwise, recording each range.
+type cappedLogsNode struct {
+	maxSpan uint64
+
+	mu     sync.Mutex
+	ranges [][2]uint64
+}
+
+func (n *cappedLogsNode) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		ID     json.RawMessage `json:"id"`
+		Params []struct {
+			FromBlock hexutil.Uint64 `json:"fromBlock"`
+			ToBlock   hexutil.Uint64 `json:"toBlock"`
+		} `json:"params"`
+	}
+	_ = json.NewDecoder(r.Body).Decode(&req)
+	from, to := uint64(req.Params[0].FromBlock), uint64(req.Params[0].ToBlock)
+
+	n.mu.Lock()
+	n.ranges = append(n.ranges, [2]uint64{from, to})
+	n.mu.Unlock()
+
+	w.Header().Set("Content-Type", "application/json")
+	if to-from+1 > n.maxSpan {
+		fmt.Fprintf(w, `{"jsonrpc":"2.0","id":%s,"error":{"code":-32005,"message":"query returned more than 10000 results"}}`, req.ID)
+		return
+	}
+	fmt.Fprintf(w, `{"jsonrpc":"2.0","id":%s,"result":[]}`, req.ID)
+}
+
+func allLogsFilter(from, to *big.Int) ethereum.FilterQuery {
+	return ethereum.FilterQuery{FromBlock: from, ToBlock: to}
+}
+
+func TestGetLogsBisectsAndShrinksWindow(t *testing.T) {
+	node := &cappedLogsNode{maxSpan: 30}
+	server := httptest.NewServer(node)
+	defer server.Close()
+	client := newTestPool(t, server.URL)
+
+	p := newDecoderForTest(t, erc20TransferAbi)
+	p.chain.BlockRange = 100
+
+	if _, err := p.getLogs(context.Background(), client, allLogsFilter, 1, 100); err != nil {
+		t.Fatalf("getLogs: %v", err)
+	}
+	// 100 -> 50 -> 25: every accepted request spans at most 30 blocks and the
+	// accepted ones cover the range exactly once.
+	var covered uint64
+	for _, r := range node.ranges {
+		if span := r[1] - r[0] + 1; span <= node.maxSpan {
+			covered += span
+		}
+	}
+	if covered != 100 {
+		t.Errorf("accepted ranges cover %d blocks, want 100: %v", covered, node.ranges)
+	}
+	if p.logsWindow() != 25 {
+		t.Errorf("window = %d, want 25", p.logsWindow())
+	}
+
+	// A sparse range served in one call doubles the window, capped at BlockRange.
+	if _, err := p.getLogs(context.Background(), client, allLogsFilter, 101, 125); err != nil {
+		t.Fatalf("getLogs: %v", err)
+	}
+	if p.logsWindow() != 50 {
+		t.Errorf("window after sparse range = %d, want 50", p.logsWindow())
+	}
+}
+
+func TestGetLogsGivesUpOnSingleBlock(t *testing.T) {
+	node := &cappedLogsNode{maxSpan: 0}
+	server := httptest.NewServer(node)
+	defer server.Close()
+	client := newTestPool(t, server.URL)
+
+	p := newDecoderForTest(t, erc20TransferAbi)
+	p.chain.BlockRange = 4
+	if _, err := p.getLogs(context.Background(), client, allLogsFilter, 1, 4); err == nil {
+		t.Fatal("expected the error once the range cannot be split further")
+	}
+	if p.logsWindow() != 1 {
+		t.Errorf("window = %d, want 1", p.logsWindow())
+	}
+}
+
+func TestIsRangeTooLarge(t *testing.T) {
+	cases := []struct {
+		err  error
+		want bool
+	}{
+		{errors.New("Log response size exceeded. You can make eth_getLogs requests with up to a 2K block range"), true},
+		{errors.New("eth_getLogs block range is too wide, max 1000"), true},
+		{errors.New("query timeout exceeded"), true},
+		{errors.New("execution timeout"), true},
+		{rpc.HTTPError{StatusCode: http.StatusGatewayTimeout, Status: "504 Gateway Timeout"}, true},
+		{rpc.HTTPError{StatusCode: http.StatusTooManyRequests, Status: "429 Too Many Requests"}, false},
+		{errors.New("daily request count limit exceeded"), false},
+		{errors.New("header not found: timed out"), false},
+		{w3.CallErrors{errors.New("query returned more than 10000 results")}, true},
+		{errors.New("invalid argument 0: hex string without 0x prefix"), false},
+		{errors.New("connection refused"), false},
+	}
+	for _, c := range cases {
+		if got := isRangeTooLarge(c.err); got != c.want {
+			t.Errorf("isRangeTooLarge(%q) = %v, want %v", strings.TrimSpace(c.err.Error()), got, c.want)
+		}
+	}
+}
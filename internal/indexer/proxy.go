@@ -0,0 +1,443 @@
+package indexer
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	evmi_database "github.com/evmi-cloud/go-evm-indexer/internal/database/evmi-database"
+	log_stores "github.com/evmi-cloud/go-evm-indexer/internal/database/log-stores"
+	"github.com/evmi-cloud/go-evm-indexer/internal/types"
+	"github.com/lmittmann/w3"
+	"github.com/lmittmann/w3/module/eth"
+)
+
+var (
+	// eip1967ImplementationSlot is keccak256("eip1967.proxy.implementation") - 1.
+	eip1967ImplementationSlot = common.HexToHash("0x360894a13ba1a3210667c828492db98dca3e2076cc3735a920a3ca505d382bbc")
+	// eip1967BeaconSlot is keccak256("eip1967.proxy.beacon") - 1.
+	eip1967BeaconSlot = common.HexToHash("0xa3f0ad74e5423aebfd80d3ef4346578335a9a72aeaee59ff6cb3582b35133d50")
+	// eip1822ProxiableSlot is keccak256("PROXIABLE").
+	eip1822ProxiableSlot = common.HexToHash("0xc5f16f0fcc639fa48a6947836d9850f504798523bf8c9a3a87d5876cf622bcf7")
+
+	upgradedTopic       = crypto.Keccak256Hash([]byte("Upgraded(address)"))
+	beaconUpgradedTopic = crypto.Keccak256Hash([]byte("BeaconUpgraded(address)"))
+
+	beaconImplementationFunc = w3.MustNewFunc("implementation()", "address")
+)
+
+// proxyState is the implementation history of a proxy source, ordered by
+// (FromBlock, FromLogIndex). decoders holds, per implementation address, the
+// source's ABI combined with the implementation's; implementations without a
+// mapped ABI have none and are looked up again on the next range, so a mapping
+// added through the API is picked up without restarting the source.
+type proxyState struct {
+	history  []evmi_database.EvmProxyImplementation
+	decoders map[string]*abiDecoder
+}
+
+// decoderAt returns the decoder of the implementation active at the log at
+// (block, logIndex), or the source's when there is none or it has no ABI.
+func (p *SourceIndexerService) decoderAt(block uint64, logIndex uint) *abiDecoder {
+	if p.proxy == nil {
+		return p.decoder
+	}
+	history := p.proxy.history
+	i := sort.Search(len(history), func(i int) bool {
+		return !entryAtOrBefore(history[i], block, uint64(logIndex))
+	})
+	if i == 0 {
+		return p.decoder
+	}
+	if decoder := p.proxy.decoders[history[i-1].Implementation]; decoder != nil {
+		return decoder
+	}
+	return p.decoder
+}
+
+func entryAtOrBefore(entry evmi_database.EvmProxyImplementation, block, logIndex uint64) bool {
+	return entry.FromBlock < block || (entry.FromBlock == block && entry.FromLogIndex <= logIndex)
+}
+
+// initProxy loads the source's implementation history. A source without one
+// is probed for the proxy storage slots at block, the first block to index:
+// the implementation found there is recorded from block 0, as the one of
+// every log before the first upgrade read from events. A source that is no
+// proxy at block but is one at the head was deployed or upgraded to a proxy
+// later; its history is read from the events while indexing. Other sources
+// get no proxy state and no extra calls per range.
+func (p *SourceIndexerService) initProxy(ctx context.Context, client *rpcPool, block uint64) error {
+	if err := p.loadProxyHistory(); err != nil || p.proxy != nil {
+		return err
+	}
+
+	entry, err := p.detectProxy(ctx, client, new(big.Int).SetUint64(block))
+	if err != nil {
+		// Pruned nodes cannot read old state: the implementation at the head
+		// is the best guess for the logs to come.
+		p.logger.Warn().Msg("proxy detection failed at block " + fmt.Sprint(block) + ", using the head: " + err.Error())
+		entry, err = p.detectProxy(ctx, client, nil)
+		if err != nil {
+			p.logger.Warn().Msg("proxy detection failed, indexing as a plain contract: " + err.Error())
+			return nil
+		}
+	} else if entry == nil {
+		head, err := p.detectProxy(ctx, client, nil)
+		if err != nil {
+			p.logger.Warn().Msg("proxy detection failed, indexing as a plain contract: " + err.Error())
+			return nil
+		}
+		if head != nil {
+			p.proxy = &proxyState{decoders: map[string]*abiDecoder{}}
+		}
+		return nil
+	}
+	if entry == nil {
+		return nil
+	}
+
+	p.logger.Info().Fields(map[string]interface{}{
+		"proxyType":      entry.ProxyType,
+		"implementation": entry.Implementation,
+	}).Msg("proxy detected")
+	p.proxy = &proxyState{decoders: map[string]*abiDecoder{}}
+	return p.recordImplementations([]evmi_database.EvmProxyImplementation{*entry})
+}
+
+// loadProxyHistory loads the source's recorded implementation history, if
+// any, with the decoders of its implementations.
+func (p *SourceIndexerService) loadProxyHistory() error {
+	p.proxy = nil
+
+	var history []evmi_database.EvmProxyImplementation
+	result := p.db.Conn.Where("evm_log_source_id = ?", p.source.ID).Order("from_block, from_log_index").Find(&history)
+	if result.Error != nil {
+		return result.Error
+	}
+	if len(history) == 0 {
+		return nil
+	}
+	p.proxy = &proxyState{history: history, decoders: map[string]*abiDecoder{}}
+	return p.resolveProxyDecoders()
+}
+
+// detectProxy reads the proxy storage slots of the source at block (nil for
+// the head), and returns nil when none is set.
+func (p *SourceIndexerService) detectProxy(ctx context.Context, client *rpcPool, block *big.Int) (*evmi_database.EvmProxyImplementation, error) {
+	address := common.HexToAddress(p.source.Address.String)
+	var implementation, beacon, proxiable common.Hash
+	err := client.call(ctx, "eth_getStorageAt",
+		eth.StorageAt(address, eip1967ImplementationSlot, block).Returns(&implementation),
+		eth.StorageAt(address, eip1967BeaconSlot, block).Returns(&beacon),
+		eth.StorageAt(address, eip1822ProxiableSlot, block).Returns(&proxiable),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	entry := &evmi_database.EvmProxyImplementation{EvmLogSourceID: p.source.ID}
+	switch {
+	case implementation != (common.Hash{}):
+		entry.ProxyType = string(evmi_database.Eip1967ProxyType)
+		entry.Implementation = common.BytesToAddress(implementation.Bytes()).Hex()
+	case beacon != (common.Hash{}):
+		beaconAddress := common.BytesToAddress(beacon.Bytes())
+		target, err := p.beaconImplementation(ctx, client, beaconAddress, block)
+		if err != nil {
+			return nil, err
+		}
+		entry.ProxyType = string(evmi_database.BeaconProxyType)
+		entry.Beacon = beaconAddress.Hex()
+		entry.Implementation = target.Hex()
+	case proxiable != (common.Hash{}):
+		entry.ProxyType = string(evmi_database.Eip1822ProxyType)
+		entry.Implementation = common.BytesToAddress(proxiable.Bytes()).Hex()
+	default:
+		return nil, nil
+	}
+	return entry, nil
+}
+
+// beaconImplementation calls implementation() on beacon at block (nil for the
+// head).
+func (p *SourceIndexerService) beaconImplementation(ctx context.Context, client *rpcPool, beacon common.Address, block *big.Int) (common.Address, error) {
+	var implementation common.Address
+	err := client.call(ctx, "eth_call", eth.CallFunc(beacon, beaconImplementationFunc).AtBlock(block).Returns(&implementation))
+	return implementation, err
+}
+
+// trackUpgrades records the implementation changes of a proxy source in
+// [from, to], read from the Upgraded and BeaconUpgraded events of the proxy
+// and the Upgraded events of its beacons, before the range is decoded. Events
+// already in the history (a replayed range) are skipped. Implementations
+// still without a decoder are looked up again first, for the ABI mappings
+// added since the last range.
+func (p *SourceIndexerService) trackUpgrades(ctx context.Context, client *rpcPool, from, to uint64) error {
+	if p.proxy == nil {
+		return nil
+	}
+	if err := p.resolveProxyDecoders(); err != nil {
+		return err
+	}
+
+	proxy := common.HexToAddress(p.source.Address.String)
+	logs, _, err := p.getLogsSplitting(ctx, client, func(fromBlock, toBlock *big.Int) ethereum.FilterQuery {
+		return ethereum.FilterQuery{
+			FromBlock: fromBlock,
+			ToBlock:   toBlock,
+			Addresses: []common.Address{proxy},
+			Topics:    [][]common.Hash{{upgradedTopic, beaconUpgradedTopic}},
+		}
+	}, from, to)
+	if err != nil {
+		return err
+	}
+
+	// The beacons the proxy points to during the range: the current one and
+	// those it switches to.
+	var beacons []common.Address
+	if last, ok := p.lastImplementation(); ok && last.Beacon != "" {
+		beacons = append(beacons, common.HexToAddress(last.Beacon))
+	}
+	for _, log := range logs {
+		if log.Topics[0] == beaconUpgradedTopic && len(log.Topics) > 1 {
+			beacons = append(beacons, common.BytesToAddress(log.Topics[1].Bytes()))
+		}
+	}
+	if len(beacons) > 0 {
+		beaconLogs, _, err := p.getLogsSplitting(ctx, client, func(fromBlock, toBlock *big.Int) ethereum.FilterQuery {
+			return ethereum.FilterQuery{
+				FromBlock: fromBlock,
+				ToBlock:   toBlock,
+				Addresses: beacons,
+				Topics:    [][]common.Hash{{upgradedTopic}},
+			}
+		}, from, to)
+		if err != nil {
+			return err
+		}
+		logs = append(logs, beaconLogs...)
+	}
+	sort.Slice(logs, func(i, j int) bool {
+		if logs[i].BlockNumber != logs[j].BlockNumber {
+			return logs[i].BlockNumber < logs[j].BlockNumber
+		}
+		return logs[i].Index < logs[j].Index
+	})
+
+	var added []evmi_database.EvmProxyImplementation
+	current, hasCurrent := p.lastImplementation()
+	for _, log := range logs {
+		if len(log.Topics) < 2 || log.Removed {
+			continue
+		}
+		if hasCurrent && (log.BlockNumber < current.FromBlock || (log.BlockNumber == current.FromBlock && uint64(log.Index) <= current.FromLogIndex)) {
+			continue
+		}
+
+		target := common.BytesToAddress(log.Topics[1].Bytes())
+		next := evmi_database.EvmProxyImplementation{
+			EvmLogSourceID: p.source.ID,
+			ProxyType:      current.ProxyType,
+			Beacon:         current.Beacon,
+			Implementation: current.Implementation,
+			FromBlock:      log.BlockNumber,
+			FromLogIndex:   uint64(log.Index),
+		}
+
+		switch {
+		case log.Address == proxy && log.Topics[0] == beaconUpgradedTopic:
+			implementation, err := p.beaconImplementation(ctx, client, target, new(big.Int).SetUint64(log.BlockNumber))
+			if err != nil {
+				return err
+			}
+			next.ProxyType = string(evmi_database.BeaconProxyType)
+			next.Beacon = target.Hex()
+			next.Implementation = implementation.Hex()
+		case log.Address == proxy:
+			if next.ProxyType != string(evmi_database.Eip1822ProxyType) {
+				next.ProxyType = string(evmi_database.Eip1967ProxyType)
+			}
+			next.Beacon = ""
+			next.Implementation = target.Hex()
+		case hasCurrent && current.Beacon != "" && log.Address == common.HexToAddress(current.Beacon):
+			next.Implementation = target.Hex()
+		default:
+			// An upgrade of a beacon the proxy does not point to at that log.
+			continue
+		}
+
+		if hasCurrent && next.Implementation == current.Implementation && next.Beacon == current.Beacon {
+			continue
+		}
+		added = append(added, next)
+		current, hasCurrent = next, true
+	}
+
+	if len(added) == 0 {
+		return nil
+	}
+	return p.recordImplementations(added)
+}
+
+func (p *SourceIndexerService) lastImplementation() (evmi_database.EvmProxyImplementation, bool) {
+	if p.proxy == nil || len(p.proxy.history) == 0 {
+		return evmi_database.EvmProxyImplementation{}, false
+	}
+	return p.proxy.history[len(p.proxy.history)-1], true
+}
+
+// recordImplementations appends entries to the source's history, in the
+// database and in memory, and resolves their decoders.
+func (p *SourceIndexerService) recordImplementations(entries []evmi_database.EvmProxyImplementation) error {
+	if result := p.db.Conn.Create(&entries); result.Error != nil {
+		return result.Error
+	}
+	for _, entry := range entries {
+		p.logger.Info().Fields(map[string]interface{}{
+			"proxyType":      entry.ProxyType,
+			"implementation": entry.Implementation,
+			"fromBlock":      entry.FromBlock,
+		}).Msg("proxy implementation recorded")
+	}
+	p.proxy.history = append(p.proxy.history, entries...)
+	return p.resolveProxyDecoders()
+}
+
+// resolveProxyDecoders builds the decoders of the implementations in the
+// history that have an ABI mapped and no decoder yet.
+func (p *SourceIndexerService) resolveProxyDecoders() error {
+	if p.proxy == nil {
+		return nil
+	}
+
+	tried := map[string]bool{}
+	for _, entry := range p.proxy.history {
+		if tried[entry.Implementation] || p.proxy.decoders[entry.Implementation] != nil {
+			continue
+		}
+		tried[entry.Implementation] = true
+
+		var mappings []evmi_database.EvmImplementationAbi
+		result := p.db.Conn.Where("evm_blockchain_id = ? AND address = ?", p.chain.ID, entry.Implementation).Limit(1).Find(&mappings)
+		if result.Error != nil {
+			return result.Error
+		}
+		if len(mappings) == 0 {
+			continue
+		}
+
+		var abiEntry evmi_database.EvmJsonAbi
+		if result := p.db.Conn.First(&abiEntry, mappings[0].EvmJsonAbiID); result.Error != nil {
+			return result.Error
+		}
+		parsed, err := abi.JSON(strings.NewReader(abiEntry.Content))
+		if err != nil {
+			// A broken mapping must not stop the source: keep decoding with
+			// the proxy's ABI.
+			p.logger.Warn().Str("implementation", entry.Implementation).Msg("implementation abi parse failed: " + err.Error())
+			continue
+		}
+
+		decoder := newAbiDecoder(abiEntry.ContractName, mergeAbis(parsed, p.decoder.abi))
+		if p.store != nil {
+			if tables, ok := p.store.GetStorage().(log_stores.EventTablesStorage); ok {
+				err := tables.EnsureEventTables(decoder.contractName, types.NewEvmAbiSchema(decoder.abi).Events)
+				if err != nil {
+					return err
+				}
+			}
+		}
+		p.proxy.decoders[entry.Implementation] = decoder
+	}
+	return nil
+}
+
+// rollbackProxy drops the history entries read from the blocks above
+// ancestor.
+func (p *SourceIndexerService) rollbackProxy(ancestor uint64) error {
+	if p.proxy == nil {
+		return nil
+	}
+	result := p.db.Conn.Unscoped().Where("evm_log_source_id = ? AND from_block > ?", p.source.ID, ancestor).Delete(&evmi_database.EvmProxyImplementation{})
+	if result.Error != nil {
+		return result.Error
+	}
+	history := p.proxy.history[:0]
+	for _, entry := range p.proxy.history {
+		if entry.FromBlock <= ancestor {
+			history = append(history, entry)
+		}
+	}
+	p.proxy.history = history
+	return nil
+}
+
+// mergeAbis combines an implementation's ABI with its proxy's: the
+// implementation's entries win, the proxy's own events, functions and errors
+// (admin functions, Upgraded) are added when the implementation lacks their
+// selector, renamed on a name clash.
+func mergeAbis(implementation, proxy abi.ABI) abi.ABI {
+	merged := implementation
+	merged.Methods = make(map[string]abi.Method, len(implementation.Methods)+len(proxy.Methods))
+	merged.Events = make(map[string]abi.Event, len(implementation.Events)+len(proxy.Events))
+	merged.Errors = make(map[string]abi.Error, len(implementation.Errors)+len(proxy.Errors))
+
+	selectors := map[string]bool{}
+	for name, method := range implementation.Methods {
+		merged.Methods[name] = method
+		selectors[string(method.ID)] = true
+	}
+	for name, method := range proxy.Methods {
+		if selectors[string(method.ID)] {
+			continue
+		}
+		merged.Methods[uniqueName(name, func(n string) bool { _, ok := merged.Methods[n]; return ok })] = method
+	}
+
+	topics := map[common.Hash]bool{}
+	for name, event := range implementation.Events {
+		merged.Events[name] = event
+		topics[event.ID] = true
+	}
+	for name, event := range proxy.Events {
+		if topics[event.ID] {
+			continue
+		}
+		merged.Events[uniqueName(name, func(n string) bool { _, ok := merged.Events[n]; return ok })] = event
+	}
+
+	for name, e := range implementation.Errors {
+		merged.Errors[name] = e
+	}
+	for name, e := range proxy.Errors {
+		exists := false
+		for _, known := range merged.Errors {
+			if bytes.Equal(known.ID[:4], e.ID[:4]) {
+				exists = true
+				break
+			}
+		}
+		if !exists {
+			merged.Errors[uniqueName(name, func(n string) bool { _, ok := merged.Errors[n]; return ok })] = e
+		}
+	}
+	return merged
+}
+
+// uniqueName suffixes name with a counter until taken reports it free, like
+// go-ethereum names overloads.
+func uniqueName(name string, taken func(string) bool) string {
+	unique := name
+	for i := 0; taken(unique); i++ {
+		unique = fmt.Sprintf("%s%d", name, i)
+	}
+	return unique
+}
@@ -0,0 +1,366 @@
+package indexer
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	evmi_database "github.com/evmi-cloud/go-evm-indexer/internal/database/evmi-database"
+	"github.com/rs/zerolog"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+const proxyAbi = `[{
+	"anonymous": false,
+	"inputs": [{"indexed": true, "name": "implementation", "type": "address"}],
+	"name": "Upgraded",
+	"type": "event"
+}, {
+	"inputs": [{"name": "newImplementation", "type": "address"}],
+	"name": "upgradeTo", "outputs": [], "stateMutability": "nonpayable", "type": "function"
+}]`
+
+const approvalAbi = `[{
+	"anonymous": false,
+	"inputs": [
+		{"indexed": true, "name": "owner", "type": "address"},
+		{"indexed": true, "name": "spender", "type": "address"},
+		{"indexed": false, "name": "value", "type": "uint256"}
+	],
+	"name": "Approval",
+	"type": "event"
+}]`
+
+var (
+	proxyAddress     = common.HexToAddress("0x00000000000000000000000000000000000000f0")
+	beaconAddress    = common.HexToAddress("0x00000000000000000000000000000000000000b0")
+	implementationV1 = common.HexToAddress("0x00000000000000000000000000000000000000a1")
+	implementationV2 = common.HexToAddress("0x00000000000000000000000000000000000000a2")
+)
+
+// proxyNode serves the proxy reads of the indexer: eth_getStorageAt from the
+// slots of proxyAddress, eth_call of implementation() on the beacon and
+// eth_getLogs from logs, filtered by address and block range.
+type proxyNode struct {
+	slots          map[common.Hash]common.Hash
+	implementation common.Address
+	logs           []ethTypes.Log
+}
+
+func (n *proxyNode) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	type request struct {
+		ID     json.RawMessage   `json:"id"`
+		Method string            `json:"method"`
+		Params []json.RawMessage `json:"params"`
+	}
+	type response struct {
+		JSONRPC string          `json:"jsonrpc"`
+		ID      json.RawMessage `json:"id"`
+		Result  any             `json:"result"`
+	}
+
+	body, _ := io.ReadAll(r.Body)
+	batch := strings.HasPrefix(strings.TrimSpace(string(body)), "[")
+	var reqs []request
+	if batch {
+		_ = json.Unmarshal(body, &reqs)
+	} else {
+		var req request
+		_ = json.Unmarshal(body, &req)
+		reqs = []request{req}
+	}
+
+	resps := make([]response, len(reqs))
+	for i, req := range reqs {
+		resps[i] = response{JSONRPC: "2.0", ID: req.ID}
+		switch req.Method {
+		case "eth_getStorageAt":
+			var address common.Address
+			var slot common.Hash
+			_ = json.Unmarshal(req.Params[0], &address)
+			_ = json.Unmarshal(req.Params[1], &slot)
+			value := common.Hash{}
+			if address == proxyAddress {
+				value = n.slots[slot]
+			}
+			resps[i].Result = value
+		case "eth_call":
+			resps[i].Result = hexutil.Bytes(common.LeftPadBytes(n.implementation.Bytes(), 32))
+		case "eth_getLogs":
+			var filter struct {
+				FromBlock hexutil.Uint64   `json:"fromBlock"`
+				ToBlock   hexutil.Uint64   `json:"toBlock"`
+				Address   []common.Address `json:"address"`
+			}
+			_ = json.Unmarshal(req.Params[0], &filter)
+			logs := []ethTypes.Log{}
+			for _, log := range n.logs {
+				if log.BlockNumber < uint64(filter.FromBlock) || log.BlockNumber > uint64(filter.ToBlock) {
+					continue
+				}
+				for _, address := range filter.Address {
+					if address == log.Address {
+						logs = append(logs, log)
+					}
+				}
+			}
+			resps[i].Result = logs
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if batch {
+		_ = json.NewEncoder(w).Encode(resps)
+	} else {
+		_ = json.NewEncoder(w).Encode(resps[0])
+	}
+}
+
+func upgradeLog(emitter common.Address, topic common.Hash, target common.Address, block uint64, index uint) ethTypes.Log {
+	return ethTypes.Log{
+		Address:     emitter,
+		Topics:      []common.Hash{topic, common.BytesToHash(target.Bytes())},
+		Data:        []byte{},
+		BlockNumber: block,
+		Index:       index,
+	}
+}
+
+// newProxySourceForTest returns a CONTRACT source at proxyAddress decoded with
+// proxyAbi, on a database mapping implementationV1 to a Transfer ABI and
+// implementationV2 to an Approval ABI, and a client of node.
+func newProxySourceForTest(t *testing.T, node *proxyNode) (*SourceIndexerService, *rpcPool) {
+	t.Helper()
+	db, err := gorm.Open(sqlite.Open(filepath.Join(t.TempDir(), "proxy.db")), &gorm.Config{})
+	if err != nil {
+		t.Fatalf("open sqlite: %v", err)
+	}
+	if err := db.AutoMigrate(&evmi_database.EvmJsonAbi{}, &evmi_database.EvmImplementationAbi{}, &evmi_database.EvmProxyImplementation{}); err != nil {
+		t.Fatalf("migrate: %v", err)
+	}
+	for name, mapping := range map[string]struct {
+		address common.Address
+		content string
+	}{"TokenV1": {implementationV1, erc20TransferAbi}, "TokenV2": {implementationV2, approvalAbi}} {
+		jsonAbi := evmi_database.EvmJsonAbi{ContractName: name, Content: mapping.content}
+		db.Create(&jsonAbi)
+		db.Create(&evmi_database.EvmImplementationAbi{EvmBlockchainID: 1, Address: mapping.address.Hex(), EvmJsonAbiID: jsonAbi.ID})
+	}
+
+	parsed, err := abi.JSON(strings.NewReader(proxyAbi))
+	if err != nil {
+		t.Fatalf("parse abi: %v", err)
+	}
+	p := &SourceIndexerService{
+		db:     &evmi_database.EvmiDatabase{Conn: db},
+		source: evmi_database.EvmLogSource{Type: string(evmi_database.ContractLogSourceType), Address: sql.NullString{String: proxyAddress.Hex(), Valid: true}},
+		logger: zerolog.Nop(),
+	}
+	p.source.ID = 1
+	p.chain.ID = 1
+	p.chain.BlockRange = 1000
+	p.setAbi("Proxy", parsed)
+
+	server := httptest.NewServer(node)
+	t.Cleanup(server.Close)
+	return p, newTestPool(t, server.URL)
+}
+
+func TestProxySlots(t *testing.T) {
+	slot := func(key string) common.Hash {
+		h := crypto.Keccak256Hash([]byte(key)).Big()
+		return common.BigToHash(h.Sub(h, common.Big1))
+	}
+	if slot("eip1967.proxy.implementation") != eip1967ImplementationSlot {
+		t.Error("EIP-1967 implementation slot mismatch")
+	}
+	if slot("eip1967.proxy.beacon") != eip1967BeaconSlot {
+		t.Error("EIP-1967 beacon slot mismatch")
+	}
+	if crypto.Keccak256Hash([]byte("PROXIABLE")) != eip1822ProxiableSlot {
+		t.Error("EIP-1822 slot mismatch")
+	}
+}
+
+func TestProxyDecodesWithImplementationActiveAtEachLog(t *testing.T) {
+	node := &proxyNode{
+		slots: map[common.Hash]common.Hash{eip1967ImplementationSlot: common.BytesToHash(implementationV1.Bytes())},
+		logs:  []ethTypes.Log{upgradeLog(proxyAddress, upgradedTopic, implementationV2, 150, 3)},
+	}
+	p, client := newProxySourceForTest(t, node)
+
+	if err := p.initProxy(context.Background(), client, 100); err != nil {
+		t.Fatalf("initProxy: %v", err)
+	}
+	if err := p.trackUpgrades(context.Background(), client, 100, 200); err != nil {
+		t.Fatalf("trackUpgrades: %v", err)
+	}
+	// A replayed range records nothing new.
+	if err := p.trackUpgrades(context.Background(), client, 100, 200); err != nil {
+		t.Fatalf("trackUpgrades replay: %v", err)
+	}
+
+	var history []evmi_database.EvmProxyImplementation
+	p.db.Conn.Order("from_block").Find(&history)
+	if len(history) != 2 {
+		t.Fatalf("got %d history entries, want 2: %+v", len(history), history)
+	}
+	if history[0].ProxyType != string(evmi_database.Eip1967ProxyType) || history[0].FromBlock != 0 || history[0].Implementation != implementationV1.Hex() {
+		t.Errorf("detected entry = %+v", history[0])
+	}
+	if history[1].FromBlock != 150 || history[1].FromLogIndex != 3 || history[1].Implementation != implementationV2.Hex() {
+		t.Errorf("upgrade entry = %+v", history[1])
+	}
+
+	for _, c := range []struct {
+		block uint64
+		index uint
+		want  string
+	}{{120, 0, "TokenV1"}, {150, 2, "TokenV1"}, {150, 3, "TokenV2"}, {180, 0, "TokenV2"}} {
+		if got := p.decoderAt(c.block, c.index).contractName; got != c.want {
+			t.Errorf("decoderAt(%d, %d) = %s, want %s", c.block, c.index, got, c.want)
+		}
+	}
+
+	// The implementation's events decode, and so do the proxy's own.
+	owner := common.HexToAddress("0x00000000000000000000000000000000000000cc")
+	meta := p.GetLogMetadata(ethTypes.Log{
+		Topics:      []common.Hash{crypto.Keccak256Hash([]byte("Approval(address,address,uint256)")), common.BytesToHash(owner.Bytes()), common.BytesToHash(owner.Bytes())},
+		Data:        common.LeftPadBytes([]byte{7}, 32),
+		BlockNumber: 160,
+	})
+	if meta.ContractName != "TokenV2" || meta.EventName != "Approval" || meta.Data["value"] != "7" {
+		t.Errorf("implementation event = %+v", meta)
+	}
+	meta = p.GetLogMetadata(node.logs[0])
+	if meta.ContractName != "TokenV2" || meta.EventName != "Upgraded" || meta.Data["implementation"] != implementationV2.Hex() {
+		t.Errorf("proxy event = %+v", meta)
+	}
+
+	// A reorg below the upgrade drops it.
+	if err := p.rollbackProxy(149); err != nil {
+		t.Fatalf("rollbackProxy: %v", err)
+	}
+	var count int64
+	p.db.Conn.Model(&evmi_database.EvmProxyImplementation{}).Count(&count)
+	if count != 1 || len(p.proxy.history) != 1 {
+		t.Errorf("after rollback: %d rows, %d in memory, want 1", count, len(p.proxy.history))
+	}
+	if got := p.decoderAt(180, 0).contractName; got != "TokenV1" {
+		t.Errorf("decoderAt after rollback = %s, want TokenV1", got)
+	}
+
+	// The history is reloaded on restart.
+	if err := p.initProxy(context.Background(), client, 150); err != nil {
+		t.Fatalf("initProxy: %v", err)
+	}
+	if len(p.proxy.history) != 1 || p.decoderAt(180, 0).contractName != "TokenV1" {
+		t.Errorf("reloaded history = %+v", p.proxy.history)
+	}
+}
+
+func TestProxyFollowsBeaconUpgrades(t *testing.T) {
+	node := &proxyNode{
+		slots:          map[common.Hash]common.Hash{eip1967BeaconSlot: common.BytesToHash(beaconAddress.Bytes())},
+		implementation: implementationV1,
+		logs: []ethTypes.Log{
+			upgradeLog(beaconAddress, upgradedTopic, implementationV2, 130, 0),
+			// Another beacon's upgrade is not the proxy's.
+			upgradeLog(common.HexToAddress("0xbb"), upgradedTopic, implementationV1, 140, 0),
+		},
+	}
+	p, client := newProxySourceForTest(t, node)
+
+	if err := p.initProxy(context.Background(), client, 100); err != nil {
+		t.Fatalf("initProxy: %v", err)
+	}
+	if err := p.trackUpgrades(context.Background(), client, 100, 200); err != nil {
+		t.Fatalf("trackUpgrades: %v", err)
+	}
+
+	history := p.proxy.history
+	if len(history) != 2 {
+		t.Fatalf("got %d history entries, want 2: %+v", len(history), history)
+	}
+	if history[0].ProxyType != string(evmi_database.BeaconProxyType) || history[0].Beacon != beaconAddress.Hex() || history[0].Implementation != implementationV1.Hex() {
+		t.Errorf("detected entry = %+v", history[0])
+	}
+	if history[1].Beacon != beaconAddress.Hex() || history[1].Implementation != implementationV2.Hex() || history[1].FromBlock != 130 {
+		t.Errorf("beacon upgrade entry = %+v", history[1])
+	}
+}
+
+func TestProxyPicksUpImplementationMappingsWhileRunning(t *testing.T) {
+	node := &proxyNode{
+		slots: map[common.Hash]common.Hash{eip1967ImplementationSlot: common.BytesToHash(implementationV1.Bytes())},
+	}
+	p, client := newProxySourceForTest(t, node)
+	p.db.Conn.Where("address = ?", implementationV1.Hex()).Delete(&evmi_database.EvmImplementationAbi{})
+
+	if err := p.initProxy(context.Background(), client, 100); err != nil {
+		t.Fatalf("initProxy: %v", err)
+	}
+	if got := p.decoderAt(120, 0).contractName; got != "Proxy" {
+		t.Fatalf("decoderAt without a mapping = %s, want Proxy", got)
+	}
+
+	// A mapping added through the API applies from the next range.
+	jsonAbi := evmi_database.EvmJsonAbi{ContractName: "TokenV1b", Content: erc20TransferAbi}
+	p.db.Conn.Create(&jsonAbi)
+	p.db.Conn.Create(&evmi_database.EvmImplementationAbi{EvmBlockchainID: 1, Address: implementationV1.Hex(), EvmJsonAbiID: jsonAbi.ID})
+	if err := p.trackUpgrades(context.Background(), client, 100, 200); err != nil {
+		t.Fatalf("trackUpgrades: %v", err)
+	}
+	if got := p.decoderAt(120, 0).contractName; got != "TokenV1b" {
+		t.Errorf("decoderAt after the mapping = %s, want TokenV1b", got)
+	}
+}
+
+func TestProxyDetectionIgnoresPlainContracts(t *testing.T) {
+	p, client := newProxySourceForTest(t, &proxyNode{})
+
+	if err := p.initProxy(context.Background(), client, 100); err != nil {
+		t.Fatalf("initProxy: %v", err)
+	}
+	if p.proxy != nil {
+		t.Fatalf("plain contract detected as a proxy: %+v", p.proxy)
+	}
+	if got := p.decoderAt(120, 0).contractName; got != "Proxy" {
+		t.Errorf("decoderAt = %s, want the source's ABI", got)
+	}
+}
+
+func TestMergeAbis(t *testing.T) {
+	implementation, _ := abi.JSON(strings.NewReader(`[{
+		"inputs": [{"name": "to", "type": "address"}],
+		"name": "upgradeTo", "outputs": [], "stateMutability": "nonpayable", "type": "function"
+	}, {
+		"inputs": [],
+		"name": "Upgraded", "outputs": [], "stateMutability": "view", "type": "function"
+	}]`))
+	proxy, _ := abi.JSON(strings.NewReader(proxyAbi))
+
+	merged := mergeAbis(implementation, proxy)
+	// Same selector: the implementation's entry wins.
+	if merged.Methods["upgradeTo"].Inputs[0].Name != "to" {
+		t.Errorf("upgradeTo = %v, want the implementation's", merged.Methods["upgradeTo"])
+	}
+	if _, ok := merged.Events["Upgraded"]; !ok {
+		t.Errorf("proxy event missing: %v", merged.Events)
+	}
+	if len(implementation.Events) != 0 {
+		t.Error("implementation ABI was modified")
+	}
+}
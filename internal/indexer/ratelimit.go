@@ -0,0 +1,164 @@
+package indexer
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/rpc"
+	evmi_database "github.com/evmi-cloud/go-evm-indexer/internal/database/evmi-database"
+)
+
+const (
+	// rpcThrottlePause is how long the chain's calls are held after a 429
+	// answer without a usable Retry-After header.
+	rpcThrottlePause = time.Second
+	// rpcThrottleMaxPause caps the Retry-After a provider may impose.
+	rpcThrottleMaxPause = time.Minute
+	// rpcThrottleRetries is how many 429 answers a call is retried after before
+	// its error is returned.
+	rpcThrottleRetries = 5
+)
+
+// rpcLimiter is the token bucket of a chain's JSON-RPC calls, shared by every
+// source of the chain on the instance through its rpcPool. It refills at rate
+// tokens per second up to one second of budget; a call takes the cost of its
+// requests, 1 per request unless its method is weighed. The bucket may go
+// into debt (a batch costing more than it holds): the calls after it wait for
+// the debt to be paid. A 429 answer holds every call until its Retry-After,
+// whether a rate is set or not.
+type rpcLimiter struct {
+	mu          sync.Mutex
+	rate        float64
+	costs       map[string]uint64
+	tokens      float64
+	refilledAt  time.Time
+	pausedUntil time.Time
+}
+
+func newRpcLimiter(chain evmi_database.EvmBlockchain) *rpcLimiter {
+	l := &rpcLimiter{}
+	l.configure(chain)
+	return l
+}
+
+// configure applies the chain's rate and method costs. The bucket starts full
+// when the rate changes.
+func (l *rpcLimiter) configure(chain evmi_database.EvmBlockchain) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if chain.RpcRateLimit != l.rate {
+		l.rate = chain.RpcRateLimit
+		l.tokens = l.rate
+		l.refilledAt = time.Now()
+	}
+	l.costs = chain.MethodCosts()
+}
+
+// cost returns the tokens taken by requests calls of method.
+func (l *rpcLimiter) cost(method string, requests int) float64 {
+	cost, ok := l.costs[method]
+	if !ok {
+		cost = 1
+	}
+	return float64(cost) * float64(requests)
+}
+
+// reserve takes the cost of requests calls of method from the bucket and
+// returns how long the caller must wait before sending them.
+func (l *rpcLimiter) reserve(method string, requests int, now time.Time) time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	var delay time.Duration
+	if now.Before(l.pausedUntil) {
+		delay = l.pausedUntil.Sub(now)
+	}
+	if l.rate <= 0 {
+		return delay
+	}
+
+	// After a 429 the bucket refills from the end of the pause only.
+	if elapsed := now.Sub(l.refilledAt); elapsed > 0 {
+		l.tokens = min(l.tokens+elapsed.Seconds()*l.rate, l.rate)
+		l.refilledAt = now
+	}
+	l.tokens -= l.cost(method, requests)
+	if l.tokens < 0 {
+		debt := time.Duration(-l.tokens / l.rate * float64(time.Second))
+		delay = max(delay, l.refilledAt.Sub(now)+debt)
+	}
+	return delay
+}
+
+// wait blocks until requests calls of method may be sent, or ctx is done, and
+// returns how long it waited.
+func (l *rpcLimiter) wait(ctx context.Context, method string, requests int) (time.Duration, error) {
+	delay := l.reserve(method, requests, time.Now())
+	if delay <= 0 {
+		return 0, nil
+	}
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return delay, nil
+	case <-ctx.Done():
+		return 0, ctx.Err()
+	}
+}
+
+// throttle holds every call for pause after a 429 answer, emptying the bucket
+// so the calls resume at the configured rate.
+func (l *rpcLimiter) throttle(pause time.Duration, now time.Time) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if until := now.Add(pause); until.After(l.pausedUntil) {
+		l.pausedUntil = until
+	}
+	if l.rate > 0 {
+		l.tokens = min(l.tokens, 0)
+		l.refilledAt = l.pausedUntil
+	}
+}
+
+// retryAfter returns the pause a Retry-After header asks for, in seconds or as
+// an HTTP date, rpcThrottlePause when it is missing or invalid, capped to
+// rpcThrottleMaxPause.
+func retryAfter(header string, now time.Time) time.Duration {
+	pause := rpcThrottlePause
+	if seconds, err := strconv.Atoi(header); err == nil && seconds >= 0 {
+		pause = time.Duration(seconds) * time.Second
+	} else if at, err := http.ParseTime(header); err == nil {
+		pause = at.Sub(now)
+	}
+	return max(min(pause, rpcThrottleMaxPause), 0)
+}
+
+// isRateLimited reports whether err is an HTTP 429 answer.
+func isRateLimited(err error) bool {
+	var httpErr rpc.HTTPError
+	return errors.As(err, &httpErr) && httpErr.StatusCode == http.StatusTooManyRequests
+}
+
+// throttleTransport reports the 429 answers of an endpoint to the chain's
+// limiter with their Retry-After, before the JSON-RPC client turns them into
+// errors.
+type throttleTransport struct {
+	next       http.RoundTripper
+	limiter    *rpcLimiter
+	onThrottle func()
+}
+
+func (t throttleTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := t.next.RoundTrip(req)
+	if err == nil && resp.StatusCode == http.StatusTooManyRequests {
+		now := time.Now()
+		t.limiter.throttle(retryAfter(resp.Header.Get("Retry-After"), now), now)
+		t.onThrottle()
+	}
+	return resp, err
+}
@@ -0,0 +1,170 @@
+package indexer
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	evmi_database "github.com/evmi-cloud/go-evm-indexer/internal/database/evmi-database"
+	"github.com/lmittmann/w3/module/eth"
+	"github.com/rs/zerolog"
+)
+
+func TestRpcLimiterPacesCallsByCost(t *testing.T) {
+	now := time.Now()
+	l := &rpcLimiter{rate: 10, tokens: 10, refilledAt: now, costs: map[string]uint64{"eth_getLogs": 5}}
+
+	if d := l.reserve("eth_blockNumber", 10, now); d != 0 {
+		t.Errorf("first second of budget waited %v", d)
+	}
+	// The bucket is empty: 5 units of debt at 10 per second.
+	if d := l.reserve("eth_getLogs", 1, now); d != 500*time.Millisecond {
+		t.Errorf("weighed call waited %v, want 500ms", d)
+	}
+	if d := l.reserve("eth_blockNumber", 1, now.Add(time.Second)); d != 0 {
+		t.Errorf("call after the debt was paid waited %v", d)
+	}
+}
+
+func TestRpcLimiterThrottle(t *testing.T) {
+	now := time.Now()
+	unlimited := &rpcLimiter{}
+	if d := unlimited.reserve("eth_getLogs", 100, now); d != 0 {
+		t.Errorf("unlimited chain waited %v", d)
+	}
+	unlimited.throttle(2*time.Second, now)
+	if d := unlimited.reserve("eth_getLogs", 1, now); d != 2*time.Second {
+		t.Errorf("throttled unlimited chain waited %v, want 2s", d)
+	}
+
+	// The bucket is emptied and refills from the end of the pause.
+	limited := &rpcLimiter{rate: 10, tokens: 10, refilledAt: now}
+	limited.throttle(2*time.Second, now)
+	if d := limited.reserve("eth_getLogs", 1, now); d != 2100*time.Millisecond {
+		t.Errorf("throttled chain waited %v, want 2.1s", d)
+	}
+}
+
+func TestRetryAfter(t *testing.T) {
+	now := time.Now().Truncate(time.Second)
+	for header, want := range map[string]time.Duration{
+		"":     rpcThrottlePause,
+		"soon": rpcThrottlePause,
+		"3":    3 * time.Second,
+		"3600": rpcThrottleMaxPause,
+		"-1":   rpcThrottlePause,
+		now.Add(5 * time.Second).UTC().Format(http.TimeFormat): 5 * time.Second,
+		now.Add(-time.Hour).UTC().Format(http.TimeFormat):      0,
+	} {
+		if got := retryAfter(header, now); got != want {
+			t.Errorf("retryAfter(%q) = %v, want %v", header, got, want)
+		}
+	}
+}
+
+// rateLimitedNode answers its first limited requests with a 429, whose body
+// reads like a range-too-large eth_getLogs error (see isRangeTooLarge), then
+// serves next.
+func rateLimitedNode(limited int64, next http.Handler) http.Handler {
+	var served atomic.Int64
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if served.Add(1) <= limited {
+			w.Header().Set("Retry-After", "0")
+			http.Error(w, "query returned more than 10000 results", http.StatusTooManyRequests)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+func TestPoolRetriesRateLimitedCalls(t *testing.T) {
+	chain := &fakeChain{}
+	chain.set(buildChain(42, 43, ""))
+	server, calls := countingServer(t, rateLimitedNode(2, chain))
+	pool := newTestPool(t, server.URL)
+
+	var block *big.Int
+	if err := pool.call(context.Background(), "eth_blockNumber", eth.BlockNumber().Returns(&block)); err != nil {
+		t.Fatalf("call: %v", err)
+	}
+	if block.Uint64() != 42 || calls.Load() != 3 {
+		t.Errorf("block = %v after %d requests, want 42 after 3", block, calls.Load())
+	}
+	if pool.limiter.pausedUntil.IsZero() {
+		t.Error("the 429 answers did not reach the limiter")
+	}
+
+	// A provider that keeps answering 429 fails the call eventually.
+	server, calls = countingServer(t, rateLimitedNode(100, chain))
+	pool = newTestPool(t, server.URL)
+	if err := pool.call(context.Background(), "eth_blockNumber", eth.BlockNumber().Returns(&block)); !isRateLimited(err) {
+		t.Fatalf("err = %v, want a 429", err)
+	}
+	if calls.Load() != 1+rpcThrottleRetries {
+		t.Errorf("requests = %d, want %d", calls.Load(), 1+rpcThrottleRetries)
+	}
+}
+
+func TestPoolRetriesRateLimitedGetLogsWithoutSplitting(t *testing.T) {
+	node := &cappedLogsNode{maxSpan: 100}
+	server, calls := countingServer(t, rateLimitedNode(1, node))
+	pool := newTestPool(t, server.URL)
+
+	p := newDecoderForTest(t, erc20TransferAbi)
+	p.chain.BlockRange = 100
+	if _, err := p.getLogs(context.Background(), pool, allLogsFilter, 1, 100); err != nil {
+		t.Fatalf("getLogs: %v", err)
+	}
+	if calls.Load() != 2 || len(node.ranges) != 1 {
+		t.Errorf("requests = %d, ranges = %v, want the whole range retried once", calls.Load(), node.ranges)
+	}
+	if e := pool.endpoints[0]; e.failures != 0 || e.lastError != "" {
+		t.Errorf("the 429 counted against the endpoint: %d failures, %q", e.failures, e.lastError)
+	}
+}
+
+func TestRpcLimiterWaitReturnsOnCancel(t *testing.T) {
+	l := &rpcLimiter{}
+	l.throttle(time.Minute, time.Now())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(10*time.Millisecond, cancel)
+	start := time.Now()
+	if _, err := l.wait(ctx, "eth_getLogs", 1); !errors.Is(err, context.Canceled) {
+		t.Fatalf("err = %v, want context.Canceled", err)
+	}
+	if waited := time.Since(start); waited > time.Second {
+		t.Errorf("wait returned %v after the cancellation", waited)
+	}
+}
+
+func TestRpcPoolsShareTheChainLimiter(t *testing.T) {
+	pools := newRpcPools()
+	chain := evmi_database.EvmBlockchain{RpcUrl: "http://rpc-a.example", RpcRateLimit: 5}
+	chain.ID = 1
+	first, err := pools.acquire(chain, nil, nil, zerolog.Nop())
+	if err != nil {
+		t.Fatalf("get: %v", err)
+	}
+	t.Cleanup(func() { pools.release(first) })
+
+	chain.RpcUrl = "http://rpc-b.example"
+	chain.RpcRateLimit = 20
+	chain.SetMethodCosts(map[string]uint64{"eth_getLogs": 10})
+	second, err := pools.acquire(chain, nil, nil, zerolog.Nop())
+	if err != nil {
+		t.Fatalf("get: %v", err)
+	}
+	t.Cleanup(func() { pools.release(second) })
+
+	if first == second || first.limiter != second.limiter {
+		t.Fatal("the rebuilt pool does not share the chain's limiter")
+	}
+	if second.limiter.rate != 20 || second.limiter.cost("eth_getLogs", 2) != 20 {
+		t.Errorf("limiter = %+v, want the updated rate and costs", second.limiter)
+	}
+}
@@ -0,0 +1,201 @@
+package indexer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/evmi-cloud/go-evm-indexer/internal/types"
+	"github.com/lmittmann/w3"
+	"github.com/lmittmann/w3/module/eth"
+	"github.com/lmittmann/w3/w3types"
+)
+
+const (
+	// methodNotFoundCode is the JSON-RPC "method not found" error code.
+	methodNotFoundCode = -32601
+	// defaultScanBatchSize is the number of blocks read at a time by
+	// scanBatchSize when the chain sets no RpcMaxBatchSize.
+	defaultScanBatchSize = 100
+)
+
+// methodUnsupportedMessages are provider messages for a method the node does
+// not serve, sent without the -32601 code. They name the method explicitly:
+// looser wordings ("not available", "does not exist") also describe transient
+// failures such as a pruned block.
+var methodUnsupportedMessages = []string{
+	"method not found",
+	"method not supported",
+	"unsupported method",
+}
+
+// isMethodUnsupported reports whether err says the node does not serve the
+// method called.
+func isMethodUnsupported(err error) bool {
+	if errors.Is(err, errMethodUnsupported) {
+		return true
+	}
+
+	var callErrs w3.CallErrors
+	if errors.As(err, &callErrs) {
+		for _, e := range callErrs {
+			if e != nil && isMethodUnsupported(e) {
+				return true
+			}
+		}
+		return false
+	}
+
+	var rpcErr rpc.Error
+	if errors.As(err, &rpcErr) && rpcErr.ErrorCode() == methodNotFoundCode {
+		return true
+	}
+
+	msg := strings.ToLower(err.Error())
+	for _, m := range methodUnsupportedMessages {
+		if strings.Contains(msg, m) {
+			return true
+		}
+	}
+	return false
+}
+
+// blockReceiptsByHash is an eth_getBlockReceipts call for a block hash, which
+// w3's eth.BlockReceipts (block numbers only) cannot express. Asking by hash
+// keeps the receipts on the fork the logs were read from.
+type blockReceiptsByHash struct {
+	hash common.Hash
+	ret  *ethTypes.Receipts
+}
+
+func (c *blockReceiptsByHash) CreateRequest() (rpc.BatchElem, error) {
+	return rpc.BatchElem{
+		Method: "eth_getBlockReceipts",
+		Args:   []any{c.hash},
+		Result: c.ret,
+	}, nil
+}
+
+func (c *blockReceiptsByHash) HandleResponse(elem rpc.BatchElem) error {
+	if elem.Error != nil {
+		return elem.Error
+	}
+	if *c.ret == nil {
+		return fmt.Errorf("no receipts reported for block %s", c.hash.Hex())
+	}
+	return nil
+}
+
+var _ w3types.RPCCaller = (*blockReceiptsByHash)(nil)
+
+// loadReceipts returns the receipts of txHashes, keyed by transaction hash. They
+// are read per block with eth_getBlockReceipts; when no endpoint of the pool
+// serves it, they are read per transaction with eth_getTransactionReceipt
+// instead. The pool remembers which endpoints do not serve it (see
+// rpcPool.call), so the fallback costs no extra call.
+func (p *SourceIndexerService) loadReceipts(ctx context.Context, client *rpcPool, blockHashes []common.Hash, txHashes []common.Hash) (map[common.Hash]*ethTypes.Receipt, error) {
+	receipts, err := p.loadBlockReceipts(ctx, client, blockHashes)
+	if err == nil {
+		return receipts, nil
+	}
+	if !isMethodUnsupported(err) {
+		return nil, err
+	}
+	return p.loadTxReceipts(ctx, client, txHashes)
+}
+
+func (p *SourceIndexerService) loadBlockReceipts(ctx context.Context, client *rpcPool, blockHashes []common.Hash) (map[common.Hash]*ethTypes.Receipt, error) {
+	blocks := make([]ethTypes.Receipts, len(blockHashes))
+	request := make([]w3types.RPCCaller, len(blockHashes))
+	for i, hash := range blockHashes {
+		request[i] = &blockReceiptsByHash{hash: hash, ret: &blocks[i]}
+	}
+	if err := p.callInBatches(ctx, client, "eth_getBlockReceipts", request); err != nil {
+		return nil, err
+	}
+
+	receipts := make(map[common.Hash]*ethTypes.Receipt)
+	for _, block := range blocks {
+		for _, receipt := range block {
+			receipts[receipt.TxHash] = receipt
+		}
+	}
+	return receipts, nil
+}
+
+func (p *SourceIndexerService) loadTxReceipts(ctx context.Context, client *rpcPool, txHashes []common.Hash) (map[common.Hash]*ethTypes.Receipt, error) {
+	list := make([]*ethTypes.Receipt, len(txHashes))
+	request := make([]w3types.RPCCaller, len(txHashes))
+	for i, hash := range txHashes {
+		request[i] = eth.TxReceipt(hash).Returns(&list[i])
+	}
+	if err := p.callInBatches(ctx, client, "eth_getTransactionReceipt", request); err != nil {
+		return nil, err
+	}
+
+	receipts := make(map[common.Hash]*ethTypes.Receipt, len(list))
+	for i, receipt := range list {
+		receipts[txHashes[i]] = receipt
+	}
+	return receipts, nil
+}
+
+// scanBatchSize is the number of blocks read at a time by the sources that
+// scan every block of a range: the chain's RpcMaxBatchSize, or
+// defaultScanBatchSize when it sets none.
+func (p *SourceIndexerService) scanBatchSize() uint64 {
+	if p.chain.RpcMaxBatchSize > 0 {
+		return p.chain.RpcMaxBatchSize
+	}
+	return defaultScanBatchSize
+}
+
+// callInBatches runs calls in batches of at most RpcMaxBatchSize.
+func (p *SourceIndexerService) callInBatches(ctx context.Context, client *rpcPool, method string, calls []w3types.RPCCaller) error {
+	size := int(p.chain.RpcMaxBatchSize)
+	if size == 0 {
+		size = len(calls)
+	}
+	for start := 0; start < len(calls); start += size {
+		if err := client.call(ctx, method, calls[start:min(start+size, len(calls))]...); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// applyReceipt copies the execution results of receipt, and the type and blob
+// hashes of transaction, onto tx.
+func applyReceipt(tx *types.EvmTransaction, transaction *ethTypes.Transaction, receipt *ethTypes.Receipt) {
+	tx.Type = uint64(transaction.Type())
+	tx.Status = receipt.Status
+	tx.GasUsed = receipt.GasUsed
+	// Nodes predating EIP-1559 leave effectiveGasPrice out: it is the gas price.
+	if receipt.EffectiveGasPrice != nil {
+		tx.EffectiveGasPrice = receipt.EffectiveGasPrice.String()
+	} else {
+		tx.EffectiveGasPrice = weiString(transaction.GasPrice())
+	}
+	if receipt.ContractAddress != (common.Address{}) {
+		tx.ContractAddress = receipt.ContractAddress.Hex()
+	}
+
+	tx.BlobGasUsed = receipt.BlobGasUsed
+	tx.BlobGasPrice = weiString(receipt.BlobGasPrice)
+	for _, hash := range transaction.BlobHashes() {
+		tx.BlobVersionedHashes = append(tx.BlobVersionedHashes, hash.Hex())
+	}
+}
+
+// weiString renders an optional wei amount, 0 when absent.
+func weiString(v *big.Int) string {
+	if v == nil {
+		return "0"
+	}
+	return v.String()
+}
@@ -0,0 +1,209 @@
+package indexer
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/evmi-cloud/go-evm-indexer/internal/types"
+)
+
+// receiptsNode serves eth_getTransactionReceipt for any hash and, unless
+// noBlockReceipts is set, eth_getBlockReceipts with one receipt per block. It
+// records the methods called.
+type receiptsNode struct {
+	noBlockReceipts bool
+
+	mu      sync.Mutex
+	methods []string
+}
+
+type rpcRequest struct {
+	ID     json.RawMessage   `json:"id"`
+	Method string            `json:"method"`
+	Params []json.RawMessage `json:"params"`
+}
+
+func (n *receiptsNode) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var body json.RawMessage
+	_ = json.NewDecoder(r.Body).Decode(&body)
+
+	var requests []rpcRequest
+	batch := bytes.HasPrefix(bytes.TrimSpace(body), []byte("["))
+	if batch {
+		_ = json.Unmarshal(body, &requests)
+	} else {
+		var req rpcRequest
+		_ = json.Unmarshal(body, &req)
+		requests = []rpcRequest{req}
+	}
+
+	responses := make([]map[string]any, len(requests))
+	for i, req := range requests {
+		n.mu.Lock()
+		n.methods = append(n.methods, req.Method)
+		n.mu.Unlock()
+
+		var hash common.Hash
+		_ = json.Unmarshal(req.Params[0], &hash)
+		response := map[string]any{"jsonrpc": "2.0", "id": req.ID}
+		switch {
+		case req.Method == "eth_getBlockReceipts" && n.noBlockReceipts:
+			response["error"] = map[string]any{"code": methodNotFoundCode, "message": "the method eth_getBlockReceipts does not exist/is not available"}
+		case req.Method == "eth_getBlockReceipts":
+			response["result"] = []map[string]any{testReceipt(hash)}
+		default:
+			response["result"] = testReceipt(hash)
+		}
+		responses[i] = response
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if batch {
+		_ = json.NewEncoder(w).Encode(responses)
+	} else {
+		_ = json.NewEncoder(w).Encode(responses[0])
+	}
+}
+
+// testReceipt is a successful receipt of transaction hash, which for block
+// receipts is the block hash itself.
+func testReceipt(hash common.Hash) map[string]any {
+	return map[string]any{
+		"type":              "0x2",
+		"status":            "0x1",
+		"cumulativeGasUsed": "0x5208",
+		"gasUsed":           "0x5208",
+		"effectiveGasPrice": "0x7",
+		"logsBloom":         ethTypes.Bloom{},
+		"logs":              []any{},
+		"transactionHash":   hash,
+		"contractAddress":   nil,
+	}
+}
+
+func TestLoadReceiptsFallsBackToTransactionReceipts(t *testing.T) {
+	hashes := []common.Hash{common.HexToHash("0x01"), common.HexToHash("0x02")}
+
+	node := &receiptsNode{}
+	server := httptest.NewServer(node)
+	defer server.Close()
+	client := newTestPool(t, server.URL)
+	p := newDecoderForTest(t, erc20TransferAbi)
+
+	receipts, err := p.loadReceipts(context.Background(), client, hashes, hashes)
+	if err != nil || len(receipts) != 2 || receipts[hashes[0]].GasUsed != 21000 {
+		t.Fatalf("block receipts = %v, err %v", receipts, err)
+	}
+	for _, method := range node.methods {
+		if method != "eth_getBlockReceipts" {
+			t.Errorf("called %s, want only eth_getBlockReceipts", method)
+		}
+	}
+
+	// A node without eth_getBlockReceipts: the pool remembers it and reads
+	// receipts per transaction without asking it again.
+	node = &receiptsNode{noBlockReceipts: true}
+	server = httptest.NewServer(node)
+	defer server.Close()
+	client = newTestPool(t, server.URL)
+
+	for range 2 {
+		receipts, err = p.loadReceipts(context.Background(), client, hashes, hashes)
+		if err != nil || len(receipts) != 2 || receipts[hashes[1]].Status != ethTypes.ReceiptStatusSuccessful {
+			t.Fatalf("fallback receipts = %v, err %v", receipts, err)
+		}
+	}
+	if client.endpoints[0].serves("eth_getBlockReceipts", time.Now()) {
+		t.Error("pool should remember eth_getBlockReceipts is unsupported")
+	}
+	blockCalls := 0
+	for _, method := range node.methods {
+		if method == "eth_getBlockReceipts" {
+			blockCalls++
+		}
+	}
+	if blockCalls != 2 || len(node.methods) != 6 {
+		t.Errorf("methods = %v, want one eth_getBlockReceipts batch then transaction receipts only", node.methods)
+	}
+
+	// Another endpoint of the pool serves it: block receipts are read there.
+	other := &receiptsNode{}
+	otherServer := httptest.NewServer(other)
+	defer otherServer.Close()
+	node.methods = nil
+	client = newTestPool(t, server.URL, otherServer.URL)
+	for range 2 {
+		if receipts, err = p.loadReceipts(context.Background(), client, hashes, hashes); err != nil || len(receipts) != 2 {
+			t.Fatalf("mixed pool receipts = %v, err %v", receipts, err)
+		}
+	}
+	for _, method := range append(node.methods, other.methods...) {
+		if method != "eth_getBlockReceipts" {
+			t.Errorf("mixed pool called %s, want only eth_getBlockReceipts", method)
+		}
+	}
+	if len(node.methods) > 2 {
+		t.Errorf("unsupported endpoint asked %d times, want at most one batch", len(node.methods))
+	}
+}
+
+func TestIsMethodUnsupported(t *testing.T) {
+	for msg, want := range map[string]bool{
+		"Method not found":                           true,
+		"eth_getBlockReceipts: method not supported": true,
+		"header not found":                           false,
+		"block 12 does not exist":                    false,
+		"archive data not available":                 false,
+		"feature not supported on this tier":         false,
+	} {
+		if got := isMethodUnsupported(errors.New(msg)); got != want {
+			t.Errorf("isMethodUnsupported(%q) = %v, want %v", msg, got, want)
+		}
+	}
+	if !isMethodUnsupported(errMethodUnsupported) {
+		t.Error("errMethodUnsupported should be recognized")
+	}
+}
+
+func TestApplyReceiptCopiesExecutionResults(t *testing.T) {
+	blobHash := common.HexToHash("0x01aa")
+	transaction := ethTypes.NewTx(&ethTypes.BlobTx{BlobHashes: []common.Hash{blobHash}})
+	receipt := &ethTypes.Receipt{
+		Status:            ethTypes.ReceiptStatusFailed,
+		GasUsed:           46097,
+		EffectiveGasPrice: big.NewInt(1000000000),
+		BlobGasUsed:       131072,
+		BlobGasPrice:      big.NewInt(3),
+	}
+
+	var tx types.EvmTransaction
+	applyReceipt(&tx, transaction, receipt)
+	if tx.Type != ethTypes.BlobTxType || tx.Status != 0 || tx.GasUsed != 46097 || tx.EffectiveGasPrice != "1000000000" {
+		t.Errorf("execution results not copied: %+v", tx)
+	}
+	if tx.ContractAddress != "" {
+		t.Errorf("contract address = %q, want empty for a call", tx.ContractAddress)
+	}
+	if tx.BlobGasUsed != 131072 || tx.BlobGasPrice != "3" || len(tx.BlobVersionedHashes) != 1 || tx.BlobVersionedHashes[0] != blobHash.Hex() {
+		t.Errorf("blob fields not copied: %+v", tx)
+	}
+
+	// A legacy receipt without effectiveGasPrice reports the gas price.
+	legacy := ethTypes.NewTx(&ethTypes.LegacyTx{GasPrice: big.NewInt(5)})
+	created := common.HexToAddress("0x00000000000000000000000000000000000000c1")
+	tx = types.EvmTransaction{}
+	applyReceipt(&tx, legacy, &ethTypes.Receipt{Status: ethTypes.ReceiptStatusSuccessful, ContractAddress: created})
+	if tx.EffectiveGasPrice != "5" || tx.ContractAddress != created.Hex() || tx.BlobGasPrice != "0" {
+		t.Errorf("legacy receipt = %+v", tx)
+	}
+}
@@ -0,0 +1,187 @@
+package indexer
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethTypes "github.com/ethereum/go-ethereum/core/types"
+	internal_bus "github.com/evmi-cloud/go-evm-indexer/internal/bus"
+	evmi_database "github.com/evmi-cloud/go-evm-indexer/internal/database/evmi-database"
+	"github.com/evmi-cloud/go-evm-indexer/internal/types"
+)
+
+const (
+	// redecodeChunkBlocks is the block range streamed from the store at a
+	// time; the job's progress is saved after each one.
+	redecodeChunkBlocks = 10_000
+	// redecodeBatchSize is the most logs rewritten per store call.
+	redecodeBatchSize = 500
+)
+
+// startRedecode runs the RUNNING re-decode job of a source in the background,
+// unless it is already running on this instance.
+func (s *IndexerService) startRedecode(sourceId uint) {
+	s.mu.Lock()
+	if s.redecoding[sourceId] {
+		s.mu.Unlock()
+		return
+	}
+	s.redecoding[sourceId] = true
+	s.mu.Unlock()
+
+	go func() {
+		defer func() {
+			s.mu.Lock()
+			delete(s.redecoding, sourceId)
+			s.mu.Unlock()
+		}()
+		if err := s.runRedecode(sourceId); err != nil {
+			s.logger.Error().Msg("re-decode of source id " + fmt.Sprint(sourceId) + " failed: " + err.Error())
+		}
+	}()
+}
+
+// runRedecode runs the re-decode job of a source with a decoder of its own,
+// loaded with the current ABIs: the job does not share the state of the
+// source's indexer, which keeps indexing meanwhile, and closes the store it
+// loaded when it ends. The job ends DONE, or FAILED with the error.
+func (s *IndexerService) runRedecode(sourceId uint) error {
+	var job evmi_database.EvmLogSourceRedecode
+	result := s.db.Conn.Where("evm_log_source_id = ?", sourceId).Limit(1).Find(&job)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 || job.Status != string(evmi_database.RunningRedecodeStatus) {
+		return nil
+	}
+
+	var source evmi_database.EvmLogSource
+	if err := s.db.Conn.First(&source, sourceId).Error; err != nil {
+		return err
+	}
+
+	s.logger.Info().Msg("re-decoding source id " + fmt.Sprint(sourceId))
+	p := NewSourceIndexerService(s.db, s.bus, s.metrics, s.headTrackers, s.logsBatches, source)
+	err := p.loadSource(map[string]interface{}{"type": source.Type, "redecode": true})
+	if p.store != nil {
+		defer func() {
+			if err := p.store.Close(); err != nil {
+				s.logger.Warn().Msg("failed to close the store of the re-decode of source id " + fmt.Sprint(sourceId) + ": " + err.Error())
+			}
+		}()
+	}
+	if err == nil && (source.Type == string(evmi_database.ContractLogSourceType) || source.Type == string(evmi_database.FactoryLogSourceType)) {
+		err = p.loadProxyHistory()
+	}
+	if err == nil {
+		err = p.redecode(&job)
+	}
+	return p.endRedecode(&job, err)
+}
+
+// redecode streams the stored logs of the job's remaining blocks, chunk by
+// chunk, decodes them again and rewrites their metadata. The job's progress
+// is saved and emitted as a source update after each chunk, so an interrupted
+// job resumes from the chunk it was at.
+func (p *SourceIndexerService) redecode(job *evmi_database.EvmLogSourceRedecode) error {
+	storage := p.store.GetStorage()
+
+	for job.NextBlock <= job.ToBlock {
+		to := job.ToBlock
+		if to-job.NextBlock >= redecodeChunkBlocks {
+			to = job.NextBlock + redecodeChunkBlocks - 1
+		}
+
+		stream := make(chan types.EvmLog, redecodeBatchSize)
+		streamErr := make(chan error, 1)
+		go func() {
+			streamErr <- storage.GetLogStream(uint64(p.source.ID), job.NextBlock, to, stream)
+		}()
+
+		var batch []types.EvmLog
+		var updateErr error
+		logs := uint64(0)
+		for log := range stream {
+			// Drain the stream after a failed update so the store returns.
+			if updateErr != nil {
+				continue
+			}
+			log.Metadata = p.GetLogMetadata(storedLog(log))
+			batch = append(batch, log)
+			if len(batch) == redecodeBatchSize {
+				updateErr = storage.UpdateLogsMetadata(batch)
+				logs += uint64(len(batch))
+				batch = nil
+			}
+		}
+		if err := <-streamErr; err != nil {
+			return err
+		}
+		if updateErr != nil {
+			return updateErr
+		}
+		if len(batch) > 0 {
+			if err := storage.UpdateLogsMetadata(batch); err != nil {
+				return err
+			}
+			logs += uint64(len(batch))
+		}
+
+		job.NextBlock = to + 1
+		job.Logs += logs
+		result := p.db.Conn.Model(job).Updates(map[string]interface{}{
+			"next_block": job.NextBlock,
+			"logs":       job.Logs,
+		})
+		if result.Error != nil {
+			return result.Error
+		}
+		p.emitRedecodeUpdate()
+	}
+	return nil
+}
+
+// endRedecode saves the outcome of the job: DONE, or FAILED with err.
+func (p *SourceIndexerService) endRedecode(job *evmi_database.EvmLogSourceRedecode, err error) error {
+	job.Status = string(evmi_database.DoneRedecodeStatus)
+	job.Error = ""
+	if err != nil {
+		job.Status = string(evmi_database.FailedRedecodeStatus)
+		job.Error = err.Error()
+	}
+	result := p.db.Conn.Model(job).Updates(map[string]interface{}{
+		"status": job.Status,
+		"error":  job.Error,
+	})
+	if result.Error != nil {
+		return result.Error
+	}
+	p.emitRedecodeUpdate()
+	return err
+}
+
+// emitRedecodeUpdate emits the source as a source update, read again as the
+// job does not track it: the update carries the job's progress.
+func (p *SourceIndexerService) emitRedecodeUpdate() {
+	var source evmi_database.EvmLogSource
+	if err := p.db.Conn.First(&source, p.source.ID).Error; err != nil {
+		return
+	}
+	p.bus.Emit(context.Background(), internal_bus.SourceUpdateTopic, source)
+}
+
+// storedLog is the chain log of a stored one, with what decoding needs.
+func storedLog(log types.EvmLog) ethTypes.Log {
+	topics := make([]common.Hash, len(log.Topics))
+	for i, topic := range log.Topics {
+		topics[i] = common.HexToHash(topic)
+	}
+	return ethTypes.Log{
+		Address:     common.HexToAddress(log.Address),
+		Topics:      topics,
+		Data:        common.FromHex(log.Data),
+		BlockNumber: log.BlockNumber,
+		Index:       uint(log.LogIndex),
+	}
+}
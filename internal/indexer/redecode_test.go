@@ -0,0 +1,125 @@
+package indexer
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	internal_bus "github.com/evmi-cloud/go-evm-indexer/internal/bus"
+	evmi_database "github.com/evmi-cloud/go-evm-indexer/internal/database/evmi-database"
+	log_stores "github.com/evmi-cloud/go-evm-indexer/internal/database/log-stores"
+	"github.com/evmi-cloud/go-evm-indexer/internal/types"
+	"github.com/mustafaturan/bus/v3"
+)
+
+// redecodeStore streams its logs and records the metadata updates; other
+// methods are unused.
+type redecodeStore struct {
+	log_stores.EvmIndexerStorage
+	logs    []types.EvmLog
+	updated []types.EvmLog
+}
+
+func (s *redecodeStore) GetLogStream(sourceId uint64, fromBlock uint64, toBlock uint64, stream chan types.EvmLog) error {
+	for _, log := range s.logs {
+		if uint64(log.SourceId) == sourceId && log.BlockNumber >= fromBlock && log.BlockNumber <= toBlock {
+			stream <- log
+		}
+	}
+	close(stream)
+	return nil
+}
+
+func (s *redecodeStore) UpdateLogsMetadata(logs []types.EvmLog) error {
+	s.updated = append(s.updated, logs...)
+	return nil
+}
+
+func TestRedecodeRewritesStoredMetadata(t *testing.T) {
+	p := newSourceIndexerForTest(t, evmi_database.EvmLogSource{
+		Type:       string(evmi_database.ContractLogSourceType),
+		StartBlock: 1,
+		SyncBlock:  25_000,
+	})
+	if err := p.db.Conn.AutoMigrate(&evmi_database.EvmLogSourceRedecode{}); err != nil {
+		t.Fatalf("migrate: %v", err)
+	}
+	parsed, err := abi.JSON(strings.NewReader(erc20TransferAbi))
+	if err != nil {
+		t.Fatalf("parse abi: %v", err)
+	}
+	p.setAbi("Token", parsed)
+
+	// Logs stored before the ABI declared them: undecoded.
+	mkLog := func(block uint64) types.EvmLog {
+		return types.EvmLog{
+			Id:          fmt.Sprintf("1:%d:0", block),
+			SourceId:    p.source.ID,
+			Address:     "0x00000000000000000000000000000000000000cc",
+			Topics:      []string{transferTopic0.Hex(), addressTopic("0xaa").Hex(), addressTopic("0xbb").Hex()},
+			Data:        common.Bytes2Hex(common.LeftPadBytes(big.NewInt(1000).Bytes(), 32)),
+			BlockNumber: block,
+			Metadata:    types.EvmMetadata{ContractName: "Token", Data: map[string]string{}},
+		}
+	}
+	store := &redecodeStore{logs: []types.EvmLog{mkLog(5), mkLog(10_005), mkLog(25_000), mkLog(25_001)}}
+	p.store = log_stores.NewIndexerStore(store)
+
+	job := evmi_database.EvmLogSourceRedecode{
+		EvmLogSourceID: p.source.ID,
+		Status:         string(evmi_database.RunningRedecodeStatus),
+		FromBlock:      1,
+		NextBlock:      1,
+		ToBlock:        25_000,
+	}
+	if err := p.db.Conn.Create(&job).Error; err != nil {
+		t.Fatalf("create job: %v", err)
+	}
+
+	var mu sync.Mutex
+	updates := 0
+	p.bus.RegisterHandler("redecode-test", bus.Handler{
+		Matcher: internal_bus.SourceUpdateTopic,
+		Handle: func(_ context.Context, e bus.Event) {
+			mu.Lock()
+			defer mu.Unlock()
+			if e.Data.(evmi_database.EvmLogSource).ID == p.source.ID {
+				updates++
+			}
+		},
+	})
+
+	if err := p.endRedecode(&job, p.redecode(&job)); err != nil {
+		t.Fatalf("redecode: %v", err)
+	}
+
+	// The log above the job's ToBlock is indexed after it started, decoded
+	// with the current ABI already.
+	if len(store.updated) != 3 {
+		t.Fatalf("updated %d logs, want 3", len(store.updated))
+	}
+	for _, log := range store.updated {
+		if log.Metadata.EventName != "Transfer" || log.Metadata.Data["value"] != "1000" {
+			t.Errorf("log %s metadata = %+v", log.Id, log.Metadata)
+		}
+	}
+
+	var stored evmi_database.EvmLogSourceRedecode
+	if err := p.db.Conn.First(&stored, job.ID).Error; err != nil {
+		t.Fatalf("reload job: %v", err)
+	}
+	if stored.Status != string(evmi_database.DoneRedecodeStatus) || stored.NextBlock != 25_001 || stored.Logs != 3 {
+		t.Errorf("job = %+v", stored)
+	}
+	// One update per chunk of redecodeChunkBlocks, and one for the outcome.
+	mu.Lock()
+	defer mu.Unlock()
+	if updates != 4 {
+		t.Errorf("source updates = %d, want 4", updates)
+	}
+}
@@ -0,0 +1,269 @@
+package indexer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethTypes "github.com/ethereum/go-ethereum/core/types"
+	internal_bus "github.com/evmi-cloud/go-evm-indexer/internal/bus"
+	evmi_database "github.com/evmi-cloud/go-evm-indexer/internal/database/evmi-database"
+	"github.com/evmi-cloud/go-evm-indexer/internal/types"
+	"github.com/lmittmann/w3/module/eth"
+	"github.com/lmittmann/w3/w3types"
+)
+
+// reorgTrackingDepth is how many blocks below the chain head a source remembers
+// the hash of. Reorgs deeper than this are not detected; ranges further from the
+// head (catch-up) are indexed without hash tracking.
+const reorgTrackingDepth uint64 = 128
+
+// blockHashWindow remembers the hashes of the most recently indexed blocks of a
+// source, keyed by block number, capped to reorgTrackingDepth entries. It lives
+// on the service struct so it survives supervisor restarts of Serve, but not a
+// process restart: the first range indexed after a restart has no parent to
+// compare against.
+type blockHashWindow struct {
+	hashes map[uint64]common.Hash
+}
+
+func newBlockHashWindow() *blockHashWindow {
+	return &blockHashWindow{hashes: make(map[uint64]common.Hash)}
+}
+
+// record stores the hash of block number and evicts entries that fell out of the
+// tracking depth below it.
+func (w *blockHashWindow) record(number uint64, hash common.Hash) {
+	w.hashes[number] = hash
+	if number < reorgTrackingDepth {
+		return
+	}
+	for n := range w.hashes {
+		if n <= number-reorgTrackingDepth {
+			delete(w.hashes, n)
+		}
+	}
+}
+
+// hash returns the recorded hash of block number, if any.
+func (w *blockHashWindow) hash(number uint64) (common.Hash, bool) {
+	h, ok := w.hashes[number]
+	return h, ok
+}
+
+// forgetFrom drops every recorded block at or above number.
+func (w *blockHashWindow) forgetFrom(number uint64) {
+	for n := range w.hashes {
+		if n >= number {
+			delete(w.hashes, n)
+		}
+	}
+}
+
+// lowest returns the lowest recorded block number, false when empty.
+func (w *blockHashWindow) lowest() (uint64, bool) {
+	found := false
+	var min uint64
+	for n := range w.hashes {
+		if !found || n < min {
+			min, found = n, true
+		}
+	}
+	return min, found
+}
+
+// checkReorg verifies that [from, to] extends the blocks this source already
+// indexed. Only the part of the range within reorgTrackingDepth of the head is
+// checked. It returns the fetched headers, to be recorded once the range is
+// stored, or reorged=true with the last block still on the canonical chain when
+// the parent of the range no longer matches what was indexed.
+func (p *SourceIndexerService) checkReorg(ctx context.Context, client *rpcPool, from, to, head uint64) (headers []*ethTypes.Header, ancestor uint64, reorged bool, err error) {
+	if p.recentBlocks == nil {
+		p.recentBlocks = newBlockHashWindow()
+	}
+
+	windowStart := from
+	if head >= reorgTrackingDepth && head-reorgTrackingDepth+1 > windowStart {
+		windowStart = head - reorgTrackingDepth + 1
+	}
+	if windowStart > to || windowStart == 0 {
+		return nil, 0, false, nil
+	}
+
+	headers, err = p.loadHeadersByNumber(ctx, client, windowStart, to)
+	if err != nil {
+		return nil, 0, false, err
+	}
+
+	// A break inside the range means the node is switching forks while we read
+	// it: fail the range so it is retried against a settled chain.
+	for i := 1; i < len(headers); i++ {
+		if headers[i].ParentHash != headers[i-1].Hash() {
+			return nil, 0, false, fmt.Errorf("block %d does not extend block %d: chain reorganizing", headers[i].Number, headers[i-1].Number)
+		}
+	}
+
+	parent, known := p.recentBlocks.hash(windowStart - 1)
+	if !known || parent == headers[0].ParentHash {
+		return headers, 0, false, nil
+	}
+
+	ancestor, err = p.findCommonAncestor(ctx, client, windowStart-1)
+	if err != nil {
+		return nil, 0, false, err
+	}
+	return nil, ancestor, true, nil
+}
+
+// recordHeaders remembers the hashes of a range once it has been stored.
+func (p *SourceIndexerService) recordHeaders(headers []*ethTypes.Header) {
+	for _, header := range headers {
+		p.recentBlocks.record(header.Number.Uint64(), header.Hash())
+	}
+}
+
+// findCommonAncestor walks the recorded blocks down from below and returns the
+// highest one whose hash is still canonical. When none matches, the reorg is
+// deeper than the tracked window and the block just below it is used.
+func (p *SourceIndexerService) findCommonAncestor(ctx context.Context, client *rpcPool, below uint64) (uint64, error) {
+	lowest, ok := p.recentBlocks.lowest()
+	if !ok || lowest > below {
+		return below, nil
+	}
+
+	headers, err := p.loadHeadersByNumber(ctx, client, lowest, below)
+	if err != nil {
+		return 0, err
+	}
+
+	for i := len(headers) - 1; i >= 0; i-- {
+		number := headers[i].Number.Uint64()
+		if recorded, ok := p.recentBlocks.hash(number); ok && recorded == headers[i].Hash() {
+			return number, nil
+		}
+	}
+
+	p.logger.Warn().Msg(fmt.Sprintf("reorg deeper than the %d tracked blocks, rolling back to block %d", reorgTrackingDepth, lowest-1))
+	if lowest == 0 {
+		return 0, nil
+	}
+	return lowest - 1, nil
+}
+
+// rollback rewinds the source to ancestor after a reorg: the stored data and
+// proxy rows above it first, then the persisted cursors of the pipeline's
+// exporters, then the source's cursor. In that order a failure leaves the
+// cursor, and the tracked block hashes, past the reorg, so the next pass
+// detects it again and repeats the deletes instead of leaving the orphaned
+// fork's rows stored. Running exporters are also told on the source.rollback
+// topic so they can undo what they delivered.
+func (p *SourceIndexerService) rollback(ancestor uint64) error {
+	var depth uint64
+	if p.source.SyncBlock > ancestor {
+		depth = p.source.SyncBlock - ancestor
+	}
+
+	p.logger.Warn().Fields(map[string]interface{}{
+		"source":   p.source.ID,
+		"ancestor": ancestor,
+		"depth":    depth,
+	}).Msg("chain reorganization detected, rolling back")
+
+	start := time.Now()
+	err := p.store.GetStorage().RollbackSourceData(uint64(p.source.ID), ancestor+1)
+	p.metrics.ObserveStoreWrite(p.storeInfo.Identifier, "rollback", time.Since(start), err)
+	if err != nil {
+		p.logger.Error().Msg(err.Error())
+		return err
+	}
+
+	if err := p.rollbackProxy(ancestor); err != nil {
+		p.logger.Error().Msg(err.Error())
+		return err
+	}
+
+	if err := p.rewindExporters(ancestor + 1); err != nil {
+		p.logger.Error().Msg(err.Error())
+		return err
+	}
+
+	if tx := p.db.Conn.Model(&p.source).Update("sync_block", ancestor); tx.Error != nil {
+		p.logger.Error().Msg(tx.Error.Error())
+		return tx.Error
+	}
+	p.source.SyncBlock = ancestor
+
+	p.recentBlocks.forgetFrom(ancestor + 1)
+	p.emitSourceUpdate()
+	p.bus.Emit(context.Background(), internal_bus.SourceRollbackTopic, types.SourceRollback{
+		SourceId:   p.source.ID,
+		PipelineId: p.source.EvmLogPipelineID,
+		ChainId:    p.chain.ChainId,
+		FromBlock:  ancestor + 1,
+	})
+	p.metrics.RecordReorg(p.sourceLabels(), depth)
+	return nil
+}
+
+// rewindExporters moves the persisted cursor of the pipeline's exporters that
+// are past fromBlock back before it, so the exporters that are not running to
+// receive the source.rollback event still redeliver the reorged blocks.
+func (p *SourceIndexerService) rewindExporters(fromBlock uint64) error {
+	var exporters []evmi_database.EvmiExporter
+	if err := p.db.Conn.Where("evm_log_pipeline_id = ?", p.source.EvmLogPipelineID).Find(&exporters).Error; err != nil {
+		return err
+	}
+	for _, exporter := range exporters {
+		rewind, past := exporter.RollbackCursor(fromBlock)
+		if !past {
+			continue
+		}
+		// Column-scoped: the exporter's own service writes the other columns.
+		if err := p.db.Conn.Model(&exporter).Updates(map[string]interface{}{
+			"sync_block":     rewind,
+			"sync_log_index": -1,
+		}).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// loadHeadersByNumber batch-fetches the headers of blocks [from, to] in order,
+// honoring RpcMaxBatchSize.
+func (p *SourceIndexerService) loadHeadersByNumber(ctx context.Context, client *rpcPool, from, to uint64) ([]*ethTypes.Header, error) {
+	count := to - from + 1
+	maxBatchRequest := p.chain.RpcMaxBatchSize
+	if maxBatchRequest == 0 {
+		maxBatchRequest = count
+	}
+
+	headers := make([]*ethTypes.Header, count)
+	for start := uint64(0); start < count; start += maxBatchRequest {
+		end := start + maxBatchRequest
+		if end > count {
+			end = count
+		}
+
+		request := make([]w3types.RPCCaller, 0, end-start)
+		for i := start; i < end; i++ {
+			request = append(request, eth.HeaderByNumber(new(big.Int).SetUint64(from+i)).Returns(&headers[i]))
+		}
+
+		batchCallErr := client.call(ctx, "eth_getBlockByNumber", request...)
+		if batchCallErr != nil {
+			p.logger.Error().Msg(batchCallErr.Error())
+			return nil, batchCallErr
+		}
+	}
+
+	for i, header := range headers {
+		if header == nil {
+			return nil, errors.New("block header not found for " + new(big.Int).SetUint64(from+uint64(i)).String())
+		}
+	}
+	return headers, nil
+}
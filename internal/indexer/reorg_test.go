@@ -0,0 +1,285 @@
+package indexer
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethTypes "github.com/ethereum/go-ethereum/core/types"
+	internal_bus "github.com/evmi-cloud/go-evm-indexer/internal/bus"
+	evmi_database "github.com/evmi-cloud/go-evm-indexer/internal/database/evmi-database"
+	log_stores "github.com/evmi-cloud/go-evm-indexer/internal/database/log-stores"
+	"github.com/evmi-cloud/go-evm-indexer/internal/types"
+	"github.com/mustafaturan/bus/v3"
+)
+
+// fakeChain serves eth_blockNumber and eth_getBlockByNumber from an in-memory
+// header list, replacing it wholesale to simulate a reorg. tags maps block tags
+// ("safe", "finalized") to block numbers.
+type fakeChain struct {
+	mu      sync.Mutex
+	headers map[uint64]*ethTypes.Header
+	tags    map[string]uint64
+}
+
+// buildChain returns headers 0..to, forking from the parent chain at forkAt:
+// blocks below forkAt are shared, blocks at or above it carry the fork tag.
+func buildChain(to uint64, forkAt uint64, fork string) map[uint64]*ethTypes.Header {
+	headers := make(map[uint64]*ethTypes.Header, to+1)
+	var parent *ethTypes.Header
+	for n := uint64(0); n <= to; n++ {
+		h := &ethTypes.Header{Number: new(big.Int).SetUint64(n), Difficulty: big.NewInt(0)}
+		if parent != nil {
+			h.ParentHash = parent.Hash()
+		}
+		if n >= forkAt {
+			h.Extra = []byte(fork)
+		}
+		headers[n] = h
+		parent = h
+	}
+	return headers
+}
+
+func (c *fakeChain) set(headers map[uint64]*ethTypes.Header) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.headers = headers
+}
+
+func (c *fakeChain) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	type request struct {
+		ID     json.RawMessage   `json:"id"`
+		Method string            `json:"method"`
+		Params []json.RawMessage `json:"params"`
+	}
+	type response struct {
+		JSONRPC string          `json:"jsonrpc"`
+		ID      json.RawMessage `json:"id"`
+		Result  any             `json:"result"`
+	}
+
+	body, _ := io.ReadAll(r.Body)
+	batch := strings.HasPrefix(strings.TrimSpace(string(body)), "[")
+	var reqs []request
+	if batch {
+		_ = json.Unmarshal(body, &reqs)
+	} else {
+		var req request
+		_ = json.Unmarshal(body, &req)
+		reqs = []request{req}
+	}
+
+	c.mu.Lock()
+	resps := make([]response, len(reqs))
+	for i, req := range reqs {
+		resps[i] = response{JSONRPC: "2.0", ID: req.ID}
+		if req.Method == "eth_blockNumber" {
+			resps[i].Result = hexutil.Uint64(len(c.headers) - 1)
+			continue
+		}
+		var number string
+		_ = json.Unmarshal(req.Params[0], &number)
+		if tagged, ok := c.tags[number]; ok {
+			resps[i].Result = c.headers[tagged]
+			continue
+		}
+		n, _ := new(big.Int).SetString(strings.TrimPrefix(number, "0x"), 16)
+		if n != nil {
+			resps[i].Result = c.headers[n.Uint64()]
+		}
+	}
+	c.mu.Unlock()
+
+	w.Header().Set("Content-Type", "application/json")
+	if batch {
+		_ = json.NewEncoder(w).Encode(resps)
+	} else {
+		_ = json.NewEncoder(w).Encode(resps[0])
+	}
+}
+
+func newReorgClient(t *testing.T, chain *fakeChain) *rpcPool {
+	t.Helper()
+	server := httptest.NewServer(chain)
+	t.Cleanup(server.Close)
+	return newTestPool(t, server.URL)
+}
+
+func TestBlockHashWindowEvictsBeyondDepth(t *testing.T) {
+	w := newBlockHashWindow()
+	for n := uint64(1); n <= reorgTrackingDepth+10; n++ {
+		w.record(n, ethTypes.EmptyRootHash)
+	}
+	if len(w.hashes) != int(reorgTrackingDepth) {
+		t.Fatalf("window size = %d, want %d", len(w.hashes), reorgTrackingDepth)
+	}
+	if lowest, _ := w.lowest(); lowest != 11 {
+		t.Errorf("lowest = %d, want 11", lowest)
+	}
+	w.forgetFrom(100)
+	if _, ok := w.hash(100); ok {
+		t.Error("block 100 still recorded after forgetFrom(100)")
+	}
+	if _, ok := w.hash(99); !ok {
+		t.Error("block 99 dropped by forgetFrom(100)")
+	}
+}
+
+func TestCheckReorgFindsCommonAncestor(t *testing.T) {
+	chain := &fakeChain{}
+	chain.set(buildChain(20, 21, ""))
+	client := newReorgClient(t, chain)
+
+	p := newDecoderForTest(t, erc20TransferAbi)
+	p.chain.RpcMaxBatchSize = 4
+
+	// Index 1..15 in three ranges, recording their hashes.
+	for from := uint64(1); from <= 15; from += 5 {
+		headers, _, reorged, err := p.checkReorg(context.Background(), client, from, from+4, 20)
+		if err != nil || reorged {
+			t.Fatalf("range %d: reorged=%v err=%v", from, reorged, err)
+		}
+		p.recordHeaders(headers)
+	}
+
+	// Same chain: the next range extends what was indexed.
+	if _, _, reorged, err := p.checkReorg(context.Background(), client, 16, 20, 20); err != nil || reorged {
+		t.Fatalf("no reorg expected: reorged=%v err=%v", reorged, err)
+	}
+
+	// Blocks 13+ are replaced by a fork: 12 is the last shared block.
+	chain.set(buildChain(20, 13, "fork"))
+	_, ancestor, reorged, err := p.checkReorg(context.Background(), client, 16, 20, 20)
+	if err != nil {
+		t.Fatalf("checkReorg: %v", err)
+	}
+	if !reorged || ancestor != 12 {
+		t.Fatalf("reorged=%v ancestor=%d, want true/12", reorged, ancestor)
+	}
+}
+
+func TestCheckReorgSkipsRangesBelowTrackingDepth(t *testing.T) {
+	p := newDecoderForTest(t, erc20TransferAbi)
+	// No client needed: nothing within reorgTrackingDepth of the head is fetched.
+	headers, _, reorged, err := p.checkReorg(context.Background(), nil, 1, 10, 10+reorgTrackingDepth)
+	if err != nil || reorged || headers != nil {
+		t.Fatalf("headers=%v reorged=%v err=%v, want nothing checked", headers, reorged, err)
+	}
+}
+
+// rollbackStore records RollbackSourceData calls, failing them with err;
+// other methods are unused.
+type rollbackStore struct {
+	log_stores.EvmIndexerStorage
+	sourceId  uint64
+	fromBlock uint64
+	err       error
+}
+
+func (s *rollbackStore) RollbackSourceData(sourceId uint64, fromBlock uint64) error {
+	s.sourceId, s.fromBlock = sourceId, fromBlock
+	return s.err
+}
+
+func TestRollbackRewindsCursorAndNotifies(t *testing.T) {
+	p := newSourceIndexerForTest(t, evmi_database.EvmLogSource{
+		Type:             string(evmi_database.ContractLogSourceType),
+		EvmLogPipelineID: 3,
+		SyncBlock:        20,
+	})
+	store := &rollbackStore{}
+	p.store = log_stores.NewIndexerStore(store)
+	p.chain.ChainId = 1
+	p.recentBlocks = newBlockHashWindow()
+	for n := uint64(10); n <= 20; n++ {
+		p.recentBlocks.record(n, ethTypes.EmptyRootHash)
+	}
+	if err := p.db.Conn.AutoMigrate(&evmi_database.EvmiExporter{}); err != nil {
+		t.Fatalf("migrate: %v", err)
+	}
+	exporters := []evmi_database.EvmiExporter{
+		{EvmLogPipelineID: 3, SyncBlock: 18, SyncLogIndex: 4},
+		{EvmLogPipelineID: 3, SyncBlock: 11, SyncLogIndex: -1},
+		{EvmLogPipelineID: 4, SyncBlock: 18, SyncLogIndex: -1},
+	}
+	if err := p.db.Conn.Create(&exporters).Error; err != nil {
+		t.Fatalf("create exporters: %v", err)
+	}
+
+	var got []types.SourceRollback
+	p.bus.RegisterHandler("rollback-test", bus.Handler{
+		Matcher: internal_bus.SourceRollbackTopic,
+		Handle: func(_ context.Context, e bus.Event) {
+			got = append(got, e.Data.(types.SourceRollback))
+		},
+	})
+
+	if err := p.rollback(12); err != nil {
+		t.Fatalf("rollback: %v", err)
+	}
+
+	var stored evmi_database.EvmLogSource
+	if err := p.db.Conn.First(&stored, p.source.ID).Error; err != nil {
+		t.Fatalf("reload source: %v", err)
+	}
+	if stored.SyncBlock != 12 {
+		t.Errorf("sync_block = %d, want 12", stored.SyncBlock)
+	}
+	if store.sourceId != uint64(p.source.ID) || store.fromBlock != 13 {
+		t.Errorf("RollbackSourceData(%d, %d), want (%d, 13)", store.sourceId, store.fromBlock, p.source.ID)
+	}
+	if _, ok := p.recentBlocks.hash(13); ok {
+		t.Error("block 13 still recorded after rollback")
+	}
+	if _, ok := p.recentBlocks.hash(12); !ok {
+		t.Error("ancestor 12 dropped by rollback")
+	}
+	for i, want := range []uint64{12, 11, 18} {
+		var exporter evmi_database.EvmiExporter
+		if err := p.db.Conn.First(&exporter, exporters[i].ID).Error; err != nil {
+			t.Fatalf("reload exporter: %v", err)
+		}
+		if exporter.SyncBlock != want {
+			t.Errorf("exporter %d sync_block = %d, want %d", i, exporter.SyncBlock, want)
+		}
+	}
+	want := types.SourceRollback{SourceId: p.source.ID, PipelineId: 3, ChainId: 1, FromBlock: 13}
+	if len(got) != 1 || got[0] != want {
+		t.Errorf("rollback events = %+v, want [%+v]", got, want)
+	}
+}
+
+func TestFailedRollbackKeepsCursor(t *testing.T) {
+	p := newSourceIndexerForTest(t, evmi_database.EvmLogSource{
+		Type:      string(evmi_database.ContractLogSourceType),
+		SyncBlock: 20,
+	})
+	p.store = log_stores.NewIndexerStore(&rollbackStore{err: errors.New("store down")})
+	p.recentBlocks = newBlockHashWindow()
+	for n := uint64(10); n <= 20; n++ {
+		p.recentBlocks.record(n, ethTypes.EmptyRootHash)
+	}
+
+	if err := p.rollback(12); err == nil {
+		t.Fatal("rollback succeeded on a failing store")
+	}
+	var stored evmi_database.EvmLogSource
+	if err := p.db.Conn.First(&stored, p.source.ID).Error; err != nil {
+		t.Fatalf("reload source: %v", err)
+	}
+	if stored.SyncBlock != 20 || p.source.SyncBlock != 20 {
+		t.Errorf("sync_block = %d (%d in memory), want 20 until the data is deleted", stored.SyncBlock, p.source.SyncBlock)
+	}
+	if _, ok := p.recentBlocks.hash(13); !ok {
+		t.Error("block 13 forgotten: the next pass would not detect the reorg again")
+	}
+}
@@ -28,6 +28,14 @@ type IndexerService struct {
 	mu                  sync.Mutex
 	sourceIdToServiceId map[uint]suture.ServiceToken
 	sourceIndexers      map[uint]*SourceIndexerService
+	// redecoding holds the sources whose re-decode job runs on this instance.
+	redecoding map[uint]bool
+
+	// headTrackers are the chains' head trackers and endpoint pools, shared by
+	// their sources.
+	headTrackers *headTrackers
+	// logsBatches coalesce the eth_getLogs ranges of address sources.
+	logsBatches *logsBatches
 
 	logger zerolog.Logger
 }
@@ -62,6 +70,19 @@ func (s *IndexerService) Start() error {
 				s.startSource(source)
 			}
 		}
+
+		// Re-decode jobs interrupted by a restart resume where they were.
+		var jobs []evmi_database.EvmLogSourceRedecode
+		result = s.db.Conn.
+			Joins("JOIN evm_log_sources ON evm_log_sources.id = evm_log_source_redecodes.evm_log_source_id").
+			Where("evm_log_sources.evm_log_pipeline_id = ? AND evm_log_source_redecodes.status = ?", pipeline.ID, evmi_database.RunningRedecodeStatus).
+			Find(&jobs)
+		if result.Error != nil {
+			return result.Error
+		}
+		for _, job := range jobs {
+			s.startRedecode(job.EvmLogSourceID)
+		}
 	}
 
 	enableSourceHandlerId := uuid.New()
@@ -82,20 +103,31 @@ func (s *IndexerService) Start() error {
 		Matcher: internal_bus.DisableSourceTopic,
 	})
 
+	redecodeSourceHandlerId := uuid.New()
+	s.bus.RegisterHandler(redecodeSourceHandlerId.String(), bus.Handler{
+		Handle: func(ctx context.Context, e bus.Event) {
+			sourceId := e.Data.(uint)
+			s.startRedecode(sourceId)
+		},
+		Matcher: internal_bus.RedecodeSourceTopic,
+	})
+
 	s.supervisor.ServeBackground(context.Background())
 	return nil
 }
 
 // startSource registers and supervises an indexer for source. Caller need not
 // hold the lock; this takes it and dedupes so a source is started at most once.
+// The indexer of a completed source has left the supervisor and is replaced, so
+// a source whose end block was raised indexes again.
 func (s *IndexerService) startSource(source evmi_database.EvmLogSource) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
-	if _, ok := s.sourceIndexers[source.ID]; ok {
+	if existing, ok := s.sourceIndexers[source.ID]; ok && !existing.completed.Load() {
 		return
 	}
 	s.logger.Info().Msg("starting source id " + fmt.Sprint(source.ID))
-	service := NewSourceIndexerService(s.db, s.bus, s.metrics, source)
+	service := NewSourceIndexerService(s.db, s.bus, s.metrics, s.headTrackers, s.logsBatches, source)
 	s.sourceIndexers[source.ID] = service
 	s.sourceIdToServiceId[source.ID] = s.supervisor.Add(service)
 }
@@ -142,6 +174,7 @@ func (s *IndexerService) DisableSource(sourceId uint) error {
 
 	s.mu.Lock()
 	token, ok := s.sourceIdToServiceId[sourceId]
+	service := s.sourceIndexers[sourceId]
 	if ok {
 		delete(s.sourceIdToServiceId, sourceId)
 		delete(s.sourceIndexers, sourceId)
@@ -151,6 +184,11 @@ func (s *IndexerService) DisableSource(sourceId uint) error {
 		return errors.New("service already stopped")
 	}
 
+	// A completed source already left the supervisor and keeps its status.
+	if service.completed.Load() {
+		return nil
+	}
+
 	s.logger.Info().Msg("disable source id " + fmt.Sprint(source.ID))
 	s.supervisor.RemoveAndWait(token, time.Minute)
 
@@ -182,6 +220,9 @@ func NewIndexerService(
 		supervisor:          supervisor,
 		sourceIndexers:      make(map[uint]*SourceIndexerService),
 		sourceIdToServiceId: make(map[uint]suture.ServiceToken),
+		redecoding:          make(map[uint]bool),
+		headTrackers:        newHeadTrackers(),
+		logsBatches:         newLogsBatches(),
 		logger:              logger,
 	}
 }
@@ -1,12 +1,16 @@
 package indexer
 
 import (
+	"context"
+	"errors"
 	"path/filepath"
 	"testing"
 
 	internal_bus "github.com/evmi-cloud/go-evm-indexer/internal/bus"
 	evmi_database "github.com/evmi-cloud/go-evm-indexer/internal/database/evmi-database"
+	"github.com/mustafaturan/bus/v3"
 	"github.com/rs/zerolog"
+	"github.com/thejerf/suture/v4"
 	"gorm.io/driver/sqlite"
 	"gorm.io/gorm"
 )
@@ -26,7 +30,7 @@ func newSourceIndexerForTest(t *testing.T, factory evmi_database.EvmLogSource) *
 	if err := db.Create(&factory).Error; err != nil {
 		t.Fatalf("create factory: %v", err)
 	}
-	s := NewSourceIndexerService(&evmi_database.EvmiDatabase{Conn: db}, internal_bus.InitializeBus(), nil, factory)
+	s := NewSourceIndexerService(&evmi_database.EvmiDatabase{Conn: db}, internal_bus.InitializeBus(), nil, nil, nil, factory)
 	s.source = factory
 	s.pipeline = evmi_database.EvmLogPipeline{}
 	s.pipeline.ID = factory.EvmLogPipelineID
@@ -91,7 +95,7 @@ func TestRegisterFactoryChildUniquePerFactory(t *testing.T) {
 	if err := sA.db.Conn.Create(&factoryB).Error; err != nil {
 		t.Fatalf("create factory B: %v", err)
 	}
-	sB := NewSourceIndexerService(sA.db, internal_bus.InitializeBus(), nil, factoryB)
+	sB := NewSourceIndexerService(sA.db, internal_bus.InitializeBus(), nil, nil, nil, factoryB)
 	sB.source = factoryB
 	sB.pipeline.ID = factoryB.EvmLogPipelineID
 	sB.logger = zerolog.Nop()
@@ -164,3 +168,101 @@ func TestRegisterFactoryChildCreatesNestedFactory(t *testing.T) {
 		t.Errorf("cloned rule wrong: %+v", cr)
 	}
 }
+
+func TestServeCompletesBoundedSourcePastItsEnd(t *testing.T) {
+	source := evmi_database.EvmLogSource{
+		Enabled:    true,
+		Status:     string(evmi_database.StoppedLogSourceStatus),
+		Type:       string(evmi_database.ContractLogSourceType),
+		StartBlock: 50,
+		SyncBlock:  120,
+		EndBlock:   120,
+	}
+	p := newSourceIndexerForTest(t, source)
+
+	var updates []evmi_database.EvmLogSource
+	p.bus.RegisterHandler("test-source-update", bus.Handler{
+		Matcher: internal_bus.SourceUpdateTopic,
+		Handle: func(_ context.Context, e bus.Event) {
+			updates = append(updates, e.Data.(evmi_database.EvmLogSource))
+		},
+	})
+
+	if err := p.Serve(context.Background()); !errors.Is(err, suture.ErrDoNotRestart) {
+		t.Fatalf("Serve = %v, want ErrDoNotRestart", err)
+	}
+	if !p.completed.Load() {
+		t.Error("service not marked completed")
+	}
+
+	var stored evmi_database.EvmLogSource
+	if err := p.db.Conn.First(&stored, p.source.ID).Error; err != nil {
+		t.Fatal(err)
+	}
+	if stored.Status != string(evmi_database.CompletedLogSourceStatus) {
+		t.Errorf("status = %q, want COMPLETED", stored.Status)
+	}
+	if len(updates) != 1 || updates[0].Status != string(evmi_database.CompletedLogSourceStatus) {
+		t.Errorf("source updates = %+v, want one COMPLETED", updates)
+	}
+}
+
+func TestReachedEnd(t *testing.T) {
+	for _, tc := range []struct {
+		start, sync, end uint64
+		want             bool
+	}{
+		{start: 10, sync: 500, end: 0, want: false},
+		{start: 10, sync: 99, end: 100, want: false},
+		{start: 10, sync: 100, end: 100, want: true},
+		{start: 100, sync: 0, end: 100, want: true},
+	} {
+		p := &SourceIndexerService{source: evmi_database.EvmLogSource{StartBlock: tc.start, SyncBlock: tc.sync, EndBlock: tc.end}}
+		if got := p.reachedEnd(); got != tc.want {
+			t.Errorf("reachedEnd(start=%d, sync=%d, end=%d) = %v, want %v", tc.start, tc.sync, tc.end, got, tc.want)
+		}
+	}
+}
+
+func TestStartSourceReplacesCompletedIndexer(t *testing.T) {
+	s := NewIndexerService("test", nil, nil, nil, zerolog.Nop())
+	source := evmi_database.EvmLogSource{EndBlock: 100}
+	source.ID = 4
+
+	s.startSource(source)
+	first := s.sourceIndexers[4]
+	s.startSource(source)
+	if s.sourceIndexers[4] != first {
+		t.Fatal("a running indexer was replaced")
+	}
+
+	// Raising the end block of a completed source and starting it again
+	// supervises a new indexer.
+	first.completed.Store(true)
+	source.EndBlock = 200
+	s.startSource(source)
+	if s.sourceIndexers[4] == first || s.sourceIndexers[4].source.EndBlock != 200 {
+		t.Fatal("the completed indexer was not replaced")
+	}
+}
+
+func TestRegisterFactoryChildInheritsEndBlock(t *testing.T) {
+	factory := evmi_database.EvmLogSource{Type: string(evmi_database.FactoryLogSourceType), EvmLogPipelineID: 3, EvmBlockchainID: 2, EndBlock: 900}
+	s := newSourceIndexerForTest(t, factory)
+	sourceID := s.source.ID
+	rule := evmi_database.EvmFactoryRule{EvmLogSourceID: &sourceID, ChildType: string(evmi_database.ContractLogSourceType)}
+	if err := s.db.Conn.Create(&rule).Error; err != nil {
+		t.Fatal(err)
+	}
+
+	if err := s.registerFactoryChild(rule, "0xChild", 500); err != nil {
+		t.Fatalf("register: %v", err)
+	}
+	var child evmi_database.EvmLogSource
+	if err := s.db.Conn.Where("parent_source_id = ?", sourceID).First(&child).Error; err != nil {
+		t.Fatal(err)
+	}
+	if child.EndBlock != 900 {
+		t.Errorf("child end block = %d, want the factory's 900", child.EndBlock)
+	}
+}
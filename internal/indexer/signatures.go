@@ -0,0 +1,125 @@
+package indexer
+
+import (
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	evmi_database "github.com/evmi-cloud/go-evm-indexer/internal/database/evmi-database"
+	"github.com/evmi-cloud/go-evm-indexer/internal/types"
+	"github.com/rs/zerolog"
+)
+
+// registeredEvent is one event of the signature registry.
+type registeredEvent struct {
+	event        abi.Event
+	indexedCount int
+	args         []types.EvmArgSchema
+}
+
+// signatureRegistry maps topic0s to the events FULL and TOPIC sources decode
+// the logs their ABIs do not declare with. Events sharing a topic0 differ by
+// their indexed arguments (ERC-20 and ERC-721 Transfer), so a log picks the
+// first one whose indexed count matches its topic count: the events of the
+// ABIs, by id, then the imported ones.
+type signatureRegistry struct {
+	events map[common.Hash][]registeredEvent
+	seen   map[string]bool
+}
+
+func newSignatureRegistry() *signatureRegistry {
+	return &signatureRegistry{
+		events: map[common.Hash][]registeredEvent{},
+		seen:   map[string]bool{},
+	}
+}
+
+// loadSignatureRegistry reads the registry from the events of every ABI and
+// the imported signatures. ABIs and signatures that do not parse are skipped
+// with a warning.
+func loadSignatureRegistry(db *evmi_database.EvmiDatabase, logger zerolog.Logger) (*signatureRegistry, error) {
+	registry := newSignatureRegistry()
+
+	var abis []evmi_database.EvmJsonAbi
+	if err := db.Conn.Order("id").Find(&abis).Error; err != nil {
+		return nil, err
+	}
+	for _, abiEntry := range abis {
+		events, err := types.ParseEventSignatures(abiEntry.Content)
+		if err != nil {
+			logger.Warn().Uint("abi", abiEntry.ID).Msg("abi skipped from the signature registry: " + err.Error())
+			continue
+		}
+		for _, event := range events {
+			registry.add(event)
+		}
+	}
+
+	var signatures []evmi_database.EvmEventSignature
+	if err := db.Conn.Order("id").Find(&signatures).Error; err != nil {
+		return nil, err
+	}
+	for _, signature := range signatures {
+		event, err := types.ParseEventSignature(signature.Signature)
+		if err != nil {
+			logger.Warn().Uint("signature", signature.ID).Msg("signature skipped from the registry: " + err.Error())
+			continue
+		}
+		registry.add(event)
+	}
+
+	return registry, nil
+}
+
+// add registers event, unless the same signature already is.
+func (r *signatureRegistry) add(event abi.Event) {
+	signature := types.FormatEventSignature(event)
+	if r.seen[signature] {
+		return
+	}
+	r.seen[signature] = true
+
+	indexedCount := 0
+	for _, input := range event.Inputs {
+		if input.Indexed {
+			indexedCount++
+		}
+	}
+	r.events[event.ID] = append(r.events[event.ID], registeredEvent{
+		event:        event,
+		indexedCount: indexedCount,
+		args:         types.EventSchema(event).Args,
+	})
+}
+
+// lookup returns the event of a log with topics.
+func (r *signatureRegistry) lookup(topics []common.Hash) (registeredEvent, bool) {
+	if r == nil || len(topics) == 0 {
+		return registeredEvent{}, false
+	}
+	for _, candidate := range r.events[topics[0]] {
+		if candidate.indexedCount == len(topics)-1 {
+			return candidate, true
+		}
+	}
+	return registeredEvent{}, false
+}
+
+// decodeEvent decodes the topics and data of a log of event into event's
+// argument names. As for the ABI decoders, a decode failure keeps whatever
+// decoded.
+func decodeEvent(event abi.Event, topics []common.Hash, data []byte, logger zerolog.Logger) map[string]any {
+	values := map[string]any{}
+
+	var indexed abi.Arguments
+	for _, input := range event.Inputs {
+		if input.Indexed {
+			indexed = append(indexed, input)
+		}
+	}
+	if err := abi.ParseTopicsIntoMap(values, indexed, topics[1:]); err != nil {
+		logger.Warn().Str("event", event.RawName).Msg("topics decode failed: " + err.Error())
+	}
+	if err := event.Inputs.UnpackIntoMap(values, data); err != nil {
+		logger.Warn().Str("event", event.RawName).Msg("data decode failed: " + err.Error())
+	}
+	return values
+}
@@ -0,0 +1,104 @@
+package indexer
+
+import (
+	"math/big"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	ethTypes "github.com/ethereum/go-ethereum/core/types"
+	evmi_database "github.com/evmi-cloud/go-evm-indexer/internal/database/evmi-database"
+	"github.com/rs/zerolog"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+// newRegistrySourceForTest returns a source of sourceType whose registry holds
+// the ERC-20 Transfer of an ABI and an imported ERC-721 Transfer.
+func newRegistrySourceForTest(t *testing.T, sourceType evmi_database.LogSourceType) *SourceIndexerService {
+	t.Helper()
+	db, err := gorm.Open(sqlite.Open(filepath.Join(t.TempDir(), "signatures.db")), &gorm.Config{})
+	if err != nil {
+		t.Fatalf("open sqlite: %v", err)
+	}
+	if err := db.AutoMigrate(&evmi_database.EvmJsonAbi{}, &evmi_database.EvmEventSignature{}); err != nil {
+		t.Fatalf("migrate: %v", err)
+	}
+	db.Create(&evmi_database.EvmJsonAbi{ContractName: "Token", Content: erc20TransferAbi})
+	db.Create(&evmi_database.EvmJsonAbi{ContractName: "Broken", Content: "not json"})
+	db.Create(&evmi_database.EvmEventSignature{
+		Topic0:       transferTopic0.Hex(),
+		IndexedCount: 3,
+		Signature:    "Transfer(address indexed from, address indexed to, uint256 indexed tokenId)",
+	})
+
+	registry, err := loadSignatureRegistry(&evmi_database.EvmiDatabase{Conn: db}, zerolog.Nop())
+	if err != nil {
+		t.Fatalf("load registry: %v", err)
+	}
+	return &SourceIndexerService{
+		source:     evmi_database.EvmLogSource{Type: string(sourceType)},
+		signatures: registry,
+		logger:     zerolog.Nop(),
+	}
+}
+
+var transferTopic0 = common.HexToHash("0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef")
+
+func addressTopic(address string) common.Hash {
+	return common.BytesToHash(common.LeftPadBytes(common.HexToAddress(address).Bytes(), 32))
+}
+
+func TestFullSourceDecodesWithTheRegistryByTopicCount(t *testing.T) {
+	s := newRegistrySourceForTest(t, evmi_database.FullLogSourceType)
+	from, to := addressTopic("0xaa"), addressTopic("0xbb")
+
+	erc20 := s.GetLogMetadata(ethTypes.Log{
+		Topics: []common.Hash{transferTopic0, from, to},
+		Data:   common.LeftPadBytes(big.NewInt(1000).Bytes(), 32),
+	})
+	if erc20.ContractName != "Unknown" || erc20.EventName != "Transfer" || erc20.Data["value"] != "1000" {
+		t.Errorf("erc20 transfer = %+v", erc20)
+	}
+	if len(erc20.Args) != 3 || erc20.Args[2].Name != "value" || erc20.Args[2].Indexed {
+		t.Errorf("erc20 args = %+v", erc20.Args)
+	}
+
+	erc721 := s.GetLogMetadata(ethTypes.Log{
+		Topics: []common.Hash{transferTopic0, from, to, common.BigToHash(big.NewInt(7))},
+	})
+	if erc721.EventName != "Transfer" || erc721.Data["tokenId"] != "7" {
+		t.Errorf("erc721 transfer = %+v", erc721)
+	}
+
+	// No registered Transfer has a single indexed argument.
+	unknown := s.GetLogMetadata(ethTypes.Log{Topics: []common.Hash{transferTopic0, from}})
+	if unknown.EventName != "Unknown" || len(unknown.Data) != 0 {
+		t.Errorf("unmatched topic count = %+v", unknown)
+	}
+}
+
+func TestTopicSourceFallsBackToTheRegistry(t *testing.T) {
+	s := newRegistrySourceForTest(t, evmi_database.TopicLogSourceType)
+	parsed, err := abi.JSON(strings.NewReader(approvalAbi))
+	if err != nil {
+		t.Fatalf("parse abi: %v", err)
+	}
+	s.setAbi("Approvals", parsed)
+
+	meta := s.GetLogMetadata(ethTypes.Log{
+		Topics: []common.Hash{transferTopic0, addressTopic("0xaa"), addressTopic("0xbb"), common.BigToHash(big.NewInt(7))},
+	})
+	if meta.ContractName != "Unknown" || meta.EventName != "Transfer" || meta.Data["tokenId"] != "7" {
+		t.Errorf("registry fallback = %+v", meta)
+	}
+
+	// A CONTRACT source does not use the registry.
+	s.source.Type = string(evmi_database.ContractLogSourceType)
+	meta = s.GetLogMetadata(ethTypes.Log{Topics: []common.Hash{transferTopic0, addressTopic("0xaa"), addressTopic("0xbb")}})
+	if meta.ContractName != "Approvals" || meta.EventName != "" {
+		t.Errorf("contract source = %+v", meta)
+	}
+}
@@ -0,0 +1,406 @@
+package indexer
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/evmi-cloud/go-evm-indexer/internal/types"
+)
+
+// sqdRequestTimeout bounds one call to the SQD gateway or one of its workers.
+const sqdRequestTimeout = 60 * time.Second
+
+// sqdClient queries an SQD (Subsquid) EVM archive through its gateway: /height
+// is the highest archived block, /{block}/worker names the worker serving a
+// block, and a POST to that worker runs a query from that block on. Workers
+// answer with as many blocks as they hold, so a range is walked worker by
+// worker until it is covered.
+type sqdClient struct {
+	url  string
+	http *http.Client
+}
+
+func newSqdClient(url string) *sqdClient {
+	return &sqdClient{
+		url:  strings.TrimSuffix(url, "/"),
+		http: &http.Client{Timeout: sqdRequestTimeout},
+	}
+}
+
+// sqdQuery is the body of a worker query. Only the listed fields are returned;
+// block numbers always are.
+type sqdQuery struct {
+	FromBlock uint64          `json:"fromBlock"`
+	ToBlock   uint64          `json:"toBlock"`
+	Fields    sqdFields       `json:"fields"`
+	Logs      []sqdLogRequest `json:"logs"`
+}
+
+type sqdFields struct {
+	Block       map[string]bool `json:"block"`
+	Log         map[string]bool `json:"log"`
+	Transaction map[string]bool `json:"transaction"`
+}
+
+// sqdLogRequest selects logs by address and positional topics (lowercase hex,
+// a missing list matching anything) and asks for their parent transactions.
+type sqdLogRequest struct {
+	Address     []string `json:"address,omitempty"`
+	Topic0      []string `json:"topic0,omitempty"`
+	Topic1      []string `json:"topic1,omitempty"`
+	Topic2      []string `json:"topic2,omitempty"`
+	Topic3      []string `json:"topic3,omitempty"`
+	Transaction bool     `json:"transaction"`
+}
+
+type sqdBlock struct {
+	Header struct {
+		Number    uint64 `json:"number"`
+		Hash      string `json:"hash"`
+		Timestamp uint64 `json:"timestamp"`
+	} `json:"header"`
+	Logs         []sqdLog         `json:"logs"`
+	Transactions []sqdTransaction `json:"transactions"`
+}
+
+type sqdLog struct {
+	LogIndex         uint64   `json:"logIndex"`
+	TransactionIndex uint64   `json:"transactionIndex"`
+	TransactionHash  string   `json:"transactionHash"`
+	Address          string   `json:"address"`
+	Data             string   `json:"data"`
+	Topics           []string `json:"topics"`
+}
+
+type sqdTransaction struct {
+	TransactionIndex    uint64   `json:"transactionIndex"`
+	Hash                string   `json:"hash"`
+	From                string   `json:"from"`
+	To                  *string  `json:"to"`
+	Input               string   `json:"input"`
+	Value               string   `json:"value"`
+	Nonce               uint64   `json:"nonce"`
+	Type                uint64   `json:"type"`
+	Status              uint64   `json:"status"`
+	GasUsed             string   `json:"gasUsed"`
+	EffectiveGasPrice   string   `json:"effectiveGasPrice"`
+	ContractAddress     *string  `json:"contractAddress"`
+	BlobVersionedHashes []string `json:"blobVersionedHashes"`
+}
+
+// sqdQueryFields are the fields needed to build EvmLog/EvmTransaction rows.
+var sqdQueryFields = sqdFields{
+	Block: map[string]bool{"hash": true, "timestamp": true},
+	Log: map[string]bool{
+		"logIndex": true, "transactionIndex": true, "transactionHash": true,
+		"address": true, "data": true, "topics": true,
+	},
+	Transaction: map[string]bool{
+		"transactionIndex": true, "hash": true, "from": true, "to": true,
+		"input": true, "value": true, "nonce": true, "type": true, "status": true,
+		"gasUsed": true, "effectiveGasPrice": true, "contractAddress": true,
+		"blobVersionedHashes": true,
+	},
+}
+
+// height returns the highest block the archive holds.
+func (c *sqdClient) height(ctx context.Context) (uint64, error) {
+	body, err := c.get(ctx, c.url+"/height")
+	if err != nil {
+		return 0, err
+	}
+	return strconv.ParseUint(strings.TrimSpace(string(body)), 10, 64)
+}
+
+// worker returns the URL of the worker serving block from.
+func (c *sqdClient) worker(ctx context.Context, from uint64) (string, error) {
+	body, err := c.get(ctx, fmt.Sprintf("%s/%d/worker", c.url, from))
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(string(body)), nil
+}
+
+// query runs q on a worker and returns the blocks it answered with, in order.
+func (c *sqdClient) query(ctx context.Context, worker string, q sqdQuery) ([]sqdBlock, error) {
+	payload, err := json.Marshal(q)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, worker, bytes.NewReader(payload))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	body, err := c.do(req)
+	if err != nil {
+		return nil, err
+	}
+	var blocks []sqdBlock
+	if err := json.Unmarshal(body, &blocks); err != nil {
+		return nil, fmt.Errorf("sqd: decode worker response: %w", err)
+	}
+	return blocks, nil
+}
+
+func (c *sqdClient) get(ctx context.Context, url string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	return c.do(req)
+}
+
+func (c *sqdClient) do(req *http.Request) ([]byte, error) {
+	res, err := c.http.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("sqd: %s %s: %s: %s", req.Method, req.URL, res.Status, strings.TrimSpace(string(body)))
+	}
+	return body, nil
+}
+
+// toSqdLogRequest translates a source's eth_getLogs filter into the archive's
+// log selection. nil topic positions are wildcards and are left out.
+func toSqdLogRequest(q ethereum.FilterQuery) sqdLogRequest {
+	r := sqdLogRequest{Transaction: true}
+	for _, address := range q.Addresses {
+		r.Address = append(r.Address, strings.ToLower(address.Hex()))
+	}
+	positions := []*[]string{&r.Topic0, &r.Topic1, &r.Topic2, &r.Topic3}
+	for i, hashes := range q.Topics {
+		if i >= len(positions) {
+			break
+		}
+		for _, h := range hashes {
+			*positions[i] = append(*positions[i], strings.ToLower(h.Hex()))
+		}
+	}
+	return r
+}
+
+// sqdTimedCall runs one gateway/worker call, recording it under the chain's RPC
+// metrics with the gateway as endpoint and an sqd_ method name.
+func (p *SourceIndexerService) sqdTimedCall(method string, fn func() error) error {
+	start := time.Now()
+	err := fn()
+	p.metrics.RecordRPC(p.chain.ChainId, endpointLabel(p.chain.SqdGatewayUrl), "sqd_"+method, time.Since(start), err)
+	return err
+}
+
+// refreshSqdHeight reads the archive height while the source may still need it.
+// Once the cursor has reached the archive the source stays on JSON-RPC: the
+// archive trails the chain head, so it never gets ahead of the cursor again.
+// A read cut short by ctx leaves the height as it was.
+func (p *SourceIndexerService) refreshSqdHeight(ctx context.Context, cursor uint64) {
+	if p.sqd == nil || p.sqdDone {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, sqdRequestTimeout)
+	defer cancel()
+
+	var height uint64
+	if err := p.sqdTimedCall("height", func() (err error) {
+		height, err = p.sqd.height(ctx)
+		return err
+	}); err != nil {
+		if errors.Is(ctx.Err(), context.Canceled) {
+			return
+		}
+		p.logger.Warn().Msg("sqd gateway unavailable, indexing over JSON-RPC: " + err.Error())
+		p.sqdHeight = 0
+		return
+	}
+
+	p.sqdHeight = height
+	if cursor >= height {
+		p.logger.Info().Msg(fmt.Sprintf("cursor reached sqd archive height %d, switching to JSON-RPC", height))
+		p.sqdDone = true
+	}
+}
+
+// sqdCovers reports whether a range ending at to is served by the archive.
+func (p *SourceIndexerService) sqdCovers(to uint64) bool {
+	return p.sqd != nil && to <= p.sqdHeight
+}
+
+// fetchFromSqd fetches the logs of [from, to] matching query, with their
+// transactions and block timestamps, from the archive, building the same rows
+// as the JSON-RPC path.
+func (p *SourceIndexerService) fetchFromSqd(ctx context.Context, query ethereum.FilterQuery, from, to uint64) ([]types.EvmLog, []types.EvmTransaction, error) {
+	ctx, cancel := context.WithTimeout(ctx, sqdRequestTimeout*4)
+	defer cancel()
+
+	dbLogs := []types.EvmLog{}
+	dbTxs := []types.EvmTransaction{}
+	seenTx := make(map[string]bool)
+	logRequest := toSqdLogRequest(query)
+
+	for next := from; next <= to; {
+		var worker string
+		if err := p.sqdTimedCall("worker", func() (err error) {
+			worker, err = p.sqd.worker(ctx, next)
+			return err
+		}); err != nil {
+			return nil, nil, err
+		}
+
+		var blocks []sqdBlock
+		if err := p.sqdTimedCall("query", func() (err error) {
+			blocks, err = p.sqd.query(ctx, worker, sqdQuery{
+				FromBlock: next,
+				ToBlock:   to,
+				Fields:    sqdQueryFields,
+				Logs:      []sqdLogRequest{logRequest},
+			})
+			return err
+		}); err != nil {
+			return nil, nil, err
+		}
+		if len(blocks) == 0 {
+			return nil, nil, fmt.Errorf("sqd: worker returned no blocks from %d", next)
+		}
+
+		for _, block := range blocks {
+			txs := make(map[string]sqdTransaction, len(block.Transactions))
+			for _, tx := range block.Transactions {
+				txs[strings.ToLower(tx.Hash)] = tx
+			}
+
+			for _, l := range block.Logs {
+				tx, ok := txs[strings.ToLower(l.TransactionHash)]
+				if !ok {
+					return nil, nil, errors.New("sqd: transaction not found for log in " + l.TransactionHash)
+				}
+
+				log := ethTypes.Log{
+					Address:     common.HexToAddress(l.Address),
+					Data:        common.FromHex(l.Data),
+					BlockNumber: block.Header.Number,
+					TxHash:      common.HexToHash(l.TransactionHash),
+					TxIndex:     uint(l.TransactionIndex),
+					BlockHash:   common.HexToHash(block.Header.Hash),
+					Index:       uint(l.LogIndex),
+				}
+				for _, topic := range l.Topics {
+					log.Topics = append(log.Topics, common.HexToHash(topic))
+				}
+
+				from := common.HexToAddress(tx.From).Hex()
+				dbLogs = append(dbLogs, p.toEvmLog(log, p.chain.ChainId, block.Header.Timestamp, from))
+
+				if seenTx[log.TxHash.Hex()] {
+					continue
+				}
+				seenTx[log.TxHash.Hex()] = true
+
+				evmTx, err := p.sqdTransaction(tx, block.Header.Number, block.Header.Timestamp, log.Index)
+				if err != nil {
+					return nil, nil, err
+				}
+				dbTxs = append(dbTxs, evmTx)
+			}
+		}
+
+		next = blocks[len(blocks)-1].Header.Number + 1
+	}
+
+	return dbLogs, dbTxs, nil
+}
+
+// sqdTransaction converts an archive transaction into the row the JSON-RPC path
+// stores for it, decoded as of the log at logIndex it was loaded for. The
+// archive has no blob gas usage or price: they are left at zero.
+func (p *SourceIndexerService) sqdTransaction(tx sqdTransaction, blockNumber, timestamp uint64, logIndex uint) (types.EvmTransaction, error) {
+	value, err := parseQuantity(tx.Value)
+	if err != nil {
+		return types.EvmTransaction{}, fmt.Errorf("sqd: transaction %s value: %w", tx.Hash, err)
+	}
+	gasUsed, err := parseQuantity(tx.GasUsed)
+	if err != nil {
+		return types.EvmTransaction{}, fmt.Errorf("sqd: transaction %s gas used: %w", tx.Hash, err)
+	}
+	gasPrice, err := parseQuantity(tx.EffectiveGasPrice)
+	if err != nil {
+		return types.EvmTransaction{}, fmt.Errorf("sqd: transaction %s effective gas price: %w", tx.Hash, err)
+	}
+
+	to := "0x0000000000000000000000000000000000000000"
+	if tx.To != nil {
+		to = common.HexToAddress(*tx.To).Hex()
+	}
+	hash := common.HexToHash(tx.Hash).Hex()
+
+	var contractAddress string
+	if tx.ContractAddress != nil {
+		contractAddress = common.HexToAddress(*tx.ContractAddress).Hex()
+	}
+	var blobHashes []string
+	for _, blobHash := range tx.BlobVersionedHashes {
+		blobHashes = append(blobHashes, common.HexToHash(blobHash).Hex())
+	}
+
+	input := common.FromHex(tx.Input)
+
+	return types.EvmTransaction{
+		Id:               fmt.Sprintf("%d:%s", p.chain.ChainId, hash),
+		SourceId:         p.source.ID,
+		BlockNumber:      blockNumber,
+		BlockTimestamp:   timestamp,
+		ChainId:          p.chain.ChainId,
+		From:             common.HexToAddress(tx.From).Hex(),
+		Data:             common.Bytes2Hex(input),
+		Value:            value.String(),
+		TransactionIndex: tx.TransactionIndex,
+		Nonce:            tx.Nonce,
+		To:               to,
+		Hash:             hash,
+		Type:             tx.Type,
+
+		Status:            tx.Status,
+		GasUsed:           gasUsed.Uint64(),
+		EffectiveGasPrice: gasPrice.String(),
+		ContractAddress:   contractAddress,
+
+		BlobGasPrice:        "0",
+		BlobVersionedHashes: blobHashes,
+
+		Metadata: p.GetTransactionMetadata(input, blockNumber, logIndex),
+	}, nil
+}
+
+// parseQuantity parses a 0x-prefixed hex or a decimal integer.
+func parseQuantity(s string) (*big.Int, error) {
+	if s == "" {
+		return new(big.Int), nil
+	}
+	if strings.HasPrefix(s, "0x") {
+		return hexutil.DecodeBig(s)
+	}
+	v, ok := new(big.Int).SetString(s, 10)
+	if !ok {
+		return nil, fmt.Errorf("invalid quantity %q", s)
+	}
+	return v, nil
+}
@@ -0,0 +1,214 @@
+package indexer
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+const (
+	sqdToken = "0x00000000000000000000000000000000000000aa"
+	sqdAlice = "0x00000000000000000000000000000000000000a1"
+	sqdBob   = "0x00000000000000000000000000000000000000b0"
+)
+
+// fakeSqdArchive is a local stand-in for an SQD gateway and its worker. Each
+// worker answer holds at most perQuery blocks, so a range takes several queries.
+type fakeSqdArchive struct {
+	height   uint64
+	blocks   []sqdBlock
+	perQuery int
+
+	mu      sync.Mutex
+	queries []sqdQuery
+}
+
+func (a *fakeSqdArchive) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	switch {
+	case r.URL.Path == "/height":
+		fmt.Fprint(w, a.height)
+	case strings.HasSuffix(r.URL.Path, "/worker") && r.Method == http.MethodGet:
+		fmt.Fprint(w, "http://"+r.Host+"/query")
+	case r.URL.Path == "/query" && r.Method == http.MethodPost:
+		var q sqdQuery
+		if err := json.NewDecoder(r.Body).Decode(&q); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		a.mu.Lock()
+		a.queries = append(a.queries, q)
+		a.mu.Unlock()
+
+		out := []sqdBlock{}
+		for _, b := range a.blocks {
+			if b.Header.Number >= q.FromBlock && b.Header.Number <= q.ToBlock && len(out) < a.perQuery {
+				out = append(out, b)
+			}
+		}
+		_ = json.NewEncoder(w).Encode(out)
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func sqdTransferBlock(number uint64, txHash string, txIndex uint64) sqdBlock {
+	var b sqdBlock
+	b.Header.Number = number
+	b.Header.Hash = common.BigToHash(new(big.Int).SetUint64(number)).Hex()
+	b.Header.Timestamp = 1_700_000_000 + number
+	if txHash == "" {
+		return b
+	}
+	b.Logs = []sqdLog{{
+		LogIndex:         3,
+		TransactionIndex: txIndex,
+		TransactionHash:  txHash,
+		Address:          sqdToken,
+		Data:             "0x" + strings.Repeat("0", 63) + "5",
+		Topics: []string{
+			"0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef",
+			common.BytesToHash(common.HexToAddress(sqdAlice).Bytes()).Hex(),
+			common.BytesToHash(common.HexToAddress(sqdBob).Bytes()).Hex(),
+		},
+	}}
+	b.Transactions = []sqdTransaction{{
+		TransactionIndex:  txIndex,
+		Hash:              txHash,
+		From:              sqdAlice,
+		To:                func() *string { s := sqdToken; return &s }(),
+		Input:             "0xa9059cbb",
+		Value:             "0x0",
+		Nonce:             7,
+		Type:              2,
+		Status:            1,
+		GasUsed:           "0xb411",
+		EffectiveGasPrice: "0x3b9aca00",
+	}}
+	return b
+}
+
+func TestFetchFromSqdBuildsRows(t *testing.T) {
+	archive := &fakeSqdArchive{
+		height:   120,
+		perQuery: 2,
+		blocks: []sqdBlock{
+			sqdTransferBlock(100, "0x"+strings.Repeat("1", 64), 0),
+			sqdTransferBlock(101, "", 0),
+			sqdTransferBlock(103, "0x"+strings.Repeat("2", 64), 4),
+			sqdTransferBlock(110, "", 0), // last block of the range, reported without data
+		},
+	}
+	server := httptest.NewServer(archive)
+	defer server.Close()
+
+	p := newDecoderForTest(t, erc20TransferAbi)
+	p.chain.ChainId = 1
+	p.source.ID = 9
+	p.sqd = newSqdClient(server.URL + "/")
+
+	query := ethereum.FilterQuery{Addresses: []common.Address{common.HexToAddress(sqdToken)}}
+	logs, txs, err := p.fetchFromSqd(context.Background(), query, 100, 110)
+	if err != nil {
+		t.Fatalf("fetchFromSqd: %v", err)
+	}
+
+	// The range was walked worker answer by worker answer.
+	if len(archive.queries) != 2 || archive.queries[1].FromBlock != 102 {
+		t.Fatalf("queries = %+v, want 2 starting at 100 and 102", archive.queries)
+	}
+	if got := archive.queries[0].Logs[0].Address; len(got) != 1 || got[0] != sqdToken || !archive.queries[0].Logs[0].Transaction {
+		t.Errorf("log request = %+v, want lowercase token address with transactions", archive.queries[0].Logs[0])
+	}
+
+	if len(logs) != 2 || len(txs) != 2 {
+		t.Fatalf("got %d logs / %d txs, want 2 / 2", len(logs), len(txs))
+	}
+	l := logs[1]
+	if l.Id != "1:103:3" || l.SourceId != 9 || l.BlockNumber != 103 || l.BlockTimestamp != 1_700_000_103 ||
+		l.TransactionIndex != 4 || l.TransactionFrom != common.HexToAddress(sqdAlice).Hex() {
+		t.Errorf("log row = %+v", l)
+	}
+	if l.Metadata.EventName != "Transfer" || l.Metadata.Data["value"] != "5" {
+		t.Errorf("log not decoded: %+v", l.Metadata)
+	}
+	tx := txs[1]
+	if tx.Id != "1:0x"+strings.Repeat("2", 64) || tx.To != common.HexToAddress(sqdToken).Hex() ||
+		tx.Data != "a9059cbb" || tx.Value != "0" || tx.Nonce != 7 || tx.TransactionIndex != 4 {
+		t.Errorf("transaction row = %+v", tx)
+	}
+	if tx.Type != 2 || tx.Status != 1 || tx.GasUsed != 46097 || tx.EffectiveGasPrice != "1000000000" || tx.ContractAddress != "" {
+		t.Errorf("transaction receipt fields = %+v", tx)
+	}
+}
+
+func TestRefreshSqdHeightSwitchesToRpc(t *testing.T) {
+	archive := &fakeSqdArchive{height: 120, perQuery: 10, blocks: []sqdBlock{sqdTransferBlock(60, "", 0)}}
+	server := httptest.NewServer(archive)
+	defer server.Close()
+
+	p := newDecoderForTest(t, erc20TransferAbi)
+	p.sqd = newSqdClient(server.URL)
+
+	p.refreshSqdHeight(context.Background(), 50)
+	if p.sqdHeight != 120 || p.sqdDone {
+		t.Fatalf("height=%d done=%v, want 120/false", p.sqdHeight, p.sqdDone)
+	}
+
+	// A range the archive holds is served without touching JSON-RPC (nil client).
+	if _, _, err := p.fetchRange(context.Background(), nil, func(from, to *big.Int) ethereum.FilterQuery {
+		return ethereum.FilterQuery{FromBlock: from, ToBlock: to}
+	}, nil, big.NewInt(51), big.NewInt(60), nil); err != nil {
+		t.Fatalf("fetchRange from archive: %v", err)
+	}
+
+	p.refreshSqdHeight(context.Background(), 120)
+	if !p.sqdDone {
+		t.Fatal("source should switch to JSON-RPC once the cursor reaches the archive height")
+	}
+}
+
+func TestFetchFromSqdReturnsOnCancel(t *testing.T) {
+	// A gateway that never answers: only the source's context ends the call.
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-r.Context().Done()
+	}))
+	defer server.Close()
+
+	p := newDecoderForTest(t, erc20TransferAbi)
+	p.sqd = newSqdClient(server.URL)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(10*time.Millisecond, cancel)
+	start := time.Now()
+	if _, _, err := p.fetchFromSqd(ctx, ethereum.FilterQuery{}, 100, 110); err == nil {
+		t.Fatal("fetchFromSqd succeeded on a cancelled context")
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("cancelled fetch returned after %s", elapsed)
+	}
+}
+
+func TestToSqdLogRequestTopics(t *testing.T) {
+	topic0 := common.HexToHash("0xDDF252AD1BE2C89B69C2B068FC378DAA952BA7F163C4A11628F55A4DF523B3EF")
+	to := common.HexToHash("0x01")
+	r := toSqdLogRequest(ethereum.FilterQuery{Topics: [][]common.Hash{{topic0}, nil, {to}}})
+	if len(r.Topic0) != 1 || r.Topic0[0] != strings.ToLower(topic0.Hex()) {
+		t.Errorf("topic0 = %v", r.Topic0)
+	}
+	if r.Topic1 != nil {
+		t.Errorf("wildcard topic1 = %v, want omitted", r.Topic1)
+	}
+	if len(r.Topic2) != 1 || r.Topic2[0] != to.Hex() {
+		t.Errorf("topic2 = %v", r.Topic2)
+	}
+}
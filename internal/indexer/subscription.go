@@ -0,0 +1,199 @@
+package indexer
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+const (
+	// pushFallbackPoll is the longest a chain in push mode goes without a head
+	// read: it covers a socket that stalls without closing and, in logs mode,
+	// keeps the cursors moving over blocks without matching logs.
+	pushFallbackPoll = time.Minute
+	// pushRetryInterval is the delay between two subscription attempts while
+	// the chain polls.
+	pushRetryInterval = 30 * time.Second
+	// pushSubscribeTimeout bounds dialing the WebSocket and subscribing.
+	pushSubscribeTimeout = 10 * time.Second
+)
+
+// pushNotification is the part of a newHeads header or logs entry the indexer
+// needs: only the block number, for logging. Everything else is fetched over
+// the regular range path.
+type pushNotification struct {
+	Number      *hexutil.Big `json:"number"`
+	BlockNumber *hexutil.Big `json:"blockNumber"`
+}
+
+// pushSubscription wakes a head tracker from a WebSocket subscription to
+// newHeads, or a source from a subscription to its logs. Notifications only
+// trigger a head read: the range indexed is still read from the cursor to the
+// resolved head, so nothing is skipped when notifications are coalesced or the
+// socket reconnects.
+type pushSubscription struct {
+	client *rpc.Client
+	sub    *rpc.ClientSubscription
+	wake   chan struct{}
+	last   atomic.Uint64
+	// ownsClient is set when the subscription dialed its connection, closed
+	// with it; logs subscriptions share the connection of their chain's
+	// tracker (see headTracker.subscribeLogs).
+	ownsClient bool
+
+	// done is closed when the subscription ends, err then holding the reason
+	// (nil when the connection was closed cleanly).
+	done chan struct{}
+	err  error
+}
+
+// subscribePush dials url and subscribes to newHeads.
+func subscribePush(ctx context.Context, url string) (*pushSubscription, error) {
+	client, err := rpc.DialContext(ctx, url)
+	if err != nil {
+		return nil, err
+	}
+	s, err := subscribe(ctx, client, false, ethereum.FilterQuery{})
+	if err != nil {
+		client.Close()
+		return nil, err
+	}
+	s.ownsClient = true
+	return s, nil
+}
+
+// subscribe subscribes over client to newHeads or, when logs is set, to the
+// logs matching query (its block range is ignored).
+func subscribe(ctx context.Context, client *rpc.Client, logs bool, query ethereum.FilterQuery) (*pushSubscription, error) {
+	notifications := make(chan pushNotification, 16)
+	var sub *rpc.ClientSubscription
+	var err error
+	if logs {
+		sub, err = client.EthSubscribe(ctx, notifications, "logs", toLogsCriteria(query))
+	} else {
+		sub, err = client.EthSubscribe(ctx, notifications, "newHeads")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	s := &pushSubscription{client: client, sub: sub, wake: make(chan struct{}, 1), done: make(chan struct{})}
+	go s.forward(notifications)
+	return s, nil
+}
+
+// toLogsCriteria is the eth_subscribe logs filter for query. Block bounds are
+// left out: a subscription only ever reports new logs.
+func toLogsCriteria(query ethereum.FilterQuery) map[string]interface{} {
+	criteria := map[string]interface{}{}
+	if len(query.Addresses) > 0 {
+		criteria["address"] = query.Addresses
+	}
+	if len(query.Topics) > 0 {
+		criteria["topics"] = query.Topics
+	}
+	return criteria
+}
+
+// forward coalesces notifications into wake until the subscription ends.
+func (s *pushSubscription) forward(notifications <-chan pushNotification) {
+	defer close(s.done)
+	for {
+		select {
+		case n := <-notifications:
+			if n.Number != nil {
+				s.last.Store(n.Number.ToInt().Uint64())
+			} else if n.BlockNumber != nil {
+				s.last.Store(n.BlockNumber.ToInt().Uint64())
+			}
+			select {
+			case s.wake <- struct{}{}:
+			default:
+			}
+		case err := <-s.sub.Err():
+			s.err = err
+			return
+		}
+	}
+}
+
+func (s *pushSubscription) close() {
+	s.sub.Unsubscribe()
+	if s.ownsClient {
+		s.client.Close()
+	}
+}
+
+// reason describes why the subscription ended.
+func (s *pushSubscription) reason() string {
+	if s.err != nil {
+		return s.err.Error()
+	}
+	return "connection closed"
+}
+
+// waitForHead blocks until the chain's head tracker publishes a head this
+// source has not consumed yet and returns it. When the chain is in logs push
+// mode, the source also subscribes to its own logs, over the tracker's
+// connection, and asks the tracker for a head read on each notification; the
+// tracker otherwise only polls every pushFallbackPoll. A dropped logs
+// subscription is retried every pushRetryInterval, the tracker's fallback poll
+// keeping the cursor moving meanwhile.
+func (p *SourceIndexerService) waitForHead(ctx context.Context, tracker *headTracker, query ethereum.FilterQuery) (uint64, error) {
+	for {
+		head, seq, updated, err := tracker.latest()
+		if err != nil {
+			return 0, err
+		}
+		if seq > p.headSeq {
+			p.headSeq = seq
+			return head, nil
+		}
+
+		// Sources scanning blocks may have no log to be notified of.
+		chain, _, _ := tracker.current()
+		if p.push == nil && chain.WsUrl != "" && chain.WsSubscribeLogs && !p.scansBlocks() &&
+			time.Since(p.pushAttempt) >= pushRetryInterval {
+			p.pushAttempt = time.Now()
+			subCtx, cancel := context.WithTimeout(ctx, pushSubscribeTimeout)
+			push, err := tracker.subscribeLogs(subCtx, query)
+			cancel()
+			if err != nil {
+				p.logger.Warn().Msg("websocket logs subscription failed, polling: " + err.Error())
+			} else {
+				p.logger.Info().Msg("subscribed to logs over websocket")
+				p.push = push
+			}
+		}
+
+		var wake, done <-chan struct{}
+		if p.push != nil {
+			wake, done = p.push.wake, p.push.done
+		}
+
+		select {
+		case <-ctx.Done():
+			p.closePush()
+			return 0, ctx.Err()
+		case <-updated:
+		case <-wake:
+			p.logger.Debug().Msg(fmt.Sprintf("logs notification at block %d", p.push.last.Load()))
+			tracker.refresh()
+		case <-done:
+			p.logger.Warn().Msg("websocket logs subscription dropped, polling: " + p.push.reason())
+			p.closePush()
+		}
+	}
+}
+
+func (p *SourceIndexerService) closePush() {
+	if p.push != nil {
+		p.push.close()
+		p.push = nil
+	}
+}
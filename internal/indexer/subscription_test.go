@@ -0,0 +1,174 @@
+package indexer
+
+import (
+	"context"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/rpc"
+	evmi_database "github.com/evmi-cloud/go-evm-indexer/internal/database/evmi-database"
+	"github.com/rs/zerolog"
+)
+
+// fakeSubscriptions is an eth namespace serving newHeads and logs
+// subscriptions; each new subscription is handed to the test on subscribed.
+type fakeSubscriptions struct {
+	subscribed chan fakeSubscription
+}
+
+type fakeSubscription struct {
+	notifier *rpc.Notifier
+	sub      *rpc.Subscription
+	criteria map[string]interface{}
+}
+
+func (s *fakeSubscriptions) NewHeads(ctx context.Context) (*rpc.Subscription, error) {
+	return s.subscribe(ctx, nil)
+}
+
+func (s *fakeSubscriptions) Logs(ctx context.Context, criteria map[string]interface{}) (*rpc.Subscription, error) {
+	return s.subscribe(ctx, criteria)
+}
+
+func (s *fakeSubscriptions) subscribe(ctx context.Context, criteria map[string]interface{}) (*rpc.Subscription, error) {
+	notifier, _ := rpc.NotifierFromContext(ctx)
+	sub := notifier.CreateSubscription()
+	s.subscribed <- fakeSubscription{notifier: notifier, sub: sub, criteria: criteria}
+	return sub, nil
+}
+
+func newFakeWsNode(t *testing.T) (*rpc.Server, string, *fakeSubscriptions) {
+	t.Helper()
+	service := &fakeSubscriptions{subscribed: make(chan fakeSubscription, 4)}
+	server := rpc.NewServer()
+	if err := server.RegisterName("eth", service); err != nil {
+		t.Fatalf("register: %v", err)
+	}
+	httpServer := httptest.NewServer(server.WebsocketHandler([]string{"*"}))
+	t.Cleanup(httpServer.Close)
+	t.Cleanup(server.Stop)
+	return server, "ws" + strings.TrimPrefix(httpServer.URL, "http"), service
+}
+
+// newPushTracker starts a private tracker for a chain reading its safe head
+// from node over HTTP, with PullInterval long enough that every head read in
+// the test comes from a notification.
+func newPushTracker(t *testing.T, node *fakeChain, wsUrl string, logs bool) *headTracker {
+	t.Helper()
+	server := httptest.NewServer(node)
+	t.Cleanup(server.Close)
+	var trackers *headTrackers
+	tracker, pool, err := trackers.acquire(evmi_database.EvmBlockchain{
+		RpcUrl:          server.URL,
+		PullInterval:    3600,
+		HeadMode:        string(evmi_database.SafeChainHeadMode),
+		WsUrl:           wsUrl,
+		WsSubscribeLogs: logs,
+	}, nil, nil, zerolog.Nop())
+	if err != nil {
+		t.Fatalf("acquire: %v", err)
+	}
+	t.Cleanup(func() { trackers.release(tracker, pool) })
+	return tracker
+}
+
+func TestHeadTrackerWakesOnNewHead(t *testing.T) {
+	server, url, service := newFakeWsNode(t)
+	node := &fakeChain{tags: map[string]uint64{"safe": 10}}
+	node.set(buildChain(100, 101, ""))
+	tracker := newPushTracker(t, node, url, false)
+
+	p := newDecoderForTest(t, erc20TransferAbi)
+	if head := <-waitForHeadAsync(p, tracker, ethereum.FilterQuery{}); head != 10 {
+		t.Fatalf("head = %d, want 10", head)
+	}
+
+	s := <-service.subscribed
+	node.setTag("safe", 16)
+	if err := s.notifier.Notify(s.sub.ID, map[string]string{"number": "0x10"}); err != nil {
+		t.Fatalf("notify: %v", err)
+	}
+	if head := <-waitForHeadAsync(p, tracker, ethereum.FilterQuery{}); head != 16 {
+		t.Fatalf("head = %d, want 16 after the notification", head)
+	}
+
+	// The socket drops: the tracker reads the head right away and falls back
+	// to polling.
+	node.setTag("safe", 20)
+	server.Stop()
+	if head := <-waitForHeadAsync(p, tracker, ethereum.FilterQuery{}); head != 20 {
+		t.Fatalf("head = %d, want 20 after the drop", head)
+	}
+}
+
+func TestWaitForHeadSubscribesToSourceLogs(t *testing.T) {
+	_, url, service := newFakeWsNode(t)
+	node := &fakeChain{tags: map[string]uint64{"safe": 10}}
+	node.set(buildChain(100, 101, ""))
+	tracker := newPushTracker(t, node, url, true)
+
+	p := newDecoderForTest(t, erc20TransferAbi)
+	p.chain.WsUrl = url
+	p.chain.WsSubscribeLogs = true
+	defer p.closePush()
+
+	token := common.HexToAddress(sqdToken)
+	query := ethereum.FilterQuery{Addresses: []common.Address{token}}
+	if head := <-waitForHeadAsync(p, tracker, query); head != 10 {
+		t.Fatalf("head = %d, want 10", head)
+	}
+
+	done := waitForHeadAsync(p, tracker, query)
+	// The tracker does not subscribe to newHeads in logs mode: the first
+	// subscription is the source's.
+	s := <-service.subscribed
+	if addresses, _ := s.criteria["address"].([]interface{}); len(addresses) != 1 || !strings.EqualFold(addresses[0].(string), token.Hex()) {
+		t.Errorf("logs criteria = %v, want the source address", s.criteria)
+	}
+	if _, ok := s.criteria["fromBlock"]; ok {
+		t.Errorf("logs criteria = %v, want no block bounds", s.criteria)
+	}
+	node.setTag("safe", 32)
+	if err := s.notifier.Notify(s.sub.ID, map[string]string{"blockNumber": "0x20"}); err != nil {
+		t.Fatalf("notify: %v", err)
+	}
+	if head := <-done; head != 32 {
+		t.Fatalf("head = %d, want 32 after the logs notification", head)
+	}
+}
+
+func TestSourcesShareTheChainLogsConnection(t *testing.T) {
+	_, url, service := newFakeWsNode(t)
+	node := &fakeChain{tags: map[string]uint64{"safe": 10}}
+	node.set(buildChain(100, 101, ""))
+	tracker := newPushTracker(t, node, url, true)
+
+	sources := []*SourceIndexerService{newDecoderForTest(t, erc20TransferAbi), newDecoderForTest(t, erc20TransferAbi)}
+	query := ethereum.FilterQuery{Addresses: []common.Address{common.HexToAddress(sqdToken)}}
+	var waits []<-chan uint64
+	for _, p := range sources {
+		defer p.closePush()
+		if head := <-waitForHeadAsync(p, tracker, query); head != 10 {
+			t.Fatalf("head = %d, want 10", head)
+		}
+		waits = append(waits, waitForHeadAsync(p, tracker, query))
+	}
+
+	s := <-service.subscribed
+	<-service.subscribed
+	node.setTag("safe", 32)
+	if err := s.notifier.Notify(s.sub.ID, map[string]string{"blockNumber": "0x20"}); err != nil {
+		t.Fatalf("notify: %v", err)
+	}
+	for _, wait := range waits {
+		if head := <-wait; head != 32 {
+			t.Fatalf("head = %d, want 32 after the logs notification", head)
+		}
+	}
+	if sources[0].push == nil || sources[1].push == nil || sources[0].push.client != sources[1].push.client {
+		t.Error("sources should subscribe over the chain's one logs connection")
+	}
+}
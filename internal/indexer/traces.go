@@ -0,0 +1,432 @@
+package indexer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+	evmi_database "github.com/evmi-cloud/go-evm-indexer/internal/database/evmi-database"
+	"github.com/evmi-cloud/go-evm-indexer/internal/types"
+	"github.com/lmittmann/w3/module/eth"
+	"github.com/lmittmann/w3/w3types"
+)
+
+// parityTrace is one entry of a trace_filter result. Calls and creations
+// carry from/to (or init), self-destructs address/refundAddress/balance.
+type parityTrace struct {
+	Action struct {
+		CallType       string          `json:"callType"`
+		CreationMethod string          `json:"creationMethod"`
+		From           *common.Address `json:"from"`
+		To             *common.Address `json:"to"`
+		Value          *hexutil.Big    `json:"value"`
+		Gas            hexutil.Uint64  `json:"gas"`
+		Input          hexutil.Bytes   `json:"input"`
+		Init           hexutil.Bytes   `json:"init"`
+		Address        *common.Address `json:"address"`
+		RefundAddress  *common.Address `json:"refundAddress"`
+		Balance        *hexutil.Big    `json:"balance"`
+	} `json:"action"`
+	Result *struct {
+		Address *common.Address `json:"address"`
+		GasUsed hexutil.Uint64  `json:"gasUsed"`
+		Output  hexutil.Bytes   `json:"output"`
+		Code    hexutil.Bytes   `json:"code"`
+	} `json:"result"`
+	Error               string       `json:"error"`
+	TraceAddress        []uint64     `json:"traceAddress"`
+	TransactionHash     *common.Hash `json:"transactionHash"`
+	TransactionPosition uint64       `json:"transactionPosition"`
+	BlockNumber         uint64       `json:"blockNumber"`
+	Type                string       `json:"type"`
+}
+
+// traceFilterCall is a trace_filter call for the traces of [from, to] whose
+// field (fromAddress or toAddress) is one of addresses. Both filters set at
+// once would match the traces satisfying both.
+type traceFilterCall struct {
+	from, to  uint64
+	field     string
+	addresses []common.Address
+	ret       *[]parityTrace
+}
+
+func (c *traceFilterCall) CreateRequest() (rpc.BatchElem, error) {
+	return rpc.BatchElem{
+		Method: "trace_filter",
+		Args: []any{map[string]any{
+			"fromBlock": hexutil.Uint64(c.from),
+			"toBlock":   hexutil.Uint64(c.to),
+			c.field:     c.addresses,
+		}},
+		Result: c.ret,
+	}, nil
+}
+
+func (c *traceFilterCall) HandleResponse(elem rpc.BatchElem) error {
+	return elem.Error
+}
+
+var _ w3types.RPCCaller = (*traceFilterCall)(nil)
+
+// callFrame is a frame of the callTracer, with its nested calls.
+type callFrame struct {
+	Type    string          `json:"type"`
+	From    common.Address  `json:"from"`
+	To      *common.Address `json:"to"`
+	Value   *hexutil.Big    `json:"value"`
+	Gas     hexutil.Uint64  `json:"gas"`
+	GasUsed hexutil.Uint64  `json:"gasUsed"`
+	Input   hexutil.Bytes   `json:"input"`
+	Output  hexutil.Bytes   `json:"output"`
+	Error   string          `json:"error"`
+	Calls   []callFrame     `json:"calls"`
+}
+
+// txCallTrace is the callTracer result of one transaction of a block.
+type txCallTrace struct {
+	TxHash common.Hash `json:"txHash"`
+	Result *callFrame  `json:"result"`
+	Error  string      `json:"error"`
+}
+
+// blockCallTracesCall is a debug_traceBlockByNumber call with the callTracer.
+type blockCallTracesCall struct {
+	number uint64
+	ret    *[]txCallTrace
+}
+
+func (c *blockCallTracesCall) CreateRequest() (rpc.BatchElem, error) {
+	return rpc.BatchElem{
+		Method: "debug_traceBlockByNumber",
+		Args:   []any{hexutil.Uint64(c.number), map[string]any{"tracer": "callTracer"}},
+		Result: c.ret,
+	}, nil
+}
+
+func (c *blockCallTracesCall) HandleResponse(elem rpc.BatchElem) error {
+	return elem.Error
+}
+
+var _ w3types.RPCCaller = (*blockCallTracesCall)(nil)
+
+// trace is a call frame read from either trace API, before its block
+// timestamp is known. path is its position in the call tree.
+type trace struct {
+	types.EvmTrace
+	path []uint64
+}
+
+// fetchTraces returns the call frames of [from, to] from or to one of
+// addresses (for a creation, the created contract), ordered by block,
+// transaction and position in the call tree. Chains with TraceFilterAvailable
+// are read with trace_filter, the others by tracing every block with
+// debug_traceBlockByNumber; both are batched by RpcMaxBatchSize (see
+// traceBlocks for the scan).
+func (p *SourceIndexerService) fetchTraces(ctx context.Context, client *rpcPool, from, to uint64, addresses []common.Address) ([]types.EvmTrace, error) {
+	var traces []trace
+	var err error
+	if p.chain.TraceFilterAvailable {
+		traces, err = p.filterTraces(ctx, client, from, to, addresses)
+	} else {
+		traces, err = p.traceBlocks(ctx, client, from, to, addresses)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(traces, func(i, j int) bool {
+		a, b := traces[i], traces[j]
+		if a.BlockNumber != b.BlockNumber {
+			return a.BlockNumber < b.BlockNumber
+		}
+		if a.TransactionIndex != b.TransactionIndex {
+			return a.TransactionIndex < b.TransactionIndex
+		}
+		return comparePaths(a.path, b.path) < 0
+	})
+
+	var numbers []uint64
+	for i, t := range traces {
+		if i == 0 || t.BlockNumber != traces[i-1].BlockNumber {
+			numbers = append(numbers, t.BlockNumber)
+		}
+	}
+	timestamps, err := p.blockTimestamps(ctx, client, numbers)
+	if err != nil {
+		return nil, err
+	}
+
+	dbTraces := make([]types.EvmTrace, len(traces))
+	for i, t := range traces {
+		t.SourceId = p.source.ID
+		t.ChainId = p.chain.ChainId
+		t.BlockTimestamp = timestamps[t.BlockNumber]
+		t.TraceAddress = joinPath(t.path)
+		t.Id = fmt.Sprintf("%d:%d:%s:%s", p.chain.ChainId, p.source.ID, t.TransactionHash, t.TraceAddress)
+		dbTraces[i] = t.EvmTrace
+	}
+	return dbTraces, nil
+}
+
+// filterTraces reads the traces of [from, to] with trace_filter, once from
+// and once to addresses. Block rewards carry no transaction and are skipped.
+func (p *SourceIndexerService) filterTraces(ctx context.Context, client *rpcPool, from, to uint64, addresses []common.Address) ([]trace, error) {
+	var fromTraces, toTraces []parityTrace
+	err := client.call(ctx, "trace_filter",
+		&traceFilterCall{from: from, to: to, field: "fromAddress", addresses: addresses, ret: &fromTraces},
+		&traceFilterCall{from: from, to: to, field: "toAddress", addresses: addresses, ret: &toTraces},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	matches := newAddressFilter(addresses)
+	seen := map[string]bool{}
+	var traces []trace
+	for _, entry := range append(fromTraces, toTraces...) {
+		if entry.TransactionHash == nil {
+			continue
+		}
+		t, ok := fromParityTrace(entry)
+		if !ok || !matches.has(t.From, t.To) {
+			continue
+		}
+		key := t.TransactionHash + ":" + joinPath(t.path)
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		traces = append(traces, t)
+	}
+	return traces, nil
+}
+
+func fromParityTrace(entry parityTrace) (trace, bool) {
+	t := trace{path: entry.TraceAddress}
+	t.BlockNumber = entry.BlockNumber
+	t.TransactionHash = entry.TransactionHash.Hex()
+	t.TransactionIndex = entry.TransactionPosition
+	t.Error = entry.Error
+	t.Gas = uint64(entry.Action.Gas)
+
+	action := entry.Action
+	switch entry.Type {
+	case "call":
+		t.Type = strings.ToUpper(action.CallType)
+		t.From, t.To = addressHex(action.From), addressHex(action.To)
+		t.Input = hexutil.Encode(action.Input)
+		t.Value = bigString(action.Value)
+		if entry.Result != nil {
+			t.Output = hexutil.Encode(entry.Result.Output)
+		}
+	case "create":
+		t.Type = "CREATE"
+		if action.CreationMethod != "" {
+			t.Type = strings.ToUpper(action.CreationMethod)
+		}
+		t.From = addressHex(action.From)
+		t.Input = hexutil.Encode(action.Init)
+		t.Value = bigString(action.Value)
+		if entry.Result != nil {
+			t.To = addressHex(entry.Result.Address)
+			t.Output = hexutil.Encode(entry.Result.Code)
+		}
+	case "suicide":
+		t.Type = "SELFDESTRUCT"
+		t.From, t.To = addressHex(action.Address), addressHex(action.RefundAddress)
+		t.Value = bigString(action.Balance)
+	default:
+		return trace{}, false
+	}
+	if entry.Result != nil {
+		t.GasUsed = uint64(entry.Result.GasUsed)
+	}
+	return t, true
+}
+
+// traceBlocks reads the call frames of [from, to] by tracing each block with
+// the callTracer, scanBatchSize blocks at a time, and keeps the frames from or
+// to addresses before the next blocks are traced. A transaction the node
+// fails to trace fails the range, so its traces are retried rather than lost.
+func (p *SourceIndexerService) traceBlocks(ctx context.Context, client *rpcPool, from, to uint64, addresses []common.Address) ([]trace, error) {
+	matches := newAddressFilter(addresses)
+	var traces []trace
+	size := p.scanBatchSize()
+	for start := from; start <= to; start += size {
+		end := min(start+size-1, to)
+		blocks := make([][]txCallTrace, end-start+1)
+		request := make([]w3types.RPCCaller, len(blocks))
+		for i := range blocks {
+			request[i] = &blockCallTracesCall{number: start + uint64(i), ret: &blocks[i]}
+		}
+		if err := p.callInBatches(ctx, client, "debug_traceBlockByNumber", request); err != nil {
+			return nil, err
+		}
+
+		for i, block := range blocks {
+			number := start + uint64(i)
+			for index, tx := range block {
+				if tx.Result == nil {
+					return nil, &rangeError{from: number, to: number,
+						err: fmt.Errorf("transaction %d (%s) not traced: %s", index, tx.TxHash.Hex(), tx.Error)}
+				}
+				if tx.TxHash == (common.Hash{}) {
+					return nil, errors.New("debug_traceBlockByNumber reported no transaction hash: the node is too old")
+				}
+				walkFrames(*tx.Result, nil, func(frame callFrame, path []uint64) {
+					target := addressHex(frame.To)
+					if !matches.has(frame.From.Hex(), target) {
+						return
+					}
+					t := trace{path: path}
+					t.BlockNumber = number
+					t.TransactionHash = tx.TxHash.Hex()
+					t.TransactionIndex = uint64(index)
+					t.Type = frame.Type
+					t.From, t.To = frame.From.Hex(), target
+					t.Value = bigString(frame.Value)
+					t.Input, t.Output = hexutil.Encode(frame.Input), hexutil.Encode(frame.Output)
+					t.Gas, t.GasUsed = uint64(frame.Gas), uint64(frame.GasUsed)
+					t.Error = frame.Error
+					traces = append(traces, t)
+				})
+			}
+		}
+	}
+	return traces, nil
+}
+
+// walkFrames visits frame and its nested calls depth-first, with their
+// positions in the call tree.
+func walkFrames(frame callFrame, path []uint64, visit func(callFrame, []uint64)) {
+	visit(frame, path)
+	for i, call := range frame.Calls {
+		walkFrames(call, append(append([]uint64{}, path...), uint64(i)), visit)
+	}
+}
+
+// blockTimestamps returns the timestamps of blocks numbers, read from their
+// headers in batches of RpcMaxBatchSize.
+func (p *SourceIndexerService) blockTimestamps(ctx context.Context, client *rpcPool, numbers []uint64) (map[uint64]uint64, error) {
+	headers := make([]*ethTypes.Header, len(numbers))
+	request := make([]w3types.RPCCaller, len(numbers))
+	for i, number := range numbers {
+		request[i] = eth.HeaderByNumber(new(big.Int).SetUint64(number)).Returns(&headers[i])
+	}
+	if err := p.callInBatches(ctx, client, "eth_getBlockByNumber", request); err != nil {
+		return nil, err
+	}
+
+	timestamps := make(map[uint64]uint64, len(numbers))
+	for i, header := range headers {
+		if header == nil {
+			return nil, fmt.Errorf("block header not found for %d", numbers[i])
+		}
+		timestamps[numbers[i]] = header.Time
+	}
+	return timestamps, nil
+}
+
+// traceCreations returns the contracts created by the factory source in [from,
+// to], its failed creations excluded.
+func (p *SourceIndexerService) traceCreations(ctx context.Context, client *rpcPool, from, to uint64) ([]types.EvmTrace, error) {
+	factory := common.HexToAddress(p.source.Address.String)
+	traces, err := p.fetchTraces(ctx, client, from, to, []common.Address{factory})
+	if err != nil {
+		return nil, err
+	}
+
+	var creations []types.EvmTrace
+	for _, t := range traces {
+		if (t.Type == "CREATE" || t.Type == "CREATE2") && t.Error == "" && t.To != "" && t.From == factory.Hex() {
+			creations = append(creations, t)
+		}
+	}
+	return creations, nil
+}
+
+// traceRules returns the factory rules triggered by contract creations found
+// in traces.
+func traceRules(rules []evmi_database.EvmFactoryRule) []evmi_database.EvmFactoryRule {
+	var found []evmi_database.EvmFactoryRule
+	for _, rule := range rules {
+		if rule.Trigger == string(evmi_database.TraceFactoryRuleTrigger) {
+			found = append(found, rule)
+		}
+	}
+	return found
+}
+
+// creationArgs are the values the conditions of a TRACE factory rule are
+// evaluated against.
+func creationArgs(creation types.EvmTrace) map[string]string {
+	return map[string]string{
+		"from":    creation.From,
+		"address": creation.To,
+		"value":   creation.Value,
+		"type":    creation.Type,
+	}
+}
+
+// addressFilter matches checksummed hex addresses.
+type addressFilter map[string]bool
+
+func newAddressFilter(list []common.Address) addressFilter {
+	filter := make(addressFilter, len(list))
+	for _, address := range list {
+		filter[address.Hex()] = true
+	}
+	return filter
+}
+
+// has reports whether one of values is matched.
+func (f addressFilter) has(values ...string) bool {
+	for _, value := range values {
+		if f[value] {
+			return true
+		}
+	}
+	return false
+}
+
+func addressHex(address *common.Address) string {
+	if address == nil {
+		return ""
+	}
+	return address.Hex()
+}
+
+func bigString(v *hexutil.Big) string {
+	if v == nil {
+		return "0"
+	}
+	return v.ToInt().String()
+}
+
+func joinPath(path []uint64) string {
+	parts := make([]string, len(path))
+	for i, index := range path {
+		parts[i] = fmt.Sprint(index)
+	}
+	return strings.Join(parts, ".")
+}
+
+func comparePaths(a, b []uint64) int {
+	for i := 0; i < len(a) && i < len(b); i++ {
+		if a[i] != b[i] {
+			if a[i] < b[i] {
+				return -1
+			}
+			return 1
+		}
+	}
+	return len(a) - len(b)
+}
@@ -0,0 +1,282 @@
+package indexer
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethTypes "github.com/ethereum/go-ethereum/core/types"
+	evmi_database "github.com/evmi-cloud/go-evm-indexer/internal/database/evmi-database"
+	"github.com/rs/zerolog"
+)
+
+var (
+	traceUser     = common.HexToAddress("0x00000000000000000000000000000000000000a1")
+	traceTreasury = common.HexToAddress("0x00000000000000000000000000000000000000b2")
+	traceRouter   = common.HexToAddress("0x00000000000000000000000000000000000000c3")
+	traceCreated  = common.HexToAddress("0x00000000000000000000000000000000000000d4")
+	traceTx0      = common.HexToHash("0x01")
+	traceTx1      = common.HexToHash("0x02")
+)
+
+// tracesNode serves debug_traceBlockByNumber, trace_filter and the headers of
+// eth_getBlockByNumber from fixed results, counting the requests of each
+// method (a batch counts once).
+type tracesNode struct {
+	callTraces map[uint64][]map[string]any
+	// filtered holds the trace_filter results by filter field.
+	filtered map[string][]map[string]any
+
+	mu       sync.Mutex
+	requests map[string]int
+}
+
+func (n *tracesNode) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var body json.RawMessage
+	_ = json.NewDecoder(r.Body).Decode(&body)
+
+	var requests []rpcRequest
+	batch := body[0] == '['
+	if batch {
+		_ = json.Unmarshal(body, &requests)
+	} else {
+		var req rpcRequest
+		_ = json.Unmarshal(body, &req)
+		requests = []rpcRequest{req}
+	}
+
+	n.mu.Lock()
+	n.requests[requests[0].Method]++
+	n.mu.Unlock()
+
+	responses := make([]map[string]any, len(requests))
+	for i, req := range requests {
+		response := map[string]any{"jsonrpc": "2.0", "id": req.ID}
+		switch req.Method {
+		case "debug_traceBlockByNumber":
+			var number hexutil.Uint64
+			_ = json.Unmarshal(req.Params[0], &number)
+			traces := n.callTraces[uint64(number)]
+			if traces == nil {
+				traces = []map[string]any{}
+			}
+			response["result"] = traces
+		case "trace_filter":
+			var filter map[string]json.RawMessage
+			_ = json.Unmarshal(req.Params[0], &filter)
+			result := []map[string]any{}
+			for field, traces := range n.filtered {
+				if _, ok := filter[field]; ok {
+					result = traces
+				}
+			}
+			response["result"] = result
+		case "eth_getBlockByNumber":
+			var number hexutil.Uint64
+			_ = json.Unmarshal(req.Params[0], &number)
+			header := &ethTypes.Header{Number: new(big.Int).SetUint64(uint64(number)), Time: 1000 + uint64(number), Difficulty: big.NewInt(0)}
+			response["result"] = rpcBlockJSON(ethTypes.NewBlockWithHeader(header))
+		}
+		responses[i] = response
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if batch {
+		_ = json.NewEncoder(w).Encode(responses)
+	} else {
+		_ = json.NewEncoder(w).Encode(responses[0])
+	}
+}
+
+// treasuryCallTraces are the callTracer results of block 10: a router call
+// sending 5 wei to the treasury and reading another contract, then a CREATE2
+// by the treasury.
+func treasuryCallTraces() map[uint64][]map[string]any {
+	return map[uint64][]map[string]any{
+		10: {
+			{"txHash": traceTx0, "result": map[string]any{
+				"type": "CALL", "from": traceUser, "to": traceRouter, "value": "0x0", "gas": "0x10000", "gasUsed": "0x5000", "input": "0x1234",
+				"calls": []map[string]any{
+					{"type": "CALL", "from": traceRouter, "to": traceTreasury, "value": "0x5", "gas": "0x100", "gasUsed": "0x50", "input": "0x"},
+					{"type": "STATICCALL", "from": traceRouter, "to": traceCreated, "gas": "0x100", "gasUsed": "0x50", "input": "0x"},
+				},
+			}},
+			{"txHash": traceTx1, "result": map[string]any{
+				"type": "CREATE2", "from": traceTreasury, "to": traceCreated, "value": "0x0", "gas": "0x20000", "gasUsed": "0x8000",
+				"input": "0x6080", "output": "0x6080",
+			}},
+		},
+	}
+}
+
+func newTraceIndexer(t *testing.T, chain evmi_database.EvmBlockchain, handler http.Handler) (*SourceIndexerService, *rpcPool) {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+	p := &SourceIndexerService{
+		source: evmi_database.EvmLogSource{Type: string(evmi_database.TracesLogSourceType)},
+		chain:  chain,
+		logger: zerolog.Nop(),
+	}
+	p.source.ID = 5
+	return p, newTestPool(t, server.URL)
+}
+
+func TestFetchTracesWithCallTracer(t *testing.T) {
+	node := &tracesNode{callTraces: treasuryCallTraces(), requests: map[string]int{}}
+	p, client := newTraceIndexer(t, evmi_database.EvmBlockchain{ChainId: 1, RpcMaxBatchSize: 2}, node)
+
+	traces, err := p.fetchTraces(context.Background(), client, 10, 12, []common.Address{traceTreasury})
+	if err != nil {
+		t.Fatalf("fetch: %v", err)
+	}
+	if len(traces) != 2 {
+		t.Fatalf("traces = %+v", traces)
+	}
+	transfer, creation := traces[0], traces[1]
+	if transfer.Id != "1:5:"+traceTx0.Hex()+":0" || transfer.SourceId != 5 || transfer.Type != "CALL" || transfer.TraceAddress != "0" ||
+		transfer.From != traceRouter.Hex() || transfer.To != traceTreasury.Hex() || transfer.Value != "5" ||
+		transfer.BlockTimestamp != 1010 || transfer.Gas != 0x100 || transfer.GasUsed != 0x50 {
+		t.Errorf("transfer = %+v", transfer)
+	}
+	if creation.Type != "CREATE2" || creation.TraceAddress != "" || creation.TransactionIndex != 1 ||
+		creation.From != traceTreasury.Hex() || creation.To != traceCreated.Hex() || creation.Input != "0x6080" {
+		t.Errorf("creation = %+v", creation)
+	}
+	// Three blocks traced in batches of two; one header read for block 10.
+	if node.requests["debug_traceBlockByNumber"] != 2 || node.requests["eth_getBlockByNumber"] != 1 {
+		t.Errorf("requests = %v", node.requests)
+	}
+}
+
+func TestFetchTracesWithTraceFilter(t *testing.T) {
+	transfer := map[string]any{
+		"type": "call", "blockNumber": 10, "transactionHash": traceTx0, "transactionPosition": 0, "traceAddress": []int{0},
+		"action": map[string]any{"callType": "call", "from": traceRouter, "to": traceTreasury, "value": "0x5", "gas": "0x100", "input": "0x"},
+		"result": map[string]any{"gasUsed": "0x50", "output": "0x"},
+	}
+	selfCall := map[string]any{
+		"type": "call", "blockNumber": 11, "transactionHash": traceTx1, "transactionPosition": 0, "traceAddress": []int{},
+		"action": map[string]any{"callType": "delegatecall", "from": traceTreasury, "to": traceTreasury, "value": "0x0", "gas": "0x100", "input": "0x"},
+		"error":  "Reverted",
+	}
+	creation := map[string]any{
+		"type": "create", "blockNumber": 11, "transactionHash": traceTx1, "transactionPosition": 0, "traceAddress": []int{0},
+		"action": map[string]any{"creationMethod": "create2", "from": traceTreasury, "value": "0x0", "gas": "0x100", "init": "0x6080"},
+		"result": map[string]any{"address": traceCreated, "gasUsed": "0x80", "code": "0x60"},
+	}
+	reward := map[string]any{
+		"type": "reward", "blockNumber": 11, "traceAddress": []int{},
+		"action": map[string]any{"author": traceTreasury, "rewardType": "block", "value": "0x1"},
+	}
+	node := &tracesNode{
+		filtered: map[string][]map[string]any{
+			"fromAddress": {selfCall, creation, reward},
+			"toAddress":   {transfer, selfCall},
+		},
+		requests: map[string]int{},
+	}
+	p, client := newTraceIndexer(t, evmi_database.EvmBlockchain{ChainId: 1, TraceFilterAvailable: true}, node)
+
+	traces, err := p.fetchTraces(context.Background(), client, 10, 12, []common.Address{traceTreasury})
+	if err != nil {
+		t.Fatalf("fetch: %v", err)
+	}
+	// The self call is reported by both filters and kept once; the reward is
+	// skipped.
+	if len(traces) != 3 {
+		t.Fatalf("traces = %+v", traces)
+	}
+	if traces[0].Value != "5" || traces[0].BlockTimestamp != 1010 || traces[0].TraceAddress != "0" {
+		t.Errorf("transfer = %+v", traces[0])
+	}
+	if traces[1].Type != "DELEGATECALL" || traces[1].Error != "Reverted" || traces[1].TraceAddress != "" {
+		t.Errorf("self call = %+v", traces[1])
+	}
+	if traces[2].Type != "CREATE2" || traces[2].To != traceCreated.Hex() || traces[2].Input != "0x6080" || traces[2].GasUsed != 0x80 {
+		t.Errorf("creation = %+v", traces[2])
+	}
+	if node.requests["trace_filter"] != 1 || node.requests["debug_traceBlockByNumber"] != 0 {
+		t.Errorf("requests = %v", node.requests)
+	}
+}
+
+func TestTraceFactoryRuleRegistersCreatedContracts(t *testing.T) {
+	factory := evmi_database.EvmLogSource{
+		Enabled:          true,
+		Type:             string(evmi_database.FactoryLogSourceType),
+		EvmLogPipelineID: 3,
+		EvmBlockchainID:  2,
+	}
+	factory.Address.String, factory.Address.Valid = traceTreasury.Hex(), true
+	p := newSourceIndexerForTest(t, factory)
+	node := &tracesNode{callTraces: treasuryCallTraces(), requests: map[string]int{}}
+	server := httptest.NewServer(node)
+	defer server.Close()
+	p.chain = evmi_database.EvmBlockchain{ChainId: 1}
+
+	sourceID := p.source.ID
+	rules := []evmi_database.EvmFactoryRule{
+		// An event rule, and a trace rule whose condition rejects the
+		// creation.
+		{EvmLogSourceID: &sourceID, CreationFunctionName: "PoolCreated", CreationAddressLogArg: "pool", ChildType: "CONTRACT"},
+		{EvmLogSourceID: &sourceID, Trigger: "TRACE", ChildType: "CONTRACT", EvmJsonAbiID: 4,
+			Conditions: []evmi_database.EvmFactoryRuleCondition{{Arg: "type", Operator: "eq", Value: "CREATE"}}},
+	}
+	for i := range rules {
+		if err := p.db.Conn.Create(&rules[i]).Error; err != nil {
+			t.Fatal(err)
+		}
+	}
+	client := newTestPool(t, server.URL)
+	if err := p.loadTraceRules(); err != nil {
+		t.Fatalf("load rules: %v", err)
+	}
+	if err := p.registerTraceFactoryChildren(context.Background(), client, 10, 10); err != nil {
+		t.Fatalf("register: %v", err)
+	}
+	var count int64
+	p.db.Conn.Model(&evmi_database.EvmLogSource{}).Where("parent_source_id = ?", sourceID).Count(&count)
+	if count != 0 {
+		t.Fatalf("children = %d, want none: the condition rejects CREATE2", count)
+	}
+
+	// The rules are loaded with the source: a change applies once it reloads.
+	if err := p.db.Conn.Model(&evmi_database.EvmFactoryRuleCondition{}).Where("arg = ?", "type").Update("value", "CREATE2").Error; err != nil {
+		t.Fatal(err)
+	}
+	if err := p.loadTraceRules(); err != nil {
+		t.Fatalf("reload rules: %v", err)
+	}
+	if err := p.registerTraceFactoryChildren(context.Background(), client, 10, 10); err != nil {
+		t.Fatalf("register: %v", err)
+	}
+	var child evmi_database.EvmLogSource
+	if err := p.db.Conn.Where("parent_source_id = ?", sourceID).First(&child).Error; err != nil {
+		t.Fatalf("child not registered: %v", err)
+	}
+	if child.Address.String != traceCreated.Hex() || child.StartBlock != 9 || child.EvmJsonAbiID != 4 {
+		t.Errorf("child = %+v", child)
+	}
+}
+
+func TestTraceBlocksFailsOnUntracedTransactions(t *testing.T) {
+	callTraces := treasuryCallTraces()
+	callTraces[10] = append([]map[string]any{{"txHash": common.HexToHash("0x03"), "error": "execution timeout"}}, callTraces[10]...)
+	node := &tracesNode{callTraces: callTraces, requests: map[string]int{}}
+	p, client := newTraceIndexer(t, evmi_database.EvmBlockchain{ChainId: 1}, node)
+
+	_, err := p.fetchTraces(context.Background(), client, 10, 10, []common.Address{traceTreasury})
+	var failed *rangeError
+	if !errors.As(err, &failed) || failed.from != 10 || failed.to != 10 || !strings.Contains(err.Error(), "execution timeout") {
+		t.Errorf("err = %v, want the untraced transaction to fail block 10", err)
+	}
+}
@@ -0,0 +1,152 @@
+package indexer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/evmi-cloud/go-evm-indexer/internal/types"
+	"github.com/lmittmann/w3/module/eth"
+	"github.com/lmittmann/w3/w3types"
+)
+
+// transactionFilter matches the transactions of a TRANSACTIONS source: from
+// or to one of its addresses and, when selector is set, calling that
+// function.
+type transactionFilter struct {
+	addresses map[common.Address]bool
+	selector  []byte
+}
+
+func (p *SourceIndexerService) transactionFilter() transactionFilter {
+	filter := transactionFilter{addresses: map[common.Address]bool{}}
+	for _, address := range p.source.AddressValues() {
+		filter.addresses[common.HexToAddress(address)] = true
+	}
+	if p.source.FunctionSelector != "" {
+		filter.selector = common.FromHex(p.source.FunctionSelector)
+	}
+	return filter
+}
+
+func (f transactionFilter) matches(sender common.Address, tx *ethTypes.Transaction) bool {
+	if !f.addresses[sender] && (tx.To() == nil || !f.addresses[*tx.To()]) {
+		return false
+	}
+	if f.selector != nil {
+		data := tx.Data()
+		return len(data) >= 4 && string(data[:4]) == string(f.selector)
+	}
+	return true
+}
+
+// fetchTransactions scans the blocks of [from, to] for the transactions of a
+// TRANSACTIONS source. The blocks are read with their transactions by
+// eth_getBlockByNumber, a batch of scanBatchSize blocks at a time: only the
+// matching transactions of a batch are kept before the next one is read. The
+// receipts of the matching ones are then loaded as for the transactions of
+// logs.
+func (p *SourceIndexerService) fetchTransactions(ctx context.Context, client *rpcPool, from, to uint64) ([]types.EvmTransaction, error) {
+	filter := p.transactionFilter()
+	chainId := new(big.Int).SetUint64(p.chain.ChainId)
+	signer := ethTypes.NewPragueSigner(chainId)
+
+	type match struct {
+		tx             *ethTypes.Transaction
+		index          int
+		sender         common.Address
+		blockNumber    uint64
+		blockTimestamp uint64
+	}
+	var matches []match
+	var blockHashes, txHashes []common.Hash
+	size := p.scanBatchSize()
+	for start := from; start <= to; start += size {
+		end := min(start+size-1, to)
+		blocks := make([]*ethTypes.Block, end-start+1)
+		request := make([]w3types.RPCCaller, len(blocks))
+		for i := range blocks {
+			request[i] = eth.BlockByNumber(new(big.Int).SetUint64(start + uint64(i))).Returns(&blocks[i])
+		}
+		if err := p.callInBatches(ctx, client, "eth_getBlockByNumber", request); err != nil {
+			return nil, err
+		}
+
+		for i, block := range blocks {
+			if block == nil {
+				return nil, fmt.Errorf("block %d not found", start+uint64(i))
+			}
+			matched := false
+			for index, tx := range block.Transactions() {
+				sender, err := ethTypes.Sender(signer, tx)
+				if err != nil {
+					return nil, errors.New("TX " + tx.Hash().Hex() + ": " + err.Error())
+				}
+				if !filter.matches(sender, tx) {
+					continue
+				}
+				matches = append(matches, match{
+					tx:             tx,
+					index:          index,
+					sender:         sender,
+					blockNumber:    block.NumberU64(),
+					blockTimestamp: block.Time(),
+				})
+				txHashes = append(txHashes, tx.Hash())
+				matched = true
+			}
+			if matched {
+				blockHashes = append(blockHashes, block.Hash())
+			}
+		}
+	}
+
+	dbTxs := []types.EvmTransaction{}
+	if len(matches) == 0 {
+		p.logger.Info().Msg("No transactions found")
+		return dbTxs, nil
+	}
+	p.logger.Info().Msg(fmt.Sprintf("%d transactions found", len(matches)))
+
+	receipts, err := p.loadReceipts(ctx, client, blockHashes, txHashes)
+	if err != nil {
+		p.logger.Error().Msg(err.Error())
+		return nil, err
+	}
+
+	for _, m := range matches {
+		transaction := m.tx
+		to := "0x0000000000000000000000000000000000000000"
+		if transaction.To() != nil {
+			to = transaction.To().Hex()
+		}
+
+		evmTx := types.EvmTransaction{
+			Id:               fmt.Sprintf("%d:%s", transaction.ChainId().Uint64(), transaction.Hash().Hex()),
+			SourceId:         p.source.ID,
+			BlockNumber:      m.blockNumber,
+			BlockTimestamp:   m.blockTimestamp,
+			ChainId:          transaction.ChainId().Uint64(),
+			From:             m.sender.Hex(),
+			Data:             common.Bytes2Hex(transaction.Data()),
+			Value:            transaction.Value().String(),
+			TransactionIndex: uint64(m.index),
+			Nonce:            transaction.Nonce(),
+			To:               to,
+			Hash:             transaction.Hash().Hex(),
+			Metadata:         p.GetTransactionMetadata(transaction.Data(), m.blockNumber, 0),
+		}
+
+		receipt := receipts[transaction.Hash()]
+		if receipt == nil {
+			return nil, errors.New("receipt not found for " + transaction.Hash().Hex())
+		}
+		applyReceipt(&evmTx, transaction, receipt)
+
+		dbTxs = append(dbTxs, evmTx)
+	}
+	return dbTxs, nil
+}
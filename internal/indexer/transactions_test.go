@@ -0,0 +1,197 @@
+package indexer
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	evmi_database "github.com/evmi-cloud/go-evm-indexer/internal/database/evmi-database"
+	"github.com/lib/pq"
+	"github.com/rs/zerolog"
+)
+
+const treasuryAbi = `[{
+	"inputs": [
+		{"name": "target", "type": "address"},
+		{"name": "value", "type": "uint256"}
+	],
+	"name": "execute",
+	"outputs": [],
+	"stateMutability": "nonpayable",
+	"type": "function"
+}]`
+
+// blocksNode serves eth_getBlockByNumber with full transactions,
+// eth_getBlockTransactionCountByNumber and eth_getBlockReceipts from an
+// in-memory block list, counting the requests of each method (a batch counts
+// once).
+type blocksNode struct {
+	blocks map[uint64]*ethTypes.Block
+
+	mu       sync.Mutex
+	requests map[string]int
+}
+
+func (n *blocksNode) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var body json.RawMessage
+	_ = json.NewDecoder(r.Body).Decode(&body)
+
+	var requests []rpcRequest
+	batch := bytes.HasPrefix(bytes.TrimSpace(body), []byte("["))
+	if batch {
+		_ = json.Unmarshal(body, &requests)
+	} else {
+		var req rpcRequest
+		_ = json.Unmarshal(body, &req)
+		requests = []rpcRequest{req}
+	}
+
+	n.mu.Lock()
+	n.requests[requests[0].Method]++
+	n.mu.Unlock()
+
+	responses := make([]map[string]any, len(requests))
+	for i, req := range requests {
+		response := map[string]any{"jsonrpc": "2.0", "id": req.ID}
+		switch req.Method {
+		case "eth_getBlockByNumber":
+			var number hexutil.Uint64
+			_ = json.Unmarshal(req.Params[0], &number)
+			if block := n.blocks[uint64(number)]; block != nil {
+				response["result"] = rpcBlockJSON(block)
+			}
+		case "eth_getBlockTransactionCountByNumber":
+			var number hexutil.Uint64
+			_ = json.Unmarshal(req.Params[0], &number)
+			if block := n.blocks[uint64(number)]; block != nil {
+				response["result"] = hexutil.Uint(len(block.Transactions()))
+			}
+		case "eth_getBlockReceipts":
+			var hash common.Hash
+			_ = json.Unmarshal(req.Params[0], &hash)
+			receipts := []map[string]any{}
+			for _, block := range n.blocks {
+				if block.Hash() == hash {
+					for _, tx := range block.Transactions() {
+						receipts = append(receipts, testReceipt(tx.Hash()))
+					}
+				}
+			}
+			response["result"] = receipts
+		}
+		responses[i] = response
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if batch {
+		_ = json.NewEncoder(w).Encode(responses)
+	} else {
+		_ = json.NewEncoder(w).Encode(responses[0])
+	}
+}
+
+// rpcBlockJSON is the eth_getBlockByNumber result of block, with full
+// transactions.
+func rpcBlockJSON(block *ethTypes.Block) map[string]any {
+	var fields map[string]any
+	data, _ := json.Marshal(block.Header())
+	_ = json.Unmarshal(data, &fields)
+	fields["transactions"] = block.Transactions()
+	return fields
+}
+
+func TestFetchTransactionsMatchesAddressesAndSelector(t *testing.T) {
+	signer := ethTypes.LatestSignerForChainID(big.NewInt(1))
+	userKey, _ := crypto.GenerateKey()
+	treasuryKey, _ := crypto.GenerateKey()
+	treasury := crypto.PubkeyToAddress(treasuryKey.PublicKey)
+	other := common.HexToAddress("0x00000000000000000000000000000000000000ee")
+
+	parsed, err := abi.JSON(strings.NewReader(treasuryAbi))
+	if err != nil {
+		t.Fatalf("parse abi: %v", err)
+	}
+	execute, _ := parsed.Pack("execute", other, big.NewInt(42))
+
+	nonce := uint64(0)
+	newTx := func(key *ecdsa.PrivateKey, to common.Address, value int64, data []byte) *ethTypes.Transaction {
+		nonce++
+		signed, err := ethTypes.SignNewTx(key, signer, &ethTypes.DynamicFeeTx{
+			ChainID: big.NewInt(1), Nonce: nonce, To: &to, Value: big.NewInt(value), Data: data,
+			Gas: 21000, GasFeeCap: big.NewInt(1), GasTipCap: big.NewInt(1),
+		})
+		if err != nil {
+			t.Fatalf("sign: %v", err)
+		}
+		return signed
+	}
+	// A plain transfer to the treasury, an unrelated one, and a call from the
+	// treasury.
+	deposit := newTx(userKey, treasury, 5, nil)
+	unrelated := newTx(userKey, other, 1, nil)
+	call := newTx(treasuryKey, other, 0, execute)
+	block := func(number uint64, txs ...*ethTypes.Transaction) *ethTypes.Block {
+		header := &ethTypes.Header{Number: new(big.Int).SetUint64(number), Time: 1000 + number, Difficulty: big.NewInt(0)}
+		return ethTypes.NewBlockWithHeader(header).WithBody(ethTypes.Body{Transactions: txs})
+	}
+	node := &blocksNode{
+		blocks: map[uint64]*ethTypes.Block{
+			10: block(10, deposit, unrelated),
+			11: block(11, call),
+			12: block(12),
+		},
+		requests: map[string]int{},
+	}
+	server := httptest.NewServer(node)
+	defer server.Close()
+	client := newTestPool(t, server.URL)
+
+	p := &SourceIndexerService{
+		source: evmi_database.EvmLogSource{
+			Type:      string(evmi_database.TransactionsLogSourceType),
+			Addresses: pq.StringArray{strings.ToLower(treasury.Hex())},
+		},
+		chain:  evmi_database.EvmBlockchain{ChainId: 1, RpcMaxBatchSize: 2},
+		logger: zerolog.Nop(),
+	}
+	p.setAbi("Treasury", parsed)
+
+	txs, err := p.fetchTransactions(context.Background(), client, 10, 12)
+	if err != nil {
+		t.Fatalf("fetch: %v", err)
+	}
+	if len(txs) != 2 || txs[0].Hash != deposit.Hash().Hex() || txs[1].Hash != call.Hash().Hex() {
+		t.Fatalf("transactions = %+v", txs)
+	}
+	if txs[0].Value != "5" || txs[0].BlockTimestamp != 1010 || txs[0].Metadata.FunctionName != "" || txs[0].GasUsed != 21000 {
+		t.Errorf("deposit = %+v", txs[0])
+	}
+	if txs[1].From != treasury.Hex() || txs[1].BlockNumber != 11 || txs[1].Metadata.FunctionName != "execute" ||
+		txs[1].Metadata.Data["value"] != "42" {
+		t.Errorf("call = %+v", txs[1])
+	}
+	// Three blocks in batches of two; receipts of the two matching blocks in
+	// one batch.
+	if node.requests["eth_getBlockByNumber"] != 2 || node.requests["eth_getBlockReceipts"] != 1 {
+		t.Errorf("requests = %v", node.requests)
+	}
+
+	// With a selector, only the calls of that function match.
+	p.source.FunctionSelector = hexutil.Encode(parsed.Methods["execute"].ID)
+	txs, err = p.fetchTransactions(context.Background(), client, 10, 12)
+	if err != nil || len(txs) != 1 || txs[0].Hash != call.Hash().Hex() {
+		t.Errorf("selector transactions = %+v, err %v", txs, err)
+	}
+}
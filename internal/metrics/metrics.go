@@ -23,8 +23,8 @@ var (
 
 	chainHeadBlockMetrics = promauto.NewGaugeVec(prometheus.GaugeOpts{
 		Name: "evm_indexer_chain_head_block",
-		Help: "Latest block number observed on the chain (eth_blockNumber).",
-	}, []string{"chain_id"})
+		Help: "Head block observed on the chain, by block tag (latest via eth_blockNumber, safe, finalized).",
+	}, []string{"chain_id", "tag"})
 
 	// --- per source: progress ---
 
@@ -61,6 +61,28 @@ var (
 		Buckets: durationBuckets,
 	}, sourceLabelNames)
 
+	logsWindowMetrics = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "evm_indexer_source_logs_window_blocks",
+		Help: "Current adaptive eth_getLogs block window of a source (shrinks on provider limits, grows back on sparse ranges).",
+	}, sourceLabelNames)
+
+	// --- per source: reorgs ---
+
+	sourceReorgsMetrics = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "evm_indexer_source_reorgs_total",
+		Help: "Total chain reorganizations detected by a source.",
+	}, sourceLabelNames)
+
+	sourceRolledBackBlocksMetrics = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "evm_indexer_source_rolled_back_blocks_total",
+		Help: "Total blocks a source rewound to reach the common ancestor after a reorg.",
+	}, sourceLabelNames)
+
+	sourceFailuresMetrics = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "evm_indexer_source_failures_total",
+		Help: "Total failures of a source's indexer, each followed by a backoff and a restart.",
+	}, sourceLabelNames)
+
 	// --- store ---
 
 	logsStoredMetrics = promauto.NewGaugeVec(prometheus.GaugeOpts{
@@ -88,15 +110,31 @@ var (
 
 	rpcRequestsMetrics = promauto.NewCounterVec(prometheus.CounterOpts{
 		Name: "evm_indexer_rpc_requests_total",
-		Help: "Total JSON-RPC requests, by method and outcome (status = ok | error).",
-	}, []string{"chain_id", "method", "status"})
+		Help: "Total JSON-RPC requests, by endpoint, method and outcome (status = ok | error).",
+	}, []string{"chain_id", "endpoint", "method", "status"})
 
 	rpcDurationMetrics = promauto.NewHistogramVec(prometheus.HistogramOpts{
 		Name:    "evm_indexer_rpc_request_duration_seconds",
-		Help:    "JSON-RPC request latency, by method.",
+		Help:    "JSON-RPC request latency, by endpoint and method.",
+		Buckets: durationBuckets,
+	}, []string{"chain_id", "endpoint", "method"})
+
+	rpcEndpointUpMetrics = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "evm_indexer_rpc_endpoint_up",
+		Help: "1 if the JSON-RPC endpoint is receiving calls, 0 while it is failing or lagging behind the other endpoints.",
+	}, []string{"chain_id", "endpoint"})
+
+	rpcThrottleWaitMetrics = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "evm_indexer_rpc_throttle_wait_seconds",
+		Help:    "Time JSON-RPC calls waited on the chain's rate limiter, by method; calls sent without waiting are not observed.",
 		Buckets: durationBuckets,
 	}, []string{"chain_id", "method"})
 
+	rpcRateLimitedMetrics = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "evm_indexer_rpc_rate_limited_total",
+		Help: "Total HTTP 429 (Too Many Requests) answers, by endpoint.",
+	}, []string{"chain_id", "endpoint"})
+
 	// --- exporter ---
 
 	exporterSyncedBlockMetrics = promauto.NewGaugeVec(prometheus.GaugeOpts{
@@ -61,11 +61,13 @@ func (h *MetricService) Start() {
 
 // --- chain ---
 
-func (h *MetricService) SetChainHead(chainID, block uint64) {
+// SetChainHead records the head block read from the node for a block tag
+// ("latest", "safe", "finalized").
+func (h *MetricService) SetChainHead(chainID uint64, tag string, block uint64) {
 	if h == nil || !h.enabled {
 		return
 	}
-	chainHeadBlockMetrics.WithLabelValues(fmt.Sprint(chainID)).Set(float64(block))
+	chainHeadBlockMetrics.WithLabelValues(fmt.Sprint(chainID), tag).Set(float64(block))
 }
 
 // --- source ---
@@ -111,6 +113,33 @@ func (h *MetricService) ObserveBatchDuration(l SourceLabels, d time.Duration) {
 	batchDurationMetrics.WithLabelValues(l.values()...).Observe(d.Seconds())
 }
 
+// SetLogsWindow records a source's current eth_getLogs block window.
+func (h *MetricService) SetLogsWindow(l SourceLabels, blocks uint64) {
+	if h == nil || !h.enabled {
+		return
+	}
+	logsWindowMetrics.WithLabelValues(l.values()...).Set(float64(blocks))
+}
+
+// RecordReorg counts one detected reorganization and the number of blocks the
+// source rewound to reach the common ancestor.
+func (h *MetricService) RecordReorg(l SourceLabels, depth uint64) {
+	if h == nil || !h.enabled {
+		return
+	}
+	v := l.values()
+	sourceReorgsMetrics.WithLabelValues(v...).Inc()
+	sourceRolledBackBlocksMetrics.WithLabelValues(v...).Add(float64(depth))
+}
+
+// IncSourceFailures counts one failure of the source's indexer.
+func (h *MetricService) IncSourceFailures(l SourceLabels) {
+	if h == nil || !h.enabled {
+		return
+	}
+	sourceFailuresMetrics.WithLabelValues(l.values()...).Inc()
+}
+
 // --- store ---
 
 // ObserveStoreWrite records the duration of a store write and, on error, bumps the
@@ -134,9 +163,9 @@ func (h *MetricService) SetStoreDiskBytes(store string, bytes uint64) {
 
 // --- rpc ---
 
-// RecordRPC records one JSON-RPC call's latency and outcome. status is derived
-// from err (ok / error).
-func (h *MetricService) RecordRPC(chainID uint64, method string, d time.Duration, err error) {
+// RecordRPC records one JSON-RPC call's latency and outcome against the endpoint
+// (host) that served it. status is derived from err (ok / error).
+func (h *MetricService) RecordRPC(chainID uint64, endpoint, method string, d time.Duration, err error) {
 	if h == nil || !h.enabled {
 		return
 	}
@@ -145,8 +174,37 @@ func (h *MetricService) RecordRPC(chainID uint64, method string, d time.Duration
 		status = "error"
 	}
 	chain := fmt.Sprint(chainID)
-	rpcRequestsMetrics.WithLabelValues(chain, method, status).Inc()
-	rpcDurationMetrics.WithLabelValues(chain, method).Observe(d.Seconds())
+	rpcRequestsMetrics.WithLabelValues(chain, endpoint, method, status).Inc()
+	rpcDurationMetrics.WithLabelValues(chain, endpoint, method).Observe(d.Seconds())
+}
+
+// SetRPCEndpointUp records whether an endpoint is currently routed to.
+func (h *MetricService) SetRPCEndpointUp(chainID uint64, endpoint string, up bool) {
+	if h == nil || !h.enabled {
+		return
+	}
+	v := 0.0
+	if up {
+		v = 1
+	}
+	rpcEndpointUpMetrics.WithLabelValues(fmt.Sprint(chainID), endpoint).Set(v)
+}
+
+// ObserveRPCThrottle records the time a call waited on the chain's rate
+// limiter before being sent.
+func (h *MetricService) ObserveRPCThrottle(chainID uint64, method string, d time.Duration) {
+	if h == nil || !h.enabled || d <= 0 {
+		return
+	}
+	rpcThrottleWaitMetrics.WithLabelValues(fmt.Sprint(chainID), method).Observe(d.Seconds())
+}
+
+// IncRPCRateLimited counts one 429 answer of an endpoint.
+func (h *MetricService) IncRPCRateLimited(chainID uint64, endpoint string) {
+	if h == nil || !h.enabled {
+		return
+	}
+	rpcRateLimitedMetrics.WithLabelValues(fmt.Sprint(chainID), endpoint).Inc()
 }
 
 // --- exporter ---
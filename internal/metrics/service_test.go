@@ -32,14 +32,17 @@ func TestDisabledServiceIsNoOp(t *testing.T) {
 	el := ExporterLabels{ChainID: 1, Pipeline: "p", Exporter: "e"}
 
 	// None of these should panic or record anything.
-	m.SetChainHead(1, 10)
+	m.SetChainHead(1, "latest", 10)
 	m.SetSourceProgress(sl, 10, 5)
 	m.SetSourceUp(sl, true)
 	m.AddLogsIndexed(sl, 3)
 	m.AddTransactionsIndexed(sl, 2)
 	m.ObserveBatchDuration(sl, time.Second)
+	m.RecordReorg(sl, 3)
+	m.SetLogsWindow(sl, 250)
 	m.ObserveStoreWrite("s", "logs", time.Second, nil)
-	m.RecordRPC(1, "eth_getLogs", time.Second, nil)
+	m.RecordRPC(1, "node-a", "eth_getLogs", time.Second, nil)
+	m.SetRPCEndpointUp(1, "node-a", true)
 	m.SetExporterProgress(el, 10, 5)
 	m.AddExporterEvents(el, 4)
 	m.IncExporterErrors(el)
@@ -53,6 +56,15 @@ func TestEnabledServiceRecords(t *testing.T) {
 	m := NewMetricService(true, "/metrics", 0, zerolog.Nop())
 	sl := SourceLabels{ChainID: 42, Pipeline: "pipe", Store: "store", SourceID: 1, SourceType: "TOPIC"}
 
+	m.SetChainHead(42, "latest", 120)
+	m.SetChainHead(42, "finalized", 56)
+	if v := testutil.ToFloat64(chainHeadBlockMetrics.WithLabelValues("42", "latest")); v != 120 {
+		t.Errorf("chain_head_block{tag=latest} = %v, want 120", v)
+	}
+	if v := testutil.ToFloat64(chainHeadBlockMetrics.WithLabelValues("42", "finalized")); v != 56 {
+		t.Errorf("chain_head_block{tag=finalized} = %v, want 56", v)
+	}
+
 	m.SetSourceProgress(sl, 100, 90)
 	if v := testutil.ToFloat64(sourceLagBlocksMetrics.WithLabelValues(sl.values()...)); v != 10 {
 		t.Errorf("source_lag_blocks = %v, want 10", v)
@@ -78,15 +90,43 @@ func TestEnabledServiceRecords(t *testing.T) {
 		t.Errorf("store_write_errors_total = %v, want 1", v)
 	}
 
+	m.SetLogsWindow(sl, 250)
+	if v := testutil.ToFloat64(logsWindowMetrics.WithLabelValues(sl.values()...)); v != 250 {
+		t.Errorf("source_logs_window_blocks = %v, want 250", v)
+	}
+
+	m.RecordReorg(sl, 2)
+	m.RecordReorg(sl, 3)
+	if v := testutil.ToFloat64(sourceReorgsMetrics.WithLabelValues(sl.values()...)); v != 2 {
+		t.Errorf("source_reorgs_total = %v, want 2", v)
+	}
+	if v := testutil.ToFloat64(sourceRolledBackBlocksMetrics.WithLabelValues(sl.values()...)); v != 5 {
+		t.Errorf("source_rolled_back_blocks_total = %v, want 5", v)
+	}
+	m.IncSourceFailures(sl)
+	if v := testutil.ToFloat64(sourceFailuresMetrics.WithLabelValues(sl.values()...)); v != 1 {
+		t.Errorf("source_failures_total = %v, want 1", v)
+	}
+
 	// RPC status label is derived from the error.
-	m.RecordRPC(42, "eth_getLogs", time.Millisecond, nil)
-	m.RecordRPC(42, "eth_getLogs", time.Millisecond, errors.New("x"))
-	if v := testutil.ToFloat64(rpcRequestsMetrics.WithLabelValues("42", "eth_getLogs", "ok")); v != 1 {
+	m.RecordRPC(42, "node-a", "eth_getLogs", time.Millisecond, nil)
+	m.RecordRPC(42, "node-a", "eth_getLogs", time.Millisecond, errors.New("x"))
+	if v := testutil.ToFloat64(rpcRequestsMetrics.WithLabelValues("42", "node-a", "eth_getLogs", "ok")); v != 1 {
 		t.Errorf("rpc ok = %v, want 1", v)
 	}
-	if v := testutil.ToFloat64(rpcRequestsMetrics.WithLabelValues("42", "eth_getLogs", "error")); v != 1 {
+	if v := testutil.ToFloat64(rpcRequestsMetrics.WithLabelValues("42", "node-a", "eth_getLogs", "error")); v != 1 {
 		t.Errorf("rpc error = %v, want 1", v)
 	}
+
+	m.SetRPCEndpointUp(42, "node-b", false)
+	if v := testutil.ToFloat64(rpcEndpointUpMetrics.WithLabelValues("42", "node-b")); v != 0 {
+		t.Errorf("rpc_endpoint_up = %v, want 0", v)
+	}
+
+	m.IncRPCRateLimited(42, "node-a")
+	if v := testutil.ToFloat64(rpcRateLimitedMetrics.WithLabelValues("42", "node-a")); v != 1 {
+		t.Errorf("rpc_rate_limited_total = %v, want 1", v)
+	}
 }
 
 // Guard against label/value drift: the declared label count must match values().
@@ -0,0 +1,23 @@
+package types
+
+// EvmBlock is a block header indexed by a BLOCKS source.
+type EvmBlock struct {
+	Id             string // chainId:sourceId:blockNumber
+	SourceId       uint
+	ChainId        uint64
+	BlockNumber    uint64
+	BlockTimestamp uint64 // unix seconds
+	Hash           string
+	ParentHash     string
+	Miner          string // fee recipient
+	GasUsed        uint64
+	GasLimit       uint64
+	BaseFeePerGas  string // wei, empty before London
+
+	// EIP-4844 blob gas, zero before Cancun.
+	BlobGasUsed   uint64
+	ExcessBlobGas uint64
+
+	TransactionCount uint64
+	LogsBloom        string
+}
@@ -74,6 +74,33 @@ type ConfigBlockchain struct {
 	RpcMaxBatchSize     uint64 `json:"rpcMaxBatchSize"`
 	SqdGatewayAvailable bool   `json:"sqdGatewayAvailable"`
 	SqdGatewayUrl       string `json:"sqdGatewayUrl"`
+	// HeadMode is LATEST (default), CONFIRMATIONS, SAFE or FINALIZED;
+	// HeadConfirmations is the depth used by CONFIRMATIONS.
+	HeadMode          string `json:"headMode,omitempty"`
+	HeadConfirmations uint64 `json:"headConfirmations,omitempty"`
+	// RpcEndpoints spreads calls over several nodes by weight; rpcUrl is used
+	// alone when it is empty.
+	RpcEndpoints []ConfigRpcEndpoint `json:"rpcEndpoints,omitempty"`
+	// WsUrl enables push mode: sources wake on the WebSocket newHeads (or, with
+	// WsSubscribeLogs, their own logs) subscription instead of polling.
+	WsUrl           string `json:"wsUrl,omitempty"`
+	WsSubscribeLogs bool   `json:"wsSubscribeLogs,omitempty"`
+	// LogsMaxAddresses caps the addresses per batched eth_getLogs call.
+	LogsMaxAddresses uint64 `json:"logsMaxAddresses,omitempty"`
+	// TraceFilterAvailable reads traces with trace_filter instead of
+	// debug_traceBlockByNumber.
+	TraceFilterAvailable bool `json:"traceFilterAvailable,omitempty"`
+	// RpcRateLimit caps the chain's JSON-RPC calls per second on the
+	// instance, weighed by RpcMethodCosts (compute units, 1 for a method not
+	// listed). 0 is unlimited.
+	RpcRateLimit   float64           `json:"rpcRateLimit,omitempty"`
+	RpcMethodCosts map[string]uint64 `json:"rpcMethodCosts,omitempty"`
+}
+
+// ConfigRpcEndpoint is one JSON-RPC node of a blockchain. Weight defaults to 1.
+type ConfigRpcEndpoint struct {
+	Url    string `json:"url"`
+	Weight uint64 `json:"weight,omitempty"`
 }
 
 // ConfigAbi matches an EvmJsonAbi by ContractName. Content is the ABI JSON.
@@ -100,20 +127,34 @@ type ConfigPipeline struct {
 }
 
 // ConfigSource matches an EvmLogSource within its pipeline: for CONTRACT/FACTORY
-// by Address, for TOPIC by Topic0, for FULL by (pipeline, type). Pipeline / Abi /
-// FactoryChildAbi reference resources by name; Blockchain defaults to the
-// pipeline's blockchain when empty.
+// by Address, for TRANSACTIONS and TRACES by its (first) address, for TOPIC by
+// its (first) topic0, for FULL and BLOCKS by (pipeline, type).
+// Pipeline / Abi / FactoryChildAbi reference resources by name; Blockchain
+// defaults to the pipeline's blockchain when empty. Abis and Topic0s list
+// several ABIs / topic0 values and take precedence over Abi and Topic0.
 type ConfigSource struct {
-	Pipeline   string `json:"pipeline"`
-	Blockchain string `json:"blockchain,omitempty"`
-	Abi        string `json:"abi,omitempty"`
-	Type       string `json:"type"`
-	Enabled    bool   `json:"enabled"`
-	StartBlock uint64 `json:"startBlock"`
+	Pipeline   string   `json:"pipeline"`
+	Blockchain string   `json:"blockchain,omitempty"`
+	Abi        string   `json:"abi,omitempty"`
+	Abis       []string `json:"abis,omitempty"`
+	Type       string   `json:"type"`
+	Enabled    bool     `json:"enabled"`
+	StartBlock uint64   `json:"startBlock"`
+	// EndBlock bounds the source to [StartBlock, EndBlock]; 0 indexes forever.
+	EndBlock uint64 `json:"endBlock,omitempty"`
+	// CatchUpWorkers is the number of ranges fetched concurrently while the
+	// source is far behind the head; 0 and 1 index sequentially.
+	CatchUpWorkers uint `json:"catchUpWorkers,omitempty"`
 
 	Address string `json:"address,omitempty"`
+	// Addresses (Type == TRANSACTIONS or TRACES) and FunctionSelector (Type ==
+	// TRANSACTIONS): the addresses matched, taking precedence over Address, and
+	// the optional selector.
+	Addresses        []string `json:"addresses,omitempty"`
+	FunctionSelector string   `json:"functionSelector,omitempty"`
 
 	Topic0       string   `json:"topic0,omitempty"`
+	Topic0s      []string `json:"topic0s,omitempty"`
 	TopicFilters []string `json:"topicFilters,omitempty"`
 
 	// FactoryRules (Type == FACTORY): the creation rules, recursive.
@@ -121,8 +162,11 @@ type ConfigSource struct {
 }
 
 // ConfigFactoryRule is one creation rule of a FACTORY source, referencing the
-// child ABI by contract name. ChildRules apply when ChildType is FACTORY.
+// child ABI by contract name. ChildRules apply when ChildType is FACTORY. A
+// TRACE rule matches the contracts created in the factory's traces and needs
+// no creation function or argument.
 type ConfigFactoryRule struct {
+	Trigger               string                       `json:"trigger,omitempty"` // EVENT (default) | TRACE
 	CreationFunctionName  string                       `json:"creationFunctionName"`
 	CreationAddressLogArg string                       `json:"creationAddressLogArg"`
 	ChildAbi              string                       `json:"childAbi"`  // ABI by contractName
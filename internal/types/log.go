@@ -1,10 +1,38 @@
 package types
 
+import (
+	"encoding/json"
+	"strings"
+)
+
 type EvmMetadata struct {
 	ContractName string
 	EventName    string
 	FunctionName string
-	Data         map[string]string
+	// Data holds the decoded arguments as strings; tuples and arrays are
+	// canonical JSON objects and arrays.
+	Data map[string]string
+	// Args is the schema of Data's arguments, in ABI order, for the stores
+	// writing typed values (see TypedArgs). It is set on decoded logs and
+	// transactions and not read back from the stores.
+	Args []EvmArgSchema
+}
+
+// DecodedData returns Data with its JSON object and array values parsed, for
+// the stores that index nested documents. Scalars stay strings.
+func (m EvmMetadata) DecodedData() map[string]any {
+	decoded := make(map[string]any, len(m.Data))
+	for k, v := range m.Data {
+		decoded[k] = v
+		if !strings.HasPrefix(v, "{") && !strings.HasPrefix(v, "[") {
+			continue
+		}
+		var parsed any
+		if err := json.Unmarshal([]byte(v), &parsed); err == nil {
+			decoded[k] = parsed
+		}
+	}
+	return decoded
 }
 
 type EvmLog struct {
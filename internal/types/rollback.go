@@ -0,0 +1,11 @@
+package types
+
+// SourceRollback is emitted on the source.rollback bus topic when a source drops
+// blocks orphaned by a chain reorganization: every log and transaction it stored
+// at or above FromBlock was removed and is re-indexed from the canonical chain.
+type SourceRollback struct {
+	SourceId   uint
+	PipelineId uint
+	ChainId    uint64
+	FromBlock  uint64
+}
@@ -0,0 +1,193 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// EvmArgSchema describes one decoded argument: its ABI name, canonical
+// Solidity type (uint256, address[], (address,uint24)...) and whether it is an
+// indexed event topic. Tuples, and arrays of tuples, list their components.
+type EvmArgSchema struct {
+	Name       string
+	Type       string
+	Indexed    bool
+	Components []EvmArgSchema
+}
+
+// EvmEntrySchema describes an event or function of an ABI. Selector is the
+// event's topic0 or the function's 4-byte selector, 0x-hex.
+type EvmEntrySchema struct {
+	Name      string
+	Signature string
+	Selector  string
+	Args      []EvmArgSchema
+}
+
+// EvmAbiSchema is the schema of the events and functions of an ABI, sorted by
+// signature.
+type EvmAbiSchema struct {
+	Events    []EvmEntrySchema
+	Functions []EvmEntrySchema
+}
+
+// NewEvmAbiSchema derives the schema of parsed.
+func NewEvmAbiSchema(parsed abi.ABI) EvmAbiSchema {
+	schema := EvmAbiSchema{}
+	for _, event := range parsed.Events {
+		schema.Events = append(schema.Events, EventSchema(event))
+	}
+	for _, method := range parsed.Methods {
+		schema.Functions = append(schema.Functions, FunctionSchema(method))
+	}
+	sort.Slice(schema.Events, func(i, j int) bool { return schema.Events[i].Signature < schema.Events[j].Signature })
+	sort.Slice(schema.Functions, func(i, j int) bool { return schema.Functions[i].Signature < schema.Functions[j].Signature })
+	return schema
+}
+
+// EventSchema is the schema of one event.
+func EventSchema(event abi.Event) EvmEntrySchema {
+	return EvmEntrySchema{
+		Name:      event.RawName,
+		Signature: event.Sig,
+		Selector:  event.ID.Hex(),
+		Args:      argsSchema(event.Inputs),
+	}
+}
+
+// FunctionSchema is the schema of one function, its inputs as arguments.
+func FunctionSchema(method abi.Method) EvmEntrySchema {
+	return EvmEntrySchema{
+		Name:      method.RawName,
+		Signature: method.Sig,
+		Selector:  hexutil.Encode(method.ID),
+		Args:      argsSchema(method.Inputs),
+	}
+}
+
+// argsSchema names unnamed arguments arg0, arg1, ... as the decoders do.
+func argsSchema(args abi.Arguments) []EvmArgSchema {
+	schema := make([]EvmArgSchema, len(args))
+	for i, arg := range args {
+		schema[i] = typeSchema(arg.Name, arg.Type)
+		schema[i].Indexed = arg.Indexed
+		if schema[i].Name == "" {
+			schema[i].Name = fmt.Sprintf("arg%d", i)
+		}
+	}
+	return schema
+}
+
+func typeSchema(name string, t abi.Type) EvmArgSchema {
+	arg := EvmArgSchema{Name: name, Type: t.String()}
+	elem := &t
+	for elem.Elem != nil && (elem.T == abi.SliceTy || elem.T == abi.ArrayTy) {
+		elem = elem.Elem
+	}
+	if elem.T == abi.TupleTy {
+		for i, component := range elem.TupleElems {
+			arg.Components = append(arg.Components, typeSchema(elem.TupleRawNames[i], *component))
+		}
+	}
+	return arg
+}
+
+// EvmTypedArgs are the decoded arguments of a log, typed after its schema, for
+// the stores that keep typed columns. Arguments of other types (bytes,
+// strings, tuples, arrays of those) are only in Data.
+type EvmTypedArgs struct {
+	Uint         map[string]*big.Int
+	Int          map[string]*big.Int
+	Bool         map[string]bool
+	Address      map[string]string
+	UintArray    map[string][]*big.Int
+	IntArray     map[string][]*big.Int
+	AddressArray map[string][]string
+}
+
+// TypedArgs parses Data after Args. Values that do not parse (a partial
+// decode) are left out.
+func (m EvmMetadata) TypedArgs() EvmTypedArgs {
+	typed := EvmTypedArgs{
+		Uint:         map[string]*big.Int{},
+		Int:          map[string]*big.Int{},
+		Bool:         map[string]bool{},
+		Address:      map[string]string{},
+		UintArray:    map[string][]*big.Int{},
+		IntArray:     map[string][]*big.Int{},
+		AddressArray: map[string][]string{},
+	}
+	for _, arg := range m.Args {
+		value, ok := m.Data[arg.Name]
+		if !ok {
+			continue
+		}
+
+		elemType, isArray := strings.CutSuffix(arg.Type, "[]")
+		if !isArray {
+			if i := strings.LastIndex(arg.Type, "["); i > 0 && strings.HasSuffix(arg.Type, "]") {
+				elemType, isArray = arg.Type[:i], true
+			}
+		}
+		// Indexed arrays are topic hashes, not values.
+		if isArray && (arg.Indexed || strings.Contains(elemType, "[") || strings.HasPrefix(elemType, "(")) {
+			continue
+		}
+
+		if !isArray {
+			switch {
+			case strings.HasPrefix(arg.Type, "uint"):
+				if n, ok := new(big.Int).SetString(value, 10); ok {
+					typed.Uint[arg.Name] = n
+				}
+			case strings.HasPrefix(arg.Type, "int"):
+				if n, ok := new(big.Int).SetString(value, 10); ok {
+					typed.Int[arg.Name] = n
+				}
+			case arg.Type == "bool":
+				typed.Bool[arg.Name] = value == "true"
+			case arg.Type == "address":
+				typed.Address[arg.Name] = value
+			}
+			continue
+		}
+
+		var items []string
+		if err := json.Unmarshal([]byte(value), &items); err != nil {
+			continue
+		}
+		switch {
+		case strings.HasPrefix(elemType, "uint"), strings.HasPrefix(elemType, "int"):
+			numbers, ok := parseIntegers(items)
+			if !ok {
+				continue
+			}
+			if strings.HasPrefix(elemType, "uint") {
+				typed.UintArray[arg.Name] = numbers
+			} else {
+				typed.IntArray[arg.Name] = numbers
+			}
+		case elemType == "address":
+			typed.AddressArray[arg.Name] = items
+		}
+	}
+	return typed
+}
+
+func parseIntegers(items []string) ([]*big.Int, bool) {
+	numbers := make([]*big.Int, len(items))
+	for i, item := range items {
+		n, ok := new(big.Int).SetString(item, 10)
+		if !ok {
+			return nil, false
+		}
+		numbers[i] = n
+	}
+	return numbers, true
+}
@@ -0,0 +1,238 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+)
+
+var identifierRegex = regexp.MustCompile(`^[A-Za-z_$][A-Za-z0-9_$]*$`)
+
+// ParseEventSignatures parses a signature file: a JSON ABI, whose events are
+// returned sorted by signature, or one human-readable event signature per line
+// (see ParseEventSignature). Blank lines and lines starting with # or // are
+// skipped. Anonymous events, which have no topic0, are left out.
+func ParseEventSignatures(content string) ([]abi.Event, error) {
+	trimmed := strings.TrimSpace(content)
+	if strings.HasPrefix(trimmed, "[") {
+		parsed, err := abi.JSON(strings.NewReader(trimmed))
+		if err != nil {
+			return nil, err
+		}
+		var events []abi.Event
+		for _, event := range parsed.Events {
+			if !event.Anonymous {
+				events = append(events, event)
+			}
+		}
+		sort.Slice(events, func(i, j int) bool { return events[i].Sig < events[j].Sig })
+		return events, nil
+	}
+
+	var events []abi.Event
+	for i, line := range strings.Split(content, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, "//") {
+			continue
+		}
+		event, err := ParseEventSignature(line)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", i+1, err)
+		}
+		events = append(events, event)
+	}
+	return events, nil
+}
+
+// ParseEventSignature parses a human-readable event signature, optionally
+// prefixed with "event": Transfer(address indexed from, address indexed to,
+// uint256 value). Argument names are optional; tuples are written as their
+// parenthesized components, e.g. (uint256 amount, address token)[] orders.
+// Without "indexed" markers, every argument is read from the log data.
+func ParseEventSignature(signature string) (abi.Event, error) {
+	s := strings.TrimSpace(strings.TrimSuffix(strings.TrimSpace(signature), ";"))
+	s = strings.TrimSpace(strings.TrimPrefix(s, "event "))
+	open := strings.Index(s, "(")
+	if open < 0 || !strings.HasSuffix(s, ")") {
+		return abi.Event{}, fmt.Errorf("invalid event signature %q", signature)
+	}
+	name := strings.TrimSpace(s[:open])
+	if !identifierRegex.MatchString(name) {
+		return abi.Event{}, fmt.Errorf("invalid event name %q", name)
+	}
+
+	params, err := splitParams(s[open+1 : len(s)-1])
+	if err != nil {
+		return abi.Event{}, fmt.Errorf("invalid event signature %q: %w", signature, err)
+	}
+	args := make(abi.Arguments, len(params))
+	for i, param := range params {
+		marshaling, indexed, err := parseParam(param)
+		if err != nil {
+			return abi.Event{}, fmt.Errorf("invalid event signature %q: %w", signature, err)
+		}
+		typ, err := abi.NewType(marshaling.Type, "", marshaling.Components)
+		if err != nil {
+			return abi.Event{}, fmt.Errorf("invalid event signature %q: %w", signature, err)
+		}
+		args[i] = abi.Argument{Name: marshaling.Name, Type: typ, Indexed: indexed}
+	}
+	return abi.NewEvent(name, name, false, args), nil
+}
+
+// FormatEventSignature is the human-readable signature of event, with its
+// argument names and indexed markers, as parsed by ParseEventSignature.
+func FormatEventSignature(event abi.Event) string {
+	params := make([]string, len(event.Inputs))
+	for i, input := range event.Inputs {
+		param := formatType(input.Type)
+		if input.Indexed {
+			param += " indexed"
+		}
+		if input.Name != "" {
+			param += " " + input.Name
+		}
+		params[i] = param
+	}
+	return fmt.Sprintf("%s(%s)", event.RawName, strings.Join(params, ", "))
+}
+
+// formatType writes tuples, and arrays of tuples, with their named components.
+func formatType(t abi.Type) string {
+	elem := &t
+	for elem.Elem != nil && (elem.T == abi.SliceTy || elem.T == abi.ArrayTy) {
+		elem = elem.Elem
+	}
+	if elem.T != abi.TupleTy {
+		return t.String()
+	}
+	components := make([]string, len(elem.TupleElems))
+	for i, component := range elem.TupleElems {
+		components[i] = formatType(*component) + " " + elem.TupleRawNames[i]
+	}
+	suffix := strings.TrimPrefix(t.String(), elem.String())
+	return "(" + strings.Join(components, ", ") + ")" + suffix
+}
+
+// splitParams splits a parameter list at its top-level commas.
+func splitParams(s string) ([]string, error) {
+	if strings.TrimSpace(s) == "" {
+		return nil, nil
+	}
+	var params []string
+	depth, start := 0, 0
+	for i, c := range s {
+		switch c {
+		case '(':
+			depth++
+		case ')':
+			depth--
+			if depth < 0 {
+				return nil, errors.New("unbalanced parentheses")
+			}
+		case ',':
+			if depth == 0 {
+				params = append(params, s[start:i])
+				start = i + 1
+			}
+		}
+	}
+	if depth != 0 {
+		return nil, errors.New("unbalanced parentheses")
+	}
+	return append(params, s[start:]), nil
+}
+
+// parseParam parses "type [indexed] [name]" into the ABI JSON form of the
+// argument. Unnamed tuple components are named arg0, arg1, ... as unnamed
+// arguments are.
+func parseParam(param string) (abi.ArgumentMarshaling, bool, error) {
+	param = strings.TrimSpace(param)
+	var marshaling abi.ArgumentMarshaling
+	var rest string
+	if strings.HasPrefix(param, "tuple(") {
+		param = strings.TrimPrefix(param, "tuple")
+	}
+	if strings.HasPrefix(param, "(") {
+		end := closingParen(param)
+		if end < 0 {
+			return marshaling, false, errors.New("unbalanced parentheses")
+		}
+		components, err := splitParams(param[1:end])
+		if err != nil {
+			return marshaling, false, err
+		}
+		for i, component := range components {
+			componentMarshaling, indexed, err := parseParam(component)
+			if err != nil {
+				return marshaling, false, err
+			}
+			if indexed {
+				return marshaling, false, errors.New("tuple components cannot be indexed")
+			}
+			if componentMarshaling.Name == "" {
+				componentMarshaling.Name = fmt.Sprintf("arg%d", i)
+			}
+			marshaling.Components = append(marshaling.Components, componentMarshaling)
+		}
+		suffix, after, _ := strings.Cut(param[end+1:], " ")
+		marshaling.Type = "tuple" + suffix
+		rest = after
+	} else {
+		typ, after, _ := strings.Cut(param, " ")
+		marshaling.Type = canonicalType(typ)
+		rest = after
+	}
+
+	fields := strings.Fields(rest)
+	indexed := len(fields) > 0 && fields[0] == "indexed"
+	if indexed {
+		fields = fields[1:]
+	}
+	switch {
+	case len(fields) > 1:
+		return marshaling, false, fmt.Errorf("invalid parameter %q", param)
+	case len(fields) == 1:
+		if !identifierRegex.MatchString(fields[0]) {
+			return marshaling, false, fmt.Errorf("invalid parameter name %q", fields[0])
+		}
+		marshaling.Name = fields[0]
+	}
+	return marshaling, indexed, nil
+}
+
+// closingParen is the index of the parenthesis closing the one s starts with.
+func closingParen(s string) int {
+	depth := 0
+	for i, c := range s {
+		switch c {
+		case '(':
+			depth++
+		case ')':
+			depth--
+			if depth == 0 {
+				return i
+			}
+		}
+	}
+	return -1
+}
+
+// canonicalType expands the uint and int aliases, array suffixes included.
+func canonicalType(typ string) string {
+	base, suffix := typ, ""
+	if i := strings.Index(typ, "["); i >= 0 {
+		base, suffix = typ[:i], typ[i:]
+	}
+	switch base {
+	case "uint":
+		base = "uint256"
+	case "int":
+		base = "int256"
+	}
+	return base + suffix
+}
@@ -0,0 +1,32 @@
+package types
+
+// EvmTrace is one call frame of a transaction's execution (an internal call,
+// a value transfer, a contract creation or a self-destruct) indexed by a
+// TRACES source.
+type EvmTrace struct {
+	Id               string // chainId:sourceId:transactionHash:traceAddress
+	SourceId         uint
+	ChainId          uint64
+	BlockNumber      uint64
+	BlockTimestamp   uint64 // unix seconds
+	TransactionHash  string
+	TransactionIndex uint64
+	// TraceAddress is the frame's position in the call tree, its indices
+	// joined by dots: empty for the transaction's top call, "0.1" for the
+	// second call of its first call.
+	TraceAddress string
+
+	// Type is CALL, STATICCALL, DELEGATECALL, CALLCODE, CREATE, CREATE2 or
+	// SELFDESTRUCT. To is the created contract of a creation, and the
+	// beneficiary of a self-destruct.
+	Type    string
+	From    string
+	To      string
+	Value   string // wei
+	Input   string // hex, the init code of a creation
+	Output  string
+	Gas     uint64
+	GasUsed uint64
+	// Error is the reason the frame reverted, empty when it succeeded.
+	Error string
+}
@@ -13,6 +13,18 @@ type EvmTransaction struct {
 	Nonce            uint64
 	To               string
 	Hash             string
+	Type             uint64 // EIP-2718 type: 0 legacy, 1 access list, 2 dynamic fee, 3 blob, 4 set code
+
+	// Execution results, from the transaction receipt.
+	Status            uint64 // 1 success, 0 reverted
+	GasUsed           uint64
+	EffectiveGasPrice string // wei
+	ContractAddress   string // contract created by the transaction, empty otherwise
+
+	// EIP-4844 blob fields, zero for other transaction types.
+	BlobGasUsed         uint64
+	BlobGasPrice        string // wei
+	BlobVersionedHashes []string
 
 	Metadata EvmMetadata
 }
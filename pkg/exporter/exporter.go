@@ -98,3 +98,33 @@ type ConfigField struct {
 type Configurable interface {
 	ConfigSchema() []ConfigField
 }
+
+// RollbackEvent tells a plugin that a chain reorganization orphaned blocks it
+// may already have received: every log of SourceId at or above FromBlock was
+// removed from the log store and will be delivered again from the canonical
+// chain.
+type RollbackEvent struct {
+	SourceId  uint
+	ChainId   uint64
+	FromBlock uint64
+}
+
+// Rollbacker is an optional interface. A plugin implementing it is told when
+// logs it was delivered are invalidated by a reorg, so it can undo their
+// effects downstream before the canonical logs are replayed. Returning an error
+// stops the exporter; the rollback is retried on the next start. Plugins that do
+// not implement it only see the replay, which upsert-by-Id handles for logs that
+// survived the reorg but not for logs that were dropped.
+type Rollbacker interface {
+	Rollback(event RollbackEvent) error
+}
+
+// Completer is an optional interface. A plugin implementing it is told when
+// every source of its pipeline is bounded and completed, and every log they
+// indexed was delivered: the exporter then finishes with the COMPLETED status
+// instead of waiting for new logs, calling Close right after. Returning an
+// error stops the exporter; the completion is retried on the next start. It is
+// called again when a completed exporter is restarted.
+type Completer interface {
+	Complete() error
+}
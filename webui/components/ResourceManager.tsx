@@ -585,6 +585,7 @@ function topicPlaceholder(type: string): string {
 // of these; a rule whose child is a FACTORY carries its own childRules (recursive).
 type FactoryRuleCondition = { arg?: string; operator?: string; value?: string };
 type FactoryRule = {
+  trigger?: "EVENT" | "TRACE";
   creationFunctionName?: string;
   creationAddressLogArg?: string;
   evmJsonAbiId?: number;
@@ -595,6 +596,14 @@ type FactoryRule = {
 
 const CONDITION_OPS = ["eq", "neq", "gt", "gte", "lt", "lte", "contains"];
 
+// The args a TRACE rule's conditions read from a contract creation.
+const TRACE_CREATION_ARGS: IndexedArg[] = [
+  { name: "from", type: "address" },
+  { name: "address", type: "address" },
+  { name: "value", type: "uint256" },
+  { name: "type", type: "string" },
+];
+
 // ConditionsEditor edits a rule's conditions (arg / operator / value); a child is
 // created only when all pass. The arg is picked from the creation event's args.
 function ConditionsEditor({
@@ -747,31 +756,49 @@ function RuleList({
             </button>
           </div>
           <div>
-            <label>Creation event name</label>
-            {/* Events of the ABI this rule's factory is decoded with; reset the arg when the event changes. */}
-            <select value={r.creationFunctionName ?? ""} onChange={(e) => update(i, { creationFunctionName: e.target.value, creationAddressLogArg: undefined })}>
-              <option value="">{ownerAbiId ? "—" : "select an ABI first"}</option>
-              {events.map((ev) => (
-                <option key={ev.name} value={ev.name}>
-                  {ev.name}
-                </option>
-              ))}
-            </select>
-          </div>
-          <div>
-            <label>Creation address arg</label>
-            <select value={r.creationAddressLogArg ?? ""} onChange={(e) => update(i, { creationAddressLogArg: e.target.value })}>
-              <option value="">—</option>
-              {(events.find((ev) => ev.name === r.creationFunctionName)?.inputs ?? []).map((p) => (
-                <option key={p.name} value={p.name}>
-                  {p.name} ({p.type})
-                </option>
-              ))}
+            <label>Trigger</label>
+            {/* A TRACE rule spawns a child for every contract the factory deploys, read from its traces. */}
+            <select
+              value={r.trigger || "EVENT"}
+              onChange={(e) => {
+                const t = e.target.value as "EVENT" | "TRACE";
+                update(i, { trigger: t, creationFunctionName: undefined, creationAddressLogArg: undefined, conditions: [] });
+              }}
+            >
+              <option value="EVENT">Creation event</option>
+              <option value="TRACE">Contract creation trace</option>
             </select>
           </div>
+          {r.trigger !== "TRACE" && (
+            <>
+              <div>
+                <label>Creation event name</label>
+                {/* Events of the ABI this rule's factory is decoded with; reset the arg when the event changes. */}
+                <select value={r.creationFunctionName ?? ""} onChange={(e) => update(i, { creationFunctionName: e.target.value, creationAddressLogArg: undefined })}>
+                  <option value="">{ownerAbiId ? "—" : "select an ABI first"}</option>
+                  {events.map((ev) => (
+                    <option key={ev.name} value={ev.name}>
+                      {ev.name}
+                    </option>
+                  ))}
+                </select>
+              </div>
+              <div>
+                <label>Creation address arg</label>
+                <select value={r.creationAddressLogArg ?? ""} onChange={(e) => update(i, { creationAddressLogArg: e.target.value })}>
+                  <option value="">—</option>
+                  {(events.find((ev) => ev.name === r.creationFunctionName)?.inputs ?? []).map((p) => (
+                    <option key={p.name} value={p.name}>
+                      {p.name} ({p.type})
+                    </option>
+                  ))}
+                </select>
+              </div>
+            </>
+          )}
           <ConditionsEditor
             conditions={r.conditions ?? []}
-            eventArgs={events.find((ev) => ev.name === r.creationFunctionName)?.inputs ?? []}
+            eventArgs={r.trigger === "TRACE" ? TRACE_CREATION_ARGS : events.find((ev) => ev.name === r.creationFunctionName)?.inputs ?? []}
             onChange={(c) => update(i, { conditions: c })}
           />
           <div>
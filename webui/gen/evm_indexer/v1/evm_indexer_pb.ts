@@ -10,7 +10,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file evm_indexer/v1/evm_indexer.proto.
  */
 export const file_evm_indexer_v1_evm_indexer: GenFile = /*@__PURE__*/
-  fileDesc("CiBldm1faW5kZXhlci92MS9ldm1faW5kZXhlci5wcm90bxIOZXZtX2luZGV4ZXIudjEi0QEKDEV2bWlJbnN0YW5jZRIPCgJpZBgBIAEoDUgAiAEBEhMKC2luc3RhbmNlX2lkGAIgASgJEgwKBGlwdjQYAyABKAkSDgoGc3RhdHVzGAQgASgJEhcKCmNyZWF0ZWRfYXQYBSABKA1IAYgBARIXCgp1cGRhdGVkX2F0GAYgASgNSAKIAQESFwoKZGVsZXRlZF9hdBgHIAEoDUgDiAEBQgUKA19pZEINCgtfY3JlYXRlZF9hdEINCgtfdXBkYXRlZF9hdEINCgtfZGVsZXRlZF9hdCKtAgoNRXZtQmxvY2tjaGFpbhIPCgJpZBgBIAEoDUgAiAEBEhAKCGNoYWluX2lkGAIgASgEEgwKBG5hbWUYAyABKAkSDwoHcnBjX3VybBgEIAEoCRITCgtibG9ja19yYW5nZRgFIAEoBBITCgtibG9ja19zbGljZRgGIAEoBBIVCg1wdWxsX2ludGVydmFsGAcgASgEEhoKEnJwY19tYXhfYmF0Y2hfc2l6ZRgIIAEoBBIXCgpjcmVhdGVkX2F0GAkgASgNSAGIAQESFwoKdXBkYXRlZF9hdBgKIAEoDUgCiAEBEhcKCmRlbGV0ZWRfYXQYCyABKA1IA4gBAUIFCgNfaWRCDQoLX2NyZWF0ZWRfYXRCDQoLX3VwZGF0ZWRfYXRCDQoLX2RlbGV0ZWRfYXQixAEKCkV2bUpzb25BYmkSDwoCaWQYASABKA1IAIgBARIVCg1jb250cmFjdF9uYW1lGAIgASgJEg8KB2NvbnRlbnQYAyABKAkSFwoKY3JlYXRlZF9hdBgEIAEoDUgBiAEBEhcKCnVwZGF0ZWRfYXQYBSABKA1IAogBARIXCgpkZWxldGVkX2F0GAYgASgNSAOIAQFCBQoDX2lkQg0KC19jcmVhdGVkX2F0Qg0KC191cGRhdGVkX2F0Qg0KC19kZWxldGVkX2F0IvUBCgtFdm1Mb2dTdG9yZRIPCgJpZBgBIAEoDUgAiAEBEhIKCmlkZW50aWZpZXIYAiABKAkSEwoLZGVzY3JpcHRpb24YAyABKAkSEgoKc3RvcmVfdHlwZRgEIAEoCRIZChFzdG9yZV9jb25maWdfanNvbhgFIAEoCRIXCgpjcmVhdGVkX2F0GAYgASgNSAGIAQESFwoKdXBkYXRlZF9hdBgHIAEoDUgCiAEBEhcKCmRlbGV0ZWRfYXQYCCABKA1IA4gBAUIFCgNfaWRCDQoLX2NyZWF0ZWRfYXRCDQoLX3VwZGF0ZWRfYXRCDQoLX2RlbGV0ZWRfYXQi4gEKDkV2bUxvZ1BpcGVsaW5lEg8KAmlkGAEgASgNSACIAQESDAoEbmFtZRgCIAEoCRIYChBldm1pX2luc3RhbmNlX2lkGAQgASgNEhgKEGV2bV9sb2dfc3RvcmVfaWQYBiABKA0SFwoKY3JlYXRlZF9hdBgHIAEoDUgBiAEBEhcKCnVwZGF0ZWRfYXQYCCABKA1IAogBARIXCgpkZWxldGVkX2F0GAkgASgNSAOIAQFCBQoDX2lkQg0KC19jcmVhdGVkX2F0Qg0KC191cGRhdGVkX2F0Qg0KC19kZWxldGVkX2F0IkQKFEZhY3RvcnlSdWxlQ29uZGl0aW9uEgsKA2FyZxgBIAEoCRIQCghvcGVyYXRvchgCIAEoCRINCgV2YWx1ZRgDIAEoCSKAAgoLRmFjdG9yeVJ1bGUSDwoCaWQYASABKA1IAIgBARIeChZjcmVhdGlvbl9mdW5jdGlvbl9uYW1lGAIgASgJEiAKGGNyZWF0aW9uX2FkZHJlc3NfbG9nX2FyZxgDIAEoCRISCgpjaGlsZF90eXBlGAQgASgJEhcKD2V2bV9qc29uX2FiaV9pZBgFIAEoDRIwCgtjaGlsZF9ydWxlcxgGIAMoCzIbLmV2bV9pbmRleGVyLnYxLkZhY3RvcnlSdWxlEjgKCmNvbmRpdGlvbnMYByADKAsyJC5ldm1faW5kZXhlci52MS5GYWN0b3J5UnVsZUNvbmRpdGlvbkIFCgNfaWQijAQKDEV2bUxvZ1NvdXJjZRIPCgJpZBgBIAEoDUgAiAEBEgwKBHR5cGUYAiABKAkSDwoHZW5hYmxlZBgDIAEoCBIOCgZzdGF0dXMYBCABKAkSEwoLc3RhcnRfYmxvY2sYBSABKAQSEgoKc3luY19ibG9jaxgGIAEoBBIUCgdhZGRyZXNzGAcgASgJSAGIAQESEwoGdG9waWMwGAggASgJSAKIAQESFQoNdG9waWNfZmlsdGVycxgJIAMoCRIYChBwYXJlbnRfc291cmNlX2lkGAwgASgNEjIKDWZhY3RvcnlfcnVsZXMYFyADKAsyGy5ldm1faW5kZXhlci52MS5GYWN0b3J5UnVsZRIbChNldm1fbG9nX3BpcGVsaW5lX2lkGA0gASgNEhcKD2V2bV9qc29uX2FiaV9pZBgOIAEoDRIZChFldm1fYmxvY2tjaGFpbl9pZBgPIAEoDRIXCgpjcmVhdGVkX2F0GBAgASgNSAOIAQESFwoKdXBkYXRlZF9hdBgRIAEoDUgEiAEBEhcKCmRlbGV0ZWRfYXQYEiABKA1IBYgBAUIFCgNfaWRCCgoIX2FkZHJlc3NCCQoHX3RvcGljMEINCgtfY3JlYXRlZF9hdEINCgtfdXBkYXRlZF9hdEINCgtfZGVsZXRlZF9hdEoECAoQC0oECAsQDEoECBQQFUoECBUQFkoECBYQFyLzAQoLRXZtTWV0YWRhdGESGgoNY29udHJhY3RfbmFtZRgBIAEoCUgAiAEBEhcKCmV2ZW50X25hbWUYAiABKAlIAYgBARIaCg1mdW5jdGlvbl9uYW1lGAMgASgJSAKIAQESMwoEZGF0YRgEIAMoCzIlLmV2bV9pbmRleGVyLnYxLkV2bU1ldGFkYXRhLkRhdGFFbnRyeRorCglEYXRhRW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgJOgI4AUIQCg5fY29udHJhY3RfbmFtZUINCgtfZXZlbnRfbmFtZUIQCg5fZnVuY3Rpb25fbmFtZSLNAgoGRXZtTG9nEgoKAmlkGAEgASgJEhAKCGNoYWluX2lkGAIgASgNEhEKCXNvdXJjZV9pZBgDIAEoDRIPCgdhZGRyZXNzGAQgASgJEg4KBnRvcGljcxgFIAMoCRIMCgRkYXRhGAYgASgJEhQKDGJsb2NrX251bWJlchgHIAEoBBIYChB0cmFuc2FjdGlvbl9mcm9tGAggASgJEhgKEHRyYW5zYWN0aW9uX2hhc2gYCSABKAkSGQoRdHJhbnNhY3Rpb25faW5kZXgYCiABKAQSEgoKYmxvY2tfaGFzaBgLIAEoCRIRCglsb2dfaW5kZXgYDCABKAQSDwoHcmVtb3ZlZBgNIAEoCBItCghtZXRhZGF0YRgOIAEoCzIbLmV2bV9pbmRleGVyLnYxLkV2bU1ldGFkYXRhEhcKD2Jsb2NrX3RpbWVzdGFtcBgPIAEoBCKOAgoORXZtVHJhbnNhY3Rpb24SCgoCaWQYASABKAkSEQoJc291cmNlX2lkGAIgASgNEhQKDGJsb2NrX251bWJlchgDIAEoBBIZChF0cmFuc2FjdGlvbl9pbmRleBgEIAEoBBIQCghjaGFpbl9pZBgFIAEoBBIMCgRmcm9tGAYgASgJEgwKBGRhdGEYByABKAkSDQoFdmFsdWUYCCABKAkSDQoFbm9uY2UYCSABKAQSCgoCdG8YCiABKAkSDAoEaGFzaBgLIAEoCRItCghtZXRhZGF0YRgMIAEoCzIbLmV2bV9pbmRleGVyLnYxLkV2bU1ldGFkYXRhEhcKD2Jsb2NrX3RpbWVzdGFtcBgNIAEoBCIrCgpQYWdpbmF0aW9uEg0KBWxpbWl0GAEgASgNEg4KBm9mZnNldBgCIAEoDSIkChZHZXRFdm1pSW5zdGFuY2VSZXF1ZXN0EgoKAmlkGAEgASgNIkkKF0dldEV2bWlJbnN0YW5jZVJlc3BvbnNlEi4KCGluc3RhbmNlGAEgASgLMhwuZXZtX2luZGV4ZXIudjEuRXZtaUluc3RhbmNlIkoKGExpc3RFdm1pSW5zdGFuY2VzUmVxdWVzdBIuCgpwYWdpbmF0aW9uGAEgASgLMhouZXZtX2luZGV4ZXIudjEuUGFnaW5hdGlvbiJMChlMaXN0RXZtaUluc3RhbmNlc1Jlc3BvbnNlEi8KCWluc3RhbmNlcxgBIAMoCzIcLmV2bV9pbmRleGVyLnYxLkV2bWlJbnN0YW5jZSJPChpDcmVhdGVFdm1CbG9ja2NoYWluUmVxdWVzdBIxCgpibG9ja2NoYWluGAEgASgLMh0uZXZtX2luZGV4ZXIudjEuRXZtQmxvY2tjaGFpbiIpChtDcmVhdGVFdm1CbG9ja2NoYWluUmVzcG9uc2USCgoCaWQYASABKA0iJQoXR2V0RXZtQmxvY2tjaGFpblJlcXVlc3QSCgoCaWQYASABKA0iTQoYR2V0RXZtQmxvY2tjaGFpblJlc3BvbnNlEjEKCmJsb2NrY2hhaW4YASABKAsyHS5ldm1faW5kZXhlci52MS5Fdm1CbG9ja2NoYWluIk8KGlVwZGF0ZUV2bUJsb2NrY2hhaW5SZXF1ZXN0EjEKCmJsb2NrY2hhaW4YASABKAsyHS5ldm1faW5kZXhlci52MS5Fdm1CbG9ja2NoYWluIh0KG1VwZGF0ZUV2bUJsb2NrY2hhaW5SZXNwb25zZSJLChlMaXN0RXZtQmxvY2tjaGFpbnNSZXF1ZXN0Ei4KCnBhZ2luYXRpb24YASABKAsyGi5ldm1faW5kZXhlci52MS5QYWdpbmF0aW9uIlAKGkxpc3RFdm1CbG9ja2NoYWluc1Jlc3BvbnNlEjIKC2Jsb2NrY2hhaW5zGAEgAygLMh0uZXZtX2luZGV4ZXIudjEuRXZtQmxvY2tjaGFpbiIoChpEZWxldGVFdm1CbG9ja2NoYWluUmVxdWVzdBIKCgJpZBgBIAEoDSIdChtEZWxldGVFdm1CbG9ja2NoYWluUmVzcG9uc2UiQgoXQ3JlYXRlRXZtSnNvbkFiaVJlcXVlc3QSJwoDYWJpGAEgASgLMhouZXZtX2luZGV4ZXIudjEuRXZtSnNvbkFiaSImChhDcmVhdGVFdm1Kc29uQWJpUmVzcG9uc2USCgoCaWQYASABKA0iIgoUR2V0RXZtSnNvbkFiaVJlcXVlc3QSCgoCaWQYASABKA0iQAoVR2V0RXZtSnNvbkFiaVJlc3BvbnNlEicKA2FiaRgBIAEoCzIaLmV2bV9pbmRleGVyLnYxLkV2bUpzb25BYmkiQgoXVXBkYXRlRXZtSnNvbkFiaVJlcXVlc3QSJwoDYWJpGAEgASgLMhouZXZtX2luZGV4ZXIudjEuRXZtSnNvbkFiaSIaChhVcGRhdGVFdm1Kc29uQWJpUmVzcG9uc2UiSAoWTGlzdEV2bUpzb25BYmlzUmVxdWVzdBIuCgpwYWdpbmF0aW9uGAEgASgLMhouZXZtX2luZGV4ZXIudjEuUGFnaW5hdGlvbiJDChdMaXN0RXZtSnNvbkFiaXNSZXNwb25zZRIoCgRhYmlzGAEgAygLMhouZXZtX2luZGV4ZXIudjEuRXZtSnNvbkFiaSIlChdEZWxldGVFdm1Kc29uQWJpUmVxdWVzdBIKCgJpZBgBIAEoDSIaChhEZWxldGVFdm1Kc29uQWJpUmVzcG9uc2UiRgoYQ3JlYXRlRXZtTG9nU3RvcmVSZXF1ZXN0EioKBXN0b3JlGAEgASgLMhsuZXZtX2luZGV4ZXIudjEuRXZtTG9nU3RvcmUiJwoZQ3JlYXRlRXZtTG9nU3RvcmVSZXNwb25zZRIKCgJpZBgBIAEoDSIjChVHZXRFdm1Mb2dTdG9yZVJlcXVlc3QSCgoCaWQYASABKA0iRAoWR2V0RXZtTG9nU3RvcmVSZXNwb25zZRIqCgVzdG9yZRgBIAEoCzIbLmV2bV9pbmRleGVyLnYxLkV2bUxvZ1N0b3JlIkYKGFVwZGF0ZUV2bUxvZ1N0b3JlUmVxdWVzdBIqCgVzdG9yZRgBIAEoCzIbLmV2bV9pbmRleGVyLnYxLkV2bUxvZ1N0b3JlIhsKGVVwZGF0ZUV2bUxvZ1N0b3JlUmVzcG9uc2UiSQoXTGlzdEV2bUxvZ1N0b3Jlc1JlcXVlc3QSLgoKcGFnaW5hdGlvbhgBIAEoCzIaLmV2bV9pbmRleGVyLnYxLlBhZ2luYXRpb24iRwoYTGlzdEV2bUxvZ1N0b3Jlc1Jlc3BvbnNlEisKBnN0b3JlcxgBIAMoCzIbLmV2bV9pbmRleGVyLnYxLkV2bUxvZ1N0b3JlIiYKGERlbGV0ZUV2bUxvZ1N0b3JlUmVxdWVzdBIKCgJpZBgBIAEoDSIbChlEZWxldGVFdm1Mb2dTdG9yZVJlc3BvbnNlIk8KG0NyZWF0ZUV2bUxvZ1BpcGVsaW5lUmVxdWVzdBIwCghwaXBlbGluZRgBIAEoCzIeLmV2bV9pbmRleGVyLnYxLkV2bUxvZ1BpcGVsaW5lIioKHENyZWF0ZUV2bUxvZ1BpcGVsaW5lUmVzcG9uc2USCgoCaWQYASABKA0iJgoYR2V0RXZtTG9nUGlwZWxpbmVSZXF1ZXN0EgoKAmlkGAEgASgNIk0KGUdldEV2bUxvZ1BpcGVsaW5lUmVzcG9uc2USMAoIcGlwZWxpbmUYASABKAsyHi5ldm1faW5kZXhlci52MS5Fdm1Mb2dQaXBlbGluZSJPChtVcGRhdGVFdm1Mb2dQaXBlbGluZVJlcXVlc3QSMAoIcGlwZWxpbmUYASABKAsyHi5ldm1faW5kZXhlci52MS5Fdm1Mb2dQaXBlbGluZSIeChxVcGRhdGVFdm1Mb2dQaXBlbGluZVJlc3BvbnNlIkwKGkxpc3RFdm1Mb2dQaXBlbGluZXNSZXF1ZXN0Ei4KCnBhZ2luYXRpb24YASABKAsyGi5ldm1faW5kZXhlci52MS5QYWdpbmF0aW9uIlAKG0xpc3RFdm1Mb2dQaXBlbGluZXNSZXNwb25zZRIxCglwaXBlbGluZXMYASADKAsyHi5ldm1faW5kZXhlci52MS5Fdm1Mb2dQaXBlbGluZSIpChtEZWxldGVFdm1Mb2dQaXBlbGluZVJlcXVlc3QSCgoCaWQYASABKA0iHgocRGVsZXRlRXZtTG9nUGlwZWxpbmVSZXNwb25zZSJJChlDcmVhdGVFdm1Mb2dTb3VyY2VSZXF1ZXN0EiwKBnNvdXJjZRgBIAEoCzIcLmV2bV9pbmRleGVyLnYxLkV2bUxvZ1NvdXJjZSIoChpDcmVhdGVFdm1Mb2dTb3VyY2VSZXNwb25zZRIKCgJpZBgBIAEoDSIkChZHZXRFdm1Mb2dTb3VyY2VSZXF1ZXN0EgoKAmlkGAEgASgNIkcKF0dldEV2bUxvZ1NvdXJjZVJlc3BvbnNlEiwKBnNvdXJjZRgBIAEoCzIcLmV2bV9pbmRleGVyLnYxLkV2bUxvZ1NvdXJjZSJJChlVcGRhdGVFdm1Mb2dTb3VyY2VSZXF1ZXN0EiwKBnNvdXJjZRgBIAEoCzIcLmV2bV9pbmRleGVyLnYxLkV2bUxvZ1NvdXJjZSIcChpVcGRhdGVFdm1Mb2dTb3VyY2VSZXNwb25zZSJfChhMaXN0RXZtTG9nU291cmNlc1JlcXVlc3QSLgoKcGFnaW5hdGlvbhgBIAEoCzIaLmV2bV9pbmRleGVyLnYxLlBhZ2luYXRpb24SEwoLcGlwZWxpbmVfaWQYAiABKA0iSgoZTGlzdEV2bUxvZ1NvdXJjZXNSZXNwb25zZRItCgdzb3VyY2VzGAEgAygLMhwuZXZtX2luZGV4ZXIudjEuRXZtTG9nU291cmNlIicKGURlbGV0ZUV2bUxvZ1NvdXJjZVJlcXVlc3QSCgoCaWQYASABKA0iHAoaRGVsZXRlRXZtTG9nU291cmNlUmVzcG9uc2UiNwogU3RyZWFtRXZtTG9nU291cmNlVXBkYXRlc1JlcXVlc3QSEwoLcGlwZWxpbmVfaWQYASABKA0iJwoZU3RhcnRTb3VyY2VJbmRleGVyUmVxdWVzdBIKCgJpZBgBIAEoDSI8ChpTdGFydFNvdXJjZUluZGV4ZXJSZXNwb25zZRIPCgdzdWNjZXNzGAEgASgIEg0KBWVycm9yGAIgASgJIiYKGFN0b3BTb3VyY2VJbmRleGVyUmVxdWVzdBIKCgJpZBgBIAEoDSI7ChlTdG9wU291cmNlSW5kZXhlclJlc3BvbnNlEg8KB3N1Y2Nlc3MYASABKAgSDQoFZXJyb3IYAiABKAkiTQoSTGlzdEV2bUxvZ3NSZXF1ZXN0EhEKCXNvdXJjZV9pZBgBIAEoDRISCgpmcm9tX2Jsb2NrGAIgASgEEhAKCHRvX2Jsb2NrGAMgASgEIjsKE0xpc3RFdm1Mb2dzUmVzcG9uc2USJAoEbG9ncxgBIAMoCzIWLmV2bV9pbmRleGVyLnYxLkV2bUxvZyI8ChhMaXN0TGF0ZXN0RXZtTG9nc1JlcXVlc3QSEQoJc291cmNlX2lkGAEgASgNEg0KBWxpbWl0GAIgASgEIkEKGUxpc3RMYXRlc3RFdm1Mb2dzUmVzcG9uc2USJAoEbG9ncxgBIAMoCzIWLmV2bV9pbmRleGVyLnYxLkV2bUxvZyJVChpMaXN0RXZtVHJhbnNhY3Rpb25zUmVxdWVzdBIRCglzb3VyY2VfaWQYASABKA0SEgoKZnJvbV9ibG9jaxgCIAEoBBIQCgh0b19ibG9jaxgDIAEoBCJTChtMaXN0RXZtVHJhbnNhY3Rpb25zUmVzcG9uc2USNAoMdHJhbnNhY3Rpb25zGAEgAygLMh4uZXZtX2luZGV4ZXIudjEuRXZtVHJhbnNhY3Rpb24iRQoIQXV0aFVzZXISCgoCaWQYASABKA0SEAoIdXNlcm5hbWUYAiABKAkSDQoFZW1haWwYAyABKAkSDAoEcm9sZRgEIAEoCSKnAQoPQWNjZXNzVG9rZW5JbmZvEgoKAmlkGAEgASgNEgwKBG5hbWUYAiABKAkSFwoKY3JlYXRlZF9hdBgDIAEoA0gAiAEBEhcKCmV4cGlyZXNfYXQYBCABKANIAYgBARIZCgxsYXN0X3VzZWRfYXQYBSABKANIAogBAUINCgtfY3JlYXRlZF9hdEINCgtfZXhwaXJlc19hdEIPCg1fbGFzdF91c2VkX2F0IjIKDExvZ2luUmVxdWVzdBIQCgh1c2VybmFtZRgBIAEoCRIQCghwYXNzd29yZBgCIAEoCSJGCg1Mb2dpblJlc3BvbnNlEg0KBXRva2VuGAEgASgJEhcKCmV4cGlyZXNfYXQYAiABKANIAIgBAUINCgtfZXhwaXJlc19hdCILCglNZVJlcXVlc3QiNAoKTWVSZXNwb25zZRImCgR1c2VyGAEgASgLMhguZXZtX2luZGV4ZXIudjEuQXV0aFVzZXIiQQoYQ3JlYXRlQWNjZXNzVG9rZW5SZXF1ZXN0EgwKBG5hbWUYASABKAkSFwoPZXhwaXJlc19pbl9kYXlzGAIgASgNImwKGUNyZWF0ZUFjY2Vzc1Rva2VuUmVzcG9uc2USCgoCaWQYASABKA0SDAoEbmFtZRgCIAEoCRINCgV0b2tlbhgDIAEoCRIXCgpleHBpcmVzX2F0GAQgASgDSACIAQFCDQoLX2V4cGlyZXNfYXQiGQoXTGlzdEFjY2Vzc1Rva2Vuc1JlcXVlc3QiSwoYTGlzdEFjY2Vzc1Rva2Vuc1Jlc3BvbnNlEi8KBnRva2VucxgBIAMoCzIfLmV2bV9pbmRleGVyLnYxLkFjY2Vzc1Rva2VuSW5mbyImChhSZXZva2VBY2Nlc3NUb2tlblJlcXVlc3QSCgoCaWQYASABKA0iGwoZUmV2b2tlQWNjZXNzVG9rZW5SZXNwb25zZSK7AQoNT0F1dGhQcm92aWRlchIPCgJpZBgBIAEoDUgAiAEBEg8KB2VuYWJsZWQYAiABKAgSDAoEbmFtZRgDIAEoCRIRCgljbGllbnRfaWQYBCABKAkSEAoIYXV0aF91cmwYBSABKAkSEQoJdG9rZW5fdXJsGAYgASgJEhUKDXVzZXJfaW5mb191cmwYByABKAkSFAoMcmVkaXJlY3RfdXJsGAggASgJEg4KBnNjb3BlcxgJIAEoCUIFCgNfaWQiZAoaQ3JlYXRlT0F1dGhQcm92aWRlclJlcXVlc3QSLwoIcHJvdmlkZXIYASABKAsyHS5ldm1faW5kZXhlci52MS5PQXV0aFByb3ZpZGVyEhUKDWNsaWVudF9zZWNyZXQYAiABKAkiKQobQ3JlYXRlT0F1dGhQcm92aWRlclJlc3BvbnNlEgoKAmlkGAEgASgNImQKGlVwZGF0ZU9BdXRoUHJvdmlkZXJSZXF1ZXN0Ei8KCHByb3ZpZGVyGAEgASgLMh0uZXZtX2luZGV4ZXIudjEuT0F1dGhQcm92aWRlchIVCg1jbGllbnRfc2VjcmV0GAIgASgJIh0KG1VwZGF0ZU9BdXRoUHJvdmlkZXJSZXNwb25zZSIbChlMaXN0T0F1dGhQcm92aWRlcnNSZXF1ZXN0Ik4KGkxpc3RPQXV0aFByb3ZpZGVyc1Jlc3BvbnNlEjAKCXByb3ZpZGVycxgBIAMoCzIdLmV2bV9pbmRleGVyLnYxLk9BdXRoUHJvdmlkZXIiKAoaRGVsZXRlT0F1dGhQcm92aWRlclJlcXVlc3QSCgoCaWQYASABKA0iHQobRGVsZXRlT0F1dGhQcm92aWRlclJlc3BvbnNlIkIKEE9BdXRoTG9naW5PcHRpb24SEwoLcHJvdmlkZXJfaWQYASABKA0SDAoEbmFtZRgCIAEoCRILCgN1cmwYAyABKAkiGwoZTGlzdE9BdXRoTG9naW5VcmxzUmVxdWVzdCJPChpMaXN0T0F1dGhMb2dpblVybHNSZXNwb25zZRIxCgdvcHRpb25zGAEgAygLMiAuZXZtX2luZGV4ZXIudjEuT0F1dGhMb2dpbk9wdGlvbiISChBMaXN0VXNlcnNSZXF1ZXN0IjwKEUxpc3RVc2Vyc1Jlc3BvbnNlEicKBXVzZXJzGAEgAygLMhguZXZtX2luZGV4ZXIudjEuQXV0aFVzZXIiVAoRQ3JlYXRlVXNlclJlcXVlc3QSEAoIdXNlcm5hbWUYASABKAkSEAoIcGFzc3dvcmQYAiABKAkSDAoEcm9sZRgDIAEoCRINCgVlbWFpbBgEIAEoCSIgChJDcmVhdGVVc2VyUmVzcG9uc2USCgoCaWQYASABKA0iYAoRVXBkYXRlVXNlclJlcXVlc3QSCgoCaWQYASABKA0SEAoIdXNlcm5hbWUYAiABKAkSDAoEcm9sZRgDIAEoCRINCgVlbWFpbBgEIAEoCRIQCghwYXNzd29yZBgFIAEoCSIUChJVcGRhdGVVc2VyUmVzcG9uc2UiHwoRRGVsZXRlVXNlclJlcXVlc3QSCgoCaWQYASABKA0iFAoSRGVsZXRlVXNlclJlc3BvbnNlItoCCgxFdm1pRXhwb3J0ZXISDwoCaWQYASABKA1IAIgBARIMCgRuYW1lGAIgASgJEhsKE2V2bV9sb2dfcGlwZWxpbmVfaWQYAyABKA0SDwoHZW5hYmxlZBgEIAEoCBIOCgZzdGF0dXMYBSABKAkSEwoLc3RhcnRfYmxvY2sYBiABKAQSEgoKc3luY19ibG9jaxgHIAEoBBIWCg5zeW5jX2xvZ19pbmRleBgIIAEoAxIaChJwbHVnaW5fY29uZmlnX2pzb24YCSABKAkSEQoJcGx1Z2luX2lkGAogASgNEhcKCmNyZWF0ZWRfYXQYDSABKA1IAYgBARIXCgp1cGRhdGVkX2F0GA4gASgNSAKIAQESFwoKZGVsZXRlZF9hdBgPIAEoDUgDiAEBQgUKA19pZEINCgtfY3JlYXRlZF9hdEINCgtfdXBkYXRlZF9hdEINCgtfZGVsZXRlZF9hdCK1AgoGUGx1Z2luEg8KAmlkGAEgASgNSACIAQESDAoEbmFtZRgCIAEoCRITCgtkZXNjcmlwdGlvbhgDIAEoCRIPCgdnaXRfdXJsGAQgASgJEg8KB3NvX3BhdGgYByABKAkSDgoGc3RhdHVzGAggASgJEg0KBWVycm9yGAkgASgJEg8KB2dpdF9yZWYYDiABKAkSGgoSY29uZmlnX3NjaGVtYV9qc29uGA0gASgJEhcKCmNyZWF0ZWRfYXQYCiABKA1IAYgBARIXCgp1cGRhdGVkX2F0GAsgASgNSAKIAQESFwoKZGVsZXRlZF9hdBgMIAEoDUgDiAEBQgUKA19pZEINCgtfY3JlYXRlZF9hdEINCgtfdXBkYXRlZF9hdEINCgtfZGVsZXRlZF9hdEoECAUQBkoECAYQByI9ChNDcmVhdGVQbHVnaW5SZXF1ZXN0EiYKBnBsdWdpbhgBIAEoCzIWLmV2bV9pbmRleGVyLnYxLlBsdWdpbiIiChRDcmVhdGVQbHVnaW5SZXNwb25zZRIKCgJpZBgBIAEoDSIeChBHZXRQbHVnaW5SZXF1ZXN0EgoKAmlkGAEgASgNIjsKEUdldFBsdWdpblJlc3BvbnNlEiYKBnBsdWdpbhgBIAEoCzIWLmV2bV9pbmRleGVyLnYxLlBsdWdpbiI9ChNVcGRhdGVQbHVnaW5SZXF1ZXN0EiYKBnBsdWdpbhgBIAEoCzIWLmV2bV9pbmRleGVyLnYxLlBsdWdpbiIWChRVcGRhdGVQbHVnaW5SZXNwb25zZSJEChJMaXN0UGx1Z2luc1JlcXVlc3QSLgoKcGFnaW5hdGlvbhgBIAEoCzIaLmV2bV9pbmRleGVyLnYxLlBhZ2luYXRpb24iPgoTTGlzdFBsdWdpbnNSZXNwb25zZRInCgdwbHVnaW5zGAEgAygLMhYuZXZtX2luZGV4ZXIudjEuUGx1Z2luIiEKE0RlbGV0ZVBsdWdpblJlcXVlc3QSCgoCaWQYASABKA0iFgoURGVsZXRlUGx1Z2luUmVzcG9uc2UiIgoUSW5zdGFsbFBsdWdpblJlcXVlc3QSCgoCaWQYASABKA0iRwoVSW5zdGFsbFBsdWdpblJlc3BvbnNlEg8KB3N1Y2Nlc3MYASABKAgSDQoFZXJyb3IYAiABKAkSDgoGc3RhdHVzGAMgASgJIisKGExpc3RQbHVnaW5HaXRSZWZzUmVxdWVzdBIPCgdnaXRfdXJsGAEgASgJIjsKGUxpc3RQbHVnaW5HaXRSZWZzUmVzcG9uc2USEAoIYnJhbmNoZXMYASADKAkSDAoEdGFncxgCIAMoCSJLChlDcmVhdGVFdm1pRXhwb3J0ZXJSZXF1ZXN0Ei4KCGV4cG9ydGVyGAEgASgLMhwuZXZtX2luZGV4ZXIudjEuRXZtaUV4cG9ydGVyIigKGkNyZWF0ZUV2bWlFeHBvcnRlclJlc3BvbnNlEgoKAmlkGAEgASgNIiQKFkdldEV2bWlFeHBvcnRlclJlcXVlc3QSCgoCaWQYASABKA0iSQoXR2V0RXZtaUV4cG9ydGVyUmVzcG9uc2USLgoIZXhwb3J0ZXIYASABKAsyHC5ldm1faW5kZXhlci52MS5Fdm1pRXhwb3J0ZXIiSwoZVXBkYXRlRXZtaUV4cG9ydGVyUmVxdWVzdBIuCghleHBvcnRlchgBIAEoCzIcLmV2bV9pbmRleGVyLnYxLkV2bWlFeHBvcnRlciIcChpVcGRhdGVFdm1pRXhwb3J0ZXJSZXNwb25zZSJfChhMaXN0RXZtaUV4cG9ydGVyc1JlcXVlc3QSLgoKcGFnaW5hdGlvbhgBIAEoCzIaLmV2bV9pbmRleGVyLnYxLlBhZ2luYXRpb24SEwoLcGlwZWxpbmVfaWQYAiABKA0iTAoZTGlzdEV2bWlFeHBvcnRlcnNSZXNwb25zZRIvCglleHBvcnRlcnMYASADKAsyHC5ldm1faW5kZXhlci52MS5Fdm1pRXhwb3J0ZXIiJwoZRGVsZXRlRXZtaUV4cG9ydGVyUmVxdWVzdBIKCgJpZBgBIAEoDSIcChpEZWxldGVFdm1pRXhwb3J0ZXJSZXNwb25zZSIiChRTdGFydEV4cG9ydGVyUmVxdWVzdBIKCgJpZBgBIAEoDSI3ChVTdGFydEV4cG9ydGVyUmVzcG9uc2USDwoHc3VjY2VzcxgBIAEoCBINCgVlcnJvchgCIAEoCSIhChNTdG9wRXhwb3J0ZXJSZXF1ZXN0EgoKAmlkGAEgASgNIjYKFFN0b3BFeHBvcnRlclJlc3BvbnNlEg8KB3N1Y2Nlc3MYASABKAgSDQoFZXJyb3IYAiABKAkiNwogU3RyZWFtRXZtaUV4cG9ydGVyVXBkYXRlc1JlcXVlc3QSEwoLcGlwZWxpbmVfaWQYASABKA0iHAoaRXhwb3J0Q29uZmlndXJhdGlvblJlcXVlc3QiMgobRXhwb3J0Q29uZmlndXJhdGlvblJlc3BvbnNlEhMKC2NvbmZpZ19qc29uGAEgASgJMq8yChFFdm1JbmRleGVyU2VydmljZRJiCg9HZXRFdm1pSW5zdGFuY2USJi5ldm1faW5kZXhlci52MS5HZXRFdm1pSW5zdGFuY2VSZXF1ZXN0GicuZXZtX2luZGV4ZXIudjEuR2V0RXZtaUluc3RhbmNlUmVzcG9uc2USaAoRTGlzdEV2bWlJbnN0YW5jZXMSKC5ldm1faW5kZXhlci52MS5MaXN0RXZtaUluc3RhbmNlc1JlcXVlc3QaKS5ldm1faW5kZXhlci52MS5MaXN0RXZtaUluc3RhbmNlc1Jlc3BvbnNlEm4KE0NyZWF0ZUV2bUJsb2NrY2hhaW4SKi5ldm1faW5kZXhlci52MS5DcmVhdGVFdm1CbG9ja2NoYWluUmVxdWVzdBorLmV2bV9pbmRleGVyLnYxLkNyZWF0ZUV2bUJsb2NrY2hhaW5SZXNwb25zZRJlChBHZXRFdm1CbG9ja2NoYWluEicuZXZtX2luZGV4ZXIudjEuR2V0RXZtQmxvY2tjaGFpblJlcXVlc3QaKC5ldm1faW5kZXhlci52MS5HZXRFdm1CbG9ja2NoYWluUmVzcG9uc2USbgoTVXBkYXRlRXZtQmxvY2tjaGFpbhIqLmV2bV9pbmRleGVyLnYxLlVwZGF0ZUV2bUJsb2NrY2hhaW5SZXF1ZXN0GisuZXZtX2luZGV4ZXIudjEuVXBkYXRlRXZtQmxvY2tjaGFpblJlc3BvbnNlEmsKEkxpc3RFdm1CbG9ja2NoYWlucxIpLmV2bV9pbmRleGVyLnYxLkxpc3RFdm1CbG9ja2NoYWluc1JlcXVlc3QaKi5ldm1faW5kZXhlci52MS5MaXN0RXZtQmxvY2tjaGFpbnNSZXNwb25zZRJuChNEZWxldGVFdm1CbG9ja2NoYWluEiouZXZtX2luZGV4ZXIudjEuRGVsZXRlRXZtQmxvY2tjaGFpblJlcXVlc3QaKy5ldm1faW5kZXhlci52MS5EZWxldGVFdm1CbG9ja2NoYWluUmVzcG9uc2USZQoQQ3JlYXRlRXZtSnNvbkFiaRInLmV2bV9pbmRleGVyLnYxLkNyZWF0ZUV2bUpzb25BYmlSZXF1ZXN0GiguZXZtX2luZGV4ZXIudjEuQ3JlYXRlRXZtSnNvbkFiaVJlc3BvbnNlElwKDUdldEV2bUpzb25BYmkSJC5ldm1faW5kZXhlci52MS5HZXRFdm1Kc29uQWJpUmVxdWVzdBolLmV2bV9pbmRleGVyLnYxLkdldEV2bUpzb25BYmlSZXNwb25zZRJlChBVcGRhdGVFdm1Kc29uQWJpEicuZXZtX2luZGV4ZXIudjEuVXBkYXRlRXZtSnNvbkFiaVJlcXVlc3QaKC5ldm1faW5kZXhlci52MS5VcGRhdGVFdm1Kc29uQWJpUmVzcG9uc2USYgoPTGlzdEV2bUpzb25BYmlzEiYuZXZtX2luZGV4ZXIudjEuTGlzdEV2bUpzb25BYmlzUmVxdWVzdBonLmV2bV9pbmRleGVyLnYxLkxpc3RFdm1Kc29uQWJpc1Jlc3BvbnNlEmUKEERlbGV0ZUV2bUpzb25BYmkSJy5ldm1faW5kZXhlci52MS5EZWxldGVFdm1Kc29uQWJpUmVxdWVzdBooLmV2bV9pbmRleGVyLnYxLkRlbGV0ZUV2bUpzb25BYmlSZXNwb25zZRJoChFDcmVhdGVFdm1Mb2dTdG9yZRIoLmV2bV9pbmRleGVyLnYxLkNyZWF0ZUV2bUxvZ1N0b3JlUmVxdWVzdBopLmV2bV9pbmRleGVyLnYxLkNyZWF0ZUV2bUxvZ1N0b3JlUmVzcG9uc2USXwoOR2V0RXZtTG9nU3RvcmUSJS5ldm1faW5kZXhlci52MS5HZXRFdm1Mb2dTdG9yZVJlcXVlc3QaJi5ldm1faW5kZXhlci52MS5HZXRFdm1Mb2dTdG9yZVJlc3BvbnNlEmgKEVVwZGF0ZUV2bUxvZ1N0b3JlEiguZXZtX2luZGV4ZXIudjEuVXBkYXRlRXZtTG9nU3RvcmVSZXF1ZXN0GikuZXZtX2luZGV4ZXIudjEuVXBkYXRlRXZtTG9nU3RvcmVSZXNwb25zZRJlChBMaXN0RXZtTG9nU3RvcmVzEicuZXZtX2luZGV4ZXIudjEuTGlzdEV2bUxvZ1N0b3Jlc1JlcXVlc3QaKC5ldm1faW5kZXhlci52MS5MaXN0RXZtTG9nU3RvcmVzUmVzcG9uc2USaAoRRGVsZXRlRXZtTG9nU3RvcmUSKC5ldm1faW5kZXhlci52MS5EZWxldGVFdm1Mb2dTdG9yZVJlcXVlc3QaKS5ldm1faW5kZXhlci52MS5EZWxldGVFdm1Mb2dTdG9yZVJlc3BvbnNlEnEKFENyZWF0ZUV2bUxvZ1BpcGVsaW5lEisuZXZtX2luZGV4ZXIudjEuQ3JlYXRlRXZtTG9nUGlwZWxpbmVSZXF1ZXN0GiwuZXZtX2luZGV4ZXIudjEuQ3JlYXRlRXZtTG9nUGlwZWxpbmVSZXNwb25zZRJoChFHZXRFdm1Mb2dQaXBlbGluZRIoLmV2bV9pbmRleGVyLnYxLkdldEV2bUxvZ1BpcGVsaW5lUmVxdWVzdBopLmV2bV9pbmRleGVyLnYxLkdldEV2bUxvZ1BpcGVsaW5lUmVzcG9uc2UScQoUVXBkYXRlRXZtTG9nUGlwZWxpbmUSKy5ldm1faW5kZXhlci52MS5VcGRhdGVFdm1Mb2dQaXBlbGluZVJlcXVlc3QaLC5ldm1faW5kZXhlci52MS5VcGRhdGVFdm1Mb2dQaXBlbGluZVJlc3BvbnNlEm4KE0xpc3RFdm1Mb2dQaXBlbGluZXMSKi5ldm1faW5kZXhlci52MS5MaXN0RXZtTG9nUGlwZWxpbmVzUmVxdWVzdBorLmV2bV9pbmRleGVyLnYxLkxpc3RFdm1Mb2dQaXBlbGluZXNSZXNwb25zZRJxChREZWxldGVFdm1Mb2dQaXBlbGluZRIrLmV2bV9pbmRleGVyLnYxLkRlbGV0ZUV2bUxvZ1BpcGVsaW5lUmVxdWVzdBosLmV2bV9pbmRleGVyLnYxLkRlbGV0ZUV2bUxvZ1BpcGVsaW5lUmVzcG9uc2USbQoSU3RhcnRTb3VyY2VJbmRleGVyEikuZXZtX2luZGV4ZXIudjEuU3RhcnRTb3VyY2VJbmRleGVyUmVxdWVzdBoqLmV2bV9pbmRleGVyLnYxLlN0YXJ0U291cmNlSW5kZXhlclJlc3BvbnNlIgASagoRU3RvcFNvdXJjZUluZGV4ZXISKC5ldm1faW5kZXhlci52MS5TdG9wU291cmNlSW5kZXhlclJlcXVlc3QaKS5ldm1faW5kZXhlci52MS5TdG9wU291cmNlSW5kZXhlclJlc3BvbnNlIgASawoSQ3JlYXRlRXZtTG9nU291cmNlEikuZXZtX2luZGV4ZXIudjEuQ3JlYXRlRXZtTG9nU291cmNlUmVxdWVzdBoqLmV2bV9pbmRleGVyLnYxLkNyZWF0ZUV2bUxvZ1NvdXJjZVJlc3BvbnNlEmIKD0dldEV2bUxvZ1NvdXJjZRImLmV2bV9pbmRleGVyLnYxLkdldEV2bUxvZ1NvdXJjZVJlcXVlc3QaJy5ldm1faW5kZXhlci52MS5HZXRFdm1Mb2dTb3VyY2VSZXNwb25zZRJrChJVcGRhdGVFdm1Mb2dTb3VyY2USKS5ldm1faW5kZXhlci52MS5VcGRhdGVFdm1Mb2dTb3VyY2VSZXF1ZXN0GiouZXZtX2luZGV4ZXIudjEuVXBkYXRlRXZtTG9nU291cmNlUmVzcG9uc2USaAoRTGlzdEV2bUxvZ1NvdXJjZXMSKC5ldm1faW5kZXhlci52MS5MaXN0RXZtTG9nU291cmNlc1JlcXVlc3QaKS5ldm1faW5kZXhlci52MS5MaXN0RXZtTG9nU291cmNlc1Jlc3BvbnNlEmsKEkRlbGV0ZUV2bUxvZ1NvdXJjZRIpLmV2bV9pbmRleGVyLnYxLkRlbGV0ZUV2bUxvZ1NvdXJjZVJlcXVlc3QaKi5ldm1faW5kZXhlci52MS5EZWxldGVFdm1Mb2dTb3VyY2VSZXNwb25zZRJtChlTdHJlYW1Fdm1Mb2dTb3VyY2VVcGRhdGVzEjAuZXZtX2luZGV4ZXIudjEuU3RyZWFtRXZtTG9nU291cmNlVXBkYXRlc1JlcXVlc3QaHC5ldm1faW5kZXhlci52MS5Fdm1Mb2dTb3VyY2UwARJWCgtMaXN0RXZtTG9ncxIiLmV2bV9pbmRleGVyLnYxLkxpc3RFdm1Mb2dzUmVxdWVzdBojLmV2bV9pbmRleGVyLnYxLkxpc3RFdm1Mb2dzUmVzcG9uc2USaAoRTGlzdExhdGVzdEV2bUxvZ3MSKC5ldm1faW5kZXhlci52MS5MaXN0TGF0ZXN0RXZtTG9nc1JlcXVlc3QaKS5ldm1faW5kZXhlci52MS5MaXN0TGF0ZXN0RXZtTG9nc1Jlc3BvbnNlEm4KE0xpc3RFdm1UcmFuc2FjdGlvbnMSKi5ldm1faW5kZXhlci52MS5MaXN0RXZtVHJhbnNhY3Rpb25zUmVxdWVzdBorLmV2bV9pbmRleGVyLnYxLkxpc3RFdm1UcmFuc2FjdGlvbnNSZXNwb25zZRJECgVMb2dpbhIcLmV2bV9pbmRleGVyLnYxLkxvZ2luUmVxdWVzdBodLmV2bV9pbmRleGVyLnYxLkxvZ2luUmVzcG9uc2USawoSTGlzdE9BdXRoTG9naW5VcmxzEikuZXZtX2luZGV4ZXIudjEuTGlzdE9BdXRoTG9naW5VcmxzUmVxdWVzdBoqLmV2bV9pbmRleGVyLnYxLkxpc3RPQXV0aExvZ2luVXJsc1Jlc3BvbnNlEjsKAk1lEhkuZXZtX2luZGV4ZXIudjEuTWVSZXF1ZXN0GhouZXZtX2luZGV4ZXIudjEuTWVSZXNwb25zZRJoChFDcmVhdGVBY2Nlc3NUb2tlbhIoLmV2bV9pbmRleGVyLnYxLkNyZWF0ZUFjY2Vzc1Rva2VuUmVxdWVzdBopLmV2bV9pbmRleGVyLnYxLkNyZWF0ZUFjY2Vzc1Rva2VuUmVzcG9uc2USZQoQTGlzdEFjY2Vzc1Rva2VucxInLmV2bV9pbmRleGVyLnYxLkxpc3RBY2Nlc3NUb2tlbnNSZXF1ZXN0GiguZXZtX2luZGV4ZXIudjEuTGlzdEFjY2Vzc1Rva2Vuc1Jlc3BvbnNlEmgKEVJldm9rZUFjY2Vzc1Rva2VuEiguZXZtX2luZGV4ZXIudjEuUmV2b2tlQWNjZXNzVG9rZW5SZXF1ZXN0GikuZXZtX2luZGV4ZXIudjEuUmV2b2tlQWNjZXNzVG9rZW5SZXNwb25zZRJrChJMaXN0T0F1dGhQcm92aWRlcnMSKS5ldm1faW5kZXhlci52MS5MaXN0T0F1dGhQcm92aWRlcnNSZXF1ZXN0GiouZXZtX2luZGV4ZXIudjEuTGlzdE9BdXRoUHJvdmlkZXJzUmVzcG9uc2USbgoTQ3JlYXRlT0F1dGhQcm92aWRlchIqLmV2bV9pbmRleGVyLnYxLkNyZWF0ZU9BdXRoUHJvdmlkZXJSZXF1ZXN0GisuZXZtX2luZGV4ZXIudjEuQ3JlYXRlT0F1dGhQcm92aWRlclJlc3BvbnNlEm4KE1VwZGF0ZU9BdXRoUHJvdmlkZXISKi5ldm1faW5kZXhlci52MS5VcGRhdGVPQXV0aFByb3ZpZGVyUmVxdWVzdBorLmV2bV9pbmRleGVyLnYxLlVwZGF0ZU9BdXRoUHJvdmlkZXJSZXNwb25zZRJuChNEZWxldGVPQXV0aFByb3ZpZGVyEiouZXZtX2luZGV4ZXIudjEuRGVsZXRlT0F1dGhQcm92aWRlclJlcXVlc3QaKy5ldm1faW5kZXhlci52MS5EZWxldGVPQXV0aFByb3ZpZGVyUmVzcG9uc2USUAoJTGlzdFVzZXJzEiAuZXZtX2luZGV4ZXIudjEuTGlzdFVzZXJzUmVxdWVzdBohLmV2bV9pbmRleGVyLnYxLkxpc3RVc2Vyc1Jlc3BvbnNlElMKCkNyZWF0ZVVzZXISIS5ldm1faW5kZXhlci52MS5DcmVhdGVVc2VyUmVxdWVzdBoiLmV2bV9pbmRleGVyLnYxLkNyZWF0ZVVzZXJSZXNwb25zZRJTCgpVcGRhdGVVc2VyEiEuZXZtX2luZGV4ZXIudjEuVXBkYXRlVXNlclJlcXVlc3QaIi5ldm1faW5kZXhlci52MS5VcGRhdGVVc2VyUmVzcG9uc2USUwoKRGVsZXRlVXNlchIhLmV2bV9pbmRleGVyLnYxLkRlbGV0ZVVzZXJSZXF1ZXN0GiIuZXZtX2luZGV4ZXIudjEuRGVsZXRlVXNlclJlc3BvbnNlEmsKEkNyZWF0ZUV2bWlFeHBvcnRlchIpLmV2bV9pbmRleGVyLnYxLkNyZWF0ZUV2bWlFeHBvcnRlclJlcXVlc3QaKi5ldm1faW5kZXhlci52MS5DcmVhdGVFdm1pRXhwb3J0ZXJSZXNwb25zZRJiCg9HZXRFdm1pRXhwb3J0ZXISJi5ldm1faW5kZXhlci52MS5HZXRFdm1pRXhwb3J0ZXJSZXF1ZXN0GicuZXZtX2luZGV4ZXIudjEuR2V0RXZtaUV4cG9ydGVyUmVzcG9uc2USawoSVXBkYXRlRXZtaUV4cG9ydGVyEikuZXZtX2luZGV4ZXIudjEuVXBkYXRlRXZtaUV4cG9ydGVyUmVxdWVzdBoqLmV2bV9pbmRleGVyLnYxLlVwZGF0ZUV2bWlFeHBvcnRlclJlc3BvbnNlEmgKEUxpc3RFdm1pRXhwb3J0ZXJzEiguZXZtX2luZGV4ZXIudjEuTGlzdEV2bWlFeHBvcnRlcnNSZXF1ZXN0GikuZXZtX2luZGV4ZXIudjEuTGlzdEV2bWlFeHBvcnRlcnNSZXNwb25zZRJrChJEZWxldGVFdm1pRXhwb3J0ZXISKS5ldm1faW5kZXhlci52MS5EZWxldGVFdm1pRXhwb3J0ZXJSZXF1ZXN0GiouZXZtX2luZGV4ZXIudjEuRGVsZXRlRXZtaUV4cG9ydGVyUmVzcG9uc2USXAoNU3RhcnRFeHBvcnRlchIkLmV2bV9pbmRleGVyLnYxLlN0YXJ0RXhwb3J0ZXJSZXF1ZXN0GiUuZXZtX2luZGV4ZXIudjEuU3RhcnRFeHBvcnRlclJlc3BvbnNlElkKDFN0b3BFeHBvcnRlchIjLmV2bV9pbmRleGVyLnYxLlN0b3BFeHBvcnRlclJlcXVlc3QaJC5ldm1faW5kZXhlci52MS5TdG9wRXhwb3J0ZXJSZXNwb25zZRJtChlTdHJlYW1Fdm1pRXhwb3J0ZXJVcGRhdGVzEjAuZXZtX2luZGV4ZXIudjEuU3RyZWFtRXZtaUV4cG9ydGVyVXBkYXRlc1JlcXVlc3QaHC5ldm1faW5kZXhlci52MS5Fdm1pRXhwb3J0ZXIwARJZCgxDcmVhdGVQbHVnaW4SIy5ldm1faW5kZXhlci52MS5DcmVhdGVQbHVnaW5SZXF1ZXN0GiQuZXZtX2luZGV4ZXIudjEuQ3JlYXRlUGx1Z2luUmVzcG9uc2USUAoJR2V0UGx1Z2luEiAuZXZtX2luZGV4ZXIudjEuR2V0UGx1Z2luUmVxdWVzdBohLmV2bV9pbmRleGVyLnYxLkdldFBsdWdpblJlc3BvbnNlElkKDFVwZGF0ZVBsdWdpbhIjLmV2bV9pbmRleGVyLnYxLlVwZGF0ZVBsdWdpblJlcXVlc3QaJC5ldm1faW5kZXhlci52MS5VcGRhdGVQbHVnaW5SZXNwb25zZRJWCgtMaXN0UGx1Z2lucxIiLmV2bV9pbmRleGVyLnYxLkxpc3RQbHVnaW5zUmVxdWVzdBojLmV2bV9pbmRleGVyLnYxLkxpc3RQbHVnaW5zUmVzcG9uc2USWQoMRGVsZXRlUGx1Z2luEiMuZXZtX2luZGV4ZXIudjEuRGVsZXRlUGx1Z2luUmVxdWVzdBokLmV2bV9pbmRleGVyLnYxLkRlbGV0ZVBsdWdpblJlc3BvbnNlElwKDUluc3RhbGxQbHVnaW4SJC5ldm1faW5kZXhlci52MS5JbnN0YWxsUGx1Z2luUmVxdWVzdBolLmV2bV9pbmRleGVyLnYxLkluc3RhbGxQbHVnaW5SZXNwb25zZRJoChFMaXN0UGx1Z2luR2l0UmVmcxIoLmV2bV9pbmRleGVyLnYxLkxpc3RQbHVnaW5HaXRSZWZzUmVxdWVzdBopLmV2bV9pbmRleGVyLnYxLkxpc3RQbHVnaW5HaXRSZWZzUmVzcG9uc2USbgoTRXhwb3J0Q29uZmlndXJhdGlvbhIqLmV2bV9pbmRleGVyLnYxLkV4cG9ydENvbmZpZ3VyYXRpb25SZXF1ZXN0GisuZXZtX2luZGV4ZXIudjEuRXhwb3J0Q29uZmlndXJhdGlvblJlc3BvbnNlQkVaQ2dpdGh1Yi5jb20vZXZtaS1jbG91ZC9nby1ldm0taW5kZXhlci9nZW4vZXZtLWluZGV4ZXIvdjE7ZXZtX2luZGV4ZXJiBnByb3RvMw");
+  fileDesc("CiBldm1faW5kZXhlci92MS9ldm1faW5kZXhlci5wcm90bxIOZXZtX2luZGV4ZXIudjEi0QEKDEV2bWlJbnN0YW5jZRIPCgJpZBgBIAEoDUgAiAEBEhMKC2luc3RhbmNlX2lkGAIgASgJEgwKBGlwdjQYAyABKAkSDgoGc3RhdHVzGAQgASgJEhcKCmNyZWF0ZWRfYXQYBSABKA1IAYgBARIXCgp1cGRhdGVkX2F0GAYgASgNSAKIAQESFwoKZGVsZXRlZF9hdBgHIAEoDUgDiAEBQgUKA19pZEINCgtfY3JlYXRlZF9hdEINCgtfdXBkYXRlZF9hdEINCgtfZGVsZXRlZF9hdCLOBQoNRXZtQmxvY2tjaGFpbhIPCgJpZBgBIAEoDUgAiAEBEhAKCGNoYWluX2lkGAIgASgEEgwKBG5hbWUYAyABKAkSDwoHcnBjX3VybBgEIAEoCRITCgtibG9ja19yYW5nZRgFIAEoBBITCgtibG9ja19zbGljZRgGIAEoBBIVCg1wdWxsX2ludGVydmFsGAcgASgEEhoKEnJwY19tYXhfYmF0Y2hfc2l6ZRgIIAEoBBIXCgpjcmVhdGVkX2F0GAkgASgNSAGIAQESFwoKdXBkYXRlZF9hdBgKIAEoDUgCiAEBEhcKCmRlbGV0ZWRfYXQYCyABKA1IA4gBARIRCgloZWFkX21vZGUYDCABKAkSGgoSaGVhZF9jb25maXJtYXRpb25zGA0gASgEEh0KFXNxZF9nYXRld2F5X2F2YWlsYWJsZRgOIAEoCBIXCg9zcWRfZ2F0ZXdheV91cmwYDyABKAkSNQoNcnBjX2VuZHBvaW50cxgQIAMoCzIeLmV2bV9pbmRleGVyLnYxLkV2bVJwY0VuZHBvaW50Eg4KBndzX3VybBgRIAEoCRIZChF3c19zdWJzY3JpYmVfbG9ncxgSIAEoCBIaChJsb2dzX21heF9hZGRyZXNzZXMYEyABKAQSHgoWdHJhY2VfZmlsdGVyX2F2YWlsYWJsZRgUIAEoCBIWCg5ycGNfcmF0ZV9saW1pdBgVIAEoARJLChBycGNfbWV0aG9kX2Nvc3RzGBYgAygLMjEuZXZtX2luZGV4ZXIudjEuRXZtQmxvY2tjaGFpbi5ScGNNZXRob2RDb3N0c0VudHJ5GjUKE1JwY01ldGhvZENvc3RzRW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgEOgI4AUIFCgNfaWRCDQoLX2NyZWF0ZWRfYXRCDQoLX3VwZGF0ZWRfYXRCDQoLX2RlbGV0ZWRfYXQizgEKDkV2bVJwY0VuZHBvaW50Eg8KAmlkGAEgASgNSACIAQESCwoDdXJsGAIgASgJEg4KBndlaWdodBgDIAEoBBIPCgdoZWFsdGh5GAQgASgIEhIKCmhlYWRfYmxvY2sYBSABKAQSEgoKbGF0ZW5jeV9tcxgGIAEoBBISCgplcnJvcl9yYXRlGAcgASgBEhIKCmxhc3RfZXJyb3IYCCABKAkSFwoKY2hlY2tlZF9hdBgJIAEoDUgBiAEBQgUKA19pZEINCgtfY2hlY2tlZF9hdCLEAQoKRXZtSnNvbkFiaRIPCgJpZBgBIAEoDUgAiAEBEhUKDWNvbnRyYWN0X25hbWUYAiABKAkSDwoHY29udGVudBgDIAEoCRIXCgpjcmVhdGVkX2F0GAQgASgNSAGIAQESFwoKdXBkYXRlZF9hdBgFIAEoDUgCiAEBEhcKCmRlbGV0ZWRfYXQYBiABKA1IA4gBAUIFCgNfaWRCDQoLX2NyZWF0ZWRfYXRCDQoLX3VwZGF0ZWRfYXRCDQoLX2RlbGV0ZWRfYXQi9QEKC0V2bUxvZ1N0b3JlEg8KAmlkGAEgASgNSACIAQESEgoKaWRlbnRpZmllchgCIAEoCRITCgtkZXNjcmlwdGlvbhgDIAEoCRISCgpzdG9yZV90eXBlGAQgASgJEhkKEXN0b3JlX2NvbmZpZ19qc29uGAUgASgJEhcKCmNyZWF0ZWRfYXQYBiABKA1IAYgBARIXCgp1cGRhdGVkX2F0GAcgASgNSAKIAQESFwoKZGVsZXRlZF9hdBgIIAEoDUgDiAEBQgUKA19pZEINCgtfY3JlYXRlZF9hdEINCgtfdXBkYXRlZF9hdEINCgtfZGVsZXRlZF9hdCLiAQoORXZtTG9nUGlwZWxpbmUSDwoCaWQYASABKA1IAIgBARIMCgRuYW1lGAIgASgJEhgKEGV2bWlfaW5zdGFuY2VfaWQYBCABKA0SGAoQZXZtX2xvZ19zdG9yZV9pZBgGIAEoDRIXCgpjcmVhdGVkX2F0GAcgASgNSAGIAQESFwoKdXBkYXRlZF9hdBgIIAEoDUgCiAEBEhcKCmRlbGV0ZWRfYXQYCSABKA1IA4gBAUIFCgNfaWRCDQoLX2NyZWF0ZWRfYXRCDQoLX3VwZGF0ZWRfYXRCDQoLX2RlbGV0ZWRfYXQiRAoURmFjdG9yeVJ1bGVDb25kaXRpb24SCwoDYXJnGAEgASgJEhAKCG9wZXJhdG9yGAIgASgJEg0KBXZhbHVlGAMgASgJIpECCgtGYWN0b3J5UnVsZRIPCgJpZBgBIAEoDUgAiAEBEh4KFmNyZWF0aW9uX2Z1bmN0aW9uX25hbWUYAiABKAkSIAoYY3JlYXRpb25fYWRkcmVzc19sb2dfYXJnGAMgASgJEhIKCmNoaWxkX3R5cGUYBCABKAkSFwoPZXZtX2pzb25fYWJpX2lkGAUgASgNEjAKC2NoaWxkX3J1bGVzGAYgAygLMhsuZXZtX2luZGV4ZXIudjEuRmFjdG9yeVJ1bGUSOAoKY29uZGl0aW9ucxgHIAMoCzIkLmV2bV9pbmRleGVyLnYxLkZhY3RvcnlSdWxlQ29uZGl0aW9uEg8KB3RyaWdnZXIYCCABKAlCBQoDX2lkIvcGCgxFdm1Mb2dTb3VyY2USDwoCaWQYASABKA1IAIgBARIMCgR0eXBlGAIgASgJEg8KB2VuYWJsZWQYAyABKAgSDgoGc3RhdHVzGAQgASgJEhMKC3N0YXJ0X2Jsb2NrGAUgASgEEhIKCnN5bmNfYmxvY2sYBiABKAQSEQoJZW5kX2Jsb2NrGB0gASgEEhgKEGNhdGNoX3VwX3dvcmtlcnMYHiABKA0SEgoKbGFzdF9lcnJvchgfIAEoCRIaCg1sYXN0X2Vycm9yX2F0GCAgASgNSAGIAQESHQoVbGFzdF9lcnJvcl9mcm9tX2Jsb2NrGCEgASgEEhsKE2xhc3RfZXJyb3JfdG9fYmxvY2sYIiABKAQSHAoUY29uc2VjdXRpdmVfZmFpbHVyZXMYIyABKA0SFAoHYWRkcmVzcxgHIAEoCUgCiAEBEhMKBnRvcGljMBgIIAEoCUgDiAEBEhUKDXRvcGljX2ZpbHRlcnMYCSADKAkSGAoQcGFyZW50X3NvdXJjZV9pZBgMIAEoDRIyCg1mYWN0b3J5X3J1bGVzGBcgAygLMhsuZXZtX2luZGV4ZXIudjEuRmFjdG9yeVJ1bGUSGwoTZXZtX2xvZ19waXBlbGluZV9pZBgNIAEoDRIXCg9ldm1fanNvbl9hYmlfaWQYDiABKA0SGQoRZXZtX2Jsb2NrY2hhaW5faWQYDyABKA0SDwoHdG9waWMwcxgYIAMoCRIYChBldm1fanNvbl9hYmlfaWRzGBkgAygNEjoKCHJlZGVjb2RlGBogASgLMiMuZXZtX2luZGV4ZXIudjEuRXZtUmVkZWNvZGVQcm9ncmVzc0gEiAEBEhEKCWFkZHJlc3NlcxgbIAMoCRIZChFmdW5jdGlvbl9zZWxlY3RvchgcIAEoCRIXCgpjcmVhdGVkX2F0GBAgASgNSAWIAQESFwoKdXBkYXRlZF9hdBgRIAEoDUgGiAEBEhcKCmRlbGV0ZWRfYXQYEiABKA1IB4gBAUIFCgNfaWRCEAoOX2xhc3RfZXJyb3JfYXRCCgoIX2FkZHJlc3NCCQoHX3RvcGljMEILCglfcmVkZWNvZGVCDQoLX2NyZWF0ZWRfYXRCDQoLX3VwZGF0ZWRfYXRCDQoLX2RlbGV0ZWRfYXRKBAgKEAtKBAgLEAxKBAgUEBVKBAgVEBZKBAgWEBcifAoTRXZtUmVkZWNvZGVQcm9ncmVzcxIOCgZzdGF0dXMYASABKAkSEgoKZnJvbV9ibG9jaxgCIAEoBBISCgpuZXh0X2Jsb2NrGAMgASgEEhAKCHRvX2Jsb2NrGAQgASgEEgwKBGxvZ3MYBSABKAQSDQoFZXJyb3IYBiABKAki8wEKC0V2bU1ldGFkYXRhEhoKDWNvbnRyYWN0X25hbWUYASABKAlIAIgBARIXCgpldmVudF9uYW1lGAIgASgJSAGIAQESGgoNZnVuY3Rpb25fbmFtZRgDIAEoCUgCiAEBEjMKBGRhdGEYBCADKAsyJS5ldm1faW5kZXhlci52MS5Fdm1NZXRhZGF0YS5EYXRhRW50cnkaKwoJRGF0YUVudHJ5EgsKA2tleRgBIAEoCRINCgV2YWx1ZRgCIAEoCToCOAFCEAoOX2NvbnRyYWN0X25hbWVCDQoLX2V2ZW50X25hbWVCEAoOX2Z1bmN0aW9uX25hbWUizQIKBkV2bUxvZxIKCgJpZBgBIAEoCRIQCghjaGFpbl9pZBgCIAEoDRIRCglzb3VyY2VfaWQYAyABKA0SDwoHYWRkcmVzcxgEIAEoCRIOCgZ0b3BpY3MYBSADKAkSDAoEZGF0YRgGIAEoCRIUCgxibG9ja19udW1iZXIYByABKAQSGAoQdHJhbnNhY3Rpb25fZnJvbRgIIAEoCRIYChB0cmFuc2FjdGlvbl9oYXNoGAkgASgJEhkKEXRyYW5zYWN0aW9uX2luZGV4GAogASgEEhIKCmJsb2NrX2hhc2gYCyABKAkSEQoJbG9nX2luZGV4GAwgASgEEg8KB3JlbW92ZWQYDSABKAgSLQoIbWV0YWRhdGEYDiABKAsyGy5ldm1faW5kZXhlci52MS5Fdm1NZXRhZGF0YRIXCg9ibG9ja190aW1lc3RhbXAYDyABKAQiwwMKDkV2bVRyYW5zYWN0aW9uEgoKAmlkGAEgASgJEhEKCXNvdXJjZV9pZBgCIAEoDRIUCgxibG9ja19udW1iZXIYAyABKAQSGQoRdHJhbnNhY3Rpb25faW5kZXgYBCABKAQSEAoIY2hhaW5faWQYBSABKAQSDAoEZnJvbRgGIAEoCRIMCgRkYXRhGAcgASgJEg0KBXZhbHVlGAggASgJEg0KBW5vbmNlGAkgASgEEgoKAnRvGAogASgJEgwKBGhhc2gYCyABKAkSLQoIbWV0YWRhdGEYDCABKAsyGy5ldm1faW5kZXhlci52MS5Fdm1NZXRhZGF0YRIXCg9ibG9ja190aW1lc3RhbXAYDSABKAQSDAoEdHlwZRgOIAEoBBIOCgZzdGF0dXMYDyABKAQSEAoIZ2FzX3VzZWQYECABKAQSGwoTZWZmZWN0aXZlX2dhc19wcmljZRgRIAEoCRIYChBjb250cmFjdF9hZGRyZXNzGBIgASgJEhUKDWJsb2JfZ2FzX3VzZWQYEyABKAQSFgoOYmxvYl9nYXNfcHJpY2UYFCABKAkSHQoVYmxvYl92ZXJzaW9uZWRfaGFzaGVzGBUgAygJIroCCghFdm1CbG9jaxIKCgJpZBgBIAEoCRIRCglzb3VyY2VfaWQYAiABKA0SEAoIY2hhaW5faWQYAyABKAQSFAoMYmxvY2tfbnVtYmVyGAQgASgEEhcKD2Jsb2NrX3RpbWVzdGFtcBgFIAEoBBIMCgRoYXNoGAYgASgJEhMKC3BhcmVudF9oYXNoGAcgASgJEg0KBW1pbmVyGAggASgJEhAKCGdhc191c2VkGAkgASgEEhEKCWdhc19saW1pdBgKIAEoBBIYChBiYXNlX2ZlZV9wZXJfZ2FzGAsgASgJEhUKDWJsb2JfZ2FzX3VzZWQYDCABKAQSFwoPZXhjZXNzX2Jsb2JfZ2FzGA0gASgEEhkKEXRyYW5zYWN0aW9uX2NvdW50GA4gASgEEhIKCmxvZ3NfYmxvb20YDyABKAkiugIKCEV2bVRyYWNlEgoKAmlkGAEgASgJEhEKCXNvdXJjZV9pZBgCIAEoDRIQCghjaGFpbl9pZBgDIAEoBBIUCgxibG9ja19udW1iZXIYBCABKAQSFwoPYmxvY2tfdGltZXN0YW1wGAUgASgEEhgKEHRyYW5zYWN0aW9uX2hhc2gYBiABKAkSGQoRdHJhbnNhY3Rpb25faW5kZXgYByABKAQSFQoNdHJhY2VfYWRkcmVzcxgIIAEoCRIMCgR0eXBlGAkgASgJEgwKBGZyb20YCiABKAkSCgoCdG8YCyABKAkSDQoFdmFsdWUYDCABKAkSDQoFaW5wdXQYDSABKAkSDgoGb3V0cHV0GA4gASgJEgsKA2dhcxgPIAEoBBIQCghnYXNfdXNlZBgQIAEoBBINCgVlcnJvchgRIAEoCSIrCgpQYWdpbmF0aW9uEg0KBWxpbWl0GAEgASgNEg4KBm9mZnNldBgCIAEoDSIkChZHZXRFdm1pSW5zdGFuY2VSZXF1ZXN0EgoKAmlkGAEgASgNIkkKF0dldEV2bWlJbnN0YW5jZVJlc3BvbnNlEi4KCGluc3RhbmNlGAEgASgLMhwuZXZtX2luZGV4ZXIudjEuRXZtaUluc3RhbmNlIkoKGExpc3RFdm1pSW5zdGFuY2VzUmVxdWVzdBIuCgpwYWdpbmF0aW9uGAEgASgLMhouZXZtX2luZGV4ZXIudjEuUGFnaW5hdGlvbiJMChlMaXN0RXZtaUluc3RhbmNlc1Jlc3BvbnNlEi8KCWluc3RhbmNlcxgBIAMoCzIcLmV2bV9pbmRleGVyLnYxLkV2bWlJbnN0YW5jZSJPChpDcmVhdGVFdm1CbG9ja2NoYWluUmVxdWVzdBIxCgpibG9ja2NoYWluGAEgASgLMh0uZXZtX2luZGV4ZXIudjEuRXZtQmxvY2tjaGFpbiIpChtDcmVhdGVFdm1CbG9ja2NoYWluUmVzcG9uc2USCgoCaWQYASABKA0iJQoXR2V0RXZtQmxvY2tjaGFpblJlcXVlc3QSCgoCaWQYASABKA0iTQoYR2V0RXZtQmxvY2tjaGFpblJlc3BvbnNlEjEKCmJsb2NrY2hhaW4YASABKAsyHS5ldm1faW5kZXhlci52MS5Fdm1CbG9ja2NoYWluIk8KGlVwZGF0ZUV2bUJsb2NrY2hhaW5SZXF1ZXN0EjEKCmJsb2NrY2hhaW4YASABKAsyHS5ldm1faW5kZXhlci52MS5Fdm1CbG9ja2NoYWluIh0KG1VwZGF0ZUV2bUJsb2NrY2hhaW5SZXNwb25zZSJLChlMaXN0RXZtQmxvY2tjaGFpbnNSZXF1ZXN0Ei4KCnBhZ2luYXRpb24YASABKAsyGi5ldm1faW5kZXhlci52MS5QYWdpbmF0aW9uIlAKGkxpc3RFdm1CbG9ja2NoYWluc1Jlc3BvbnNlEjIKC2Jsb2NrY2hhaW5zGAEgAygLMh0uZXZtX2luZGV4ZXIudjEuRXZtQmxvY2tjaGFpbiIoChpEZWxldGVFdm1CbG9ja2NoYWluUmVxdWVzdBIKCgJpZBgBIAEoDSIdChtEZWxldGVFdm1CbG9ja2NoYWluUmVzcG9uc2UiQgoXQ3JlYXRlRXZtSnNvbkFiaVJlcXVlc3QSJwoDYWJpGAEgASgLMhouZXZtX2luZGV4ZXIudjEuRXZtSnNvbkFiaSImChhDcmVhdGVFdm1Kc29uQWJpUmVzcG9uc2USCgoCaWQYASABKA0iIgoUR2V0RXZtSnNvbkFiaVJlcXVlc3QSCgoCaWQYASABKA0iQAoVR2V0RXZtSnNvbkFiaVJlc3BvbnNlEicKA2FiaRgBIAEoCzIaLmV2bV9pbmRleGVyLnYxLkV2bUpzb25BYmkiQgoXVXBkYXRlRXZtSnNvbkFiaVJlcXVlc3QSJwoDYWJpGAEgASgLMhouZXZtX2luZGV4ZXIudjEuRXZtSnNvbkFiaSIaChhVcGRhdGVFdm1Kc29uQWJpUmVzcG9uc2UiSAoWTGlzdEV2bUpzb25BYmlzUmVxdWVzdBIuCgpwYWdpbmF0aW9uGAEgASgLMhouZXZtX2luZGV4ZXIudjEuUGFnaW5hdGlvbiJDChdMaXN0RXZtSnNvbkFiaXNSZXNwb25zZRIoCgRhYmlzGAEgAygLMhouZXZtX2luZGV4ZXIudjEuRXZtSnNvbkFiaSIlChdEZWxldGVFdm1Kc29uQWJpUmVxdWVzdBIKCgJpZBgBIAEoDSIaChhEZWxldGVFdm1Kc29uQWJpUmVzcG9uc2UibQoMRXZtQXJnU2NoZW1hEgwKBG5hbWUYASABKAkSDAoEdHlwZRgCIAEoCRIPCgdpbmRleGVkGAMgASgIEjAKCmNvbXBvbmVudHMYBCADKAsyHC5ldm1faW5kZXhlci52MS5Fdm1BcmdTY2hlbWEibwoORXZtRW50cnlTY2hlbWESDAoEbmFtZRgBIAEoCRIRCglzaWduYXR1cmUYAiABKAkSEAoIc2VsZWN0b3IYAyABKAkSKgoEYXJncxgEIAMoCzIcLmV2bV9pbmRleGVyLnYxLkV2bUFyZ1NjaGVtYSIoChpHZXRFdm1Kc29uQWJpU2NoZW1hUmVxdWVzdBIKCgJpZBgBIAEoDSKAAQobR2V0RXZtSnNvbkFiaVNjaGVtYVJlc3BvbnNlEi4KBmV2ZW50cxgBIAMoCzIeLmV2bV9pbmRleGVyLnYxLkV2bUVudHJ5U2NoZW1hEjEKCWZ1bmN0aW9ucxgCIAMoCzIeLmV2bV9pbmRleGVyLnYxLkV2bUVudHJ5U2NoZW1hInMKFEV2bUltcGxlbWVudGF0aW9uQWJpEg8KAmlkGAEgASgNSACIAQESGQoRZXZtX2Jsb2NrY2hhaW5faWQYAiABKA0SDwoHYWRkcmVzcxgDIAEoCRIXCg9ldm1fanNvbl9hYmlfaWQYBCABKA1CBQoDX2lkImIKHlNldEV2bUltcGxlbWVudGF0aW9uQWJpUmVxdWVzdBJAChJpbXBsZW1lbnRhdGlvbl9hYmkYASABKAsyJC5ldm1faW5kZXhlci52MS5Fdm1JbXBsZW1lbnRhdGlvbkFiaSItCh9TZXRFdm1JbXBsZW1lbnRhdGlvbkFiaVJlc3BvbnNlEgoKAmlkGAEgASgNIm0KIExpc3RFdm1JbXBsZW1lbnRhdGlvbkFiaXNSZXF1ZXN0EhkKEWV2bV9ibG9ja2NoYWluX2lkGAEgASgNEi4KCnBhZ2luYXRpb24YAiABKAsyGi5ldm1faW5kZXhlci52MS5QYWdpbmF0aW9uImYKIUxpc3RFdm1JbXBsZW1lbnRhdGlvbkFiaXNSZXNwb25zZRJBChNpbXBsZW1lbnRhdGlvbl9hYmlzGAEgAygLMiQuZXZtX2luZGV4ZXIudjEuRXZtSW1wbGVtZW50YXRpb25BYmkiLwohRGVsZXRlRXZtSW1wbGVtZW50YXRpb25BYmlSZXF1ZXN0EgoKAmlkGAEgASgNIiQKIkRlbGV0ZUV2bUltcGxlbWVudGF0aW9uQWJpUmVzcG9uc2UisgEKFkV2bVByb3h5SW1wbGVtZW50YXRpb24SEgoKcHJveHlfdHlwZRgBIAEoCRIOCgZiZWFjb24YAiABKAkSFgoOaW1wbGVtZW50YXRpb24YAyABKAkSEgoKZnJvbV9ibG9jaxgEIAEoBBIWCg5mcm9tX2xvZ19pbmRleBgFIAEoBBIcCg9ldm1fanNvbl9hYmlfaWQYBiABKA1IAIgBAUISChBfZXZtX2pzb25fYWJpX2lkIjcKIkxpc3RFdm1Qcm94eUltcGxlbWVudGF0aW9uc1JlcXVlc3QSEQoJc291cmNlX2lkGAEgASgNImYKI0xpc3RFdm1Qcm94eUltcGxlbWVudGF0aW9uc1Jlc3BvbnNlEj8KD2ltcGxlbWVudGF0aW9ucxgBIAMoCzImLmV2bV9pbmRleGVyLnYxLkV2bVByb3h5SW1wbGVtZW50YXRpb24ilwEKEUV2bUV2ZW50U2lnbmF0dXJlEg8KAmlkGAEgASgNSACIAQESDgoGdG9waWMwGAIgASgJEhUKDWluZGV4ZWRfY291bnQYAyABKA0SEQoJc2lnbmF0dXJlGAQgASgJEhwKD2V2bV9qc29uX2FiaV9pZBgFIAEoDUgBiAEBQgUKA19pZEISChBfZXZtX2pzb25fYWJpX2lkIjIKH0ltcG9ydEV2bUV2ZW50U2lnbmF0dXJlc1JlcXVlc3QSDwoHY29udGVudBgBIAEoCSJFCiBJbXBvcnRFdm1FdmVudFNpZ25hdHVyZXNSZXNwb25zZRIQCghpbXBvcnRlZBgBIAEoDRIPCgdza2lwcGVkGAIgASgNIl8KHUxpc3RFdm1FdmVudFNpZ25hdHVyZXNSZXF1ZXN0Eg4KBnRvcGljMBgBIAEoCRIuCgpwYWdpbmF0aW9uGAIgASgLMhouZXZtX2luZGV4ZXIudjEuUGFnaW5hdGlvbiJXCh5MaXN0RXZtRXZlbnRTaWduYXR1cmVzUmVzcG9uc2USNQoKc2lnbmF0dXJlcxgBIAMoCzIhLmV2bV9pbmRleGVyLnYxLkV2bUV2ZW50U2lnbmF0dXJlIiwKHkRlbGV0ZUV2bUV2ZW50U2lnbmF0dXJlUmVxdWVzdBIKCgJpZBgBIAEoDSIhCh9EZWxldGVFdm1FdmVudFNpZ25hdHVyZVJlc3BvbnNlIkYKGENyZWF0ZUV2bUxvZ1N0b3JlUmVxdWVzdBIqCgVzdG9yZRgBIAEoCzIbLmV2bV9pbmRleGVyLnYxLkV2bUxvZ1N0b3JlIicKGUNyZWF0ZUV2bUxvZ1N0b3JlUmVzcG9uc2USCgoCaWQYASABKA0iIwoVR2V0RXZtTG9nU3RvcmVSZXF1ZXN0EgoKAmlkGAEgASgNIkQKFkdldEV2bUxvZ1N0b3JlUmVzcG9uc2USKgoFc3RvcmUYASABKAsyGy5ldm1faW5kZXhlci52MS5Fdm1Mb2dTdG9yZSJGChhVcGRhdGVFdm1Mb2dTdG9yZVJlcXVlc3QSKgoFc3RvcmUYASABKAsyGy5ldm1faW5kZXhlci52MS5Fdm1Mb2dTdG9yZSIbChlVcGRhdGVFdm1Mb2dTdG9yZVJlc3BvbnNlIkkKF0xpc3RFdm1Mb2dTdG9yZXNSZXF1ZXN0Ei4KCnBhZ2luYXRpb24YASABKAsyGi5ldm1faW5kZXhlci52MS5QYWdpbmF0aW9uIkcKGExpc3RFdm1Mb2dTdG9yZXNSZXNwb25zZRIrCgZzdG9yZXMYASADKAsyGy5ldm1faW5kZXhlci52MS5Fdm1Mb2dTdG9yZSImChhEZWxldGVFdm1Mb2dTdG9yZVJlcXVlc3QSCgoCaWQYASABKA0iGwoZRGVsZXRlRXZtTG9nU3RvcmVSZXNwb25zZSJPChtDcmVhdGVFdm1Mb2dQaXBlbGluZVJlcXVlc3QSMAoIcGlwZWxpbmUYASABKAsyHi5ldm1faW5kZXhlci52MS5Fdm1Mb2dQaXBlbGluZSIqChxDcmVhdGVFdm1Mb2dQaXBlbGluZVJlc3BvbnNlEgoKAmlkGAEgASgNIiYKGEdldEV2bUxvZ1BpcGVsaW5lUmVxdWVzdBIKCgJpZBgBIAEoDSJNChlHZXRFdm1Mb2dQaXBlbGluZVJlc3BvbnNlEjAKCHBpcGVsaW5lGAEgASgLMh4uZXZtX2luZGV4ZXIudjEuRXZtTG9nUGlwZWxpbmUiTwobVXBkYXRlRXZtTG9nUGlwZWxpbmVSZXF1ZXN0EjAKCHBpcGVsaW5lGAEgASgLMh4uZXZtX2luZGV4ZXIudjEuRXZtTG9nUGlwZWxpbmUiHgocVXBkYXRlRXZtTG9nUGlwZWxpbmVSZXNwb25zZSJMChpMaXN0RXZtTG9nUGlwZWxpbmVzUmVxdWVzdBIuCgpwYWdpbmF0aW9uGAEgASgLMhouZXZtX2luZGV4ZXIudjEuUGFnaW5hdGlvbiJQChtMaXN0RXZtTG9nUGlwZWxpbmVzUmVzcG9uc2USMQoJcGlwZWxpbmVzGAEgAygLMh4uZXZtX2luZGV4ZXIudjEuRXZtTG9nUGlwZWxpbmUiKQobRGVsZXRlRXZtTG9nUGlwZWxpbmVSZXF1ZXN0EgoKAmlkGAEgASgNIh4KHERlbGV0ZUV2bUxvZ1BpcGVsaW5lUmVzcG9uc2UiSQoZQ3JlYXRlRXZtTG9nU291cmNlUmVxdWVzdBIsCgZzb3VyY2UYASABKAsyHC5ldm1faW5kZXhlci52MS5Fdm1Mb2dTb3VyY2UiKAoaQ3JlYXRlRXZtTG9nU291cmNlUmVzcG9uc2USCgoCaWQYASABKA0iJAoWR2V0RXZtTG9nU291cmNlUmVxdWVzdBIKCgJpZBgBIAEoDSJHChdHZXRFdm1Mb2dTb3VyY2VSZXNwb25zZRIsCgZzb3VyY2UYASABKAsyHC5ldm1faW5kZXhlci52MS5Fdm1Mb2dTb3VyY2UiSQoZVXBkYXRlRXZtTG9nU291cmNlUmVxdWVzdBIsCgZzb3VyY2UYASABKAsyHC5ldm1faW5kZXhlci52MS5Fdm1Mb2dTb3VyY2UiHAoaVXBkYXRlRXZtTG9nU291cmNlUmVzcG9uc2UiXwoYTGlzdEV2bUxvZ1NvdXJjZXNSZXF1ZXN0Ei4KCnBhZ2luYXRpb24YASABKAsyGi5ldm1faW5kZXhlci52MS5QYWdpbmF0aW9uEhMKC3BpcGVsaW5lX2lkGAIgASgNIkoKGUxpc3RFdm1Mb2dTb3VyY2VzUmVzcG9uc2USLQoHc291cmNlcxgBIAMoCzIcLmV2bV9pbmRleGVyLnYxLkV2bUxvZ1NvdXJjZSInChlEZWxldGVFdm1Mb2dTb3VyY2VSZXF1ZXN0EgoKAmlkGAEgASgNIhwKGkRlbGV0ZUV2bUxvZ1NvdXJjZVJlc3BvbnNlIjcKIFN0cmVhbUV2bUxvZ1NvdXJjZVVwZGF0ZXNSZXF1ZXN0EhMKC3BpcGVsaW5lX2lkGAEgASgNIikKG1JlZGVjb2RlRXZtTG9nU291cmNlUmVxdWVzdBIKCgJpZBgBIAEoDSIeChxSZWRlY29kZUV2bUxvZ1NvdXJjZVJlc3BvbnNlIlUKGVJlc3luY0V2bUxvZ1NvdXJjZVJlcXVlc3QSCgoCaWQYASABKA0SEgoKZnJvbV9ibG9jaxgCIAEoBBIYChBpbmNsdWRlX2NoaWxkcmVuGAMgASgIIjAKGlJlc3luY0V2bUxvZ1NvdXJjZVJlc3BvbnNlEhIKCnNvdXJjZV9pZHMYASADKA0iJwoZU3RhcnRTb3VyY2VJbmRleGVyUmVxdWVzdBIKCgJpZBgBIAEoDSI8ChpTdGFydFNvdXJjZUluZGV4ZXJSZXNwb25zZRIPCgdzdWNjZXNzGAEgASgIEg0KBWVycm9yGAIgASgJIiYKGFN0b3BTb3VyY2VJbmRleGVyUmVxdWVzdBIKCgJpZBgBIAEoDSI7ChlTdG9wU291cmNlSW5kZXhlclJlc3BvbnNlEg8KB3N1Y2Nlc3MYASABKAgSDQoFZXJyb3IYAiABKAkiTQoSTGlzdEV2bUxvZ3NSZXF1ZXN0EhEKCXNvdXJjZV9pZBgBIAEoDRISCgpmcm9tX2Jsb2NrGAIgASgEEhAKCHRvX2Jsb2NrGAMgASgEIjsKE0xpc3RFdm1Mb2dzUmVzcG9uc2USJAoEbG9ncxgBIAMoCzIWLmV2bV9pbmRleGVyLnYxLkV2bUxvZyI8ChhMaXN0TGF0ZXN0RXZtTG9nc1JlcXVlc3QSEQoJc291cmNlX2lkGAEgASgNEg0KBWxpbWl0GAIgASgEIkEKGUxpc3RMYXRlc3RFdm1Mb2dzUmVzcG9uc2USJAoEbG9ncxgBIAMoCzIWLmV2bV9pbmRleGVyLnYxLkV2bUxvZyJVChpMaXN0RXZtVHJhbnNhY3Rpb25zUmVxdWVzdBIRCglzb3VyY2VfaWQYASABKA0SEgoKZnJvbV9ibG9jaxgCIAEoBBIQCgh0b19ibG9jaxgDIAEoBCJTChtMaXN0RXZtVHJhbnNhY3Rpb25zUmVzcG9uc2USNAoMdHJhbnNhY3Rpb25zGAEgAygLMh4uZXZtX2luZGV4ZXIudjEuRXZtVHJhbnNhY3Rpb24iTwoUTGlzdEV2bUJsb2Nrc1JlcXVlc3QSEQoJc291cmNlX2lkGAEgASgNEhIKCmZyb21fYmxvY2sYAiABKAQSEAoIdG9fYmxvY2sYAyABKAQiQQoVTGlzdEV2bUJsb2Nrc1Jlc3BvbnNlEigKBmJsb2NrcxgBIAMoCzIYLmV2bV9pbmRleGVyLnYxLkV2bUJsb2NrIk8KFExpc3RFdm1UcmFjZXNSZXF1ZXN0EhEKCXNvdXJjZV9pZBgBIAEoDRISCgpmcm9tX2Jsb2NrGAIgASgEEhAKCHRvX2Jsb2NrGAMgASgEIkEKFUxpc3RFdm1UcmFjZXNSZXNwb25zZRIoCgZ0cmFjZXMYASADKAsyGC5ldm1faW5kZXhlci52MS5Fdm1UcmFjZSJFCghBdXRoVXNlchIKCgJpZBgBIAEoDRIQCgh1c2VybmFtZRgCIAEoCRINCgVlbWFpbBgDIAEoCRIMCgRyb2xlGAQgASgJIqcBCg9BY2Nlc3NUb2tlbkluZm8SCgoCaWQYASABKA0SDAoEbmFtZRgCIAEoCRIXCgpjcmVhdGVkX2F0GAMgASgDSACIAQESFwoKZXhwaXJlc19hdBgEIAEoA0gBiAEBEhkKDGxhc3RfdXNlZF9hdBgFIAEoA0gCiAEBQg0KC19jcmVhdGVkX2F0Qg0KC19leHBpcmVzX2F0Qg8KDV9sYXN0X3VzZWRfYXQiMgoMTG9naW5SZXF1ZXN0EhAKCHVzZXJuYW1lGAEgASgJEhAKCHBhc3N3b3JkGAIgASgJIkYKDUxvZ2luUmVzcG9uc2USDQoFdG9rZW4YASABKAkSFwoKZXhwaXJlc19hdBgCIAEoA0gAiAEBQg0KC19leHBpcmVzX2F0IgsKCU1lUmVxdWVzdCI0CgpNZVJlc3BvbnNlEiYKBHVzZXIYASABKAsyGC5ldm1faW5kZXhlci52MS5BdXRoVXNlciJBChhDcmVhdGVBY2Nlc3NUb2tlblJlcXVlc3QSDAoEbmFtZRgBIAEoCRIXCg9leHBpcmVzX2luX2RheXMYAiABKA0ibAoZQ3JlYXRlQWNjZXNzVG9rZW5SZXNwb25zZRIKCgJpZBgBIAEoDRIMCgRuYW1lGAIgASgJEg0KBXRva2VuGAMgASgJEhcKCmV4cGlyZXNfYXQYBCABKANIAIgBAUINCgtfZXhwaXJlc19hdCIZChdMaXN0QWNjZXNzVG9rZW5zUmVxdWVzdCJLChhMaXN0QWNjZXNzVG9rZW5zUmVzcG9uc2USLwoGdG9rZW5zGAEgAygLMh8uZXZtX2luZGV4ZXIudjEuQWNjZXNzVG9rZW5JbmZvIiYKGFJldm9rZUFjY2Vzc1Rva2VuUmVxdWVzdBIKCgJpZBgBIAEoDSIbChlSZXZva2VBY2Nlc3NUb2tlblJlc3BvbnNlIrsBCg1PQXV0aFByb3ZpZGVyEg8KAmlkGAEgASgNSACIAQESDwoHZW5hYmxlZBgCIAEoCBIMCgRuYW1lGAMgASgJEhEKCWNsaWVudF9pZBgEIAEoCRIQCghhdXRoX3VybBgFIAEoCRIRCgl0b2tlbl91cmwYBiABKAkSFQoNdXNlcl9pbmZvX3VybBgHIAEoCRIUCgxyZWRpcmVjdF91cmwYCCABKAkSDgoGc2NvcGVzGAkgASgJQgUKA19pZCJkChpDcmVhdGVPQXV0aFByb3ZpZGVyUmVxdWVzdBIvCghwcm92aWRlchgBIAEoCzIdLmV2bV9pbmRleGVyLnYxLk9BdXRoUHJvdmlkZXISFQoNY2xpZW50X3NlY3JldBgCIAEoCSIpChtDcmVhdGVPQXV0aFByb3ZpZGVyUmVzcG9uc2USCgoCaWQYASABKA0iZAoaVXBkYXRlT0F1dGhQcm92aWRlclJlcXVlc3QSLwoIcHJvdmlkZXIYASABKAsyHS5ldm1faW5kZXhlci52MS5PQXV0aFByb3ZpZGVyEhUKDWNsaWVudF9zZWNyZXQYAiABKAkiHQobVXBkYXRlT0F1dGhQcm92aWRlclJlc3BvbnNlIhsKGUxpc3RPQXV0aFByb3ZpZGVyc1JlcXVlc3QiTgoaTGlzdE9BdXRoUHJvdmlkZXJzUmVzcG9uc2USMAoJcHJvdmlkZXJzGAEgAygLMh0uZXZtX2luZGV4ZXIudjEuT0F1dGhQcm92aWRlciIoChpEZWxldGVPQXV0aFByb3ZpZGVyUmVxdWVzdBIKCgJpZBgBIAEoDSIdChtEZWxldGVPQXV0aFByb3ZpZGVyUmVzcG9uc2UiQgoQT0F1dGhMb2dpbk9wdGlvbhITCgtwcm92aWRlcl9pZBgBIAEoDRIMCgRuYW1lGAIgASgJEgsKA3VybBgDIAEoCSIbChlMaXN0T0F1dGhMb2dpblVybHNSZXF1ZXN0Ik8KGkxpc3RPQXV0aExvZ2luVXJsc1Jlc3BvbnNlEjEKB29wdGlvbnMYASADKAsyIC5ldm1faW5kZXhlci52MS5PQXV0aExvZ2luT3B0aW9uIhIKEExpc3RVc2Vyc1JlcXVlc3QiPAoRTGlzdFVzZXJzUmVzcG9uc2USJwoFdXNlcnMYASADKAsyGC5ldm1faW5kZXhlci52MS5BdXRoVXNlciJUChFDcmVhdGVVc2VyUmVxdWVzdBIQCgh1c2VybmFtZRgBIAEoCRIQCghwYXNzd29yZBgCIAEoCRIMCgRyb2xlGAMgASgJEg0KBWVtYWlsGAQgASgJIiAKEkNyZWF0ZVVzZXJSZXNwb25zZRIKCgJpZBgBIAEoDSJgChFVcGRhdGVVc2VyUmVxdWVzdBIKCgJpZBgBIAEoDRIQCgh1c2VybmFtZRgCIAEoCRIMCgRyb2xlGAMgASgJEg0KBWVtYWlsGAQgASgJEhAKCHBhc3N3b3JkGAUgASgJIhQKElVwZGF0ZVVzZXJSZXNwb25zZSIfChFEZWxldGVVc2VyUmVxdWVzdBIKCgJpZBgBIAEoDSIUChJEZWxldGVVc2VyUmVzcG9uc2Ui2gIKDEV2bWlFeHBvcnRlchIPCgJpZBgBIAEoDUgAiAEBEgwKBG5hbWUYAiABKAkSGwoTZXZtX2xvZ19waXBlbGluZV9pZBgDIAEoDRIPCgdlbmFibGVkGAQgASgIEg4KBnN0YXR1cxgFIAEoCRITCgtzdGFydF9ibG9jaxgGIAEoBBISCgpzeW5jX2Jsb2NrGAcgASgEEhYKDnN5bmNfbG9nX2luZGV4GAggASgDEhoKEnBsdWdpbl9jb25maWdfanNvbhgJIAEoCRIRCglwbHVnaW5faWQYCiABKA0SFwoKY3JlYXRlZF9hdBgNIAEoDUgBiAEBEhcKCnVwZGF0ZWRfYXQYDiABKA1IAogBARIXCgpkZWxldGVkX2F0GA8gASgNSAOIAQFCBQoDX2lkQg0KC19jcmVhdGVkX2F0Qg0KC191cGRhdGVkX2F0Qg0KC19kZWxldGVkX2F0IrUCCgZQbHVnaW4SDwoCaWQYASABKA1IAIgBARIMCgRuYW1lGAIgASgJEhMKC2Rlc2NyaXB0aW9uGAMgASgJEg8KB2dpdF91cmwYBCABKAkSDwoHc29fcGF0aBgHIAEoCRIOCgZzdGF0dXMYCCABKAkSDQoFZXJyb3IYCSABKAkSDwoHZ2l0X3JlZhgOIAEoCRIaChJjb25maWdfc2NoZW1hX2pzb24YDSABKAkSFwoKY3JlYXRlZF9hdBgKIAEoDUgBiAEBEhcKCnVwZGF0ZWRfYXQYCyABKA1IAogBARIXCgpkZWxldGVkX2F0GAwgASgNSAOIAQFCBQoDX2lkQg0KC19jcmVhdGVkX2F0Qg0KC191cGRhdGVkX2F0Qg0KC19kZWxldGVkX2F0SgQIBRAGSgQIBhAHIj0KE0NyZWF0ZVBsdWdpblJlcXVlc3QSJgoGcGx1Z2luGAEgASgLMhYuZXZtX2luZGV4ZXIudjEuUGx1Z2luIiIKFENyZWF0ZVBsdWdpblJlc3BvbnNlEgoKAmlkGAEgASgNIh4KEEdldFBsdWdpblJlcXVlc3QSCgoCaWQYASABKA0iOwoRR2V0UGx1Z2luUmVzcG9uc2USJgoGcGx1Z2luGAEgASgLMhYuZXZtX2luZGV4ZXIudjEuUGx1Z2luIj0KE1VwZGF0ZVBsdWdpblJlcXVlc3QSJgoGcGx1Z2luGAEgASgLMhYuZXZtX2luZGV4ZXIudjEuUGx1Z2luIhYKFFVwZGF0ZVBsdWdpblJlc3BvbnNlIkQKEkxpc3RQbHVnaW5zUmVxdWVzdBIuCgpwYWdpbmF0aW9uGAEgASgLMhouZXZtX2luZGV4ZXIudjEuUGFnaW5hdGlvbiI+ChNMaXN0UGx1Z2luc1Jlc3BvbnNlEicKB3BsdWdpbnMYASADKAsyFi5ldm1faW5kZXhlci52MS5QbHVnaW4iIQoTRGVsZXRlUGx1Z2luUmVxdWVzdBIKCgJpZBgBIAEoDSIWChREZWxldGVQbHVnaW5SZXNwb25zZSIiChRJbnN0YWxsUGx1Z2luUmVxdWVzdBIKCgJpZBgBIAEoDSJHChVJbnN0YWxsUGx1Z2luUmVzcG9uc2USDwoHc3VjY2VzcxgBIAEoCBINCgVlcnJvchgCIAEoCRIOCgZzdGF0dXMYAyABKAkiKwoYTGlzdFBsdWdpbkdpdFJlZnNSZXF1ZXN0Eg8KB2dpdF91cmwYASABKAkiOwoZTGlzdFBsdWdpbkdpdFJlZnNSZXNwb25zZRIQCghicmFuY2hlcxgBIAMoCRIMCgR0YWdzGAIgAygJIksKGUNyZWF0ZUV2bWlFeHBvcnRlclJlcXVlc3QSLgoIZXhwb3J0ZXIYASABKAsyHC5ldm1faW5kZXhlci52MS5Fdm1pRXhwb3J0ZXIiKAoaQ3JlYXRlRXZtaUV4cG9ydGVyUmVzcG9uc2USCgoCaWQYASABKA0iJAoWR2V0RXZtaUV4cG9ydGVyUmVxdWVzdBIKCgJpZBgBIAEoDSJJChdHZXRFdm1pRXhwb3J0ZXJSZXNwb25zZRIuCghleHBvcnRlchgBIAEoCzIcLmV2bV9pbmRleGVyLnYxLkV2bWlFeHBvcnRlciJLChlVcGRhdGVFdm1pRXhwb3J0ZXJSZXF1ZXN0Ei4KCGV4cG9ydGVyGAEgASgLMhwuZXZtX2luZGV4ZXIudjEuRXZtaUV4cG9ydGVyIhwKGlVwZGF0ZUV2bWlFeHBvcnRlclJlc3BvbnNlIl8KGExpc3RFdm1pRXhwb3J0ZXJzUmVxdWVzdBIuCgpwYWdpbmF0aW9uGAEgASgLMhouZXZtX2luZGV4ZXIudjEuUGFnaW5hdGlvbhITCgtwaXBlbGluZV9pZBgCIAEoDSJMChlMaXN0RXZtaUV4cG9ydGVyc1Jlc3BvbnNlEi8KCWV4cG9ydGVycxgBIAMoCzIcLmV2bV9pbmRleGVyLnYxLkV2bWlFeHBvcnRlciInChlEZWxldGVFdm1pRXhwb3J0ZXJSZXF1ZXN0EgoKAmlkGAEgASgNIhwKGkRlbGV0ZUV2bWlFeHBvcnRlclJlc3BvbnNlIiIKFFN0YXJ0RXhwb3J0ZXJSZXF1ZXN0EgoKAmlkGAEgASgNIjcKFVN0YXJ0RXhwb3J0ZXJSZXNwb25zZRIPCgdzdWNjZXNzGAEgASgIEg0KBWVycm9yGAIgASgJIiEKE1N0b3BFeHBvcnRlclJlcXVlc3QSCgoCaWQYASABKA0iNgoUU3RvcEV4cG9ydGVyUmVzcG9uc2USDwoHc3VjY2VzcxgBIAEoCBINCgVlcnJvchgCIAEoCSI3CiBTdHJlYW1Fdm1pRXhwb3J0ZXJVcGRhdGVzUmVxdWVzdBITCgtwaXBlbGluZV9pZBgBIAEoDSIcChpFeHBvcnRDb25maWd1cmF0aW9uUmVxdWVzdCIyChtFeHBvcnRDb25maWd1cmF0aW9uUmVzcG9uc2USEwoLY29uZmlnX2pzb24YASABKAkyvT0KEUV2bUluZGV4ZXJTZXJ2aWNlEmIKD0dldEV2bWlJbnN0YW5jZRImLmV2bV9pbmRleGVyLnYxLkdldEV2bWlJbnN0YW5jZVJlcXVlc3QaJy5ldm1faW5kZXhlci52MS5HZXRFdm1pSW5zdGFuY2VSZXNwb25zZRJoChFMaXN0RXZtaUluc3RhbmNlcxIoLmV2bV9pbmRleGVyLnYxLkxpc3RFdm1pSW5zdGFuY2VzUmVxdWVzdBopLmV2bV9pbmRleGVyLnYxLkxpc3RFdm1pSW5zdGFuY2VzUmVzcG9uc2USbgoTQ3JlYXRlRXZtQmxvY2tjaGFpbhIqLmV2bV9pbmRleGVyLnYxLkNyZWF0ZUV2bUJsb2NrY2hhaW5SZXF1ZXN0GisuZXZtX2luZGV4ZXIudjEuQ3JlYXRlRXZtQmxvY2tjaGFpblJlc3BvbnNlEmUKEEdldEV2bUJsb2NrY2hhaW4SJy5ldm1faW5kZXhlci52MS5HZXRFdm1CbG9ja2NoYWluUmVxdWVzdBooLmV2bV9pbmRleGVyLnYxLkdldEV2bUJsb2NrY2hhaW5SZXNwb25zZRJuChNVcGRhdGVFdm1CbG9ja2NoYWluEiouZXZtX2luZGV4ZXIudjEuVXBkYXRlRXZtQmxvY2tjaGFpblJlcXVlc3QaKy5ldm1faW5kZXhlci52MS5VcGRhdGVFdm1CbG9ja2NoYWluUmVzcG9uc2USawoSTGlzdEV2bUJsb2NrY2hhaW5zEikuZXZtX2luZGV4ZXIudjEuTGlzdEV2bUJsb2NrY2hhaW5zUmVxdWVzdBoqLmV2bV9pbmRleGVyLnYxLkxpc3RFdm1CbG9ja2NoYWluc1Jlc3BvbnNlEm4KE0RlbGV0ZUV2bUJsb2NrY2hhaW4SKi5ldm1faW5kZXhlci52MS5EZWxldGVFdm1CbG9ja2NoYWluUmVxdWVzdBorLmV2bV9pbmRleGVyLnYxLkRlbGV0ZUV2bUJsb2NrY2hhaW5SZXNwb25zZRJlChBDcmVhdGVFdm1Kc29uQWJpEicuZXZtX2luZGV4ZXIudjEuQ3JlYXRlRXZtSnNvbkFiaVJlcXVlc3QaKC5ldm1faW5kZXhlci52MS5DcmVhdGVFdm1Kc29uQWJpUmVzcG9uc2USXAoNR2V0RXZtSnNvbkFiaRIkLmV2bV9pbmRleGVyLnYxLkdldEV2bUpzb25BYmlSZXF1ZXN0GiUuZXZtX2luZGV4ZXIudjEuR2V0RXZtSnNvbkFiaVJlc3BvbnNlEmUKEFVwZGF0ZUV2bUpzb25BYmkSJy5ldm1faW5kZXhlci52MS5VcGRhdGVFdm1Kc29uQWJpUmVxdWVzdBooLmV2bV9pbmRleGVyLnYxLlVwZGF0ZUV2bUpzb25BYmlSZXNwb25zZRJiCg9MaXN0RXZtSnNvbkFiaXMSJi5ldm1faW5kZXhlci52MS5MaXN0RXZtSnNvbkFiaXNSZXF1ZXN0GicuZXZtX2luZGV4ZXIudjEuTGlzdEV2bUpzb25BYmlzUmVzcG9uc2USZQoQRGVsZXRlRXZtSnNvbkFiaRInLmV2bV9pbmRleGVyLnYxLkRlbGV0ZUV2bUpzb25BYmlSZXF1ZXN0GiguZXZtX2luZGV4ZXIudjEuRGVsZXRlRXZtSnNvbkFiaVJlc3BvbnNlEm4KE0dldEV2bUpzb25BYmlTY2hlbWESKi5ldm1faW5kZXhlci52MS5HZXRFdm1Kc29uQWJpU2NoZW1hUmVxdWVzdBorLmV2bV9pbmRleGVyLnYxLkdldEV2bUpzb25BYmlTY2hlbWFSZXNwb25zZRJ6ChdTZXRFdm1JbXBsZW1lbnRhdGlvbkFiaRIuLmV2bV9pbmRleGVyLnYxLlNldEV2bUltcGxlbWVudGF0aW9uQWJpUmVxdWVzdBovLmV2bV9pbmRleGVyLnYxLlNldEV2bUltcGxlbWVudGF0aW9uQWJpUmVzcG9uc2USgAEKGUxpc3RFdm1JbXBsZW1lbnRhdGlvbkFiaXMSMC5ldm1faW5kZXhlci52MS5MaXN0RXZtSW1wbGVtZW50YXRpb25BYmlzUmVxdWVzdBoxLmV2bV9pbmRleGVyLnYxLkxpc3RFdm1JbXBsZW1lbnRhdGlvbkFiaXNSZXNwb25zZRKDAQoaRGVsZXRlRXZtSW1wbGVtZW50YXRpb25BYmkSMS5ldm1faW5kZXhlci52MS5EZWxldGVFdm1JbXBsZW1lbnRhdGlvbkFiaVJlcXVlc3QaMi5ldm1faW5kZXhlci52MS5EZWxldGVFdm1JbXBsZW1lbnRhdGlvbkFiaVJlc3BvbnNlEoYBChtMaXN0RXZtUHJveHlJbXBsZW1lbnRhdGlvbnMSMi5ldm1faW5kZXhlci52MS5MaXN0RXZtUHJveHlJbXBsZW1lbnRhdGlvbnNSZXF1ZXN0GjMuZXZtX2luZGV4ZXIudjEuTGlzdEV2bVByb3h5SW1wbGVtZW50YXRpb25zUmVzcG9uc2USfQoYSW1wb3J0RXZtRXZlbnRTaWduYXR1cmVzEi8uZXZtX2luZGV4ZXIudjEuSW1wb3J0RXZtRXZlbnRTaWduYXR1cmVzUmVxdWVzdBowLmV2bV9pbmRleGVyLnYxLkltcG9ydEV2bUV2ZW50U2lnbmF0dXJlc1Jlc3BvbnNlEncKFkxpc3RFdm1FdmVudFNpZ25hdHVyZXMSLS5ldm1faW5kZXhlci52MS5MaXN0RXZtRXZlbnRTaWduYXR1cmVzUmVxdWVzdBouLmV2bV9pbmRleGVyLnYxLkxpc3RFdm1FdmVudFNpZ25hdHVyZXNSZXNwb25zZRJ6ChdEZWxldGVFdm1FdmVudFNpZ25hdHVyZRIuLmV2bV9pbmRleGVyLnYxLkRlbGV0ZUV2bUV2ZW50U2lnbmF0dXJlUmVxdWVzdBovLmV2bV9pbmRleGVyLnYxLkRlbGV0ZUV2bUV2ZW50U2lnbmF0dXJlUmVzcG9uc2USaAoRQ3JlYXRlRXZtTG9nU3RvcmUSKC5ldm1faW5kZXhlci52MS5DcmVhdGVFdm1Mb2dTdG9yZVJlcXVlc3QaKS5ldm1faW5kZXhlci52MS5DcmVhdGVFdm1Mb2dTdG9yZVJlc3BvbnNlEl8KDkdldEV2bUxvZ1N0b3JlEiUuZXZtX2luZGV4ZXIudjEuR2V0RXZtTG9nU3RvcmVSZXF1ZXN0GiYuZXZtX2luZGV4ZXIudjEuR2V0RXZtTG9nU3RvcmVSZXNwb25zZRJoChFVcGRhdGVFdm1Mb2dTdG9yZRIoLmV2bV9pbmRleGVyLnYxLlVwZGF0ZUV2bUxvZ1N0b3JlUmVxdWVzdBopLmV2bV9pbmRleGVyLnYxLlVwZGF0ZUV2bUxvZ1N0b3JlUmVzcG9uc2USZQoQTGlzdEV2bUxvZ1N0b3JlcxInLmV2bV9pbmRleGVyLnYxLkxpc3RFdm1Mb2dTdG9yZXNSZXF1ZXN0GiguZXZtX2luZGV4ZXIudjEuTGlzdEV2bUxvZ1N0b3Jlc1Jlc3BvbnNlEmgKEURlbGV0ZUV2bUxvZ1N0b3JlEiguZXZtX2luZGV4ZXIudjEuRGVsZXRlRXZtTG9nU3RvcmVSZXF1ZXN0GikuZXZtX2luZGV4ZXIudjEuRGVsZXRlRXZtTG9nU3RvcmVSZXNwb25zZRJxChRDcmVhdGVFdm1Mb2dQaXBlbGluZRIrLmV2bV9pbmRleGVyLnYxLkNyZWF0ZUV2bUxvZ1BpcGVsaW5lUmVxdWVzdBosLmV2bV9pbmRleGVyLnYxLkNyZWF0ZUV2bUxvZ1BpcGVsaW5lUmVzcG9uc2USaAoRR2V0RXZtTG9nUGlwZWxpbmUSKC5ldm1faW5kZXhlci52MS5HZXRFdm1Mb2dQaXBlbGluZVJlcXVlc3QaKS5ldm1faW5kZXhlci52MS5HZXRFdm1Mb2dQaXBlbGluZVJlc3BvbnNlEnEKFFVwZGF0ZUV2bUxvZ1BpcGVsaW5lEisuZXZtX2luZGV4ZXIudjEuVXBkYXRlRXZtTG9nUGlwZWxpbmVSZXF1ZXN0GiwuZXZtX2luZGV4ZXIudjEuVXBkYXRlRXZtTG9nUGlwZWxpbmVSZXNwb25zZRJuChNMaXN0RXZtTG9nUGlwZWxpbmVzEiouZXZtX2luZGV4ZXIudjEuTGlzdEV2bUxvZ1BpcGVsaW5lc1JlcXVlc3QaKy5ldm1faW5kZXhlci52MS5MaXN0RXZtTG9nUGlwZWxpbmVzUmVzcG9uc2UScQoURGVsZXRlRXZtTG9nUGlwZWxpbmUSKy5ldm1faW5kZXhlci52MS5EZWxldGVFdm1Mb2dQaXBlbGluZVJlcXVlc3QaLC5ldm1faW5kZXhlci52MS5EZWxldGVFdm1Mb2dQaXBlbGluZVJlc3BvbnNlEm0KElN0YXJ0U291cmNlSW5kZXhlchIpLmV2bV9pbmRleGVyLnYxLlN0YXJ0U291cmNlSW5kZXhlclJlcXVlc3QaKi5ldm1faW5kZXhlci52MS5TdGFydFNvdXJjZUluZGV4ZXJSZXNwb25zZSIAEmoKEVN0b3BTb3VyY2VJbmRleGVyEiguZXZtX2luZGV4ZXIudjEuU3RvcFNvdXJjZUluZGV4ZXJSZXF1ZXN0GikuZXZtX2luZGV4ZXIudjEuU3RvcFNvdXJjZUluZGV4ZXJSZXNwb25zZSIAEmsKEkNyZWF0ZUV2bUxvZ1NvdXJjZRIpLmV2bV9pbmRleGVyLnYxLkNyZWF0ZUV2bUxvZ1NvdXJjZVJlcXVlc3QaKi5ldm1faW5kZXhlci52MS5DcmVhdGVFdm1Mb2dTb3VyY2VSZXNwb25zZRJiCg9HZXRFdm1Mb2dTb3VyY2USJi5ldm1faW5kZXhlci52MS5HZXRFdm1Mb2dTb3VyY2VSZXF1ZXN0GicuZXZtX2luZGV4ZXIudjEuR2V0RXZtTG9nU291cmNlUmVzcG9uc2USawoSVXBkYXRlRXZtTG9nU291cmNlEikuZXZtX2luZGV4ZXIudjEuVXBkYXRlRXZtTG9nU291cmNlUmVxdWVzdBoqLmV2bV9pbmRleGVyLnYxLlVwZGF0ZUV2bUxvZ1NvdXJjZVJlc3BvbnNlEmgKEUxpc3RFdm1Mb2dTb3VyY2VzEiguZXZtX2luZGV4ZXIudjEuTGlzdEV2bUxvZ1NvdXJjZXNSZXF1ZXN0GikuZXZtX2luZGV4ZXIudjEuTGlzdEV2bUxvZ1NvdXJjZXNSZXNwb25zZRJrChJEZWxldGVFdm1Mb2dTb3VyY2USKS5ldm1faW5kZXhlci52MS5EZWxldGVFdm1Mb2dTb3VyY2VSZXF1ZXN0GiouZXZtX2luZGV4ZXIudjEuRGVsZXRlRXZtTG9nU291cmNlUmVzcG9uc2UScQoUUmVkZWNvZGVFdm1Mb2dTb3VyY2USKy5ldm1faW5kZXhlci52MS5SZWRlY29kZUV2bUxvZ1NvdXJjZVJlcXVlc3QaLC5ldm1faW5kZXhlci52MS5SZWRlY29kZUV2bUxvZ1NvdXJjZVJlc3BvbnNlEmsKElJlc3luY0V2bUxvZ1NvdXJjZRIpLmV2bV9pbmRleGVyLnYxLlJlc3luY0V2bUxvZ1NvdXJjZVJlcXVlc3QaKi5ldm1faW5kZXhlci52MS5SZXN5bmNFdm1Mb2dTb3VyY2VSZXNwb25zZRJtChlTdHJlYW1Fdm1Mb2dTb3VyY2VVcGRhdGVzEjAuZXZtX2luZGV4ZXIudjEuU3RyZWFtRXZtTG9nU291cmNlVXBkYXRlc1JlcXVlc3QaHC5ldm1faW5kZXhlci52MS5Fdm1Mb2dTb3VyY2UwARJWCgtMaXN0RXZtTG9ncxIiLmV2bV9pbmRleGVyLnYxLkxpc3RFdm1Mb2dzUmVxdWVzdBojLmV2bV9pbmRleGVyLnYxLkxpc3RFdm1Mb2dzUmVzcG9uc2USaAoRTGlzdExhdGVzdEV2bUxvZ3MSKC5ldm1faW5kZXhlci52MS5MaXN0TGF0ZXN0RXZtTG9nc1JlcXVlc3QaKS5ldm1faW5kZXhlci52MS5MaXN0TGF0ZXN0RXZtTG9nc1Jlc3BvbnNlEm4KE0xpc3RFdm1UcmFuc2FjdGlvbnMSKi5ldm1faW5kZXhlci52MS5MaXN0RXZtVHJhbnNhY3Rpb25zUmVxdWVzdBorLmV2bV9pbmRleGVyLnYxLkxpc3RFdm1UcmFuc2FjdGlvbnNSZXNwb25zZRJcCg1MaXN0RXZtQmxvY2tzEiQuZXZtX2luZGV4ZXIudjEuTGlzdEV2bUJsb2Nrc1JlcXVlc3QaJS5ldm1faW5kZXhlci52MS5MaXN0RXZtQmxvY2tzUmVzcG9uc2USXAoNTGlzdEV2bVRyYWNlcxIkLmV2bV9pbmRleGVyLnYxLkxpc3RFdm1UcmFjZXNSZXF1ZXN0GiUuZXZtX2luZGV4ZXIudjEuTGlzdEV2bVRyYWNlc1Jlc3BvbnNlEkQKBUxvZ2luEhwuZXZtX2luZGV4ZXIudjEuTG9naW5SZXF1ZXN0Gh0uZXZtX2luZGV4ZXIudjEuTG9naW5SZXNwb25zZRJrChJMaXN0T0F1dGhMb2dpblVybHMSKS5ldm1faW5kZXhlci52MS5MaXN0T0F1dGhMb2dpblVybHNSZXF1ZXN0GiouZXZtX2luZGV4ZXIudjEuTGlzdE9BdXRoTG9naW5VcmxzUmVzcG9uc2USOwoCTWUSGS5ldm1faW5kZXhlci52MS5NZVJlcXVlc3QaGi5ldm1faW5kZXhlci52MS5NZVJlc3BvbnNlEmgKEUNyZWF0ZUFjY2Vzc1Rva2VuEiguZXZtX2luZGV4ZXIudjEuQ3JlYXRlQWNjZXNzVG9rZW5SZXF1ZXN0GikuZXZtX2luZGV4ZXIudjEuQ3JlYXRlQWNjZXNzVG9rZW5SZXNwb25zZRJlChBMaXN0QWNjZXNzVG9rZW5zEicuZXZtX2luZGV4ZXIudjEuTGlzdEFjY2Vzc1Rva2Vuc1JlcXVlc3QaKC5ldm1faW5kZXhlci52MS5MaXN0QWNjZXNzVG9rZW5zUmVzcG9uc2USaAoRUmV2b2tlQWNjZXNzVG9rZW4SKC5ldm1faW5kZXhlci52MS5SZXZva2VBY2Nlc3NUb2tlblJlcXVlc3QaKS5ldm1faW5kZXhlci52MS5SZXZva2VBY2Nlc3NUb2tlblJlc3BvbnNlEmsKEkxpc3RPQXV0aFByb3ZpZGVycxIpLmV2bV9pbmRleGVyLnYxLkxpc3RPQXV0aFByb3ZpZGVyc1JlcXVlc3QaKi5ldm1faW5kZXhlci52MS5MaXN0T0F1dGhQcm92aWRlcnNSZXNwb25zZRJuChNDcmVhdGVPQXV0aFByb3ZpZGVyEiouZXZtX2luZGV4ZXIudjEuQ3JlYXRlT0F1dGhQcm92aWRlclJlcXVlc3QaKy5ldm1faW5kZXhlci52MS5DcmVhdGVPQXV0aFByb3ZpZGVyUmVzcG9uc2USbgoTVXBkYXRlT0F1dGhQcm92aWRlchIqLmV2bV9pbmRleGVyLnYxLlVwZGF0ZU9BdXRoUHJvdmlkZXJSZXF1ZXN0GisuZXZtX2luZGV4ZXIudjEuVXBkYXRlT0F1dGhQcm92aWRlclJlc3BvbnNlEm4KE0RlbGV0ZU9BdXRoUHJvdmlkZXISKi5ldm1faW5kZXhlci52MS5EZWxldGVPQXV0aFByb3ZpZGVyUmVxdWVzdBorLmV2bV9pbmRleGVyLnYxLkRlbGV0ZU9BdXRoUHJvdmlkZXJSZXNwb25zZRJQCglMaXN0VXNlcnMSIC5ldm1faW5kZXhlci52MS5MaXN0VXNlcnNSZXF1ZXN0GiEuZXZtX2luZGV4ZXIudjEuTGlzdFVzZXJzUmVzcG9uc2USUwoKQ3JlYXRlVXNlchIhLmV2bV9pbmRleGVyLnYxLkNyZWF0ZVVzZXJSZXF1ZXN0GiIuZXZtX2luZGV4ZXIudjEuQ3JlYXRlVXNlclJlc3BvbnNlElMKClVwZGF0ZVVzZXISIS5ldm1faW5kZXhlci52MS5VcGRhdGVVc2VyUmVxdWVzdBoiLmV2bV9pbmRleGVyLnYxLlVwZGF0ZVVzZXJSZXNwb25zZRJTCgpEZWxldGVVc2VyEiEuZXZtX2luZGV4ZXIudjEuRGVsZXRlVXNlclJlcXVlc3QaIi5ldm1faW5kZXhlci52MS5EZWxldGVVc2VyUmVzcG9uc2USawoSQ3JlYXRlRXZtaUV4cG9ydGVyEikuZXZtX2luZGV4ZXIudjEuQ3JlYXRlRXZtaUV4cG9ydGVyUmVxdWVzdBoqLmV2bV9pbmRleGVyLnYxLkNyZWF0ZUV2bWlFeHBvcnRlclJlc3BvbnNlEmIKD0dldEV2bWlFeHBvcnRlchImLmV2bV9pbmRleGVyLnYxLkdldEV2bWlFeHBvcnRlclJlcXVlc3QaJy5ldm1faW5kZXhlci52MS5HZXRFdm1pRXhwb3J0ZXJSZXNwb25zZRJrChJVcGRhdGVFdm1pRXhwb3J0ZXISKS5ldm1faW5kZXhlci52MS5VcGRhdGVFdm1pRXhwb3J0ZXJSZXF1ZXN0GiouZXZtX2luZGV4ZXIudjEuVXBkYXRlRXZtaUV4cG9ydGVyUmVzcG9uc2USaAoRTGlzdEV2bWlFeHBvcnRlcnMSKC5ldm1faW5kZXhlci52MS5MaXN0RXZtaUV4cG9ydGVyc1JlcXVlc3QaKS5ldm1faW5kZXhlci52MS5MaXN0RXZtaUV4cG9ydGVyc1Jlc3BvbnNlEmsKEkRlbGV0ZUV2bWlFeHBvcnRlchIpLmV2bV9pbmRleGVyLnYxLkRlbGV0ZUV2bWlFeHBvcnRlclJlcXVlc3QaKi5ldm1faW5kZXhlci52MS5EZWxldGVFdm1pRXhwb3J0ZXJSZXNwb25zZRJcCg1TdGFydEV4cG9ydGVyEiQuZXZtX2luZGV4ZXIudjEuU3RhcnRFeHBvcnRlclJlcXVlc3QaJS5ldm1faW5kZXhlci52MS5TdGFydEV4cG9ydGVyUmVzcG9uc2USWQoMU3RvcEV4cG9ydGVyEiMuZXZtX2luZGV4ZXIudjEuU3RvcEV4cG9ydGVyUmVxdWVzdBokLmV2bV9pbmRleGVyLnYxLlN0b3BFeHBvcnRlclJlc3BvbnNlEm0KGVN0cmVhbUV2bWlFeHBvcnRlclVwZGF0ZXMSMC5ldm1faW5kZXhlci52MS5TdHJlYW1Fdm1pRXhwb3J0ZXJVcGRhdGVzUmVxdWVzdBocLmV2bV9pbmRleGVyLnYxLkV2bWlFeHBvcnRlcjABElkKDENyZWF0ZVBsdWdpbhIjLmV2bV9pbmRleGVyLnYxLkNyZWF0ZVBsdWdpblJlcXVlc3QaJC5ldm1faW5kZXhlci52MS5DcmVhdGVQbHVnaW5SZXNwb25zZRJQCglHZXRQbHVnaW4SIC5ldm1faW5kZXhlci52MS5HZXRQbHVnaW5SZXF1ZXN0GiEuZXZtX2luZGV4ZXIudjEuR2V0UGx1Z2luUmVzcG9uc2USWQoMVXBkYXRlUGx1Z2luEiMuZXZtX2luZGV4ZXIudjEuVXBkYXRlUGx1Z2luUmVxdWVzdBokLmV2bV9pbmRleGVyLnYxLlVwZGF0ZVBsdWdpblJlc3BvbnNlElYKC0xpc3RQbHVnaW5zEiIuZXZtX2luZGV4ZXIudjEuTGlzdFBsdWdpbnNSZXF1ZXN0GiMuZXZtX2luZGV4ZXIudjEuTGlzdFBsdWdpbnNSZXNwb25zZRJZCgxEZWxldGVQbHVnaW4SIy5ldm1faW5kZXhlci52MS5EZWxldGVQbHVnaW5SZXF1ZXN0GiQuZXZtX2luZGV4ZXIudjEuRGVsZXRlUGx1Z2luUmVzcG9uc2USXAoNSW5zdGFsbFBsdWdpbhIkLmV2bV9pbmRleGVyLnYxLkluc3RhbGxQbHVnaW5SZXF1ZXN0GiUuZXZtX2luZGV4ZXIudjEuSW5zdGFsbFBsdWdpblJlc3BvbnNlEmgKEUxpc3RQbHVnaW5HaXRSZWZzEiguZXZtX2luZGV4ZXIudjEuTGlzdFBsdWdpbkdpdFJlZnNSZXF1ZXN0GikuZXZtX2luZGV4ZXIudjEuTGlzdFBsdWdpbkdpdFJlZnNSZXNwb25zZRJuChNFeHBvcnRDb25maWd1cmF0aW9uEiouZXZtX2luZGV4ZXIudjEuRXhwb3J0Q29uZmlndXJhdGlvblJlcXVlc3QaKy5ldm1faW5kZXhlci52MS5FeHBvcnRDb25maWd1cmF0aW9uUmVzcG9uc2VCRVpDZ2l0aHViLmNvbS9ldm1pLWNsb3VkL2dvLWV2bS1pbmRleGVyL2dlbi9ldm0taW5kZXhlci92MTtldm1faW5kZXhlcmIGcHJvdG8z");
 
 /**
  * @generated from message evm_indexer.v1.EvmiInstance
@@ -117,6 +117,84 @@ export type EvmBlockchain = Message<"evm_indexer.v1.EvmBlockchain"> & {
    * @generated from field: optional uint32 deleted_at = 11;
    */
   deletedAt?: number | undefined;
+
+  /**
+   * Block the chain's sources index up to: LATEST (default when empty),
+   * CONFIRMATIONS (latest minus head_confirmations), SAFE or FINALIZED.
+   *
+   * @generated from field: string head_mode = 12;
+   */
+  headMode: string;
+
+  /**
+   * @generated from field: uint64 head_confirmations = 13;
+   */
+  headConfirmations: bigint;
+
+  /**
+   * SQD (Subsquid) archive gateway used to backfill ranges it holds.
+   *
+   * @generated from field: bool sqd_gateway_available = 14;
+   */
+  sqdGatewayAvailable: boolean;
+
+  /**
+   * @generated from field: string sqd_gateway_url = 15;
+   */
+  sqdGatewayUrl: string;
+
+  /**
+   * JSON-RPC endpoints calls are spread over by weight; rpc_url is used alone
+   * when the list is empty.
+   *
+   * @generated from field: repeated evm_indexer.v1.EvmRpcEndpoint rpc_endpoints = 16;
+   */
+  rpcEndpoints: EvmRpcEndpoint[];
+
+  /**
+   * WebSocket endpoint whose newHeads subscription (or, with
+   * ws_subscribe_logs, each source's logs subscription) triggers indexing
+   * instead of polling every pull_interval.
+   *
+   * @generated from field: string ws_url = 17;
+   */
+  wsUrl: string;
+
+  /**
+   * @generated from field: bool ws_subscribe_logs = 18;
+   */
+  wsSubscribeLogs: boolean;
+
+  /**
+   * Most addresses per eth_getLogs call when the ranges of several sources
+   * are batched; 0 uses the indexer default.
+   *
+   * @generated from field: uint64 logs_max_addresses = 19;
+   */
+  logsMaxAddresses: bigint;
+
+  /**
+   * Read the traces of TRACES sources and TRACE factory rules with
+   * trace_filter (Erigon, Nethermind, Reth) instead of tracing every block
+   * with debug_traceBlockByNumber.
+   *
+   * @generated from field: bool trace_filter_available = 20;
+   */
+  traceFilterAvailable: boolean;
+
+  /**
+   * JSON-RPC calls per second the chain's sources make together on an
+   * indexer instance, 0 for no limit. rpc_method_costs weighs the methods
+   * against it (compute units), 1 for a method left out.
+   *
+   * @generated from field: double rpc_rate_limit = 21;
+   */
+  rpcRateLimit: number;
+
+  /**
+   * @generated from field: map<string, uint64> rpc_method_costs = 22;
+   */
+  rpcMethodCosts: { [key: string]: bigint };
 };
 
 /**
@@ -126,6 +204,67 @@ export type EvmBlockchain = Message<"evm_indexer.v1.EvmBlockchain"> & {
 export const EvmBlockchainSchema: GenMessage<EvmBlockchain> = /*@__PURE__*/
   messageDesc(file_evm_indexer_v1_evm_indexer, 1);
 
+/**
+ * EvmRpcEndpoint is one JSON-RPC node of a blockchain. url and weight are set
+ * through the API; the other fields are the health last reported by the
+ * indexers and are ignored on create/update.
+ *
+ * @generated from message evm_indexer.v1.EvmRpcEndpoint
+ */
+export type EvmRpcEndpoint = Message<"evm_indexer.v1.EvmRpcEndpoint"> & {
+  /**
+   * @generated from field: optional uint32 id = 1;
+   */
+  id?: number | undefined;
+
+  /**
+   * @generated from field: string url = 2;
+   */
+  url: string;
+
+  /**
+   * @generated from field: uint64 weight = 3;
+   */
+  weight: bigint;
+
+  /**
+   * @generated from field: bool healthy = 4;
+   */
+  healthy: boolean;
+
+  /**
+   * @generated from field: uint64 head_block = 5;
+   */
+  headBlock: bigint;
+
+  /**
+   * @generated from field: uint64 latency_ms = 6;
+   */
+  latencyMs: bigint;
+
+  /**
+   * @generated from field: double error_rate = 7;
+   */
+  errorRate: number;
+
+  /**
+   * @generated from field: string last_error = 8;
+   */
+  lastError: string;
+
+  /**
+   * @generated from field: optional uint32 checked_at = 9;
+   */
+  checkedAt?: number | undefined;
+};
+
+/**
+ * Describes the message evm_indexer.v1.EvmRpcEndpoint.
+ * Use `create(EvmRpcEndpointSchema)` to create a new message.
+ */
+export const EvmRpcEndpointSchema: GenMessage<EvmRpcEndpoint> = /*@__PURE__*/
+  messageDesc(file_evm_indexer_v1_evm_indexer, 2);
+
 /**
  * @generated from message evm_indexer.v1.EvmJsonAbi
  */
@@ -166,7 +305,7 @@ export type EvmJsonAbi = Message<"evm_indexer.v1.EvmJsonAbi"> & {
  * Use `create(EvmJsonAbiSchema)` to create a new message.
  */
 export const EvmJsonAbiSchema: GenMessage<EvmJsonAbi> = /*@__PURE__*/
-  messageDesc(file_evm_indexer_v1_evm_indexer, 2);
+  messageDesc(file_evm_indexer_v1_evm_indexer, 3);
 
 /**
  * @generated from message evm_indexer.v1.EvmLogStore
@@ -218,7 +357,7 @@ export type EvmLogStore = Message<"evm_indexer.v1.EvmLogStore"> & {
  * Use `create(EvmLogStoreSchema)` to create a new message.
  */
 export const EvmLogStoreSchema: GenMessage<EvmLogStore> = /*@__PURE__*/
-  messageDesc(file_evm_indexer_v1_evm_indexer, 3);
+  messageDesc(file_evm_indexer_v1_evm_indexer, 4);
 
 /**
  * @generated from message evm_indexer.v1.EvmLogPipeline
@@ -265,12 +404,14 @@ export type EvmLogPipeline = Message<"evm_indexer.v1.EvmLogPipeline"> & {
  * Use `create(EvmLogPipelineSchema)` to create a new message.
  */
 export const EvmLogPipelineSchema: GenMessage<EvmLogPipeline> = /*@__PURE__*/
-  messageDesc(file_evm_indexer_v1_evm_indexer, 4);
+  messageDesc(file_evm_indexer_v1_evm_indexer, 5);
 
 /**
  * FactoryRule is one creation rule of a FACTORY source: match creation_function_name,
  * read the new address from creation_address_log_arg, and create a child of
  * child_type using evm_json_abi_id. A FACTORY child runs child_rules (recursive).
+ * A rule whose trigger is TRACE matches the contracts the factory creates in
+ * its traces instead; its conditions apply to from, address, value and type.
  * FactoryRuleCondition gates a rule on a decoded event arg (all conditions must
  * hold for the child to be created). operator: eq|neq|gt|gte|lt|lte|contains.
  *
@@ -298,7 +439,7 @@ export type FactoryRuleCondition = Message<"evm_indexer.v1.FactoryRuleCondition"
  * Use `create(FactoryRuleConditionSchema)` to create a new message.
  */
 export const FactoryRuleConditionSchema: GenMessage<FactoryRuleCondition> = /*@__PURE__*/
-  messageDesc(file_evm_indexer_v1_evm_indexer, 5);
+  messageDesc(file_evm_indexer_v1_evm_indexer, 6);
 
 /**
  * @generated from message evm_indexer.v1.FactoryRule
@@ -342,6 +483,13 @@ export type FactoryRule = Message<"evm_indexer.v1.FactoryRule"> & {
    * @generated from field: repeated evm_indexer.v1.FactoryRuleCondition conditions = 7;
    */
   conditions: FactoryRuleCondition[];
+
+  /**
+   * EVENT (default when empty) | TRACE
+   *
+   * @generated from field: string trigger = 8;
+   */
+  trigger: string;
 };
 
 /**
@@ -349,7 +497,7 @@ export type FactoryRule = Message<"evm_indexer.v1.FactoryRule"> & {
  * Use `create(FactoryRuleSchema)` to create a new message.
  */
 export const FactoryRuleSchema: GenMessage<FactoryRule> = /*@__PURE__*/
-  messageDesc(file_evm_indexer_v1_evm_indexer, 6);
+  messageDesc(file_evm_indexer_v1_evm_indexer, 7);
 
 /**
  * @generated from message evm_indexer.v1.EvmLogSource
@@ -371,6 +519,9 @@ export type EvmLogSource = Message<"evm_indexer.v1.EvmLogSource"> & {
   enabled: boolean;
 
   /**
+   * RUNNING, LOOPBACKOFF, STOPPED or COMPLETED (a bounded source that indexed
+   * its end_block).
+   *
    * @generated from field: string status = 4;
    */
   status: string;
@@ -385,6 +536,53 @@ export type EvmLogSource = Message<"evm_indexer.v1.EvmLogSource"> & {
    */
   syncBlock: bigint;
 
+  /**
+   * end_block bounds the source to [start_block, end_block]; 0 indexes
+   * forever.
+   *
+   * @generated from field: uint64 end_block = 29;
+   */
+  endBlock: bigint;
+
+  /**
+   * catch_up_workers is the number of block ranges fetched concurrently while
+   * the source is far behind the head; 0 and 1 index one range at a time.
+   *
+   * @generated from field: uint32 catch_up_workers = 30;
+   */
+  catchUpWorkers: number;
+
+  /**
+   * The last error the source's indexer failed with, at last_error_at, while
+   * indexing [last_error_from_block, last_error_to_block] (both 0 when it
+   * failed outside a range). consecutive_failures counts the failures since
+   * the last range indexed: while it is not 0 the status is LOOPBACKOFF, the
+   * indexer being restarted after an exponential backoff.
+   *
+   * @generated from field: string last_error = 31;
+   */
+  lastError: string;
+
+  /**
+   * @generated from field: optional uint32 last_error_at = 32;
+   */
+  lastErrorAt?: number | undefined;
+
+  /**
+   * @generated from field: uint64 last_error_from_block = 33;
+   */
+  lastErrorFromBlock: bigint;
+
+  /**
+   * @generated from field: uint64 last_error_to_block = 34;
+   */
+  lastErrorToBlock: bigint;
+
+  /**
+   * @generated from field: uint32 consecutive_failures = 35;
+   */
+  consecutiveFailures: number;
+
   /**
    * Nullable fields
    *
@@ -432,6 +630,42 @@ export type EvmLogSource = Message<"evm_indexer.v1.EvmLogSource"> & {
    */
   evmBlockchainId: number;
 
+  /**
+   * TOPIC sources match any of topic0s; logs are decoded against whichever of
+   * evm_json_abi_ids declares their event. topic0 and evm_json_abi_id hold the
+   * first value of each; on writes, a single value may be set there instead.
+   *
+   * @generated from field: repeated string topic0s = 24;
+   */
+  topic0s: string[];
+
+  /**
+   * @generated from field: repeated uint32 evm_json_abi_ids = 25;
+   */
+  evmJsonAbiIds: number[];
+
+  /**
+   * The last re-decode job of the source, if any.
+   *
+   * @generated from field: optional evm_indexer.v1.EvmRedecodeProgress redecode = 26;
+   */
+  redecode?: EvmRedecodeProgress | undefined;
+
+  /**
+   * TRANSACTIONS sources index the transactions from or to any of addresses,
+   * address holding the first one (on writes, a single value may be set there
+   * instead), optionally only the calls of function_selector (0x + 4 bytes).
+   * TRACES sources index the call frames from or to any of addresses.
+   *
+   * @generated from field: repeated string addresses = 27;
+   */
+  addresses: string[];
+
+  /**
+   * @generated from field: string function_selector = 28;
+   */
+  functionSelector: string;
+
   /**
    * @generated from field: optional uint32 created_at = 16;
    */
@@ -453,7 +687,55 @@ export type EvmLogSource = Message<"evm_indexer.v1.EvmLogSource"> & {
  * Use `create(EvmLogSourceSchema)` to create a new message.
  */
 export const EvmLogSourceSchema: GenMessage<EvmLogSource> = /*@__PURE__*/
-  messageDesc(file_evm_indexer_v1_evm_indexer, 7);
+  messageDesc(file_evm_indexer_v1_evm_indexer, 8);
+
+/**
+ * EvmRedecodeProgress is the progress of a source's re-decode job: its
+ * stored logs of [from_block, to_block] are decoded again with the current
+ * ABIs, next_block being the first block not rewritten yet and logs the
+ * number of logs rewritten so far. status is RUNNING, DONE or FAILED, with
+ * error set on FAILED.
+ *
+ * @generated from message evm_indexer.v1.EvmRedecodeProgress
+ */
+export type EvmRedecodeProgress = Message<"evm_indexer.v1.EvmRedecodeProgress"> & {
+  /**
+   * @generated from field: string status = 1;
+   */
+  status: string;
+
+  /**
+   * @generated from field: uint64 from_block = 2;
+   */
+  fromBlock: bigint;
+
+  /**
+   * @generated from field: uint64 next_block = 3;
+   */
+  nextBlock: bigint;
+
+  /**
+   * @generated from field: uint64 to_block = 4;
+   */
+  toBlock: bigint;
+
+  /**
+   * @generated from field: uint64 logs = 5;
+   */
+  logs: bigint;
+
+  /**
+   * @generated from field: string error = 6;
+   */
+  error: string;
+};
+
+/**
+ * Describes the message evm_indexer.v1.EvmRedecodeProgress.
+ * Use `create(EvmRedecodeProgressSchema)` to create a new message.
+ */
+export const EvmRedecodeProgressSchema: GenMessage<EvmRedecodeProgress> = /*@__PURE__*/
+  messageDesc(file_evm_indexer_v1_evm_indexer, 9);
 
 /**
  * @generated from message evm_indexer.v1.EvmMetadata
@@ -485,7 +767,7 @@ export type EvmMetadata = Message<"evm_indexer.v1.EvmMetadata"> & {
  * Use `create(EvmMetadataSchema)` to create a new message.
  */
 export const EvmMetadataSchema: GenMessage<EvmMetadata> = /*@__PURE__*/
-  messageDesc(file_evm_indexer_v1_evm_indexer, 8);
+  messageDesc(file_evm_indexer_v1_evm_indexer, 10);
 
 /**
  * @generated from message evm_indexer.v1.EvmLog
@@ -574,7 +856,7 @@ export type EvmLog = Message<"evm_indexer.v1.EvmLog"> & {
  * Use `create(EvmLogSchema)` to create a new message.
  */
 export const EvmLogSchema: GenMessage<EvmLog> = /*@__PURE__*/
-  messageDesc(file_evm_indexer_v1_evm_indexer, 9);
+  messageDesc(file_evm_indexer_v1_evm_indexer, 11);
 
 /**
  * @generated from message evm_indexer.v1.EvmTransaction
@@ -646,98 +928,356 @@ export type EvmTransaction = Message<"evm_indexer.v1.EvmTransaction"> & {
    * @generated from field: uint64 block_timestamp = 13;
    */
   blockTimestamp: bigint;
-};
-
-/**
- * Describes the message evm_indexer.v1.EvmTransaction.
- * Use `create(EvmTransactionSchema)` to create a new message.
- */
-export const EvmTransactionSchema: GenMessage<EvmTransaction> = /*@__PURE__*/
-  messageDesc(file_evm_indexer_v1_evm_indexer, 10);
 
-/**
- * Pagination
- *
- * @generated from message evm_indexer.v1.Pagination
- */
-export type Pagination = Message<"evm_indexer.v1.Pagination"> & {
   /**
-   * @generated from field: uint32 limit = 1;
+   * Execution results, from the transaction receipt.
+   *
+   * EIP-2718 transaction type
+   *
+   * @generated from field: uint64 type = 14;
    */
-  limit: number;
+  type: bigint;
 
   /**
-   * @generated from field: uint32 offset = 2;
+   * 1 success, 0 reverted
+   *
+   * @generated from field: uint64 status = 15;
    */
-  offset: number;
-};
+  status: bigint;
 
-/**
- * Describes the message evm_indexer.v1.Pagination.
- * Use `create(PaginationSchema)` to create a new message.
- */
-export const PaginationSchema: GenMessage<Pagination> = /*@__PURE__*/
-  messageDesc(file_evm_indexer_v1_evm_indexer, 11);
+  /**
+   * @generated from field: uint64 gas_used = 16;
+   */
+  gasUsed: bigint;
 
-/**
- * EvmiInstance
- *
- * @generated from message evm_indexer.v1.GetEvmiInstanceRequest
- */
-export type GetEvmiInstanceRequest = Message<"evm_indexer.v1.GetEvmiInstanceRequest"> & {
   /**
-   * @generated from field: uint32 id = 1;
+   * wei
+   *
+   * @generated from field: string effective_gas_price = 17;
    */
-  id: number;
-};
+  effectiveGasPrice: string;
 
-/**
- * Describes the message evm_indexer.v1.GetEvmiInstanceRequest.
- * Use `create(GetEvmiInstanceRequestSchema)` to create a new message.
- */
-export const GetEvmiInstanceRequestSchema: GenMessage<GetEvmiInstanceRequest> = /*@__PURE__*/
-  messageDesc(file_evm_indexer_v1_evm_indexer, 12);
+  /**
+   * set on contract creations
+   *
+   * @generated from field: string contract_address = 18;
+   */
+  contractAddress: string;
 
-/**
- * @generated from message evm_indexer.v1.GetEvmiInstanceResponse
- */
-export type GetEvmiInstanceResponse = Message<"evm_indexer.v1.GetEvmiInstanceResponse"> & {
   /**
-   * @generated from field: evm_indexer.v1.EvmiInstance instance = 1;
+   * @generated from field: uint64 blob_gas_used = 19;
    */
-  instance?: EvmiInstance | undefined;
-};
+  blobGasUsed: bigint;
 
-/**
- * Describes the message evm_indexer.v1.GetEvmiInstanceResponse.
- * Use `create(GetEvmiInstanceResponseSchema)` to create a new message.
- */
-export const GetEvmiInstanceResponseSchema: GenMessage<GetEvmiInstanceResponse> = /*@__PURE__*/
-  messageDesc(file_evm_indexer_v1_evm_indexer, 13);
+  /**
+   * wei
+   *
+   * @generated from field: string blob_gas_price = 20;
+   */
+  blobGasPrice: string;
 
-/**
- * @generated from message evm_indexer.v1.ListEvmiInstancesRequest
- */
-export type ListEvmiInstancesRequest = Message<"evm_indexer.v1.ListEvmiInstancesRequest"> & {
   /**
-   * @generated from field: evm_indexer.v1.Pagination pagination = 1;
+   * @generated from field: repeated string blob_versioned_hashes = 21;
    */
-  pagination?: Pagination | undefined;
+  blobVersionedHashes: string[];
 };
 
 /**
- * Describes the message evm_indexer.v1.ListEvmiInstancesRequest.
- * Use `create(ListEvmiInstancesRequestSchema)` to create a new message.
+ * Describes the message evm_indexer.v1.EvmTransaction.
+ * Use `create(EvmTransactionSchema)` to create a new message.
  */
-export const ListEvmiInstancesRequestSchema: GenMessage<ListEvmiInstancesRequest> = /*@__PURE__*/
-  messageDesc(file_evm_indexer_v1_evm_indexer, 14);
+export const EvmTransactionSchema: GenMessage<EvmTransaction> = /*@__PURE__*/
+  messageDesc(file_evm_indexer_v1_evm_indexer, 12);
 
 /**
- * @generated from message evm_indexer.v1.ListEvmiInstancesResponse
+ * EvmBlock is a block header indexed by a BLOCKS source.
+ *
+ * @generated from message evm_indexer.v1.EvmBlock
  */
-export type ListEvmiInstancesResponse = Message<"evm_indexer.v1.ListEvmiInstancesResponse"> & {
+export type EvmBlock = Message<"evm_indexer.v1.EvmBlock"> & {
   /**
-   * @generated from field: repeated evm_indexer.v1.EvmiInstance instances = 1;
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: uint32 source_id = 2;
+   */
+  sourceId: number;
+
+  /**
+   * @generated from field: uint64 chain_id = 3;
+   */
+  chainId: bigint;
+
+  /**
+   * @generated from field: uint64 block_number = 4;
+   */
+  blockNumber: bigint;
+
+  /**
+   * unix seconds
+   *
+   * @generated from field: uint64 block_timestamp = 5;
+   */
+  blockTimestamp: bigint;
+
+  /**
+   * @generated from field: string hash = 6;
+   */
+  hash: string;
+
+  /**
+   * @generated from field: string parent_hash = 7;
+   */
+  parentHash: string;
+
+  /**
+   * fee recipient
+   *
+   * @generated from field: string miner = 8;
+   */
+  miner: string;
+
+  /**
+   * @generated from field: uint64 gas_used = 9;
+   */
+  gasUsed: bigint;
+
+  /**
+   * @generated from field: uint64 gas_limit = 10;
+   */
+  gasLimit: bigint;
+
+  /**
+   * wei, empty before London
+   *
+   * @generated from field: string base_fee_per_gas = 11;
+   */
+  baseFeePerGas: string;
+
+  /**
+   * @generated from field: uint64 blob_gas_used = 12;
+   */
+  blobGasUsed: bigint;
+
+  /**
+   * @generated from field: uint64 excess_blob_gas = 13;
+   */
+  excessBlobGas: bigint;
+
+  /**
+   * @generated from field: uint64 transaction_count = 14;
+   */
+  transactionCount: bigint;
+
+  /**
+   * @generated from field: string logs_bloom = 15;
+   */
+  logsBloom: string;
+};
+
+/**
+ * Describes the message evm_indexer.v1.EvmBlock.
+ * Use `create(EvmBlockSchema)` to create a new message.
+ */
+export const EvmBlockSchema: GenMessage<EvmBlock> = /*@__PURE__*/
+  messageDesc(file_evm_indexer_v1_evm_indexer, 13);
+
+/**
+ * EvmTrace is a call frame indexed by a TRACES source.
+ *
+ * @generated from message evm_indexer.v1.EvmTrace
+ */
+export type EvmTrace = Message<"evm_indexer.v1.EvmTrace"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: uint32 source_id = 2;
+   */
+  sourceId: number;
+
+  /**
+   * @generated from field: uint64 chain_id = 3;
+   */
+  chainId: bigint;
+
+  /**
+   * @generated from field: uint64 block_number = 4;
+   */
+  blockNumber: bigint;
+
+  /**
+   * unix seconds
+   *
+   * @generated from field: uint64 block_timestamp = 5;
+   */
+  blockTimestamp: bigint;
+
+  /**
+   * @generated from field: string transaction_hash = 6;
+   */
+  transactionHash: string;
+
+  /**
+   * @generated from field: uint64 transaction_index = 7;
+   */
+  transactionIndex: bigint;
+
+  /**
+   * e.g. "0.1", empty for the top call
+   *
+   * @generated from field: string trace_address = 8;
+   */
+  traceAddress: string;
+
+  /**
+   * CALL | STATICCALL | DELEGATECALL | CALLCODE | CREATE | CREATE2 | SELFDESTRUCT
+   *
+   * @generated from field: string type = 9;
+   */
+  type: string;
+
+  /**
+   * @generated from field: string from = 10;
+   */
+  from: string;
+
+  /**
+   * the created contract of a creation
+   *
+   * @generated from field: string to = 11;
+   */
+  to: string;
+
+  /**
+   * wei
+   *
+   * @generated from field: string value = 12;
+   */
+  value: string;
+
+  /**
+   * @generated from field: string input = 13;
+   */
+  input: string;
+
+  /**
+   * @generated from field: string output = 14;
+   */
+  output: string;
+
+  /**
+   * @generated from field: uint64 gas = 15;
+   */
+  gas: bigint;
+
+  /**
+   * @generated from field: uint64 gas_used = 16;
+   */
+  gasUsed: bigint;
+
+  /**
+   * empty when the frame succeeded
+   *
+   * @generated from field: string error = 17;
+   */
+  error: string;
+};
+
+/**
+ * Describes the message evm_indexer.v1.EvmTrace.
+ * Use `create(EvmTraceSchema)` to create a new message.
+ */
+export const EvmTraceSchema: GenMessage<EvmTrace> = /*@__PURE__*/
+  messageDesc(file_evm_indexer_v1_evm_indexer, 14);
+
+/**
+ * Pagination
+ *
+ * @generated from message evm_indexer.v1.Pagination
+ */
+export type Pagination = Message<"evm_indexer.v1.Pagination"> & {
+  /**
+   * @generated from field: uint32 limit = 1;
+   */
+  limit: number;
+
+  /**
+   * @generated from field: uint32 offset = 2;
+   */
+  offset: number;
+};
+
+/**
+ * Describes the message evm_indexer.v1.Pagination.
+ * Use `create(PaginationSchema)` to create a new message.
+ */
+export const PaginationSchema: GenMessage<Pagination> = /*@__PURE__*/
+  messageDesc(file_evm_indexer_v1_evm_indexer, 15);
+
+/**
+ * EvmiInstance
+ *
+ * @generated from message evm_indexer.v1.GetEvmiInstanceRequest
+ */
+export type GetEvmiInstanceRequest = Message<"evm_indexer.v1.GetEvmiInstanceRequest"> & {
+  /**
+   * @generated from field: uint32 id = 1;
+   */
+  id: number;
+};
+
+/**
+ * Describes the message evm_indexer.v1.GetEvmiInstanceRequest.
+ * Use `create(GetEvmiInstanceRequestSchema)` to create a new message.
+ */
+export const GetEvmiInstanceRequestSchema: GenMessage<GetEvmiInstanceRequest> = /*@__PURE__*/
+  messageDesc(file_evm_indexer_v1_evm_indexer, 16);
+
+/**
+ * @generated from message evm_indexer.v1.GetEvmiInstanceResponse
+ */
+export type GetEvmiInstanceResponse = Message<"evm_indexer.v1.GetEvmiInstanceResponse"> & {
+  /**
+   * @generated from field: evm_indexer.v1.EvmiInstance instance = 1;
+   */
+  instance?: EvmiInstance | undefined;
+};
+
+/**
+ * Describes the message evm_indexer.v1.GetEvmiInstanceResponse.
+ * Use `create(GetEvmiInstanceResponseSchema)` to create a new message.
+ */
+export const GetEvmiInstanceResponseSchema: GenMessage<GetEvmiInstanceResponse> = /*@__PURE__*/
+  messageDesc(file_evm_indexer_v1_evm_indexer, 17);
+
+/**
+ * @generated from message evm_indexer.v1.ListEvmiInstancesRequest
+ */
+export type ListEvmiInstancesRequest = Message<"evm_indexer.v1.ListEvmiInstancesRequest"> & {
+  /**
+   * @generated from field: evm_indexer.v1.Pagination pagination = 1;
+   */
+  pagination?: Pagination | undefined;
+};
+
+/**
+ * Describes the message evm_indexer.v1.ListEvmiInstancesRequest.
+ * Use `create(ListEvmiInstancesRequestSchema)` to create a new message.
+ */
+export const ListEvmiInstancesRequestSchema: GenMessage<ListEvmiInstancesRequest> = /*@__PURE__*/
+  messageDesc(file_evm_indexer_v1_evm_indexer, 18);
+
+/**
+ * @generated from message evm_indexer.v1.ListEvmiInstancesResponse
+ */
+export type ListEvmiInstancesResponse = Message<"evm_indexer.v1.ListEvmiInstancesResponse"> & {
+  /**
+   * @generated from field: repeated evm_indexer.v1.EvmiInstance instances = 1;
    */
   instances: EvmiInstance[];
 };
@@ -747,7 +1287,7 @@ export type ListEvmiInstancesResponse = Message<"evm_indexer.v1.ListEvmiInstance
  * Use `create(ListEvmiInstancesResponseSchema)` to create a new message.
  */
 export const ListEvmiInstancesResponseSchema: GenMessage<ListEvmiInstancesResponse> = /*@__PURE__*/
-  messageDesc(file_evm_indexer_v1_evm_indexer, 15);
+  messageDesc(file_evm_indexer_v1_evm_indexer, 19);
 
 /**
  * EvmBlockchain
@@ -766,7 +1306,7 @@ export type CreateEvmBlockchainRequest = Message<"evm_indexer.v1.CreateEvmBlockc
  * Use `create(CreateEvmBlockchainRequestSchema)` to create a new message.
  */
 export const CreateEvmBlockchainRequestSchema: GenMessage<CreateEvmBlockchainRequest> = /*@__PURE__*/
-  messageDesc(file_evm_indexer_v1_evm_indexer, 16);
+  messageDesc(file_evm_indexer_v1_evm_indexer, 20);
 
 /**
  * @generated from message evm_indexer.v1.CreateEvmBlockchainResponse
@@ -783,7 +1323,7 @@ export type CreateEvmBlockchainResponse = Message<"evm_indexer.v1.CreateEvmBlock
  * Use `create(CreateEvmBlockchainResponseSchema)` to create a new message.
  */
 export const CreateEvmBlockchainResponseSchema: GenMessage<CreateEvmBlockchainResponse> = /*@__PURE__*/
-  messageDesc(file_evm_indexer_v1_evm_indexer, 17);
+  messageDesc(file_evm_indexer_v1_evm_indexer, 21);
 
 /**
  * @generated from message evm_indexer.v1.GetEvmBlockchainRequest
@@ -800,7 +1340,7 @@ export type GetEvmBlockchainRequest = Message<"evm_indexer.v1.GetEvmBlockchainRe
  * Use `create(GetEvmBlockchainRequestSchema)` to create a new message.
  */
 export const GetEvmBlockchainRequestSchema: GenMessage<GetEvmBlockchainRequest> = /*@__PURE__*/
-  messageDesc(file_evm_indexer_v1_evm_indexer, 18);
+  messageDesc(file_evm_indexer_v1_evm_indexer, 22);
 
 /**
  * @generated from message evm_indexer.v1.GetEvmBlockchainResponse
@@ -817,7 +1357,7 @@ export type GetEvmBlockchainResponse = Message<"evm_indexer.v1.GetEvmBlockchainR
  * Use `create(GetEvmBlockchainResponseSchema)` to create a new message.
  */
 export const GetEvmBlockchainResponseSchema: GenMessage<GetEvmBlockchainResponse> = /*@__PURE__*/
-  messageDesc(file_evm_indexer_v1_evm_indexer, 19);
+  messageDesc(file_evm_indexer_v1_evm_indexer, 23);
 
 /**
  * @generated from message evm_indexer.v1.UpdateEvmBlockchainRequest
@@ -834,7 +1374,7 @@ export type UpdateEvmBlockchainRequest = Message<"evm_indexer.v1.UpdateEvmBlockc
  * Use `create(UpdateEvmBlockchainRequestSchema)` to create a new message.
  */
 export const UpdateEvmBlockchainRequestSchema: GenMessage<UpdateEvmBlockchainRequest> = /*@__PURE__*/
-  messageDesc(file_evm_indexer_v1_evm_indexer, 20);
+  messageDesc(file_evm_indexer_v1_evm_indexer, 24);
 
 /**
  * @generated from message evm_indexer.v1.UpdateEvmBlockchainResponse
@@ -847,7 +1387,7 @@ export type UpdateEvmBlockchainResponse = Message<"evm_indexer.v1.UpdateEvmBlock
  * Use `create(UpdateEvmBlockchainResponseSchema)` to create a new message.
  */
 export const UpdateEvmBlockchainResponseSchema: GenMessage<UpdateEvmBlockchainResponse> = /*@__PURE__*/
-  messageDesc(file_evm_indexer_v1_evm_indexer, 21);
+  messageDesc(file_evm_indexer_v1_evm_indexer, 25);
 
 /**
  * @generated from message evm_indexer.v1.ListEvmBlockchainsRequest
@@ -864,7 +1404,7 @@ export type ListEvmBlockchainsRequest = Message<"evm_indexer.v1.ListEvmBlockchai
  * Use `create(ListEvmBlockchainsRequestSchema)` to create a new message.
  */
 export const ListEvmBlockchainsRequestSchema: GenMessage<ListEvmBlockchainsRequest> = /*@__PURE__*/
-  messageDesc(file_evm_indexer_v1_evm_indexer, 22);
+  messageDesc(file_evm_indexer_v1_evm_indexer, 26);
 
 /**
  * @generated from message evm_indexer.v1.ListEvmBlockchainsResponse
@@ -881,7 +1421,7 @@ export type ListEvmBlockchainsResponse = Message<"evm_indexer.v1.ListEvmBlockcha
  * Use `create(ListEvmBlockchainsResponseSchema)` to create a new message.
  */
 export const ListEvmBlockchainsResponseSchema: GenMessage<ListEvmBlockchainsResponse> = /*@__PURE__*/
-  messageDesc(file_evm_indexer_v1_evm_indexer, 23);
+  messageDesc(file_evm_indexer_v1_evm_indexer, 27);
 
 /**
  * @generated from message evm_indexer.v1.DeleteEvmBlockchainRequest
@@ -897,160 +1437,657 @@ export type DeleteEvmBlockchainRequest = Message<"evm_indexer.v1.DeleteEvmBlockc
  * Describes the message evm_indexer.v1.DeleteEvmBlockchainRequest.
  * Use `create(DeleteEvmBlockchainRequestSchema)` to create a new message.
  */
-export const DeleteEvmBlockchainRequestSchema: GenMessage<DeleteEvmBlockchainRequest> = /*@__PURE__*/
-  messageDesc(file_evm_indexer_v1_evm_indexer, 24);
+export const DeleteEvmBlockchainRequestSchema: GenMessage<DeleteEvmBlockchainRequest> = /*@__PURE__*/
+  messageDesc(file_evm_indexer_v1_evm_indexer, 28);
+
+/**
+ * @generated from message evm_indexer.v1.DeleteEvmBlockchainResponse
+ */
+export type DeleteEvmBlockchainResponse = Message<"evm_indexer.v1.DeleteEvmBlockchainResponse"> & {
+};
+
+/**
+ * Describes the message evm_indexer.v1.DeleteEvmBlockchainResponse.
+ * Use `create(DeleteEvmBlockchainResponseSchema)` to create a new message.
+ */
+export const DeleteEvmBlockchainResponseSchema: GenMessage<DeleteEvmBlockchainResponse> = /*@__PURE__*/
+  messageDesc(file_evm_indexer_v1_evm_indexer, 29);
+
+/**
+ * EvmJsonAbi
+ *
+ * @generated from message evm_indexer.v1.CreateEvmJsonAbiRequest
+ */
+export type CreateEvmJsonAbiRequest = Message<"evm_indexer.v1.CreateEvmJsonAbiRequest"> & {
+  /**
+   * @generated from field: evm_indexer.v1.EvmJsonAbi abi = 1;
+   */
+  abi?: EvmJsonAbi | undefined;
+};
+
+/**
+ * Describes the message evm_indexer.v1.CreateEvmJsonAbiRequest.
+ * Use `create(CreateEvmJsonAbiRequestSchema)` to create a new message.
+ */
+export const CreateEvmJsonAbiRequestSchema: GenMessage<CreateEvmJsonAbiRequest> = /*@__PURE__*/
+  messageDesc(file_evm_indexer_v1_evm_indexer, 30);
+
+/**
+ * @generated from message evm_indexer.v1.CreateEvmJsonAbiResponse
+ */
+export type CreateEvmJsonAbiResponse = Message<"evm_indexer.v1.CreateEvmJsonAbiResponse"> & {
+  /**
+   * @generated from field: uint32 id = 1;
+   */
+  id: number;
+};
+
+/**
+ * Describes the message evm_indexer.v1.CreateEvmJsonAbiResponse.
+ * Use `create(CreateEvmJsonAbiResponseSchema)` to create a new message.
+ */
+export const CreateEvmJsonAbiResponseSchema: GenMessage<CreateEvmJsonAbiResponse> = /*@__PURE__*/
+  messageDesc(file_evm_indexer_v1_evm_indexer, 31);
+
+/**
+ * @generated from message evm_indexer.v1.GetEvmJsonAbiRequest
+ */
+export type GetEvmJsonAbiRequest = Message<"evm_indexer.v1.GetEvmJsonAbiRequest"> & {
+  /**
+   * @generated from field: uint32 id = 1;
+   */
+  id: number;
+};
+
+/**
+ * Describes the message evm_indexer.v1.GetEvmJsonAbiRequest.
+ * Use `create(GetEvmJsonAbiRequestSchema)` to create a new message.
+ */
+export const GetEvmJsonAbiRequestSchema: GenMessage<GetEvmJsonAbiRequest> = /*@__PURE__*/
+  messageDesc(file_evm_indexer_v1_evm_indexer, 32);
+
+/**
+ * @generated from message evm_indexer.v1.GetEvmJsonAbiResponse
+ */
+export type GetEvmJsonAbiResponse = Message<"evm_indexer.v1.GetEvmJsonAbiResponse"> & {
+  /**
+   * @generated from field: evm_indexer.v1.EvmJsonAbi abi = 1;
+   */
+  abi?: EvmJsonAbi | undefined;
+};
+
+/**
+ * Describes the message evm_indexer.v1.GetEvmJsonAbiResponse.
+ * Use `create(GetEvmJsonAbiResponseSchema)` to create a new message.
+ */
+export const GetEvmJsonAbiResponseSchema: GenMessage<GetEvmJsonAbiResponse> = /*@__PURE__*/
+  messageDesc(file_evm_indexer_v1_evm_indexer, 33);
+
+/**
+ * @generated from message evm_indexer.v1.UpdateEvmJsonAbiRequest
+ */
+export type UpdateEvmJsonAbiRequest = Message<"evm_indexer.v1.UpdateEvmJsonAbiRequest"> & {
+  /**
+   * @generated from field: evm_indexer.v1.EvmJsonAbi abi = 1;
+   */
+  abi?: EvmJsonAbi | undefined;
+};
+
+/**
+ * Describes the message evm_indexer.v1.UpdateEvmJsonAbiRequest.
+ * Use `create(UpdateEvmJsonAbiRequestSchema)` to create a new message.
+ */
+export const UpdateEvmJsonAbiRequestSchema: GenMessage<UpdateEvmJsonAbiRequest> = /*@__PURE__*/
+  messageDesc(file_evm_indexer_v1_evm_indexer, 34);
+
+/**
+ * @generated from message evm_indexer.v1.UpdateEvmJsonAbiResponse
+ */
+export type UpdateEvmJsonAbiResponse = Message<"evm_indexer.v1.UpdateEvmJsonAbiResponse"> & {
+};
+
+/**
+ * Describes the message evm_indexer.v1.UpdateEvmJsonAbiResponse.
+ * Use `create(UpdateEvmJsonAbiResponseSchema)` to create a new message.
+ */
+export const UpdateEvmJsonAbiResponseSchema: GenMessage<UpdateEvmJsonAbiResponse> = /*@__PURE__*/
+  messageDesc(file_evm_indexer_v1_evm_indexer, 35);
+
+/**
+ * @generated from message evm_indexer.v1.ListEvmJsonAbisRequest
+ */
+export type ListEvmJsonAbisRequest = Message<"evm_indexer.v1.ListEvmJsonAbisRequest"> & {
+  /**
+   * @generated from field: evm_indexer.v1.Pagination pagination = 1;
+   */
+  pagination?: Pagination | undefined;
+};
+
+/**
+ * Describes the message evm_indexer.v1.ListEvmJsonAbisRequest.
+ * Use `create(ListEvmJsonAbisRequestSchema)` to create a new message.
+ */
+export const ListEvmJsonAbisRequestSchema: GenMessage<ListEvmJsonAbisRequest> = /*@__PURE__*/
+  messageDesc(file_evm_indexer_v1_evm_indexer, 36);
+
+/**
+ * @generated from message evm_indexer.v1.ListEvmJsonAbisResponse
+ */
+export type ListEvmJsonAbisResponse = Message<"evm_indexer.v1.ListEvmJsonAbisResponse"> & {
+  /**
+   * @generated from field: repeated evm_indexer.v1.EvmJsonAbi abis = 1;
+   */
+  abis: EvmJsonAbi[];
+};
+
+/**
+ * Describes the message evm_indexer.v1.ListEvmJsonAbisResponse.
+ * Use `create(ListEvmJsonAbisResponseSchema)` to create a new message.
+ */
+export const ListEvmJsonAbisResponseSchema: GenMessage<ListEvmJsonAbisResponse> = /*@__PURE__*/
+  messageDesc(file_evm_indexer_v1_evm_indexer, 37);
+
+/**
+ * @generated from message evm_indexer.v1.DeleteEvmJsonAbiRequest
+ */
+export type DeleteEvmJsonAbiRequest = Message<"evm_indexer.v1.DeleteEvmJsonAbiRequest"> & {
+  /**
+   * @generated from field: uint32 id = 1;
+   */
+  id: number;
+};
+
+/**
+ * Describes the message evm_indexer.v1.DeleteEvmJsonAbiRequest.
+ * Use `create(DeleteEvmJsonAbiRequestSchema)` to create a new message.
+ */
+export const DeleteEvmJsonAbiRequestSchema: GenMessage<DeleteEvmJsonAbiRequest> = /*@__PURE__*/
+  messageDesc(file_evm_indexer_v1_evm_indexer, 38);
+
+/**
+ * @generated from message evm_indexer.v1.DeleteEvmJsonAbiResponse
+ */
+export type DeleteEvmJsonAbiResponse = Message<"evm_indexer.v1.DeleteEvmJsonAbiResponse"> & {
+};
+
+/**
+ * Describes the message evm_indexer.v1.DeleteEvmJsonAbiResponse.
+ * Use `create(DeleteEvmJsonAbiResponseSchema)` to create a new message.
+ */
+export const DeleteEvmJsonAbiResponseSchema: GenMessage<DeleteEvmJsonAbiResponse> = /*@__PURE__*/
+  messageDesc(file_evm_indexer_v1_evm_indexer, 39);
+
+/**
+ * Schema of the events and functions of an ABI, as attached to decoded logs
+ * and transactions.
+ *
+ * @generated from message evm_indexer.v1.EvmArgSchema
+ */
+export type EvmArgSchema = Message<"evm_indexer.v1.EvmArgSchema"> & {
+  /**
+   * unnamed arguments are arg0, arg1, ...
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * canonical Solidity type, e.g. uint256, address[], (address,uint24)
+   *
+   * @generated from field: string type = 2;
+   */
+  type: string;
+
+  /**
+   * @generated from field: bool indexed = 3;
+   */
+  indexed: boolean;
+
+  /**
+   * tuple (or tuple array) components
+   *
+   * @generated from field: repeated evm_indexer.v1.EvmArgSchema components = 4;
+   */
+  components: EvmArgSchema[];
+};
+
+/**
+ * Describes the message evm_indexer.v1.EvmArgSchema.
+ * Use `create(EvmArgSchemaSchema)` to create a new message.
+ */
+export const EvmArgSchemaSchema: GenMessage<EvmArgSchema> = /*@__PURE__*/
+  messageDesc(file_evm_indexer_v1_evm_indexer, 40);
+
+/**
+ * @generated from message evm_indexer.v1.EvmEntrySchema
+ */
+export type EvmEntrySchema = Message<"evm_indexer.v1.EvmEntrySchema"> & {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * @generated from field: string signature = 2;
+   */
+  signature: string;
+
+  /**
+   * event topic0 or 4-byte function selector
+   *
+   * @generated from field: string selector = 3;
+   */
+  selector: string;
+
+  /**
+   * @generated from field: repeated evm_indexer.v1.EvmArgSchema args = 4;
+   */
+  args: EvmArgSchema[];
+};
+
+/**
+ * Describes the message evm_indexer.v1.EvmEntrySchema.
+ * Use `create(EvmEntrySchemaSchema)` to create a new message.
+ */
+export const EvmEntrySchemaSchema: GenMessage<EvmEntrySchema> = /*@__PURE__*/
+  messageDesc(file_evm_indexer_v1_evm_indexer, 41);
+
+/**
+ * @generated from message evm_indexer.v1.GetEvmJsonAbiSchemaRequest
+ */
+export type GetEvmJsonAbiSchemaRequest = Message<"evm_indexer.v1.GetEvmJsonAbiSchemaRequest"> & {
+  /**
+   * @generated from field: uint32 id = 1;
+   */
+  id: number;
+};
+
+/**
+ * Describes the message evm_indexer.v1.GetEvmJsonAbiSchemaRequest.
+ * Use `create(GetEvmJsonAbiSchemaRequestSchema)` to create a new message.
+ */
+export const GetEvmJsonAbiSchemaRequestSchema: GenMessage<GetEvmJsonAbiSchemaRequest> = /*@__PURE__*/
+  messageDesc(file_evm_indexer_v1_evm_indexer, 42);
+
+/**
+ * @generated from message evm_indexer.v1.GetEvmJsonAbiSchemaResponse
+ */
+export type GetEvmJsonAbiSchemaResponse = Message<"evm_indexer.v1.GetEvmJsonAbiSchemaResponse"> & {
+  /**
+   * @generated from field: repeated evm_indexer.v1.EvmEntrySchema events = 1;
+   */
+  events: EvmEntrySchema[];
+
+  /**
+   * @generated from field: repeated evm_indexer.v1.EvmEntrySchema functions = 2;
+   */
+  functions: EvmEntrySchema[];
+};
+
+/**
+ * Describes the message evm_indexer.v1.GetEvmJsonAbiSchemaResponse.
+ * Use `create(GetEvmJsonAbiSchemaResponseSchema)` to create a new message.
+ */
+export const GetEvmJsonAbiSchemaResponseSchema: GenMessage<GetEvmJsonAbiSchemaResponse> = /*@__PURE__*/
+  messageDesc(file_evm_indexer_v1_evm_indexer, 43);
+
+/**
+ * EvmImplementationAbi maps an implementation contract to the ABI the logs and
+ * calldata of the proxies delegating to it are decoded with (combined with the
+ * proxy source's own ABI).
+ *
+ * @generated from message evm_indexer.v1.EvmImplementationAbi
+ */
+export type EvmImplementationAbi = Message<"evm_indexer.v1.EvmImplementationAbi"> & {
+  /**
+   * @generated from field: optional uint32 id = 1;
+   */
+  id?: number | undefined;
+
+  /**
+   * @generated from field: uint32 evm_blockchain_id = 2;
+   */
+  evmBlockchainId: number;
+
+  /**
+   * @generated from field: string address = 3;
+   */
+  address: string;
+
+  /**
+   * @generated from field: uint32 evm_json_abi_id = 4;
+   */
+  evmJsonAbiId: number;
+};
+
+/**
+ * Describes the message evm_indexer.v1.EvmImplementationAbi.
+ * Use `create(EvmImplementationAbiSchema)` to create a new message.
+ */
+export const EvmImplementationAbiSchema: GenMessage<EvmImplementationAbi> = /*@__PURE__*/
+  messageDesc(file_evm_indexer_v1_evm_indexer, 44);
+
+/**
+ * SetEvmImplementationAbi creates the mapping of (evm_blockchain_id, address),
+ * or replaces its ABI.
+ *
+ * @generated from message evm_indexer.v1.SetEvmImplementationAbiRequest
+ */
+export type SetEvmImplementationAbiRequest = Message<"evm_indexer.v1.SetEvmImplementationAbiRequest"> & {
+  /**
+   * @generated from field: evm_indexer.v1.EvmImplementationAbi implementation_abi = 1;
+   */
+  implementationAbi?: EvmImplementationAbi | undefined;
+};
+
+/**
+ * Describes the message evm_indexer.v1.SetEvmImplementationAbiRequest.
+ * Use `create(SetEvmImplementationAbiRequestSchema)` to create a new message.
+ */
+export const SetEvmImplementationAbiRequestSchema: GenMessage<SetEvmImplementationAbiRequest> = /*@__PURE__*/
+  messageDesc(file_evm_indexer_v1_evm_indexer, 45);
+
+/**
+ * @generated from message evm_indexer.v1.SetEvmImplementationAbiResponse
+ */
+export type SetEvmImplementationAbiResponse = Message<"evm_indexer.v1.SetEvmImplementationAbiResponse"> & {
+  /**
+   * @generated from field: uint32 id = 1;
+   */
+  id: number;
+};
+
+/**
+ * Describes the message evm_indexer.v1.SetEvmImplementationAbiResponse.
+ * Use `create(SetEvmImplementationAbiResponseSchema)` to create a new message.
+ */
+export const SetEvmImplementationAbiResponseSchema: GenMessage<SetEvmImplementationAbiResponse> = /*@__PURE__*/
+  messageDesc(file_evm_indexer_v1_evm_indexer, 46);
+
+/**
+ * @generated from message evm_indexer.v1.ListEvmImplementationAbisRequest
+ */
+export type ListEvmImplementationAbisRequest = Message<"evm_indexer.v1.ListEvmImplementationAbisRequest"> & {
+  /**
+   * 0 = every blockchain
+   *
+   * @generated from field: uint32 evm_blockchain_id = 1;
+   */
+  evmBlockchainId: number;
+
+  /**
+   * @generated from field: evm_indexer.v1.Pagination pagination = 2;
+   */
+  pagination?: Pagination | undefined;
+};
+
+/**
+ * Describes the message evm_indexer.v1.ListEvmImplementationAbisRequest.
+ * Use `create(ListEvmImplementationAbisRequestSchema)` to create a new message.
+ */
+export const ListEvmImplementationAbisRequestSchema: GenMessage<ListEvmImplementationAbisRequest> = /*@__PURE__*/
+  messageDesc(file_evm_indexer_v1_evm_indexer, 47);
+
+/**
+ * @generated from message evm_indexer.v1.ListEvmImplementationAbisResponse
+ */
+export type ListEvmImplementationAbisResponse = Message<"evm_indexer.v1.ListEvmImplementationAbisResponse"> & {
+  /**
+   * @generated from field: repeated evm_indexer.v1.EvmImplementationAbi implementation_abis = 1;
+   */
+  implementationAbis: EvmImplementationAbi[];
+};
+
+/**
+ * Describes the message evm_indexer.v1.ListEvmImplementationAbisResponse.
+ * Use `create(ListEvmImplementationAbisResponseSchema)` to create a new message.
+ */
+export const ListEvmImplementationAbisResponseSchema: GenMessage<ListEvmImplementationAbisResponse> = /*@__PURE__*/
+  messageDesc(file_evm_indexer_v1_evm_indexer, 48);
+
+/**
+ * @generated from message evm_indexer.v1.DeleteEvmImplementationAbiRequest
+ */
+export type DeleteEvmImplementationAbiRequest = Message<"evm_indexer.v1.DeleteEvmImplementationAbiRequest"> & {
+  /**
+   * @generated from field: uint32 id = 1;
+   */
+  id: number;
+};
+
+/**
+ * Describes the message evm_indexer.v1.DeleteEvmImplementationAbiRequest.
+ * Use `create(DeleteEvmImplementationAbiRequestSchema)` to create a new message.
+ */
+export const DeleteEvmImplementationAbiRequestSchema: GenMessage<DeleteEvmImplementationAbiRequest> = /*@__PURE__*/
+  messageDesc(file_evm_indexer_v1_evm_indexer, 49);
 
 /**
- * @generated from message evm_indexer.v1.DeleteEvmBlockchainResponse
+ * @generated from message evm_indexer.v1.DeleteEvmImplementationAbiResponse
  */
-export type DeleteEvmBlockchainResponse = Message<"evm_indexer.v1.DeleteEvmBlockchainResponse"> & {
+export type DeleteEvmImplementationAbiResponse = Message<"evm_indexer.v1.DeleteEvmImplementationAbiResponse"> & {
 };
 
 /**
- * Describes the message evm_indexer.v1.DeleteEvmBlockchainResponse.
- * Use `create(DeleteEvmBlockchainResponseSchema)` to create a new message.
+ * Describes the message evm_indexer.v1.DeleteEvmImplementationAbiResponse.
+ * Use `create(DeleteEvmImplementationAbiResponseSchema)` to create a new message.
  */
-export const DeleteEvmBlockchainResponseSchema: GenMessage<DeleteEvmBlockchainResponse> = /*@__PURE__*/
-  messageDesc(file_evm_indexer_v1_evm_indexer, 25);
+export const DeleteEvmImplementationAbiResponseSchema: GenMessage<DeleteEvmImplementationAbiResponse> = /*@__PURE__*/
+  messageDesc(file_evm_indexer_v1_evm_indexer, 50);
 
 /**
- * EvmJsonAbi
+ * EvmProxyImplementation is one entry of a proxy source's implementation
+ * history, active from the log at (from_block, from_log_index).
+ * proxy_type: EIP1967 | EIP1822 | BEACON.
  *
- * @generated from message evm_indexer.v1.CreateEvmJsonAbiRequest
+ * @generated from message evm_indexer.v1.EvmProxyImplementation
  */
-export type CreateEvmJsonAbiRequest = Message<"evm_indexer.v1.CreateEvmJsonAbiRequest"> & {
+export type EvmProxyImplementation = Message<"evm_indexer.v1.EvmProxyImplementation"> & {
   /**
-   * @generated from field: evm_indexer.v1.EvmJsonAbi abi = 1;
+   * @generated from field: string proxy_type = 1;
    */
-  abi?: EvmJsonAbi | undefined;
+  proxyType: string;
+
+  /**
+   * @generated from field: string beacon = 2;
+   */
+  beacon: string;
+
+  /**
+   * @generated from field: string implementation = 3;
+   */
+  implementation: string;
+
+  /**
+   * @generated from field: uint64 from_block = 4;
+   */
+  fromBlock: bigint;
+
+  /**
+   * @generated from field: uint64 from_log_index = 5;
+   */
+  fromLogIndex: bigint;
+
+  /**
+   * unset when no ABI is mapped
+   *
+   * @generated from field: optional uint32 evm_json_abi_id = 6;
+   */
+  evmJsonAbiId?: number | undefined;
 };
 
 /**
- * Describes the message evm_indexer.v1.CreateEvmJsonAbiRequest.
- * Use `create(CreateEvmJsonAbiRequestSchema)` to create a new message.
+ * Describes the message evm_indexer.v1.EvmProxyImplementation.
+ * Use `create(EvmProxyImplementationSchema)` to create a new message.
  */
-export const CreateEvmJsonAbiRequestSchema: GenMessage<CreateEvmJsonAbiRequest> = /*@__PURE__*/
-  messageDesc(file_evm_indexer_v1_evm_indexer, 26);
+export const EvmProxyImplementationSchema: GenMessage<EvmProxyImplementation> = /*@__PURE__*/
+  messageDesc(file_evm_indexer_v1_evm_indexer, 51);
 
 /**
- * @generated from message evm_indexer.v1.CreateEvmJsonAbiResponse
+ * @generated from message evm_indexer.v1.ListEvmProxyImplementationsRequest
  */
-export type CreateEvmJsonAbiResponse = Message<"evm_indexer.v1.CreateEvmJsonAbiResponse"> & {
+export type ListEvmProxyImplementationsRequest = Message<"evm_indexer.v1.ListEvmProxyImplementationsRequest"> & {
   /**
-   * @generated from field: uint32 id = 1;
+   * @generated from field: uint32 source_id = 1;
    */
-  id: number;
+  sourceId: number;
 };
 
 /**
- * Describes the message evm_indexer.v1.CreateEvmJsonAbiResponse.
- * Use `create(CreateEvmJsonAbiResponseSchema)` to create a new message.
+ * Describes the message evm_indexer.v1.ListEvmProxyImplementationsRequest.
+ * Use `create(ListEvmProxyImplementationsRequestSchema)` to create a new message.
  */
-export const CreateEvmJsonAbiResponseSchema: GenMessage<CreateEvmJsonAbiResponse> = /*@__PURE__*/
-  messageDesc(file_evm_indexer_v1_evm_indexer, 27);
+export const ListEvmProxyImplementationsRequestSchema: GenMessage<ListEvmProxyImplementationsRequest> = /*@__PURE__*/
+  messageDesc(file_evm_indexer_v1_evm_indexer, 52);
 
 /**
- * @generated from message evm_indexer.v1.GetEvmJsonAbiRequest
+ * @generated from message evm_indexer.v1.ListEvmProxyImplementationsResponse
  */
-export type GetEvmJsonAbiRequest = Message<"evm_indexer.v1.GetEvmJsonAbiRequest"> & {
+export type ListEvmProxyImplementationsResponse = Message<"evm_indexer.v1.ListEvmProxyImplementationsResponse"> & {
   /**
-   * @generated from field: uint32 id = 1;
+   * @generated from field: repeated evm_indexer.v1.EvmProxyImplementation implementations = 1;
    */
-  id: number;
+  implementations: EvmProxyImplementation[];
 };
 
 /**
- * Describes the message evm_indexer.v1.GetEvmJsonAbiRequest.
- * Use `create(GetEvmJsonAbiRequestSchema)` to create a new message.
+ * Describes the message evm_indexer.v1.ListEvmProxyImplementationsResponse.
+ * Use `create(ListEvmProxyImplementationsResponseSchema)` to create a new message.
  */
-export const GetEvmJsonAbiRequestSchema: GenMessage<GetEvmJsonAbiRequest> = /*@__PURE__*/
-  messageDesc(file_evm_indexer_v1_evm_indexer, 28);
+export const ListEvmProxyImplementationsResponseSchema: GenMessage<ListEvmProxyImplementationsResponse> = /*@__PURE__*/
+  messageDesc(file_evm_indexer_v1_evm_indexer, 53);
 
 /**
- * @generated from message evm_indexer.v1.GetEvmJsonAbiResponse
+ * EvmEventSignature is one event of the signature registry FULL and TOPIC
+ * sources decode logs with when their ABIs do not declare the event. The
+ * events of every ABI are in the registry (evm_json_abi_id set, id unset),
+ * along with the imported ones (id set). signature is human-readable, with its
+ * indexed arguments: Transfer(address indexed from, address indexed to, uint256 value).
+ *
+ * @generated from message evm_indexer.v1.EvmEventSignature
  */
-export type GetEvmJsonAbiResponse = Message<"evm_indexer.v1.GetEvmJsonAbiResponse"> & {
+export type EvmEventSignature = Message<"evm_indexer.v1.EvmEventSignature"> & {
   /**
-   * @generated from field: evm_indexer.v1.EvmJsonAbi abi = 1;
+   * @generated from field: optional uint32 id = 1;
    */
-  abi?: EvmJsonAbi | undefined;
+  id?: number | undefined;
+
+  /**
+   * @generated from field: string topic0 = 2;
+   */
+  topic0: string;
+
+  /**
+   * @generated from field: uint32 indexed_count = 3;
+   */
+  indexedCount: number;
+
+  /**
+   * @generated from field: string signature = 4;
+   */
+  signature: string;
+
+  /**
+   * @generated from field: optional uint32 evm_json_abi_id = 5;
+   */
+  evmJsonAbiId?: number | undefined;
 };
 
 /**
- * Describes the message evm_indexer.v1.GetEvmJsonAbiResponse.
- * Use `create(GetEvmJsonAbiResponseSchema)` to create a new message.
+ * Describes the message evm_indexer.v1.EvmEventSignature.
+ * Use `create(EvmEventSignatureSchema)` to create a new message.
  */
-export const GetEvmJsonAbiResponseSchema: GenMessage<GetEvmJsonAbiResponse> = /*@__PURE__*/
-  messageDesc(file_evm_indexer_v1_evm_indexer, 29);
+export const EvmEventSignatureSchema: GenMessage<EvmEventSignature> = /*@__PURE__*/
+  messageDesc(file_evm_indexer_v1_evm_indexer, 54);
 
 /**
- * @generated from message evm_indexer.v1.UpdateEvmJsonAbiRequest
+ * ImportEvmEventSignatures imports the events of a signature file: a JSON ABI,
+ * or one human-readable event signature per line. Signatures already imported
+ * are skipped.
+ *
+ * @generated from message evm_indexer.v1.ImportEvmEventSignaturesRequest
  */
-export type UpdateEvmJsonAbiRequest = Message<"evm_indexer.v1.UpdateEvmJsonAbiRequest"> & {
+export type ImportEvmEventSignaturesRequest = Message<"evm_indexer.v1.ImportEvmEventSignaturesRequest"> & {
   /**
-   * @generated from field: evm_indexer.v1.EvmJsonAbi abi = 1;
+   * @generated from field: string content = 1;
    */
-  abi?: EvmJsonAbi | undefined;
+  content: string;
 };
 
 /**
- * Describes the message evm_indexer.v1.UpdateEvmJsonAbiRequest.
- * Use `create(UpdateEvmJsonAbiRequestSchema)` to create a new message.
+ * Describes the message evm_indexer.v1.ImportEvmEventSignaturesRequest.
+ * Use `create(ImportEvmEventSignaturesRequestSchema)` to create a new message.
  */
-export const UpdateEvmJsonAbiRequestSchema: GenMessage<UpdateEvmJsonAbiRequest> = /*@__PURE__*/
-  messageDesc(file_evm_indexer_v1_evm_indexer, 30);
+export const ImportEvmEventSignaturesRequestSchema: GenMessage<ImportEvmEventSignaturesRequest> = /*@__PURE__*/
+  messageDesc(file_evm_indexer_v1_evm_indexer, 55);
 
 /**
- * @generated from message evm_indexer.v1.UpdateEvmJsonAbiResponse
+ * @generated from message evm_indexer.v1.ImportEvmEventSignaturesResponse
  */
-export type UpdateEvmJsonAbiResponse = Message<"evm_indexer.v1.UpdateEvmJsonAbiResponse"> & {
+export type ImportEvmEventSignaturesResponse = Message<"evm_indexer.v1.ImportEvmEventSignaturesResponse"> & {
+  /**
+   * @generated from field: uint32 imported = 1;
+   */
+  imported: number;
+
+  /**
+   * @generated from field: uint32 skipped = 2;
+   */
+  skipped: number;
 };
 
 /**
- * Describes the message evm_indexer.v1.UpdateEvmJsonAbiResponse.
- * Use `create(UpdateEvmJsonAbiResponseSchema)` to create a new message.
+ * Describes the message evm_indexer.v1.ImportEvmEventSignaturesResponse.
+ * Use `create(ImportEvmEventSignaturesResponseSchema)` to create a new message.
  */
-export const UpdateEvmJsonAbiResponseSchema: GenMessage<UpdateEvmJsonAbiResponse> = /*@__PURE__*/
-  messageDesc(file_evm_indexer_v1_evm_indexer, 31);
+export const ImportEvmEventSignaturesResponseSchema: GenMessage<ImportEvmEventSignaturesResponse> = /*@__PURE__*/
+  messageDesc(file_evm_indexer_v1_evm_indexer, 56);
 
 /**
- * @generated from message evm_indexer.v1.ListEvmJsonAbisRequest
+ * @generated from message evm_indexer.v1.ListEvmEventSignaturesRequest
  */
-export type ListEvmJsonAbisRequest = Message<"evm_indexer.v1.ListEvmJsonAbisRequest"> & {
+export type ListEvmEventSignaturesRequest = Message<"evm_indexer.v1.ListEvmEventSignaturesRequest"> & {
   /**
-   * @generated from field: evm_indexer.v1.Pagination pagination = 1;
+   * empty = every topic0
+   *
+   * @generated from field: string topic0 = 1;
+   */
+  topic0: string;
+
+  /**
+   * @generated from field: evm_indexer.v1.Pagination pagination = 2;
    */
   pagination?: Pagination | undefined;
 };
 
 /**
- * Describes the message evm_indexer.v1.ListEvmJsonAbisRequest.
- * Use `create(ListEvmJsonAbisRequestSchema)` to create a new message.
+ * Describes the message evm_indexer.v1.ListEvmEventSignaturesRequest.
+ * Use `create(ListEvmEventSignaturesRequestSchema)` to create a new message.
  */
-export const ListEvmJsonAbisRequestSchema: GenMessage<ListEvmJsonAbisRequest> = /*@__PURE__*/
-  messageDesc(file_evm_indexer_v1_evm_indexer, 32);
+export const ListEvmEventSignaturesRequestSchema: GenMessage<ListEvmEventSignaturesRequest> = /*@__PURE__*/
+  messageDesc(file_evm_indexer_v1_evm_indexer, 57);
 
 /**
- * @generated from message evm_indexer.v1.ListEvmJsonAbisResponse
+ * @generated from message evm_indexer.v1.ListEvmEventSignaturesResponse
  */
-export type ListEvmJsonAbisResponse = Message<"evm_indexer.v1.ListEvmJsonAbisResponse"> & {
+export type ListEvmEventSignaturesResponse = Message<"evm_indexer.v1.ListEvmEventSignaturesResponse"> & {
   /**
-   * @generated from field: repeated evm_indexer.v1.EvmJsonAbi abis = 1;
+   * @generated from field: repeated evm_indexer.v1.EvmEventSignature signatures = 1;
    */
-  abis: EvmJsonAbi[];
+  signatures: EvmEventSignature[];
 };
 
 /**
- * Describes the message evm_indexer.v1.ListEvmJsonAbisResponse.
- * Use `create(ListEvmJsonAbisResponseSchema)` to create a new message.
+ * Describes the message evm_indexer.v1.ListEvmEventSignaturesResponse.
+ * Use `create(ListEvmEventSignaturesResponseSchema)` to create a new message.
  */
-export const ListEvmJsonAbisResponseSchema: GenMessage<ListEvmJsonAbisResponse> = /*@__PURE__*/
-  messageDesc(file_evm_indexer_v1_evm_indexer, 33);
+export const ListEvmEventSignaturesResponseSchema: GenMessage<ListEvmEventSignaturesResponse> = /*@__PURE__*/
+  messageDesc(file_evm_indexer_v1_evm_indexer, 58);
 
 /**
- * @generated from message evm_indexer.v1.DeleteEvmJsonAbiRequest
+ * @generated from message evm_indexer.v1.DeleteEvmEventSignatureRequest
  */
-export type DeleteEvmJsonAbiRequest = Message<"evm_indexer.v1.DeleteEvmJsonAbiRequest"> & {
+export type DeleteEvmEventSignatureRequest = Message<"evm_indexer.v1.DeleteEvmEventSignatureRequest"> & {
   /**
    * @generated from field: uint32 id = 1;
    */
@@ -1058,24 +2095,24 @@ export type DeleteEvmJsonAbiRequest = Message<"evm_indexer.v1.DeleteEvmJsonAbiRe
 };
 
 /**
- * Describes the message evm_indexer.v1.DeleteEvmJsonAbiRequest.
- * Use `create(DeleteEvmJsonAbiRequestSchema)` to create a new message.
+ * Describes the message evm_indexer.v1.DeleteEvmEventSignatureRequest.
+ * Use `create(DeleteEvmEventSignatureRequestSchema)` to create a new message.
  */
-export const DeleteEvmJsonAbiRequestSchema: GenMessage<DeleteEvmJsonAbiRequest> = /*@__PURE__*/
-  messageDesc(file_evm_indexer_v1_evm_indexer, 34);
+export const DeleteEvmEventSignatureRequestSchema: GenMessage<DeleteEvmEventSignatureRequest> = /*@__PURE__*/
+  messageDesc(file_evm_indexer_v1_evm_indexer, 59);
 
 /**
- * @generated from message evm_indexer.v1.DeleteEvmJsonAbiResponse
+ * @generated from message evm_indexer.v1.DeleteEvmEventSignatureResponse
  */
-export type DeleteEvmJsonAbiResponse = Message<"evm_indexer.v1.DeleteEvmJsonAbiResponse"> & {
+export type DeleteEvmEventSignatureResponse = Message<"evm_indexer.v1.DeleteEvmEventSignatureResponse"> & {
 };
 
 /**
- * Describes the message evm_indexer.v1.DeleteEvmJsonAbiResponse.
- * Use `create(DeleteEvmJsonAbiResponseSchema)` to create a new message.
+ * Describes the message evm_indexer.v1.DeleteEvmEventSignatureResponse.
+ * Use `create(DeleteEvmEventSignatureResponseSchema)` to create a new message.
  */
-export const DeleteEvmJsonAbiResponseSchema: GenMessage<DeleteEvmJsonAbiResponse> = /*@__PURE__*/
-  messageDesc(file_evm_indexer_v1_evm_indexer, 35);
+export const DeleteEvmEventSignatureResponseSchema: GenMessage<DeleteEvmEventSignatureResponse> = /*@__PURE__*/
+  messageDesc(file_evm_indexer_v1_evm_indexer, 60);
 
 /**
  * EvmLogStore
@@ -1094,7 +2131,7 @@ export type CreateEvmLogStoreRequest = Message<"evm_indexer.v1.CreateEvmLogStore
  * Use `create(CreateEvmLogStoreRequestSchema)` to create a new message.
  */
 export const CreateEvmLogStoreRequestSchema: GenMessage<CreateEvmLogStoreRequest> = /*@__PURE__*/
-  messageDesc(file_evm_indexer_v1_evm_indexer, 36);
+  messageDesc(file_evm_indexer_v1_evm_indexer, 61);
 
 /**
  * @generated from message evm_indexer.v1.CreateEvmLogStoreResponse
@@ -1111,7 +2148,7 @@ export type CreateEvmLogStoreResponse = Message<"evm_indexer.v1.CreateEvmLogStor
  * Use `create(CreateEvmLogStoreResponseSchema)` to create a new message.
  */
 export const CreateEvmLogStoreResponseSchema: GenMessage<CreateEvmLogStoreResponse> = /*@__PURE__*/
-  messageDesc(file_evm_indexer_v1_evm_indexer, 37);
+  messageDesc(file_evm_indexer_v1_evm_indexer, 62);
 
 /**
  * @generated from message evm_indexer.v1.GetEvmLogStoreRequest
@@ -1128,7 +2165,7 @@ export type GetEvmLogStoreRequest = Message<"evm_indexer.v1.GetEvmLogStoreReques
  * Use `create(GetEvmLogStoreRequestSchema)` to create a new message.
  */
 export const GetEvmLogStoreRequestSchema: GenMessage<GetEvmLogStoreRequest> = /*@__PURE__*/
-  messageDesc(file_evm_indexer_v1_evm_indexer, 38);
+  messageDesc(file_evm_indexer_v1_evm_indexer, 63);
 
 /**
  * @generated from message evm_indexer.v1.GetEvmLogStoreResponse
@@ -1145,7 +2182,7 @@ export type GetEvmLogStoreResponse = Message<"evm_indexer.v1.GetEvmLogStoreRespo
  * Use `create(GetEvmLogStoreResponseSchema)` to create a new message.
  */
 export const GetEvmLogStoreResponseSchema: GenMessage<GetEvmLogStoreResponse> = /*@__PURE__*/
-  messageDesc(file_evm_indexer_v1_evm_indexer, 39);
+  messageDesc(file_evm_indexer_v1_evm_indexer, 64);
 
 /**
  * @generated from message evm_indexer.v1.UpdateEvmLogStoreRequest
@@ -1162,7 +2199,7 @@ export type UpdateEvmLogStoreRequest = Message<"evm_indexer.v1.UpdateEvmLogStore
  * Use `create(UpdateEvmLogStoreRequestSchema)` to create a new message.
  */
 export const UpdateEvmLogStoreRequestSchema: GenMessage<UpdateEvmLogStoreRequest> = /*@__PURE__*/
-  messageDesc(file_evm_indexer_v1_evm_indexer, 40);
+  messageDesc(file_evm_indexer_v1_evm_indexer, 65);
 
 /**
  * @generated from message evm_indexer.v1.UpdateEvmLogStoreResponse
@@ -1175,7 +2212,7 @@ export type UpdateEvmLogStoreResponse = Message<"evm_indexer.v1.UpdateEvmLogStor
  * Use `create(UpdateEvmLogStoreResponseSchema)` to create a new message.
  */
 export const UpdateEvmLogStoreResponseSchema: GenMessage<UpdateEvmLogStoreResponse> = /*@__PURE__*/
-  messageDesc(file_evm_indexer_v1_evm_indexer, 41);
+  messageDesc(file_evm_indexer_v1_evm_indexer, 66);
 
 /**
  * @generated from message evm_indexer.v1.ListEvmLogStoresRequest
@@ -1192,7 +2229,7 @@ export type ListEvmLogStoresRequest = Message<"evm_indexer.v1.ListEvmLogStoresRe
  * Use `create(ListEvmLogStoresRequestSchema)` to create a new message.
  */
 export const ListEvmLogStoresRequestSchema: GenMessage<ListEvmLogStoresRequest> = /*@__PURE__*/
-  messageDesc(file_evm_indexer_v1_evm_indexer, 42);
+  messageDesc(file_evm_indexer_v1_evm_indexer, 67);
 
 /**
  * @generated from message evm_indexer.v1.ListEvmLogStoresResponse
@@ -1209,7 +2246,7 @@ export type ListEvmLogStoresResponse = Message<"evm_indexer.v1.ListEvmLogStoresR
  * Use `create(ListEvmLogStoresResponseSchema)` to create a new message.
  */
 export const ListEvmLogStoresResponseSchema: GenMessage<ListEvmLogStoresResponse> = /*@__PURE__*/
-  messageDesc(file_evm_indexer_v1_evm_indexer, 43);
+  messageDesc(file_evm_indexer_v1_evm_indexer, 68);
 
 /**
  * @generated from message evm_indexer.v1.DeleteEvmLogStoreRequest
@@ -1226,7 +2263,7 @@ export type DeleteEvmLogStoreRequest = Message<"evm_indexer.v1.DeleteEvmLogStore
  * Use `create(DeleteEvmLogStoreRequestSchema)` to create a new message.
  */
 export const DeleteEvmLogStoreRequestSchema: GenMessage<DeleteEvmLogStoreRequest> = /*@__PURE__*/
-  messageDesc(file_evm_indexer_v1_evm_indexer, 44);
+  messageDesc(file_evm_indexer_v1_evm_indexer, 69);
 
 /**
  * @generated from message evm_indexer.v1.DeleteEvmLogStoreResponse
@@ -1239,7 +2276,7 @@ export type DeleteEvmLogStoreResponse = Message<"evm_indexer.v1.DeleteEvmLogStor
  * Use `create(DeleteEvmLogStoreResponseSchema)` to create a new message.
  */
 export const DeleteEvmLogStoreResponseSchema: GenMessage<DeleteEvmLogStoreResponse> = /*@__PURE__*/
-  messageDesc(file_evm_indexer_v1_evm_indexer, 45);
+  messageDesc(file_evm_indexer_v1_evm_indexer, 70);
 
 /**
  * EvmLogPipeline
@@ -1258,7 +2295,7 @@ export type CreateEvmLogPipelineRequest = Message<"evm_indexer.v1.CreateEvmLogPi
  * Use `create(CreateEvmLogPipelineRequestSchema)` to create a new message.
  */
 export const CreateEvmLogPipelineRequestSchema: GenMessage<CreateEvmLogPipelineRequest> = /*@__PURE__*/
-  messageDesc(file_evm_indexer_v1_evm_indexer, 46);
+  messageDesc(file_evm_indexer_v1_evm_indexer, 71);
 
 /**
  * @generated from message evm_indexer.v1.CreateEvmLogPipelineResponse
@@ -1275,7 +2312,7 @@ export type CreateEvmLogPipelineResponse = Message<"evm_indexer.v1.CreateEvmLogP
  * Use `create(CreateEvmLogPipelineResponseSchema)` to create a new message.
  */
 export const CreateEvmLogPipelineResponseSchema: GenMessage<CreateEvmLogPipelineResponse> = /*@__PURE__*/
-  messageDesc(file_evm_indexer_v1_evm_indexer, 47);
+  messageDesc(file_evm_indexer_v1_evm_indexer, 72);
 
 /**
  * @generated from message evm_indexer.v1.GetEvmLogPipelineRequest
@@ -1292,7 +2329,7 @@ export type GetEvmLogPipelineRequest = Message<"evm_indexer.v1.GetEvmLogPipeline
  * Use `create(GetEvmLogPipelineRequestSchema)` to create a new message.
  */
 export const GetEvmLogPipelineRequestSchema: GenMessage<GetEvmLogPipelineRequest> = /*@__PURE__*/
-  messageDesc(file_evm_indexer_v1_evm_indexer, 48);
+  messageDesc(file_evm_indexer_v1_evm_indexer, 73);
 
 /**
  * @generated from message evm_indexer.v1.GetEvmLogPipelineResponse
@@ -1309,7 +2346,7 @@ export type GetEvmLogPipelineResponse = Message<"evm_indexer.v1.GetEvmLogPipelin
  * Use `create(GetEvmLogPipelineResponseSchema)` to create a new message.
  */
 export const GetEvmLogPipelineResponseSchema: GenMessage<GetEvmLogPipelineResponse> = /*@__PURE__*/
-  messageDesc(file_evm_indexer_v1_evm_indexer, 49);
+  messageDesc(file_evm_indexer_v1_evm_indexer, 74);
 
 /**
  * @generated from message evm_indexer.v1.UpdateEvmLogPipelineRequest
@@ -1326,7 +2363,7 @@ export type UpdateEvmLogPipelineRequest = Message<"evm_indexer.v1.UpdateEvmLogPi
  * Use `create(UpdateEvmLogPipelineRequestSchema)` to create a new message.
  */
 export const UpdateEvmLogPipelineRequestSchema: GenMessage<UpdateEvmLogPipelineRequest> = /*@__PURE__*/
-  messageDesc(file_evm_indexer_v1_evm_indexer, 50);
+  messageDesc(file_evm_indexer_v1_evm_indexer, 75);
 
 /**
  * @generated from message evm_indexer.v1.UpdateEvmLogPipelineResponse
@@ -1339,7 +2376,7 @@ export type UpdateEvmLogPipelineResponse = Message<"evm_indexer.v1.UpdateEvmLogP
  * Use `create(UpdateEvmLogPipelineResponseSchema)` to create a new message.
  */
 export const UpdateEvmLogPipelineResponseSchema: GenMessage<UpdateEvmLogPipelineResponse> = /*@__PURE__*/
-  messageDesc(file_evm_indexer_v1_evm_indexer, 51);
+  messageDesc(file_evm_indexer_v1_evm_indexer, 76);
 
 /**
  * @generated from message evm_indexer.v1.ListEvmLogPipelinesRequest
@@ -1356,7 +2393,7 @@ export type ListEvmLogPipelinesRequest = Message<"evm_indexer.v1.ListEvmLogPipel
  * Use `create(ListEvmLogPipelinesRequestSchema)` to create a new message.
  */
 export const ListEvmLogPipelinesRequestSchema: GenMessage<ListEvmLogPipelinesRequest> = /*@__PURE__*/
-  messageDesc(file_evm_indexer_v1_evm_indexer, 52);
+  messageDesc(file_evm_indexer_v1_evm_indexer, 77);
 
 /**
  * @generated from message evm_indexer.v1.ListEvmLogPipelinesResponse
@@ -1373,7 +2410,7 @@ export type ListEvmLogPipelinesResponse = Message<"evm_indexer.v1.ListEvmLogPipe
  * Use `create(ListEvmLogPipelinesResponseSchema)` to create a new message.
  */
 export const ListEvmLogPipelinesResponseSchema: GenMessage<ListEvmLogPipelinesResponse> = /*@__PURE__*/
-  messageDesc(file_evm_indexer_v1_evm_indexer, 53);
+  messageDesc(file_evm_indexer_v1_evm_indexer, 78);
 
 /**
  * @generated from message evm_indexer.v1.DeleteEvmLogPipelineRequest
@@ -1390,7 +2427,7 @@ export type DeleteEvmLogPipelineRequest = Message<"evm_indexer.v1.DeleteEvmLogPi
  * Use `create(DeleteEvmLogPipelineRequestSchema)` to create a new message.
  */
 export const DeleteEvmLogPipelineRequestSchema: GenMessage<DeleteEvmLogPipelineRequest> = /*@__PURE__*/
-  messageDesc(file_evm_indexer_v1_evm_indexer, 54);
+  messageDesc(file_evm_indexer_v1_evm_indexer, 79);
 
 /**
  * @generated from message evm_indexer.v1.DeleteEvmLogPipelineResponse
@@ -1403,7 +2440,7 @@ export type DeleteEvmLogPipelineResponse = Message<"evm_indexer.v1.DeleteEvmLogP
  * Use `create(DeleteEvmLogPipelineResponseSchema)` to create a new message.
  */
 export const DeleteEvmLogPipelineResponseSchema: GenMessage<DeleteEvmLogPipelineResponse> = /*@__PURE__*/
-  messageDesc(file_evm_indexer_v1_evm_indexer, 55);
+  messageDesc(file_evm_indexer_v1_evm_indexer, 80);
 
 /**
  * EvmLogSource
@@ -1422,7 +2459,7 @@ export type CreateEvmLogSourceRequest = Message<"evm_indexer.v1.CreateEvmLogSour
  * Use `create(CreateEvmLogSourceRequestSchema)` to create a new message.
  */
 export const CreateEvmLogSourceRequestSchema: GenMessage<CreateEvmLogSourceRequest> = /*@__PURE__*/
-  messageDesc(file_evm_indexer_v1_evm_indexer, 56);
+  messageDesc(file_evm_indexer_v1_evm_indexer, 81);
 
 /**
  * @generated from message evm_indexer.v1.CreateEvmLogSourceResponse
@@ -1439,7 +2476,7 @@ export type CreateEvmLogSourceResponse = Message<"evm_indexer.v1.CreateEvmLogSou
  * Use `create(CreateEvmLogSourceResponseSchema)` to create a new message.
  */
 export const CreateEvmLogSourceResponseSchema: GenMessage<CreateEvmLogSourceResponse> = /*@__PURE__*/
-  messageDesc(file_evm_indexer_v1_evm_indexer, 57);
+  messageDesc(file_evm_indexer_v1_evm_indexer, 82);
 
 /**
  * @generated from message evm_indexer.v1.GetEvmLogSourceRequest
@@ -1456,7 +2493,7 @@ export type GetEvmLogSourceRequest = Message<"evm_indexer.v1.GetEvmLogSourceRequ
  * Use `create(GetEvmLogSourceRequestSchema)` to create a new message.
  */
 export const GetEvmLogSourceRequestSchema: GenMessage<GetEvmLogSourceRequest> = /*@__PURE__*/
-  messageDesc(file_evm_indexer_v1_evm_indexer, 58);
+  messageDesc(file_evm_indexer_v1_evm_indexer, 83);
 
 /**
  * @generated from message evm_indexer.v1.GetEvmLogSourceResponse
@@ -1473,7 +2510,7 @@ export type GetEvmLogSourceResponse = Message<"evm_indexer.v1.GetEvmLogSourceRes
  * Use `create(GetEvmLogSourceResponseSchema)` to create a new message.
  */
 export const GetEvmLogSourceResponseSchema: GenMessage<GetEvmLogSourceResponse> = /*@__PURE__*/
-  messageDesc(file_evm_indexer_v1_evm_indexer, 59);
+  messageDesc(file_evm_indexer_v1_evm_indexer, 84);
 
 /**
  * @generated from message evm_indexer.v1.UpdateEvmLogSourceRequest
@@ -1490,7 +2527,7 @@ export type UpdateEvmLogSourceRequest = Message<"evm_indexer.v1.UpdateEvmLogSour
  * Use `create(UpdateEvmLogSourceRequestSchema)` to create a new message.
  */
 export const UpdateEvmLogSourceRequestSchema: GenMessage<UpdateEvmLogSourceRequest> = /*@__PURE__*/
-  messageDesc(file_evm_indexer_v1_evm_indexer, 60);
+  messageDesc(file_evm_indexer_v1_evm_indexer, 85);
 
 /**
  * @generated from message evm_indexer.v1.UpdateEvmLogSourceResponse
@@ -1503,7 +2540,7 @@ export type UpdateEvmLogSourceResponse = Message<"evm_indexer.v1.UpdateEvmLogSou
  * Use `create(UpdateEvmLogSourceResponseSchema)` to create a new message.
  */
 export const UpdateEvmLogSourceResponseSchema: GenMessage<UpdateEvmLogSourceResponse> = /*@__PURE__*/
-  messageDesc(file_evm_indexer_v1_evm_indexer, 61);
+  messageDesc(file_evm_indexer_v1_evm_indexer, 86);
 
 /**
  * @generated from message evm_indexer.v1.ListEvmLogSourcesRequest
@@ -1525,7 +2562,7 @@ export type ListEvmLogSourcesRequest = Message<"evm_indexer.v1.ListEvmLogSources
  * Use `create(ListEvmLogSourcesRequestSchema)` to create a new message.
  */
 export const ListEvmLogSourcesRequestSchema: GenMessage<ListEvmLogSourcesRequest> = /*@__PURE__*/
-  messageDesc(file_evm_indexer_v1_evm_indexer, 62);
+  messageDesc(file_evm_indexer_v1_evm_indexer, 87);
 
 /**
  * @generated from message evm_indexer.v1.ListEvmLogSourcesResponse
@@ -1542,7 +2579,7 @@ export type ListEvmLogSourcesResponse = Message<"evm_indexer.v1.ListEvmLogSource
  * Use `create(ListEvmLogSourcesResponseSchema)` to create a new message.
  */
 export const ListEvmLogSourcesResponseSchema: GenMessage<ListEvmLogSourcesResponse> = /*@__PURE__*/
-  messageDesc(file_evm_indexer_v1_evm_indexer, 63);
+  messageDesc(file_evm_indexer_v1_evm_indexer, 88);
 
 /**
  * @generated from message evm_indexer.v1.DeleteEvmLogSourceRequest
@@ -1559,7 +2596,7 @@ export type DeleteEvmLogSourceRequest = Message<"evm_indexer.v1.DeleteEvmLogSour
  * Use `create(DeleteEvmLogSourceRequestSchema)` to create a new message.
  */
 export const DeleteEvmLogSourceRequestSchema: GenMessage<DeleteEvmLogSourceRequest> = /*@__PURE__*/
-  messageDesc(file_evm_indexer_v1_evm_indexer, 64);
+  messageDesc(file_evm_indexer_v1_evm_indexer, 89);
 
 /**
  * @generated from message evm_indexer.v1.DeleteEvmLogSourceResponse
@@ -1568,30 +2605,111 @@ export type DeleteEvmLogSourceResponse = Message<"evm_indexer.v1.DeleteEvmLogSou
 };
 
 /**
- * Describes the message evm_indexer.v1.DeleteEvmLogSourceResponse.
- * Use `create(DeleteEvmLogSourceResponseSchema)` to create a new message.
+ * Describes the message evm_indexer.v1.DeleteEvmLogSourceResponse.
+ * Use `create(DeleteEvmLogSourceResponseSchema)` to create a new message.
+ */
+export const DeleteEvmLogSourceResponseSchema: GenMessage<DeleteEvmLogSourceResponse> = /*@__PURE__*/
+  messageDesc(file_evm_indexer_v1_evm_indexer, 90);
+
+/**
+ * @generated from message evm_indexer.v1.StreamEvmLogSourceUpdatesRequest
+ */
+export type StreamEvmLogSourceUpdatesRequest = Message<"evm_indexer.v1.StreamEvmLogSourceUpdatesRequest"> & {
+  /**
+   * Optional: only stream updates for sources of this pipeline (0 = all).
+   *
+   * @generated from field: uint32 pipeline_id = 1;
+   */
+  pipelineId: number;
+};
+
+/**
+ * Describes the message evm_indexer.v1.StreamEvmLogSourceUpdatesRequest.
+ * Use `create(StreamEvmLogSourceUpdatesRequestSchema)` to create a new message.
+ */
+export const StreamEvmLogSourceUpdatesRequestSchema: GenMessage<StreamEvmLogSourceUpdatesRequest> = /*@__PURE__*/
+  messageDesc(file_evm_indexer_v1_evm_indexer, 91);
+
+/**
+ * @generated from message evm_indexer.v1.RedecodeEvmLogSourceRequest
+ */
+export type RedecodeEvmLogSourceRequest = Message<"evm_indexer.v1.RedecodeEvmLogSourceRequest"> & {
+  /**
+   * @generated from field: uint32 id = 1;
+   */
+  id: number;
+};
+
+/**
+ * Describes the message evm_indexer.v1.RedecodeEvmLogSourceRequest.
+ * Use `create(RedecodeEvmLogSourceRequestSchema)` to create a new message.
+ */
+export const RedecodeEvmLogSourceRequestSchema: GenMessage<RedecodeEvmLogSourceRequest> = /*@__PURE__*/
+  messageDesc(file_evm_indexer_v1_evm_indexer, 92);
+
+/**
+ * @generated from message evm_indexer.v1.RedecodeEvmLogSourceResponse
+ */
+export type RedecodeEvmLogSourceResponse = Message<"evm_indexer.v1.RedecodeEvmLogSourceResponse"> & {
+};
+
+/**
+ * Describes the message evm_indexer.v1.RedecodeEvmLogSourceResponse.
+ * Use `create(RedecodeEvmLogSourceResponseSchema)` to create a new message.
+ */
+export const RedecodeEvmLogSourceResponseSchema: GenMessage<RedecodeEvmLogSourceResponse> = /*@__PURE__*/
+  messageDesc(file_evm_indexer_v1_evm_indexer, 93);
+
+/**
+ * @generated from message evm_indexer.v1.ResyncEvmLogSourceRequest
+ */
+export type ResyncEvmLogSourceRequest = Message<"evm_indexer.v1.ResyncEvmLogSourceRequest"> & {
+  /**
+   * @generated from field: uint32 id = 1;
+   */
+  id: number;
+
+  /**
+   * The first block indexed again: the source's stored data at or above it
+   * is deleted.
+   *
+   * @generated from field: uint64 from_block = 2;
+   */
+  fromBlock: bigint;
+
+  /**
+   * Also resync the factory children of the source, recursively.
+   *
+   * @generated from field: bool include_children = 3;
+   */
+  includeChildren: boolean;
+};
+
+/**
+ * Describes the message evm_indexer.v1.ResyncEvmLogSourceRequest.
+ * Use `create(ResyncEvmLogSourceRequestSchema)` to create a new message.
  */
-export const DeleteEvmLogSourceResponseSchema: GenMessage<DeleteEvmLogSourceResponse> = /*@__PURE__*/
-  messageDesc(file_evm_indexer_v1_evm_indexer, 65);
+export const ResyncEvmLogSourceRequestSchema: GenMessage<ResyncEvmLogSourceRequest> = /*@__PURE__*/
+  messageDesc(file_evm_indexer_v1_evm_indexer, 94);
 
 /**
- * @generated from message evm_indexer.v1.StreamEvmLogSourceUpdatesRequest
+ * @generated from message evm_indexer.v1.ResyncEvmLogSourceResponse
  */
-export type StreamEvmLogSourceUpdatesRequest = Message<"evm_indexer.v1.StreamEvmLogSourceUpdatesRequest"> & {
+export type ResyncEvmLogSourceResponse = Message<"evm_indexer.v1.ResyncEvmLogSourceResponse"> & {
   /**
-   * Optional: only stream updates for sources of this pipeline (0 = all).
+   * The sources resynced: the source, then its children when included.
    *
-   * @generated from field: uint32 pipeline_id = 1;
+   * @generated from field: repeated uint32 source_ids = 1;
    */
-  pipelineId: number;
+  sourceIds: number[];
 };
 
 /**
- * Describes the message evm_indexer.v1.StreamEvmLogSourceUpdatesRequest.
- * Use `create(StreamEvmLogSourceUpdatesRequestSchema)` to create a new message.
+ * Describes the message evm_indexer.v1.ResyncEvmLogSourceResponse.
+ * Use `create(ResyncEvmLogSourceResponseSchema)` to create a new message.
  */
-export const StreamEvmLogSourceUpdatesRequestSchema: GenMessage<StreamEvmLogSourceUpdatesRequest> = /*@__PURE__*/
-  messageDesc(file_evm_indexer_v1_evm_indexer, 66);
+export const ResyncEvmLogSourceResponseSchema: GenMessage<ResyncEvmLogSourceResponse> = /*@__PURE__*/
+  messageDesc(file_evm_indexer_v1_evm_indexer, 95);
 
 /**
  * @generated from message evm_indexer.v1.StartSourceIndexerRequest
@@ -1608,7 +2726,7 @@ export type StartSourceIndexerRequest = Message<"evm_indexer.v1.StartSourceIndex
  * Use `create(StartSourceIndexerRequestSchema)` to create a new message.
  */
 export const StartSourceIndexerRequestSchema: GenMessage<StartSourceIndexerRequest> = /*@__PURE__*/
-  messageDesc(file_evm_indexer_v1_evm_indexer, 67);
+  messageDesc(file_evm_indexer_v1_evm_indexer, 96);
 
 /**
  * @generated from message evm_indexer.v1.StartSourceIndexerResponse
@@ -1630,7 +2748,7 @@ export type StartSourceIndexerResponse = Message<"evm_indexer.v1.StartSourceInde
  * Use `create(StartSourceIndexerResponseSchema)` to create a new message.
  */
 export const StartSourceIndexerResponseSchema: GenMessage<StartSourceIndexerResponse> = /*@__PURE__*/
-  messageDesc(file_evm_indexer_v1_evm_indexer, 68);
+  messageDesc(file_evm_indexer_v1_evm_indexer, 97);
 
 /**
  * @generated from message evm_indexer.v1.StopSourceIndexerRequest
@@ -1647,7 +2765,7 @@ export type StopSourceIndexerRequest = Message<"evm_indexer.v1.StopSourceIndexer
  * Use `create(StopSourceIndexerRequestSchema)` to create a new message.
  */
 export const StopSourceIndexerRequestSchema: GenMessage<StopSourceIndexerRequest> = /*@__PURE__*/
-  messageDesc(file_evm_indexer_v1_evm_indexer, 69);
+  messageDesc(file_evm_indexer_v1_evm_indexer, 98);
 
 /**
  * @generated from message evm_indexer.v1.StopSourceIndexerResponse
@@ -1669,7 +2787,7 @@ export type StopSourceIndexerResponse = Message<"evm_indexer.v1.StopSourceIndexe
  * Use `create(StopSourceIndexerResponseSchema)` to create a new message.
  */
 export const StopSourceIndexerResponseSchema: GenMessage<StopSourceIndexerResponse> = /*@__PURE__*/
-  messageDesc(file_evm_indexer_v1_evm_indexer, 70);
+  messageDesc(file_evm_indexer_v1_evm_indexer, 99);
 
 /**
  * EvmLog
@@ -1698,7 +2816,7 @@ export type ListEvmLogsRequest = Message<"evm_indexer.v1.ListEvmLogsRequest"> &
  * Use `create(ListEvmLogsRequestSchema)` to create a new message.
  */
 export const ListEvmLogsRequestSchema: GenMessage<ListEvmLogsRequest> = /*@__PURE__*/
-  messageDesc(file_evm_indexer_v1_evm_indexer, 71);
+  messageDesc(file_evm_indexer_v1_evm_indexer, 100);
 
 /**
  * @generated from message evm_indexer.v1.ListEvmLogsResponse
@@ -1715,7 +2833,7 @@ export type ListEvmLogsResponse = Message<"evm_indexer.v1.ListEvmLogsResponse">
  * Use `create(ListEvmLogsResponseSchema)` to create a new message.
  */
 export const ListEvmLogsResponseSchema: GenMessage<ListEvmLogsResponse> = /*@__PURE__*/
-  messageDesc(file_evm_indexer_v1_evm_indexer, 72);
+  messageDesc(file_evm_indexer_v1_evm_indexer, 101);
 
 /**
  * @generated from message evm_indexer.v1.ListLatestEvmLogsRequest
@@ -1737,7 +2855,7 @@ export type ListLatestEvmLogsRequest = Message<"evm_indexer.v1.ListLatestEvmLogs
  * Use `create(ListLatestEvmLogsRequestSchema)` to create a new message.
  */
 export const ListLatestEvmLogsRequestSchema: GenMessage<ListLatestEvmLogsRequest> = /*@__PURE__*/
-  messageDesc(file_evm_indexer_v1_evm_indexer, 73);
+  messageDesc(file_evm_indexer_v1_evm_indexer, 102);
 
 /**
  * @generated from message evm_indexer.v1.ListLatestEvmLogsResponse
@@ -1754,7 +2872,7 @@ export type ListLatestEvmLogsResponse = Message<"evm_indexer.v1.ListLatestEvmLog
  * Use `create(ListLatestEvmLogsResponseSchema)` to create a new message.
  */
 export const ListLatestEvmLogsResponseSchema: GenMessage<ListLatestEvmLogsResponse> = /*@__PURE__*/
-  messageDesc(file_evm_indexer_v1_evm_indexer, 74);
+  messageDesc(file_evm_indexer_v1_evm_indexer, 103);
 
 /**
  * EvmTransaction
@@ -1783,7 +2901,7 @@ export type ListEvmTransactionsRequest = Message<"evm_indexer.v1.ListEvmTransact
  * Use `create(ListEvmTransactionsRequestSchema)` to create a new message.
  */
 export const ListEvmTransactionsRequestSchema: GenMessage<ListEvmTransactionsRequest> = /*@__PURE__*/
-  messageDesc(file_evm_indexer_v1_evm_indexer, 75);
+  messageDesc(file_evm_indexer_v1_evm_indexer, 104);
 
 /**
  * @generated from message evm_indexer.v1.ListEvmTransactionsResponse
@@ -1800,7 +2918,99 @@ export type ListEvmTransactionsResponse = Message<"evm_indexer.v1.ListEvmTransac
  * Use `create(ListEvmTransactionsResponseSchema)` to create a new message.
  */
 export const ListEvmTransactionsResponseSchema: GenMessage<ListEvmTransactionsResponse> = /*@__PURE__*/
-  messageDesc(file_evm_indexer_v1_evm_indexer, 76);
+  messageDesc(file_evm_indexer_v1_evm_indexer, 105);
+
+/**
+ * EvmBlock
+ *
+ * @generated from message evm_indexer.v1.ListEvmBlocksRequest
+ */
+export type ListEvmBlocksRequest = Message<"evm_indexer.v1.ListEvmBlocksRequest"> & {
+  /**
+   * @generated from field: uint32 source_id = 1;
+   */
+  sourceId: number;
+
+  /**
+   * @generated from field: uint64 from_block = 2;
+   */
+  fromBlock: bigint;
+
+  /**
+   * @generated from field: uint64 to_block = 3;
+   */
+  toBlock: bigint;
+};
+
+/**
+ * Describes the message evm_indexer.v1.ListEvmBlocksRequest.
+ * Use `create(ListEvmBlocksRequestSchema)` to create a new message.
+ */
+export const ListEvmBlocksRequestSchema: GenMessage<ListEvmBlocksRequest> = /*@__PURE__*/
+  messageDesc(file_evm_indexer_v1_evm_indexer, 106);
+
+/**
+ * @generated from message evm_indexer.v1.ListEvmBlocksResponse
+ */
+export type ListEvmBlocksResponse = Message<"evm_indexer.v1.ListEvmBlocksResponse"> & {
+  /**
+   * @generated from field: repeated evm_indexer.v1.EvmBlock blocks = 1;
+   */
+  blocks: EvmBlock[];
+};
+
+/**
+ * Describes the message evm_indexer.v1.ListEvmBlocksResponse.
+ * Use `create(ListEvmBlocksResponseSchema)` to create a new message.
+ */
+export const ListEvmBlocksResponseSchema: GenMessage<ListEvmBlocksResponse> = /*@__PURE__*/
+  messageDesc(file_evm_indexer_v1_evm_indexer, 107);
+
+/**
+ * EvmTrace
+ *
+ * @generated from message evm_indexer.v1.ListEvmTracesRequest
+ */
+export type ListEvmTracesRequest = Message<"evm_indexer.v1.ListEvmTracesRequest"> & {
+  /**
+   * @generated from field: uint32 source_id = 1;
+   */
+  sourceId: number;
+
+  /**
+   * @generated from field: uint64 from_block = 2;
+   */
+  fromBlock: bigint;
+
+  /**
+   * @generated from field: uint64 to_block = 3;
+   */
+  toBlock: bigint;
+};
+
+/**
+ * Describes the message evm_indexer.v1.ListEvmTracesRequest.
+ * Use `create(ListEvmTracesRequestSchema)` to create a new message.
+ */
+export const ListEvmTracesRequestSchema: GenMessage<ListEvmTracesRequest> = /*@__PURE__*/
+  messageDesc(file_evm_indexer_v1_evm_indexer, 108);
+
+/**
+ * @generated from message evm_indexer.v1.ListEvmTracesResponse
+ */
+export type ListEvmTracesResponse = Message<"evm_indexer.v1.ListEvmTracesResponse"> & {
+  /**
+   * @generated from field: repeated evm_indexer.v1.EvmTrace traces = 1;
+   */
+  traces: EvmTrace[];
+};
+
+/**
+ * Describes the message evm_indexer.v1.ListEvmTracesResponse.
+ * Use `create(ListEvmTracesResponseSchema)` to create a new message.
+ */
+export const ListEvmTracesResponseSchema: GenMessage<ListEvmTracesResponse> = /*@__PURE__*/
+  messageDesc(file_evm_indexer_v1_evm_indexer, 109);
 
 /**
  * Auth
@@ -1834,7 +3044,7 @@ export type AuthUser = Message<"evm_indexer.v1.AuthUser"> & {
  * Use `create(AuthUserSchema)` to create a new message.
  */
 export const AuthUserSchema: GenMessage<AuthUser> = /*@__PURE__*/
-  messageDesc(file_evm_indexer_v1_evm_indexer, 77);
+  messageDesc(file_evm_indexer_v1_evm_indexer, 110);
 
 /**
  * @generated from message evm_indexer.v1.AccessTokenInfo
@@ -1871,7 +3081,7 @@ export type AccessTokenInfo = Message<"evm_indexer.v1.AccessTokenInfo"> & {
  * Use `create(AccessTokenInfoSchema)` to create a new message.
  */
 export const AccessTokenInfoSchema: GenMessage<AccessTokenInfo> = /*@__PURE__*/
-  messageDesc(file_evm_indexer_v1_evm_indexer, 78);
+  messageDesc(file_evm_indexer_v1_evm_indexer, 111);
 
 /**
  * @generated from message evm_indexer.v1.LoginRequest
@@ -1893,7 +3103,7 @@ export type LoginRequest = Message<"evm_indexer.v1.LoginRequest"> & {
  * Use `create(LoginRequestSchema)` to create a new message.
  */
 export const LoginRequestSchema: GenMessage<LoginRequest> = /*@__PURE__*/
-  messageDesc(file_evm_indexer_v1_evm_indexer, 79);
+  messageDesc(file_evm_indexer_v1_evm_indexer, 112);
 
 /**
  * @generated from message evm_indexer.v1.LoginResponse
@@ -1915,7 +3125,7 @@ export type LoginResponse = Message<"evm_indexer.v1.LoginResponse"> & {
  * Use `create(LoginResponseSchema)` to create a new message.
  */
 export const LoginResponseSchema: GenMessage<LoginResponse> = /*@__PURE__*/
-  messageDesc(file_evm_indexer_v1_evm_indexer, 80);
+  messageDesc(file_evm_indexer_v1_evm_indexer, 113);
 
 /**
  * @generated from message evm_indexer.v1.MeRequest
@@ -1928,7 +3138,7 @@ export type MeRequest = Message<"evm_indexer.v1.MeRequest"> & {
  * Use `create(MeRequestSchema)` to create a new message.
  */
 export const MeRequestSchema: GenMessage<MeRequest> = /*@__PURE__*/
-  messageDesc(file_evm_indexer_v1_evm_indexer, 81);
+  messageDesc(file_evm_indexer_v1_evm_indexer, 114);
 
 /**
  * @generated from message evm_indexer.v1.MeResponse
@@ -1945,7 +3155,7 @@ export type MeResponse = Message<"evm_indexer.v1.MeResponse"> & {
  * Use `create(MeResponseSchema)` to create a new message.
  */
 export const MeResponseSchema: GenMessage<MeResponse> = /*@__PURE__*/
-  messageDesc(file_evm_indexer_v1_evm_indexer, 82);
+  messageDesc(file_evm_indexer_v1_evm_indexer, 115);
 
 /**
  * @generated from message evm_indexer.v1.CreateAccessTokenRequest
@@ -1967,7 +3177,7 @@ export type CreateAccessTokenRequest = Message<"evm_indexer.v1.CreateAccessToken
  * Use `create(CreateAccessTokenRequestSchema)` to create a new message.
  */
 export const CreateAccessTokenRequestSchema: GenMessage<CreateAccessTokenRequest> = /*@__PURE__*/
-  messageDesc(file_evm_indexer_v1_evm_indexer, 83);
+  messageDesc(file_evm_indexer_v1_evm_indexer, 116);
 
 /**
  * @generated from message evm_indexer.v1.CreateAccessTokenResponse
@@ -1999,7 +3209,7 @@ export type CreateAccessTokenResponse = Message<"evm_indexer.v1.CreateAccessToke
  * Use `create(CreateAccessTokenResponseSchema)` to create a new message.
  */
 export const CreateAccessTokenResponseSchema: GenMessage<CreateAccessTokenResponse> = /*@__PURE__*/
-  messageDesc(file_evm_indexer_v1_evm_indexer, 84);
+  messageDesc(file_evm_indexer_v1_evm_indexer, 117);
 
 /**
  * @generated from message evm_indexer.v1.ListAccessTokensRequest
@@ -2012,7 +3222,7 @@ export type ListAccessTokensRequest = Message<"evm_indexer.v1.ListAccessTokensRe
  * Use `create(ListAccessTokensRequestSchema)` to create a new message.
  */
 export const ListAccessTokensRequestSchema: GenMessage<ListAccessTokensRequest> = /*@__PURE__*/
-  messageDesc(file_evm_indexer_v1_evm_indexer, 85);
+  messageDesc(file_evm_indexer_v1_evm_indexer, 118);
 
 /**
  * @generated from message evm_indexer.v1.ListAccessTokensResponse
@@ -2029,7 +3239,7 @@ export type ListAccessTokensResponse = Message<"evm_indexer.v1.ListAccessTokensR
  * Use `create(ListAccessTokensResponseSchema)` to create a new message.
  */
 export const ListAccessTokensResponseSchema: GenMessage<ListAccessTokensResponse> = /*@__PURE__*/
-  messageDesc(file_evm_indexer_v1_evm_indexer, 86);
+  messageDesc(file_evm_indexer_v1_evm_indexer, 119);
 
 /**
  * @generated from message evm_indexer.v1.RevokeAccessTokenRequest
@@ -2046,7 +3256,7 @@ export type RevokeAccessTokenRequest = Message<"evm_indexer.v1.RevokeAccessToken
  * Use `create(RevokeAccessTokenRequestSchema)` to create a new message.
  */
 export const RevokeAccessTokenRequestSchema: GenMessage<RevokeAccessTokenRequest> = /*@__PURE__*/
-  messageDesc(file_evm_indexer_v1_evm_indexer, 87);
+  messageDesc(file_evm_indexer_v1_evm_indexer, 120);
 
 /**
  * @generated from message evm_indexer.v1.RevokeAccessTokenResponse
@@ -2059,7 +3269,7 @@ export type RevokeAccessTokenResponse = Message<"evm_indexer.v1.RevokeAccessToke
  * Use `create(RevokeAccessTokenResponseSchema)` to create a new message.
  */
 export const RevokeAccessTokenResponseSchema: GenMessage<RevokeAccessTokenResponse> = /*@__PURE__*/
-  messageDesc(file_evm_indexer_v1_evm_indexer, 88);
+  messageDesc(file_evm_indexer_v1_evm_indexer, 121);
 
 /**
  * OAuthProvider. client_secret is never returned; it is set via the separate
@@ -2119,7 +3329,7 @@ export type OAuthProvider = Message<"evm_indexer.v1.OAuthProvider"> & {
  * Use `create(OAuthProviderSchema)` to create a new message.
  */
 export const OAuthProviderSchema: GenMessage<OAuthProvider> = /*@__PURE__*/
-  messageDesc(file_evm_indexer_v1_evm_indexer, 89);
+  messageDesc(file_evm_indexer_v1_evm_indexer, 122);
 
 /**
  * @generated from message evm_indexer.v1.CreateOAuthProviderRequest
@@ -2141,7 +3351,7 @@ export type CreateOAuthProviderRequest = Message<"evm_indexer.v1.CreateOAuthProv
  * Use `create(CreateOAuthProviderRequestSchema)` to create a new message.
  */
 export const CreateOAuthProviderRequestSchema: GenMessage<CreateOAuthProviderRequest> = /*@__PURE__*/
-  messageDesc(file_evm_indexer_v1_evm_indexer, 90);
+  messageDesc(file_evm_indexer_v1_evm_indexer, 123);
 
 /**
  * @generated from message evm_indexer.v1.CreateOAuthProviderResponse
@@ -2158,7 +3368,7 @@ export type CreateOAuthProviderResponse = Message<"evm_indexer.v1.CreateOAuthPro
  * Use `create(CreateOAuthProviderResponseSchema)` to create a new message.
  */
 export const CreateOAuthProviderResponseSchema: GenMessage<CreateOAuthProviderResponse> = /*@__PURE__*/
-  messageDesc(file_evm_indexer_v1_evm_indexer, 91);
+  messageDesc(file_evm_indexer_v1_evm_indexer, 124);
 
 /**
  * @generated from message evm_indexer.v1.UpdateOAuthProviderRequest
@@ -2180,7 +3390,7 @@ export type UpdateOAuthProviderRequest = Message<"evm_indexer.v1.UpdateOAuthProv
  * Use `create(UpdateOAuthProviderRequestSchema)` to create a new message.
  */
 export const UpdateOAuthProviderRequestSchema: GenMessage<UpdateOAuthProviderRequest> = /*@__PURE__*/
-  messageDesc(file_evm_indexer_v1_evm_indexer, 92);
+  messageDesc(file_evm_indexer_v1_evm_indexer, 125);
 
 /**
  * @generated from message evm_indexer.v1.UpdateOAuthProviderResponse
@@ -2193,7 +3403,7 @@ export type UpdateOAuthProviderResponse = Message<"evm_indexer.v1.UpdateOAuthPro
  * Use `create(UpdateOAuthProviderResponseSchema)` to create a new message.
  */
 export const UpdateOAuthProviderResponseSchema: GenMessage<UpdateOAuthProviderResponse> = /*@__PURE__*/
-  messageDesc(file_evm_indexer_v1_evm_indexer, 93);
+  messageDesc(file_evm_indexer_v1_evm_indexer, 126);
 
 /**
  * @generated from message evm_indexer.v1.ListOAuthProvidersRequest
@@ -2206,7 +3416,7 @@ export type ListOAuthProvidersRequest = Message<"evm_indexer.v1.ListOAuthProvide
  * Use `create(ListOAuthProvidersRequestSchema)` to create a new message.
  */
 export const ListOAuthProvidersRequestSchema: GenMessage<ListOAuthProvidersRequest> = /*@__PURE__*/
-  messageDesc(file_evm_indexer_v1_evm_indexer, 94);
+  messageDesc(file_evm_indexer_v1_evm_indexer, 127);
 
 /**
  * @generated from message evm_indexer.v1.ListOAuthProvidersResponse
@@ -2223,7 +3433,7 @@ export type ListOAuthProvidersResponse = Message<"evm_indexer.v1.ListOAuthProvid
  * Use `create(ListOAuthProvidersResponseSchema)` to create a new message.
  */
 export const ListOAuthProvidersResponseSchema: GenMessage<ListOAuthProvidersResponse> = /*@__PURE__*/
-  messageDesc(file_evm_indexer_v1_evm_indexer, 95);
+  messageDesc(file_evm_indexer_v1_evm_indexer, 128);
 
 /**
  * @generated from message evm_indexer.v1.DeleteOAuthProviderRequest
@@ -2240,7 +3450,7 @@ export type DeleteOAuthProviderRequest = Message<"evm_indexer.v1.DeleteOAuthProv
  * Use `create(DeleteOAuthProviderRequestSchema)` to create a new message.
  */
 export const DeleteOAuthProviderRequestSchema: GenMessage<DeleteOAuthProviderRequest> = /*@__PURE__*/
-  messageDesc(file_evm_indexer_v1_evm_indexer, 96);
+  messageDesc(file_evm_indexer_v1_evm_indexer, 129);
 
 /**
  * @generated from message evm_indexer.v1.DeleteOAuthProviderResponse
@@ -2253,7 +3463,7 @@ export type DeleteOAuthProviderResponse = Message<"evm_indexer.v1.DeleteOAuthPro
  * Use `create(DeleteOAuthProviderResponseSchema)` to create a new message.
  */
 export const DeleteOAuthProviderResponseSchema: GenMessage<DeleteOAuthProviderResponse> = /*@__PURE__*/
-  messageDesc(file_evm_indexer_v1_evm_indexer, 97);
+  messageDesc(file_evm_indexer_v1_evm_indexer, 130);
 
 /**
  * Public: the enabled providers a user can sign in with.
@@ -2282,7 +3492,7 @@ export type OAuthLoginOption = Message<"evm_indexer.v1.OAuthLoginOption"> & {
  * Use `create(OAuthLoginOptionSchema)` to create a new message.
  */
 export const OAuthLoginOptionSchema: GenMessage<OAuthLoginOption> = /*@__PURE__*/
-  messageDesc(file_evm_indexer_v1_evm_indexer, 98);
+  messageDesc(file_evm_indexer_v1_evm_indexer, 131);
 
 /**
  * @generated from message evm_indexer.v1.ListOAuthLoginUrlsRequest
@@ -2295,7 +3505,7 @@ export type ListOAuthLoginUrlsRequest = Message<"evm_indexer.v1.ListOAuthLoginUr
  * Use `create(ListOAuthLoginUrlsRequestSchema)` to create a new message.
  */
 export const ListOAuthLoginUrlsRequestSchema: GenMessage<ListOAuthLoginUrlsRequest> = /*@__PURE__*/
-  messageDesc(file_evm_indexer_v1_evm_indexer, 99);
+  messageDesc(file_evm_indexer_v1_evm_indexer, 132);
 
 /**
  * @generated from message evm_indexer.v1.ListOAuthLoginUrlsResponse
@@ -2312,7 +3522,7 @@ export type ListOAuthLoginUrlsResponse = Message<"evm_indexer.v1.ListOAuthLoginU
  * Use `create(ListOAuthLoginUrlsResponseSchema)` to create a new message.
  */
 export const ListOAuthLoginUrlsResponseSchema: GenMessage<ListOAuthLoginUrlsResponse> = /*@__PURE__*/
-  messageDesc(file_evm_indexer_v1_evm_indexer, 100);
+  messageDesc(file_evm_indexer_v1_evm_indexer, 133);
 
 /**
  * User management (admin).
@@ -2327,7 +3537,7 @@ export type ListUsersRequest = Message<"evm_indexer.v1.ListUsersRequest"> & {
  * Use `create(ListUsersRequestSchema)` to create a new message.
  */
 export const ListUsersRequestSchema: GenMessage<ListUsersRequest> = /*@__PURE__*/
-  messageDesc(file_evm_indexer_v1_evm_indexer, 101);
+  messageDesc(file_evm_indexer_v1_evm_indexer, 134);
 
 /**
  * @generated from message evm_indexer.v1.ListUsersResponse
@@ -2344,7 +3554,7 @@ export type ListUsersResponse = Message<"evm_indexer.v1.ListUsersResponse"> & {
  * Use `create(ListUsersResponseSchema)` to create a new message.
  */
 export const ListUsersResponseSchema: GenMessage<ListUsersResponse> = /*@__PURE__*/
-  messageDesc(file_evm_indexer_v1_evm_indexer, 102);
+  messageDesc(file_evm_indexer_v1_evm_indexer, 135);
 
 /**
  * @generated from message evm_indexer.v1.CreateUserRequest
@@ -2376,7 +3586,7 @@ export type CreateUserRequest = Message<"evm_indexer.v1.CreateUserRequest"> & {
  * Use `create(CreateUserRequestSchema)` to create a new message.
  */
 export const CreateUserRequestSchema: GenMessage<CreateUserRequest> = /*@__PURE__*/
-  messageDesc(file_evm_indexer_v1_evm_indexer, 103);
+  messageDesc(file_evm_indexer_v1_evm_indexer, 136);
 
 /**
  * @generated from message evm_indexer.v1.CreateUserResponse
@@ -2393,7 +3603,7 @@ export type CreateUserResponse = Message<"evm_indexer.v1.CreateUserResponse"> &
  * Use `create(CreateUserResponseSchema)` to create a new message.
  */
 export const CreateUserResponseSchema: GenMessage<CreateUserResponse> = /*@__PURE__*/
-  messageDesc(file_evm_indexer_v1_evm_indexer, 104);
+  messageDesc(file_evm_indexer_v1_evm_indexer, 137);
 
 /**
  * @generated from message evm_indexer.v1.UpdateUserRequest
@@ -2432,7 +3642,7 @@ export type UpdateUserRequest = Message<"evm_indexer.v1.UpdateUserRequest"> & {
  * Use `create(UpdateUserRequestSchema)` to create a new message.
  */
 export const UpdateUserRequestSchema: GenMessage<UpdateUserRequest> = /*@__PURE__*/
-  messageDesc(file_evm_indexer_v1_evm_indexer, 105);
+  messageDesc(file_evm_indexer_v1_evm_indexer, 138);
 
 /**
  * @generated from message evm_indexer.v1.UpdateUserResponse
@@ -2445,7 +3655,7 @@ export type UpdateUserResponse = Message<"evm_indexer.v1.UpdateUserResponse"> &
  * Use `create(UpdateUserResponseSchema)` to create a new message.
  */
 export const UpdateUserResponseSchema: GenMessage<UpdateUserResponse> = /*@__PURE__*/
-  messageDesc(file_evm_indexer_v1_evm_indexer, 106);
+  messageDesc(file_evm_indexer_v1_evm_indexer, 139);
 
 /**
  * @generated from message evm_indexer.v1.DeleteUserRequest
@@ -2462,7 +3672,7 @@ export type DeleteUserRequest = Message<"evm_indexer.v1.DeleteUserRequest"> & {
  * Use `create(DeleteUserRequestSchema)` to create a new message.
  */
 export const DeleteUserRequestSchema: GenMessage<DeleteUserRequest> = /*@__PURE__*/
-  messageDesc(file_evm_indexer_v1_evm_indexer, 107);
+  messageDesc(file_evm_indexer_v1_evm_indexer, 140);
 
 /**
  * @generated from message evm_indexer.v1.DeleteUserResponse
@@ -2475,7 +3685,7 @@ export type DeleteUserResponse = Message<"evm_indexer.v1.DeleteUserResponse"> &
  * Use `create(DeleteUserResponseSchema)` to create a new message.
  */
 export const DeleteUserResponseSchema: GenMessage<DeleteUserResponse> = /*@__PURE__*/
-  messageDesc(file_evm_indexer_v1_evm_indexer, 108);
+  messageDesc(file_evm_indexer_v1_evm_indexer, 141);
 
 /**
  * EvmiExporter
@@ -2504,6 +3714,9 @@ export type EvmiExporter = Message<"evm_indexer.v1.EvmiExporter"> & {
   enabled: boolean;
 
   /**
+   * RUNNING, STOPPED, FAILED or COMPLETED (every log of the pipeline's
+   * sources delivered, all of them completed).
+   *
    * @generated from field: string status = 5;
    */
   status: string;
@@ -2554,7 +3767,7 @@ export type EvmiExporter = Message<"evm_indexer.v1.EvmiExporter"> & {
  * Use `create(EvmiExporterSchema)` to create a new message.
  */
 export const EvmiExporterSchema: GenMessage<EvmiExporter> = /*@__PURE__*/
-  messageDesc(file_evm_indexer_v1_evm_indexer, 109);
+  messageDesc(file_evm_indexer_v1_evm_indexer, 142);
 
 /**
  * Plugin
@@ -2633,7 +3846,7 @@ export type Plugin = Message<"evm_indexer.v1.Plugin"> & {
  * Use `create(PluginSchema)` to create a new message.
  */
 export const PluginSchema: GenMessage<Plugin> = /*@__PURE__*/
-  messageDesc(file_evm_indexer_v1_evm_indexer, 110);
+  messageDesc(file_evm_indexer_v1_evm_indexer, 143);
 
 /**
  * @generated from message evm_indexer.v1.CreatePluginRequest
@@ -2650,7 +3863,7 @@ export type CreatePluginRequest = Message<"evm_indexer.v1.CreatePluginRequest">
  * Use `create(CreatePluginRequestSchema)` to create a new message.
  */
 export const CreatePluginRequestSchema: GenMessage<CreatePluginRequest> = /*@__PURE__*/
-  messageDesc(file_evm_indexer_v1_evm_indexer, 111);
+  messageDesc(file_evm_indexer_v1_evm_indexer, 144);
 
 /**
  * @generated from message evm_indexer.v1.CreatePluginResponse
@@ -2667,7 +3880,7 @@ export type CreatePluginResponse = Message<"evm_indexer.v1.CreatePluginResponse"
  * Use `create(CreatePluginResponseSchema)` to create a new message.
  */
 export const CreatePluginResponseSchema: GenMessage<CreatePluginResponse> = /*@__PURE__*/
-  messageDesc(file_evm_indexer_v1_evm_indexer, 112);
+  messageDesc(file_evm_indexer_v1_evm_indexer, 145);
 
 /**
  * @generated from message evm_indexer.v1.GetPluginRequest
@@ -2684,7 +3897,7 @@ export type GetPluginRequest = Message<"evm_indexer.v1.GetPluginRequest"> & {
  * Use `create(GetPluginRequestSchema)` to create a new message.
  */
 export const GetPluginRequestSchema: GenMessage<GetPluginRequest> = /*@__PURE__*/
-  messageDesc(file_evm_indexer_v1_evm_indexer, 113);
+  messageDesc(file_evm_indexer_v1_evm_indexer, 146);
 
 /**
  * @generated from message evm_indexer.v1.GetPluginResponse
@@ -2701,7 +3914,7 @@ export type GetPluginResponse = Message<"evm_indexer.v1.GetPluginResponse"> & {
  * Use `create(GetPluginResponseSchema)` to create a new message.
  */
 export const GetPluginResponseSchema: GenMessage<GetPluginResponse> = /*@__PURE__*/
-  messageDesc(file_evm_indexer_v1_evm_indexer, 114);
+  messageDesc(file_evm_indexer_v1_evm_indexer, 147);
 
 /**
  * @generated from message evm_indexer.v1.UpdatePluginRequest
@@ -2718,7 +3931,7 @@ export type UpdatePluginRequest = Message<"evm_indexer.v1.UpdatePluginRequest">
  * Use `create(UpdatePluginRequestSchema)` to create a new message.
  */
 export const UpdatePluginRequestSchema: GenMessage<UpdatePluginRequest> = /*@__PURE__*/
-  messageDesc(file_evm_indexer_v1_evm_indexer, 115);
+  messageDesc(file_evm_indexer_v1_evm_indexer, 148);
 
 /**
  * @generated from message evm_indexer.v1.UpdatePluginResponse
@@ -2731,7 +3944,7 @@ export type UpdatePluginResponse = Message<"evm_indexer.v1.UpdatePluginResponse"
  * Use `create(UpdatePluginResponseSchema)` to create a new message.
  */
 export const UpdatePluginResponseSchema: GenMessage<UpdatePluginResponse> = /*@__PURE__*/
-  messageDesc(file_evm_indexer_v1_evm_indexer, 116);
+  messageDesc(file_evm_indexer_v1_evm_indexer, 149);
 
 /**
  * @generated from message evm_indexer.v1.ListPluginsRequest
@@ -2748,7 +3961,7 @@ export type ListPluginsRequest = Message<"evm_indexer.v1.ListPluginsRequest"> &
  * Use `create(ListPluginsRequestSchema)` to create a new message.
  */
 export const ListPluginsRequestSchema: GenMessage<ListPluginsRequest> = /*@__PURE__*/
-  messageDesc(file_evm_indexer_v1_evm_indexer, 117);
+  messageDesc(file_evm_indexer_v1_evm_indexer, 150);
 
 /**
  * @generated from message evm_indexer.v1.ListPluginsResponse
@@ -2765,7 +3978,7 @@ export type ListPluginsResponse = Message<"evm_indexer.v1.ListPluginsResponse">
  * Use `create(ListPluginsResponseSchema)` to create a new message.
  */
 export const ListPluginsResponseSchema: GenMessage<ListPluginsResponse> = /*@__PURE__*/
-  messageDesc(file_evm_indexer_v1_evm_indexer, 118);
+  messageDesc(file_evm_indexer_v1_evm_indexer, 151);
 
 /**
  * @generated from message evm_indexer.v1.DeletePluginRequest
@@ -2782,7 +3995,7 @@ export type DeletePluginRequest = Message<"evm_indexer.v1.DeletePluginRequest">
  * Use `create(DeletePluginRequestSchema)` to create a new message.
  */
 export const DeletePluginRequestSchema: GenMessage<DeletePluginRequest> = /*@__PURE__*/
-  messageDesc(file_evm_indexer_v1_evm_indexer, 119);
+  messageDesc(file_evm_indexer_v1_evm_indexer, 152);
 
 /**
  * @generated from message evm_indexer.v1.DeletePluginResponse
@@ -2795,7 +4008,7 @@ export type DeletePluginResponse = Message<"evm_indexer.v1.DeletePluginResponse"
  * Use `create(DeletePluginResponseSchema)` to create a new message.
  */
 export const DeletePluginResponseSchema: GenMessage<DeletePluginResponse> = /*@__PURE__*/
-  messageDesc(file_evm_indexer_v1_evm_indexer, 120);
+  messageDesc(file_evm_indexer_v1_evm_indexer, 153);
 
 /**
  * @generated from message evm_indexer.v1.InstallPluginRequest
@@ -2812,7 +4025,7 @@ export type InstallPluginRequest = Message<"evm_indexer.v1.InstallPluginRequest"
  * Use `create(InstallPluginRequestSchema)` to create a new message.
  */
 export const InstallPluginRequestSchema: GenMessage<InstallPluginRequest> = /*@__PURE__*/
-  messageDesc(file_evm_indexer_v1_evm_indexer, 121);
+  messageDesc(file_evm_indexer_v1_evm_indexer, 154);
 
 /**
  * @generated from message evm_indexer.v1.InstallPluginResponse
@@ -2839,7 +4052,7 @@ export type InstallPluginResponse = Message<"evm_indexer.v1.InstallPluginRespons
  * Use `create(InstallPluginResponseSchema)` to create a new message.
  */
 export const InstallPluginResponseSchema: GenMessage<InstallPluginResponse> = /*@__PURE__*/
-  messageDesc(file_evm_indexer_v1_evm_indexer, 122);
+  messageDesc(file_evm_indexer_v1_evm_indexer, 155);
 
 /**
  * Lists the branches and tags of a git repository (server runs `git ls-remote`),
@@ -2859,7 +4072,7 @@ export type ListPluginGitRefsRequest = Message<"evm_indexer.v1.ListPluginGitRefs
  * Use `create(ListPluginGitRefsRequestSchema)` to create a new message.
  */
 export const ListPluginGitRefsRequestSchema: GenMessage<ListPluginGitRefsRequest> = /*@__PURE__*/
-  messageDesc(file_evm_indexer_v1_evm_indexer, 123);
+  messageDesc(file_evm_indexer_v1_evm_indexer, 156);
 
 /**
  * @generated from message evm_indexer.v1.ListPluginGitRefsResponse
@@ -2881,7 +4094,7 @@ export type ListPluginGitRefsResponse = Message<"evm_indexer.v1.ListPluginGitRef
  * Use `create(ListPluginGitRefsResponseSchema)` to create a new message.
  */
 export const ListPluginGitRefsResponseSchema: GenMessage<ListPluginGitRefsResponse> = /*@__PURE__*/
-  messageDesc(file_evm_indexer_v1_evm_indexer, 124);
+  messageDesc(file_evm_indexer_v1_evm_indexer, 157);
 
 /**
  * @generated from message evm_indexer.v1.CreateEvmiExporterRequest
@@ -2898,7 +4111,7 @@ export type CreateEvmiExporterRequest = Message<"evm_indexer.v1.CreateEvmiExport
  * Use `create(CreateEvmiExporterRequestSchema)` to create a new message.
  */
 export const CreateEvmiExporterRequestSchema: GenMessage<CreateEvmiExporterRequest> = /*@__PURE__*/
-  messageDesc(file_evm_indexer_v1_evm_indexer, 125);
+  messageDesc(file_evm_indexer_v1_evm_indexer, 158);
 
 /**
  * @generated from message evm_indexer.v1.CreateEvmiExporterResponse
@@ -2915,7 +4128,7 @@ export type CreateEvmiExporterResponse = Message<"evm_indexer.v1.CreateEvmiExpor
  * Use `create(CreateEvmiExporterResponseSchema)` to create a new message.
  */
 export const CreateEvmiExporterResponseSchema: GenMessage<CreateEvmiExporterResponse> = /*@__PURE__*/
-  messageDesc(file_evm_indexer_v1_evm_indexer, 126);
+  messageDesc(file_evm_indexer_v1_evm_indexer, 159);
 
 /**
  * @generated from message evm_indexer.v1.GetEvmiExporterRequest
@@ -2932,7 +4145,7 @@ export type GetEvmiExporterRequest = Message<"evm_indexer.v1.GetEvmiExporterRequ
  * Use `create(GetEvmiExporterRequestSchema)` to create a new message.
  */
 export const GetEvmiExporterRequestSchema: GenMessage<GetEvmiExporterRequest> = /*@__PURE__*/
-  messageDesc(file_evm_indexer_v1_evm_indexer, 127);
+  messageDesc(file_evm_indexer_v1_evm_indexer, 160);
 
 /**
  * @generated from message evm_indexer.v1.GetEvmiExporterResponse
@@ -2949,7 +4162,7 @@ export type GetEvmiExporterResponse = Message<"evm_indexer.v1.GetEvmiExporterRes
  * Use `create(GetEvmiExporterResponseSchema)` to create a new message.
  */
 export const GetEvmiExporterResponseSchema: GenMessage<GetEvmiExporterResponse> = /*@__PURE__*/
-  messageDesc(file_evm_indexer_v1_evm_indexer, 128);
+  messageDesc(file_evm_indexer_v1_evm_indexer, 161);
 
 /**
  * @generated from message evm_indexer.v1.UpdateEvmiExporterRequest
@@ -2966,7 +4179,7 @@ export type UpdateEvmiExporterRequest = Message<"evm_indexer.v1.UpdateEvmiExport
  * Use `create(UpdateEvmiExporterRequestSchema)` to create a new message.
  */
 export const UpdateEvmiExporterRequestSchema: GenMessage<UpdateEvmiExporterRequest> = /*@__PURE__*/
-  messageDesc(file_evm_indexer_v1_evm_indexer, 129);
+  messageDesc(file_evm_indexer_v1_evm_indexer, 162);
 
 /**
  * @generated from message evm_indexer.v1.UpdateEvmiExporterResponse
@@ -2979,7 +4192,7 @@ export type UpdateEvmiExporterResponse = Message<"evm_indexer.v1.UpdateEvmiExpor
  * Use `create(UpdateEvmiExporterResponseSchema)` to create a new message.
  */
 export const UpdateEvmiExporterResponseSchema: GenMessage<UpdateEvmiExporterResponse> = /*@__PURE__*/
-  messageDesc(file_evm_indexer_v1_evm_indexer, 130);
+  messageDesc(file_evm_indexer_v1_evm_indexer, 163);
 
 /**
  * @generated from message evm_indexer.v1.ListEvmiExportersRequest
@@ -3001,7 +4214,7 @@ export type ListEvmiExportersRequest = Message<"evm_indexer.v1.ListEvmiExporters
  * Use `create(ListEvmiExportersRequestSchema)` to create a new message.
  */
 export const ListEvmiExportersRequestSchema: GenMessage<ListEvmiExportersRequest> = /*@__PURE__*/
-  messageDesc(file_evm_indexer_v1_evm_indexer, 131);
+  messageDesc(file_evm_indexer_v1_evm_indexer, 164);
 
 /**
  * @generated from message evm_indexer.v1.ListEvmiExportersResponse
@@ -3018,7 +4231,7 @@ export type ListEvmiExportersResponse = Message<"evm_indexer.v1.ListEvmiExporter
  * Use `create(ListEvmiExportersResponseSchema)` to create a new message.
  */
 export const ListEvmiExportersResponseSchema: GenMessage<ListEvmiExportersResponse> = /*@__PURE__*/
-  messageDesc(file_evm_indexer_v1_evm_indexer, 132);
+  messageDesc(file_evm_indexer_v1_evm_indexer, 165);
 
 /**
  * @generated from message evm_indexer.v1.DeleteEvmiExporterRequest
@@ -3035,7 +4248,7 @@ export type DeleteEvmiExporterRequest = Message<"evm_indexer.v1.DeleteEvmiExport
  * Use `create(DeleteEvmiExporterRequestSchema)` to create a new message.
  */
 export const DeleteEvmiExporterRequestSchema: GenMessage<DeleteEvmiExporterRequest> = /*@__PURE__*/
-  messageDesc(file_evm_indexer_v1_evm_indexer, 133);
+  messageDesc(file_evm_indexer_v1_evm_indexer, 166);
 
 /**
  * @generated from message evm_indexer.v1.DeleteEvmiExporterResponse
@@ -3048,7 +4261,7 @@ export type DeleteEvmiExporterResponse = Message<"evm_indexer.v1.DeleteEvmiExpor
  * Use `create(DeleteEvmiExporterResponseSchema)` to create a new message.
  */
 export const DeleteEvmiExporterResponseSchema: GenMessage<DeleteEvmiExporterResponse> = /*@__PURE__*/
-  messageDesc(file_evm_indexer_v1_evm_indexer, 134);
+  messageDesc(file_evm_indexer_v1_evm_indexer, 167);
 
 /**
  * @generated from message evm_indexer.v1.StartExporterRequest
@@ -3065,7 +4278,7 @@ export type StartExporterRequest = Message<"evm_indexer.v1.StartExporterRequest"
  * Use `create(StartExporterRequestSchema)` to create a new message.
  */
 export const StartExporterRequestSchema: GenMessage<StartExporterRequest> = /*@__PURE__*/
-  messageDesc(file_evm_indexer_v1_evm_indexer, 135);
+  messageDesc(file_evm_indexer_v1_evm_indexer, 168);
 
 /**
  * @generated from message evm_indexer.v1.StartExporterResponse
@@ -3087,7 +4300,7 @@ export type StartExporterResponse = Message<"evm_indexer.v1.StartExporterRespons
  * Use `create(StartExporterResponseSchema)` to create a new message.
  */
 export const StartExporterResponseSchema: GenMessage<StartExporterResponse> = /*@__PURE__*/
-  messageDesc(file_evm_indexer_v1_evm_indexer, 136);
+  messageDesc(file_evm_indexer_v1_evm_indexer, 169);
 
 /**
  * @generated from message evm_indexer.v1.StopExporterRequest
@@ -3104,7 +4317,7 @@ export type StopExporterRequest = Message<"evm_indexer.v1.StopExporterRequest">
  * Use `create(StopExporterRequestSchema)` to create a new message.
  */
 export const StopExporterRequestSchema: GenMessage<StopExporterRequest> = /*@__PURE__*/
-  messageDesc(file_evm_indexer_v1_evm_indexer, 137);
+  messageDesc(file_evm_indexer_v1_evm_indexer, 170);
 
 /**
  * @generated from message evm_indexer.v1.StopExporterResponse
@@ -3126,7 +4339,7 @@ export type StopExporterResponse = Message<"evm_indexer.v1.StopExporterResponse"
  * Use `create(StopExporterResponseSchema)` to create a new message.
  */
 export const StopExporterResponseSchema: GenMessage<StopExporterResponse> = /*@__PURE__*/
-  messageDesc(file_evm_indexer_v1_evm_indexer, 138);
+  messageDesc(file_evm_indexer_v1_evm_indexer, 171);
 
 /**
  * @generated from message evm_indexer.v1.StreamEvmiExporterUpdatesRequest
@@ -3145,7 +4358,7 @@ export type StreamEvmiExporterUpdatesRequest = Message<"evm_indexer.v1.StreamEvm
  * Use `create(StreamEvmiExporterUpdatesRequestSchema)` to create a new message.
  */
 export const StreamEvmiExporterUpdatesRequestSchema: GenMessage<StreamEvmiExporterUpdatesRequest> = /*@__PURE__*/
-  messageDesc(file_evm_indexer_v1_evm_indexer, 139);
+  messageDesc(file_evm_indexer_v1_evm_indexer, 172);
 
 /**
  * @generated from message evm_indexer.v1.ExportConfigurationRequest
@@ -3158,7 +4371,7 @@ export type ExportConfigurationRequest = Message<"evm_indexer.v1.ExportConfigura
  * Use `create(ExportConfigurationRequestSchema)` to create a new message.
  */
 export const ExportConfigurationRequestSchema: GenMessage<ExportConfigurationRequest> = /*@__PURE__*/
-  messageDesc(file_evm_indexer_v1_evm_indexer, 140);
+  messageDesc(file_evm_indexer_v1_evm_indexer, 173);
 
 /**
  * @generated from message evm_indexer.v1.ExportConfigurationResponse
@@ -3180,7 +4393,7 @@ export type ExportConfigurationResponse = Message<"evm_indexer.v1.ExportConfigur
  * Use `create(ExportConfigurationResponseSchema)` to create a new message.
  */
 export const ExportConfigurationResponseSchema: GenMessage<ExportConfigurationResponse> = /*@__PURE__*/
-  messageDesc(file_evm_indexer_v1_evm_indexer, 141);
+  messageDesc(file_evm_indexer_v1_evm_indexer, 174);
 
 /**
  * @generated from service evm_indexer.v1.EvmIndexerService
@@ -3288,6 +4501,74 @@ export const EvmIndexerService: GenService<{
     input: typeof DeleteEvmJsonAbiRequestSchema;
     output: typeof DeleteEvmJsonAbiResponseSchema;
   },
+  /**
+   * @generated from rpc evm_indexer.v1.EvmIndexerService.GetEvmJsonAbiSchema
+   */
+  getEvmJsonAbiSchema: {
+    methodKind: "unary";
+    input: typeof GetEvmJsonAbiSchemaRequestSchema;
+    output: typeof GetEvmJsonAbiSchemaResponseSchema;
+  },
+  /**
+   * Proxies
+   *
+   * @generated from rpc evm_indexer.v1.EvmIndexerService.SetEvmImplementationAbi
+   */
+  setEvmImplementationAbi: {
+    methodKind: "unary";
+    input: typeof SetEvmImplementationAbiRequestSchema;
+    output: typeof SetEvmImplementationAbiResponseSchema;
+  },
+  /**
+   * @generated from rpc evm_indexer.v1.EvmIndexerService.ListEvmImplementationAbis
+   */
+  listEvmImplementationAbis: {
+    methodKind: "unary";
+    input: typeof ListEvmImplementationAbisRequestSchema;
+    output: typeof ListEvmImplementationAbisResponseSchema;
+  },
+  /**
+   * @generated from rpc evm_indexer.v1.EvmIndexerService.DeleteEvmImplementationAbi
+   */
+  deleteEvmImplementationAbi: {
+    methodKind: "unary";
+    input: typeof DeleteEvmImplementationAbiRequestSchema;
+    output: typeof DeleteEvmImplementationAbiResponseSchema;
+  },
+  /**
+   * @generated from rpc evm_indexer.v1.EvmIndexerService.ListEvmProxyImplementations
+   */
+  listEvmProxyImplementations: {
+    methodKind: "unary";
+    input: typeof ListEvmProxyImplementationsRequestSchema;
+    output: typeof ListEvmProxyImplementationsResponseSchema;
+  },
+  /**
+   * Event signatures
+   *
+   * @generated from rpc evm_indexer.v1.EvmIndexerService.ImportEvmEventSignatures
+   */
+  importEvmEventSignatures: {
+    methodKind: "unary";
+    input: typeof ImportEvmEventSignaturesRequestSchema;
+    output: typeof ImportEvmEventSignaturesResponseSchema;
+  },
+  /**
+   * @generated from rpc evm_indexer.v1.EvmIndexerService.ListEvmEventSignatures
+   */
+  listEvmEventSignatures: {
+    methodKind: "unary";
+    input: typeof ListEvmEventSignaturesRequestSchema;
+    output: typeof ListEvmEventSignaturesResponseSchema;
+  },
+  /**
+   * @generated from rpc evm_indexer.v1.EvmIndexerService.DeleteEvmEventSignature
+   */
+  deleteEvmEventSignature: {
+    methodKind: "unary";
+    input: typeof DeleteEvmEventSignatureRequestSchema;
+    output: typeof DeleteEvmEventSignatureResponseSchema;
+  },
   /**
    * EvmLogStore
    *
@@ -3430,6 +4711,28 @@ export const EvmIndexerService: GenService<{
     input: typeof DeleteEvmLogSourceRequestSchema;
     output: typeof DeleteEvmLogSourceResponseSchema;
   },
+  /**
+   * Starts re-decoding the source's stored logs with its current ABIs; the
+   * progress is streamed as source updates.
+   *
+   * @generated from rpc evm_indexer.v1.EvmIndexerService.RedecodeEvmLogSource
+   */
+  redecodeEvmLogSource: {
+    methodKind: "unary";
+    input: typeof RedecodeEvmLogSourceRequestSchema;
+    output: typeof RedecodeEvmLogSourceResponseSchema;
+  },
+  /**
+   * Stops the source, deletes its stored data from from_block, rewinds its
+   * cursor and its pipeline's exporters, then starts it again if enabled.
+   *
+   * @generated from rpc evm_indexer.v1.EvmIndexerService.ResyncEvmLogSource
+   */
+  resyncEvmLogSource: {
+    methodKind: "unary";
+    input: typeof ResyncEvmLogSourceRequestSchema;
+    output: typeof ResyncEvmLogSourceResponseSchema;
+  },
   /**
    * Server stream of live source updates (indexing progress, status changes).
    *
@@ -3468,6 +4771,22 @@ export const EvmIndexerService: GenService<{
     input: typeof ListEvmTransactionsRequestSchema;
     output: typeof ListEvmTransactionsResponseSchema;
   },
+  /**
+   * @generated from rpc evm_indexer.v1.EvmIndexerService.ListEvmBlocks
+   */
+  listEvmBlocks: {
+    methodKind: "unary";
+    input: typeof ListEvmBlocksRequestSchema;
+    output: typeof ListEvmBlocksResponseSchema;
+  },
+  /**
+   * @generated from rpc evm_indexer.v1.EvmIndexerService.ListEvmTraces
+   */
+  listEvmTraces: {
+    methodKind: "unary";
+    input: typeof ListEvmTracesRequestSchema;
+    output: typeof ListEvmTracesResponseSchema;
+  },
   /**
    * Auth (Login and GetOAuthLoginUrl are public; all others require a token)
    *
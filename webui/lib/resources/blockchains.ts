@@ -1,6 +1,35 @@
 import { client } from "@/lib/client";
-import type { EvmBlockchain } from "@/gen/evm_indexer/v1/evm_indexer_pb";
-import { PAGE, big, str, type Resource } from "./types";
+import type { EvmBlockchain, EvmRpcEndpoint } from "@/gen/evm_indexer/v1/evm_indexer_pb";
+import { PAGE, big, bool, num, splitList, str, type FormValues, type Option, type Resource } from "./types";
+
+const headModeOptions: Option[] = [
+  { value: "LATEST", label: "Latest" },
+  { value: "CONFIRMATIONS", label: "N confirmations" },
+  { value: "SAFE", label: "Safe" },
+  { value: "FINALIZED", label: "Finalized" },
+];
+
+// RPC endpoints are edited as one "url [weight]" per line.
+const parseRpcEndpoints = (s: string) =>
+  splitList(s).map((line) => {
+    const [url, weight] = line.split(/\s+/);
+    return { url, weight: BigInt(weight || "1") };
+  });
+const formatRpcEndpoints = (endpoints: EvmRpcEndpoint[]) =>
+  endpoints.map((e) => `${e.url} ${e.weight}`).join("\n");
+// Method costs are edited as one "method cost" per line.
+const parseMethodCosts = (s: string) =>
+  Object.fromEntries(
+    splitList(s).map((line) => {
+      const [method, cost] = line.split(/\s+/);
+      return [method, BigInt(cost || "1")];
+    }),
+  );
+const formatMethodCosts = (costs: { [method: string]: bigint }) =>
+  Object.entries(costs)
+    .map(([method, cost]) => `${method} ${cost}`)
+    .join("\n");
+const healthyEndpoints = (b: EvmBlockchain) => (b.rpcEndpoints ?? []).filter((e) => e.healthy).length;
 
 export const blockchains: Resource<EvmBlockchain> = {
   key: "blockchains",
@@ -9,17 +38,91 @@ export const blockchains: Resource<EvmBlockchain> = {
   fields: [
     { name: "name", label: "Name", type: "text", required: true },
     { name: "chainId", label: "Chain ID", type: "bigint", required: true },
-    { name: "rpcUrl", label: "RPC URL", type: "text", required: true },
+    { name: "rpcUrl", label: "RPC URL", type: "text", help: "Used when no RPC endpoints are listed" },
+    {
+      name: "rpcEndpoints",
+      label: "RPC endpoints",
+      type: "textarea",
+      placeholder: "https://rpc-a.example 3\nhttps://rpc-b.example 1",
+      help: "One endpoint per line, optionally followed by its weight (default 1)",
+    },
     { name: "blockRange", label: "Block range", type: "bigint", placeholder: "1000" },
     { name: "blockSlice", label: "Block slice (confirmations)", type: "bigint", placeholder: "4" },
     { name: "pullInterval", label: "Pull interval (s)", type: "bigint", placeholder: "2" },
     { name: "rpcMaxBatchSize", label: "RPC max batch size", type: "bigint", placeholder: "1000" },
+    {
+      name: "logsMaxAddresses",
+      label: "eth_getLogs max addresses",
+      type: "bigint",
+      placeholder: "1000",
+      help: "Addresses per eth_getLogs call when the ranges of several sources are batched",
+    },
+    {
+      name: "rpcRateLimit",
+      label: "RPC rate limit (per second)",
+      type: "number",
+      placeholder: "0",
+      help: "Requests (or compute units) per second shared by the chain's sources on an indexer; 0 is unlimited",
+    },
+    {
+      name: "rpcMethodCosts",
+      label: "RPC method costs",
+      type: "textarea",
+      placeholder: "eth_getLogs 75\ndebug_traceBlockByNumber 300",
+      help: "One method per line followed by its compute units; other methods cost 1",
+    },
+    { name: "headMode", label: "Head mode", type: "select", options: headModeOptions, help: "Block the chain's sources index up to" },
+    {
+      name: "headConfirmations",
+      label: "Head confirmations",
+      type: "bigint",
+      placeholder: "12",
+      showIf: (v: FormValues) => v.headMode === "CONFIRMATIONS",
+    },
+    {
+      name: "wsUrl",
+      label: "WebSocket URL",
+      type: "text",
+      placeholder: "wss://…",
+      help: "Index on newHeads notifications instead of polling; falls back to polling if the socket drops",
+    },
+    {
+      name: "wsSubscribeLogs",
+      label: "Wake on the source's logs instead of every head",
+      type: "checkbox",
+      showIf: (v: FormValues) => str(v, "wsUrl") !== "",
+    },
+    { name: "sqdGatewayAvailable", label: "Backfill from SQD archive", type: "checkbox" },
+    {
+      name: "traceFilterAvailable",
+      label: "Node supports trace_filter",
+      type: "checkbox",
+      help: "TRACES sources use trace_filter; otherwise every block is traced with debug_traceBlockByNumber",
+    },
+    {
+      name: "sqdGatewayUrl",
+      label: "SQD gateway URL",
+      type: "text",
+      placeholder: "https://v2.archive.subsquid.io/network/ethereum-mainnet",
+      showIf: (v: FormValues) => v.sqdGatewayAvailable === true,
+    },
   ],
   columns: [
     { label: "ID", get: (b) => String(b.id ?? "") },
     { label: "Name", get: (b) => b.name },
     { label: "Chain", get: (b) => String(b.chainId), mono: true },
     { label: "RPC", get: (b) => b.rpcUrl, mono: true },
+    {
+      label: "Endpoints",
+      get: (b) => (b.rpcEndpoints?.length ? `${healthyEndpoints(b)}/${b.rpcEndpoints.length} healthy` : "—"),
+      tone: (b) =>
+        !b.rpcEndpoints?.length ? "muted" : healthyEndpoints(b) === b.rpcEndpoints.length ? "ok" : healthyEndpoints(b) > 0 ? "warn" : "danger",
+      title: (b) =>
+        (b.rpcEndpoints ?? [])
+          .map((e) => `${e.url}: ${e.healthy ? "healthy" : "unhealthy"}, head ${e.headBlock}, ${e.latencyMs} ms, ${(e.errorRate * 100).toFixed(1)}% errors${e.lastError ? ` (${e.lastError})` : ""}`)
+          .join("\n"),
+    },
+    { label: "Head", get: (b) => (b.headMode === "CONFIRMATIONS" ? `${b.headConfirmations} confirmations` : b.headMode || "LATEST") },
   ],
   idOf: (b) => b.id ?? 0,
   list: async () => (await client.listEvmBlockchains(PAGE)).blockchains ?? [],
@@ -33,6 +136,17 @@ export const blockchains: Resource<EvmBlockchain> = {
         blockSlice: big(v, "blockSlice"),
         pullInterval: big(v, "pullInterval"),
         rpcMaxBatchSize: big(v, "rpcMaxBatchSize"),
+        logsMaxAddresses: big(v, "logsMaxAddresses"),
+        headMode: str(v, "headMode"),
+        headConfirmations: big(v, "headConfirmations"),
+        sqdGatewayAvailable: bool(v, "sqdGatewayAvailable"),
+        sqdGatewayUrl: str(v, "sqdGatewayUrl"),
+        traceFilterAvailable: bool(v, "traceFilterAvailable"),
+        wsUrl: str(v, "wsUrl"),
+        wsSubscribeLogs: bool(v, "wsSubscribeLogs"),
+        rpcEndpoints: parseRpcEndpoints(str(v, "rpcEndpoints")),
+        rpcRateLimit: num(v, "rpcRateLimit"),
+        rpcMethodCosts: parseMethodCosts(str(v, "rpcMethodCosts")),
       },
     });
   },
@@ -47,6 +161,17 @@ export const blockchains: Resource<EvmBlockchain> = {
         blockSlice: big(v, "blockSlice"),
         pullInterval: big(v, "pullInterval"),
         rpcMaxBatchSize: big(v, "rpcMaxBatchSize"),
+        logsMaxAddresses: big(v, "logsMaxAddresses"),
+        headMode: str(v, "headMode"),
+        headConfirmations: big(v, "headConfirmations"),
+        sqdGatewayAvailable: bool(v, "sqdGatewayAvailable"),
+        sqdGatewayUrl: str(v, "sqdGatewayUrl"),
+        traceFilterAvailable: bool(v, "traceFilterAvailable"),
+        wsUrl: str(v, "wsUrl"),
+        wsSubscribeLogs: bool(v, "wsSubscribeLogs"),
+        rpcEndpoints: parseRpcEndpoints(str(v, "rpcEndpoints")),
+        rpcRateLimit: num(v, "rpcRateLimit"),
+        rpcMethodCosts: parseMethodCosts(str(v, "rpcMethodCosts")),
       },
     });
   },
@@ -61,5 +186,16 @@ export const blockchains: Resource<EvmBlockchain> = {
     blockSlice: String(b.blockSlice),
     pullInterval: String(b.pullInterval),
     rpcMaxBatchSize: String(b.rpcMaxBatchSize),
+    logsMaxAddresses: String(b.logsMaxAddresses),
+    headMode: b.headMode || "LATEST",
+    headConfirmations: String(b.headConfirmations),
+    sqdGatewayAvailable: b.sqdGatewayAvailable,
+    sqdGatewayUrl: b.sqdGatewayUrl,
+    traceFilterAvailable: b.traceFilterAvailable,
+    wsUrl: b.wsUrl,
+    wsSubscribeLogs: b.wsSubscribeLogs,
+    rpcEndpoints: formatRpcEndpoints(b.rpcEndpoints ?? []),
+    rpcRateLimit: String(b.rpcRateLimit),
+    rpcMethodCosts: formatMethodCosts(b.rpcMethodCosts ?? {}),
   }),
 };
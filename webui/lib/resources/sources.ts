@@ -1,10 +1,11 @@
 import { client } from "@/lib/client";
 import type { EvmLogSource } from "@/gen/evm_indexer/v1/evm_indexer_pb";
-import { PAGE, big, bool, num, optStr, str, type FormValues, type Option, type Resource } from "./types";
+import { PAGE, big, bool, num, optStr, splitList, str, type FormValues, type Option, type Resource } from "./types";
 import { abiOptions, abiTopic0Options, blockchainOptions, pipelineOptions } from "./options";
 
 // A FactoryRule init shape (keys match the proto message; recursive).
 type FactoryRuleInit = {
+  trigger?: string;
   creationFunctionName?: string;
   creationAddressLogArg?: string;
   childType?: string;
@@ -35,6 +36,9 @@ const sourceTypeOptions: Option[] = [
   { value: "TOPIC", label: "Topic" },
   { value: "FACTORY", label: "Factory" },
   { value: "FULL", label: "Full chain" },
+  { value: "TRANSACTIONS", label: "Transactions" },
+  { value: "BLOCKS", label: "Blocks" },
+  { value: "TRACES", label: "Traces" },
 ];
 
 // Show a field only for the given source type(s).
@@ -53,13 +57,29 @@ export const sources: Resource<EvmLogSource> = {
     { name: "evmLogPipelineId", label: "Pipeline", type: "select", optionsFrom: pipelineOptions },
     { name: "evmBlockchainId", label: "Blockchain", type: "select", optionsFrom: blockchainOptions },
     { name: "startBlock", label: "Start block", type: "bigint" },
+    { name: "endBlock", label: "End block", type: "bigint", help: "Stop once this block is indexed (COMPLETED); 0 indexes forever" },
+    {
+      name: "catchUpWorkers",
+      label: "Catch-up workers",
+      type: "number",
+      help: "Ranges fetched concurrently while far behind the head; 0 or 1 indexes one range at a time",
+    },
     // ABI is used for decoding (not needed for a full-chain source).
-    { name: "evmJsonAbiId", label: "ABI", type: "select", optionsFrom: abiOptions, showIf: forType("CONTRACT", "TOPIC", "FACTORY") },
-    // Contract / factory: the address to watch.
-    { name: "address", label: "Contract address", type: "text", showIf: forType("CONTRACT", "FACTORY") },
+    { name: "evmJsonAbiId", label: "ABI", type: "select", optionsFrom: abiOptions, showIf: forType("CONTRACT", "TOPIC", "FACTORY", "TRANSACTIONS") },
+    // Contract / factory: the address to watch; transactions: the first address
+    // whose calls are matched.
+    { name: "address", label: "Contract address", type: "text", showIf: forType("CONTRACT", "FACTORY", "TRANSACTIONS", "TRACES") },
     // Topic: pick the event from the selected ABI (stored as its topic0 hash).
     { name: "topic0", label: "Event (topic0)", type: "select", loadOptions: abiTopic0Options, depends: ["evmJsonAbiId"], help: "Derived from the selected ABI", showIf: forType("TOPIC") },
     { name: "topicFilters", label: "Indexed argument filters", type: "topicFilters", showIf: forType("TOPIC") },
+    // Optional extras: a TOPIC source matches any of its topic0s, and logs are
+    // decoded against whichever of the source's ABIs declares the event.
+    { name: "extraTopic0s", label: "Additional topic0s", type: "textarea", placeholder: "0x… (one per line)", showIf: forType("TOPIC") },
+    { name: "extraAbiIds", label: "Additional ABI ids", type: "text", placeholder: "e.g. 3, 7", showIf: forType("CONTRACT", "TOPIC", "FACTORY", "TRANSACTIONS") },
+    // Transactions: any of the addresses as sender or recipient, optionally
+    // calling one function.
+    { name: "extraAddresses", label: "Additional addresses", type: "textarea", placeholder: "0x… (one per line)", showIf: forType("TRANSACTIONS", "TRACES") },
+    { name: "functionSelector", label: "Function selector", type: "text", placeholder: "0xa9059cbb", showIf: forType("TRANSACTIONS") },
     // Factory: N creation rules (each: creation event → child of a type/ABI).
     // A rule that creates a factory carries its own nested rules (recursive).
     { name: "factoryRules", label: "Factory rules", type: "factoryRules", showIf: forType("FACTORY") },
@@ -79,12 +99,34 @@ export const sources: Resource<EvmLogSource> = {
       label: "Contract",
       get: (s, ctx) => (ctx.abiNames as Record<number, string> | undefined)?.[s.evmJsonAbiId ?? 0] || "—",
     },
-    { label: "Sync block", get: (s) => String(s.syncBlock) },
+    { label: "Sync block", get: (s) => (s.endBlock ? `${s.syncBlock} / ${s.endBlock}` : String(s.syncBlock)) },
+    // A failing source shows its failure count, its last error on hover.
     {
       label: "Status",
-      get: (s) => (s.enabled ? s.status || "enabled" : "disabled"),
+      get: (s) =>
+        !s.enabled
+          ? "disabled"
+          : s.consecutiveFailures
+            ? `${s.status} (${s.consecutiveFailures} failures)`
+            : s.status || "enabled",
+      title: (s) =>
+        s.lastError
+          ? `${s.lastError}${s.lastErrorAt ? ` at ${new Date(s.lastErrorAt * 1000).toISOString()}` : ""}`
+          : "",
       tone: (s) => (!s.enabled ? "muted" : s.status === "RUNNING" ? "ok" : s.status === "LOOPBACKOFF" ? "warn" : "neutral"),
     },
+    // Progress of the last re-decode job, streamed with the source updates.
+    {
+      label: "Re-decode",
+      get: (s) =>
+        !s.redecode
+          ? "—"
+          : s.redecode.status === "RUNNING"
+            ? `${s.redecode.nextBlock} / ${s.redecode.toBlock} (${s.redecode.logs} logs)`
+            : `${s.redecode.status} (${s.redecode.logs} logs)`,
+      title: (s) => s.redecode?.error ?? "",
+      tone: (s) => (s.redecode?.status === "FAILED" ? "danger" : s.redecode?.status === "DONE" ? "ok" : "neutral"),
+    },
   ],
   // Load abi id→name so the Contract column can label each source's ABI.
   loadColumnContext: async () => {
@@ -114,17 +156,35 @@ export const sources: Resource<EvmLogSource> = {
     type: s.type,
     enabled: s.enabled,
     startBlock: String(s.startBlock),
+    endBlock: String(s.endBlock),
+    catchUpWorkers: String(s.catchUpWorkers),
     evmLogPipelineId: String(s.evmLogPipelineId),
     evmBlockchainId: String(s.evmBlockchainId),
     evmJsonAbiId: String(s.evmJsonAbiId),
     address: s.address ?? "",
     topic0: s.topic0 ?? "",
+    extraTopic0s: (s.topic0s ?? []).slice(1).join("\n"),
+    extraAbiIds: (s.evmJsonAbiIds ?? []).slice(1).join(", "),
+    extraAddresses: (s.addresses ?? []).slice(1).join("\n"),
+    functionSelector: s.functionSelector,
     topicFilters: (s.topicFilters ?? []).join("\n"),
     factoryRules: JSON.stringify(s.factoryRules ?? []),
   }),
   actions: [
     { label: "Start", run: async (s) => void (await client.startSourceIndexer({ id: s.id ?? 0 })) },
     { label: "Stop", run: async (s) => void (await client.stopSourceIndexer({ id: s.id ?? 0 })) },
+    { label: "Re-decode", run: async (s) => void (await client.redecodeEvmLogSource({ id: s.id ?? 0 })) },
+    {
+      label: "Resync",
+      run: async (s) => {
+        // Stored data from this block on is deleted and indexed again.
+        const from = window.prompt("Resync from block", String(s.startBlock ?? 0));
+        if (from === null || !/^\d+$/.test(from.trim())) return;
+        const includeChildren =
+          s.type === "FACTORY" && window.confirm("Also resync the sources this factory created?");
+        await client.resyncEvmLogSource({ id: s.id ?? 0, fromBlock: BigInt(from.trim()), includeChildren });
+      },
+    },
   ],
   // Live indexing progress via the server stream, with auto-reconnect.
   stream: (onUpdate, signal) => {
@@ -145,15 +205,27 @@ export const sources: Resource<EvmLogSource> = {
 };
 
 function sourceFromForm(v: Parameters<Resource<EvmLogSource>["create"]>[0]) {
+  // The first entry of each list is the primary ABI / topic0 / address picked above.
+  const topic0 = optStr(v, "topic0");
+  const extraTopic0s = splitList(str(v, "extraTopic0s"));
+  const address = optStr(v, "address");
+  const extraAddresses = splitList(str(v, "extraAddresses"));
+  const extraAbiIds = splitList(str(v, "extraAbiIds")).map(Number).filter((id) => id > 0);
   return {
     type: str(v, "type"),
     enabled: bool(v, "enabled"),
     startBlock: big(v, "startBlock"),
+    endBlock: big(v, "endBlock"),
+    catchUpWorkers: num(v, "catchUpWorkers"),
     evmLogPipelineId: num(v, "evmLogPipelineId"),
     evmBlockchainId: num(v, "evmBlockchainId"),
     evmJsonAbiId: num(v, "evmJsonAbiId"),
-    address: optStr(v, "address"),
-    topic0: optStr(v, "topic0"),
+    address,
+    addresses: address && extraAddresses.length ? [address, ...extraAddresses] : [],
+    functionSelector: str(v, "functionSelector"),
+    topic0,
+    topic0s: topic0 && extraTopic0s.length ? [topic0, ...extraTopic0s] : [],
+    evmJsonAbiIds: extraAbiIds.length ? [num(v, "evmJsonAbiId"), ...extraAbiIds] : [],
     // Positional topics[1..] filters: keep interior blanks (wildcards) in place,
     // trim only trailing blanks. Do NOT use splitList — it drops empties.
     topicFilters: parseTopicFilters(str(v, "topicFilters")),